@@ -0,0 +1,193 @@
+package coords
+
+import (
+	"math"
+	"time"
+
+	atime "github.com/sakiphan/qsim-core/astro/time"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Equatorial holds right ascension and declination.
+type Equatorial struct {
+	RightAscension units.Angle
+	Declination    units.Angle
+}
+
+// Ecliptic holds ecliptic longitude and latitude.
+type Ecliptic struct {
+	Longitude units.Angle
+	Latitude  units.Angle
+}
+
+// Galactic holds galactic longitude l and latitude b.
+type Galactic struct {
+	Longitude units.Angle
+	Latitude  units.Angle
+}
+
+// Horizontal holds azimuth, measured from north through east, and
+// altitude above the horizon.
+type Horizontal struct {
+	Azimuth  units.Angle
+	Altitude units.Angle
+}
+
+// Observer is a location on Earth. Longitude is positive east.
+type Observer struct {
+	Latitude  units.Angle
+	Longitude units.Angle
+}
+
+// J2000 is the standard epoch 2000 January 1, 12:00 TT, taken as UTC.
+var J2000 = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+// J2000 galactic frame: north galactic pole and the galactic longitude of
+// the north celestial pole.
+var (
+	poleRA  = units.Degree(192.85948).Val()
+	poleDec = units.Degree(27.12825).Val()
+	poleL   = units.Degree(122.93192).Val()
+)
+
+// -----------------------------------------------------------------------------
+// Ecliptic
+// -----------------------------------------------------------------------------
+
+// MeanObliquity returns the mean obliquity of the ecliptic at the given
+// epoch (IAU 1976).
+//
+// Formula:
+//
+//	ε = 23°26'21.448" - 46.8150"T - 0.00059"T² + 0.001813"T³
+//
+// where T is in Julian centuries from J2000.
+func MeanObliquity(epoch time.Time) units.Angle {
+	t := atime.JulianCenturies(epoch)
+	return units.ArcSecond(84381.448 + t*(-46.8150+t*(-0.00059+t*0.001813)))
+}
+
+// EquatorialToEcliptic converts equatorial coordinates referred to the
+// mean equinox of epoch to ecliptic coordinates of the same epoch.
+func EquatorialToEcliptic(eq Equatorial, epoch time.Time) Ecliptic {
+	e := MeanObliquity(epoch).Val()
+	a, d := eq.RightAscension.Val(), eq.Declination.Val()
+	lon := math.Atan2(math.Sin(a)*math.Cos(d)*math.Cos(e)+math.Sin(d)*math.Sin(e), math.Cos(a)*math.Cos(d))
+	lat := math.Asin(math.Sin(d)*math.Cos(e) - math.Cos(d)*math.Sin(e)*math.Sin(a))
+	return Ecliptic{Longitude: units.Radian(normalize(lon)), Latitude: units.Radian(lat)}
+}
+
+// EclipticToEquatorial converts ecliptic coordinates of epoch to
+// equatorial coordinates referred to the mean equinox of the same epoch.
+func EclipticToEquatorial(ec Ecliptic, epoch time.Time) Equatorial {
+	e := MeanObliquity(epoch).Val()
+	l, b := ec.Longitude.Val(), ec.Latitude.Val()
+	ra := math.Atan2(math.Sin(l)*math.Cos(b)*math.Cos(e)-math.Sin(b)*math.Sin(e), math.Cos(l)*math.Cos(b))
+	dec := math.Asin(math.Sin(b)*math.Cos(e) + math.Cos(b)*math.Sin(e)*math.Sin(l))
+	return Equatorial{RightAscension: units.Radian(normalize(ra)), Declination: units.Radian(dec)}
+}
+
+// -----------------------------------------------------------------------------
+// Galactic
+// -----------------------------------------------------------------------------
+
+// EquatorialToGalactic converts J2000 equatorial coordinates to galactic
+// coordinates.
+func EquatorialToGalactic(eq Equatorial) Galactic {
+	a, d := eq.RightAscension.Val()-poleRA, eq.Declination.Val()
+	b := math.Asin(math.Sin(d)*math.Sin(poleDec) + math.Cos(d)*math.Cos(poleDec)*math.Cos(a))
+	l := poleL - math.Atan2(math.Cos(d)*math.Sin(a), math.Sin(d)*math.Cos(poleDec)-math.Cos(d)*math.Sin(poleDec)*math.Cos(a))
+	return Galactic{Longitude: units.Radian(normalize(l)), Latitude: units.Radian(b)}
+}
+
+// GalacticToEquatorial converts galactic coordinates to J2000 equatorial
+// coordinates.
+func GalacticToEquatorial(g Galactic) Equatorial {
+	l, b := poleL-g.Longitude.Val(), g.Latitude.Val()
+	dec := math.Asin(math.Sin(b)*math.Sin(poleDec) + math.Cos(b)*math.Cos(poleDec)*math.Cos(l))
+	ra := poleRA + math.Atan2(math.Cos(b)*math.Sin(l), math.Sin(b)*math.Cos(poleDec)-math.Cos(b)*math.Sin(poleDec)*math.Cos(l))
+	return Equatorial{RightAscension: units.Radian(normalize(ra)), Declination: units.Radian(dec)}
+}
+
+// -----------------------------------------------------------------------------
+// Horizontal
+// -----------------------------------------------------------------------------
+
+// EquatorialToHorizontal returns the azimuth and altitude of a position
+// for an observer at time t. The hour angle is H = θ - α, where θ is the
+// local mean sidereal time.
+//
+// Example:
+//
+//	// Venus from Washington, 1987 April 10 19:21 UT (Meeus, Ex. 13.b)
+//	obs := coords.Observer{Latitude: units.Degree(38.9214), Longitude: units.Degree(-77.0656)}
+//	venus := coords.Equatorial{RightAscension: units.Degree(347.3193), Declination: units.Degree(-6.7199)}
+//	h := coords.EquatorialToHorizontal(venus, obs, time.Date(1987, 4, 10, 19, 21, 0, 0, time.UTC))
+//	// h.Azimuth ≈ 248.03°, h.Altitude ≈ 15.12°
+func EquatorialToHorizontal(eq Equatorial, obs Observer, t time.Time) Horizontal {
+	h := atime.LocalSiderealTime(t, obs.Longitude).Val() - eq.RightAscension.Val()
+	d, phi := eq.Declination.Val(), obs.Latitude.Val()
+	alt := math.Asin(math.Sin(phi)*math.Sin(d) + math.Cos(phi)*math.Cos(d)*math.Cos(h))
+	az := math.Atan2(-math.Cos(d)*math.Sin(h), math.Sin(d)*math.Cos(phi)-math.Cos(d)*math.Sin(phi)*math.Cos(h))
+	return Horizontal{Azimuth: units.Radian(normalize(az)), Altitude: units.Radian(alt)}
+}
+
+// HorizontalToEquatorial returns the equatorial position seen at the
+// given azimuth and altitude by an observer at time t.
+func HorizontalToEquatorial(hz Horizontal, obs Observer, t time.Time) Equatorial {
+	az, alt, phi := hz.Azimuth.Val(), hz.Altitude.Val(), obs.Latitude.Val()
+	dec := math.Asin(math.Sin(phi)*math.Sin(alt) + math.Cos(phi)*math.Cos(alt)*math.Cos(az))
+	h := math.Atan2(-math.Sin(az)*math.Cos(alt), math.Sin(alt)*math.Cos(phi)-math.Cos(alt)*math.Sin(phi)*math.Cos(az))
+	return Equatorial{RightAscension: units.Radian(normalize(atime.LocalSiderealTime(t, obs.Longitude).Val() - h)), Declination: units.Radian(dec)}
+}
+
+// -----------------------------------------------------------------------------
+// Separation and Precession
+// -----------------------------------------------------------------------------
+
+// Separation returns the great-circle angle between two positions. It uses
+// the Vincenty formula, which stays accurate for both very small and
+// nearly antipodal separations.
+func Separation(a, b Equatorial) units.Angle {
+	d1, d2 := a.Declination.Val(), b.Declination.Val()
+	da := b.RightAscension.Val() - a.RightAscension.Val()
+	x := math.Cos(d2) * math.Sin(da)
+	y := math.Cos(d1)*math.Sin(d2) - math.Sin(d1)*math.Cos(d2)*math.Cos(da)
+	z := math.Sin(d1)*math.Sin(d2) + math.Cos(d1)*math.Cos(d2)*math.Cos(da)
+	return units.Radian(math.Atan2(math.Hypot(x, y), z))
+}
+
+// Precess moves an equatorial position from the mean equator and equinox
+// of one epoch to those of another, using the IAU 1976 angles ζ, z and θ.
+// Proper motion is not applied.
+//
+// Example:
+//
+//	// J2000 position of Polaris at the start of 2050
+//	p := coords.Precess(polaris, coords.J2000, time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC))
+func Precess(eq Equatorial, from, to time.Time) Equatorial {
+	T := atime.JulianCenturies(from)
+	t := atime.JulianCenturies(to) - T
+	c := 2306.2181 + T*(1.39656-0.000139*T)
+	zeta := units.ArcSecond(c*t + (0.30188-0.000344*T)*t*t + 0.017998*t*t*t).Val()
+	z := units.ArcSecond(c*t + (1.09468+0.000066*T)*t*t + 0.018203*t*t*t).Val()
+	theta := units.ArcSecond((2004.3109-T*(0.85330+0.000217*T))*t - (0.42665+0.000217*T)*t*t - 0.041833*t*t*t).Val()
+
+	a, d := eq.RightAscension.Val()+zeta, eq.Declination.Val()
+	A := math.Cos(d) * math.Sin(a)
+	B := math.Cos(theta)*math.Cos(d)*math.Cos(a) - math.Sin(theta)*math.Sin(d)
+	C := math.Sin(theta)*math.Cos(d)*math.Cos(a) + math.Cos(theta)*math.Sin(d)
+	return Equatorial{
+		RightAscension: units.Radian(normalize(math.Atan2(A, B) + z)),
+		Declination:    units.Radian(math.Atan2(C, math.Hypot(A, B))),
+	}
+}
+
+// normalize wraps an angle in radians into [0, 2π).
+func normalize(x float64) float64 {
+	x = math.Mod(x, 2*math.Pi)
+	if x < 0 {
+		x += 2 * math.Pi
+	}
+	return x
+}
@@ -0,0 +1,100 @@
+package coords
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestEcliptic(t *testing.T) {
+	// Pollux (Meeus, Ex. 13.a): λ = 113.215630°, β = 6.684170°.
+	pollux := Equatorial{RightAscension: units.Degree(116.328942), Declination: units.Degree(28.026183)}
+	if e := MeanObliquity(J2000).ToDegrees(); !almostEqual(e, 23.4392911, 1e-9) {
+		t.Errorf("MeanObliquity(J2000) = %v°, want 23.4392911°", e)
+	}
+	ec := EquatorialToEcliptic(pollux, J2000)
+	if !almostEqual(ec.Longitude.ToDegrees(), 113.215630, 1e-8) || !almostEqual(ec.Latitude.ToDegrees(), 6.684170, 1e-6) {
+		t.Errorf("Pollux ecliptic = %v°, %v°", ec.Longitude.ToDegrees(), ec.Latitude.ToDegrees())
+	}
+	back := EclipticToEquatorial(ec, J2000)
+	if Separation(back, pollux).ToArcseconds() > 1e-6 {
+		t.Errorf("ecliptic round trip = %+v, want %+v", back, pollux)
+	}
+}
+
+func TestGalactic(t *testing.T) {
+	// The galactic center lies at α ≈ 266.405°, δ ≈ -28.936°.
+	gc := GalacticToEquatorial(Galactic{})
+	if !almostEqual(gc.RightAscension.ToDegrees(), 266.405, 1e-5) || !almostEqual(gc.Declination.ToDegrees(), -28.936, 1e-4) {
+		t.Errorf("galactic center = %v°, %v°", gc.RightAscension.ToDegrees(), gc.Declination.ToDegrees())
+	}
+	ngp := EquatorialToGalactic(Equatorial{RightAscension: units.Degree(192.85948), Declination: units.Degree(27.12825)})
+	if !almostEqual(ngp.Latitude.ToDegrees(), 90, 1e-12) {
+		t.Errorf("north galactic pole b = %v°, want 90°", ngp.Latitude.ToDegrees())
+	}
+	for _, g := range []Galactic{{units.Degree(30), units.Degree(10)}, {units.Degree(280), units.Degree(-45)}} {
+		back := EquatorialToGalactic(GalacticToEquatorial(g))
+		if !almostEqual(back.Longitude.Val(), g.Longitude.Val(), 1e-12) || !almostEqual(back.Latitude.Val(), g.Latitude.Val(), 1e-12) {
+			t.Errorf("galactic round trip %+v -> %+v", g, back)
+		}
+	}
+}
+
+func TestHorizontal(t *testing.T) {
+	// Venus from Washington (Meeus, Ex. 13.b), azimuth converted to
+	// north-based. Meeus uses apparent sidereal time, which differs by 0.24 s.
+	obs := Observer{Latitude: units.Degree(38.92139), Longitude: units.Degree(-77.06556)}
+	venus := Equatorial{RightAscension: units.HourAngle(23.1546225), Declination: units.Degree(-6.719892)}
+	at := time.Date(1987, time.April, 10, 19, 21, 0, 0, time.UTC)
+	h := EquatorialToHorizontal(venus, obs, at)
+	if !almostEqual(h.Azimuth.ToDegrees(), 248.0337, 1e-5) || !almostEqual(h.Altitude.ToDegrees(), 15.1249, 1e-4) {
+		t.Errorf("Venus horizontal = %v°, %v°; want 248.0337°, 15.1249°", h.Azimuth.ToDegrees(), h.Altitude.ToDegrees())
+	}
+	if back := HorizontalToEquatorial(h, obs, at); Separation(back, venus).ToArcseconds() > 1e-6 {
+		t.Errorf("horizontal round trip = %+v", back)
+	}
+	// The celestial pole sits at altitude φ due north.
+	pole := EquatorialToHorizontal(Equatorial{Declination: units.Degree(90)}, obs, at)
+	if !almostEqual(pole.Altitude.Val(), obs.Latitude.Val(), 1e-12) || math.Abs(math.Sin(pole.Azimuth.Val())) > 1e-9 {
+		t.Errorf("pole = %+v, want altitude φ, azimuth 0", pole)
+	}
+}
+
+func TestSeparation(t *testing.T) {
+	// Arcturus and Spica (Meeus, Ex. 17.a).
+	a := Equatorial{RightAscension: units.Degree(213.9154), Declination: units.Degree(19.1825)}
+	b := Equatorial{RightAscension: units.Degree(201.2983), Declination: units.Degree(-11.1614)}
+	if d := Separation(a, b).ToDegrees(); !almostEqual(d, 32.7930, 1e-5) {
+		t.Errorf("Separation() = %v°, want 32.7930°", d)
+	}
+	small := Equatorial{RightAscension: a.RightAscension, Declination: units.Radian(a.Declination.Val() + units.ArcSecond(1e-3).Val())}
+	if d := Separation(a, small).ToArcseconds(); !almostEqual(d, 1e-3, 1e-6) {
+		t.Errorf("tiny separation = %v\", want 0.001\"", d)
+	}
+}
+
+func TestPrecess(t *testing.T) {
+	// General precession in longitude is about 5029" per century.
+	pollux := Equatorial{RightAscension: units.Degree(116.328942), Declination: units.Degree(28.026183)}
+	later := time.Date(2100, time.January, 1, 12, 0, 0, 0, time.UTC)
+	p := Precess(pollux, J2000, later)
+	l0, l1 := EquatorialToEcliptic(pollux, J2000), EquatorialToEcliptic(p, later)
+	if dl := units.Radian(l1.Longitude.Val() - l0.Longitude.Val()).ToArcseconds(); !almostEqual(dl, 5029, 2e-3) {
+		t.Errorf("precession in longitude = %v\", want ≈ 5029\"", dl)
+	}
+	if db := units.Radian(l1.Latitude.Val() - l0.Latitude.Val()).ToArcseconds(); math.Abs(db) > 50 {
+		t.Errorf("change in latitude = %v\", want < 50\"", db)
+	}
+	if back := Precess(p, later, J2000); Separation(back, pollux).ToArcseconds() > 1e-6 {
+		t.Errorf("precession round trip = %+v", back)
+	}
+	if same := Precess(pollux, J2000, J2000); Separation(same, pollux).ToArcseconds() > 1e-9 {
+		t.Errorf("zero-interval precession = %+v", same)
+	}
+}
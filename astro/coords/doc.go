@@ -0,0 +1,43 @@
+// Package coords converts celestial positions between the equatorial,
+// ecliptic, galactic and horizontal coordinate systems.
+//
+// All angles are units.Angle values. Right ascension and longitudes are
+// returned in [0, 2π) and latitudes and declinations in [-π/2, π/2].
+// Azimuth is measured from north through east. Observer longitude is
+// positive east of Greenwich.
+//
+// Equatorial coordinates refer to the mean equator and equinox of an
+// epoch. Ecliptic conversions use the mean obliquity of the same epoch,
+// and galactic conversions assume J2000 (ICRS) equatorial input. Precess
+// moves a position between epochs with the IAU 1976 precession angles.
+// Horizontal coordinates use mean sidereal time from package astro/time
+// with UT1 ≈ UTC, and ignore nutation, aberration and refraction. That
+// keeps them accurate to a few arcseconds.
+//
+// Example usage:
+//
+//	import (
+//	    "time"
+//
+//	    "github.com/sakiphan/qsim-core/astro/coords"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Galactic center
+//	gc := coords.GalacticToEquatorial(coords.Galactic{}) // α ≈ 17h45.6m, δ ≈ -28.94°
+//
+//	// Where is it from Greenwich tonight?
+//	obs := coords.Observer{Latitude: units.Degree(51.48), Longitude: units.Degree(0)}
+//	h := coords.EquatorialToHorizontal(gc, obs, time.Date(2024, 7, 1, 22, 0, 0, 0, time.UTC))
+//
+//	// Angular distance between Arcturus and Spica ≈ 32.79°
+//	d := coords.Separation(
+//	    coords.Equatorial{RightAscension: units.Degree(213.9154), Declination: units.Degree(19.1825)},
+//	    coords.Equatorial{RightAscension: units.Degree(201.2983), Declination: units.Degree(-11.1614)},
+//	)
+//
+// References:
+//   - Meeus. "Astronomical Algorithms", 2nd ed., Ch. 12, 13, 17, 21, 22
+//   - Reid, Brunthaler. "The Proper Motion of Sagittarius A*",
+//     ApJ 616, 872 (2004), for the J2000 galactic pole
+package coords
@@ -0,0 +1,255 @@
+package cosmology
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// c is the speed of light in m/s.
+var c = constants.SpeedOfLight.Val()
+
+// steps is the number of integration steps used for distance and time
+// integrals; with the u = √a substitution this gives relative accuracy
+// well below 10⁻⁸.
+const steps = 2048
+
+// Parameters describes a FLRW cosmology by its present-day Hubble constant
+// and density parameters.
+type Parameters struct {
+	H0          units.Frequency
+	OmegaM      float64 // matter (baryons and cold dark matter)
+	OmegaLambda float64 // cosmological constant
+	OmegaR      float64 // radiation (photons and relativistic neutrinos)
+	OmegaK      float64 // curvature, 1 - Ω_m - Ω_Λ - Ω_r
+}
+
+// NewParameters returns the cosmology with the given Hubble constant and
+// matter, dark energy and radiation densities, with Ω_k set so the density
+// parameters sum to one.
+func NewParameters(h0 units.Frequency, omegaM, omegaLambda, omegaR float64) Parameters {
+	return Parameters{
+		H0:          h0,
+		OmegaM:      omegaM,
+		OmegaLambda: omegaLambda,
+		OmegaR:      omegaR,
+		OmegaK:      1 - omegaM - omegaLambda - omegaR,
+	}
+}
+
+// FromParams returns the cosmology of a published parameter set, such as
+// constants.CosmologyWMAP9, with matter from baryons, cold dark matter and
+// massive neutrinos and radiation from the CMB temperature and N_eff.
+func FromParams(p constants.CosmologyParams) Parameters {
+	return NewParameters(p.H0, p.OmegaM(), p.OmegaLambda, p.OmegaR())
+}
+
+// Planck2018 is flat ΛCDM with H₀ = 67.66 km/s/Mpc and Ω_m = 0.3111 from
+// Planck 2018, with radiation from T_CMB = 2.7255 K and N_eff = 3.046; it
+// is FromParams(constants.CosmologyPlanck2018).
+var Planck2018 = FromParams(constants.CosmologyPlanck2018)
+
+func (p Parameters) check() error {
+	if !(p.H0.Val() > 0) {
+		return fmt.Errorf("Hubble constant must be positive, got %g s⁻¹", p.H0.Val())
+	}
+	if sum := p.OmegaM + p.OmegaLambda + p.OmegaR + p.OmegaK; math.Abs(sum-1) > 1e-6 {
+		return fmt.Errorf("density parameters must sum to 1, got %g", sum)
+	}
+	if p.OmegaM < 0 || p.OmegaR < 0 {
+		return fmt.Errorf("matter and radiation densities must be non-negative, got Ω_m = %g, Ω_r = %g", p.OmegaM, p.OmegaR)
+	}
+	return nil
+}
+
+// E returns the dimensionless expansion rate H(z)/H₀. It is NaN if the
+// universe has no expansion at redshift z.
+func (p Parameters) E(z float64) float64 {
+	x := 1 + z
+	return math.Sqrt(((p.OmegaR*x+p.OmegaM)*x+p.OmegaK)*x*x + p.OmegaLambda)
+}
+
+// Hubble returns the Hubble parameter H(z) = H₀ E(z).
+func (p Parameters) Hubble(z float64) units.Frequency {
+	return units.Hertz(p.H0.Val() * p.E(z))
+}
+
+// HubbleDistance returns D_H = c/H₀.
+func (p Parameters) HubbleDistance() units.Length {
+	return units.Meter(c / p.H0.Val())
+}
+
+// HubbleTime returns t_H = 1/H₀.
+func (p Parameters) HubbleTime() units.Time {
+	return units.Second(1 / p.H0.Val())
+}
+
+// -----------------------------------------------------------------------------
+// Distances
+// -----------------------------------------------------------------------------
+
+// ComovingDistance returns the line-of-sight comoving distance to redshift z.
+// Returns an error if the parameters are invalid, z < 0, or the expansion
+// history does not reach z.
+//
+// Formula:
+//
+//	D_C = D_H ∫₀^z dz'/E(z')
+func (p Parameters) ComovingDistance(z float64) (units.Length, error) {
+	dc, err := p.comoving(z)
+	if err != nil {
+		return units.Length{}, err
+	}
+	return units.Meter(dc * c / p.H0.Val()), nil
+}
+
+// TransverseComovingDistance returns D_M, the comoving distance between two
+// objects at redshift z separated by unit angle on the sky. It equals D_C in
+// a flat universe. Errors are as for ComovingDistance.
+//
+// Formula:
+//
+//	D_M = D_H sinh(√Ω_k D_C/D_H)/√Ω_k    (Ω_k > 0; sin for Ω_k < 0)
+func (p Parameters) TransverseComovingDistance(z float64) (units.Length, error) {
+	dc, err := p.comoving(z)
+	if err != nil {
+		return units.Length{}, err
+	}
+	return units.Meter(p.transverse(dc) * c / p.H0.Val()), nil
+}
+
+// LuminosityDistance returns D_L = (1 + z) D_M, the distance for which the
+// observed flux is F = L / (4π D_L²). Errors are as for ComovingDistance.
+func (p Parameters) LuminosityDistance(z float64) (units.Length, error) {
+	dm, err := p.TransverseComovingDistance(z)
+	if err != nil {
+		return units.Length{}, err
+	}
+	return units.Meter((1 + z) * dm.Val()), nil
+}
+
+// AngularDiameterDistance returns D_A = D_M / (1 + z), the ratio of an
+// object's proper size to its observed angular size. Errors are as for
+// ComovingDistance.
+func (p Parameters) AngularDiameterDistance(z float64) (units.Length, error) {
+	dm, err := p.TransverseComovingDistance(z)
+	if err != nil {
+		return units.Length{}, err
+	}
+	return units.Meter(dm.Val() / (1 + z)), nil
+}
+
+// comoving returns D_C/D_H. In u = √a the integrand dz/E becomes
+// 2u du / √(Ω_r + Ω_m u² + Ω_k u⁴ + Ω_Λ u⁸).
+func (p Parameters) comoving(z float64) (float64, error) {
+	if err := p.check(); err != nil {
+		return 0, err
+	}
+	if !(z >= 0) {
+		return 0, fmt.Errorf("redshift must be non-negative, got %g", z)
+	}
+	return p.integrate(func(u float64) float64 {
+		return 2 * u / p.aE2(u)
+	}, 1/math.Sqrt(1+z), 1)
+}
+
+// transverse returns D_M/D_H for a line-of-sight distance dc = D_C/D_H.
+func (p Parameters) transverse(dc float64) float64 {
+	switch k := math.Sqrt(math.Abs(p.OmegaK)); {
+	case p.OmegaK > 0:
+		return math.Sinh(k*dc) / k
+	case p.OmegaK < 0:
+		return math.Sin(k*dc) / k
+	default:
+		return dc
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Times
+// -----------------------------------------------------------------------------
+
+// Age returns the age of the universe at redshift z, the cosmic time since
+// the Big Bang. Returns an error if the parameters are invalid, z < 0, the
+// expansion history does not reach z, or the universe has neither matter
+// nor radiation and so no Big Bang.
+//
+// Formula:
+//
+//	t(z) = (1/H₀) ∫_z^∞ dz' / ((1 + z') E(z'))
+func (p Parameters) Age(z float64) (units.Time, error) {
+	if err := p.checkTime(z); err != nil {
+		return units.Time{}, err
+	}
+	if p.OmegaM == 0 && p.OmegaR == 0 {
+		return units.Time{}, fmt.Errorf("a universe without matter or radiation has no Big Bang")
+	}
+	t, err := p.time(0, 1/math.Sqrt(1+z))
+	if err != nil {
+		return units.Time{}, err
+	}
+	return units.Second(t / p.H0.Val()), nil
+}
+
+// LookbackTime returns the cosmic time elapsed between redshift z and today.
+// Errors are as for ComovingDistance.
+//
+// Formula:
+//
+//	t_L(z) = (1/H₀) ∫₀^z dz' / ((1 + z') E(z'))
+func (p Parameters) LookbackTime(z float64) (units.Time, error) {
+	if err := p.checkTime(z); err != nil {
+		return units.Time{}, err
+	}
+	t, err := p.time(1/math.Sqrt(1+z), 1)
+	if err != nil {
+		return units.Time{}, err
+	}
+	return units.Second(t / p.H0.Val()), nil
+}
+
+func (p Parameters) checkTime(z float64) error {
+	if err := p.check(); err != nil {
+		return err
+	}
+	if !(z >= 0) {
+		return fmt.Errorf("redshift must be non-negative, got %g", z)
+	}
+	return nil
+}
+
+// time returns H₀ t between u0 and u1. In u = √a the integrand
+// dz/((1+z)E) becomes 2u³ du / √(Ω_r + Ω_m u² + Ω_k u⁴ + Ω_Λ u⁸).
+func (p Parameters) time(u0, u1 float64) (float64, error) {
+	return p.integrate(func(u float64) float64 {
+		if u == 0 {
+			return 0 // the integrand vanishes at the Big Bang
+		}
+		return 2 * u * u * u / p.aE2(u)
+	}, u0, u1)
+}
+
+// aE2 returns a²E(a) = √(Ω_r + Ω_m a + Ω_k a² + Ω_Λ a⁴) at a = u².
+func (p Parameters) aE2(u float64) float64 {
+	a := u * u
+	return math.Sqrt(((p.OmegaLambda*a*a+p.OmegaK)*a+p.OmegaM)*a + p.OmegaR)
+}
+
+// integrate returns ∫ g(u) du from u0 to u1, or an error if the expansion
+// rate vanishes or turns negative on the interval.
+func (p Parameters) integrate(g func(u float64) float64, u0, u1 float64) (float64, error) {
+	y := []float64{0}
+	f := func(u float64, _, dydt []float64) {
+		dydt[0] = g(u)
+	}
+	if err := solver.Integrate(&solver.RK4{}, f, u0, u1, steps, y); err != nil {
+		return 0, err
+	}
+	if math.IsNaN(y[0]) || math.IsInf(y[0], 0) {
+		return 0, fmt.Errorf("expansion history does not reach redshift %g", 1/(u0*u0)-1)
+	}
+	return y[0], nil
+}
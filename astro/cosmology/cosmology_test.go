@@ -0,0 +1,206 @@
+package cosmology
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+var h70 = units.KilometerPerSecondPerMegaparsec(70)
+
+func TestEinsteinDeSitter(t *testing.T) {
+	// Ω_m = 1: D_C = 2D_H(1 - 1/√(1+z)), t(z) = (2/3H₀)(1+z)^(-3/2).
+	p := NewParameters(h70, 1, 0, 0)
+	dh, th := p.HubbleDistance().Val(), p.HubbleTime().Val()
+	for _, z := range []float64{0.1, 1, 10, 1100} {
+		dc, err := p.ComovingDistance(z)
+		if err != nil || !almostEqual(dc.Val(), 2*dh*(1-1/math.Sqrt(1+z)), 1e-10) {
+			t.Errorf("D_C(%v) = %v, %v", z, dc, err)
+		}
+		age, err := p.Age(z)
+		if err != nil || !almostEqual(age.Val(), 2*th/3*math.Pow(1+z, -1.5), 1e-10) {
+			t.Errorf("t(%v) = %v, %v", z, age, err)
+		}
+	}
+	if tl, _ := p.LookbackTime(1); !almostEqual(tl.Val(), 2*th/3*(1-math.Pow(2, -1.5)), 1e-10) {
+		t.Errorf("t_L(1) = %v", tl)
+	}
+}
+
+func TestCurvedUniverses(t *testing.T) {
+	// Empty (Milne) universe, Ω_k = 1: D_L = D_H z(1 + z/2).
+	milne := NewParameters(h70, 0, 0, 0)
+	if milne.OmegaK != 1 {
+		t.Fatalf("Ω_k = %v, want 1", milne.OmegaK)
+	}
+	dh := milne.HubbleDistance().Val()
+	for _, z := range []float64{0.5, 3} {
+		dl, err := milne.LuminosityDistance(z)
+		if err != nil || !almostEqual(dl.Val(), dh*z*(1+z/2), 1e-10) {
+			t.Errorf("Milne D_L(%v) = %v, %v", z, dl, err)
+		}
+	}
+	if tl, _ := milne.LookbackTime(1); !almostEqual(tl.Val(), milne.HubbleTime().Val()/2, 1e-10) {
+		t.Errorf("Milne t_L(1) = %v, want t_H/2", tl)
+	}
+	if _, err := milne.Age(0); err == nil {
+		t.Error("Age should reject a universe with no Big Bang")
+	}
+
+	// Matter-only universes follow Mattig's relation
+	// D_M = 2D_H[Ω z + (Ω-2)(√(1+Ωz) - 1)] / (Ω²(1+z)), which for the closed
+	// Ω = 2 case reduces to D_H z/(1+z).
+	closed := NewParameters(h70, 2, 0, 0)
+	z := 2.0
+	want := dh * z / (1 + z)
+	if dm, err := closed.TransverseComovingDistance(z); err != nil || !almostEqual(dm.Val(), want, 1e-10) {
+		t.Errorf("closed D_M = %v, %v; want %v", dm, err, want)
+	}
+	open := NewParameters(h70, 0.3, 0, 0)
+	om := 0.3
+	want = 2 * dh * (om*z + (om-2)*(math.Sqrt(1+om*z)-1)) / (om * om * (1 + z))
+	if dm, _ := open.TransverseComovingDistance(z); !almostEqual(dm.Val(), want, 1e-10) {
+		t.Errorf("open D_M = %v, want %v", dm, want)
+	}
+}
+
+func TestPlanck2018(t *testing.T) {
+	p := Planck2018
+	if sum := p.OmegaM + p.OmegaLambda + p.OmegaR + p.OmegaK; !almostEqual(sum, 1, 1e-15) || math.Abs(p.OmegaK) > 1e-15 {
+		t.Errorf("ΣΩ = %v, Ω_k = %v", sum, p.OmegaK)
+	}
+	age, err := p.Age(0)
+	if err != nil || !almostEqual(age.Val()/units.Year(1e9).Val(), 13.79, 2e-3) {
+		t.Errorf("age = %v Gyr, %v; want ≈ 13.79", age.Val()/units.Year(1e9).Val(), err)
+	}
+	dc, _ := p.ComovingDistance(1)
+	if !almostEqual(dc.Val()/units.Megaparsec(1).Val(), 3396, 2e-3) {
+		t.Errorf("D_C(1) = %v Mpc, want ≈ 3396", dc.Val()/units.Megaparsec(1).Val())
+	}
+	dl, _ := p.LuminosityDistance(1)
+	da, _ := p.AngularDiameterDistance(1)
+	if !almostEqual(dl.Val(), 4*da.Val(), 1e-12) || !almostEqual(dl.Val(), 2*dc.Val(), 1e-12) {
+		t.Errorf("distance duality violated: D_L = %v, D_A = %v", dl, da)
+	}
+	// Lookback time and age at z sum to the present age.
+	tl, _ := p.LookbackTime(1)
+	a1, _ := p.Age(1)
+	if !almostEqual(tl.Val()+a1.Val(), age.Val(), 1e-10) {
+		t.Errorf("t_L + t(z) = %v, want %v", tl.Val()+a1.Val(), age.Val())
+	}
+	// Recombination happened about 370 kyr after the Big Bang.
+	if rec, _ := p.Age(1090); !almostEqual(rec.Val()/units.Year(1).Val(), 3.7e5, 0.03) {
+		t.Errorf("t(1090) = %v yr, want ≈ 370 kyr", rec.Val()/units.Year(1).Val())
+	}
+	if h := p.Hubble(0); h != p.H0 {
+		t.Errorf("H(0) = %v, want H₀", h)
+	}
+	// Low-redshift Hubble law: D ≈ cz/H₀.
+	if d, _ := p.ComovingDistance(0.001); !almostEqual(d.Val(), 0.001*p.HubbleDistance().Val(), 1e-3) {
+		t.Errorf("D_C(0.001) = %v", d)
+	}
+}
+
+func TestFromParams(t *testing.T) {
+	// A higher H₀ at fixed Ω shortens every time scale by the same factor.
+	planck := FromParams(constants.CosmologyPlanck2018)
+	sh0es := FromParams(constants.CosmologySH0ES)
+	a0, _ := planck.Age(0)
+	a1, err := sh0es.Age(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(a1.Val()/a0.Val(), 67.66/73.04, 1e-4) {
+		t.Errorf("SH0ES age = %v Gyr, Planck %v Gyr", a1.Val()/units.Year(1e9).Val(), a0.Val()/units.Year(1e9).Val())
+	}
+	wmap := FromParams(constants.CosmologyWMAP9)
+	if age, _ := wmap.Age(0); !almostEqual(age.Val()/units.Year(1e9).Val(), 13.77, 3e-3) {
+		t.Errorf("WMAP9 age = %v Gyr, want ≈ 13.77", age.Val()/units.Year(1e9).Val())
+	}
+	if math.Abs(wmap.OmegaK) > 1e-15 {
+		t.Errorf("WMAP9 Ω_k = %v, want flat", wmap.OmegaK)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := Planck2018.ComovingDistance(-0.5); err == nil {
+		t.Error("ComovingDistance should reject negative redshift")
+	}
+	bad := Planck2018
+	bad.OmegaM = 0.5
+	if _, err := bad.Age(0); err == nil {
+		t.Error("Age should reject parameters that do not sum to 1")
+	}
+	if _, err := NewParameters(units.Hertz(0), 1, 0, 0).LookbackTime(1); err == nil {
+		t.Error("LookbackTime should reject zero H₀")
+	}
+	// A strongly closed Λ universe bounces and never reached high redshift.
+	bounce := NewParameters(h70, 0.05, 2.5, 0)
+	if _, err := bounce.ComovingDistance(10); err == nil {
+		t.Error("ComovingDistance should reject a redshift beyond the bounce")
+	}
+}
+
+func TestEvolve(t *testing.T) {
+	// Einstein-de Sitter: a = (t/t₀)^(2/3), H = 2/(3t).
+	eds := NewParameters(h70, 1, 0, 0)
+	t0 := 2 / (3 * h70.Val())
+	samples, err := eds.Evolve(0.01, units.Second(1.5*t0), 300)
+	if err != nil || len(samples) != 301 {
+		t.Fatalf("Evolve() = %d samples, %v", len(samples), err)
+	}
+	for _, s := range samples {
+		tt := s.Time.Val()
+		if !almostEqual(s.ScaleFactor, math.Pow(tt/t0, 2.0/3), 1e-6) || !almostEqual(s.Hubble.Val(), 2/(3*tt), 1e-6) {
+			t.Fatalf("t = %v: a = %v, H = %v", tt, s.ScaleFactor, s.Hubble)
+		}
+	}
+
+	// Closed Ω_m = 2 universe: a_max = Ω/(Ω-1) = 2 and the Big Crunch comes
+	// at t = πΩ/(H₀(Ω-1)^(3/2)) = 2π/H₀.
+	closed := NewParameters(h70, 2, 0, 0)
+	crunch := 2 * math.Pi / h70.Val()
+	samples, err = closed.Evolve(0.01, units.Second(1.2*crunch), 2000)
+	if err != nil {
+		t.Fatalf("Evolve() error = %v", err)
+	}
+	amax, last := 0.0, samples[len(samples)-1]
+	for _, s := range samples {
+		amax = math.Max(amax, s.ScaleFactor)
+	}
+	if !almostEqual(amax, 2, 1e-4) {
+		t.Errorf("a_max = %v, want 2", amax)
+	}
+	if last.Time.Val() > crunch || last.Time.Val() < 0.99*crunch || last.Hubble.Val() >= 0 {
+		t.Errorf("last sample t = %v (crunch %v), H = %v", last.Time.Val(), crunch, last.Hubble)
+	}
+
+	// Planck 2018 reaches a = 1 at the present age, with H = H₀.
+	p := Planck2018
+	age, _ := p.Age(0)
+	start, _ := p.Age(1090)
+	samples, err = p.Evolve(1.0/1091, units.Second(age.Val()-start.Val()), 500)
+	if err != nil {
+		t.Fatalf("Evolve() error = %v", err)
+	}
+	now := samples[len(samples)-1]
+	if !almostEqual(now.Time.Val(), age.Val(), 1e-10) || !almostEqual(now.ScaleFactor, 1, 1e-6) || !almostEqual(now.Hubble.Val(), p.H0.Val(), 1e-5) {
+		t.Errorf("today: t = %v, a = %v, H = %v", now.Time, now.ScaleFactor, now.Hubble)
+	}
+
+	if _, err := NewParameters(h70, 0, 1, 0).Evolve(1, units.Year(1e9), 10); err == nil {
+		t.Error("Evolve should reject a model without a Big Bang")
+	}
+	if _, err := p.Evolve(0, units.Year(1e9), 10); err == nil {
+		t.Error("Evolve should reject a zero start scale factor")
+	}
+	if _, err := NewParameters(h70, 0.05, 2.5, 0).Evolve(0.5, units.Year(1e9), 10); err == nil {
+		t.Error("Evolve should reject a scale factor beyond the bounce")
+	}
+}
@@ -0,0 +1,48 @@
+// Package cosmology computes distances and times in a homogeneous,
+// isotropic (Friedmann-Lemaître-Robertson-Walker) universe.
+//
+// A Parameters value holds the Hubble constant H₀ and the present-day
+// density parameters of matter, dark energy (a cosmological constant),
+// radiation and curvature, which sum to one. Planck2018 is the default
+// flat ΛCDM cosmology; FromParams converts the published parameter sets of
+// the constants package, such as WMAP9 or the SH0ES Hubble constant. The
+// expansion rate at redshift z is
+//
+//	H(z) = H₀ E(z),   E(z) = √(Ω_r(1+z)⁴ + Ω_m(1+z)³ + Ω_k(1+z)² + Ω_Λ)
+//
+// Distances and times are integrals over 1/E evaluated numerically with
+// the solver package. The integrals are taken in u = √a, a = 1/(1+z), which
+// keeps the integrands smooth up to the Big Bang, so the age of the
+// universe and distances to arbitrarily high redshift are accurate.
+//
+// Evolve integrates the Friedmann acceleration equation in time and
+// returns sampled (t, a, H) trajectories. It follows closed models through
+// turnaround and recollapse.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/astro/cosmology"
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	p := cosmology.Planck2018
+//	age, _ := p.Age(0)                    // ≈ 13.79 Gyr
+//	dl, _ := p.LuminosityDistance(1)      // ≈ 6.8 Gpc
+//	da, _ := p.AngularDiameterDistance(1) // ≈ 1.7 Gpc
+//	tl, _ := p.LookbackTime(1)            // ≈ 7.9 Gyr
+//
+//	// The same, with the distance-ladder H₀
+//	sh0es := cosmology.FromParams(constants.CosmologySH0ES)
+//	age, _ = sh0es.Age(0) // ≈ 12.8 Gyr
+//
+//	// a(t) and H(t) from a = 10⁻³ for 20 Gyr
+//	samples, _ := p.Evolve(1e-3, units.Year(20e9), 400)
+//
+// References:
+//   - Hogg. "Distance measures in cosmology", arXiv:astro-ph/9905116
+//   - Planck Collaboration. "Planck 2018 results. VI. Cosmological
+//     parameters", A&A 641, A6 (2020), Table 2 (TT,TE,EE+lowE+lensing+BAO)
+//   - Ryden. "Introduction to Cosmology", 2nd ed., Ch. 5-6
+package cosmology
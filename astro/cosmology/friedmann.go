@@ -0,0 +1,103 @@
+package cosmology
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Sample is the state of the expansion at one cosmic time.
+type Sample struct {
+	Time        units.Time      // time since the Big Bang
+	ScaleFactor float64         // a, equal to 1 today
+	Hubble      units.Frequency // H = ȧ/a, negative while contracting
+}
+
+// maxSubsteps bounds the work per output interval.
+const maxSubsteps = 1 << 16
+
+// Evolve integrates the Friedmann equations forward from the moment the
+// scale factor equals aStart on the expanding branch, returning n+1 samples
+// equally spaced over span. Unlike the distance integrals it follows the
+// expansion through a turnaround into recollapse; if the universe ends in
+// a Big Crunch within span, the samples stop at the last one before it.
+// Returns an error if the parameters are invalid, aStart or span is not
+// positive, n < 1, the model has no Big Bang, or the expansion history does
+// not reach aStart.
+//
+// The acceleration equation is integrated in τ = H₀t:
+//
+//	d²a/dτ² = -Ω_r/a³ - Ω_m/(2a²) + Ω_Λ a
+//
+// starting from da/dτ = a E(a). Each output interval is split into RK4
+// substeps no longer than 1% of the local expansion time.
+//
+// Example:
+//
+//	// Planck 2018 from recombination to 20 Gyr
+//	samples, _ := cosmology.Planck2018.Evolve(1.0/1091, units.Year(20e9), 200)
+func (p Parameters) Evolve(aStart float64, span units.Time, n int) ([]Sample, error) {
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	if !(aStart > 0) || !(span.Val() > 0) {
+		return nil, fmt.Errorf("start scale factor and span must be positive, got %g and %g s", aStart, span.Val())
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("number of samples must be positive, got %d", n)
+	}
+	if p.OmegaM == 0 && p.OmegaR == 0 {
+		return nil, fmt.Errorf("a universe without matter or radiation has no Big Bang")
+	}
+	tau0, err := p.time(0, math.Sqrt(aStart))
+	if err != nil {
+		return nil, err
+	}
+	adot := aStart * p.E(1/aStart-1)
+	if math.IsNaN(adot) {
+		return nil, fmt.Errorf("expansion history does not reach a = %g", aStart)
+	}
+
+	h0 := p.H0.Val()
+	f := func(_ float64, y, dydt []float64) {
+		a := y[0]
+		dydt[0] = y[1]
+		dydt[1] = -p.OmegaR/(a*a*a) - p.OmegaM/(2*a*a) + p.OmegaLambda*a
+	}
+	sample := func(tau float64, y []float64) Sample {
+		return Sample{Time: units.Second(tau / h0), ScaleFactor: y[0], Hubble: units.Hertz(h0 * y[1] / y[0])}
+	}
+
+	y := []float64{aStart, adot}
+	dtau := span.Val() * h0 / float64(n)
+	rk := &solver.RK4{}
+	out := []Sample{sample(tau0, y)}
+	for i := 0; i < n; i++ {
+		tau := tau0 + float64(i)*dtau
+		sub := p.substeps(y, dtau)
+		h := dtau / float64(sub)
+		for j := 0; j < sub; j++ {
+			rk.Step(f, tau+float64(j)*h, h, y)
+			if !(y[0] > 0) {
+				return out, nil // Big Crunch
+			}
+		}
+		out = append(out, sample(tau+dtau, y))
+	}
+	return out, nil
+}
+
+// substeps returns the number of RK4 steps needed to cross an interval of
+// dtau with steps of at most 1% of the expansion time min(a/|ȧ|, √(a/|ä|)).
+func (p Parameters) substeps(y []float64, dtau float64) int {
+	a := y[0]
+	accel := -p.OmegaR/(a*a*a) - p.OmegaM/(2*a*a) + p.OmegaLambda*a
+	scale := math.Min(a/math.Abs(y[1]), math.Sqrt(a/math.Abs(accel)))
+	n := math.Ceil(dtau / (0.01 * scale))
+	if !(n >= 1) {
+		return 1
+	}
+	return int(math.Min(n, maxSubsteps))
+}
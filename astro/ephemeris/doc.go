@@ -0,0 +1,38 @@
+// Package ephemeris computes low-precision heliocentric positions and
+// velocities of the major planets from mean Keplerian elements with linear
+// secular rates.
+//
+// The elements are Standish's fit to the JPL DE405 ephemeris for
+// 1800–2050, referred to the mean ecliptic and equinox of J2000. Inside
+// that interval the positions are good to better than an arcminute for
+// the terrestrial planets and to several arcminutes for the giant
+// planets. Accuracy degrades slowly outside it. Earth is
+// represented by the Earth–Moon barycenter. Time arguments are taken as
+// TDB ≈ UTC.
+//
+// Elements returns orbit.Elements for a date, so positions can be
+// propagated or transformed with the orbit package. State returns
+// position and velocity vectors in meters and meters per second, suitable
+// as initial conditions for a solar-system N-body integration. Velocities
+// follow from two-body motion about the Sun. ToEquatorial rotates vectors
+// into the J2000 equatorial (ICRF-aligned) frame.
+//
+// Example usage:
+//
+//	import (
+//	    "time"
+//
+//	    "github.com/sakiphan/qsim-core/astro/ephemeris"
+//	)
+//
+//	t := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+//	for _, p := range ephemeris.Planets {
+//	    r, v, _ := ephemeris.State(p, t)
+//	    // r in m, v in m/s, heliocentric ecliptic J2000
+//	}
+//
+// References:
+//   - Standish, Williams. "Approximate Positions of the Planets", JPL
+//     Solar System Dynamics, Table 1 (https://ssd.jpl.nasa.gov/planets/approx_pos.html)
+//   - Meeus. "Astronomical Algorithms", 2nd ed., Ch. 31-33
+package ephemeris
@@ -0,0 +1,153 @@
+package ephemeris
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/sakiphan/qsim-core/astro/orbit"
+	atime "github.com/sakiphan/qsim-core/astro/time"
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Planet identifies a major planet.
+type Planet int
+
+const (
+	Mercury Planet = iota
+	Venus
+	EarthMoon // Earth–Moon barycenter
+	Mars
+	Jupiter
+	Saturn
+	Uranus
+	Neptune
+)
+
+// Planets lists all planets in order of distance from the Sun.
+var Planets = []Planet{Mercury, Venus, EarthMoon, Mars, Jupiter, Saturn, Uranus, Neptune}
+
+var planetNames = [...]string{"Mercury", "Venus", "Earth-Moon", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
+
+// String returns the planet's name.
+func (p Planet) String() string {
+	if p < 0 || int(p) >= len(planetNames) {
+		return fmt.Sprintf("Planet(%d)", int(p))
+	}
+	return planetNames[p]
+}
+
+// meanElements holds J2000 values and rates per Julian century of the
+// semi-major axis (au), eccentricity, inclination, mean longitude,
+// longitude of perihelion and longitude of the ascending node (degrees).
+type meanElements struct {
+	a, e, i, L, peri, node                   float64
+	aDot, eDot, iDot, LDot, periDot, nodeDot float64
+}
+
+// standish is Table 1 of Standish's approximate planetary positions,
+// valid 1800–2050.
+var standish = [...]meanElements{
+	Mercury: {0.38709927, 0.20563593, 7.00497902, 252.25032350, 77.45779628, 48.33076593,
+		0.00000037, 0.00001906, -0.00594749, 149472.67411175, 0.16047689, -0.12534081},
+	Venus: {0.72333566, 0.00677672, 3.39467605, 181.97909950, 131.60246718, 76.67984255,
+		0.00000390, -0.00004107, -0.00078890, 58517.81538729, 0.00268329, -0.27769418},
+	EarthMoon: {1.00000261, 0.01671123, -0.00001531, 100.46457166, 102.93768193, 0.0,
+		0.00000562, -0.00004392, -0.01294668, 35999.37244981, 0.32327364, 0.0},
+	Mars: {1.52371034, 0.09339410, 1.84969142, -4.55343205, -23.94362959, 49.55953891,
+		0.00001847, 0.00007882, -0.00813131, 19140.30268499, 0.44441088, -0.29257343},
+	Jupiter: {5.20288700, 0.04838624, 1.30439695, 34.39644051, 14.72847983, 100.47390909,
+		-0.00011607, -0.00013253, -0.00183714, 3034.74612775, 0.21252668, 0.20469106},
+	Saturn: {9.53667594, 0.05386179, 2.48599187, 49.95424423, 92.59887831, 113.66242448,
+		-0.00125060, -0.00050991, 0.00193609, 1222.49362201, -0.41897216, -0.28867794},
+	Uranus: {19.18916464, 0.04725744, 0.77263783, 313.23810451, 170.95427630, 74.01692503,
+		-0.00196176, -0.00004397, -0.00242939, 428.48202785, 0.40805281, 0.04240589},
+	Neptune: {30.06992276, 0.00859048, 1.77004347, -55.12002969, 44.96476227, 131.78422574,
+		0.00026291, 0.00005105, 0.00035372, 218.45945325, -0.32241464, -0.01262724},
+}
+
+// j2000Obliquity is the obliquity of the ecliptic at J2000 (84381.448″).
+var j2000Obliquity = units.ArcSecond(84381.448).Val()
+
+// Elements returns the mean heliocentric orbital elements of a planet at
+// time t, referred to the ecliptic and equinox of J2000. The node and
+// argument of perihelion follow from the longitudes as Ω and ω = ϖ - Ω,
+// and the true anomaly from the mean anomaly M = L - ϖ.
+//
+// Returns an error for an unknown planet.
+func Elements(p Planet, t time.Time) (orbit.Elements, error) {
+	if p < 0 || int(p) >= len(standish) {
+		return orbit.Elements{}, fmt.Errorf("unknown planet %d", int(p))
+	}
+	m := standish[p]
+	T := atime.JulianCenturies(t)
+	deg := func(x, rate float64) float64 { return units.Degree(x + rate*T).Val() }
+
+	e := m.e + m.eDot*T
+	inc, node, peri := deg(m.i, m.iDot), deg(m.node, m.nodeDot), deg(m.peri, m.periDot)
+	if inc < 0 {
+		// Flip a slightly negative inclination by moving the node by π.
+		inc, node = -inc, node+math.Pi
+	}
+	nu, err := orbit.MeanToTrueAnomaly(wrap(deg(m.L, m.LDot)-peri), e)
+	if err != nil {
+		return orbit.Elements{}, err
+	}
+	return orbit.Elements{
+		SemiMajorAxis:       units.Meter((m.a + m.aDot*T) * constants.AstronomicalUnit.Val()),
+		Eccentricity:        e,
+		Inclination:         inc,
+		RAAN:                wrap(node),
+		ArgumentOfPeriapsis: wrap(peri - node),
+		TrueAnomaly:         nu,
+	}, nil
+}
+
+// State returns the heliocentric position and velocity of a planet at time
+// t in the J2000 ecliptic frame.
+//
+// Example:
+//
+//	r, v, _ := ephemeris.State(ephemeris.EarthMoon, time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC))
+//	// r ≈ (-0.177, 0.967, 0) au, |v| ≈ 30.3 km/s
+func State(p Planet, t time.Time) (r, v vector.Vector3, err error) {
+	el, err := Elements(p, t)
+	if err != nil {
+		return vector.Vector3{}, vector.Vector3{}, err
+	}
+	return el.StateVectorMu(constants.SolarMassParameter)
+}
+
+// Position returns the heliocentric position of a planet at time t in the
+// J2000 ecliptic frame.
+func Position(p Planet, t time.Time) (vector.Vector3, error) {
+	r, _, err := State(p, t)
+	return r, err
+}
+
+// ToEquatorial rotates a vector from the J2000 ecliptic frame to the J2000
+// equatorial frame, a rotation about the x axis by the obliquity ε₀.
+//
+// Formula:
+//
+//	x' = x,  y' = y cos ε₀ - z sin ε₀,  z' = y sin ε₀ + z cos ε₀
+func ToEquatorial(v vector.Vector3) vector.Vector3 {
+	c, s := math.Cos(j2000Obliquity), math.Sin(j2000Obliquity)
+	y, z := v.Y.Val(), v.Z.Val()
+	return vector.Vector3{
+		X: v.X,
+		Y: units.NewValue(y*c-z*s, v.Y.Dim()),
+		Z: units.NewValue(y*s+z*c, v.Z.Dim()),
+	}
+}
+
+// wrap maps an angle onto [0, 2π).
+func wrap(theta float64) float64 {
+	theta = math.Mod(theta, 2*math.Pi)
+	if theta < 0 {
+		theta += 2 * math.Pi
+	}
+	return theta
+}
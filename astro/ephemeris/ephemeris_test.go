@@ -0,0 +1,102 @@
+package ephemeris
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+var j2000 = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+// au returns the components of a position vector in astronomical units.
+func au(r vector.Vector3) [3]float64 {
+	a := r.ToArray()
+	k := 1 / constants.AstronomicalUnit.Val()
+	return [3]float64{a[0] * k, a[1] * k, a[2] * k}
+}
+
+func TestPositionsAtJ2000(t *testing.T) {
+	// DE405 heliocentric ecliptic J2000 positions at 2000-01-01 12:00 TDB.
+	tests := []struct {
+		p    Planet
+		want [3]float64
+		tol  float64 // au
+	}{
+		{EarthMoon, [3]float64{-0.17713, 0.96724, -0.00000}, 2e-4},
+		{Mars, [3]float64{1.39072, -0.01342, -0.03447}, 5e-4},
+		{Jupiter, [3]float64{4.00135, 2.93870, -0.10178}, 1e-2}, // ≈ 400″ at 5 au
+	}
+	for _, tt := range tests {
+		r, err := Position(tt.p, j2000)
+		if err != nil {
+			t.Fatalf("Position(%v) error = %v", tt.p, err)
+		}
+		got := au(r)
+		for k := range got {
+			if math.Abs(got[k]-tt.want[k]) > tt.tol {
+				t.Errorf("%v position = %v au, want %v au", tt.p, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestState(t *testing.T) {
+	for _, p := range Planets {
+		el, err := Elements(p, j2000)
+		if err != nil || el.Inclination < 0 || el.Eccentricity >= 1 {
+			t.Errorf("Elements(%v) = %+v, %v", p, el, err)
+		}
+	}
+	// Earth moves at ≈ 30.3 km/s in early January, near perihelion.
+	_, v, _ := State(EarthMoon, j2000)
+	speed := math.Sqrt(v.MagnitudeSquared().Val())
+	if !almostEqual(speed, 30.29e3, 2e-3) {
+		t.Errorf("Earth speed = %v m/s, want ≈ 30.29 km/s", speed)
+	}
+	// The velocity matches the finite-difference rate of the position.
+	r0, _ := Position(Mars, j2000.Add(-time.Hour))
+	r1, _ := Position(Mars, j2000.Add(time.Hour))
+	_, vm, _ := State(Mars, j2000)
+	a0, a1, va := r0.ToArray(), r1.ToArray(), vm.ToArray()
+	for k := range va {
+		if d := (a1[k] - a0[k]) / 7200; math.Abs(d-va[k]) > 5 {
+			t.Errorf("Mars v[%d] = %v m/s, finite difference %v m/s", k, va[k], d)
+		}
+	}
+	if _, _, err := State(Planet(42), j2000); err == nil {
+		t.Error("State should reject an unknown planet")
+	}
+}
+
+func TestJupiterPeriod(t *testing.T) {
+	// After one sidereal period Jupiter returns close to its start.
+	r0, _ := Position(Jupiter, j2000)
+	r1, _ := Position(Jupiter, j2000.Add(time.Duration(4332.59*24*float64(time.Hour))))
+	a, b := au(r0), au(r1)
+	if d := math.Hypot(math.Hypot(a[0]-b[0], a[1]-b[1]), a[2]-b[2]); d > 0.01 {
+		t.Errorf("Jupiter displacement after one period = %v au", d)
+	}
+}
+
+func TestToEquatorial(t *testing.T) {
+	r, _ := Position(EarthMoon, j2000)
+	eq := ToEquatorial(r)
+	if !almostEqual(eq.MagnitudeSquared().Val(), r.MagnitudeSquared().Val(), 1e-14) || eq.Dim() != r.Dim() {
+		t.Error("ToEquatorial should preserve length and dimension")
+	}
+	// The EMB lies near the ecliptic, so its equatorial z ≈ y sin ε.
+	if e := au(eq); !almostEqual(e[2], 0.96724*math.Sin(j2000Obliquity), 1e-4) {
+		t.Errorf("equatorial z = %v au, want ≈ 0.3847 au", e[2])
+	}
+	if Mars.String() != "Mars" || Planet(-1).String() != "Planet(-1)" {
+		t.Error("Planet.String() mismatch")
+	}
+}
@@ -0,0 +1,44 @@
+// Package orbit provides two-body (Keplerian) orbital mechanics with
+// unit-safe inputs.
+//
+// It converts between Cartesian state vectors (position r, velocity v) and
+// the six classical orbital elements (a, e, i, Ω, ω, ν), solves Kepler's
+// equation for elliptic and hyperbolic orbits, and propagates orbits
+// analytically in time. Tidal helpers give the Roche limit, Hill sphere
+// and sphere of influence of a secondary body and the tidal acceleration
+// across it.
+//
+// Each function taking the mass of the central body has a Mu variant
+// taking its gravitational parameter μ = GM instead. μ is measured far
+// more precisely than G, so the Mu variants with constants.SolarMassParameter,
+// constants.EarthMassParameter or planets.Body.GM avoid the 2 × 10⁻⁵
+// uncertainty of G.
+//
+// Angles are expressed in radians. Position vectors must have dimension [L]
+// and velocity vectors dimension [LT⁻¹].
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/astro/orbit"
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	r := vector.NewPosition(units.Kilometer(7000), units.Meter(0), units.Meter(0))
+//	v := vector.NewVelocity(units.MeterPerSecond(0), units.MeterPerSecond(7546), units.MeterPerSecond(0))
+//
+//	el, _ := orbit.ElementsFromState(r, v, constants.EarthMass)
+//	period, _ := orbit.Period(el.SemiMajorAxis, constants.EarthMass)
+//
+//	// Advance the orbit by 30 minutes
+//	later, _ := orbit.Propagate(el, constants.EarthMass, units.Minute(30))
+//
+//	// The same with the geocentric gravitational constant
+//	el, _ = orbit.ElementsFromStateMu(r, v, constants.EarthMassParameter)
+//
+// References:
+//   - Vallado, D. A. "Fundamentals of Astrodynamics and Applications", 4th ed., Ch. 2
+//   - Curtis, H. D. "Orbital Mechanics for Engineering Students", 3rd ed., Ch. 3-4
+package orbit
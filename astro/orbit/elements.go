@@ -0,0 +1,306 @@
+package orbit
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// singularityTolerance is the threshold below which an orbit is treated as
+// circular (e ≈ 0) or equatorial (i ≈ 0 or π) when resolving angles that are
+// otherwise undefined.
+const singularityTolerance = 1e-11
+
+// Elements holds the six classical orbital elements of a two-body orbit.
+//
+// For elliptic orbits (0 ≤ e < 1) the semi-major axis is positive; for
+// hyperbolic orbits (e > 1) it is negative, following the convention
+// a = -μ/(2ε) where ε is the specific orbital energy.
+//
+// Singular cases are resolved as follows:
+//   - Circular inclined orbits: ω = 0 and ν is the argument of latitude.
+//   - Elliptic equatorial orbits: Ω = 0 and ω is the longitude of periapsis.
+//   - Circular equatorial orbits: Ω = ω = 0 and ν is the true longitude.
+type Elements struct {
+	SemiMajorAxis       units.Length // a
+	Eccentricity        float64      // e (dimensionless)
+	Inclination         float64      // i (rad), in [0, π]
+	RAAN                float64      // Ω, right ascension of the ascending node (rad), in [0, 2π)
+	ArgumentOfPeriapsis float64      // ω (rad), in [0, 2π)
+	TrueAnomaly         float64      // ν (rad), in [0, 2π)
+}
+
+// SemiLatusRectum returns the semi-latus rectum p = a(1 - e²).
+func (el Elements) SemiLatusRectum() units.Length {
+	a := el.SemiMajorAxis.Val()
+	return units.Meter(a * (1 - el.Eccentricity*el.Eccentricity))
+}
+
+// Periapsis returns the periapsis distance r_p = a(1 - e).
+func (el Elements) Periapsis() units.Length {
+	return units.Meter(el.SemiMajorAxis.Val() * (1 - el.Eccentricity))
+}
+
+// Apoapsis returns the apoapsis distance r_a = a(1 + e).
+// Returns an error for open (parabolic or hyperbolic) orbits, which have no apoapsis.
+func (el Elements) Apoapsis() (units.Length, error) {
+	if el.Eccentricity >= 1 {
+		return units.Length{}, fmt.Errorf("open orbit (e = %g) has no apoapsis", el.Eccentricity)
+	}
+	return units.Meter(el.SemiMajorAxis.Val() * (1 + el.Eccentricity)), nil
+}
+
+// ElementsFromState computes the classical orbital elements from a position
+// and velocity vector relative to a central body of the given mass.
+//
+// Formula:
+//
+//	h = r × v,  n = ẑ × h
+//	e = ((v² - μ/r) r - (r·v) v) / μ
+//	a = -μ / (2ε),  ε = v²/2 - μ/r
+//
+// Returns an error if the vectors have the wrong dimensions, if the state is
+// degenerate (rectilinear motion), or if the orbit is parabolic.
+//
+// References:
+//   - Vallado, "Fundamentals of Astrodynamics and Applications", 4th ed., Algorithm 9 (RV2COE)
+func ElementsFromState(r, v vector.Vector3, central units.Mass) (Elements, error) {
+	return elementsFromState(r, v, gravitationalParameter(central))
+}
+
+// ElementsFromStateMu is ElementsFromState for a central body given by its
+// gravitational parameter μ, such as constants.EarthMassParameter.
+func ElementsFromStateMu(r, v vector.Vector3, mu units.StandardGravitationalParameter) (Elements, error) {
+	return elementsFromState(r, v, mu.Val())
+}
+
+func elementsFromState(r, v vector.Vector3, mu float64) (Elements, error) {
+	if err := checkState(r, v); err != nil {
+		return Elements{}, err
+	}
+	if mu <= 0 {
+		return Elements{}, fmt.Errorf("gravitational parameter must be positive, got %g", mu)
+	}
+
+	rv := r.ToArray()
+	vv := v.ToArray()
+
+	rMag := norm(rv)
+	vMag := norm(vv)
+	if rMag == 0 {
+		return Elements{}, fmt.Errorf("position vector must be non-zero")
+	}
+
+	h := cross(rv, vv)
+	hMag := norm(h)
+	if hMag == 0 {
+		return Elements{}, fmt.Errorf("rectilinear state (r ∥ v) has no defined orbital plane")
+	}
+
+	n := [3]float64{-h[1], h[0], 0}
+	nMag := norm(n)
+
+	rDotV := dot(rv, vv)
+	var ev [3]float64
+	for k := 0; k < 3; k++ {
+		ev[k] = ((vMag*vMag-mu/rMag)*rv[k] - rDotV*vv[k]) / mu
+	}
+	e := norm(ev)
+
+	energy := vMag*vMag/2 - mu/rMag
+	if math.Abs(e-1) < singularityTolerance || energy == 0 {
+		return Elements{}, fmt.Errorf("parabolic orbits (e = 1) are not supported")
+	}
+	a := -mu / (2 * energy)
+
+	inc := math.Acos(clamp(h[2]/hMag, -1, 1))
+
+	circular := e < singularityTolerance
+	equatorial := nMag < singularityTolerance*hMag
+
+	var raan, argp, nu float64
+	switch {
+	case !circular && !equatorial:
+		raan = math.Atan2(n[1], n[0])
+		argp = angleBetween(n, ev, h)
+		nu = angleBetween(ev, rv, h)
+	case circular && !equatorial:
+		// Argument of latitude measured from the ascending node.
+		raan = math.Atan2(n[1], n[0])
+		nu = angleBetween(n, rv, h)
+	case !circular && equatorial:
+		// Longitude of periapsis measured from the x axis.
+		argp = math.Atan2(ev[1], ev[0])
+		if h[2] < 0 {
+			argp = -argp
+		}
+		nu = angleBetween(ev, rv, h)
+	default:
+		// True longitude measured from the x axis.
+		nu = math.Atan2(rv[1], rv[0])
+		if h[2] < 0 {
+			nu = -nu
+		}
+	}
+
+	return Elements{
+		SemiMajorAxis:       units.Meter(a),
+		Eccentricity:        e,
+		Inclination:         inc,
+		RAAN:                wrapAngle(raan),
+		ArgumentOfPeriapsis: wrapAngle(argp),
+		TrueAnomaly:         wrapAngle(nu),
+	}, nil
+}
+
+// StateVector computes the position and velocity vectors corresponding to
+// the orbital elements about a central body of the given mass.
+//
+// The state is first computed in the perifocal frame and then rotated into
+// the reference frame by R₃(-Ω) R₁(-i) R₃(-ω).
+//
+// Returns an error for parabolic or otherwise invalid elements, or when the
+// true anomaly lies outside the asymptotes of a hyperbolic orbit.
+//
+// References:
+//   - Vallado, "Fundamentals of Astrodynamics and Applications", 4th ed., Algorithm 10 (COE2RV)
+func (el Elements) StateVector(central units.Mass) (r, v vector.Vector3, err error) {
+	return el.stateVector(gravitationalParameter(central))
+}
+
+// StateVectorMu is StateVector for a central body given by its
+// gravitational parameter μ.
+func (el Elements) StateVectorMu(mu units.StandardGravitationalParameter) (r, v vector.Vector3, err error) {
+	return el.stateVector(mu.Val())
+}
+
+func (el Elements) stateVector(mu float64) (r, v vector.Vector3, err error) {
+	if err := el.validate(); err != nil {
+		return vector.Vector3{}, vector.Vector3{}, err
+	}
+
+	e := el.Eccentricity
+	p := el.SemiLatusRectum().Val()
+	cosNu, sinNu := math.Cos(el.TrueAnomaly), math.Sin(el.TrueAnomaly)
+
+	denom := 1 + e*cosNu
+	if denom <= 0 {
+		return vector.Vector3{}, vector.Vector3{}, fmt.Errorf("true anomaly %g rad is beyond the asymptote of a hyperbolic orbit with e = %g", el.TrueAnomaly, e)
+	}
+
+	rPF := [3]float64{p * cosNu / denom, p * sinNu / denom, 0}
+	k := math.Sqrt(mu / p)
+	vPF := [3]float64{-k * sinNu, k * (e + cosNu), 0}
+
+	rot := perifocalToInertial(el.RAAN, el.Inclination, el.ArgumentOfPeriapsis)
+	rI := mulMat(rot, rPF)
+	vI := mulMat(rot, vPF)
+
+	r = vector.NewPosition(units.Meter(rI[0]), units.Meter(rI[1]), units.Meter(rI[2]))
+	v = vector.NewVelocity(units.MeterPerSecond(vI[0]), units.MeterPerSecond(vI[1]), units.MeterPerSecond(vI[2]))
+	return r, v, nil
+}
+
+// validate checks that the elements describe a closed or hyperbolic conic
+// with a consistent sign of the semi-major axis.
+func (el Elements) validate() error {
+	e := el.Eccentricity
+	a := el.SemiMajorAxis.Val()
+	switch {
+	case e < 0:
+		return fmt.Errorf("eccentricity must be non-negative, got %g", e)
+	case math.Abs(e-1) < singularityTolerance:
+		return fmt.Errorf("parabolic orbits (e = 1) are not supported")
+	case e < 1 && a <= 0:
+		return fmt.Errorf("elliptic orbit requires a > 0, got a = %g m", a)
+	case e > 1 && a >= 0:
+		return fmt.Errorf("hyperbolic orbit requires a < 0, got a = %g m", a)
+	}
+	return nil
+}
+
+// GravitationalParameter returns μ = GM for a central body of the given
+// mass. G is known only to about 2 × 10⁻⁵, while the μ of the Sun and
+// planets are measured far more precisely; prefer the Mu functions with
+// constants.SolarMassParameter or planets.Body.GM where they exist.
+func GravitationalParameter(central units.Mass) units.StandardGravitationalParameter {
+	return units.CubicMeterPerSecond2(constants.GravitationalConstant.Val() * central.Val())
+}
+
+// gravitationalParameter returns μ = GM for the central body in m³/s².
+func gravitationalParameter(central units.Mass) float64 {
+	return GravitationalParameter(central).Val()
+}
+
+// checkState verifies that r has dimension [L] and v has dimension [LT⁻¹].
+func checkState(r, v vector.Vector3) error {
+	if r.Dim() != (units.Dimension{L: 1}) {
+		return fmt.Errorf("position must have dimension %s, got %s", units.Dimension{L: 1}, r.Dim())
+	}
+	if v.Dim() != (units.Dimension{L: 1, T: -1}) {
+		return fmt.Errorf("velocity must have dimension %s, got %s", units.Dimension{L: 1, T: -1}, v.Dim())
+	}
+	return nil
+}
+
+// perifocalToInertial returns the rotation matrix R₃(-Ω) R₁(-i) R₃(-ω).
+func perifocalToInertial(raan, inc, argp float64) [3][3]float64 {
+	cO, sO := math.Cos(raan), math.Sin(raan)
+	ci, si := math.Cos(inc), math.Sin(inc)
+	cw, sw := math.Cos(argp), math.Sin(argp)
+
+	return [3][3]float64{
+		{cO*cw - sO*sw*ci, -cO*sw - sO*cw*ci, sO * si},
+		{sO*cw + cO*sw*ci, -sO*sw + cO*cw*ci, -cO * si},
+		{sw * si, cw * si, ci},
+	}
+}
+
+// angleBetween returns the angle from a to b in [0, 2π), measured
+// counter-clockwise about the axis h.
+func angleBetween(a, b, h [3]float64) float64 {
+	c := cross(a, b)
+	sinTheta := dot(c, h) / norm(h)
+	cosTheta := dot(a, b)
+	return math.Atan2(sinTheta, cosTheta)
+}
+
+// wrapAngle maps an angle onto [0, 2π).
+func wrapAngle(theta float64) float64 {
+	theta = math.Mod(theta, 2*math.Pi)
+	if theta < 0 {
+		theta += 2 * math.Pi
+	}
+	return theta
+}
+
+func clamp(x, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, x))
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+func norm(a [3]float64) float64 {
+	return math.Sqrt(dot(a, a))
+}
+
+func mulMat(m [3][3]float64, v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
@@ -0,0 +1,250 @@
+package orbit
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// keplerTolerance is the convergence threshold (in radians) for the Newton
+// iteration used to solve Kepler's equation.
+const keplerTolerance = 1e-14
+
+// keplerMaxIterations bounds the Newton iteration for Kepler's equation.
+const keplerMaxIterations = 100
+
+// SolveKepler solves Kepler's equation M = E - e sin E for the eccentric
+// anomaly E of an elliptic orbit (0 ≤ e < 1) using Newton-Raphson iteration.
+//
+// The mean anomaly may be any real number; the result is returned in the
+// same revolution as M.
+//
+// Example:
+//
+//	E, _ := orbit.SolveKepler(math.Pi/3, 0.5) // ≈ 1.4987 rad
+//
+// References:
+//   - Vallado, "Fundamentals of Astrodynamics and Applications", 4th ed., Algorithm 2
+func SolveKepler(meanAnomaly, e float64) (float64, error) {
+	if e < 0 || e >= 1 {
+		return 0, fmt.Errorf("elliptic Kepler equation requires 0 ≤ e < 1, got %g", e)
+	}
+
+	// Reduce M to [-π, π] for a robust starting guess, then restore the revolution.
+	revolutions := math.Round(meanAnomaly / (2 * math.Pi))
+	m := meanAnomaly - revolutions*2*math.Pi
+
+	E := m
+	if e > 0.8 {
+		E = math.Copysign(math.Pi, m)
+	}
+	for i := 0; i < keplerMaxIterations; i++ {
+		f := E - e*math.Sin(E) - m
+		dE := f / (1 - e*math.Cos(E))
+		E -= dE
+		if math.Abs(dE) < keplerTolerance {
+			return E + revolutions*2*math.Pi, nil
+		}
+	}
+	return 0, fmt.Errorf("no convergence solving Kepler's equation for M = %g, e = %g", meanAnomaly, e)
+}
+
+// SolveKeplerHyperbolic solves the hyperbolic Kepler equation
+// M = e sinh H - H for the hyperbolic anomaly H (e > 1).
+//
+// References:
+//   - Vallado, "Fundamentals of Astrodynamics and Applications", 4th ed., Algorithm 4
+func SolveKeplerHyperbolic(meanAnomaly, e float64) (float64, error) {
+	if e <= 1 {
+		return 0, fmt.Errorf("hyperbolic Kepler equation requires e > 1, got %g", e)
+	}
+
+	H := math.Asinh(meanAnomaly / e)
+	for i := 0; i < keplerMaxIterations; i++ {
+		f := e*math.Sinh(H) - H - meanAnomaly
+		dH := f / (e*math.Cosh(H) - 1)
+		H -= dH
+		if math.Abs(dH) < keplerTolerance*math.Max(1, math.Abs(H)) {
+			return H, nil
+		}
+	}
+	return 0, fmt.Errorf("no convergence solving hyperbolic Kepler equation for M = %g, e = %g", meanAnomaly, e)
+}
+
+// TrueToMeanAnomaly converts a true anomaly ν to the mean anomaly M.
+//
+// For elliptic orbits the result lies in [0, 2π); for hyperbolic orbits it
+// is unbounded and signed like ν.
+//
+// Formula:
+//
+//	Elliptic:   tan(E/2) = √((1-e)/(1+e)) tan(ν/2),  M = E - e sin E
+//	Hyperbolic: tanh(H/2) = √((e-1)/(e+1)) tan(ν/2), M = e sinh H - H
+func TrueToMeanAnomaly(nu, e float64) (float64, error) {
+	switch {
+	case e < 0:
+		return 0, fmt.Errorf("eccentricity must be non-negative, got %g", e)
+	case e < 1:
+		E := 2 * math.Atan2(math.Sqrt(1-e)*math.Sin(nu/2), math.Sqrt(1+e)*math.Cos(nu/2))
+		return wrapAngle(E - e*math.Sin(E)), nil
+	case e > 1:
+		nu = math.Remainder(nu, 2*math.Pi)
+		x := math.Sqrt((e-1)/(e+1)) * math.Tan(nu/2)
+		if math.Abs(x) >= 1 {
+			return 0, fmt.Errorf("true anomaly %g rad is beyond the asymptote of a hyperbolic orbit with e = %g", nu, e)
+		}
+		H := 2 * math.Atanh(x)
+		return e*math.Sinh(H) - H, nil
+	default:
+		return 0, fmt.Errorf("parabolic orbits (e = 1) are not supported")
+	}
+}
+
+// MeanToTrueAnomaly converts a mean anomaly M to the true anomaly ν by
+// solving Kepler's equation.
+//
+// For elliptic orbits the result lies in [0, 2π); for hyperbolic orbits it
+// lies in (-ν_∞, ν_∞) where cos ν_∞ = -1/e.
+func MeanToTrueAnomaly(meanAnomaly, e float64) (float64, error) {
+	switch {
+	case e < 0:
+		return 0, fmt.Errorf("eccentricity must be non-negative, got %g", e)
+	case e < 1:
+		E, err := SolveKepler(meanAnomaly, e)
+		if err != nil {
+			return 0, err
+		}
+		nu := 2 * math.Atan2(math.Sqrt(1+e)*math.Sin(E/2), math.Sqrt(1-e)*math.Cos(E/2))
+		return wrapAngle(nu), nil
+	case e > 1:
+		H, err := SolveKeplerHyperbolic(meanAnomaly, e)
+		if err != nil {
+			return 0, err
+		}
+		return 2 * math.Atan(math.Sqrt((e+1)/(e-1))*math.Tanh(H/2)), nil
+	default:
+		return 0, fmt.Errorf("parabolic orbits (e = 1) are not supported")
+	}
+}
+
+// MeanMotion returns the mean motion n = √(μ/|a|³) of an orbit with
+// semi-major axis a about a central body of the given mass.
+func MeanMotion(a units.Length, central units.Mass) (units.AngularVelocity, error) {
+	return meanMotion(a, gravitationalParameter(central))
+}
+
+// MeanMotionMu is MeanMotion for a central body given by its gravitational
+// parameter μ.
+func MeanMotionMu(a units.Length, mu units.StandardGravitationalParameter) (units.AngularVelocity, error) {
+	return meanMotion(a, mu.Val())
+}
+
+func meanMotion(a units.Length, mu float64) (units.AngularVelocity, error) {
+	if a.Val() == 0 {
+		return units.AngularVelocity{}, fmt.Errorf("semi-major axis must be non-zero")
+	}
+	aAbs := math.Abs(a.Val())
+	return units.RadianPerSecond(math.Sqrt(mu / (aAbs * aAbs * aAbs))), nil
+}
+
+// Period returns the orbital period T = 2π√(a³/μ) of an elliptic orbit.
+// Returns an error for non-positive semi-major axes (open orbits).
+//
+// Example:
+//
+//	T, _ := orbit.Period(units.AstronomicalUnit(1), constants.SolarMass) // ≈ 1 year
+//
+// References:
+//   - Kepler's third law; Curtis, "Orbital Mechanics for Engineering Students", Eq. 2.83
+func Period(a units.Length, central units.Mass) (units.Time, error) {
+	return period(a, gravitationalParameter(central))
+}
+
+// PeriodMu is Period for a central body given by its gravitational
+// parameter μ.
+//
+// Example:
+//
+//	T, _ := orbit.PeriodMu(units.AstronomicalUnit(1), constants.SolarMassParameter) // ≈ 365.257 days
+func PeriodMu(a units.Length, mu units.StandardGravitationalParameter) (units.Time, error) {
+	return period(a, mu.Val())
+}
+
+func period(a units.Length, mu float64) (units.Time, error) {
+	if a.Val() <= 0 {
+		return units.Time{}, fmt.Errorf("orbital period requires a > 0, got a = %g m", a.Val())
+	}
+	n, err := meanMotion(a, mu)
+	if err != nil {
+		return units.Time{}, err
+	}
+	return units.Second(2 * math.Pi / n.Val()), nil
+}
+
+// Propagate advances the orbital elements by the time interval dt using the
+// analytic two-body solution. Only the true anomaly changes; the orbit shape
+// and orientation are constant.
+//
+// Negative dt propagates backwards in time.
+func Propagate(el Elements, central units.Mass, dt units.Time) (Elements, error) {
+	return propagate(el, gravitationalParameter(central), dt)
+}
+
+// PropagateMu is Propagate for a central body given by its gravitational
+// parameter μ.
+func PropagateMu(el Elements, mu units.StandardGravitationalParameter, dt units.Time) (Elements, error) {
+	return propagate(el, mu.Val(), dt)
+}
+
+func propagate(el Elements, mu float64, dt units.Time) (Elements, error) {
+	if err := el.validate(); err != nil {
+		return Elements{}, err
+	}
+	n, err := meanMotion(el.SemiMajorAxis, mu)
+	if err != nil {
+		return Elements{}, err
+	}
+
+	m0, err := TrueToMeanAnomaly(el.TrueAnomaly, el.Eccentricity)
+	if err != nil {
+		return Elements{}, err
+	}
+	nu, err := MeanToTrueAnomaly(m0+n.Val()*dt.Val(), el.Eccentricity)
+	if err != nil {
+		return Elements{}, err
+	}
+
+	out := el
+	out.TrueAnomaly = wrapAngle(nu)
+	return out, nil
+}
+
+// PropagateState advances a Cartesian state (r, v) by dt about a central
+// body of the given mass, via conversion to orbital elements.
+//
+// Example:
+//
+//	r1, v1, _ := orbit.PropagateState(r0, v0, constants.EarthMass, units.Hour(1))
+func PropagateState(r, v vector.Vector3, central units.Mass, dt units.Time) (vector.Vector3, vector.Vector3, error) {
+	return propagateState(r, v, gravitationalParameter(central), dt)
+}
+
+// PropagateStateMu is PropagateState for a central body given by its
+// gravitational parameter μ.
+func PropagateStateMu(r, v vector.Vector3, mu units.StandardGravitationalParameter, dt units.Time) (vector.Vector3, vector.Vector3, error) {
+	return propagateState(r, v, mu.Val(), dt)
+}
+
+func propagateState(r, v vector.Vector3, mu float64, dt units.Time) (vector.Vector3, vector.Vector3, error) {
+	el, err := elementsFromState(r, v, mu)
+	if err != nil {
+		return vector.Vector3{}, vector.Vector3{}, err
+	}
+	el, err = propagate(el, mu, dt)
+	if err != nil {
+		return vector.Vector3{}, vector.Vector3{}, err
+	}
+	return el.stateVector(mu)
+}
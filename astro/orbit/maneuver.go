@@ -0,0 +1,327 @@
+package orbit
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Transfer describes an impulsive orbit transfer as a sequence of burns.
+// Each burn is the magnitude of the velocity change applied at that point.
+type Transfer struct {
+	Burns        []units.Velocity // Δv of each impulsive burn, in order
+	TransferTime units.Time       // Time of flight between the first and last burn
+}
+
+// TotalDeltaV returns the sum of the burn magnitudes, Σ|Δvᵢ|.
+func (tr Transfer) TotalDeltaV() units.Velocity {
+	total := 0.0
+	for _, dv := range tr.Burns {
+		total += math.Abs(dv.Val())
+	}
+	return units.MeterPerSecond(total)
+}
+
+// VisViva returns the orbital speed at distance r on an orbit with
+// semi-major axis a about a central body of the given mass.
+//
+// Formula:
+//
+//	v² = μ (2/r - 1/a)
+//
+// The semi-major axis is negative for hyperbolic orbits. Returns an error if
+// r lies outside the orbit (v² < 0) or r is not positive.
+//
+// Example:
+//
+//	// Speed at perigee of a 7000 km × 42164 km transfer orbit
+//	v, _ := orbit.VisViva(constants.EarthMass, units.Kilometer(7000), units.Kilometer(24582))
+//
+// References:
+//   - Curtis, "Orbital Mechanics for Engineering Students", 3rd ed., Eq. 2.81
+func VisViva(central units.Mass, r, a units.Length) (units.Velocity, error) {
+	return visViva(gravitationalParameter(central), r, a)
+}
+
+// VisVivaMu is VisViva for a central body given by its gravitational
+// parameter μ.
+func VisVivaMu(mu units.StandardGravitationalParameter, r, a units.Length) (units.Velocity, error) {
+	return visViva(mu.Val(), r, a)
+}
+
+func visViva(mu float64, r, a units.Length) (units.Velocity, error) {
+	if r.Val() <= 0 {
+		return units.Velocity{}, fmt.Errorf("radius must be positive, got %g m", r.Val())
+	}
+	if a.Val() == 0 {
+		return units.Velocity{}, fmt.Errorf("semi-major axis must be non-zero")
+	}
+	v2 := mu * (2/r.Val() - 1/a.Val())
+	if v2 < 0 {
+		return units.Velocity{}, fmt.Errorf("radius %g m lies beyond apoapsis of orbit with a = %g m", r.Val(), a.Val())
+	}
+	return units.MeterPerSecond(math.Sqrt(v2)), nil
+}
+
+// CircularVelocity returns the speed of a circular orbit of radius r,
+// v_c = √(μ/r).
+func CircularVelocity(central units.Mass, r units.Length) (units.Velocity, error) {
+	return visViva(gravitationalParameter(central), r, r)
+}
+
+// CircularVelocityMu is CircularVelocity for a central body given by its
+// gravitational parameter μ.
+func CircularVelocityMu(mu units.StandardGravitationalParameter, r units.Length) (units.Velocity, error) {
+	return visViva(mu.Val(), r, r)
+}
+
+// EscapeVelocity returns the speed needed to escape from distance r of a
+// body of the given mass, v_esc = √(2μ/r).
+//
+// Example:
+//
+//	v, _ := orbit.EscapeVelocity(constants.EarthMass, constants.EarthRadius) // ≈ 11.19 km/s
+func EscapeVelocity(central units.Mass, r units.Length) (units.Velocity, error) {
+	return escapeVelocity(gravitationalParameter(central), r)
+}
+
+// EscapeVelocityMu is EscapeVelocity for a central body given by its
+// gravitational parameter μ.
+func EscapeVelocityMu(mu units.StandardGravitationalParameter, r units.Length) (units.Velocity, error) {
+	return escapeVelocity(mu.Val(), r)
+}
+
+func escapeVelocity(mu float64, r units.Length) (units.Velocity, error) {
+	if r.Val() <= 0 {
+		return units.Velocity{}, fmt.Errorf("radius must be positive, got %g m", r.Val())
+	}
+	return units.MeterPerSecond(math.Sqrt(2 * mu / r.Val())), nil
+}
+
+// HohmannTransfer computes the two-burn Hohmann transfer between coplanar
+// circular orbits of radii r1 and r2.
+//
+// Formula:
+//
+//	a_t = (r₁ + r₂)/2
+//	Δv₁ = |√(μ(2/r₁ - 1/a_t)) - √(μ/r₁)|
+//	Δv₂ = |√(μ/r₂) - √(μ(2/r₂ - 1/a_t))|
+//	t   = π √(a_t³/μ)
+//
+// Example:
+//
+//	// LEO (6678 km) to GEO (42164 km): Δv ≈ 3.9 km/s
+//	tr, _ := orbit.HohmannTransfer(constants.EarthMass, units.Kilometer(6678), units.Kilometer(42164))
+//	fmt.Println(tr.TotalDeltaV())
+//
+// References:
+//   - Hohmann, W. "Die Erreichbarkeit der Himmelskörper", 1925
+//   - Vallado, "Fundamentals of Astrodynamics and Applications", 4th ed., Algorithm 36
+func HohmannTransfer(central units.Mass, r1, r2 units.Length) (Transfer, error) {
+	return hohmannTransfer(gravitationalParameter(central), r1, r2)
+}
+
+// HohmannTransferMu is HohmannTransfer for a central body given by its
+// gravitational parameter μ.
+func HohmannTransferMu(mu units.StandardGravitationalParameter, r1, r2 units.Length) (Transfer, error) {
+	return hohmannTransfer(mu.Val(), r1, r2)
+}
+
+func hohmannTransfer(mu float64, r1, r2 units.Length) (Transfer, error) {
+	if r1.Val() <= 0 || r2.Val() <= 0 {
+		return Transfer{}, fmt.Errorf("orbit radii must be positive, got r1 = %g m, r2 = %g m", r1.Val(), r2.Val())
+	}
+	at := units.Meter((r1.Val() + r2.Val()) / 2)
+
+	dv1, err := burnBetween(mu, r1, r1, at)
+	if err != nil {
+		return Transfer{}, err
+	}
+	dv2, err := burnBetween(mu, r2, at, r2)
+	if err != nil {
+		return Transfer{}, err
+	}
+	tt, err := period(at, mu)
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	return Transfer{
+		Burns:        []units.Velocity{dv1, dv2},
+		TransferTime: units.Second(tt.Val() / 2),
+	}, nil
+}
+
+// BiEllipticTransfer computes the three-burn bi-elliptic transfer between
+// coplanar circular orbits of radii r1 and r2 via an intermediate apoapsis rb.
+//
+// The first ellipse connects r1 to rb, the second connects rb to r2. For
+// r2/r1 ≳ 11.94 a bi-elliptic transfer with large rb requires less Δv than
+// the Hohmann transfer, at the cost of a much longer flight time.
+//
+// Returns an error if rb is smaller than r1 or r2.
+//
+// References:
+//   - Vallado, "Fundamentals of Astrodynamics and Applications", 4th ed., Algorithm 37
+func BiEllipticTransfer(central units.Mass, r1, r2, rb units.Length) (Transfer, error) {
+	return biEllipticTransfer(gravitationalParameter(central), r1, r2, rb)
+}
+
+// BiEllipticTransferMu is BiEllipticTransfer for a central body given by
+// its gravitational parameter μ.
+func BiEllipticTransferMu(mu units.StandardGravitationalParameter, r1, r2, rb units.Length) (Transfer, error) {
+	return biEllipticTransfer(mu.Val(), r1, r2, rb)
+}
+
+func biEllipticTransfer(mu float64, r1, r2, rb units.Length) (Transfer, error) {
+	if r1.Val() <= 0 || r2.Val() <= 0 {
+		return Transfer{}, fmt.Errorf("orbit radii must be positive, got r1 = %g m, r2 = %g m", r1.Val(), r2.Val())
+	}
+	if rb.Val() < math.Max(r1.Val(), r2.Val()) {
+		return Transfer{}, fmt.Errorf("intermediate radius rb = %g m must be at least max(r1, r2)", rb.Val())
+	}
+
+	a1 := units.Meter((r1.Val() + rb.Val()) / 2)
+	a2 := units.Meter((r2.Val() + rb.Val()) / 2)
+
+	dv1, err := burnBetween(mu, r1, r1, a1)
+	if err != nil {
+		return Transfer{}, err
+	}
+	dv2, err := burnBetween(mu, rb, a1, a2)
+	if err != nil {
+		return Transfer{}, err
+	}
+	dv3, err := burnBetween(mu, r2, a2, r2)
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	t1, err := period(a1, mu)
+	if err != nil {
+		return Transfer{}, err
+	}
+	t2, err := period(a2, mu)
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	return Transfer{
+		Burns:        []units.Velocity{dv1, dv2, dv3},
+		TransferTime: units.Second((t1.Val() + t2.Val()) / 2),
+	}, nil
+}
+
+// burnBetween returns |Δv| for a tangential burn at radius r that changes the
+// semi-major axis from aFrom to aTo.
+func burnBetween(mu float64, r, aFrom, aTo units.Length) (units.Velocity, error) {
+	vFrom, err := visViva(mu, r, aFrom)
+	if err != nil {
+		return units.Velocity{}, err
+	}
+	vTo, err := visViva(mu, r, aTo)
+	if err != nil {
+		return units.Velocity{}, err
+	}
+	return units.MeterPerSecond(math.Abs(vTo.Val() - vFrom.Val())), nil
+}
+
+// PlaneChange returns the Δv required to rotate the orbital plane by the
+// angle Δi (radians) at constant speed v.
+//
+// Formula:
+//
+//	Δv = 2 v sin(Δi/2)
+//
+// Example:
+//
+//	// Removing the 28.5° inclination of a Cape Canaveral launch at GEO speed
+//	dv := orbit.PlaneChange(units.MeterPerSecond(3075), 28.5*math.Pi/180) // ≈ 1.51 km/s
+func PlaneChange(v units.Velocity, deltaI float64) units.Velocity {
+	return units.MeterPerSecond(2 * math.Abs(v.Val()) * math.Abs(math.Sin(deltaI/2)))
+}
+
+// CombinedPlaneChange returns the Δv of a single burn that changes the speed
+// from v1 to v2 while rotating the plane by Δi (radians).
+//
+// Formula:
+//
+//	Δv = √(v₁² + v₂² - 2 v₁ v₂ cos Δi)
+//
+// References:
+//   - Curtis, "Orbital Mechanics for Engineering Students", 3rd ed., Eq. 6.8
+func CombinedPlaneChange(v1, v2 units.Velocity, deltaI float64) units.Velocity {
+	a, b := v1.Val(), v2.Val()
+	dv2 := a*a + b*b - 2*a*b*math.Cos(deltaI)
+	return units.MeterPerSecond(math.Sqrt(math.Max(dv2, 0)))
+}
+
+// -----------------------------------------------------------------------------
+// Δv Budget
+// -----------------------------------------------------------------------------
+
+// BudgetItem is a single named entry in a Δv budget.
+type BudgetItem struct {
+	Name   string
+	DeltaV units.Velocity
+	Margin float64 // Fractional contingency applied to this item (e.g. 0.05 for 5%)
+}
+
+// Budget accumulates the Δv of a mission's maneuvers.
+//
+// Example:
+//
+//	var b orbit.Budget
+//	b.Add("GTO insertion", tr.Burns[0], 0.0)
+//	b.Add("circularization", tr.Burns[1], 0.0)
+//	b.Add("station keeping", units.MeterPerSecond(50), 0.2)
+//	fmt.Println(b.Total())
+type Budget struct {
+	items []BudgetItem
+}
+
+// Add appends a maneuver with the given contingency margin to the budget.
+func (b *Budget) Add(name string, dv units.Velocity, margin float64) {
+	b.items = append(b.items, BudgetItem{Name: name, DeltaV: dv, Margin: margin})
+}
+
+// AddTransfer appends every burn of a transfer, naming them "name #1", "name #2", ….
+func (b *Budget) AddTransfer(name string, tr Transfer, margin float64) {
+	for i, dv := range tr.Burns {
+		b.Add(fmt.Sprintf("%s #%d", name, i+1), dv, margin)
+	}
+}
+
+// Items returns a copy of the budget entries in insertion order.
+func (b *Budget) Items() []BudgetItem {
+	out := make([]BudgetItem, len(b.items))
+	copy(out, b.items)
+	return out
+}
+
+// Total returns the budgeted Δv including margins, Σ|Δvᵢ|(1 + marginᵢ).
+func (b *Budget) Total() units.Velocity {
+	total := 0.0
+	for _, it := range b.items {
+		total += math.Abs(it.DeltaV.Val()) * (1 + it.Margin)
+	}
+	return units.MeterPerSecond(total)
+}
+
+// MassRatio returns the initial-to-final mass ratio m₀/m_f required to
+// achieve Δv with the given effective exhaust velocity (Tsiolkovsky rocket
+// equation).
+//
+// Formula:
+//
+//	m₀/m_f = exp(Δv / v_e)
+//
+// References:
+//   - Tsiolkovsky, K. "Exploration of Outer Space by Means of Rocket Devices", 1903
+func MassRatio(dv, exhaustVelocity units.Velocity) (float64, error) {
+	if exhaustVelocity.Val() <= 0 {
+		return 0, fmt.Errorf("exhaust velocity must be positive, got %g m/s", exhaustVelocity.Val())
+	}
+	return math.Exp(math.Abs(dv.Val()) / exhaustVelocity.Val()), nil
+}
@@ -0,0 +1,132 @@
+package orbit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestVisViva(t *testing.T) {
+	r := units.Kilometer(7000)
+
+	// Circular orbit: v = √(μ/r)
+	got, err := VisViva(constants.EarthMass, r, r)
+	if err != nil {
+		t.Fatalf("VisViva() error = %v", err)
+	}
+	circ, _ := CircularVelocity(constants.EarthMass, r)
+	if !almostEqual(got.Val(), circ.Val(), 1e-14) {
+		t.Errorf("VisViva(r, r) = %v, want circular speed %v", got, circ)
+	}
+
+	// Beyond apoapsis
+	if _, err := VisViva(constants.EarthMass, units.Kilometer(20000), units.Kilometer(8000)); err == nil {
+		t.Error("VisViva() should fail for r > 2a")
+	}
+}
+
+func TestEscapeVelocity(t *testing.T) {
+	v, err := EscapeVelocity(constants.EarthMass, constants.EarthRadius)
+	if err != nil {
+		t.Fatalf("EscapeVelocity() error = %v", err)
+	}
+	if !almostEqual(v.Val(), 11186, 1e-3) {
+		t.Errorf("Earth escape velocity = %v m/s, want ≈ 11186 m/s", v.Val())
+	}
+
+	circ, _ := CircularVelocity(constants.EarthMass, constants.EarthRadius)
+	if !almostEqual(v.Val(), circ.Val()*math.Sqrt2, 1e-14) {
+		t.Errorf("v_esc / v_circ = %v, want √2", v.Val()/circ.Val())
+	}
+}
+
+func TestHohmannTransfer_Validation(t *testing.T) {
+	// Reference: Vallado, "Fundamentals of Astrodynamics and Applications",
+	// 4th ed., Example 6-1: 191.34 km LEO to GEO.
+	tr, err := HohmannTransfer(constants.EarthMass, units.Kilometer(6569.4781), units.Kilometer(42159.4855))
+	if err != nil {
+		t.Fatalf("HohmannTransfer() error = %v", err)
+	}
+	if len(tr.Burns) != 2 {
+		t.Fatalf("HohmannTransfer() burns = %d, want 2", len(tr.Burns))
+	}
+	if !almostEqual(tr.TotalDeltaV().Val(), 3935.224, 1e-5) {
+		t.Errorf("Δv = %v m/s, want 3935.224 m/s (Vallado Ex. 6-1)", tr.TotalDeltaV().Val())
+	}
+	if !almostEqual(tr.TransferTime.ToHours(), 5.256713, 1e-5) {
+		t.Errorf("transfer time = %v h, want 5.256713 h (Vallado Ex. 6-1)", tr.TransferTime.ToHours())
+	}
+}
+
+func TestBiEllipticTransfer_Validation(t *testing.T) {
+	// Reference: Vallado, "Fundamentals of Astrodynamics and Applications",
+	// 4th ed., Example 6-2. The final and intermediate radii are given there
+	// as altitudes above R⊕ = 6378.137 km.
+	tr, err := BiEllipticTransfer(constants.EarthMass,
+		units.Kilometer(6569.4781), units.Kilometer(376310+6378.137), units.Kilometer(503873+6378.137))
+	if err != nil {
+		t.Fatalf("BiEllipticTransfer() error = %v", err)
+	}
+	if len(tr.Burns) != 3 {
+		t.Fatalf("BiEllipticTransfer() burns = %d, want 3", len(tr.Burns))
+	}
+	if !almostEqual(tr.TotalDeltaV().Val(), 3904.057, 1e-5) {
+		t.Errorf("Δv = %v m/s, want 3904.057 m/s (Vallado Ex. 6-2)", tr.TotalDeltaV().Val())
+	}
+	if !almostEqual(tr.TransferTime.ToHours(), 593.919803, 1e-5) {
+		t.Errorf("transfer time = %v h, want 593.92 h (Vallado Ex. 6-2)", tr.TransferTime.ToHours())
+	}
+
+	// Bi-elliptic beats Hohmann for this large radius ratio
+	h, _ := HohmannTransfer(constants.EarthMass, units.Kilometer(6569.4781), units.Kilometer(376310+6378.137))
+	if tr.TotalDeltaV().Val() >= h.TotalDeltaV().Val() {
+		t.Errorf("bi-elliptic Δv %v should be below Hohmann Δv %v", tr.TotalDeltaV(), h.TotalDeltaV())
+	}
+
+	if _, err := BiEllipticTransfer(constants.EarthMass, units.Kilometer(7000), units.Kilometer(9000), units.Kilometer(8000)); err == nil {
+		t.Error("BiEllipticTransfer() should fail when rb < max(r1, r2)")
+	}
+}
+
+func TestPlaneChange(t *testing.T) {
+	v := units.MeterPerSecond(3075)
+
+	if got := PlaneChange(v, 0); got.Val() != 0 {
+		t.Errorf("PlaneChange(0) = %v, want 0", got)
+	}
+	// 60° rotation: Δv = 2 v sin 30° = v
+	if got := PlaneChange(v, math.Pi/3); !almostEqual(got.Val(), v.Val(), 1e-12) {
+		t.Errorf("PlaneChange(60°) = %v, want %v", got, v)
+	}
+	// Combined maneuver reduces to a simple plane change at constant speed
+	if got := CombinedPlaneChange(v, v, 0.5); !almostEqual(got.Val(), PlaneChange(v, 0.5).Val(), 1e-12) {
+		t.Errorf("CombinedPlaneChange(v, v) = %v, want %v", got, PlaneChange(v, 0.5))
+	}
+}
+
+func TestBudget(t *testing.T) {
+	var b Budget
+	tr := Transfer{Burns: []units.Velocity{units.MeterPerSecond(2000), units.MeterPerSecond(1000)}}
+	b.AddTransfer("GTO", tr, 0)
+	b.Add("station keeping", units.MeterPerSecond(100), 0.5)
+
+	if n := len(b.Items()); n != 3 {
+		t.Fatalf("Budget items = %d, want 3", n)
+	}
+	if got := b.Items()[1].Name; got != "GTO #2" {
+		t.Errorf("item name = %q, want %q", got, "GTO #2")
+	}
+	if !almostEqual(b.Total().Val(), 3150, 1e-14) {
+		t.Errorf("Budget.Total() = %v, want 3150 m/s", b.Total())
+	}
+
+	ratio, err := MassRatio(b.Total(), units.MeterPerSecond(3150))
+	if err != nil {
+		t.Fatalf("MassRatio() error = %v", err)
+	}
+	if !almostEqual(ratio, math.E, 1e-14) {
+		t.Errorf("MassRatio() = %v, want e", ratio)
+	}
+}
@@ -0,0 +1,323 @@
+package orbit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	if a == b {
+		return true
+	}
+	diff := math.Abs(a - b)
+	if a == 0 || b == 0 || diff < tolerance {
+		return diff < tolerance
+	}
+	return diff/(math.Abs(a)+math.Abs(b)) < tolerance
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// -----------------------------------------------------------------------------
+// Kepler Equation Tests
+// -----------------------------------------------------------------------------
+
+func TestSolveKepler(t *testing.T) {
+	tests := []struct {
+		name string
+		m    float64
+		e    float64
+	}{
+		{"circular", 1.0, 0.0},
+		{"moderate", math.Pi / 3, 0.5},
+		{"high eccentricity", 0.1, 0.99},
+		{"multiple revolutions", 13.0, 0.3},
+		{"negative mean anomaly", -2.0, 0.7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			E, err := SolveKepler(tt.m, tt.e)
+			if err != nil {
+				t.Fatalf("SolveKepler() error = %v", err)
+			}
+			residual := E - tt.e*math.Sin(E) - tt.m
+			if math.Abs(residual) > 1e-12 {
+				t.Errorf("SolveKepler() residual = %e, want < 1e-12", residual)
+			}
+		})
+	}
+
+	if _, err := SolveKepler(1.0, 1.2); err == nil {
+		t.Error("SolveKepler() should fail for e ≥ 1")
+	}
+}
+
+func TestSolveKeplerHyperbolic(t *testing.T) {
+	for _, m := range []float64{-10, -0.5, 0, 2, 50} {
+		H, err := SolveKeplerHyperbolic(m, 2.5)
+		if err != nil {
+			t.Fatalf("SolveKeplerHyperbolic(%v) error = %v", m, err)
+		}
+		residual := 2.5*math.Sinh(H) - H - m
+		if math.Abs(residual) > 1e-9 {
+			t.Errorf("SolveKeplerHyperbolic(%v) residual = %e", m, residual)
+		}
+	}
+
+	if _, err := SolveKeplerHyperbolic(1.0, 0.5); err == nil {
+		t.Error("SolveKeplerHyperbolic() should fail for e ≤ 1")
+	}
+}
+
+func TestAnomalyRoundTrip(t *testing.T) {
+	for _, e := range []float64{0, 0.2, 0.9, 1.5, 3.0} {
+		for _, nu := range []float64{0.1, 1.0, 2.0, -1.0} {
+			if e > 1 && math.Cos(nu) <= -1/e {
+				continue
+			}
+			m, err := TrueToMeanAnomaly(nu, e)
+			if err != nil {
+				t.Fatalf("TrueToMeanAnomaly(%v, %v) error = %v", nu, e, err)
+			}
+			got, err := MeanToTrueAnomaly(m, e)
+			if err != nil {
+				t.Fatalf("MeanToTrueAnomaly(%v, %v) error = %v", m, e, err)
+			}
+			if !almostEqual(math.Remainder(got-nu, 2*math.Pi), 0, 1e-10) {
+				t.Errorf("round trip e=%v: ν = %v, got %v", e, nu, got)
+			}
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// State Vector Conversion Tests
+// -----------------------------------------------------------------------------
+
+func TestElementsFromState_Validation(t *testing.T) {
+	// Reference: Vallado, "Fundamentals of Astrodynamics and Applications",
+	// 4th ed., Example 2-5. Vallado uses μ = 398600.4418 km³/s²; G·M⊕ here
+	// differs by ~1e-6, well inside the tolerances below.
+	r := vector.NewPosition(units.Kilometer(6524.834), units.Kilometer(6862.875), units.Kilometer(6448.296))
+	v := vector.NewVelocity(units.MeterPerSecond(4901.327), units.MeterPerSecond(5533.756), units.MeterPerSecond(-1976.341))
+
+	el, err := ElementsFromState(r, v, constants.EarthMass)
+	if err != nil {
+		t.Fatalf("ElementsFromState() error = %v", err)
+	}
+
+	if !almostEqual(el.SemiMajorAxis.ToKilometers(), 36127.343, 1e-4) {
+		t.Errorf("a = %v km, want 36127.343 km", el.SemiMajorAxis.ToKilometers())
+	}
+	if !almostEqual(el.SemiLatusRectum().ToKilometers(), 11067.790, 1e-4) {
+		t.Errorf("p = %v km, want 11067.790 km", el.SemiLatusRectum().ToKilometers())
+	}
+	if !almostEqual(el.Eccentricity, 0.832853, 1e-5) {
+		t.Errorf("e = %v, want 0.832853", el.Eccentricity)
+	}
+	checks := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"i", degrees(el.Inclination), 87.870},
+		{"Ω", degrees(el.RAAN), 227.89},
+		{"ω", degrees(el.ArgumentOfPeriapsis), 53.38},
+		{"ν", degrees(el.TrueAnomaly), 92.335},
+	}
+	for _, c := range checks {
+		if math.Abs(c.got-c.want) > 0.01 {
+			t.Errorf("%s = %v°, want %v°", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestStateVectorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		el   Elements
+	}{
+		{
+			name: "inclined ellipse",
+			el: Elements{
+				SemiMajorAxis: units.Kilometer(12000), Eccentricity: 0.3,
+				Inclination: 0.9, RAAN: 1.2, ArgumentOfPeriapsis: 2.5, TrueAnomaly: 4.0,
+			},
+		},
+		{
+			name: "retrograde hyperbola",
+			el: Elements{
+				SemiMajorAxis: units.Kilometer(-20000), Eccentricity: 1.8,
+				Inclination: 2.5, RAAN: 0.3, ArgumentOfPeriapsis: 5.0, TrueAnomaly: 0.7,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, v, err := tt.el.StateVector(constants.EarthMass)
+			if err != nil {
+				t.Fatalf("StateVector() error = %v", err)
+			}
+			got, err := ElementsFromState(r, v, constants.EarthMass)
+			if err != nil {
+				t.Fatalf("ElementsFromState() error = %v", err)
+			}
+			if !almostEqual(got.SemiMajorAxis.Val(), tt.el.SemiMajorAxis.Val(), 1e-9) {
+				t.Errorf("a = %v, want %v", got.SemiMajorAxis, tt.el.SemiMajorAxis)
+			}
+			pairs := [][2]float64{
+				{got.Eccentricity, tt.el.Eccentricity},
+				{got.Inclination, tt.el.Inclination},
+				{got.RAAN, tt.el.RAAN},
+				{got.ArgumentOfPeriapsis, tt.el.ArgumentOfPeriapsis},
+				{got.TrueAnomaly, tt.el.TrueAnomaly},
+			}
+			for i, p := range pairs {
+				if math.Abs(p[0]-p[1]) > 1e-9 {
+					t.Errorf("element %d = %v, want %v", i, p[0], p[1])
+				}
+			}
+		})
+	}
+}
+
+func TestElementsFromState_CircularEquatorial(t *testing.T) {
+	rMag := 7000e3
+	vCirc := math.Sqrt(constants.GravitationalConstant.Val() * constants.EarthMass.Val() / rMag)
+	r := vector.NewPosition(units.Meter(0), units.Meter(rMag), units.Meter(0))
+	v := vector.NewVelocity(units.MeterPerSecond(-vCirc), units.MeterPerSecond(0), units.MeterPerSecond(0))
+
+	el, err := ElementsFromState(r, v, constants.EarthMass)
+	if err != nil {
+		t.Fatalf("ElementsFromState() error = %v", err)
+	}
+	if el.Eccentricity > 1e-10 || el.Inclination > 1e-10 {
+		t.Errorf("expected circular equatorial orbit, got e = %v, i = %v", el.Eccentricity, el.Inclination)
+	}
+	if !almostEqual(el.TrueAnomaly, math.Pi/2, 1e-10) {
+		t.Errorf("true longitude = %v, want π/2", el.TrueAnomaly)
+	}
+}
+
+func TestElementsFromState_Errors(t *testing.T) {
+	r := vector.NewPosition(units.Meter(7e6), units.Meter(0), units.Meter(0))
+	wrongDim := vector.NewPosition(units.Meter(0), units.Meter(7e3), units.Meter(0))
+	if _, err := ElementsFromState(r, wrongDim, constants.EarthMass); err == nil {
+		t.Error("ElementsFromState() should fail for velocity with length dimension")
+	}
+
+	radial := vector.NewVelocity(units.MeterPerSecond(1000), units.MeterPerSecond(0), units.MeterPerSecond(0))
+	if _, err := ElementsFromState(r, radial, constants.EarthMass); err == nil {
+		t.Error("ElementsFromState() should fail for rectilinear motion")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Propagation Tests
+// -----------------------------------------------------------------------------
+
+func TestPeriod_Validation(t *testing.T) {
+	// Kepler's third law: Earth's orbit around the Sun takes ≈ 365.25 days.
+	T, err := Period(units.AstronomicalUnit(1), constants.SolarMass)
+	if err != nil {
+		t.Fatalf("Period() error = %v", err)
+	}
+	if !almostEqual(T.ToDays(), 365.25, 1e-3) {
+		t.Errorf("Period(1 AU) = %v days, want ≈ 365.25 days", T.ToDays())
+	}
+
+	if _, err := Period(units.Meter(-1e7), constants.EarthMass); err == nil {
+		t.Error("Period() should fail for hyperbolic orbits")
+	}
+}
+
+func TestPeriodMu(t *testing.T) {
+	// With the heliocentric μ, 1 au gives the Gaussian year 2π/k,
+	// k = 0.01720209895 rad/day, far more closely than G·M☉ can.
+	T, err := PeriodMu(units.AstronomicalUnit(1), constants.SolarMassParameter)
+	if err != nil {
+		t.Fatalf("PeriodMu() error = %v", err)
+	}
+	if want := 2 * math.Pi / 0.01720209895; !almostEqual(T.ToDays(), want, 1e-9) {
+		t.Errorf("PeriodMu(1 au) = %v days, want %v", T.ToDays(), want)
+	}
+
+	// The Mu variants agree with the mass variants through GM.
+	mu := GravitationalParameter(constants.EarthMass)
+	tr, _ := HohmannTransfer(constants.EarthMass, units.Kilometer(6678), units.Kilometer(42164))
+	trMu, err := HohmannTransferMu(mu, units.Kilometer(6678), units.Kilometer(42164))
+	if err != nil || trMu.TotalDeltaV().Val() != tr.TotalDeltaV().Val() {
+		t.Errorf("HohmannTransferMu() = %v, %v, want %v", trMu.TotalDeltaV(), err, tr.TotalDeltaV())
+	}
+	v, _ := EscapeVelocityMu(constants.EarthMassParameter, constants.EarthRadius)
+	if !almostEqual(v.Val(), 11186, 1e-4) {
+		t.Errorf("EscapeVelocityMu(GM⊕, R⊕) = %v, want ≈ 11186 m/s", v)
+	}
+}
+
+func TestPropagateFullPeriod(t *testing.T) {
+	el := Elements{
+		SemiMajorAxis: units.Kilometer(9000), Eccentricity: 0.2,
+		Inclination: 0.5, RAAN: 1.0, ArgumentOfPeriapsis: 2.0, TrueAnomaly: 0.3,
+	}
+	T, _ := Period(el.SemiMajorAxis, constants.EarthMass)
+
+	got, err := Propagate(el, constants.EarthMass, T)
+	if err != nil {
+		t.Fatalf("Propagate() error = %v", err)
+	}
+	if !almostEqual(got.TrueAnomaly, el.TrueAnomaly, 1e-9) {
+		t.Errorf("after one period ν = %v, want %v", got.TrueAnomaly, el.TrueAnomaly)
+	}
+
+	half, _ := Propagate(el, constants.EarthMass, units.Second(T.Val()/2))
+	back, _ := Propagate(half, constants.EarthMass, units.Second(-T.Val()/2))
+	if !almostEqual(back.TrueAnomaly, el.TrueAnomaly, 1e-9) {
+		t.Errorf("forward/backward propagation ν = %v, want %v", back.TrueAnomaly, el.TrueAnomaly)
+	}
+}
+
+func TestPropagateState_ConservesEnergy(t *testing.T) {
+	r0 := vector.NewPosition(units.Kilometer(7000), units.Kilometer(-1200), units.Kilometer(300))
+	v0 := vector.NewVelocity(units.MeterPerSecond(1100), units.MeterPerSecond(7300), units.MeterPerSecond(900))
+	mu := gravitationalParameter(constants.EarthMass)
+
+	energy := func(r, v vector.Vector3) float64 {
+		rv, vv := r.ToArray(), v.ToArray()
+		return dot(vv, vv)/2 - mu/norm(rv)
+	}
+
+	r1, v1, err := PropagateState(r0, v0, constants.EarthMass, units.Minute(47))
+	if err != nil {
+		t.Fatalf("PropagateState() error = %v", err)
+	}
+	if !almostEqual(energy(r1, v1), energy(r0, v0), 1e-10) {
+		t.Errorf("specific energy drift: %v → %v", energy(r0, v0), energy(r1, v1))
+	}
+
+	h0 := cross(r0.ToArray(), v0.ToArray())
+	h1 := cross(r1.ToArray(), v1.ToArray())
+	for k := 0; k < 3; k++ {
+		if !almostEqual(h0[k], h1[k], 1e-9) {
+			t.Errorf("angular momentum component %d drift: %v → %v", k, h0[k], h1[k])
+		}
+	}
+}
+
+func BenchmarkPropagateState(b *testing.B) {
+	r0 := vector.NewPosition(units.Kilometer(7000), units.Kilometer(-1200), units.Kilometer(300))
+	v0 := vector.NewVelocity(units.MeterPerSecond(1100), units.MeterPerSecond(7300), units.MeterPerSecond(900))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = PropagateState(r0, v0, constants.EarthMass, units.Minute(47))
+	}
+}
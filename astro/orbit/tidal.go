@@ -0,0 +1,107 @@
+package orbit
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// RocheLimitRigid returns the distance from a primary of mass M inside
+// which tidal forces exceed the self-gravity of a rigid spherical satellite
+// of mass m and radius R_m.
+//
+// Formula:
+//
+//	d = R_m (2M/m)^(1/3)
+func RocheLimitRigid(primary, satellite units.Mass, satelliteRadius units.Length) (units.Length, error) {
+	if err := checkPair(primary, satellite); err != nil {
+		return units.Length{}, err
+	}
+	if !(satelliteRadius.Val() > 0) {
+		return units.Length{}, fmt.Errorf("satellite radius must be positive, got %g m", satelliteRadius.Val())
+	}
+	return units.Meter(satelliteRadius.Val() * math.Cbrt(2*primary.Val()/satellite.Val())), nil
+}
+
+// RocheLimitFluid returns the Roche limit for a fluid satellite, which
+// deforms into a prolate spheroid and so is disrupted farther out than a
+// rigid one.
+//
+// Formula:
+//
+//	d ≈ 2.44 R_m (M/m)^(1/3)
+//
+// Example:
+//
+//	// A fluid Moon would break up about 18 000 km from Earth
+//	d, _ := orbit.RocheLimitFluid(constants.EarthMass, units.Kilogram(7.342e22), units.Kilometer(1737.4))
+func RocheLimitFluid(primary, satellite units.Mass, satelliteRadius units.Length) (units.Length, error) {
+	if err := checkPair(primary, satellite); err != nil {
+		return units.Length{}, err
+	}
+	if !(satelliteRadius.Val() > 0) {
+		return units.Length{}, fmt.Errorf("satellite radius must be positive, got %g m", satelliteRadius.Val())
+	}
+	return units.Meter(2.44 * satelliteRadius.Val() * math.Cbrt(primary.Val()/satellite.Val())), nil
+}
+
+// HillRadius returns the radius of the Hill sphere of a secondary of mass
+// m orbiting a primary of mass M ≫ m with semi-major axis a and
+// eccentricity e, within which the secondary can hold satellites.
+//
+// Formula:
+//
+//	r_H ≈ a (1 - e) (m / 3M)^(1/3)
+//
+// Example:
+//
+//	r, _ := orbit.HillRadius(constants.SolarMass, constants.EarthMass, constants.AstronomicalUnit, 0.0167) // ≈ 1.47e6 km
+func HillRadius(primary, secondary units.Mass, a units.Length, e float64) (units.Length, error) {
+	if err := checkPair(primary, secondary); err != nil {
+		return units.Length{}, err
+	}
+	if !(a.Val() > 0) || !(e >= 0 && e < 1) {
+		return units.Length{}, fmt.Errorf("orbit must be elliptic with a > 0, got a = %g m, e = %g", a.Val(), e)
+	}
+	return units.Meter(a.Val() * (1 - e) * math.Cbrt(secondary.Val()/(3*primary.Val()))), nil
+}
+
+// SphereOfInfluence returns Laplace's sphere-of-influence radius of a
+// secondary of mass m orbiting a primary of mass M at distance a, the
+// boundary used to switch central bodies in patched-conic trajectories.
+//
+// Formula:
+//
+//	r_SOI = a (m/M)^(2/5)
+func SphereOfInfluence(primary, secondary units.Mass, a units.Length) (units.Length, error) {
+	if err := checkPair(primary, secondary); err != nil {
+		return units.Length{}, err
+	}
+	if !(a.Val() > 0) {
+		return units.Length{}, fmt.Errorf("orbital distance must be positive, got %g m", a.Val())
+	}
+	return units.Meter(a.Val() * math.Pow(secondary.Val()/primary.Val(), 0.4)), nil
+}
+
+// TidalAcceleration returns the difference in gravitational acceleration
+// toward a body of mass M across a separation Δr along the line to it, at
+// distance d ≫ Δr. For Δr equal to a planet's radius this is the
+// acceleration raising its tides.
+//
+// Formula:
+//
+//	Δg ≈ 2GM Δr / d³
+func TidalAcceleration(m units.Mass, d, span units.Length) (units.Acceleration, error) {
+	if !(d.Val() > 0) {
+		return units.Acceleration{}, fmt.Errorf("distance must be positive, got %g m", d.Val())
+	}
+	return units.MeterPerSecond2(2 * gravitationalParameter(m) * span.Val() / math.Pow(d.Val(), 3)), nil
+}
+
+func checkPair(primary, secondary units.Mass) error {
+	if !(primary.Val() > 0) || !(secondary.Val() > 0) {
+		return fmt.Errorf("masses must be positive, got %g kg and %g kg", primary.Val(), secondary.Val())
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package orbit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestRocheLimit(t *testing.T) {
+	moon, rm := units.Kilogram(7.342e22), units.Kilometer(1737.4)
+	rigid, err := RocheLimitRigid(constants.EarthMass, moon, rm)
+	if err != nil {
+		t.Fatalf("RocheLimitRigid() error = %v", err)
+	}
+	fluid, _ := RocheLimitFluid(constants.EarthMass, moon, rm)
+	if !almostEqual(fluid.Val(), 18.37e6, 1e-3) {
+		t.Errorf("fluid Roche limit = %v, want ≈ 18 370 km", fluid)
+	}
+	// Density form: d = 1.26 R_M (ρ_M/ρ_m)^(1/3) for the rigid case.
+	rhoE := constants.EarthMass.Val() / (4 * math.Pi / 3 * math.Pow(constants.EarthRadius.Val(), 3))
+	rhoM := moon.Val() / (4 * math.Pi / 3 * math.Pow(rm.Val(), 3))
+	if want := constants.EarthRadius.Val() * math.Cbrt(2*rhoE/rhoM); !almostEqual(rigid.Val(), want, 1e-12) {
+		t.Errorf("rigid Roche limit = %v, want %v", rigid, want)
+	}
+	if fluid.Val() <= rigid.Val() {
+		t.Error("fluid Roche limit should exceed the rigid one")
+	}
+	if _, err := RocheLimitRigid(constants.EarthMass, moon, units.Meter(0)); err == nil {
+		t.Error("RocheLimitRigid should reject zero radius")
+	}
+}
+
+func TestHillAndInfluence(t *testing.T) {
+	au := constants.AstronomicalUnit
+	r, err := HillRadius(constants.SolarMass, constants.EarthMass, au, 0)
+	if err != nil || !almostEqual(r.Val(), 1.4965e9, 1e-3) {
+		t.Errorf("Earth Hill radius = %v, %v; want ≈ 1.50e6 km", r, err)
+	}
+	if re, _ := HillRadius(constants.SolarMass, constants.EarthMass, au, 0.0167); !almostEqual(re.Val(), (1-0.0167)*r.Val(), 1e-12) {
+		t.Errorf("eccentric Hill radius = %v", re)
+	}
+	soi, err := SphereOfInfluence(constants.SolarMass, constants.EarthMass, au)
+	if err != nil || !almostEqual(soi.Val(), 9.25e8, 2e-3) {
+		t.Errorf("Earth SOI = %v, %v; want ≈ 925 000 km", soi, err)
+	}
+	// The Moon lies inside both.
+	if d := 3.844e8; d > soi.Val() || d > r.Val() {
+		t.Error("the Moon should lie inside Earth's Hill sphere and SOI")
+	}
+	if _, err := HillRadius(constants.SolarMass, constants.EarthMass, au, 1); err == nil {
+		t.Error("HillRadius should reject e = 1")
+	}
+	if _, err := SphereOfInfluence(units.Kilogram(0), constants.EarthMass, au); err == nil {
+		t.Error("SphereOfInfluence should reject zero mass")
+	}
+}
+
+func TestTidalAcceleration(t *testing.T) {
+	// Lunar tide on Earth ≈ 1.1e-6 m/s²; solar tide is about 0.46 of it.
+	moon, err := TidalAcceleration(units.Kilogram(7.342e22), units.Meter(3.844e8), constants.EarthRadius)
+	if err != nil || !almostEqual(moon.Val(), 1.10e-6, 1e-2) {
+		t.Errorf("lunar tide = %v, %v", moon, err)
+	}
+	sun, _ := TidalAcceleration(constants.SolarMass, constants.AstronomicalUnit, constants.EarthRadius)
+	if r := sun.Val() / moon.Val(); !almostEqual(r, 0.46, 0.02) {
+		t.Errorf("solar/lunar tide = %v, want ≈ 0.46", r)
+	}
+	// Agrees with the exact difference for a small span.
+	g := func(d float64) float64 { return gravitationalParameter(constants.EarthMass) / (d * d) }
+	d, dr := 1e8, 1e3
+	exact := g(d-dr/2) - g(d+dr/2)
+	if a, _ := TidalAcceleration(constants.EarthMass, units.Meter(d), units.Meter(dr)); !almostEqual(a.Val(), exact, 1e-9) {
+		t.Errorf("TidalAcceleration = %v, exact %v", a, exact)
+	}
+	if _, err := TidalAcceleration(constants.EarthMass, units.Meter(0), units.Meter(1)); err == nil {
+		t.Error("TidalAcceleration should reject zero distance")
+	}
+}
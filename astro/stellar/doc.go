@@ -0,0 +1,40 @@
+// Package stellar provides order-of-magnitude relations of stellar
+// structure and evolution: the main-sequence mass-luminosity relation and
+// lifetime, the Eddington and Chandrasekhar limits, Jeans instability of
+// gas clouds, the Stefan-Boltzmann relation between luminosity, radius
+// and effective temperature, and the irradiance and radiative equilibrium
+// temperature of a planet.
+//
+// Masses, luminosities and radii are unit-typed; use units.SolarMass and
+// constants.SolarLuminosity and constants.SolarRadius for solar units. The
+// mean molecular weight μ is the mean particle mass in atomic mass units:
+// about 0.6 for fully ionized solar gas and 2.3 for cold molecular clouds.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/astro/stellar"
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	l, _ := stellar.Luminosity(units.SolarMass(2))            // ≈ 16 L☉
+//	life, _ := stellar.MainSequenceLifetime(units.SolarMass(2)) // ≈ 1.26 Gyr
+//	mch, _ := stellar.ChandrasekharMass(2)                      // ≈ 1.44 M☉
+//
+//	// Sun's effective temperature
+//	teff, _ := stellar.EffectiveTemperature(constants.SolarLuminosity, constants.SolarRadius) // ≈ 5772 K
+//
+//	// Earth's equilibrium temperature, without the greenhouse effect
+//	teq, _ := stellar.EquilibriumTemperature(constants.SolarLuminosity, units.AstronomicalUnit(1), constants.EarthBondAlbedo) // ≈ 254 K
+//
+//	// A 10 K molecular cloud core at n(H₂) = 10⁴ cm⁻³
+//	mj, _ := stellar.JeansMass(units.Kelvin(10), units.KilogramPerMeter3(3.8e-17), 2.3) // ≈ 5.6 M☉
+//
+// References:
+//   - Carroll, Ostlie. "An Introduction to Modern Astrophysics", 2nd ed.,
+//     Ch. 3, 10, 12, 16
+//   - Duric. "Advanced Astrophysics" (2004), §1.4 (mass-luminosity relation)
+//   - Chandrasekhar. "The maximum mass of ideal white dwarfs", ApJ 74, 81
+//     (1931)
+package stellar
@@ -0,0 +1,205 @@
+package stellar
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/particle/scattering"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	c     = constants.SpeedOfLight.Val()
+	g     = constants.GravitationalConstant.Val()
+	kB    = constants.BoltzmannConstant.Val()
+	sigma = constants.StefanBoltzmannConstant.Val()
+	mu    = constants.AtomicMassUnit.Val()
+
+	proton, _ = particles.Lookup("p")
+)
+
+// -----------------------------------------------------------------------------
+// Main Sequence
+// -----------------------------------------------------------------------------
+
+// Luminosity returns the main-sequence luminosity of a star of mass M from
+// the piecewise power-law mass-luminosity relation. Returns an error if M
+// is not positive.
+//
+// Formula, in solar units:
+//
+//	L = 0.23 M^2.3    M < 0.43
+//	L = M⁴            0.43 ≤ M < 2
+//	L = 1.4 M^3.5     2 ≤ M < 55
+//	L = 32000 M       M ≥ 55
+func Luminosity(m units.Mass) (units.Power, error) {
+	x := m.ToSolarMasses()
+	if !(x > 0) {
+		return units.Power{}, fmt.Errorf("mass must be positive, got %g kg", m.Val())
+	}
+	var l float64
+	switch {
+	case x < 0.43:
+		l = 0.23 * math.Pow(x, 2.3)
+	case x < 2:
+		l = math.Pow(x, 4)
+	case x < 55:
+		l = 1.4 * math.Pow(x, 3.5)
+	default:
+		l = 32000 * x
+	}
+	return units.Watt(l * constants.SolarLuminosity.Val()), nil
+}
+
+// MainSequenceLifetime returns the hydrogen-burning lifetime of a star of
+// mass M, scaling the Sun's 10 Gyr by its fuel supply over its luminosity.
+// Returns an error if M is not positive.
+//
+// Formula:
+//
+//	t = 10 Gyr (M/M☉) / (L/L☉)
+func MainSequenceLifetime(m units.Mass) (units.Time, error) {
+	l, err := Luminosity(m)
+	if err != nil {
+		return units.Time{}, err
+	}
+	return units.Year(1e10 * m.ToSolarMasses() / (l.Val() / constants.SolarLuminosity.Val())), nil
+}
+
+// -----------------------------------------------------------------------------
+// Limits
+// -----------------------------------------------------------------------------
+
+// EddingtonLuminosity returns the luminosity at which radiation pressure on
+// free electrons balances gravity on ionized hydrogen around a mass M.
+//
+// Formula:
+//
+//	L_Edd = 4π G M m_p c / σ_T
+func EddingtonLuminosity(m units.Mass) units.Power {
+	sigmaT := scattering.ThomsonCrossSection().Val()
+	return units.Watt(4 * math.Pi * g * m.Val() * proton.Mass.Value().Val() * c / sigmaT)
+}
+
+// ChandrasekharMass returns the maximum mass of a white dwarf supported by
+// relativistic electron degeneracy pressure, for μ_e nucleons per electron
+// (2 for helium, carbon and oxygen). Returns an error if μ_e is not
+// positive.
+//
+// Formula:
+//
+//	M_Ch = (ω₃⁰ √(3π) / 2) (ħc/G)^(3/2) / (μ_e m_u)²,   ω₃⁰ ≈ 2.01824
+func ChandrasekharMass(muE float64) (units.Mass, error) {
+	if !(muE > 0) {
+		return units.Mass{}, fmt.Errorf("mean molecular weight per electron must be positive, got %g", muE)
+	}
+	const omega3 = 2.018236 // Lane-Emden n = 3 mass constant
+	m := muE * mu
+	return units.Kilogram(omega3 * math.Sqrt(3*math.Pi) / 2 * math.Pow(constants.HbarC.Val()/g, 1.5) / (m * m)), nil
+}
+
+// -----------------------------------------------------------------------------
+// Jeans Instability
+// -----------------------------------------------------------------------------
+
+// JeansLength returns the radius above which a uniform cloud of temperature
+// T, density ρ and mean molecular weight μ collapses under its own gravity.
+// Returns an error if T, ρ or μ is not positive.
+//
+// Formula:
+//
+//	R_J = √(15 k_B T / (4π G μ m_u ρ))
+func JeansLength(t units.Temperature, rho units.Density, meanWeight float64) (units.Length, error) {
+	if err := checkCloud(t, rho, meanWeight); err != nil {
+		return units.Length{}, err
+	}
+	return units.Meter(math.Sqrt(15 * kB * t.Val() / (4 * math.Pi * g * meanWeight * mu * rho.Val()))), nil
+}
+
+// JeansMass returns the mass (4π/3) ρ R_J³ of a cloud at the Jeans length,
+// above which it collapses. Returns an error if T, ρ or μ is not positive.
+//
+// Formula:
+//
+//	M_J = (5 k_B T / (G μ m_u))^(3/2) (3 / (4π ρ))^(1/2)
+func JeansMass(t units.Temperature, rho units.Density, meanWeight float64) (units.Mass, error) {
+	if err := checkCloud(t, rho, meanWeight); err != nil {
+		return units.Mass{}, err
+	}
+	return units.Kilogram(math.Pow(5*kB*t.Val()/(g*meanWeight*mu), 1.5) * math.Sqrt(3/(4*math.Pi*rho.Val()))), nil
+}
+
+func checkCloud(t units.Temperature, rho units.Density, meanWeight float64) error {
+	if !(t.Val() > 0) || !(rho.Val() > 0) || !(meanWeight > 0) {
+		return fmt.Errorf("temperature, density and mean molecular weight must be positive, got %g K, %g kg/m³ and %g", t.Val(), rho.Val(), meanWeight)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Stefan-Boltzmann Relations
+// -----------------------------------------------------------------------------
+
+// EffectiveTemperature returns the temperature of a blackbody sphere of
+// radius R radiating luminosity L. Returns an error if L or R is not
+// positive.
+//
+// Formula:
+//
+//	T_eff = (L / (4π R² σ))^(1/4)
+func EffectiveTemperature(l units.Power, r units.Length) (units.Temperature, error) {
+	if !(l.Val() > 0) || !(r.Val() > 0) {
+		return units.Temperature{}, fmt.Errorf("luminosity and radius must be positive, got %g W and %g m", l.Val(), r.Val())
+	}
+	return units.Kelvin(math.Pow(l.Val()/(4*math.Pi*r.Val()*r.Val()*sigma), 0.25)), nil
+}
+
+// Radius returns the radius R = √(L / (4π σ T⁴)) of a blackbody sphere
+// with luminosity L and effective temperature T. Returns an error if L or T
+// is not positive.
+func Radius(l units.Power, t units.Temperature) (units.Length, error) {
+	if !(l.Val() > 0) || !(t.Val() > 0) {
+		return units.Length{}, fmt.Errorf("luminosity and temperature must be positive, got %g W and %g K", l.Val(), t.Val())
+	}
+	t2 := t.Val() * t.Val()
+	return units.Meter(math.Sqrt(l.Val() / (4 * math.Pi * sigma * t2 * t2))), nil
+}
+
+// -----------------------------------------------------------------------------
+// Planetary Equilibrium
+// -----------------------------------------------------------------------------
+
+// Irradiance returns the flux S = L / (4π d²) received at distance d from
+// a star of luminosity L. Returns an error if L or d is not positive.
+func Irradiance(l units.Power, d units.Length) (units.Irradiance, error) {
+	if !(l.Val() > 0) || !(d.Val() > 0) {
+		return units.Irradiance{}, fmt.Errorf("luminosity and distance must be positive, got %g W and %g m", l.Val(), d.Val())
+	}
+	return units.WattPerMeter2(l.Val() / (4 * math.Pi * d.Val() * d.Val())), nil
+}
+
+// EquilibriumTemperature returns the radiative equilibrium temperature of
+// a planet at distance d from a star of luminosity L, reflecting the
+// fraction albedo of the light it intercepts. The planet absorbs over its
+// cross-section πR² and, rotating fast, radiates as a blackbody over its
+// whole surface 4πR², so R cancels. Returns an error if L or d is not
+// positive or the albedo is outside [0, 1).
+//
+// Formula:
+//
+//	T_eq = (L (1 - A) / (16π σ d²))^(1/4) = (S (1 - A) / 4σ)^(1/4)
+//
+// Earth, with constants.SolarLuminosity, 1 au and
+// constants.EarthBondAlbedo, comes to 254 K.
+func EquilibriumTemperature(l units.Power, d units.Length, albedo float64) (units.Temperature, error) {
+	if !(albedo >= 0 && albedo < 1) {
+		return units.Temperature{}, fmt.Errorf("albedo must be in [0, 1), got %g", albedo)
+	}
+	s, err := Irradiance(l, d)
+	if err != nil {
+		return units.Temperature{}, err
+	}
+	return units.Kelvin(math.Pow(s.Val()*(1-albedo)/(4*sigma), 0.25)), nil
+}
@@ -0,0 +1,141 @@
+package stellar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestMainSequence(t *testing.T) {
+	lsun := constants.SolarLuminosity.Val()
+	cases := []struct{ m, l float64 }{
+		{0.2, 0.23 * math.Pow(0.2, 2.3)},
+		{1, 1},
+		{2, 1.4 * math.Pow(2, 3.5)},
+		{10, 1.4 * math.Pow(10, 3.5)},
+		{100, 3.2e6},
+	}
+	for _, tc := range cases {
+		l, err := Luminosity(units.SolarMass(tc.m))
+		if err != nil || !almostEqual(l.Val()/lsun, tc.l, 1e-9) {
+			t.Errorf("L(%v M☉) = %v L☉, %v; want %v", tc.m, l.Val()/lsun, err, tc.l)
+		}
+	}
+	// Luminosity increases with mass across the segments.
+	prev := 0.0
+	for m := 0.1; m < 100; m *= 1.1 {
+		l, _ := Luminosity(units.SolarMass(m))
+		if l.Val() <= prev {
+			t.Errorf("L not increasing at %v M☉", m)
+		}
+		prev = l.Val()
+	}
+
+	if life, _ := MainSequenceLifetime(units.SolarMass(1)); !almostEqual(life.Val(), units.Year(1e10).Val(), 1e-12) {
+		t.Errorf("solar lifetime = %v, want 10 Gyr", life)
+	}
+	// Massive stars burn out fast: 10 M☉ lasts ≈ 23 Myr.
+	if life, _ := MainSequenceLifetime(units.SolarMass(10)); !almostEqual(life.Val()/units.Year(1e6).Val(), 22.6, 1e-2) {
+		t.Errorf("10 M☉ lifetime = %v Myr", life.Val()/units.Year(1e6).Val())
+	}
+	if _, err := Luminosity(units.Kilogram(0)); err == nil {
+		t.Error("Luminosity should reject zero mass")
+	}
+}
+
+func TestLimits(t *testing.T) {
+	// L_Edd ≈ 1.26e31 W (M/M☉) ≈ 3.3e4 L☉.
+	le := EddingtonLuminosity(units.SolarMass(1))
+	if !almostEqual(le.Val(), 1.257e31, 2e-3) {
+		t.Errorf("L_Edd(M☉) = %v, want ≈ 1.257e31 W", le)
+	}
+	mch, err := ChandrasekharMass(2)
+	if err != nil || !almostEqual(mch.ToSolarMasses(), 1.44, 0.015) {
+		t.Errorf("M_Ch(μ_e = 2) = %v M☉, %v; want ≈ 1.44", mch.ToSolarMasses(), err)
+	}
+	// M_Ch ∝ 1/μ_e²: iron-rich cores have a lower limit.
+	if fe, _ := ChandrasekharMass(56.0 / 26); !almostEqual(fe.Val()/mch.Val(), math.Pow(2*26/56.0, 2), 1e-12) {
+		t.Errorf("M_Ch(Fe)/M_Ch(C) = %v", fe.Val()/mch.Val())
+	}
+	if _, err := ChandrasekharMass(0); err == nil {
+		t.Error("ChandrasekharMass should reject μ_e = 0")
+	}
+}
+
+func TestJeans(t *testing.T) {
+	temp, rho := units.Kelvin(10), units.KilogramPerMeter3(3.8e-17)
+	rj, err := JeansLength(temp, rho, 2.3)
+	if err != nil {
+		t.Fatalf("JeansLength() error = %v", err)
+	}
+	mj, _ := JeansMass(temp, rho, 2.3)
+	if !almostEqual(mj.Val(), 4*math.Pi/3*rho.Val()*math.Pow(rj.Val(), 3), 1e-12) {
+		t.Errorf("M_J = %v, want (4π/3)ρR_J³", mj)
+	}
+	if !almostEqual(mj.ToSolarMasses(), 5.62, 1e-3) {
+		t.Errorf("M_J = %v M☉, want ≈ 5.62", mj.ToSolarMasses())
+	}
+	// M_J ∝ T^(3/2) ρ^(-1/2).
+	hot, _ := JeansMass(units.Kelvin(40), rho, 2.3)
+	dense, _ := JeansMass(temp, units.KilogramPerMeter3(4*3.8e-17), 2.3)
+	if !almostEqual(hot.Val()/mj.Val(), 8, 1e-12) || !almostEqual(dense.Val()/mj.Val(), 0.5, 1e-12) {
+		t.Errorf("scaling: hot %v, dense %v", hot.Val()/mj.Val(), dense.Val()/mj.Val())
+	}
+	if _, err := JeansMass(temp, rho, 0); err == nil {
+		t.Error("JeansMass should reject μ = 0")
+	}
+}
+
+func TestStefanBoltzmann(t *testing.T) {
+	teff, err := EffectiveTemperature(constants.SolarLuminosity, constants.SolarRadius)
+	if err != nil || !almostEqual(teff.Val(), 5772, 1e-3) {
+		t.Errorf("T_eff(Sun) = %v, %v; want ≈ 5772 K", teff, err)
+	}
+	r, _ := Radius(constants.SolarLuminosity, teff)
+	if !almostEqual(r.Val(), constants.SolarRadius.Val(), 1e-12) {
+		t.Errorf("Radius(L☉, T☉) = %v, want R☉", r)
+	}
+	if _, err := EffectiveTemperature(units.Watt(1), units.Meter(0)); err == nil {
+		t.Error("EffectiveTemperature should reject zero radius")
+	}
+	if _, err := Radius(units.Watt(1), units.Kelvin(0)); err == nil {
+		t.Error("Radius should reject zero temperature")
+	}
+}
+
+func TestEquilibriumTemperature(t *testing.T) {
+	au := units.AstronomicalUnit(1)
+	s, err := Irradiance(constants.SolarLuminosity, au)
+	if err != nil || !almostEqual(s.Val(), constants.SolarConstant.Val(), 1e-3) {
+		t.Errorf("Irradiance(L☉, 1 au) = %v, %v; want ≈ %v", s, err, constants.SolarConstant)
+	}
+
+	// Earth: 254 K; Mars at 1.524 au with A = 0.25: 210 K
+	earth, err := EquilibriumTemperature(constants.SolarLuminosity, au, constants.EarthBondAlbedo)
+	if err != nil || !almostEqual(earth.Val(), 254, 2e-3) {
+		t.Errorf("T_eq(Earth) = %v, %v; want ≈ 254 K", earth, err)
+	}
+	mars, _ := EquilibriumTemperature(constants.SolarLuminosity, units.AstronomicalUnit(1.524), 0.25)
+	if !almostEqual(mars.Val(), 210, 3e-3) {
+		t.Errorf("T_eq(Mars) = %v, want ≈ 210 K", mars)
+	}
+
+	// T ∝ d^(-1/2)
+	far, _ := EquilibriumTemperature(constants.SolarLuminosity, units.AstronomicalUnit(4), constants.EarthBondAlbedo)
+	if !almostEqual(far.Val(), earth.Val()/2, 1e-12) {
+		t.Errorf("T_eq(4 au) = %v, want half of %v", far, earth)
+	}
+
+	if _, err := EquilibriumTemperature(constants.SolarLuminosity, au, 1); err == nil {
+		t.Error("EquilibriumTemperature should reject albedo 1")
+	}
+	if _, err := Irradiance(constants.SolarLuminosity, units.Meter(0)); err == nil {
+		t.Error("Irradiance should reject zero distance")
+	}
+}
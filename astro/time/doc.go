@@ -0,0 +1,35 @@
+// Package time converts between calendar instants and the continuous day
+// counts used in astronomy, and computes sidereal time.
+//
+// The Julian Date (JD) counts days from noon UT on 1 January 4713 BC
+// (proleptic Julian calendar). The Modified Julian Date is MJD = JD -
+// 2400000.5 and starts at midnight. Julian centuries from J2000.0
+// (JD 2451545.0) are the time argument of precession, nutation and
+// sidereal-time series.
+//
+// Instants are Go time.Time values. Because a time.Time has no leap-second
+// or TT/UT1 distinction, the conversions treat UTC, UT1 and TT as equal.
+// The resulting errors, under 70 s, are ignored. Import the package under
+// an alias when the standard library time package is also needed.
+//
+// Example usage:
+//
+//	import (
+//	    "time"
+//
+//	    atime "github.com/sakiphan/qsim-core/astro/time"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	t := time.Date(1987, time.April, 10, 19, 21, 0, 0, time.UTC)
+//	jd := atime.JulianDate(t)              // 2446896.30625
+//	gmst := atime.GreenwichSiderealTime(t) // 8h34m57.09s
+//
+//	// Local sidereal time in Washington
+//	lst := atime.LocalSiderealTime(t, units.Degree(-77.0656))
+//
+// References:
+//   - Meeus. "Astronomical Algorithms", 2nd ed., Ch. 7 and 12
+//   - Urban, Seidelmann (eds.). "Explanatory Supplement to the
+//     Astronomical Almanac", 3rd ed., Ch. 3
+package time
@@ -0,0 +1,103 @@
+package time
+
+import (
+	"math"
+	"time"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+const (
+	// J2000 is the Julian Date of the standard epoch J2000.0,
+	// 2000 January 1, 12:00.
+	J2000 = 2451545.0
+
+	// MJDOffset is the difference JD - MJD.
+	MJDOffset = 2400000.5
+
+	// DaysPerJulianCentury is the length of a Julian century in days.
+	DaysPerJulianCentury = 36525.0
+
+	// unixEpoch is the Julian Date of 1970 January 1, 00:00 UTC.
+	unixEpoch = 2440587.5
+)
+
+// -----------------------------------------------------------------------------
+// Julian Dates
+// -----------------------------------------------------------------------------
+
+// JulianDate returns the Julian Date of t.
+func JulianDate(t time.Time) float64 {
+	return unixEpoch + float64(t.Unix())/86400 + float64(t.Nanosecond())/86400e9
+}
+
+// FromJulianDate returns the UTC instant with the given Julian Date. A
+// float64 Julian Date resolves times to about 40 µs.
+func FromJulianDate(jd float64) time.Time {
+	s := (jd - unixEpoch) * 86400
+	sec := math.Floor(s)
+	return time.Unix(int64(sec), int64(math.Round((s-sec)*1e9))).UTC()
+}
+
+// ModifiedJulianDate returns the Modified Julian Date of t.
+func ModifiedJulianDate(t time.Time) float64 {
+	return JulianDate(t) - MJDOffset
+}
+
+// FromModifiedJulianDate returns the UTC instant with the given Modified
+// Julian Date.
+func FromModifiedJulianDate(mjd float64) time.Time {
+	return FromJulianDate(mjd + MJDOffset)
+}
+
+// JulianCenturies returns the number of Julian centuries of 36525 days
+// from J2000.0 to t.
+//
+// Formula:
+//
+//	T = (JD - 2451545.0) / 36525
+func JulianCenturies(t time.Time) float64 {
+	return (JulianDate(t) - J2000) / DaysPerJulianCentury
+}
+
+// JulianEpoch returns t as a Julian epoch year, such as 2000.0 for J2000.
+func JulianEpoch(t time.Time) float64 {
+	return 2000 + (JulianDate(t)-J2000)/365.25
+}
+
+// -----------------------------------------------------------------------------
+// Sidereal Time
+// -----------------------------------------------------------------------------
+
+// GreenwichSiderealTime returns the Greenwich mean sidereal time at t, in
+// [0, 2π) (IAU 1982, Meeus 12.4).
+//
+// Formula:
+//
+//	θ₀ = 280.46061837° + 360.98564736629° (JD - 2451545) + 0.000387933° T² - T³/38710000°
+//
+// Example:
+//
+//	gmst := atime.GreenwichSiderealTime(time.Date(1987, 4, 10, 0, 0, 0, 0, time.UTC))
+//	// gmst.ToHourAngle() ≈ 13.179546 (13h10m46.37s)
+func GreenwichSiderealTime(t time.Time) units.Angle {
+	d := JulianDate(t) - J2000
+	T := d / DaysPerJulianCentury
+	deg := 280.46061837 + 360.98564736629*d + T*T*(0.000387933-T/38710000)
+	return units.Radian(normalize(units.Degree(deg).Val()))
+}
+
+// LocalSiderealTime returns the local mean sidereal time at t for an
+// observer at the given longitude, positive east, in [0, 2π).
+func LocalSiderealTime(t time.Time, longitude units.Angle) units.Angle {
+	return units.Radian(normalize(GreenwichSiderealTime(t).Val() + longitude.Val()))
+}
+
+// normalize wraps an angle in radians into [0, 2π).
+func normalize(x float64) float64 {
+	x = math.Mod(x, 2*math.Pi)
+	if x < 0 {
+		x += 2 * math.Pi
+	}
+	return x
+}
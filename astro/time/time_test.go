@@ -0,0 +1,72 @@
+package time
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestJulianDate(t *testing.T) {
+	// Meeus, Ch. 7.
+	tests := []struct {
+		t    time.Time
+		want float64
+	}{
+		{time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), 2451545.0},
+		{time.Date(1987, time.January, 27, 0, 0, 0, 0, time.UTC), 2446822.5},
+		{time.Date(1988, time.June, 19, 12, 0, 0, 0, time.UTC), 2447332.0},
+		{time.Date(1600, time.December, 31, 0, 0, 0, 0, time.UTC), 2305812.5},
+		{time.Date(1957, time.October, 4, 19, 26, 24, 0, time.UTC), 2436116.31},
+	}
+	for _, tt := range tests {
+		if got := JulianDate(tt.t); !almostEqual(got, tt.want, 1e-13) {
+			t.Errorf("JulianDate(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+		if back := FromJulianDate(tt.want); back.Sub(tt.t).Abs() > 50*time.Microsecond {
+			t.Errorf("FromJulianDate(%v) = %v, want %v", tt.want, back, tt.t)
+		}
+	}
+	if mjd := ModifiedJulianDate(time.Date(1858, time.November, 17, 0, 0, 0, 0, time.UTC)); mjd != 0 {
+		t.Errorf("MJD of 1858-11-17 = %v, want 0", mjd)
+	}
+	if d := FromModifiedJulianDate(51544.5); !d.Equal(time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("FromModifiedJulianDate(51544.5) = %v", d)
+	}
+}
+
+func TestJulianCenturies(t *testing.T) {
+	at := time.Date(2100, time.January, 1, 12, 0, 0, 0, time.UTC)
+	if T := JulianCenturies(at); T != 1 {
+		t.Errorf("JulianCenturies(2100) = %v", T)
+	}
+	if e := JulianEpoch(time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)); e != 2000 {
+		t.Errorf("JulianEpoch(J2000) = %v, want 2000", e)
+	}
+}
+
+func TestSiderealTime(t *testing.T) {
+	// Meeus, Ex. 12.a and 12.b.
+	midnight := time.Date(1987, time.April, 10, 0, 0, 0, 0, time.UTC)
+	if h := GreenwichSiderealTime(midnight).ToHourAngle(); !almostEqual(h, 13+10.0/60+46.3668/3600, 1e-9) {
+		t.Errorf("GMST at 0h = %vh, want 13h10m46.3668s", h)
+	}
+	evening := time.Date(1987, time.April, 10, 19, 21, 0, 0, time.UTC)
+	if h := GreenwichSiderealTime(evening).ToHourAngle(); !almostEqual(h, 8+34.0/60+57.0896/3600, 1e-8) {
+		t.Errorf("GMST at 19:21 = %vh, want 8h34m57.0896s", h)
+	}
+	// A sidereal day is about 3m56s shorter than a solar day.
+	next := GreenwichSiderealTime(midnight.Add(time.Duration(86164.0905 * float64(time.Second))))
+	if d := math.Abs(math.Remainder(next.Val()-GreenwichSiderealTime(midnight).Val(), 2*math.Pi)); d > units.ArcSecond(0.1).Val() {
+		t.Errorf("GMST drift over one sidereal day = %v rad", d)
+	}
+	lst := LocalSiderealTime(midnight, units.Degree(-90))
+	if want := math.Mod(GreenwichSiderealTime(midnight).ToHourAngle()-6+24, 24); !almostEqual(lst.ToHourAngle(), want, 1e-12) {
+		t.Errorf("LST at 90°W = %vh, want %vh", lst.ToHourAngle(), want)
+	}
+}
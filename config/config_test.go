@@ -0,0 +1,231 @@
+package config
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+const earthMoonYAML = `
+# Earth–Moon system
+name: earth-moon
+integrator: rk4
+step: 1 h
+duration: 27.3 d
+output:
+  every: 6 h
+  path: orbit.parquet
+bodies:
+  - name: earth
+    mass: 5.972e24 kg
+  - name: moon
+    mass: 7.342e22 kg   # lunar mass
+    position: [384400 km, 0, 0]
+    velocity:
+      - 0 m/s
+      - 1.022 km/s
+      - 0 m/s
+forces:
+  - type: gravity
+    softening: 10 km
+  - type: spring
+    bodies: [earth, moon]
+    stiffness: 2 N/m
+    rest_length: "384400 km"
+  - type: lorentz
+    B: [0 T, 0 T, 50 µT]
+`
+
+// -----------------------------------------------------------------------------
+// Scenario Tests
+// -----------------------------------------------------------------------------
+
+func TestParseYAMLScenario(t *testing.T) {
+	s, err := Parse([]byte(earthMoonYAML))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if s.Name != "earth-moon" || s.Integrator != "rk4" {
+		t.Errorf("name, integrator = %q, %q", s.Name, s.Integrator)
+	}
+	if s.Step.Val() != 3600 || !almostEqual(s.Duration.Val(), 27.3*86400, 1e-6) {
+		t.Errorf("step, duration = %v, %v", s.Step, s.Duration)
+	}
+	if s.Steps() != 656 || s.OutputStride() != 6 {
+		t.Errorf("Steps(), OutputStride() = %d, %d, want 656, 6", s.Steps(), s.OutputStride())
+	}
+	if s.Output.Path != "orbit.parquet" || s.Output.Format != "parquet" {
+		t.Errorf("output = %+v", s.Output)
+	}
+	if len(s.Bodies) != 2 {
+		t.Fatalf("len(Bodies) = %d, want 2", len(s.Bodies))
+	}
+	moon := s.Bodies[1]
+	if moon.Name != "moon" || moon.Mass.Val() != 7.342e22 {
+		t.Errorf("moon = %+v", moon)
+	}
+	if moon.Position.X.Val() != 3.844e8 || moon.Position.Dim() != units.Meter(1).Dim() {
+		t.Errorf("moon position = %v", moon.Position)
+	}
+	if !almostEqual(moon.Velocity.Y.Val(), 1022, 1e-9) || moon.Velocity.Dim() != units.MeterPerSecond(1).Dim() {
+		t.Errorf("moon velocity = %v", moon.Velocity)
+	}
+	if s.Bodies[0].Position.Dim() != units.Meter(1).Dim() || !s.Bodies[0].Velocity.IsZero() {
+		t.Errorf("earth defaults = %v, %v", s.Bodies[0].Position, s.Bodies[0].Velocity)
+	}
+
+	if len(s.Forces) != 3 {
+		t.Fatalf("len(Forces) = %d, want 3", len(s.Forces))
+	}
+	if soft, ok := s.Forces[0].Param("softening"); !ok || soft.Val() != 1e4 {
+		t.Errorf("gravity softening = %v, %v", soft, ok)
+	}
+	spring := s.Forces[1]
+	if len(spring.Bodies) != 2 || spring.Bodies[1] != "moon" {
+		t.Errorf("spring bodies = %v", spring.Bodies)
+	}
+	if k, _ := spring.Param("stiffness"); k.Dim() != units.NewtonPerMeter(1).Dim() {
+		t.Errorf("stiffness = %v", k)
+	}
+	if b, ok := s.Forces[2].Vector("B"); !ok || !almostEqual(b.Z.Val(), 5e-5, 1e-18) {
+		t.Errorf("lorentz B = %v, %v", b, ok)
+	}
+
+	stepper, err := s.Stepper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := stepper.(*solver.RK4); !ok {
+		t.Errorf("Stepper() = %T, want *solver.RK4", stepper)
+	}
+}
+
+func TestParseJSONScenario(t *testing.T) {
+	src := `{
+		"step": "0.01 s",
+		"duration": "2 s",
+		"integrator": "euler",
+		"bodies": [{"name": "ball", "mass": "0.5 kg", "velocity": ["3 m/s", 0, "4 m/s"]}],
+		"forces": [
+			{"type": "uniform", "acceleration": [0, 0, "-9.81 m/s^2"]},
+			{"type": "drag", "quadratic": "0.01 kg/m"}
+		]
+	}`
+	s, err := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if s.Steps() != 200 || s.OutputStride() != 1 || s.Output.Format != "csv" {
+		t.Errorf("Steps(), OutputStride(), format = %d, %d, %q", s.Steps(), s.OutputStride(), s.Output.Format)
+	}
+	if _, ok := s.Forces[1].Param("quadratic"); !ok || s.Forces[1].Bodies != nil {
+		t.Errorf("drag = %+v", s.Forces[1])
+	}
+	if g, _ := s.Forces[0].Vector("acceleration"); g.Z.Val() != -9.81 {
+		t.Errorf("uniform acceleration = %v", g)
+	}
+	if stepper, _ := s.Stepper(); stepper == nil {
+		t.Error("Stepper() = nil")
+	}
+
+	// The same scenario loads from a .json file.
+	path := filepath.Join(t.TempDir(), "ball.json")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if loaded, err := Load(path); err != nil || loaded.Bodies[0].Velocity.Z.Val() != 4 {
+		t.Errorf("Load() = %v, %v", loaded, err)
+	}
+}
+
+func TestScenarioErrors(t *testing.T) {
+	base := "step: 1 s\nduration: 10 s\nbodies:\n  - name: a\n    mass: 1 kg\n  - name: b\n    mass: 1 kg\n"
+	tests := []struct {
+		name, src, want string
+	}{
+		{"missing step", "duration: 1 s\nbodies: [{name: a, mass: 1 kg}]", "step: required"},
+		{"wrong dimension", strings.Replace(base, "1 s", "1 m", 1), "step: expected units of s, got m"},
+		{"negative step", strings.Replace(base, "1 s", "-1 s", 1), "step: must be positive"},
+		{"unknown unit", strings.Replace(base, "10 s", "10 fortnights", 1), "duration:"},
+		{"unknown setting", base + "seed: 3\n", "seed: unknown setting"},
+		{"typo in body", strings.Replace(base, "mass: 1 kg\n  - name: b", "mas: 1 kg\n  - name: b", 1), "bodies[0].mas: unknown setting"},
+		{"duplicate body", strings.Replace(base, "name: b", "name: a", 1), "duplicate body"},
+		{"no bodies", "step: 1 s\nduration: 1 s\nbodies: []\n", "at least one body"},
+		{"bad integrator", base + "integrator: leapfrog\n", "unknown integrator"},
+		{"bad vector", base + "forces:\n  - type: uniform\n    acceleration: [1 m/s^2, 0]\n", "expected 3 components"},
+		{"vector units", base + "forces:\n  - type: lorentz\n    E: [1 T, 0, 0]\n", "forces[0].E[0]: expected units of"},
+		{"unknown force", base + "forces:\n  - type: magic\n", "unknown force \"magic\""},
+		{"unknown param", base + "forces:\n  - type: gravity\n    G: 1\n", "forces[0].G: unknown parameter"},
+		{"spring bodies", base + "forces:\n  - type: spring\n    bodies: [a]\n    stiffness: 1 N/m\n    rest_length: 1 m\n", "needs exactly 2 bodies"},
+		{"spring stiffness", base + "forces:\n  - type: spring\n    bodies: [a, b]\n    rest_length: 1 m\n", "stiffness: required"},
+		{"drag coefficient", base + "forces:\n  - type: drag\n", "at least one of linear, quadratic"},
+		{"unknown body", base + "forces:\n  - type: gravity\n    bodies: [a, c]\n", "unknown body \"c\""},
+		{"bad format", base + "output:\n  format: xls\n", "unknown format"},
+		{"bad yaml", base + "  oops\n", "line 8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.src))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// -----------------------------------------------------------------------------
+// YAML Tests
+// -----------------------------------------------------------------------------
+
+func TestParseYAML(t *testing.T) {
+	src := `---
+title: "a # not a comment"
+quote: 'it''s'
+list:
+- 1
+- two, three
+- [x, [y, z]]
+nested:
+  - key: v
+    other: {a: 1, b: [true, null]}
+  -
+    - deep
+empty:
+`
+	tree, err := parseYAML(src)
+	if err != nil {
+		t.Fatalf("parseYAML() error = %v", err)
+	}
+	m := tree.(map[string]any)
+	if m["title"] != "a # not a comment" || m["quote"] != "it's" || m["empty"] != nil {
+		t.Errorf("scalars = %q, %q, %v", m["title"], m["quote"], m["empty"])
+	}
+	list := m["list"].([]any)
+	if list[0] != 1.0 || list[1] != "two, three" || list[2].([]any)[1].([]any)[1] != "z" {
+		t.Errorf("list = %#v", list)
+	}
+	nested := m["nested"].([]any)
+	item := nested[0].(map[string]any)
+	flow := item["other"].(map[string]any)
+	if item["key"] != "v" || flow["a"] != 1.0 || flow["b"].([]any)[0] != true || flow["b"].([]any)[1] != nil {
+		t.Errorf("nested[0] = %#v", item)
+	}
+	if nested[1].([]any)[0] != "deep" {
+		t.Errorf("nested[1] = %#v", nested[1])
+	}
+
+	for _, bad := range []string{"a: 1\na: 2", "a: [1, 2", "a: \"x", "\tb: 1", "- 1\nb: 2"} {
+		if _, err := parseYAML(bad); err == nil {
+			t.Errorf("parseYAML(%q) should fail", bad)
+		}
+	}
+}
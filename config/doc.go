@@ -0,0 +1,57 @@
+// Package config loads simulation scenarios from YAML or JSON files, so
+// that a run can be reproduced or varied without recompiling.
+//
+// A scenario names the integrator, step size, duration and output cadence,
+// lists the bodies with their initial conditions, and lists the force
+// models acting on them. Every physical quantity is written as a string
+// with units, parsed by units.Parse and checked against the dimension the
+// setting expects, so "1 AU" and "1.496e11 m" are equivalent while "1 kg"
+// for a position is an error. A bare 0 is accepted for any dimension.
+// Unknown settings are rejected, and errors name the offending setting
+// (e.g. "bodies[1].velocity[2]").
+//
+// Supported force types and their parameters:
+//   - gravity: mutual Newtonian gravity; optional softening [L].
+//   - drag: linear [M T⁻¹] and/or quadratic [M L⁻¹] drag coefficients.
+//   - spring: exactly two bodies; stiffness [M T⁻²], rest_length [L],
+//     optional damping [M T⁻¹].
+//   - lorentz: uniform fields E [V/m] and/or B [T] acting on charges.
+//   - uniform: a constant acceleration vector [L T⁻²], e.g. surface gravity.
+//
+// Each force may list the bodies it acts on; by default it acts on all.
+//
+// Only the subset of YAML needed for such files is supported: block and
+// flow mappings and sequences, quoted and plain scalars, and comments.
+//
+// Example scenario:
+//
+//	name: earth-moon
+//	integrator: rk4
+//	step: 1 h
+//	duration: 27.3 d
+//	output:
+//	  every: 6 h
+//	  path: orbit.csv
+//	bodies:
+//	  - name: earth
+//	    mass: 5.972e24 kg
+//	  - name: moon
+//	    mass: 7.342e22 kg
+//	    position: [384400 km, 0, 0]
+//	    velocity: [0, 1.022 km/s, 0]
+//	forces:
+//	  - type: gravity
+//
+// Example usage:
+//
+//	import "github.com/sakiphan/qsim-core/config"
+//
+//	sc, err := config.Load("earth-moon.yaml")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	stepper, _ := sc.Stepper()
+//	for i := 0; i < sc.Steps(); i++ {
+//	    // ... advance, writing output every sc.OutputStride() steps
+//	}
+package config
@@ -0,0 +1,570 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Scenario is a complete description of a simulation run.
+type Scenario struct {
+	Name       string
+	Integrator string     // "euler" or "rk4"
+	Step       units.Time // integrator step size
+	Duration   units.Time // total simulated time
+	Output     Output
+	Bodies     []Body
+	Forces     []Force
+}
+
+// Output describes how often and where results are written.
+type Output struct {
+	Every  units.Time // sampling interval; defaults to the step size
+	Path   string     // output file; empty for none
+	Format string     // "csv", "parquet" or "hdf5"
+}
+
+// Body is a point body and its initial conditions.
+type Body struct {
+	Name     string
+	Mass     units.Mass
+	Charge   units.Charge
+	Radius   units.Length
+	Position vector.Vector3 // [L]
+	Velocity vector.Vector3 // [L T⁻¹]
+}
+
+// Force is a force model acting on some of the bodies. Params holds the
+// scalar parameters and Vectors the vector parameters, keyed by the names
+// used in the scenario file.
+type Force struct {
+	Type    string
+	Bodies  []string // bodies acted on; empty for all
+	Params  map[string]units.Value
+	Vectors map[string]vector.Vector3
+}
+
+// Param returns the named scalar parameter, if set.
+func (f Force) Param(name string) (units.Value, bool) {
+	v, ok := f.Params[name]
+	return v, ok
+}
+
+// Vector returns the named vector parameter, if set.
+func (f Force) Vector(name string) (vector.Vector3, bool) {
+	v, ok := f.Vectors[name]
+	return v, ok
+}
+
+// -----------------------------------------------------------------------------
+// Loading
+// -----------------------------------------------------------------------------
+
+// Load reads a scenario file. Files ending in .json are parsed as JSON and
+// all others as YAML.
+func Load(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tree any
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &tree)
+	} else {
+		tree, err = parseYAML(string(data))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	s, err := decode(tree)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Parse parses a scenario from JSON, if the document begins with '{', or
+// otherwise from YAML.
+func Parse(data []byte) (*Scenario, error) {
+	var tree any
+	var err error
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = json.Unmarshal(data, &tree)
+	} else {
+		tree, err = parseYAML(string(data))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decode(tree)
+}
+
+// Stepper returns the integrator named by the scenario.
+func (s *Scenario) Stepper() (solver.Stepper, error) {
+	switch s.Integrator {
+	case "euler":
+		return &solver.Euler{}, nil
+	case "rk4", "":
+		return &solver.RK4{}, nil
+	}
+	return nil, fmt.Errorf("unknown integrator %q", s.Integrator)
+}
+
+// Steps returns the number of integrator steps needed to cover the
+// duration, rounding a trailing partial step up.
+func (s *Scenario) Steps() int {
+	n := s.Duration.Val() / s.Step.Val()
+	return int(math.Ceil(n - 1e-9))
+}
+
+// OutputStride returns the number of integrator steps between output
+// samples, at least one.
+func (s *Scenario) OutputStride() int {
+	if s.Output.Every.Val() <= 0 {
+		return 1
+	}
+	return max(1, int(math.Round(s.Output.Every.Val()/s.Step.Val())))
+}
+
+// -----------------------------------------------------------------------------
+// Decoding
+// -----------------------------------------------------------------------------
+
+var (
+	dimLength       = units.Meter(1).Dim()
+	dimTime         = units.Second(1).Dim()
+	dimMass         = units.Kilogram(1).Dim()
+	dimCharge       = units.Coulomb(1).Dim()
+	dimVelocity     = units.MeterPerSecond(1).Dim()
+	dimAcceleration = units.MeterPerSecond2(1).Dim()
+	dimStiffness    = units.NewtonPerMeter(1).Dim()
+	dimDamping      = units.KilogramPerSecond(1).Dim()
+	dimQuadDrag     = units.Kilogram(1).Divide(units.Meter(1).Value).Dim()
+	dimEField       = units.VoltPerMeter(1).Dim()
+	dimBField       = units.Tesla(1).Dim()
+)
+
+// forceSpec describes the parameters accepted by a force type.
+type forceSpec struct {
+	params   map[string]units.Dimension
+	vectors  map[string]units.Dimension
+	required []string // parameters that must be present
+	anyOf    []string // at least one of these must be present
+	bodies   int      // exact number of bodies, or 0 for any
+}
+
+var forceSpecs = map[string]forceSpec{
+	"gravity": {
+		params: map[string]units.Dimension{"softening": dimLength},
+	},
+	"drag": {
+		params: map[string]units.Dimension{"linear": dimDamping, "quadratic": dimQuadDrag},
+		anyOf:  []string{"linear", "quadratic"},
+	},
+	"spring": {
+		params:   map[string]units.Dimension{"stiffness": dimStiffness, "rest_length": dimLength, "damping": dimDamping},
+		required: []string{"stiffness", "rest_length"},
+		bodies:   2,
+	},
+	"lorentz": {
+		vectors: map[string]units.Dimension{"E": dimEField, "B": dimBField},
+		anyOf:   []string{"E", "B"},
+	},
+	"uniform": {
+		vectors:  map[string]units.Dimension{"acceleration": dimAcceleration},
+		required: []string{"acceleration"},
+	},
+}
+
+// decode converts a generic JSON/YAML tree into a validated Scenario.
+func decode(tree any) (*Scenario, error) {
+	root, err := asMap(tree, "scenario")
+	if err != nil {
+		return nil, err
+	}
+	if err := checkKeys(root, "", "name", "integrator", "step", "duration", "output", "bodies", "forces"); err != nil {
+		return nil, err
+	}
+	s := &Scenario{Integrator: "rk4"}
+	if s.Name, err = optString(root, "name", "name"); err != nil {
+		return nil, err
+	}
+	if v, ok := root["integrator"]; ok {
+		if s.Integrator, err = asString(v, "integrator"); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := s.Stepper(); err != nil {
+		return nil, fmt.Errorf("integrator: %w", err)
+	}
+	step, err := positive(root, "step", "step", dimTime)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := positive(root, "duration", "duration", dimTime)
+	if err != nil {
+		return nil, err
+	}
+	s.Step, s.Duration = units.Time{Value: step}, units.Time{Value: duration}
+
+	s.Output = Output{Every: s.Step, Format: "csv"}
+	if v, ok := root["output"]; ok {
+		if s.Output, err = decodeOutput(v, s.Step); err != nil {
+			return nil, err
+		}
+	}
+
+	bodies, err := asList(root["bodies"], "bodies")
+	if err != nil {
+		return nil, err
+	}
+	if len(bodies) == 0 {
+		return nil, fmt.Errorf("bodies: at least one body is required")
+	}
+	names := map[string]bool{}
+	for i, b := range bodies {
+		body, err := decodeBody(b, fmt.Sprintf("bodies[%d]", i))
+		if err != nil {
+			return nil, err
+		}
+		if names[body.Name] {
+			return nil, fmt.Errorf("bodies[%d].name: duplicate body %q", i, body.Name)
+		}
+		names[body.Name] = true
+		s.Bodies = append(s.Bodies, body)
+	}
+
+	if v, ok := root["forces"]; ok {
+		forces, err := asList(v, "forces")
+		if err != nil {
+			return nil, err
+		}
+		for i, f := range forces {
+			force, err := decodeForce(f, fmt.Sprintf("forces[%d]", i), names)
+			if err != nil {
+				return nil, err
+			}
+			s.Forces = append(s.Forces, force)
+		}
+	}
+	return s, nil
+}
+
+func decodeOutput(v any, step units.Time) (Output, error) {
+	m, err := asMap(v, "output")
+	if err != nil {
+		return Output{}, err
+	}
+	if err := checkKeys(m, "output.", "every", "path", "format"); err != nil {
+		return Output{}, err
+	}
+	out := Output{Every: step}
+	if _, ok := m["every"]; ok {
+		every, err := positive(m, "every", "output.every", dimTime)
+		if err != nil {
+			return Output{}, err
+		}
+		out.Every = units.Time{Value: every}
+	}
+	if out.Path, err = optString(m, "path", "output.path"); err != nil {
+		return Output{}, err
+	}
+	if out.Format, err = optString(m, "format", "output.format"); err != nil {
+		return Output{}, err
+	}
+	if out.Format == "" {
+		switch strings.ToLower(filepath.Ext(out.Path)) {
+		case ".parquet":
+			out.Format = "parquet"
+		case ".h5", ".hdf5":
+			out.Format = "hdf5"
+		default:
+			out.Format = "csv"
+		}
+	}
+	switch out.Format {
+	case "csv", "parquet", "hdf5":
+	default:
+		return Output{}, fmt.Errorf("output.format: unknown format %q", out.Format)
+	}
+	return out, nil
+}
+
+func decodeBody(v any, path string) (Body, error) {
+	m, err := asMap(v, path)
+	if err != nil {
+		return Body{}, err
+	}
+	if err := checkKeys(m, path+".", "name", "mass", "charge", "radius", "position", "velocity"); err != nil {
+		return Body{}, err
+	}
+	b := Body{
+		Charge:   units.Coulomb(0),
+		Radius:   units.Meter(0),
+		Position: vector.Zero(dimLength),
+		Velocity: vector.Zero(dimVelocity),
+	}
+	if b.Name, err = optString(m, "name", path+".name"); err != nil {
+		return Body{}, err
+	}
+	if b.Name == "" {
+		return Body{}, fmt.Errorf("%s.name: required", path)
+	}
+	mass, err := positive(m, "mass", path+".mass", dimMass)
+	if err != nil {
+		return Body{}, err
+	}
+	b.Mass = units.Mass{Value: mass}
+	if q, ok := m["charge"]; ok {
+		charge, err := quantity(q, path+".charge", dimCharge)
+		if err != nil {
+			return Body{}, err
+		}
+		b.Charge = units.Charge{Value: charge}
+	}
+	if r, ok := m["radius"]; ok {
+		radius, err := quantity(r, path+".radius", dimLength)
+		if err != nil {
+			return Body{}, err
+		}
+		if radius.Val() < 0 {
+			return Body{}, fmt.Errorf("%s.radius: must be non-negative, got %g m", path, radius.Val())
+		}
+		b.Radius = units.Length{Value: radius}
+	}
+	if p, ok := m["position"]; ok {
+		if b.Position, err = vector3(p, path+".position", dimLength); err != nil {
+			return Body{}, err
+		}
+	}
+	if u, ok := m["velocity"]; ok {
+		if b.Velocity, err = vector3(u, path+".velocity", dimVelocity); err != nil {
+			return Body{}, err
+		}
+	}
+	return b, nil
+}
+
+func decodeForce(v any, path string, bodies map[string]bool) (Force, error) {
+	m, err := asMap(v, path)
+	if err != nil {
+		return Force{}, err
+	}
+	typ, err := optString(m, "type", path+".type")
+	if err != nil {
+		return Force{}, err
+	}
+	spec, ok := forceSpecs[typ]
+	if !ok {
+		known := make([]string, 0, len(forceSpecs))
+		for k := range forceSpecs {
+			known = append(known, k)
+		}
+		sort.Strings(known)
+		return Force{}, fmt.Errorf("%s.type: unknown force %q (want one of %s)", path, typ, strings.Join(known, ", "))
+	}
+	f := Force{Type: typ, Params: map[string]units.Value{}, Vectors: map[string]vector.Vector3{}}
+
+	if list, ok := m["bodies"]; ok {
+		items, err := asList(list, path+".bodies")
+		if err != nil {
+			return Force{}, err
+		}
+		for i, item := range items {
+			name, err := asString(item, fmt.Sprintf("%s.bodies[%d]", path, i))
+			if err != nil {
+				return Force{}, err
+			}
+			if !bodies[name] {
+				return Force{}, fmt.Errorf("%s.bodies[%d]: unknown body %q", path, i, name)
+			}
+			f.Bodies = append(f.Bodies, name)
+		}
+	}
+	if spec.bodies > 0 && len(f.Bodies) != spec.bodies {
+		return Force{}, fmt.Errorf("%s.bodies: %s force needs exactly %d bodies, got %d", path, typ, spec.bodies, len(f.Bodies))
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		p := path + "." + k
+		switch dim, scalar := spec.params[k]; {
+		case k == "type" || k == "bodies":
+		case scalar:
+			if f.Params[k], err = quantity(m[k], p, dim); err != nil {
+				return Force{}, err
+			}
+		default:
+			dim, ok := spec.vectors[k]
+			if !ok {
+				return Force{}, fmt.Errorf("%s: unknown parameter for %s force", p, typ)
+			}
+			if f.Vectors[k], err = vector3(m[k], p, dim); err != nil {
+				return Force{}, err
+			}
+		}
+	}
+	for _, k := range spec.required {
+		if !f.has(k) {
+			return Force{}, fmt.Errorf("%s.%s: required for %s force", path, k, typ)
+		}
+	}
+	if len(spec.anyOf) > 0 {
+		found := false
+		for _, k := range spec.anyOf {
+			found = found || f.has(k)
+		}
+		if !found {
+			return Force{}, fmt.Errorf("%s: %s force needs at least one of %s", path, typ, strings.Join(spec.anyOf, ", "))
+		}
+	}
+	return f, nil
+}
+
+func (f Force) has(name string) bool {
+	_, p := f.Params[name]
+	_, v := f.Vectors[name]
+	return p || v
+}
+
+// -----------------------------------------------------------------------------
+// Tree Helpers
+// -----------------------------------------------------------------------------
+
+func asMap(v any, path string) (map[string]any, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a mapping, got %s", path, describe(v))
+	}
+	return m, nil
+}
+
+func asList(v any, path string) ([]any, error) {
+	l, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a list, got %s", path, describe(v))
+	}
+	return l, nil
+}
+
+func asString(v any, path string) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s: expected a string, got %s", path, describe(v))
+	}
+	return s, nil
+}
+
+func optString(m map[string]any, key, path string) (string, error) {
+	v, ok := m[key]
+	if !ok || v == nil {
+		return "", nil
+	}
+	return asString(v, path)
+}
+
+// checkKeys rejects keys outside the allowed set, so that misspelled
+// settings are not silently ignored.
+func checkKeys(m map[string]any, prefix string, allowed ...string) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		found := false
+		for _, a := range allowed {
+			found = found || k == a
+		}
+		if !found {
+			return fmt.Errorf("%s%s: unknown setting", prefix, k)
+		}
+	}
+	return nil
+}
+
+// quantity parses a quantity string such as "9.81 m/s^2" and checks its
+// dimension. A bare number is dimensionless, except zero, which is
+// accepted for any dimension.
+func quantity(v any, path string, dim units.Dimension) (units.Value, error) {
+	var q units.Value
+	switch x := v.(type) {
+	case float64:
+		if x == 0 {
+			return units.NewValue(0, dim), nil
+		}
+		q = units.Dimensionless(x)
+	case string:
+		var err error
+		if q, err = units.Parse(x); err != nil {
+			return units.Value{}, fmt.Errorf("%s: %w", path, err)
+		}
+	default:
+		return units.Value{}, fmt.Errorf("%s: expected a quantity, got %s", path, describe(v))
+	}
+	if q.Dim() != dim {
+		return units.Value{}, fmt.Errorf("%s: expected units of %s, got %s", path, dim.Symbol(), q.Dim().Symbol())
+	}
+	return q, nil
+}
+
+// positive reads a required quantity that must be greater than zero.
+func positive(m map[string]any, key, path string, dim units.Dimension) (units.Value, error) {
+	v, ok := m[key]
+	if !ok {
+		return units.Value{}, fmt.Errorf("%s: required", path)
+	}
+	q, err := quantity(v, path, dim)
+	if err != nil {
+		return units.Value{}, err
+	}
+	if !(q.Val() > 0) {
+		return units.Value{}, fmt.Errorf("%s: must be positive, got %g %s", path, q.Val(), dim.Symbol())
+	}
+	return q, nil
+}
+
+// vector3 parses a list of three quantities.
+func vector3(v any, path string, dim units.Dimension) (vector.Vector3, error) {
+	l, err := asList(v, path)
+	if err != nil {
+		return vector.Vector3{}, err
+	}
+	if len(l) != 3 {
+		return vector.Vector3{}, fmt.Errorf("%s: expected 3 components, got %d", path, len(l))
+	}
+	var c [3]units.Value
+	for i := range c {
+		if c[i], err = quantity(l[i], fmt.Sprintf("%s[%d]", path, i), dim); err != nil {
+			return vector.Vector3{}, err
+		}
+	}
+	return vector.Vector3{X: c[0], Y: c[1], Z: c[2]}, nil
+}
+
+func describe(v any) string {
+	switch v.(type) {
+	case nil:
+		return "nothing"
+	case map[string]any:
+		return "a mapping"
+	case []any:
+		return "a list"
+	case string:
+		return fmt.Sprintf("string %q", v)
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,387 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// This file implements the subset of YAML used by scenario files: block
+// mappings and sequences, flow sequences and mappings, quoted and plain
+// scalars, and comments. Anchors, tags, multi-line scalars and multiple
+// documents are not supported. The result is the same generic tree that
+// encoding/json produces: map[string]any, []any, string, float64, bool
+// and nil.
+
+// yamlLine is a significant source line with its indentation.
+type yamlLine struct {
+	indent int
+	text   string
+	num    int
+}
+
+// yamlParser consumes lines top to bottom.
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML parses a YAML document into a generic tree.
+func parseYAML(src string) (any, error) {
+	p := &yamlParser{}
+	for i, raw := range strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(strings.TrimLeft(raw, " "), "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+		text := strings.TrimRight(stripComment(raw), " \t")
+		trimmed := strings.TrimLeft(text, " ")
+		if trimmed == "" || trimmed == "---" || trimmed == "..." {
+			continue
+		}
+		p.lines = append(p.lines, yamlLine{indent: len(text) - len(trimmed), text: trimmed, num: i + 1})
+	}
+	if len(p.lines) == 0 {
+		return map[string]any{}, nil
+	}
+	v, err := p.block(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		return nil, fmt.Errorf("line %d: unexpected indentation", l.num)
+	}
+	return v, nil
+}
+
+// stripComment removes a trailing comment: a '#' at the start of the line
+// or preceded by a space, outside quotes.
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// block parses the mapping or sequence whose entries start at indent.
+func (p *yamlParser) block(indent int) (any, error) {
+	if isSeqItem(p.lines[p.pos].text) {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func isSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// sequence parses "- item" entries at indent.
+func (p *yamlParser) sequence(indent int) ([]any, error) {
+	out := []any{}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent || l.indent == indent && !isSeqItem(l.text) {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("line %d: expected a sequence item", l.num)
+		}
+		content := strings.TrimLeft(strings.TrimPrefix(l.text, "-"), " ")
+		if content == "" {
+			p.pos++
+			v, err := p.nested(indent)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+			continue
+		}
+		if isSeqItem(content) || isMapEntry(content) {
+			// The item is a block collection beginning on this line:
+			// reparse its content as a line indented to its own column.
+			p.lines[p.pos] = yamlLine{indent: l.indent + len(l.text) - len(content), text: content, num: l.num}
+			v, err := p.block(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+			continue
+		}
+		v, err := parseScalar(content, l.num)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+		p.pos++
+	}
+	return out, nil
+}
+
+// mapping parses "key: value" entries at indent.
+func (p *yamlParser) mapping(indent int) (map[string]any, error) {
+	out := map[string]any{}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", l.num)
+		}
+		key, rest, ok := splitMapEntry(l.text)
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected \"key: value\", got %q", l.num, l.text)
+		}
+		if _, dup := out[key]; dup {
+			return nil, fmt.Errorf("line %d: duplicate key %q", l.num, key)
+		}
+		p.pos++
+		if rest != "" {
+			v, err := parseScalar(rest, l.num)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = v
+			continue
+		}
+		// A sequence may sit at the same indentation as its key.
+		if p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSeqItem(p.lines[p.pos].text) {
+			v, err := p.sequence(indent)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = v
+			continue
+		}
+		v, err := p.nested(indent)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = v
+	}
+	return out, nil
+}
+
+// nested parses the block indented beyond parent, or returns nil if there
+// is none.
+func (p *yamlParser) nested(parent int) (any, error) {
+	if p.pos >= len(p.lines) || p.lines[p.pos].indent <= parent {
+		return nil, nil
+	}
+	return p.block(p.lines[p.pos].indent)
+}
+
+func isMapEntry(text string) bool {
+	_, _, ok := splitMapEntry(text)
+	return ok
+}
+
+// splitMapEntry splits "key: value" at the first ": " (or a trailing ':')
+// outside quotes and brackets.
+func splitMapEntry(text string) (key, rest string, ok bool) {
+	if text == "" || text[0] == '[' || text[0] == '{' {
+		return "", "", false
+	}
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ':' && (i+1 == len(text) || text[i+1] == ' '):
+			key = strings.TrimSpace(text[:i])
+			if k, err := strconv.Unquote(key); err == nil {
+				key = k
+			} else if len(key) >= 2 && key[0] == '\'' && key[len(key)-1] == '\'' {
+				key = key[1 : len(key)-1]
+			}
+			return key, strings.TrimSpace(text[i+1:]), key != ""
+		}
+	}
+	return "", "", false
+}
+
+// parseScalar parses an inline value: a flow collection, a quoted string
+// or a plain scalar.
+func parseScalar(s string, num int) (any, error) {
+	if !strings.ContainsRune("[{\"'", rune(s[0])) {
+		// Outside flow collections a plain scalar runs to the end of the
+		// line, commas included.
+		return resolvePlain(s), nil
+	}
+	f := &flowParser{s: s, num: num}
+	v, err := f.value()
+	if err != nil {
+		return nil, err
+	}
+	if f.skipSpace(); f.i < len(f.s) {
+		return nil, fmt.Errorf("line %d: unexpected %q after value", num, f.s[f.i:])
+	}
+	return v, nil
+}
+
+// flowParser parses flow-style values within a single line.
+type flowParser struct {
+	s   string
+	i   int
+	num int
+}
+
+func (f *flowParser) skipSpace() {
+	for f.i < len(f.s) && f.s[f.i] == ' ' {
+		f.i++
+	}
+}
+
+func (f *flowParser) value() (any, error) {
+	f.skipSpace()
+	if f.i >= len(f.s) {
+		return nil, nil
+	}
+	switch f.s[f.i] {
+	case '[':
+		return f.sequence()
+	case '{':
+		return f.mapping()
+	case '"', '\'':
+		return f.quoted()
+	}
+	return f.plain(), nil
+}
+
+func (f *flowParser) sequence() ([]any, error) {
+	f.i++ // '['
+	out := []any{}
+	for {
+		f.skipSpace()
+		if f.i < len(f.s) && f.s[f.i] == ']' {
+			f.i++
+			return out, nil
+		}
+		v, err := f.value()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+		f.skipSpace()
+		if f.i >= len(f.s) {
+			return nil, fmt.Errorf("line %d: unterminated '['", f.num)
+		}
+		switch f.s[f.i] {
+		case ',':
+			f.i++
+		case ']':
+		default:
+			return nil, fmt.Errorf("line %d: expected ',' or ']' in flow sequence", f.num)
+		}
+	}
+}
+
+func (f *flowParser) mapping() (map[string]any, error) {
+	f.i++ // '{'
+	out := map[string]any{}
+	for {
+		f.skipSpace()
+		if f.i < len(f.s) && f.s[f.i] == '}' {
+			f.i++
+			return out, nil
+		}
+		k, err := f.value()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			key = fmt.Sprint(k)
+		}
+		f.skipSpace()
+		if f.i >= len(f.s) || f.s[f.i] != ':' {
+			return nil, fmt.Errorf("line %d: expected ':' after key %q in flow mapping", f.num, key)
+		}
+		f.i++
+		v, err := f.value()
+		if err != nil {
+			return nil, err
+		}
+		out[key] = v
+		f.skipSpace()
+		if f.i >= len(f.s) {
+			return nil, fmt.Errorf("line %d: unterminated '{'", f.num)
+		}
+		switch f.s[f.i] {
+		case ',':
+			f.i++
+		case '}':
+		default:
+			return nil, fmt.Errorf("line %d: expected ',' or '}' in flow mapping", f.num)
+		}
+	}
+}
+
+func (f *flowParser) quoted() (string, error) {
+	q := f.s[f.i]
+	for j := f.i + 1; j < len(f.s); j++ {
+		switch {
+		case q == '"' && f.s[j] == '\\':
+			j++
+		case f.s[j] == q:
+			if q == '\'' && j+1 < len(f.s) && f.s[j+1] == '\'' {
+				j++ // '' escapes a single quote
+				continue
+			}
+			raw := f.s[f.i : j+1]
+			f.i = j + 1
+			if q == '\'' {
+				return strings.ReplaceAll(raw[1:len(raw)-1], "''", "'"), nil
+			}
+			s, err := strconv.Unquote(raw)
+			if err != nil {
+				return "", fmt.Errorf("line %d: invalid string %s", f.num, raw)
+			}
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("line %d: unterminated string", f.num)
+}
+
+// plain reads an unquoted scalar up to the next flow indicator.
+func (f *flowParser) plain() any {
+	start := f.i
+	for f.i < len(f.s) && !strings.ContainsRune(",[]{}", rune(f.s[f.i])) &&
+		!(f.s[f.i] == ':' && (f.i+1 == len(f.s) || f.s[f.i+1] == ' ')) {
+		f.i++
+	}
+	return resolvePlain(strings.TrimSpace(f.s[start:f.i]))
+}
+
+// resolvePlain resolves a plain scalar to nil, a bool, a float64 or a
+// string.
+func resolvePlain(s string) any {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	}
+	if x, err := strconv.ParseFloat(s, 64); err == nil {
+		return x
+	}
+	return s
+}
@@ -0,0 +1,72 @@
+package constants
+
+import "github.com/sakiphan/qsim-core/units"
+
+// -----------------------------------------------------------------------------
+// Atomic Units
+// -----------------------------------------------------------------------------
+//
+// Hartree atomic units set m_e = e = ℏ = 4πε₀ = 1, so that lengths are in
+// Bohr radii and energies in hartrees. The constants below are the SI
+// values of the derived atomic units of energy, velocity, time, electric
+// field and force; with BohrRadius, ElectronMass and ElementaryCharge they
+// convert the results of atomic-structure codes back to SI. In atomic
+// units the speed of light is 1/α ≈ 137.
+
+// HartreeEnergy is the Hartree energy, the atomic unit of energy
+// (E_h = α² m_e c² = ℏ²/m_e a₀²).
+// Value: 4.3597447222071(85) × 10⁻¹⁸ J = 27.211386245988(53) eV
+// Relative standard uncertainty: 1.9 × 10⁻¹²
+//
+// Twice the ionization energy of hydrogen, neglecting the reduced mass.
+//
+// References:
+//   - CODATA 2018
+var HartreeEnergy = units.Joule(4.3597447222071e-18)
+
+// HartreeEnergyEV is the Hartree energy in electron volts.
+// Value: 27.211386245988(53) eV
+//
+// References:
+//   - CODATA 2018
+var HartreeEnergyEV = 27.211386245988
+
+// BohrVelocity is the atomic unit of velocity (v₀ = a₀E_h/ℏ = αc).
+// Value: 2.18769126364(33) × 10⁶ m/s
+// Relative standard uncertainty: 1.5 × 10⁻¹⁰
+//
+// The speed of the electron in the ground-state Bohr orbit of hydrogen.
+//
+// References:
+//   - CODATA 2018
+var BohrVelocity = units.MeterPerSecond(2.18769126364e6)
+
+// AtomicUnitOfTime is the atomic unit of time (ℏ/E_h).
+// Value: 2.4188843265857(47) × 10⁻¹⁷ s
+// Relative standard uncertainty: 1.9 × 10⁻¹²
+//
+// The ground-state Bohr orbit takes 2π atomic units of time, 152 as.
+//
+// References:
+//   - CODATA 2018
+var AtomicUnitOfTime = units.Second(2.4188843265857e-17)
+
+// AtomicUnitOfElectricField is the atomic unit of electric field
+// (E_h/ea₀).
+// Value: 5.14220674763(78) × 10¹¹ V/m
+// Relative standard uncertainty: 1.5 × 10⁻¹⁰
+//
+// The field of the proton at the Bohr radius; laser fields approaching it
+// ionize atoms directly.
+//
+// References:
+//   - CODATA 2018
+var AtomicUnitOfElectricField = units.VoltPerMeter(5.14220674763e11)
+
+// AtomicUnitOfForce is the atomic unit of force (E_h/a₀).
+// Value: 8.2387234983(12) × 10⁻⁸ N
+// Relative standard uncertainty: 1.5 × 10⁻¹⁰
+//
+// References:
+//   - CODATA 2018
+var AtomicUnitOfForce = units.Newton(8.2387234983e-8)
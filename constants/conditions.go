@@ -0,0 +1,131 @@
+package constants
+
+import "github.com/sakiphan/qsim-core/units"
+
+// -----------------------------------------------------------------------------
+// Standard Conditions and Reference States
+// -----------------------------------------------------------------------------
+
+// StandardAtmosphere is the standard atmosphere (atm).
+// Value: 101325 Pa (exact by definition)
+//
+// References:
+//   - 10th CGPM (1954), Resolution 4
+var StandardAtmosphere = units.Pascal(101325)
+
+// StandardStatePressure is the standard-state pressure (p°) of
+// thermodynamic tables.
+// Value: 100000 Pa (exact by definition)
+//
+// References:
+//   - IUPAC Green Book, 3rd ed. (2007), Sec. 2.11
+var StandardStatePressure = units.Pascal(1e5)
+
+// IcePoint is the melting point of ice at one atmosphere, 0 °C.
+// Value: 273.15 K (exact by definition of the Celsius scale)
+var IcePoint = units.Kelvin(273.15)
+
+// ReferenceState is a named pair of reference temperature and pressure.
+type ReferenceState struct {
+	Name        string
+	Temperature units.Temperature
+	Pressure    units.Pressure
+}
+
+// MolarVolume returns the molar volume RT/p of an ideal gas at the
+// reference state, in m³/mol.
+func (s ReferenceState) MolarVolume() units.Value {
+	v := UniversalGasConstant.Val() * s.Temperature.Val() / s.Pressure.Val()
+	return units.NewValue(v, units.Dimension{L: 3, N: -1})
+}
+
+// NumberDensity returns the number density p/k_B T of an ideal gas at the
+// reference state; at STPAtm it is the Loschmidt constant.
+func (s ReferenceState) NumberDensity() units.NumberDensity {
+	return IdealGasNumberDensity(s.Pressure, s.Temperature)
+}
+
+// IdealGasNumberDensity returns the number of molecules per unit volume
+// n = p/k_B T of an ideal gas at pressure p and temperature t.
+func IdealGasNumberDensity(p units.Pressure, t units.Temperature) units.NumberDensity {
+	return units.PerMeter3(p.Val() / (BoltzmannConstant.Val() * t.Val()))
+}
+
+// STP is standard temperature and pressure as defined by IUPAC since 1982:
+// 0 °C and 100 kPa.
+//
+// References:
+//   - IUPAC Gold Book, "standard conditions for gases"
+var STP = ReferenceState{"STP", IcePoint, StandardStatePressure}
+
+// STPAtm is the older standard temperature and pressure of 0 °C and one
+// atmosphere, still common in textbooks and gas-law tables (22.414 L/mol).
+var STPAtm = ReferenceState{"STP (1 atm)", IcePoint, StandardAtmosphere}
+
+// NTP is normal temperature and pressure: 20 °C and one atmosphere.
+//
+// References:
+//   - NIST, "Normal temperature and pressure"
+var NTP = ReferenceState{"NTP", units.Kelvin(293.15), StandardAtmosphere}
+
+// SATP is standard ambient temperature and pressure: 25 °C and 100 kPa,
+// the reference state of most thermochemical tables.
+//
+// References:
+//   - IUPAC Gold Book, "standard ambient temperature and pressure"
+var SATP = ReferenceState{"SATP", units.Kelvin(298.15), StandardStatePressure}
+
+// WaterTriplePoint is the triple point of water (Vienna Standard Mean
+// Ocean Water).
+// Value: 273.16 K, 611.657(10) Pa
+//
+// 273.16 K was exact by definition of the kelvin until 2019; since the
+// redefinition it is measured, with a relative uncertainty of 3.7 × 10⁻⁷.
+//
+// References:
+//   - IAPWS R14-08 (2011)
+var WaterTriplePoint = ReferenceState{"water triple point", units.Kelvin(273.16), units.Pascal(611.657)}
+
+// StandardMolarVolume is the molar volume of an ideal gas at STP
+// (V_m = RT/p at 273.15 K, 100 kPa).
+// Value: 22.71095464... × 10⁻³ m³/mol (exact since 2019)
+//
+// References:
+//   - CODATA 2018
+var StandardMolarVolume = STP.MolarVolume()
+
+// LoschmidtConstant is the number density of an ideal gas at 0 °C and one
+// atmosphere (n₀ = p/k_B T).
+// Value: 2.686780111... × 10²⁵ m⁻³ (exact since 2019)
+//
+// References:
+//   - CODATA 2018
+var LoschmidtConstant = STPAtm.NumberDensity()
+
+// -----------------------------------------------------------------------------
+// Standard Atmosphere at Sea Level
+// -----------------------------------------------------------------------------
+
+// AtmosphereState is the state of the air at a reference point.
+type AtmosphereState struct {
+	Temperature  units.Temperature
+	Pressure     units.Pressure
+	Density      units.Density
+	SpeedOfSound units.Velocity
+	Gravity      units.Acceleration
+}
+
+// ISASeaLevel is the sea-level state of the International Standard
+// Atmosphere (identical to the U.S. Standard Atmosphere 1976 below 32 km):
+// 15 °C, 101325 Pa, 1.225 kg/m³, 340.294 m/s and standard gravity.
+//
+// References:
+//   - ISO 2533:1975, "Standard Atmosphere"
+//   - NOAA/NASA/USAF, "U.S. Standard Atmosphere, 1976"
+var ISASeaLevel = AtmosphereState{
+	Temperature:  units.Kelvin(288.15),
+	Pressure:     StandardAtmosphere,
+	Density:      units.KilogramPerMeter3(1.225),
+	SpeedOfSound: units.MeterPerSecond(340.294),
+	Gravity:      StandardGravity,
+}
@@ -67,6 +67,17 @@ var AvogadroConstant = units.NewValue(6.02214076e23, units.Dimension{N: -1})
 //   - CODATA 2018
 var UniversalGasConstant = units.NewValue(8.314462618, units.Dimension{L: 2, M: 1, T: -2, Θ: -1, N: -1})
 
+// MolarMassConstant is the molar mass constant (M_u = N_A m_u).
+// Value: 0.99999999965(30) × 10⁻³ kg/mol
+// Relative standard uncertainty: 3.0 × 10⁻¹⁰
+//
+// Exactly 1 g/mol until 2019; since the redefinition of the mole it is
+// measured, through the atomic mass unit.
+//
+// References:
+//   - CODATA 2018
+var MolarMassConstant = units.KilogramPerMole(0.99999999965e-3)
+
 // VacuumPermittivity is the electric constant (ε₀).
 // Value: 8.8541878128(13) × 10⁻¹² F/m
 //
@@ -160,7 +171,18 @@ var BohrRadius = units.Meter(5.29177210903e-11)
 //
 // References:
 //   - CODATA 2018
-var BohrMagneton = units.NewValue(9.2740100783e-24, units.Dimension{L: 2, I: 1})
+var BohrMagneton = units.JoulePerTesla(9.2740100783e-24)
+
+// NuclearMagneton is the nuclear magneton (μ_N = eℏ/2m_p).
+// Value: 5.0507837461(15) × 10⁻²⁷ J/T
+// Relative standard uncertainty: 3.1 × 10⁻¹⁰
+//
+// Natural unit of nucleon and nuclear magnetic moments, smaller than μ_B
+// by the proton-electron mass ratio.
+//
+// References:
+//   - CODATA 2018
+var NuclearMagneton = units.JoulePerTesla(5.0507837461e-27)
 
 // StandardGravity is standard acceleration due to gravity on Earth (g).
 // Value: 9.80665 m/s² (exact by definition)
@@ -214,13 +236,14 @@ var Parsec = units.Meter(3.0856775814913673e16)
 var LightYear = units.Meter(9.4607304725808e15)
 
 // SolarMass is the mass of the Sun (M☉).
-// Value: 1.98892 × 10³⁰ kg
+// Value: 1.98841(4) × 10³⁰ kg
 //
-// Nominal value defined by IAU 2015 Resolution B3.
+// The nominal solar mass parameter (GM)☉ᴺ divided by the CODATA 2018 G, as
+// IAU 2015 Resolution B3 recommends; the uncertainty is that of G.
 //
 // References:
 //   - IAU 2015 Resolution B3
-var SolarMass = units.Kilogram(1.98892e30)
+var SolarMass = units.Kilogram(1.98841e30)
 
 // EarthMass is the mass of Earth (M⊕).
 // Value: 5.9722 × 10²⁴ kg
@@ -229,6 +252,23 @@ var SolarMass = units.Kilogram(1.98892e30)
 //   - NASA JPL planetary fact sheet
 var EarthMass = units.Kilogram(5.9722e24)
 
+// SolarMassParameter is the heliocentric gravitational constant (GM☉).
+// Value: 1.32712440041(10) × 10²⁰ m³/s² (TDB-compatible)
+//
+// Known to about 10⁻¹⁰, five orders of magnitude better than G or M☉
+// separately; orbital mechanics about the Sun should use it directly.
+//
+// References:
+//   - Park et al. 2021, JPL DE440 ephemeris
+var SolarMassParameter = units.CubicMeterPerSecond2(1.32712440041e20)
+
+// EarthMassParameter is the geocentric gravitational constant (GM⊕).
+// Value: 3.986004418(8) × 10¹⁴ m³/s² (TT-compatible, including the atmosphere)
+//
+// References:
+//   - IERS Conventions 2010, Table 1.1
+var EarthMassParameter = units.CubicMeterPerSecond2(3.986004418e14)
+
 // SolarLuminosity is the luminosity of the Sun (L☉).
 // Value: 3.828 × 10²⁶ W
 //
@@ -254,6 +294,31 @@ var SolarRadius = units.Meter(6.957e8)
 //   - NASA Earth Fact Sheet
 var EarthRadius = units.Meter(6.371e6)
 
+// SolarConstant is the total solar irradiance at one astronomical unit (S).
+// Value: 1361 W/m²
+// Uncertainty: ±0.5 W/m²
+//
+// The SORCE/TIM measurement of 1360.8(5) W/m² at the 2008 solar minimum,
+// rounded as the IAU nominal NominalSolarIrradiance; over the solar cycle
+// it varies by about 0.1%. Earth intercepts S over its cross-section πR⊕²
+// and averages it over its surface 4πR⊕², receiving S/4 ≈ 340 W/m².
+//
+// References:
+//   - Kopp and Lean 2011
+var SolarConstant = units.WattPerMeter2(1361)
+
+// EarthBondAlbedo is the fraction of incident sunlight Earth reflects,
+// integrated over all wavelengths and directions (A⊕).
+// Value: 0.306
+//
+// Sets Earth's radiative equilibrium temperature,
+// T = (S(1 - A)/4σ)^(1/4) ≈ 254 K, some 33 K below the mean surface
+// temperature; the difference is the greenhouse effect.
+//
+// References:
+//   - NASA Earth Fact Sheet
+var EarthBondAlbedo = 0.306
+
 // HubbleConstant is the Hubble constant (H₀).
 // Value: 67.4 km/(s⋅Mpc) (Planck 2018)
 // Uncertainty: ±0.5 km/(s⋅Mpc)
@@ -265,6 +330,9 @@ var EarthRadius = units.Meter(6.371e6)
 //
 // References:
 //   - Planck Collaboration 2018 (Planck 2018 results. VI. Cosmological parameters)
+//
+// Deprecated: Use the H0 field of a parameter set such as
+// CosmologyPlanck2018, which keeps H₀ consistent with the densities.
 var HubbleConstant = units.Hertz(2.18e-18)
 
 // HubbleTime is the Hubble time (1/H₀).
@@ -274,16 +342,20 @@ var HubbleConstant = units.Hertz(2.18e-18)
 //
 // References:
 //   - Derived from Planck 2018 H₀
+//
+// Deprecated: Use CosmologyPlanck2018.HubbleTime().
 var HubbleTime = units.Year(14.5e9)
 
 // CriticalDensity is the critical density of the universe (ρ_c).
-// Value: 3H₀²/(8πG) ≈ 9.47 × 10⁻²⁷ kg/m³
+// Value: 3H₀²/(8πG) ≈ 8.53 × 10⁻²⁷ kg/m³
 //
 // Density required for a flat universe.
 //
 // References:
 //   - Derived from Planck 2018 H₀ and CODATA G
-var CriticalDensity = units.NewValue(9.47e-27, units.Dimension{L: -3, M: 1})
+//
+// Deprecated: Use CosmologyPlanck2018.CriticalDensity().
+var CriticalDensity = units.NewValue(8.53e-27, units.Dimension{L: -3, M: 1})
 
 // CMBTemperature is the cosmic microwave background temperature (T_CMB).
 // Value: 2.7255(6) K
@@ -173,7 +173,7 @@ func TestParsec(t *testing.T) {
 }
 
 func TestSolarMass(t *testing.T) {
-	expected := 1.98892e30
+	expected := 1.98841e30
 	if !almostEqual(SolarMass.Val(), expected, 1e24) {
 		t.Errorf("SolarMass = %e kg, want %e kg", SolarMass.Val(), expected)
 	}
@@ -207,6 +207,232 @@ func TestCMBTemperature(t *testing.T) {
 // Planck Units Tests
 // -----------------------------------------------------------------------------
 
+func TestIAUNominalValues(t *testing.T) {
+	// The nominal values are mutually consistent: L☉ = 4πR☉²σT☉⁴ and
+	// S☉ = L☉/(4π au²).
+	r, temp := SolarRadius.Val(), NominalSolarEffectiveTemperature.Val()
+	l := 4 * math.Pi * r * r * StefanBoltzmannConstant.Val() * math.Pow(temp, 4)
+	if !almostEqual(l, SolarLuminosity.Val(), 2e-4) {
+		t.Errorf("4πR²σT⁴ = %v W, want L☉ = %v W", l, SolarLuminosity.Val())
+	}
+	au := AstronomicalUnit.Val()
+	if s := SolarLuminosity.Val() / (4 * math.Pi * au * au); !almostEqual(s, NominalSolarIrradiance.Val(), 1e-3) {
+		t.Errorf("L☉/4π au² = %v W/m², want S☉ = %v", s, NominalSolarIrradiance.Val())
+	}
+	if SolarConstant != NominalSolarIrradiance {
+		t.Errorf("S = %v, want the nominal S☉ = %v", SolarConstant, NominalSolarIrradiance)
+	}
+
+	// Earth's energy budget: S/4 absorbed less the reflected part, in
+	// equilibrium at about 254 K.
+	absorbed := SolarConstant.Val() / 4 * (1 - EarthBondAlbedo)
+	if temp := math.Pow(absorbed/StefanBoltzmannConstant.Val(), 0.25); !almostEqual(temp, 254, 0.5) {
+		t.Errorf("T_eq(Earth) = %v K, want ≈ 254", temp)
+	}
+	if m := -2.5 * math.Log10(SolarLuminosity.Val()/BolometricZeroPointLuminosity.Val()); !almostEqual(m, 4.74, 1e-3) {
+		t.Errorf("solar M_bol = %v, want 4.74", m)
+	}
+
+	// Masses from the mass parameters. Resolution B3 quotes M☉ =
+	// 1.98848e30 kg with the CODATA 2014 G; the 2018 G gives 1.98841e30.
+	if m := NominalMass(NominalSolarMassParameter); !almostEqual(m.Val(), 1.98841e30, 1e-5) {
+		t.Errorf("(GM)☉/G = %v, want 1.98841e30 kg", m.Val())
+	}
+	if m := NominalMass(NominalEarthMassParameter); !almostEqual(m.Val(), 5.9722e24, 1e-4) {
+		t.Errorf("(GM)⊕/G = %v, want 5.9722e24 kg", m.Val())
+	}
+	if m := NominalMass(NominalJupiterMassParameter); !almostEqual(m.Val(), 1.89813e27, 1e-4) {
+		t.Errorf("(GM)♃/G = %v, want 1.89813e27 kg", m.Val())
+	}
+	if NominalSolarMassParameter.Dim() != (units.Dimension{L: 3, T: -2}) {
+		t.Errorf("mass parameter dimension = %v", NominalSolarMassParameter.Dim())
+	}
+
+	// The nominal values are the measured ones rounded.
+	for _, tc := range []struct {
+		name              string
+		measured, nominal units.StandardGravitationalParameter
+	}{
+		{"GM☉", SolarMassParameter, NominalSolarMassParameter},
+		{"GM⊕", EarthMassParameter, NominalEarthMassParameter},
+	} {
+		if !almostEqual(tc.measured.Val(), tc.nominal.Val(), 1e-7) {
+			t.Errorf("%s = %v, nominal %v", tc.name, tc.measured.Val(), tc.nominal.Val())
+		}
+	}
+}
+
+func TestCosmologyParams(t *testing.T) {
+	for _, tc := range []struct {
+		p      CosmologyParams
+		h, omM float64
+	}{
+		{CosmologyPlanck2018, 0.6766, 0.3111},
+		{CosmologyWMAP9, 0.6932, 0.2865},
+		{CosmologySH0ES, 0.7304, 0.3111},
+	} {
+		p := tc.p
+		if !almostEqual(p.HubbleParameter(), tc.h, 1e-12) {
+			t.Errorf("%s: h = %v, want %v", p.Name, p.HubbleParameter(), tc.h)
+		}
+		if !almostEqual(p.OmegaM(), tc.omM, 1e-3) {
+			t.Errorf("%s: Ω_m = %v, want %v", p.Name, p.OmegaM(), tc.omM)
+		}
+		if math.Abs(p.OmegaK()) > 1e-15 {
+			t.Errorf("%s: Ω_k = %v, want flat", p.Name, p.OmegaK())
+		}
+	}
+
+	// Planck 2018: Ω_Λ = 0.6889, and radiation Ω_r h² = 4.18 × 10⁻⁵ from
+	// Ω_γ h² = 2.47 × 10⁻⁵ with N_eff = 3.046.
+	p := CosmologyPlanck2018
+	if !almostEqual(p.OmegaLambda, 0.6889, 1e-3) {
+		t.Errorf("Ω_Λ = %v, want 0.6889", p.OmegaLambda)
+	}
+	if h := p.HubbleParameter(); !almostEqual(p.OmegaR()*h*h, 4.18e-5, 2e-3) {
+		t.Errorf("Ω_r h² = %v, want 4.18e-5", p.OmegaR()*h*h)
+	}
+	// ρ_c = 1.87847 × 10⁻²⁶ h² kg/m³
+	if h := p.HubbleParameter(); !almostEqual(p.CriticalDensity().Val(), 1.87847e-26*h*h, 1e-4) {
+		t.Errorf("ρ_c = %v", p.CriticalDensity())
+	}
+	if !almostEqual(p.HubbleTime().Val()*p.H0.Val(), 1, 1e-15) {
+		t.Errorf("Hubble time = %v", p.HubbleTime())
+	}
+}
+
+func TestReferenceStates(t *testing.T) {
+	// CODATA 2018 molar volumes of an ideal gas at 273.15 K
+	if !almostEqual(StandardMolarVolume.Val(), 22.71095464e-3, 1e-9) {
+		t.Errorf("V_m(100 kPa) = %v, want 22.71095464e-3 m³/mol", StandardMolarVolume)
+	}
+	if v := STPAtm.MolarVolume(); !almostEqual(v.Val(), 22.41396954e-3, 1e-9) {
+		t.Errorf("V_m(101.325 kPa) = %v, want 22.41396954e-3 m³/mol", v)
+	}
+	if StandardMolarVolume.Dim() != (units.Dimension{L: 3, N: -1}) {
+		t.Errorf("molar volume dimension = %v", StandardMolarVolume.Dim())
+	}
+	if SATP.Temperature.Val()-STP.Temperature.Val() != 25 || NTP.Pressure != StandardAtmosphere {
+		t.Error("SATP should be 25 °C above STP, NTP at one atmosphere")
+	}
+	if WaterTriplePoint.Temperature.Val()-IcePoint.Val() > 0.01+1e-12 {
+		t.Errorf("triple point = %v", WaterTriplePoint.Temperature)
+	}
+
+	// Loschmidt constant, and n₀ V_m = N_A
+	if r := LoschmidtConstant.Val() / 2.686780111e25; !almostEqual(r, 1, 1e-9) {
+		t.Errorf("n₀ = %v, want 2.686780111e25 m⁻³", LoschmidtConstant)
+	}
+	if r := STP.NumberDensity().Val() * StandardMolarVolume.Val() / AvogadroConstant.Val(); !almostEqual(r, 1, 1e-9) {
+		t.Errorf("n V_m / N_A = %v, want 1", r)
+	}
+
+	// ISA sea level: ρ = p/(R_air T) and a = √(γ R_air T)
+	isa := ISASeaLevel
+	rAir := UniversalGasConstant.Val() / 0.0289644
+	if rho := isa.Pressure.Val() / (rAir * isa.Temperature.Val()); !almostEqual(rho, isa.Density.Val(), 1e-4) {
+		t.Errorf("ISA density = %v, ideal gas gives %v", isa.Density.Val(), rho)
+	}
+	if a := math.Sqrt(1.4 * rAir * isa.Temperature.Val()); !almostEqual(a, isa.SpeedOfSound.Val(), 1e-5) {
+		t.Errorf("ISA speed of sound = %v, ideal gas gives %v", isa.SpeedOfSound.Val(), a)
+	}
+}
+
+func TestAtomicUnits(t *testing.T) {
+	// E_h = ℏ²/m_e a₀² and E_h = 2 R_∞ hc
+	a0, me := BohrRadius.Val(), ElectronMass.Val()
+	hbar := PlanckReduced.Val()
+	if r := hbar * hbar / (me * a0 * a0) / HartreeEnergy.Val(); !almostEqual(r, 1, 1e-9) {
+		t.Errorf("ℏ²/m_e a₀² / E_h = %v, want 1", r)
+	}
+	rydberg := 2 * RydbergConstant.Val() * PlanckConstant.Val() * SpeedOfLight.Val()
+	if r := rydberg / HartreeEnergy.Val(); !almostEqual(r, 1, 1e-11) {
+		t.Errorf("2R_∞hc / E_h = %v, want 1", r)
+	}
+	if got := HartreeEnergy.ToElectronVolts(); !almostEqual(got/HartreeEnergyEV, 1, 1e-12) {
+		t.Errorf("E_h = %v eV, want %v", got, HartreeEnergyEV)
+	}
+
+	// The atomic units compose: v₀ = a₀/t, F = E_h/a₀ = eE, and c = v₀/α.
+	if r := a0 / AtomicUnitOfTime.Val() / BohrVelocity.Val(); !almostEqual(r, 1, 1e-9) {
+		t.Errorf("a₀/t_au / v₀ = %v, want 1", r)
+	}
+	if r := ElementaryCharge.Val() * AtomicUnitOfElectricField.Val() / AtomicUnitOfForce.Val(); !almostEqual(r, 1, 1e-9) {
+		t.Errorf("e E_au / F_au = %v, want 1", r)
+	}
+	if c := BohrVelocity.Val() / FineStructureConstant.Val(); !almostEqual(c/SpeedOfLight.Val(), 1, 1e-9) {
+		t.Errorf("v₀/α = %v, want c", c)
+	}
+	if AtomicUnitOfElectricField.Dim() != units.VoltPerMeter(1).Dim() || AtomicUnitOfForce.Dim() != units.Newton(1).Dim() {
+		t.Error("atomic units of field and force have wrong dimensions")
+	}
+}
+
+func TestNaturalUnits(t *testing.T) {
+	mevFm := 1e6 * ElectronVoltToJoule * 1e-15
+	if got := HbarC.Val() / mevFm; !almostEqual(got, HbarCMeVfm, 1e-9) {
+		t.Errorf("ℏc = %v MeV⋅fm, want %v", got, HbarCMeVfm)
+	}
+	if HbarC.Dim() != units.Joule(1).Multiply(units.Meter(1).Value).Dim() {
+		t.Errorf("ℏc dimension = %v, want J⋅m", HbarC.Dim())
+	}
+	if r := HbarCSquared.Val() / (HbarC.Val() * HbarC.Val()); !almostEqual(r, 1, 1e-9) {
+		t.Errorf("(ℏc)² / ℏc ℏc = %v, want 1", r)
+	}
+
+	// (ℏc)² in GeV²⋅mb, with 1 fm² = 10 mb
+	if got := HbarCMeVfm * HbarCMeVfm * 1e-6 * 10; !almostEqual(got, HbarCSquaredGeV2mb, 1e-9) {
+		t.Errorf("(ℏc)² = %v GeV²⋅mb, want %v", got, HbarCSquaredGeV2mb)
+	}
+
+	// k_B in eV/K and its inverse
+	if got := BoltzmannConstant.Val() / ElectronVoltToJoule; !almostEqual(got/BoltzmannConstantEV, 1, 1e-9) {
+		t.Errorf("k_B = %v eV/K, want %v", got, BoltzmannConstantEV)
+	}
+	if !almostEqual(BoltzmannConstantEV*ElectronVoltToKelvin, 1, 1e-9) {
+		t.Errorf("k_B × (1 eV in K) = %v, want 1", BoltzmannConstantEV*ElectronVoltToKelvin)
+	}
+	if !almostEqual(PlanckConstantEV/PlanckReducedEV, 2*math.Pi, 1e-9) {
+		t.Errorf("h/ℏ = %v, want 2π", PlanckConstantEV/PlanckReducedEV)
+	}
+
+	// m_u c² = 931.494 MeV
+	if got := AtomicMassUnit.Val() * SpeedOfLight.Val() * SpeedOfLight.Val() / (1e6 * ElectronVoltToJoule); !almostEqual(got, AtomicMassUnitEnergyMeV, 1e-9) {
+		t.Errorf("m_u c² = %v MeV, want %v", got, AtomicMassUnitEnergyMeV)
+	}
+}
+
+func TestMolarMass(t *testing.T) {
+	water, err := MolarMass("H2O")
+	if err != nil || !almostEqual(water.ToGramsPerMole(), 18.015, 1e-9) {
+		t.Errorf("M(H2O) = %v, %v, want 18.015 g/mol", water, err)
+	}
+	if byName, _ := MolarMass("water"); byName != water {
+		t.Errorf("MolarMass(water) = %v, want %v", byName, water)
+	}
+	if _, err := MolarMass("unobtainium"); err == nil {
+		t.Error("MolarMass(unobtainium): expected error")
+	}
+
+	// The table is sorted, and the ISA sea-level density is ρ = pM/RT.
+	subs := Substances()
+	for i := 1; i < len(subs); i++ {
+		if subs[i].MolarMass.Val() < subs[i-1].MolarMass.Val() {
+			t.Errorf("%s lighter than %s", subs[i].Name, subs[i-1].Name)
+		}
+	}
+	air, _ := MolarMass("air")
+	rho := ISASeaLevel.Pressure.Val() * air.Val() / (UniversalGasConstant.Val() * ISASeaLevel.Temperature.Val())
+	if !almostEqual(rho, ISASeaLevel.Density.Val(), 1e-4) {
+		t.Errorf("pM/RT = %v, want %v", rho, ISASeaLevel.Density)
+	}
+
+	// M_u = N_A m_u
+	if r := AvogadroConstant.Val() * AtomicMassUnit.Val() / MolarMassConstant.Val(); !almostEqual(r, 1, 1e-9) {
+		t.Errorf("N_A m_u / M_u = %v, want 1", r)
+	}
+}
+
 func TestPlanckLength(t *testing.T) {
 	// l_P = √(ℏG/c³)
 	hbar := PlanckReduced.Val()
@@ -374,6 +600,35 @@ func TestMassRatios(t *testing.T) {
 	}
 }
 
+func TestMagneticConstants(t *testing.T) {
+	// μ_B/μ_N = m_p/m_e
+	if r := BohrMagneton.Val() / NuclearMagneton.Val(); !almostEqual(r, ProtonElectronMassRatio, 1e-9) {
+		t.Errorf("μ_B/μ_N = %v, want %v", r, ProtonElectronMassRatio)
+	}
+
+	// Proton NMR: 42.577 MHz in a 1 T field
+	if f := ProtonGyromagneticRatio.ToMegahertzPerTesla(); !almostEqual(f, 42.577478518, 1e-9) {
+		t.Errorf("γ_p/2π = %v MHz/T, want 42.577478518", f)
+	}
+	if f := NeutronGyromagneticRatio.ToMegahertzPerTesla(); !almostEqual(f, 29.1646931, 1e-8) {
+		t.Errorf("γ_n/2π = %v MHz/T, want 29.1646931", f)
+	}
+
+	// γ_e = |g_e| μ_B/ℏ
+	gamma := -ElectronGFactor * BohrMagneton.Val() / PlanckReduced.Val()
+	if !almostEqual(gamma/ElectronGyromagneticRatio.Val(), 1, 1e-9) {
+		t.Errorf("|g_e| μ_B/ℏ = %v, want %v", gamma, ElectronGyromagneticRatio.Val())
+	}
+
+	// a_e ≈ α/2π to leading order, within 0.2%
+	if r := ElectronMagneticMomentAnomaly / (FineStructureConstant.Val() / (2 * math.Pi)); !almostEqual(r, 1, 2e-3) {
+		t.Errorf("a_e/(α/2π) = %v, want ≈ 1", r)
+	}
+	if MuonMagneticMomentAnomaly <= ElectronMagneticMomentAnomaly {
+		t.Errorf("a_μ = %v, want > a_e = %v", MuonMagneticMomentAnomaly, ElectronMagneticMomentAnomaly)
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Example Usage Tests
 // -----------------------------------------------------------------------------
@@ -0,0 +1,120 @@
+package conversions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Factor is the size of one unit in the coherent SI unit of its quantity.
+// A value x in the unit is x·Scale + Offset in SI; Offset is zero except
+// on the affine temperature scales.
+type Factor struct {
+	Name     string
+	Symbol   string
+	Quantity string // name of the units type the unit measures, e.g. "Pressure"
+
+	Scale  float64
+	Offset float64
+
+	// Exact is true if the factor is fixed by definition, though it may
+	// not be representable as a float64, as with 1/3.6 or π/180. Inexact
+	// factors are measured and change between evaluations.
+	Exact  bool
+	Source string
+}
+
+// ToSI returns x in the unit converted to the coherent SI unit.
+func (f Factor) ToSI(x float64) float64 {
+	return x*f.Scale + f.Offset
+}
+
+// FromSI returns x in the coherent SI unit converted to the unit.
+func (f Factor) FromSI(x float64) float64 {
+	return (x - f.Offset) / f.Scale
+}
+
+// String returns the unit's name and symbol.
+func (f Factor) String() string {
+	return fmt.Sprintf("%s (%s)", f.Name, f.Symbol)
+}
+
+// Get returns the factor of the unit with the given symbol, such as "eV"
+// or "psi", or name, in any case, such as "electronvolt". Returns an
+// error if the unit is not in the table.
+func Get(unit string) (Factor, error) {
+	if i, ok := bySymbol[unit]; ok {
+		return factors[i], nil
+	}
+	if i, ok := byName[strings.ToLower(unit)]; ok {
+		return factors[i], nil
+	}
+	return Factor{}, fmt.Errorf("unknown unit %q", unit)
+}
+
+// All returns every factor in the table, grouped by quantity.
+func All() []Factor {
+	return append([]Factor(nil), factors...)
+}
+
+// OfQuantity returns the factors of the units measuring a quantity, named
+// like the units type, such as "Energy". Returns nil for an unknown
+// quantity.
+func OfQuantity(quantity string) []Factor {
+	var out []Factor
+	for _, f := range factors {
+		if f.Quantity == quantity {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Convert returns x in unit from expressed in unit to, both given by
+// symbol or name.
+//
+// Example:
+//
+//	p, _ := conversions.Convert(14.7, "psi", "kPa") // 101.35
+//	t, _ := conversions.Convert(100, "°C", "°F")    // 212
+//
+// Returns an error if either unit is unknown or they measure different
+// quantities.
+func Convert(x float64, from, to string) (float64, error) {
+	f, err := Get(from)
+	if err != nil {
+		return 0, err
+	}
+	t, err := Get(to)
+	if err != nil {
+		return 0, err
+	}
+	if f.Quantity != t.Quantity {
+		return 0, fmt.Errorf("cannot convert %s (%s) to %s (%s)", f.Symbol, f.Quantity, t.Symbol, t.Quantity)
+	}
+	return t.FromSI(f.ToSI(x)), nil
+}
+
+// -----------------------------------------------------------------------------
+// Table construction
+// -----------------------------------------------------------------------------
+
+var bySymbol, byName = index(factors)
+
+func index(table []Factor) (map[string]int, map[string]int) {
+	symbols := make(map[string]int, len(table))
+	names := make(map[string]int, len(table))
+	for i, f := range table {
+		if f.Scale <= 0 {
+			panic(fmt.Sprintf("conversions: %s has non-positive scale %g", f, f.Scale))
+		}
+		if _, dup := symbols[f.Symbol]; dup {
+			panic(fmt.Sprintf("conversions: duplicate symbol %q", f.Symbol))
+		}
+		key := strings.ToLower(f.Name)
+		if _, dup := names[key]; dup {
+			panic(fmt.Sprintf("conversions: duplicate name %q", f.Name))
+		}
+		symbols[f.Symbol], names[key] = i, i
+	}
+	return symbols, names
+}
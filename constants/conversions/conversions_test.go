@@ -0,0 +1,238 @@
+package conversions
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// constructors maps table symbols to the units constructors they describe.
+var constructors = map[string]any{
+	"m": units.Meter, "mm": units.Millimeter, "cm": units.Centimeter, "km": units.Kilometer,
+	"µm": units.Micrometer, "nm": units.Nanometer, "Å": units.Angstrom, "in": units.Inch,
+	"ft": units.Foot, "mi": units.Mile, "au": units.AstronomicalUnit, "ly": units.LightYear,
+	"pc": units.Parsec, "Mpc": units.Megaparsec,
+
+	"kg": units.Kilogram, "g": units.Gram, "mg": units.Milligram, "µg": units.Microgram,
+	"t": units.Tonne, "lb": units.Pound, "oz": units.Ounce, "u": units.AtomicMassUnit,
+	"MeV/c²": units.MegaelectronVoltPerC2, "GeV/c²": units.GigaelectronVoltPerC2,
+	"M☉": units.SolarMass, "M⊕": units.EarthMass,
+
+	"s": units.Second, "ms": units.Millisecond, "µs": units.Microsecond, "ns": units.Nanosecond,
+	"min": units.Minute, "h": units.Hour, "d": units.Day, "wk": units.Week, "yr": units.Year,
+
+	"A": units.Ampere, "mA": units.Milliampere, "µA": units.Microampere, "kA": units.Kiloampere,
+	"K": units.Kelvin, "°C": units.Celsius, "°F": units.Fahrenheit,
+	"mol": units.Mole, "mmol": units.Millimole, "µmol": units.Micromole, "kmol": units.Kilomole,
+	"cd": units.Candela, "mcd": units.Millicandela, "kcd": units.Kilocandela,
+
+	"m²": units.SquareMeter, "cm²": units.SquareCentimeter, "km²": units.SquareKilometer,
+	"ha": units.Hectare, "b": units.Barn, "mb": units.Millibarn,
+	"m³": units.CubicMeter, "L": units.Liter, "mL": units.Milliliter, "cm³": units.CubicCentimeter,
+	"m/s": units.MeterPerSecond, "km/h": units.KilometerPerHour, "mph": units.MilePerHour,
+	"c": units.SpeedOfLight, "m/s²": units.MeterPerSecond2, "g₀": units.StandardGravity,
+	"m³/s²": units.CubicMeterPerSecond2, "km³/s²": units.CubicKilometerPerSecond2,
+
+	"N": units.Newton, "kN": units.Kilonewton, "dyn": units.Dyne, "lbf": units.PoundForce,
+	"kg·m/s": units.KilogramMeterPerSecond, "MeV/c": units.MegaelectronVoltPerC, "GeV/c": units.GigaelectronVoltPerC,
+
+	"J": units.Joule, "kJ": units.Kilojoule, "MJ": units.Megajoule, "cal": units.Calorie,
+	"kcal": units.Kilocalorie, "eV": units.ElectronVolt, "keV": units.KiloelectronVolt,
+	"MeV": units.MegaelectronVolt, "GeV": units.GigaelectronVolt, "E_h": units.Hartree,
+	"W": units.Watt, "kW": units.Kilowatt, "MW": units.Megawatt, "GW": units.Gigawatt,
+	"hp": units.Horsepower,
+	"Pa": units.Pascal, "kPa": units.Kilopascal, "MPa": units.Megapascal, "bar": units.Bar,
+	"atm": units.Atmosphere, "Torr": units.Torr, "psi": units.PSI,
+
+	"kg/m³": units.KilogramPerMeter3, "g/cm³": units.GramPerCentimeter3,
+	"m⁻³": units.PerMeter3, "cm⁻³": units.PerCentimeter3,
+	"kg/mol": units.KilogramPerMole, "g/mol": units.GramPerMole,
+	"N/m": units.NewtonPerMeter, "N·s/m": units.NewtonSecondPerMeter,
+	"Pa·s": units.PascalSecond, "cP": units.Centipoise,
+	"m²/s": units.SquareMeterPerSecond, "cSt": units.Centistokes,
+	"m³/s": units.CubicMeterPerSecond, "L/min": units.LiterPerMinute,
+	"kg/s": units.KilogramPerSecond, "kg·m²": units.KilogramMeter2, "N·m": units.NewtonMeter,
+	"kg·m²/s": units.KilogramMeter2PerSecond,
+
+	"Hz": units.Hertz, "kHz": units.Kilohertz, "MHz": units.Megahertz, "GHz": units.Gigahertz,
+	"km/s/Mpc": units.KilometerPerSecondPerMegaparsec,
+
+	"rad": units.Radian, "°": units.Degree, "′": units.ArcMinute, "″": units.ArcSecond,
+	"ʰ": units.HourAngle, "rad/s": units.RadianPerSecond, "rpm": units.RPM,
+	"Bq": units.Becquerel, "Ci": units.Curie, "µCi": units.Microcurie,
+
+	"C": units.Coulomb, "mC": units.Millicoulomb, "µC": units.Microcoulomb, "e": units.ElementaryCharge,
+	"V": units.Volt, "mV": units.Millivolt, "µV": units.Microvolt, "kV": units.Kilovolt,
+	"Ω": units.Ohm, "mΩ": units.Milliohm, "kΩ": units.Kiloohm, "MΩ": units.Megaohm,
+	"F": units.Farad, "µF": units.Microfarad, "nF": units.Nanofarad, "pF": units.Picofarad,
+	"H": units.Henry, "mH": units.Millihenry, "µH": units.Microhenry,
+	"T": units.Tesla, "mT": units.Millitesla, "µT": units.Microtesla, "nT": units.Nanotesla,
+	"G": units.Gauss, "Wb": units.Weber, "mWb": units.Milliweber, "Mx": units.Maxwell,
+	"A·m²": units.AmpereMeter2, "J/T": units.JoulePerTesla, "μ_B": units.BohrMagneton, "μ_N": units.NuclearMagneton,
+	"rad/(s·T)": units.RadianPerSecondTesla, "MHz/T": units.MegahertzPerTesla,
+	"V/m": units.VoltPerMeter, "kV/m": units.KilovoltPerMeter,
+	"W/m²": units.WattPerMeter2, "mW/cm²": units.MilliwattPerCentimeter2,
+
+	"ΔK": units.KelvinDelta, "Δ°F": units.FahrenheitDelta,
+	"W/(m·K)": units.WattPerMeterKelvin, "W/(m²·K)": units.WattPerMeter2Kelvin,
+	"J/K": units.JoulePerKelvin, "J/(kg·K)": units.JoulePerKilogramKelvin,
+}
+
+// construct calls a units constructor and returns the SI value and the
+// name of the type it returns.
+func construct(fn any, x float64) (float64, string) {
+	out := reflect.ValueOf(fn).Call([]reflect.Value{reflect.ValueOf(x)})[0]
+	return out.Interface().(interface{ Val() float64 }).Val(), out.Type().Name()
+}
+
+// TestConstructors generates one subtest per table row with a units
+// constructor, checking the constructor's type and its values at 0 and 1
+// against the row's Quantity, Offset and Scale.
+func TestConstructors(t *testing.T) {
+	for _, f := range All() {
+		fn, ok := constructors[f.Symbol]
+		if !ok {
+			continue
+		}
+		t.Run(f.Symbol, func(t *testing.T) {
+			zero, typ := construct(fn, 0)
+			if typ != f.Quantity {
+				t.Errorf("constructor returns %s, table says %s", typ, f.Quantity)
+			}
+			if !almostEqual(zero, f.Offset, 1e-15) {
+				t.Errorf("0 %s = %.17g SI, table offset %.17g", f.Symbol, zero, f.Offset)
+			}
+			if one, _ := construct(fn, 1); !almostEqual(one, f.ToSI(1), 1e-15) {
+				t.Errorf("1 %s = %.17g SI, table %.17g", f.Symbol, one, f.ToSI(1))
+			}
+		})
+	}
+	for sym := range constructors {
+		if _, err := Get(sym); err != nil {
+			t.Errorf("constructor for %q has no table row", sym)
+		}
+	}
+}
+
+// TestCoverage finds the unit constructors in the units package source,
+// exported functions of one float64 named value, and requires each to
+// appear in constructors and so in the table.
+func TestCoverage(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, fn := range constructors {
+		name := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+		covered[name[strings.LastIndex(name, ".")+1:]] = true
+	}
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "units", "*.go"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("cannot find the units package source: %v", err)
+	}
+	fset := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !fn.Name.IsExported() || !isConstructor(fn.Type) {
+				continue
+			}
+			if fn.Name.Name == "Dimensionless" {
+				continue // a pure number, not a unit
+			}
+			if !covered[fn.Name.Name] {
+				t.Errorf("units.%s (%s) has no row in the conversion table", fn.Name.Name, fset.Position(fn.Pos()))
+			}
+		}
+	}
+}
+
+// isConstructor reports whether a function takes a single float64 named
+// value and returns a single result.
+func isConstructor(ft *ast.FuncType) bool {
+	params := ft.Params.List
+	if len(params) != 1 || len(params[0].Names) != 1 || params[0].Names[0].Name != "value" {
+		return false
+	}
+	id, ok := params[0].Type.(*ast.Ident)
+	return ok && id.Name == "float64" && ft.Results != nil && len(ft.Results.List) == 1
+}
+
+func TestParse(t *testing.T) {
+	// Symbols units.Parse understands must mean the same unit there.
+	for _, f := range All() {
+		if f.Offset != 0 || f.Symbol == "MHz/T" {
+			continue // MHz/T quotes γ/2π, so a cycle is implied that Parse cannot see
+		}
+		v, err := units.Parse("1 " + f.Symbol)
+		if err != nil {
+			continue
+		}
+		if !almostEqual(v.Val(), f.Scale, 1e-15) {
+			t.Errorf("Parse(1 %s) = %.17g, table scale %.17g", f.Symbol, v.Val(), f.Scale)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	for _, tc := range []struct {
+		x        float64
+		from, to string
+		want     float64
+	}{
+		{14.7, "psi", "kPa", 101.35293221},
+		{100, "°C", "°F", 212},
+		{-40, "°F", "degree Celsius", -40},
+		{1, "Btu", "J", 1055.05585262},
+		{1, "kWh", "MJ", 3.6},
+		{760, "Torr", "atm", 1},
+		{1, "mmHg", "Torr", 1.000000142},
+		{1, "kn", "km/h", 1.852},
+		{1, "au", "km", 1.495978707e8},
+		{1, "eV", "erg", 1.602176634e-12},
+	} {
+		got, err := Convert(tc.x, tc.from, tc.to)
+		if err != nil || !almostEqual(got, tc.want, 1e-9) {
+			t.Errorf("Convert(%v, %s, %s) = %v, %v, want %v", tc.x, tc.from, tc.to, got, err, tc.want)
+		}
+	}
+	if _, err := Convert(1, "eV", "K"); err == nil {
+		t.Error("Convert(eV, K): expected error for different quantities")
+	}
+	if _, err := Convert(1, "furlong", "m"); err == nil {
+		t.Error("Convert(furlong): expected error for unknown unit")
+	}
+}
+
+func TestExactness(t *testing.T) {
+	for _, sym := range []string{"u", "M☉", "M⊕"} {
+		if f, _ := Get(sym); f.Exact {
+			t.Errorf("%s is measured, not exact", f)
+		}
+	}
+	for _, sym := range []string{"eV", "in", "cal", "atm", "Torr", "pc"} {
+		if f, _ := Get(sym); !f.Exact {
+			t.Errorf("%s is exact by definition", f)
+		}
+	}
+	if f, err := Get("ElectronVolt"); err != nil || f.Symbol != "eV" {
+		t.Errorf("Get by name = %v, %v", f, err)
+	}
+}
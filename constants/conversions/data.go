@@ -0,0 +1,288 @@
+package conversions
+
+import (
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+)
+
+// Sources of the defining values.
+const (
+	si       = "SI"
+	si2019   = "SI 2019 (fixed e, h, k)"
+	cgs      = "CGS"
+	imperial = "International yard and pound (1959)"
+	iau2012  = "IAU 2012 Resolution B2"
+	iau2015  = "IAU 2015 Resolution B2"
+	codata   = "CODATA 2018"
+)
+
+var (
+	e  = constants.ElementaryCharge.Val()
+	c  = constants.SpeedOfLight.Val()
+	au = constants.AstronomicalUnit.Val()
+	pc = constants.Parsec.Val()
+	g0 = constants.StandardGravity.Val()
+
+	lbf = 0.45359237 * g0 // pound-force
+)
+
+// factors lists every unit the units package has a constructor for,
+// grouped by the units type it returns, followed by common units without
+// one. Exact factors are fixed by definition; measured ones come from the
+// constants package.
+var factors = []Factor{
+	// Length
+	{"meter", "m", "Length", 1, 0, true, si},
+	{"millimeter", "mm", "Length", 1e-3, 0, true, si},
+	{"centimeter", "cm", "Length", 1e-2, 0, true, si},
+	{"kilometer", "km", "Length", 1e3, 0, true, si},
+	{"micrometer", "µm", "Length", 1e-6, 0, true, si},
+	{"nanometer", "nm", "Length", 1e-9, 0, true, si},
+	{"ångström", "Å", "Length", 1e-10, 0, true, "ISO 80000-3"},
+	{"inch", "in", "Length", 0.0254, 0, true, imperial},
+	{"foot", "ft", "Length", 0.3048, 0, true, imperial},
+	{"mile", "mi", "Length", 1609.344, 0, true, imperial},
+	{"astronomical unit", "au", "Length", au, 0, true, iau2012},
+	{"light-year", "ly", "Length", c * 365.25 * 86400, 0, true, "IAU (Julian year × c)"},
+	{"parsec", "pc", "Length", pc, 0, true, iau2015},
+	{"megaparsec", "Mpc", "Length", pc * 1e6, 0, true, iau2015},
+	{"nautical mile", "nmi", "Length", 1852, 0, true, "International Hydrographic Conference (1929)"},
+
+	// Mass
+	{"kilogram", "kg", "Mass", 1, 0, true, si2019},
+	{"gram", "g", "Mass", 1e-3, 0, true, si},
+	{"milligram", "mg", "Mass", 1e-6, 0, true, si},
+	{"microgram", "µg", "Mass", 1e-9, 0, true, si},
+	{"tonne", "t", "Mass", 1e3, 0, true, si},
+	{"pound", "lb", "Mass", 0.45359237, 0, true, imperial},
+	{"ounce", "oz", "Mass", 0.45359237 / 16, 0, true, imperial},
+	{"atomic mass unit", "u", "Mass", constants.AtomicMassUnit.Val(), 0, false, codata},
+	{"megaelectronvolt per c²", "MeV/c²", "Mass", 1e6 * e / (c * c), 0, true, si2019},
+	{"gigaelectronvolt per c²", "GeV/c²", "Mass", 1e9 * e / (c * c), 0, true, si2019},
+	{"solar mass", "M☉", "Mass", constants.SolarMass.Val(), 0, false, "constants.SolarMass"},
+	{"Earth mass", "M⊕", "Mass", constants.EarthMass.Val(), 0, false, "constants.EarthMass"},
+
+	// Time
+	{"second", "s", "Time", 1, 0, true, si2019},
+	{"millisecond", "ms", "Time", 1e-3, 0, true, si},
+	{"microsecond", "µs", "Time", 1e-6, 0, true, si},
+	{"nanosecond", "ns", "Time", 1e-9, 0, true, si},
+	{"minute", "min", "Time", 60, 0, true, si},
+	{"hour", "h", "Time", 3600, 0, true, si},
+	{"day", "d", "Time", 86400, 0, true, si},
+	{"week", "wk", "Time", 604800, 0, true, si},
+	{"Julian year", "yr", "Time", 365.25 * 86400, 0, true, "IAU"},
+
+	// Current
+	{"ampere", "A", "Current", 1, 0, true, si2019},
+	{"milliampere", "mA", "Current", 1e-3, 0, true, si},
+	{"microampere", "µA", "Current", 1e-6, 0, true, si},
+	{"kiloampere", "kA", "Current", 1e3, 0, true, si},
+
+	// Temperature, on affine scales
+	{"kelvin", "K", "Temperature", 1, 0, true, si2019},
+	{"degree Celsius", "°C", "Temperature", 1, 273.15, true, si},
+	{"degree Fahrenheit", "°F", "Temperature", 5.0 / 9, 459.67 * 5 / 9, true, imperial},
+
+	// Amount
+	{"mole", "mol", "Amount", 1, 0, true, si2019},
+	{"millimole", "mmol", "Amount", 1e-3, 0, true, si},
+	{"micromole", "µmol", "Amount", 1e-6, 0, true, si},
+	{"kilomole", "kmol", "Amount", 1e3, 0, true, si},
+
+	// LuminousIntensity
+	{"candela", "cd", "LuminousIntensity", 1, 0, true, si},
+	{"millicandela", "mcd", "LuminousIntensity", 1e-3, 0, true, si},
+	{"kilocandela", "kcd", "LuminousIntensity", 1e3, 0, true, si},
+
+	// Area
+	{"square meter", "m²", "Area", 1, 0, true, si},
+	{"square centimeter", "cm²", "Area", 1e-4, 0, true, si},
+	{"square kilometer", "km²", "Area", 1e6, 0, true, si},
+	{"hectare", "ha", "Area", 1e4, 0, true, si},
+	{"barn", "b", "Area", 1e-28, 0, true, si},
+	{"millibarn", "mb", "Area", 1e-31, 0, true, si},
+
+	// Volume
+	{"cubic meter", "m³", "Volume", 1, 0, true, si},
+	{"liter", "L", "Volume", 1e-3, 0, true, si},
+	{"milliliter", "mL", "Volume", 1e-6, 0, true, si},
+	{"cubic centimeter", "cm³", "Volume", 1e-6, 0, true, si},
+	{"US gallon", "gal", "Volume", 231 * 0.0254 * 0.0254 * 0.0254, 0, true, imperial},
+
+	// Velocity
+	{"meter per second", "m/s", "Velocity", 1, 0, true, si},
+	{"kilometer per hour", "km/h", "Velocity", 1 / 3.6, 0, true, si},
+	{"mile per hour", "mph", "Velocity", 0.44704, 0, true, imperial},
+	{"speed of light", "c", "Velocity", c, 0, true, si2019},
+	{"knot", "kn", "Velocity", 1852.0 / 3600, 0, true, "International Hydrographic Conference (1929)"},
+
+	// Acceleration
+	{"meter per second squared", "m/s²", "Acceleration", 1, 0, true, si},
+	{"standard gravity", "g₀", "Acceleration", g0, 0, true, "3rd CGPM (1901)"},
+
+	// StandardGravitationalParameter
+	{"cubic meter per second squared", "m³/s²", "StandardGravitationalParameter", 1, 0, true, si},
+	{"cubic kilometer per second squared", "km³/s²", "StandardGravitationalParameter", 1e9, 0, true, si},
+
+	// Force
+	{"newton", "N", "Force", 1, 0, true, si},
+	{"kilonewton", "kN", "Force", 1e3, 0, true, si},
+	{"dyne", "dyn", "Force", 1e-5, 0, true, cgs},
+	{"pound-force", "lbf", "Force", lbf, 0, true, imperial},
+
+	// Momentum
+	{"kilogram meter per second", "kg·m/s", "Momentum", 1, 0, true, si},
+	{"megaelectronvolt per c", "MeV/c", "Momentum", 1e6 * e / c, 0, true, si2019},
+	{"gigaelectronvolt per c", "GeV/c", "Momentum", 1e9 * e / c, 0, true, si2019},
+
+	// Energy
+	{"joule", "J", "Energy", 1, 0, true, si},
+	{"kilojoule", "kJ", "Energy", 1e3, 0, true, si},
+	{"megajoule", "MJ", "Energy", 1e6, 0, true, si},
+	{"thermochemical calorie", "cal", "Energy", 4.184, 0, true, "thermochemical"},
+	{"thermochemical kilocalorie", "kcal", "Energy", 4184, 0, true, "thermochemical"},
+	{"electronvolt", "eV", "Energy", e, 0, true, si2019},
+	{"kiloelectronvolt", "keV", "Energy", 1e3 * e, 0, true, si2019},
+	{"megaelectronvolt", "MeV", "Energy", 1e6 * e, 0, true, si2019},
+	{"gigaelectronvolt", "GeV", "Energy", 1e9 * e, 0, true, si2019},
+	{"hartree", "E_h", "Energy", constants.HartreeEnergy.Val(), 0, false, codata},
+	{"International Table calorie", "cal_IT", "Energy", 4.1868, 0, true, "5th International Steam Table Conference (1956)"},
+	{"British thermal unit", "Btu", "Energy", 4.1868 * 453.59237 / 1.8, 0, true, "International Table"},
+	{"kilowatt hour", "kWh", "Energy", 3.6e6, 0, true, si},
+	{"erg", "erg", "Energy", 1e-7, 0, true, cgs},
+
+	// Power
+	{"watt", "W", "Power", 1, 0, true, si},
+	{"kilowatt", "kW", "Power", 1e3, 0, true, si},
+	{"megawatt", "MW", "Power", 1e6, 0, true, si},
+	{"gigawatt", "GW", "Power", 1e9, 0, true, si},
+	{"mechanical horsepower", "hp", "Power", 550 * 0.3048 * lbf, 0, true, imperial},
+
+	// Pressure
+	{"pascal", "Pa", "Pressure", 1, 0, true, si},
+	{"kilopascal", "kPa", "Pressure", 1e3, 0, true, si},
+	{"megapascal", "MPa", "Pressure", 1e6, 0, true, si},
+	{"bar", "bar", "Pressure", 1e5, 0, true, si},
+	{"standard atmosphere", "atm", "Pressure", 101325, 0, true, "10th CGPM (1954)"},
+	{"torr", "Torr", "Pressure", 101325.0 / 760, 0, true, "1/760 atm"},
+	{"pound-force per square inch", "psi", "Pressure", lbf / (0.0254 * 0.0254), 0, true, imperial},
+	{"conventional millimeter of mercury", "mmHg", "Pressure", 133.322387415, 0, true, "13.5951 g/cm³ × g₀ × 1 mm"},
+
+	// Density
+	{"kilogram per cubic meter", "kg/m³", "Density", 1, 0, true, si},
+	{"gram per cubic centimeter", "g/cm³", "Density", 1e3, 0, true, si},
+
+	// NumberDensity
+	{"per cubic meter", "m⁻³", "NumberDensity", 1, 0, true, si},
+	{"per cubic centimeter", "cm⁻³", "NumberDensity", 1e6, 0, true, si},
+
+	// MolarMass
+	{"kilogram per mole", "kg/mol", "MolarMass", 1, 0, true, si},
+	{"gram per mole", "g/mol", "MolarMass", 1e-3, 0, true, si},
+
+	// Mechanical and fluid quantities
+	{"newton per meter", "N/m", "SpringConstant", 1, 0, true, si},
+	{"newton second per meter", "N·s/m", "DampingCoefficient", 1, 0, true, si},
+	{"pascal second", "Pa·s", "Viscosity", 1, 0, true, si},
+	{"centipoise", "cP", "Viscosity", 1e-3, 0, true, cgs},
+	{"square meter per second", "m²/s", "KinematicViscosity", 1, 0, true, si},
+	{"centistokes", "cSt", "KinematicViscosity", 1e-6, 0, true, cgs},
+	{"cubic meter per second", "m³/s", "VolumetricFlowRate", 1, 0, true, si},
+	{"liter per minute", "L/min", "VolumetricFlowRate", 1e-3 / 60, 0, true, si},
+	{"kilogram per second", "kg/s", "MassFlowRate", 1, 0, true, si},
+	{"kilogram square meter", "kg·m²", "MomentOfInertia", 1, 0, true, si},
+	{"newton meter", "N·m", "Torque", 1, 0, true, si},
+	{"kilogram square meter per second", "kg·m²/s", "AngularMomentum", 1, 0, true, si},
+
+	// Frequency
+	{"hertz", "Hz", "Frequency", 1, 0, true, si},
+	{"kilohertz", "kHz", "Frequency", 1e3, 0, true, si},
+	{"megahertz", "MHz", "Frequency", 1e6, 0, true, si},
+	{"gigahertz", "GHz", "Frequency", 1e9, 0, true, si},
+	{"kilometer per second per megaparsec", "km/s/Mpc", "Frequency", 1e3 / (pc * 1e6), 0, true, iau2015},
+
+	// Angle
+	{"radian", "rad", "Angle", 1, 0, true, si},
+	{"degree", "°", "Angle", math.Pi / 180, 0, true, si},
+	{"arcminute", "′", "Angle", math.Pi / (180 * 60), 0, true, si},
+	{"arcsecond", "″", "Angle", math.Pi / (180 * 3600), 0, true, si},
+	{"hour angle", "ʰ", "Angle", math.Pi / 12, 0, true, "360°/24"},
+
+	// AngularVelocity
+	{"radian per second", "rad/s", "AngularVelocity", 1, 0, true, si},
+	{"revolution per minute", "rpm", "AngularVelocity", 2 * math.Pi / 60, 0, true, "2π rad/min"},
+
+	// Activity
+	{"becquerel", "Bq", "Activity", 1, 0, true, si},
+	{"curie", "Ci", "Activity", 3.7e10, 0, true, "12th CGPM (1964)"},
+	{"microcurie", "µCi", "Activity", 3.7e4, 0, true, "12th CGPM (1964)"},
+
+	// Charge
+	{"coulomb", "C", "Charge", 1, 0, true, si},
+	{"millicoulomb", "mC", "Charge", 1e-3, 0, true, si},
+	{"microcoulomb", "µC", "Charge", 1e-6, 0, true, si},
+	{"elementary charge", "e", "Charge", e, 0, true, si2019},
+
+	// Voltage
+	{"volt", "V", "Voltage", 1, 0, true, si},
+	{"millivolt", "mV", "Voltage", 1e-3, 0, true, si},
+	{"microvolt", "µV", "Voltage", 1e-6, 0, true, si},
+	{"kilovolt", "kV", "Voltage", 1e3, 0, true, si},
+
+	// Resistance
+	{"ohm", "Ω", "Resistance", 1, 0, true, si},
+	{"milliohm", "mΩ", "Resistance", 1e-3, 0, true, si},
+	{"kiloohm", "kΩ", "Resistance", 1e3, 0, true, si},
+	{"megaohm", "MΩ", "Resistance", 1e6, 0, true, si},
+
+	// Capacitance
+	{"farad", "F", "Capacitance", 1, 0, true, si},
+	{"microfarad", "µF", "Capacitance", 1e-6, 0, true, si},
+	{"nanofarad", "nF", "Capacitance", 1e-9, 0, true, si},
+	{"picofarad", "pF", "Capacitance", 1e-12, 0, true, si},
+
+	// Inductance
+	{"henry", "H", "Inductance", 1, 0, true, si},
+	{"millihenry", "mH", "Inductance", 1e-3, 0, true, si},
+	{"microhenry", "µH", "Inductance", 1e-6, 0, true, si},
+
+	// MagneticField
+	{"tesla", "T", "MagneticField", 1, 0, true, si},
+	{"millitesla", "mT", "MagneticField", 1e-3, 0, true, si},
+	{"microtesla", "µT", "MagneticField", 1e-6, 0, true, si},
+	{"nanotesla", "nT", "MagneticField", 1e-9, 0, true, si},
+	{"gauss", "G", "MagneticField", 1e-4, 0, true, cgs},
+
+	// MagneticFlux
+	{"weber", "Wb", "MagneticFlux", 1, 0, true, si},
+	{"milliweber", "mWb", "MagneticFlux", 1e-3, 0, true, si},
+	{"maxwell", "Mx", "MagneticFlux", 1e-8, 0, true, cgs},
+
+	// MagneticMoment
+	{"ampere square meter", "A·m²", "MagneticMoment", 1, 0, true, si},
+	{"joule per tesla", "J/T", "MagneticMoment", 1, 0, true, si},
+	{"Bohr magneton", "μ_B", "MagneticMoment", constants.BohrMagneton.Val(), 0, false, codata},
+	{"nuclear magneton", "μ_N", "MagneticMoment", constants.NuclearMagneton.Val(), 0, false, codata},
+
+	// GyromagneticRatio
+	{"radian per second tesla", "rad/(s·T)", "GyromagneticRatio", 1, 0, true, si},
+	{"megahertz per tesla", "MHz/T", "GyromagneticRatio", 2 * math.Pi * 1e6, 0, true, "γ/2π"},
+
+	// ElectricField
+	{"volt per meter", "V/m", "ElectricField", 1, 0, true, si},
+	{"kilovolt per meter", "kV/m", "ElectricField", 1e3, 0, true, si},
+
+	// Irradiance
+	{"watt per square meter", "W/m²", "Irradiance", 1, 0, true, si},
+	{"milliwatt per square centimeter", "mW/cm²", "Irradiance", 10, 0, true, si},
+
+	// Thermal quantities
+	{"kelvin (difference)", "ΔK", "TemperatureDelta", 1, 0, true, si},
+	{"degree Fahrenheit (difference)", "Δ°F", "TemperatureDelta", 5.0 / 9, 0, true, imperial},
+	{"watt per meter kelvin", "W/(m·K)", "ThermalConductivity", 1, 0, true, si},
+	{"watt per square meter kelvin", "W/(m²·K)", "HeatTransferCoefficient", 1, 0, true, si},
+	{"joule per kelvin", "J/K", "HeatCapacity", 1, 0, true, si},
+	{"joule per kilogram kelvin", "J/(kg·K)", "SpecificHeat", 1, 0, true, si},
+}
@@ -0,0 +1,30 @@
+// Package conversions provides the scale factors behind the unit
+// constructors of the units package as one queryable table.
+//
+// Each Factor gives the size of a unit in the coherent SI unit of its
+// quantity, with a flag telling whether the factor is exact by definition —
+// the inch, the calorie, and since 2019 the electronvolt — or measured, as
+// the atomic mass unit and the solar mass. The table covers every units
+// constructor and a few common units without one, such as the British
+// thermal unit and the knot. The package's tests check each constructor
+// against its row, so a mistyped or outdated literal in the units package
+// fails the build.
+//
+// Example usage:
+//
+//	import "github.com/sakiphan/qsim-core/constants/conversions"
+//
+//	f, _ := conversions.Get("eV")
+//	fmt.Println(f.Scale, f.Exact) // 1.602176634e-19 true
+//
+//	p, _ := conversions.Convert(14.7, "psi", "kPa") // 101.35
+//
+//	for _, f := range conversions.OfQuantity("Energy") {
+//	    fmt.Println(f)
+//	}
+//
+// References:
+//   - BIPM, "The International System of Units (SI)", 9th ed. (2019)
+//   - NIST Special Publication 811, "Guide for the Use of the International
+//     System of Units (SI)" (2008), Appendix B
+package conversions
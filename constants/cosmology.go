@@ -0,0 +1,122 @@
+package constants
+
+import (
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Cosmological Parameter Sets
+// -----------------------------------------------------------------------------
+
+// CosmologyParams is a set of ΛCDM cosmological parameters as published by
+// one analysis. Density parameters are fractions of the critical density
+// today. Taking H₀ and the densities from one set keeps them consistent.
+// The astro/cosmology package builds its Parameters from these with
+// cosmology.FromParams.
+type CosmologyParams struct {
+	Name string
+
+	H0          units.Frequency // Hubble constant
+	OmegaB      float64         // baryons
+	OmegaC      float64         // cold dark matter
+	OmegaNu     float64         // massive neutrinos, non-relativistic today
+	OmegaLambda float64         // cosmological constant
+
+	Ns     float64 // scalar spectral index
+	Sigma8 float64 // amplitude of matter fluctuations in 8 h⁻¹ Mpc spheres
+
+	TCMB units.Temperature // CMB temperature today
+	NEff float64           // effective number of relativistic neutrino species
+}
+
+// HubbleParameter returns the dimensionless h = H₀ / (100 km/s/Mpc).
+func (p CosmologyParams) HubbleParameter() float64 {
+	return p.H0.Val() / units.KilometerPerSecondPerMegaparsec(100).Val()
+}
+
+// HubbleTime returns the Hubble time 1/H₀.
+func (p CosmologyParams) HubbleTime() units.Time {
+	return units.Second(1 / p.H0.Val())
+}
+
+// CriticalDensity returns the critical density ρ_c = 3H₀²/(8πG).
+func (p CosmologyParams) CriticalDensity() units.Value {
+	h0 := p.H0.Val()
+	return units.NewValue(3*h0*h0/(8*math.Pi*GravitationalConstant.Val()), units.Dimension{L: -3, M: 1})
+}
+
+// OmegaM returns the total matter density Ω_b + Ω_c + Ω_ν.
+func (p CosmologyParams) OmegaM() float64 {
+	return p.OmegaB + p.OmegaC + p.OmegaNu
+}
+
+// OmegaR returns the radiation density of CMB photons and NEff species of
+// relativistic neutrinos at the neutrino temperature (4/11)^⅓ T_CMB:
+//
+//	Ω_r = Ω_γ (1 + (7/8)(4/11)^{4/3} N_eff),   Ω_γ = 4σT⁴ / (c³ ρ_c)
+func (p CosmologyParams) OmegaR() float64 {
+	c := SpeedOfLight.Val()
+	t := p.TCMB.Val()
+	gamma := 4 * StefanBoltzmannConstant.Val() * t * t * t * t / (c * c * c) / p.CriticalDensity().Val()
+	return gamma * (1 + 7.0/8*math.Pow(4.0/11, 4.0/3)*p.NEff)
+}
+
+// OmegaK returns the curvature density 1 - Ω_m - Ω_r - Ω_Λ.
+func (p CosmologyParams) OmegaK() float64 {
+	return 1 - p.OmegaM() - p.OmegaR() - p.OmegaLambda
+}
+
+// flat returns the flat cosmology with the given physical densities
+// ω = Ω h², closing with Ω_Λ.
+func flat(name string, h0, omegaBh2, omegaCh2, omegaNuh2, ns, sigma8, tcmb, neff float64) CosmologyParams {
+	p := CosmologyParams{
+		Name:   name,
+		H0:     units.KilometerPerSecondPerMegaparsec(h0),
+		Ns:     ns,
+		Sigma8: sigma8,
+		TCMB:   units.Kelvin(tcmb),
+		NEff:   neff,
+	}
+	h2 := p.HubbleParameter() * p.HubbleParameter()
+	p.OmegaB, p.OmegaC, p.OmegaNu = omegaBh2/h2, omegaCh2/h2, omegaNuh2/h2
+	p.OmegaLambda = 1 - p.OmegaM() - p.OmegaR()
+	return p
+}
+
+// CosmologyPlanck2018 is flat ΛCDM from the Planck 2018
+// TT,TE,EE+lowE+lensing+BAO analysis, with one massive neutrino of
+// 0.06 eV (Ω_ν h² = Σm_ν / 93.14 eV).
+// H₀ = 67.66 km/s/Mpc, Ω_m = 0.3111, n_s = 0.9665, σ₈ = 0.8102
+//
+// References:
+//   - Planck Collaboration. "Planck 2018 results. VI. Cosmological
+//     parameters", A&A 641, A6 (2020), Table 2
+var CosmologyPlanck2018 = flat("Planck 2018", 67.66, 0.02242, 0.11933, 0.06/93.14, 0.9665, 0.8102, 2.7255, 3.046)
+
+// CosmologyWMAP9 is flat ΛCDM from the nine-year WMAP analysis combined
+// with high-resolution CMB, BAO and H₀ data, with massless neutrinos.
+// H₀ = 69.32 km/s/Mpc, Ω_m = 0.2865, n_s = 0.9608, σ₈ = 0.820
+//
+// References:
+//   - Hinshaw et al. "Nine-year Wilkinson Microwave Anisotropy Probe
+//     (WMAP) observations: cosmological parameter results", ApJS 208, 19
+//     (2013), Table 4 (WMAP+eCMB+BAO+H₀)
+var CosmologyWMAP9 = flat("WMAP9", 69.32, 0.02224, 0.1154, 0, 0.9608, 0.820, 2.725, 3.04)
+
+// CosmologySH0ES is CosmologyPlanck2018 with the distance-ladder Hubble
+// constant of SH0ES, H₀ = 73.04(104) km/s/Mpc, keeping the Planck density
+// parameters Ω. It brackets the Hubble tension against the Planck value.
+//
+// References:
+//   - Riess et al. "A comprehensive measurement of the local value of the
+//     Hubble constant with 1 km/s/Mpc uncertainty from the Hubble Space
+//     Telescope and the SH0ES team", ApJL 934, L7 (2022)
+var CosmologySH0ES = func() CosmologyParams {
+	p := CosmologyPlanck2018
+	p.Name = "SH0ES"
+	p.H0 = units.KilometerPerSecondPerMegaparsec(73.04)
+	p.OmegaLambda = 1 - p.OmegaM() - p.OmegaR()
+	return p
+}()
@@ -1,11 +1,32 @@
 // Package constants provides fundamental physical constants with high precision.
 //
-// All values are based on the CODATA 2018 internationally recommended values
-// and Planck 2018 cosmological parameters where applicable.
-//
-// Constants are provided as unit-safe types from the units package, ensuring
+// Values follow the CODATA 2018 recommended values, with astronomical
+// values from the IAU and cosmological parameters from published analyses.
+// Constants are unit-safe types from the units package, ensuring
 // dimensional consistency in calculations.
 //
+// Get returns a constant as a Measurement carrying its standard
+// uncertainty, exactness and source, so error propagation can start from
+// the constants themselves. Lookup, LookupSymbol and All find constants
+// for tools that take them from user input, WriteJSON and WriteCSV export
+// the catalog, and Verify checks the constants tied together by exact
+// relations.
+//
+// Cosmological parameters come as whole published sets, such as
+// CosmologyPlanck2018. Ready-made combinations such as ℏc and the Hartree
+// energy serve natural-unit and atomic-unit estimates, and the IAU 2015
+// nominal solar, terrestrial and jovian values are exact conversion
+// constants.
+//
+// Reference states such as STP and SATP name a temperature and pressure
+// together, and MolarMass gives the molar masses of common substances.
+//
+// The subpackages isotopes, particles, planets and spectra hold tabulated
+// data built on these constants: nuclide masses and half-lives, the PDG
+// particle catalog, the planets and major moons of the Solar System, and
+// the bands of the electromagnetic spectrum. The conversions subpackage
+// tabulates the scale factors of the units package's constructors.
+//
 // Example usage:
 //
 //	import (
@@ -22,6 +43,11 @@
 //	c2 := constants.SpeedOfLight.Value.Multiply(constants.SpeedOfLight.Value)
 //	rs := constants.GravitationalConstant.Value.Multiply(mass.Value).Scale(2.0).Divide(c2)
 //
+//	// The same with uncertainties: u(rs)/rs = 2.2 × 10⁻⁵, all from G
+//	G, _ := constants.Get("GravitationalConstant")
+//	c, _ := constants.Get("SpeedOfLight")
+//	rsm := G.Multiply(units.Exact(mass.Value)).Scale(2.0).Divide(c.Power(2))
+//
 // References:
 //   - CODATA 2018: Tiesinga et al., "CODATA recommended values of the fundamental
 //     physical constants: 2018", Rev. Mod. Phys. 93, 025010 (2021)
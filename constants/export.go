@@ -0,0 +1,127 @@
+package constants
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// record is the machine-readable form of one constant.
+type record struct {
+	Name        string    `json:"name"`
+	Symbol      string    `json:"symbol"`
+	Description string    `json:"description"`
+	Value       float64   `json:"value"`
+	Uncertainty float64   `json:"uncertainty"`
+	Exact       bool      `json:"exact"`
+	Dimension   dimension `json:"dimension"`
+	Unit        string    `json:"unit"`
+	Source      string    `json:"source"`
+	Year        int       `json:"year,omitempty"`
+	References  []string  `json:"references"`
+}
+
+// dimension holds the base dimension exponents, omitting zeros.
+type dimension struct {
+	L int8 `json:"L,omitempty"`
+	M int8 `json:"M,omitempty"`
+	T int8 `json:"T,omitempty"`
+	I int8 `json:"I,omitempty"`
+	Θ int8 `json:"Θ,omitempty"`
+	N int8 `json:"N,omitempty"`
+	J int8 `json:"J,omitempty"`
+}
+
+func newRecord(m Measurement) record {
+	d := m.Value().Dim()
+	return record{
+		Name:        m.Name,
+		Symbol:      m.Symbol,
+		Description: m.Description,
+		Value:       m.Value().Val(),
+		Uncertainty: m.Uncertainty().Val(),
+		Exact:       m.Exact,
+		Dimension:   dimension(d),
+		Unit:        d.Symbol(),
+		Source:      m.Source,
+		Year:        m.Year,
+		References:  m.References,
+	}
+}
+
+// WriteJSON writes the whole catalog as a JSON array in the order of All,
+// so tools outside Go can use exactly the numbers the simulator uses. Each
+// constant is an object with its name, symbol, description, value and
+// standard uncertainty in SI base units, exactness, dimension exponents
+// (zeros omitted), SI unit, source, year (omitted if undated) and
+// references. Numbers are written with the shortest representation that
+// parses back to the same float64.
+//
+// Example output:
+//
+//	[
+//	  {
+//	    "name": "SpeedOfLight",
+//	    "symbol": "c",
+//	    "description": "Speed of light in vacuum (c)",
+//	    "value": 299792458,
+//	    "uncertainty": 0,
+//	    "exact": true,
+//	    "dimension": {
+//	      "L": 1,
+//	      "T": -1
+//	    },
+//	    "unit": "m s^-1",
+//	    "source": "CODATA 2018",
+//	    ...
+func WriteJSON(w io.Writer) error {
+	recs := make([]record, len(registry))
+	for i, m := range registry {
+		recs[i] = newRecord(m)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(recs)
+}
+
+// WriteCSV writes the whole catalog as CSV in the order of All, with the
+// header row
+//
+//	name,symbol,description,value,uncertainty,exact,dimension,unit,source,year
+//
+// Values and uncertainties are in SI base units with the shortest exact
+// representation; the dimension is written like "[L^1 T^-1]" and the unit
+// like "m s^-1". The year is empty for undated values.
+func WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"name", "symbol", "description", "value", "uncertainty", "exact", "dimension", "unit", "source", "year"}); err != nil {
+		return err
+	}
+	for _, m := range registry {
+		r := newRecord(m)
+		year := ""
+		if r.Year != 0 {
+			year = strconv.Itoa(r.Year)
+		}
+		row := []string{
+			r.Name,
+			r.Symbol,
+			r.Description,
+			strconv.FormatFloat(r.Value, 'g', -1, 64),
+			strconv.FormatFloat(r.Uncertainty, 'g', -1, 64),
+			strconv.FormatBool(r.Exact),
+			units.Dimension(r.Dimension).String(),
+			r.Unit,
+			r.Source,
+			year,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
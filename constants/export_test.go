@@ -0,0 +1,61 @@
+package constants
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var recs []record
+	if err := json.Unmarshal(buf.Bytes(), &recs); err != nil {
+		t.Fatal(err)
+	}
+	if len(recs) != len(registry) {
+		t.Fatalf("got %d constants, want %d", len(recs), len(registry))
+	}
+	for i, r := range recs {
+		m := registry[i]
+		if r.Name != m.Name || r.Value != m.Value().Val() || r.Uncertainty != m.Uncertainty().Val() {
+			t.Errorf("record %d = %+v, want %s = %v", i, r, m.Name, m.Measurement)
+		}
+	}
+	G := recs[byName["GravitationalConstant"]]
+	if G.Dimension != (dimension{L: 3, M: -1, T: -2}) || G.Unit != "kg^-1 m^3 s^-2" || G.Exact {
+		t.Errorf("G = %+v", G)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != len(registry)+1 {
+		t.Fatalf("got %d rows, want %d", len(rows), len(registry)+1)
+	}
+	if rows[0][3] != "value" || rows[0][4] != "uncertainty" {
+		t.Errorf("header = %v", rows[0])
+	}
+	for i, row := range rows[1:] {
+		m := registry[i]
+		v, _ := strconv.ParseFloat(row[3], 64)
+		u, _ := strconv.ParseFloat(row[4], 64)
+		if row[0] != m.Name || v != m.Value().Val() || u != m.Uncertainty().Val() {
+			t.Errorf("row %d = %v, want %s = %v", i+1, row, m.Name, m.Measurement)
+		}
+		if row[6] != m.Value().Dim().String() {
+			t.Errorf("%s dimension = %q, want %q", m.Name, row[6], m.Value().Dim().String())
+		}
+	}
+}
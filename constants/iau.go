@@ -0,0 +1,104 @@
+package constants
+
+import "github.com/sakiphan/qsim-core/units"
+
+// -----------------------------------------------------------------------------
+// IAU 2015 Nominal Solar and Planetary Values
+// -----------------------------------------------------------------------------
+//
+// IAU 2015 Resolution B3 defines nominal values as exact conversion
+// constants, so that quantities expressed in solar, terrestrial or jovian
+// units are unambiguous; they are not measurements of the bodies. The mass
+// parameters GM are known far more precisely than G, so masses are to be
+// given in units of GM/G rather than converted with a measured mass.
+// SolarRadius and SolarLuminosity are the nominal R☉ and L☉.
+
+// NominalSolarIrradiance is the nominal total solar irradiance (S☉ᴺ).
+// Value: 1361 W/m² (exact by definition)
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalSolarIrradiance = units.WattPerMeter2(1361)
+
+// NominalSolarEffectiveTemperature is the nominal solar photospheric
+// effective temperature (T☉ᴺ).
+// Value: 5772 K (exact by definition)
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalSolarEffectiveTemperature = units.Kelvin(5772)
+
+// NominalSolarMassParameter is the nominal solar mass parameter ((GM)☉ᴺ).
+// Value: 1.3271244 × 10²⁰ m³/s² (exact by definition)
+//
+// The TCB-compatible value, consistent with the measured GM☉ to its
+// uncertainty of about 10⁻¹⁰.
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalSolarMassParameter = units.CubicMeterPerSecond2(1.3271244e20)
+
+// NominalEarthEquatorialRadius is the nominal equatorial radius of Earth
+// (R⊕eᴺ).
+// Value: 6.3781 × 10⁶ m (exact by definition)
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalEarthEquatorialRadius = units.Meter(6.3781e6)
+
+// NominalEarthPolarRadius is the nominal polar radius of Earth (R⊕pᴺ).
+// Value: 6.3568 × 10⁶ m (exact by definition)
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalEarthPolarRadius = units.Meter(6.3568e6)
+
+// NominalEarthMassParameter is the nominal terrestrial mass parameter
+// ((GM)⊕ᴺ).
+// Value: 3.986004 × 10¹⁴ m³/s² (exact by definition)
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalEarthMassParameter = units.CubicMeterPerSecond2(3.986004e14)
+
+// NominalJupiterEquatorialRadius is the nominal equatorial radius of
+// Jupiter (R♃eᴺ).
+// Value: 7.1492 × 10⁷ m (exact by definition)
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalJupiterEquatorialRadius = units.Meter(7.1492e7)
+
+// NominalJupiterPolarRadius is the nominal polar radius of Jupiter
+// (R♃pᴺ).
+// Value: 6.6854 × 10⁷ m (exact by definition)
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalJupiterPolarRadius = units.Meter(6.6854e7)
+
+// NominalJupiterMassParameter is the nominal jovian mass parameter
+// ((GM)♃ᴺ).
+// Value: 1.2668653 × 10¹⁷ m³/s² (exact by definition)
+//
+// References:
+//   - IAU 2015 Resolution B3
+var NominalJupiterMassParameter = units.CubicMeterPerSecond2(1.2668653e17)
+
+// BolometricZeroPointLuminosity is the zero point of the absolute
+// bolometric magnitude scale (L₀), for which M_bol = 0.
+// Value: 3.0128 × 10²⁸ W (exact by definition)
+//
+// M_bol = -2.5 log₁₀(L/L₀); the nominal Sun has M_bol = 4.74.
+//
+// References:
+//   - IAU 2015 Resolution B2
+var BolometricZeroPointLuminosity = units.Watt(3.0128e28)
+
+// NominalMass returns the mass GM/G corresponding to a nominal mass
+// parameter, such as NominalSolarMassParameter, with the CODATA value of
+// G. Its uncertainty is that of G, 2.2 × 10⁻⁵; prefer the mass parameter
+// itself where possible.
+func NominalMass(gm units.StandardGravitationalParameter) units.Mass {
+	return units.Kilogram(gm.Val() / GravitationalConstant.Val())
+}
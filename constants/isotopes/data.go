@@ -0,0 +1,82 @@
+package isotopes
+
+import (
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants/particles"
+)
+
+// Time units of the half-life columns. NUBASE2020 converts years with the
+// tropical year, 1 y = 365.2422 d.
+const (
+	minute = 60.0
+	day    = 86400.0
+	year   = 365.2422 * day
+)
+
+// elements gives the chemical symbol of each tabulated element.
+var elements = map[int]string{
+	0: "n", 1: "H", 2: "He", 3: "Li", 4: "Be", 5: "B", 6: "C", 7: "N", 8: "O",
+	9: "F", 10: "Ne", 11: "Na", 13: "Al", 14: "Si", 19: "K", 20: "Ca",
+	26: "Fe", 27: "Co", 28: "Ni", 36: "Kr", 50: "Sn", 56: "Ba", 79: "Au",
+	82: "Pb", 84: "Po", 86: "Rn", 88: "Ra", 90: "Th", 92: "U",
+}
+
+// neutron supplies the free-neutron mean lifetime.
+var neutron, _ = particles.Lookup("n")
+
+// data lists Z, A, the atomic mass in u and its uncertainty in μu
+// (AME2020), the natural abundance (IUPAC 2013), and the half-life and its
+// uncertainty in seconds (NUBASE2020; the neutron's from its mean lifetime
+// in the particles catalog).
+var data = []entry{
+	{0, 1, 1.00866491595, 0.00049, 0, neutron.Lifetime.Value().Val() * math.Ln2, 0.6 * math.Ln2},
+	{1, 1, 1.00782503223, 0.00009, 0.999885, 0, 0},
+	{1, 2, 2.01410177812, 0.00012, 0.000115, 0, 0},
+	{1, 3, 3.01604928132, 0.00008, 0, 12.32 * year, 0.02 * year},
+	{2, 3, 3.01602932197, 0.00006, 0.00000134, 0, 0},
+	{2, 4, 4.00260325413, 0.00016, 0.99999866, 0, 0},
+	{3, 6, 6.0151228874, 0.0016, 0.0759, 0, 0},
+	{3, 7, 7.0160034366, 0.0045, 0.9241, 0, 0},
+	{4, 7, 7.016928717, 0.076, 0, 53.22 * day, 0.06 * day},
+	{4, 9, 9.012183065, 0.082, 1, 0, 0},
+	{5, 10, 10.01293695, 0.41, 0.199, 0, 0},
+	{5, 11, 11.00930536, 0.45, 0.801, 0, 0},
+	{6, 12, 12, 0, 0.9893, 0, 0},
+	{6, 13, 13.00335483507, 0.00023, 0.0107, 0, 0},
+	{6, 14, 14.0032419884, 0.0040, 0, 5700 * year, 30 * year},
+	{7, 14, 14.00307400443, 0.00020, 0.99636, 0, 0},
+	{7, 15, 15.00010889888, 0.00064, 0.00364, 0, 0},
+	{8, 16, 15.99491461957, 0.00017, 0.99757, 0, 0},
+	{8, 17, 16.99913175650, 0.00069, 0.00038, 0, 0},
+	{8, 18, 17.99915961286, 0.00076, 0.00205, 0, 0},
+	{9, 19, 18.99840316273, 0.00092, 1, 0, 0},
+	{10, 20, 19.9924401762, 0.0017, 0.9048, 0, 0},
+	{10, 22, 21.991385113, 0.018, 0.0925, 0, 0},
+	{11, 22, 21.99443742, 0.18, 0, 2.6018 * year, 0.0022 * year},
+	{11, 23, 22.9897692820, 0.0019, 1, 0, 0},
+	{13, 27, 26.98153841, 0.05, 1, 0, 0},
+	{14, 28, 27.97692653442, 0.00055, 0.92223, 0, 0},
+	{19, 39, 38.9637064864, 0.0049, 0.932581, 0, 0},
+	{19, 40, 39.963998166, 0.060, 0.000117, 1.248e9 * year, 0.003e9 * year},
+	{20, 40, 39.962590850, 0.022, 0.96941, 0, 0},
+	{26, 54, 53.93960899, 0.37, 0.05845, 0, 0},
+	{26, 56, 55.93493633, 0.49, 0.91754, 0, 0},
+	{27, 59, 58.93319429, 0.56, 1, 0, 0},
+	{27, 60, 59.93381555, 0.56, 0, 1925.28 * day, 0.14 * day},
+	{28, 58, 57.93534241, 0.37, 0.680769, 0, 0},
+	{28, 62, 61.92834537, 0.46, 0.036345, 0, 0},
+	{36, 92, 91.9261731, 2.9, 0, 1.840, 0.008},
+	{50, 120, 119.9022016, 0.1, 0.3258, 0, 0},
+	{56, 141, 140.9144033, 5.7, 0, 18.27 * minute, 0.07 * minute},
+	{79, 197, 196.9665701, 0.6, 1, 0, 0},
+	{82, 206, 205.9744651, 1.2, 0.241, 0, 0},
+	{82, 207, 206.9758973, 1.2, 0.221, 0, 0},
+	{82, 208, 207.9766525, 1.2, 0.524, 0, 0},
+	{84, 210, 209.9828736, 1.2, 0, 138.376 * day, 0.002 * day},
+	{86, 222, 222.0175782, 2.5, 0, 3.8235 * day, 0.0003 * day},
+	{88, 226, 226.0254103, 2.5, 0, 1600 * year, 7 * year},
+	{90, 234, 234.0435999, 2.8, 0, 24.10 * day, 0.03 * day},
+	{92, 235, 235.0439281, 1.2, 0.007204, 7.04e8 * year, 0.01e8 * year},
+	{92, 238, 238.0507884, 1.2, 0.992742, 4.468e9 * year, 0.003e9 * year},
+}
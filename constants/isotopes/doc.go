@@ -0,0 +1,42 @@
+// Package isotopes provides measured properties of nuclides: atomic masses
+// from the AME2020 atomic mass evaluation, half-lives from NUBASE2020 and
+// natural abundances from the IUPAC table of isotopic compositions.
+//
+// The table covers a selection of nuclides used throughout physics and
+// engineering — the stable isotopes of the light elements, structural and
+// reference nuclei such as Fe-56 and Pb-208, and common radionuclides of
+// dating, medicine, fission and the uranium series. Masses and half-lives
+// are units.Measurements carrying their standard uncertainties, so derived
+// quantities such as binding energies and Q-values propagate them; masses
+// are measured in unified atomic mass units, so the uncertainty of u in
+// kilograms enters as one shared input.
+//
+// AME2020 implements nuclear.MassModel, so the Q-value and binding-energy
+// functions of the nuclear package run on measured masses, and HalfLife
+// values plug directly into the decay package.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants/isotopes"
+//	    "github.com/sakiphan/qsim-core/nuclear"
+//	    "github.com/sakiphan/qsim-core/nuclear/decay"
+//	)
+//
+//	fe, _ := isotopes.Lookup("Fe-56")
+//	b := fe.BindingEnergyPerNucleon() // 8.790 MeV ± 8 eV
+//
+//	// Measured α-decay energy of U-238, against the liquid-drop estimate
+//	q, _ := nuclear.QAlpha(isotopes.AME2020, 92, 238) // 4.270 MeV
+//
+//	co, _ := isotopes.Get(27, 60)
+//	a, _ := decay.Activity(1e15, units.Time{Value: co.HalfLife.Value()})
+//
+// References:
+//   - Wang et al. "The AME 2020 atomic mass evaluation (II). Tables,
+//     graphs and references", Chin. Phys. C 45, 030003 (2021)
+//   - Kondev et al. "The NUBASE2020 evaluation of nuclear physics
+//     properties", Chin. Phys. C 45, 030001 (2021)
+//   - Meija et al. "Isotopic compositions of the elements 2013 (IUPAC
+//     Technical Report)", Pure Appl. Chem. 88, 293 (2016)
+package isotopes
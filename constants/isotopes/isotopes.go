@@ -0,0 +1,238 @@
+package isotopes
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Nuclide holds the measured properties of one nuclide.
+type Nuclide struct {
+	Z, A    int
+	Element string // chemical symbol, such as "Fe"; "n" for the neutron
+
+	// Mass is the atomic mass of the neutral atom in its ground state.
+	Mass units.Measurement
+
+	// Abundance is the natural abundance as a mole fraction of the
+	// element, zero for nuclides not found in nature in significant
+	// amounts.
+	Abundance float64
+
+	// HalfLife is the ground-state half-life, zero for stable nuclides.
+	HalfLife units.Measurement
+
+	massU, massUnc float64 // atomic mass and its uncertainty in u
+}
+
+// Name returns the nuclide's name, such as "Fe-56", or "n" for the
+// neutron.
+func (n Nuclide) Name() string {
+	if n.Z == 0 {
+		return "n"
+	}
+	return n.Element + "-" + strconv.Itoa(n.A)
+}
+
+// String returns the nuclide's name.
+func (n Nuclide) String() string {
+	return n.Name()
+}
+
+// N returns the neutron number A - Z.
+func (n Nuclide) N() int {
+	return n.A - n.Z
+}
+
+// Stable returns true if no decay of the nuclide has been observed.
+func (n Nuclide) Stable() bool {
+	return n.HalfLife.Value().Val() == 0
+}
+
+// MassExcess returns the mass excess Δ = (M - A u) c², the quantity
+// tabulated by mass evaluations.
+func (n Nuclide) MassExcess() units.Measurement {
+	d, _ := units.Measure(massInput(n), units.Dimensionless(n.massU-float64(n.A)), units.Dimensionless(n.massUnc))
+	return d.Multiply(amu).Multiply(c2)
+}
+
+// BindingEnergy returns the nuclear binding energy implied by the measured
+// masses,
+//
+//	B = (Z m_H + N m_n - M) c²
+//
+// with the uncertainties of all three masses propagated.
+func (n Nuclide) BindingEnergy() units.Measurement {
+	h, nn := table[key{1, 1}], table[key{0, 1}]
+	defect, _ := h.Mass.Scale(float64(n.Z)).Add(nn.Mass.Scale(float64(n.N())))
+	defect, _ = defect.Subtract(n.Mass)
+	return defect.Multiply(c2)
+}
+
+// BindingEnergyPerNucleon returns B/A.
+func (n Nuclide) BindingEnergyPerNucleon() units.Measurement {
+	return n.BindingEnergy().Scale(1 / float64(n.A))
+}
+
+// -----------------------------------------------------------------------------
+// Lookup
+// -----------------------------------------------------------------------------
+
+type key struct{ z, a int }
+
+// Get returns the nuclide with proton number z and mass number a. Returns
+// an error if the nuclide is not in the table.
+func Get(z, a int) (Nuclide, error) {
+	n, ok := table[key{z, a}]
+	if !ok {
+		return Nuclide{}, fmt.Errorf("no data for nuclide Z = %d, A = %d", z, a)
+	}
+	return n, nil
+}
+
+// Lookup returns the nuclide with the given name, written as "Fe-56",
+// "Fe56" or "56Fe" with the element symbol in any case; "n" is the
+// neutron. Returns an error if the name cannot be parsed or the nuclide is
+// not in the table.
+func Lookup(name string) (Nuclide, error) {
+	if strings.EqualFold(name, "n") {
+		return Get(0, 1)
+	}
+	s := strings.ReplaceAll(name, "-", "")
+	letters := strings.IndexFunc(s, unicode.IsLetter)
+	digits := strings.IndexFunc(s, unicode.IsDigit)
+	if letters < 0 || digits < 0 {
+		return Nuclide{}, fmt.Errorf("invalid nuclide name %q", name)
+	}
+	var sym, num string
+	if letters < digits {
+		sym, num = s[:digits], s[digits:]
+	} else {
+		num, sym = s[:letters], s[letters:]
+	}
+	a, err := strconv.Atoi(num)
+	if err != nil {
+		return Nuclide{}, fmt.Errorf("invalid nuclide name %q", name)
+	}
+	z, ok := protonNumber[strings.ToLower(sym)]
+	if !ok {
+		return Nuclide{}, fmt.Errorf("unknown element %q in %q", sym, name)
+	}
+	return Get(z, a)
+}
+
+// All returns every nuclide in the table, ordered by Z then A.
+func All() []Nuclide {
+	out := make([]Nuclide, 0, len(table))
+	for _, n := range table {
+		out = append(out, n)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Z != out[j].Z {
+			return out[i].Z < out[j].Z
+		}
+		return out[i].A < out[j].A
+	})
+	return out
+}
+
+// Isotopes returns the tabulated isotopes of element z, ordered by A.
+func Isotopes(z int) []Nuclide {
+	var out []Nuclide
+	for _, n := range All() {
+		if n.Z == z {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// -----------------------------------------------------------------------------
+// Mass model
+// -----------------------------------------------------------------------------
+
+type ame2020 struct{}
+
+// AME2020 is the table's atomic masses as a mass model, for use with the
+// nuclear package.
+var AME2020 ame2020
+
+// AtomicMass returns the measured atomic mass. Returns an error if the
+// nuclide is not in the table.
+func (ame2020) AtomicMass(z, a int) (units.Mass, error) {
+	n, err := Get(z, a)
+	if err != nil {
+		return units.Mass{}, err
+	}
+	return units.AtomicMassUnit(n.massU), nil
+}
+
+// -----------------------------------------------------------------------------
+// Table construction
+// -----------------------------------------------------------------------------
+
+var (
+	amu = mustGet("AtomicMassUnit").Measurement
+	c2  = mustGet("SpeedOfLight").Power(2)
+)
+
+func mustGet(name string) constants.Measurement {
+	m, err := constants.Get(name)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func massInput(n Nuclide) string {
+	return "m(" + n.Name() + ")"
+}
+
+// entry is one row of the data table: the atomic mass in u and its
+// uncertainty in μu, the natural abundance, and the half-life and its
+// uncertainty in seconds.
+type entry struct {
+	z, a      int
+	mass, dm  float64
+	abundance float64
+	t12, dt12 float64
+}
+
+var table, protonNumber = build(data)
+
+func build(entries []entry) (map[key]Nuclide, map[string]int) {
+	nuclides := make(map[key]Nuclide, len(entries))
+	numbers := map[string]int{}
+	for _, e := range entries {
+		n := Nuclide{
+			Z:         e.z,
+			A:         e.a,
+			Element:   elements[e.z],
+			Abundance: e.abundance,
+			massU:     e.mass,
+			massUnc:   e.dm * 1e-6,
+		}
+		m, err := units.Measure(massInput(n), units.Dimensionless(n.massU), units.Dimensionless(n.massUnc))
+		if err != nil {
+			panic(fmt.Sprintf("isotopes: %s: %v", n.Name(), err))
+		}
+		n.Mass = m.Multiply(amu)
+		n.HalfLife = units.Exact(units.Second(0).Value)
+		if e.t12 > 0 {
+			n.HalfLife, err = units.Measure("t½("+n.Name()+")", units.Second(e.t12).Value, units.Second(e.dt12).Value)
+			if err != nil {
+				panic(fmt.Sprintf("isotopes: %s: %v", n.Name(), err))
+			}
+		}
+		nuclides[key{e.z, e.a}] = n
+		if e.z > 0 {
+			numbers[strings.ToLower(n.Element)] = e.z
+		}
+	}
+	return nuclides, numbers
+}
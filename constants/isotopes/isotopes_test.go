@@ -0,0 +1,147 @@
+package isotopes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/nuclear"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+const mev = 1.602176634e-13
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"Fe-56", "fe56", "56Fe", "56-FE"} {
+		n, err := Lookup(name)
+		if err != nil {
+			t.Fatalf("Lookup(%q): %v", name, err)
+		}
+		if n.Z != 26 || n.A != 56 || n.Name() != "Fe-56" {
+			t.Errorf("Lookup(%q) = %v (Z = %d, A = %d)", name, n, n.Z, n.A)
+		}
+	}
+	if n, err := Lookup("N-14"); err != nil || n.Z != 7 {
+		t.Errorf("Lookup(N-14) = %v, %v; want nitrogen", n, err)
+	}
+	if n, err := Lookup("n"); err != nil || n.Z != 0 || n.A != 1 {
+		t.Errorf("Lookup(n) = %v, %v; want the neutron", n, err)
+	}
+	for _, name := range []string{"", "Fe", "56", "Xx-56", "Fe-57"} {
+		if _, err := Lookup(name); err == nil {
+			t.Errorf("Lookup(%q): expected error", name)
+		}
+	}
+}
+
+func TestBindingEnergy(t *testing.T) {
+	// AME2020 binding energies per nucleon in keV.
+	for _, tc := range []struct {
+		name string
+		bA   float64
+	}{
+		{"H-2", 1112.2831}, {"He-4", 7073.9156}, {"C-12", 7680.1446},
+		{"O-16", 7976.2072}, {"Fe-56", 8790.3563}, {"Pb-208", 7867.4530}, {"U-238", 7570.1262},
+	} {
+		n, _ := Lookup(tc.name)
+		b := n.BindingEnergyPerNucleon()
+		if got := b.Value().Val() / mev * 1e3; !almostEqual(got, tc.bA, 2e-6) {
+			t.Errorf("%s: B/A = %.4f keV, want %.4f", tc.name, got, tc.bA)
+		}
+		ref, err := nuclear.BindingEnergyOf(AME2020, n.Z, n.A)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The nuclear package takes m_H = m_p + m_e, neglecting the 13.6 eV
+		// binding of hydrogen.
+		if d := math.Abs(n.BindingEnergy().Value().Val()-ref.Val()) / mev * 1e6; d > 14*float64(n.Z)+1 {
+			t.Errorf("%s: B = %v, nuclear package gives %v", tc.name, n.BindingEnergy().Value(), ref)
+		}
+	}
+
+	// The mass of C-12 is exact in u, so only the neutron and hydrogen
+	// masses and the value of u contribute.
+	c, _ := Get(6, 12)
+	for _, in := range c.BindingEnergy().Budget() {
+		if in.Name == "m(C-12)" {
+			t.Errorf("exact C-12 mass contributes %v", in)
+		}
+	}
+}
+
+func TestMassExcess(t *testing.T) {
+	// AME2020 mass excesses in keV.
+	for _, tc := range []struct {
+		name  string
+		delta float64
+	}{
+		{"n", 8071.3181}, {"H-1", 7288.9712}, {"He-4", 2424.9156}, {"C-12", 0},
+	} {
+		n, _ := Lookup(tc.name)
+		if got := n.MassExcess().Value().Val() / mev * 1e3; math.Abs(got-tc.delta) > 0.5 {
+			t.Errorf("%s: Δ = %.4f keV, want %.4f", tc.name, got, tc.delta)
+		}
+	}
+}
+
+func TestQValues(t *testing.T) {
+	q, err := nuclear.QAlpha(AME2020, 92, 238)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(q.Val()/mev, 4.2699, 1e-3) {
+		t.Errorf("Q_α(U-238) = %.4f MeV, want 4.270", q.Val()/mev)
+	}
+	q, _ = nuclear.QBetaMinus(AME2020, 6, 14)
+	if !almostEqual(q.Val()/mev*1e3, 156.476, 1e-4) {
+		t.Errorf("Q_β(C-14) = %.3f keV, want 156.476", q.Val()/mev*1e3)
+	}
+	if _, err := nuclear.QAlpha(AME2020, 26, 56); err == nil {
+		t.Error("expected error for nuclide missing from the table")
+	}
+}
+
+func TestAbundances(t *testing.T) {
+	// Elements whose isotopes are all tabulated.
+	for _, z := range []int{1, 2, 3, 5, 6, 7, 8, 92} {
+		sum := 0.0
+		for _, n := range Isotopes(z) {
+			sum += n.Abundance
+		}
+		if math.Abs(sum-1) > 1e-4 {
+			t.Errorf("Z = %d: abundances sum to %g", z, sum)
+		}
+	}
+}
+
+func TestHalfLives(t *testing.T) {
+	co, _ := Lookup("Co-60")
+	if co.Stable() {
+		t.Fatal("Co-60 reported stable")
+	}
+	if got := co.HalfLife.Value().Val() / units.Year(1).Val(); !almostEqual(got, 5.2714, 1e-3) {
+		t.Errorf("t½(Co-60) = %g y, want 5.271", got)
+	}
+	if fe, _ := Lookup("Fe-56"); !fe.Stable() || !fe.HalfLife.IsExact() {
+		t.Errorf("Fe-56 should be stable, got t½ = %v", fe.HalfLife)
+	}
+	n, _ := Lookup("n")
+	if got := n.HalfLife.Value().Val(); !almostEqual(got, 609.6, 1e-3) {
+		t.Errorf("t½(n) = %g s, want 609.6", got)
+	}
+
+	all := All()
+	for i := 1; i < len(all); i++ {
+		if all[i].Z < all[i-1].Z || all[i].Z == all[i-1].Z && all[i].A <= all[i-1].A {
+			t.Fatalf("All() not ordered at %v, %v", all[i-1], all[i])
+		}
+	}
+	for _, n := range all {
+		if n.Abundance > 0 && !n.Stable() && n.HalfLife.Value().Val() < units.Year(1e8).Val() {
+			t.Errorf("%v is naturally abundant with t½ = %v", n, n.HalfLife)
+		}
+	}
+}
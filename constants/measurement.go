@@ -0,0 +1,270 @@
+package constants
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Measurement is a constant together with its standard uncertainty and
+// provenance. The embedded units.Measurement propagates the uncertainty
+// through arithmetic, with the constant's symbol as its input name, so
+// uncertainty budgets of derived quantities attribute variance to
+// individual constants.
+type Measurement struct {
+	units.Measurement
+	Name   string // name of the plain variable, such as "GravitationalConstant"
+	Symbol string // conventional symbol, such as "G"
+	Exact  bool   // value fixed by definition, such as the SI defining constants
+	Source string // compilation the value is taken from, such as "CODATA 2018"
+	Year   int    // year of the compilation, or zero if the value is undated
+
+	Description string   // what the constant is, such as "Fine-structure constant (α ≈ 1/137)"
+	References  []string // citations for the value
+}
+
+// Get returns the constant with the given variable name, such as
+// "GravitationalConstant", as a Measurement. Every constant of the package
+// is available, with these exceptions: the conversion factors, and the
+// values in MeV, eV or other natural units whose SI counterparts are
+// registered, such as the rest energies and HbarCMeVfm. Values the
+// package keeps as plain numbers in MeV or GeV⁻² are returned in SI units.
+//
+// Example:
+//
+//	// Schwarzschild radius of a 10 M☉ black hole: r = 2GM/c²
+//	G, _ := constants.Get("GravitationalConstant")
+//	c, _ := constants.Get("SpeedOfLight")
+//	m, _ := units.Measure("M", units.Kilogram(1.98892e31).Value, units.Kilogram(2e26).Value)
+//	r := G.Multiply(m).Scale(2).Divide(c.Power(2))
+//	fmt.Print(r.Report())
+//	// G contributes 83.3% of the variance
+//	// M contributes 16.7% of the variance
+//
+// Returns an error if there is no constant with that name.
+func Get(name string) (Measurement, error) {
+	i, ok := byName[name]
+	if !ok {
+		return Measurement{}, fmt.Errorf("unknown constant %q", name)
+	}
+	return registry[i].clone(), nil
+}
+
+// -----------------------------------------------------------------------------
+// Catalog
+// -----------------------------------------------------------------------------
+
+// entry records the metadata of one constant. Values quoted in their
+// source without an uncertainty and not exact (Earth's mean radius)
+// carry the rounding uncertainty of their last digit, half a unit
+// divided by √3.
+type entry struct {
+	name, symbol string
+	desc         string
+	value        units.Value
+	u            float64 // standard uncertainty in SI units; zero if exact
+	exact        bool
+	source       string
+	year         int
+}
+
+const (
+	codata  = "CODATA 2018"
+	pdg     = "Particle Data Group 2020"
+	planck  = "Planck 2018"
+	iau2015 = "IAU 2015 Resolution B3"
+)
+
+// citations gives the full reference of the recurring sources; other
+// sources are cited as named.
+var citations = map[string]string{
+	codata:                         "Tiesinga et al., \"CODATA recommended values of the fundamental physical constants: 2018\", Rev. Mod. Phys. 93, 025010 (2021)",
+	pdg:                            "Zyla et al. (Particle Data Group), \"Review of Particle Physics\", Prog. Theor. Exp. Phys. 2020, 083C01 (2020)",
+	planck:                         "Planck Collaboration, \"Planck 2018 results. VI. Cosmological parameters\", A&A 641, A6 (2020)",
+	iau2015:                        "Prša et al., \"Nominal values for selected solar and planetary quantities: IAU 2015 Resolution B3\", AJ 152, 41 (2016)",
+	"IAU 2015 Resolution B2":       "Mamajek et al., \"IAU 2015 Resolution B2 on recommended zero points for the absolute and apparent bolometric magnitude scales\", arXiv:1510.07674 (2015)",
+	"JPL DE440":                    "Park et al., \"The JPL Planetary and Lunar Ephemerides DE440 and DE441\", AJ 161, 105 (2021)",
+	"IERS Conventions 2010":        "Petit and Luzum (eds.), \"IERS Conventions (2010)\", IERS Technical Note 36 (2010)",
+	"Kopp and Lean 2011":           "Kopp and Lean, \"A new, lower value of total solar irradiance: Evidence and climate significance\", Geophys. Res. Lett. 38, L01706 (2011)",
+	"Fixsen 2009":                  "Fixsen, \"The temperature of the cosmic microwave background\", ApJ 707, 916 (2009)",
+	"ATLAS and CMS Collaborations": "ATLAS and CMS Collaborations, \"Combined measurement of the Higgs boson mass in pp collisions at √s = 7 and 8 TeV\", Phys. Rev. Lett. 114, 191803 (2015)",
+}
+
+// mev converts an energy in MeV to joules.
+func mev(x float64) units.Value {
+	return units.Joule(x * 1e6 * ElectronVoltToJoule).Value
+}
+
+// h0Rel is the relative uncertainty of the Planck 2018 Hubble constant,
+// 0.5 / 67.4, from which the derived cosmological scales inherit theirs.
+const h0Rel = 0.5 / 67.4
+
+var catalog = []entry{
+	// Universal constants
+	{"SpeedOfLight", "c", "Speed of light in vacuum (c)", SpeedOfLight.Value, 0, true, codata, 2018},
+	{"PlanckConstant", "h", "Planck's constant (h)", PlanckConstant, 0, true, codata, 2018},
+	{"PlanckReduced", "ℏ", "Reduced Planck constant (ℏ = h/2π)", PlanckReduced, 0, true, codata, 2018},
+	{"GravitationalConstant", "G", "Newton's gravitational constant (G)", GravitationalConstant, 0.00015e-11, false, codata, 2018},
+	{"BoltzmannConstant", "k_B", "Boltzmann constant (k_B)", BoltzmannConstant, 0, true, codata, 2018},
+	{"AvogadroConstant", "N_A", "Avogadro's number (N_A)", AvogadroConstant, 0, true, codata, 2018},
+	{"UniversalGasConstant", "R", "Molar gas constant (R = N_A k_B)", UniversalGasConstant, 0, true, codata, 2018},
+	{"MolarMassConstant", "M_u", "Molar mass constant (M_u)", MolarMassConstant.Value, 0.00000000030e-3, false, codata, 2018},
+	{"VacuumPermittivity", "ε₀", "Electric constant (ε₀)", VacuumPermittivity, 0.0000000013e-12, false, codata, 2018},
+	{"VacuumPermeability", "μ₀", "Magnetic constant (μ₀)", VacuumPermeability, 0.00000000019e-6, false, codata, 2018},
+	{"ElementaryCharge", "e", "Elementary charge (e)", ElementaryCharge.Value, 0, true, codata, 2018},
+	{"CoulombConstant", "k_e", "Coulomb's constant (k_e = 1/4πε₀)", CoulombConstant, 0.0000000014e9, false, codata, 2018},
+	{"StefanBoltzmannConstant", "σ", "Stefan-Boltzmann constant (σ)", StefanBoltzmannConstant, 0, true, codata, 2018},
+	{"WienDisplacementConstant", "b", "Wien's displacement law constant (b)", WienDisplacementConstant, 0, true, codata, 2018},
+	{"RydbergConstant", "R_∞", "Rydberg constant (R_∞)", RydbergConstant, 0.000021, false, codata, 2018},
+	{"FineStructureConstant", "α", "Fine-structure constant (α ≈ 1/137)", FineStructureConstant, 0.0000000011e-3, false, codata, 2018},
+	{"BohrRadius", "a₀", "Bohr radius (a₀)", BohrRadius.Value, 0.00000000080e-11, false, codata, 2018},
+	{"BohrMagneton", "μ_B", "Bohr magneton (μ_B)", BohrMagneton.Value, 0.0000000028e-24, false, codata, 2018},
+	{"NuclearMagneton", "μ_N", "Nuclear magneton (μ_N)", NuclearMagneton.Value, 0.0000000015e-27, false, codata, 2018},
+	{"StandardGravity", "g₀", "Standard acceleration due to gravity (g₀)", StandardGravity.Value, 0, true, "ISO 80000-3:2006", 2006},
+	{"AtomicMassUnit", "u", "Unified atomic mass unit (u or Da)", AtomicMassUnit.Value, 0.00000000050e-27, false, codata, 2018},
+
+	// Atomic units
+	{"HartreeEnergy", "E_h", "Hartree energy (E_h)", HartreeEnergy.Value, 0.0000000000085e-18, false, codata, 2018},
+	{"BohrVelocity", "v₀", "Atomic unit of velocity (v₀ = αc)", BohrVelocity.Value, 0.00000000033e6, false, codata, 2018},
+	{"AtomicUnitOfTime", "ℏ/E_h", "Atomic unit of time (ℏ/E_h)", AtomicUnitOfTime.Value, 0.0000000000047e-17, false, codata, 2018},
+	{"AtomicUnitOfElectricField", "E_h/ea₀", "Atomic unit of electric field (E_h/ea₀)", AtomicUnitOfElectricField.Value, 0.00000000078e11, false, codata, 2018},
+	{"AtomicUnitOfForce", "E_h/a₀", "Atomic unit of force (E_h/a₀)", AtomicUnitOfForce.Value, 0.0000000012e-8, false, codata, 2018},
+
+	// Natural-unit combinations
+	{"HbarC", "ℏc", "Reduced Planck constant times the speed of light (ℏc)", HbarC, 0, true, codata, 2018},
+	{"HbarCSquared", "(ℏc)²", "Square of ℏc ((ℏc)²)", HbarCSquared, 0, true, codata, 2018},
+	{"AtomicMassUnitEnergyMeV", "m_uc²", "Atomic mass unit energy equivalent (m_u c²)", mev(AtomicMassUnitEnergyMeV), 0.00000028 * mev(1).Val(), false, codata, 2018},
+
+	// Astronomical and cosmological constants
+	{"AstronomicalUnit", "au", "Astronomical unit (AU)", AstronomicalUnit.Value, 0, true, "IAU 2012 Resolution B2", 2012},
+	{"Parsec", "pc", "Parsec (pc)", Parsec.Value, 0, true, "IAU 2015 Resolution B2", 2015},
+	{"LightYear", "ly", "Light-year (ly)", LightYear.Value, 0, true, "IAU", 0},
+	{"SolarMass", "M☉", "Mass of the Sun (M☉)", SolarMass.Value, 0.00004e30, false, iau2015, 2015},
+	{"EarthMass", "M⊕", "Mass of Earth (M⊕)", EarthMass.Value, 0.0006e24, false, "NASA JPL planetary fact sheet", 0},
+	{"SolarMassParameter", "GM☉", "Heliocentric gravitational constant (GM☉)", SolarMassParameter.Value, 1e10, false, "JPL DE440", 2021},
+	{"EarthMassParameter", "GM⊕", "Geocentric gravitational constant (GM⊕)", EarthMassParameter.Value, 8e5, false, "IERS Conventions 2010", 2010},
+	{"SolarLuminosity", "L☉", "Luminosity of the Sun (L☉)", SolarLuminosity.Value, 0, true, iau2015, 2015},
+	{"SolarRadius", "R☉", "Radius of the Sun (R☉)", SolarRadius.Value, 0, true, iau2015, 2015},
+	{"EarthRadius", "R⊕", "Mean radius of Earth (R⊕)", EarthRadius.Value, 0.5e3 / math.Sqrt(3), false, "NASA Earth fact sheet", 0},
+	{"SolarConstant", "S", "Solar constant (S)", SolarConstant.Value, 0.5, false, "Kopp and Lean 2011", 2011},
+	{"EarthBondAlbedo", "A⊕", "Bond albedo of Earth (A⊕)", units.Dimensionless(EarthBondAlbedo), 0.0005 / math.Sqrt(3), false, "NASA Earth fact sheet", 0},
+	{"NominalSolarIrradiance", "S☉ᴺ", "Nominal total solar irradiance (S☉ᴺ)", NominalSolarIrradiance.Value, 0, true, iau2015, 2015},
+	{"NominalSolarEffectiveTemperature", "T☉ᴺ", "Nominal solar effective temperature (T☉ᴺ)", NominalSolarEffectiveTemperature.Value, 0, true, iau2015, 2015},
+	{"NominalSolarMassParameter", "(GM)☉ᴺ", "Nominal solar mass parameter ((GM)☉ᴺ)", NominalSolarMassParameter.Value, 0, true, iau2015, 2015},
+	{"NominalEarthEquatorialRadius", "R⊕eᴺ", "Nominal equatorial radius of Earth (R⊕eᴺ)", NominalEarthEquatorialRadius.Value, 0, true, iau2015, 2015},
+	{"NominalEarthPolarRadius", "R⊕pᴺ", "Nominal polar radius of Earth (R⊕pᴺ)", NominalEarthPolarRadius.Value, 0, true, iau2015, 2015},
+	{"NominalEarthMassParameter", "(GM)⊕ᴺ", "Nominal terrestrial mass parameter ((GM)⊕ᴺ)", NominalEarthMassParameter.Value, 0, true, iau2015, 2015},
+	{"NominalJupiterEquatorialRadius", "R♃eᴺ", "Nominal equatorial radius of Jupiter (R♃eᴺ)", NominalJupiterEquatorialRadius.Value, 0, true, iau2015, 2015},
+	{"NominalJupiterPolarRadius", "R♃pᴺ", "Nominal polar radius of Jupiter (R♃pᴺ)", NominalJupiterPolarRadius.Value, 0, true, iau2015, 2015},
+	{"NominalJupiterMassParameter", "(GM)♃ᴺ", "Nominal jovian mass parameter ((GM)♃ᴺ)", NominalJupiterMassParameter.Value, 0, true, iau2015, 2015},
+	{"BolometricZeroPointLuminosity", "L₀", "Zero-point luminosity of the bolometric magnitude scale (L₀)", BolometricZeroPointLuminosity.Value, 0, true, "IAU 2015 Resolution B2", 2015},
+	{"HubbleConstant", "H₀", "Hubble constant (H₀)", HubbleConstant.Value, h0Rel * HubbleConstant.Val(), false, planck, 2018},
+	{"HubbleTime", "t_H", "Hubble time (1/H₀)", HubbleTime.Value, h0Rel * HubbleTime.Val(), false, planck, 2018},
+	{"CriticalDensity", "ρ_c", "Critical density of the universe (ρ_c)", CriticalDensity, 2 * h0Rel * CriticalDensity.Val(), false, planck, 2018},
+	{"CMBTemperature", "T_CMB", "Cosmic microwave background temperature (T_CMB)", CMBTemperature.Value, 0.0006, false, "Fixsen 2009", 2009},
+
+	// Planck units
+	{"PlanckLength", "l_P", "Planck length (l_P = √(ℏG/c³))", PlanckLength.Value, 0.000018e-35, false, codata, 2018},
+	{"PlanckMass", "m_P", "Planck mass (m_P = √(ℏc/G))", PlanckMass.Value, 0.000024e-8, false, codata, 2018},
+	{"PlanckTime", "t_P", "Planck time (t_P = √(ℏG/c⁵))", PlanckTime.Value, 0.000060e-44, false, codata, 2018},
+	{"PlanckTemperature", "T_P", "Planck temperature (T_P = √(ℏc⁵/(Gk_B²)))", PlanckTemperature.Value, 0.000016e32, false, codata, 2018},
+
+	// Leptons
+	{"ElectronMass", "m_e", "Electron rest mass (m_e)", ElectronMass.Value, 0.0000000028e-31, false, codata, 2018},
+	{"ElectronCharge", "q_e", "Electron charge (q_e = -e)", ElectronCharge.Value, 0, true, codata, 2018},
+	{"ElectronRestEnergy", "m_ec²", "Electron rest energy (m_e c²)", ElectronRestEnergy.Value, 0.0000000025e-14, false, codata, 2018},
+	{"ElectronMagneticMoment", "μ_e", "Electron magnetic moment (μ_e)", ElectronMagneticMoment.Value, 0.0000000028e-24, false, codata, 2018},
+	{"ElectronGFactor", "g_e", "Electron g-factor (g_e)", units.Dimensionless(ElectronGFactor), 0.00000000000035, false, codata, 2018},
+	{"ElectronGyromagneticRatio", "γ_e", "Electron gyromagnetic ratio (γ_e)", ElectronGyromagneticRatio.Value, 0.00000000053e11, false, codata, 2018},
+	{"ElectronMagneticMomentAnomaly", "a_e", "Electron magnetic moment anomaly (a_e)", units.Dimensionless(ElectronMagneticMomentAnomaly), 0.00000000018e-3, false, codata, 2018},
+	{"ElectronComptonWavelength", "λ_C", "Compton wavelength of the electron (λ_C)", ElectronComptonWavelength.Value, 0.00000000073e-12, false, codata, 2018},
+	{"MuonMass", "m_μ", "Muon rest mass (m_μ)", MuonMass.Value, 0.000000042e-28, false, codata, 2018},
+	{"MuonCharge", "q_μ", "Muon charge (q_μ = -e)", MuonCharge.Value, 0, true, codata, 2018},
+	{"MuonRestEnergyMeV", "m_μc²", "Muon rest energy (m_μ c²)", mev(MuonRestEnergyMeV), 0.0000023 * mev(1).Val(), false, codata, 2018},
+	{"MuonMeanLifetime", "τ_μ", "Muon mean lifetime (τ_μ)", MuonMeanLifetime.Value, 0.0000022e-6, false, pdg, 2020},
+	{"MuonMagneticMomentAnomaly", "a_μ", "Muon magnetic moment anomaly (a_μ)", units.Dimensionless(MuonMagneticMomentAnomaly), 0.00000063e-3, false, codata, 2018},
+	{"TauMass", "m_τ", "Tau lepton rest mass (m_τ)", TauMass.Value, 0.00021e-27, false, pdg, 2020},
+	{"TauCharge", "q_τ", "Tau charge (q_τ = -e)", TauCharge.Value, 0, true, codata, 2018},
+	{"TauRestEnergyMeV", "m_τc²", "Tau rest energy (m_τ c²)", mev(TauRestEnergyMeV), 0.12 * mev(1).Val(), false, pdg, 2020},
+	{"TauMeanLifetime", "τ_τ", "Tau mean lifetime (τ_τ)", TauMeanLifetime.Value, 0.005e-13, false, pdg, 2020},
+
+	// Nucleons and light nuclei
+	{"ProtonMass", "m_p", "Proton rest mass (m_p)", ProtonMass.Value, 0.00000000051e-27, false, codata, 2018},
+	{"ProtonCharge", "q_p", "Proton charge (q_p = +e)", ProtonCharge.Value, 0, true, codata, 2018},
+	{"ProtonRestEnergy", "m_pc²", "Proton rest energy (m_p c²)", ProtonRestEnergy.Value, 0.00000000046e-10, false, codata, 2018},
+	{"ProtonMagneticMoment", "μ_p", "Proton magnetic moment (μ_p)", ProtonMagneticMoment.Value, 0.00000000060e-26, false, codata, 2018},
+	{"ProtonGFactor", "g_p", "Proton g-factor (g_p)", units.Dimensionless(ProtonGFactor), 0.0000000016, false, codata, 2018},
+	{"ProtonGyromagneticRatio", "γ_p", "Proton gyromagnetic ratio (γ_p)", ProtonGyromagneticRatio.Value, 0.0000000011e8, false, codata, 2018},
+	{"ProtonComptonWavelength", "λ_C,p", "Compton wavelength of the proton (λ_C,p)", ProtonComptonWavelength.Value, 0.00000000040e-15, false, codata, 2018},
+	{"NeutronMass", "m_n", "Neutron rest mass (m_n)", NeutronMass.Value, 0.00000000095e-27, false, codata, 2018},
+	{"NeutronCharge", "q_n", "Neutron charge (0)", NeutronCharge.Value, 0, true, "Standard Model", 0},
+	{"NeutronRestEnergy", "m_nc²", "Neutron rest energy (m_n c²)", NeutronRestEnergy.Value, 0.00000000086e-10, false, codata, 2018},
+	{"NeutronMagneticMoment", "μ_n", "Neutron magnetic moment (μ_n)", NeutronMagneticMoment.Value, 0.0000023e-27, false, codata, 2018},
+	{"NeutronGFactor", "g_n", "Neutron g-factor (g_n)", units.Dimensionless(NeutronGFactor), 0.00000090, false, codata, 2018},
+	{"NeutronGyromagneticRatio", "γ_n", "Neutron gyromagnetic ratio (γ_n)", NeutronGyromagneticRatio.Value, 0.00000043e8, false, codata, 2018},
+	{"NeutronComptonWavelength", "λ_C,n", "Compton wavelength of the neutron (λ_C,n)", NeutronComptonWavelength.Value, 0.00000000075e-15, false, codata, 2018},
+	{"NeutronMeanLifetime", "τ_n", "Neutron mean lifetime (τ_n)", NeutronMeanLifetime.Value, 0.6, false, pdg, 2020},
+	{"DeuteronMass", "m_d", "Deuteron rest mass (m_d)", DeuteronMass.Value, 0.0000000010e-27, false, codata, 2018},
+	{"HelionMass", "m_h", "Helion rest mass (m_h)", HelionMass.Value, 0.0000000015e-27, false, codata, 2018},
+	{"AlphaParticleMass", "m_α", "Alpha particle rest mass (m_α)", AlphaParticleMass.Value, 0.0000000020e-27, false, codata, 2018},
+
+	// Mass ratios
+	{"ProtonElectronMassRatio", "m_p/m_e", "Proton-electron mass ratio (m_p/m_e)", units.Dimensionless(ProtonElectronMassRatio), 0.00000011, false, codata, 2018},
+	{"NeutronElectronMassRatio", "m_n/m_e", "Neutron-electron mass ratio (m_n/m_e)", units.Dimensionless(NeutronElectronMassRatio), 0.00000089, false, codata, 2018},
+	{"NeutronProtonMassRatio", "m_n/m_p", "Neutron-proton mass ratio (m_n/m_p)", units.Dimensionless(NeutronProtonMassRatio), 0.00000000049, false, codata, 2018},
+	{"MuonElectronMassRatio", "m_μ/m_e", "Muon-electron mass ratio (m_μ/m_e)", units.Dimensionless(MuonElectronMassRatio), 0.000046, false, codata, 2018},
+
+	// Electroweak and strong interactions
+	{"FermiCouplingConstant", "G_F", "Fermi coupling constant (G_F/(ℏc)³)", fermi(FermiCouplingConstant), fermi(0.0000006e-5).Val(), false, pdg, 2020},
+	{"WeakMixingAngle", "sin²θ_W", "Weinberg angle (sin²θ_W)", units.Dimensionless(WeakMixingAngle), 0.00004, false, pdg, 2020},
+	{"StrongCouplingConstant", "α_s", "Strong coupling constant (α_s)", units.Dimensionless(StrongCouplingConstant), 0.0010, false, pdg, 2020},
+	{"WBosonMassMeV", "m_Wc²", "W boson rest energy (m_W c²)", mev(WBosonMassMeV), 12 * mev(1).Val(), false, pdg, 2020},
+	{"ZBosonMassMeV", "m_Zc²", "Z boson rest energy (m_Z c²)", mev(ZBosonMassMeV), 2.1 * mev(1).Val(), false, pdg, 2020},
+	{"HiggsMassMeV", "m_Hc²", "Higgs boson rest energy (m_H c²)", mev(HiggsMassMeV), 24 * mev(1).Val(), false, "ATLAS and CMS Collaborations", 2015},
+}
+
+// fermi converts a coupling in GeV⁻² to J⁻².
+func fermi(x float64) units.Value {
+	gev := 1e9 * ElectronVoltToJoule
+	return units.NewValue(x/(gev*gev), units.Dimension{L: -4, M: -2, T: 4})
+}
+
+// registry holds the measurements of the catalog in catalog order.
+var registry = build(catalog)
+
+// build turns catalog entries into measurements.
+func build(entries []entry) []Measurement {
+	out := make([]Measurement, len(entries))
+	for i, e := range entries {
+		m := units.Exact(e.value)
+		if !e.exact {
+			var err error
+			m, err = units.Measure(e.symbol, e.value, units.NewValue(e.u, e.value.Dim()))
+			if err != nil {
+				panic(fmt.Sprintf("constants: %s: %v", e.name, err))
+			}
+		}
+		ref, ok := citations[e.source]
+		if !ok {
+			ref = e.source
+		}
+		out[i] = Measurement{
+			Measurement: m,
+			Name:        e.name,
+			Symbol:      e.symbol,
+			Exact:       e.exact,
+			Source:      e.source,
+			Year:        e.year,
+			Description: e.desc,
+			References:  []string{ref},
+		}
+	}
+	return out
+}
+
+// clone returns a copy of m that shares no memory with the registry.
+func (m Measurement) clone() Measurement {
+	m.References = append([]string(nil), m.References...)
+	return m
+}
@@ -0,0 +1,86 @@
+package constants
+
+import (
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestGet(t *testing.T) {
+	G, err := Get("GravitationalConstant")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if G.Value() != GravitationalConstant {
+		t.Errorf("G = %v, want %v", G.Value(), GravitationalConstant)
+	}
+	if !almostEqual(G.Relative(), 2.2e-5, 1e-2) {
+		t.Errorf("relative u(G) = %g, want 2.2e-5", G.Relative())
+	}
+	if G.Exact || G.Symbol != "G" || G.Source != "CODATA 2018" || G.Year != 2018 {
+		t.Errorf("G metadata = %+v", G)
+	}
+
+	c, err := Get("SpeedOfLight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Exact || !c.IsExact() {
+		t.Errorf("c should be exact, got %v", c)
+	}
+
+	w, err := Get("WBosonMassMeV")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := w.Value().Val() / (1e6 * ElectronVoltToJoule); !almostEqual(got, WBosonMassMeV, 1e-12) {
+		t.Errorf("W mass = %g MeV, want %g", got, WBosonMassMeV)
+	}
+
+	if _, err := Get("Unobtainium"); err == nil {
+		t.Error("expected error for unknown constant")
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	symbols := map[string]string{}
+	for _, e := range catalog {
+		m, err := Get(e.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if prev, ok := symbols[m.Symbol]; ok {
+			t.Errorf("%s and %s share symbol %q", prev, e.name, m.Symbol)
+		}
+		symbols[m.Symbol] = e.name
+		if m.Exact != m.IsExact() {
+			t.Errorf("%s: Exact = %v but uncertainty = %v", e.name, m.Exact, m.Uncertainty())
+		}
+		if m.Uncertainty().Dim() != m.Value().Dim() {
+			t.Errorf("%s: uncertainty dimension %s, want %s", e.name, m.Uncertainty().Dim(), m.Value().Dim())
+		}
+		if m.Source == "" {
+			t.Errorf("%s has no source", e.name)
+		}
+	}
+	if len(byName) != len(catalog) {
+		t.Errorf("catalog has %d entries but %d names", len(catalog), len(byName))
+	}
+}
+
+func TestSchwarzschildBudget(t *testing.T) {
+	G, _ := Get("GravitationalConstant")
+	c, _ := Get("SpeedOfLight")
+	m, err := units.Measure("M", units.Kilogram(1.98892e31).Value, units.Kilogram(2e26).Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := G.Multiply(m).Scale(2).Divide(c.Power(2))
+	b := r.Budget()
+	if len(b) != 2 || b[0].Name != "G" {
+		t.Fatalf("budget = %v, want G first", b)
+	}
+	if got := b[0].String(); got != "G: 83.3%" {
+		t.Errorf("G contribution = %q, want %q", got, "G: 83.3%")
+	}
+}
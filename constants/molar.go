@@ -0,0 +1,68 @@
+package constants
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Molar Masses
+// -----------------------------------------------------------------------------
+
+// Substance is a chemical substance and its molar mass.
+type Substance struct {
+	Name      string
+	Formula   string
+	MolarMass units.MolarMass
+}
+
+// substances lists common substances with molar masses from the IUPAC
+// conventional atomic weights: H 1.008, C 12.011, N 14.007, O 15.999,
+// Na 22.990, Si 28.085, Cl 35.45, Ar 39.95, Ca 40.078, Fe 55.845. Dry air
+// is the mean of the U.S. Standard Atmosphere 1976.
+//
+// References:
+//   - Meija et al., "Atomic weights of the elements 2013 (IUPAC Technical
+//     Report)", Pure Appl. Chem. 88, 265 (2016)
+//   - NOAA/NASA/USAF, "U.S. Standard Atmosphere, 1976"
+var substances = []Substance{
+	{"hydrogen", "H2", units.GramPerMole(2.016)},
+	{"helium", "He", units.GramPerMole(4.0026)},
+	{"methane", "CH4", units.GramPerMole(16.043)},
+	{"ammonia", "NH3", units.GramPerMole(17.031)},
+	{"water", "H2O", units.GramPerMole(18.015)},
+	{"nitrogen", "N2", units.GramPerMole(28.014)},
+	{"dry air", "air", units.GramPerMole(28.9644)},
+	{"oxygen", "O2", units.GramPerMole(31.998)},
+	{"argon", "Ar", units.GramPerMole(39.95)},
+	{"carbon dioxide", "CO2", units.GramPerMole(44.009)},
+	{"ethanol", "C2H5OH", units.GramPerMole(46.069)},
+	{"iron", "Fe", units.GramPerMole(55.845)},
+	{"sodium chloride", "NaCl", units.GramPerMole(58.44)},
+	{"silicon dioxide", "SiO2", units.GramPerMole(60.083)},
+	{"calcium carbonate", "CaCO3", units.GramPerMole(100.086)},
+	{"glucose", "C6H12O6", units.GramPerMole(180.156)},
+}
+
+// MolarMass returns the molar mass of a common substance given by formula,
+// such as "H2O" or "CO2", or by name, such as "water". Returns an error if
+// the substance is not tabulated.
+//
+// Example:
+//
+//	mm, _ := constants.MolarMass("CO2")
+//	n := units.Kilogram(1).DivideMolarMass(mm) // 22.7 mol
+func MolarMass(substance string) (units.MolarMass, error) {
+	for _, s := range substances {
+		if s.Formula == substance || s.Name == substance {
+			return s.MolarMass, nil
+		}
+	}
+	return units.MolarMass{}, fmt.Errorf("unknown substance %q", substance)
+}
+
+// Substances returns the tabulated substances in order of molar mass.
+func Substances() []Substance {
+	return append([]Substance(nil), substances...)
+}
@@ -0,0 +1,93 @@
+package constants
+
+import "github.com/sakiphan/qsim-core/units"
+
+// -----------------------------------------------------------------------------
+// Natural-Unit Combinations
+// -----------------------------------------------------------------------------
+//
+// Products of ℏ, c and k_B that recur in particle, nuclear and thermal
+// estimates, given once rather than recomputed at each use. Since the 2019
+// redefinition of the SI they are exact; the values are truncated. The
+// rest energies m_e c², m_p c² and the others are with the particle
+// properties.
+
+// HbarC is the reduced Planck constant times the speed of light (ℏc).
+// Value: 3.161526773... × 10⁻²⁶ J⋅m = 197.3269804... MeV⋅fm (exact)
+//
+// Converts between energy and inverse length in natural units: a 1 fm
+// wavelength corresponds to 197 MeV.
+//
+// References:
+//   - CODATA 2018
+var HbarC = units.NewValue(3.161526773e-26, units.Dimension{L: 3, M: 1, T: -2})
+
+// HbarCMeVfm is ℏc in MeV⋅fm.
+// Value: 197.3269804... MeV⋅fm (exact)
+//
+// References:
+//   - CODATA 2018
+var HbarCMeVfm = 197.3269804
+
+// HbarCSquared is the square of ℏc ((ℏc)²).
+// Value: 9.99525154... × 10⁻⁵² J²⋅m² = 0.3893793721... GeV²⋅mb (exact)
+//
+// Converts a cross-section in natural units, GeV⁻², to an area.
+//
+// References:
+//   - CODATA 2018
+var HbarCSquared = units.NewValue(9.99525154e-52, units.Dimension{L: 6, M: 2, T: -4})
+
+// HbarCSquaredGeV2mb is (ℏc)² in GeV²⋅mb.
+// Value: 0.3893793721... GeV²⋅mb (exact)
+//
+// A cross-section of 1 GeV⁻² is 0.389 mb.
+//
+// References:
+//   - Particle Data Group 2020, Physical Constants
+var HbarCSquaredGeV2mb = 0.3893793721
+
+// BoltzmannConstantEV is the Boltzmann constant in electron volts per
+// kelvin (k_B/e).
+// Value: 8.617333262... × 10⁻⁵ eV/K (exact)
+//
+// Room temperature, 300 K, is k_B T ≈ 25.85 meV.
+//
+// References:
+//   - CODATA 2018
+var BoltzmannConstantEV = 8.617333262e-5
+
+// ElectronVoltToKelvin is the temperature whose thermal energy k_B T is
+// one electron volt (e/k_B).
+// Value: 1 eV = 11604.51812... K (exact)
+//
+// References:
+//   - CODATA 2018
+var ElectronVoltToKelvin = 11604.51812
+
+// PlanckConstantEV is the Planck constant in electron volt seconds (h/e).
+// Value: 4.135667696... × 10⁻¹⁵ eV⋅s (exact)
+//
+// References:
+//   - CODATA 2018
+var PlanckConstantEV = 4.135667696e-15
+
+// PlanckReducedEV is the reduced Planck constant in electron volt seconds
+// (ℏ/e).
+// Value: 6.582119569... × 10⁻¹⁶ eV⋅s (exact)
+//
+// References:
+//   - CODATA 2018
+var PlanckReducedEV = 6.582119569e-16
+
+// AtomicMassUnitEnergyMeV is the energy equivalent of the atomic mass unit
+// (m_u c²).
+// Value: 931.49410242(28) MeV
+// Relative standard uncertainty: 3.0 × 10⁻¹⁰
+//
+// Converts nuclide masses in u to the binding and reaction energies of
+// nuclear physics.
+//
+// References:
+//   - CODATA 2018
+var AtomicMassUnitEnergyMeV = 931.49410242
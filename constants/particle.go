@@ -3,7 +3,10 @@ package constants
 import "github.com/sakiphan/qsim-core/units"
 
 // Particle Properties
-// All values from CODATA 2018 recommended values.
+// All values from CODATA 2018 recommended values. The particles subpackage
+// catalogs these and other particles as structured records with PDG IDs,
+// spins, lifetimes and antiparticles; the scalars it covers are deprecated
+// in its favor and kept for compatibility.
 
 // -----------------------------------------------------------------------------
 // Electron Properties
@@ -15,6 +18,8 @@ import "github.com/sakiphan/qsim-core/units"
 //
 // References:
 //   - CODATA 2018
+//
+// Deprecated: Use the Mass field of particles.Lookup("e-").
 var ElectronMass = units.Kilogram(9.1093837015e-31)
 
 // ElectronCharge is the electron charge (-e).
@@ -24,6 +29,8 @@ var ElectronMass = units.Kilogram(9.1093837015e-31)
 //
 // References:
 //   - CODATA 2018
+//
+// Deprecated: Use the Charge field of particles.Lookup("e-").
 var ElectronCharge = units.Coulomb(-1.602176634e-19)
 
 // ElectronRestEnergy is the electron rest energy (m_e c²).
@@ -47,7 +54,9 @@ var ElectronRestEnergyMeV = 0.51099895000
 //
 // References:
 //   - CODATA 2018
-var ElectronMagneticMoment = units.NewValue(-9.2847647043e-24, units.Dimension{L: 2, I: 1})
+//
+// Deprecated: Use the MagneticMoment field of particles.Lookup("e-").
+var ElectronMagneticMoment = units.JoulePerTesla(-9.2847647043e-24)
 
 // ElectronGFactor is the electron g-factor.
 // Value: -2.00231930436256(35)
@@ -59,6 +68,30 @@ var ElectronMagneticMoment = units.NewValue(-9.2847647043e-24, units.Dimension{L
 //   - CODATA 2018
 var ElectronGFactor = -2.00231930436256
 
+// ElectronGyromagneticRatio is the electron gyromagnetic ratio
+// (γ_e = 2|μ_e|/ℏ).
+// Value: 1.76085963023(53) × 10¹¹ s⁻¹ T⁻¹ (γ_e/2π = 28024.9514242 MHz/T)
+// Relative standard uncertainty: 3.0 × 10⁻¹⁰
+//
+// Angular frequency of electron spin precession per unit field, as in
+// electron spin resonance: ω = γ_e B.
+//
+// References:
+//   - CODATA 2018
+var ElectronGyromagneticRatio = units.RadianPerSecondTesla(1.76085963023e11)
+
+// ElectronMagneticMomentAnomaly is the electron magnetic moment anomaly
+// (a_e = (|g_e| - 2)/2).
+// Value: 1.15965218128(18) × 10⁻³
+// Relative standard uncertainty: 1.5 × 10⁻¹⁰
+//
+// The departure from the Dirac value g = 2, due to QED radiative
+// corrections; the leading term is α/2π.
+//
+// References:
+//   - CODATA 2018
+var ElectronMagneticMomentAnomaly = 1.15965218128e-3
+
 // ElectronComptonWavelength is the Compton wavelength of the electron (λ_C).
 // Value: 2.42631023867(73) × 10⁻¹² m
 // Relative standard uncertainty: 3.0 × 10⁻¹⁰
@@ -79,6 +112,8 @@ var ElectronComptonWavelength = units.Meter(2.42631023867e-12)
 //
 // References:
 //   - CODATA 2018
+//
+// Deprecated: Use the Mass field of particles.Lookup("p").
 var ProtonMass = units.Kilogram(1.67262192369e-27)
 
 // ProtonCharge is the proton charge (+e).
@@ -88,6 +123,8 @@ var ProtonMass = units.Kilogram(1.67262192369e-27)
 //
 // References:
 //   - CODATA 2018
+//
+// Deprecated: Use the Charge field of particles.Lookup("p").
 var ProtonCharge = units.Coulomb(1.602176634e-19)
 
 // ProtonRestEnergy is the proton rest energy (m_p c²).
@@ -111,7 +148,9 @@ var ProtonRestEnergyMeV = 938.27208816
 //
 // References:
 //   - CODATA 2018
-var ProtonMagneticMoment = units.NewValue(1.41060679736e-26, units.Dimension{L: 2, I: 1})
+//
+// Deprecated: Use the MagneticMoment field of particles.Lookup("p").
+var ProtonMagneticMoment = units.JoulePerTesla(1.41060679736e-26)
 
 // ProtonGFactor is the proton g-factor.
 // Value: 5.5856946893(16)
@@ -121,6 +160,16 @@ var ProtonMagneticMoment = units.NewValue(1.41060679736e-26, units.Dimension{L:
 //   - CODATA 2018
 var ProtonGFactor = 5.5856946893
 
+// ProtonGyromagneticRatio is the proton gyromagnetic ratio (γ_p = 2μ_p/ℏ).
+// Value: 2.6752218744(11) × 10⁸ s⁻¹ T⁻¹ (γ_p/2π = 42.577478518 MHz/T)
+// Relative standard uncertainty: 4.2 × 10⁻¹⁰
+//
+// Sets the proton NMR frequency: 42.58 MHz in a 1 T field.
+//
+// References:
+//   - CODATA 2018
+var ProtonGyromagneticRatio = units.RadianPerSecondTesla(2.6752218744e8)
+
 // ProtonComptonWavelength is the Compton wavelength of the proton (λ_C,p).
 // Value: 1.32140985539(40) × 10⁻¹⁵ m
 // Relative standard uncertainty: 3.0 × 10⁻¹⁰
@@ -141,6 +190,8 @@ var ProtonComptonWavelength = units.Meter(1.32140985539e-15)
 //
 // References:
 //   - CODATA 2018
+//
+// Deprecated: Use the Mass field of particles.Lookup("n").
 var NeutronMass = units.Kilogram(1.67492749804e-27)
 
 // NeutronCharge is the neutron charge.
@@ -150,6 +201,8 @@ var NeutronMass = units.Kilogram(1.67492749804e-27)
 //
 // References:
 //   - Experimental limit: |q_n| < 10⁻²¹ e
+//
+// Deprecated: Use the Charge field of particles.Lookup("n").
 var NeutronCharge = units.Coulomb(0.0)
 
 // NeutronRestEnergy is the neutron rest energy (m_n c²).
@@ -175,7 +228,9 @@ var NeutronRestEnergyMeV = 939.56542052
 //
 // References:
 //   - CODATA 2018
-var NeutronMagneticMoment = units.NewValue(-9.6623651e-27, units.Dimension{L: 2, I: 1})
+//
+// Deprecated: Use the MagneticMoment field of particles.Lookup("n").
+var NeutronMagneticMoment = units.JoulePerTesla(-9.6623651e-27)
 
 // NeutronGFactor is the neutron g-factor.
 // Value: -3.82608545(90)
@@ -185,6 +240,15 @@ var NeutronMagneticMoment = units.NewValue(-9.6623651e-27, units.Dimension{L: 2,
 //   - CODATA 2018
 var NeutronGFactor = -3.82608545
 
+// NeutronGyromagneticRatio is the neutron gyromagnetic ratio
+// (γ_n = 2|μ_n|/ℏ).
+// Value: 1.83247171(43) × 10⁸ s⁻¹ T⁻¹ (γ_n/2π = 29.1646931 MHz/T)
+// Relative standard uncertainty: 2.4 × 10⁻⁷
+//
+// References:
+//   - CODATA 2018
+var NeutronGyromagneticRatio = units.RadianPerSecondTesla(1.83247171e8)
+
 // NeutronComptonWavelength is the Compton wavelength of the neutron (λ_C,n).
 // Value: 1.31959090581(75) × 10⁻¹⁵ m
 // Relative standard uncertainty: 5.7 × 10⁻¹⁰
@@ -203,6 +267,8 @@ var NeutronComptonWavelength = units.Meter(1.31959090581e-15)
 //
 // References:
 //   - Particle Data Group 2020
+//
+// Deprecated: Use the Lifetime field of particles.Lookup("n").
 var NeutronMeanLifetime = units.Second(879.4)
 
 // -----------------------------------------------------------------------------
@@ -217,6 +283,8 @@ var NeutronMeanLifetime = units.Second(879.4)
 //
 // References:
 //   - CODATA 2018
+//
+// Deprecated: Use the Mass field of particles.Lookup("mu-").
 var MuonMass = units.Kilogram(1.883531627e-28)
 
 // MuonCharge is the muon charge (-e).
@@ -224,6 +292,8 @@ var MuonMass = units.Kilogram(1.883531627e-28)
 //
 // References:
 //   - CODATA 2018
+//
+// Deprecated: Use the Charge field of particles.Lookup("mu-").
 var MuonCharge = units.Coulomb(-1.602176634e-19)
 
 // MuonRestEnergyMeV is the muon rest energy in MeV.
@@ -242,8 +312,22 @@ var MuonRestEnergyMeV = 105.6583755
 //
 // References:
 //   - Particle Data Group 2020
+//
+// Deprecated: Use the Lifetime field of particles.Lookup("mu-").
 var MuonMeanLifetime = units.Microsecond(2.1969811)
 
+// MuonMagneticMomentAnomaly is the muon magnetic moment anomaly
+// (a_μ = (|g_μ| - 2)/2).
+// Value: 1.16592089(63) × 10⁻³
+// Relative standard uncertainty: 5.4 × 10⁻⁷
+//
+// The Brookhaven E821 value adopted by CODATA 2018; its departure from
+// the Standard Model prediction is the muon g-2 anomaly.
+//
+// References:
+//   - CODATA 2018
+var MuonMagneticMomentAnomaly = 1.16592089e-3
+
 // -----------------------------------------------------------------------------
 // Tau Properties
 // -----------------------------------------------------------------------------
@@ -256,6 +340,8 @@ var MuonMeanLifetime = units.Microsecond(2.1969811)
 //
 // References:
 //   - Particle Data Group 2020
+//
+// Deprecated: Use the Mass field of particles.Lookup("tau-").
 var TauMass = units.Kilogram(3.16754e-27)
 
 // TauCharge is the tau charge (-e).
@@ -263,6 +349,8 @@ var TauMass = units.Kilogram(3.16754e-27)
 //
 // References:
 //   - CODATA 2018
+//
+// Deprecated: Use the Charge field of particles.Lookup("tau-").
 var TauCharge = units.Coulomb(-1.602176634e-19)
 
 // TauRestEnergyMeV is the tau rest energy in MeV.
@@ -281,6 +369,8 @@ var TauRestEnergyMeV = 1776.86
 //
 // References:
 //   - Particle Data Group 2020
+//
+// Deprecated: Use the Lifetime field of particles.Lookup("tau-").
 var TauMeanLifetime = units.Second(2.903e-13)
 
 // -----------------------------------------------------------------------------
@@ -406,6 +496,8 @@ var StrongCouplingConstant = 0.1179
 //
 // References:
 //   - Particle Data Group 2020
+//
+// Deprecated: Use the Mass field of particles.Lookup("W+").
 var WBosonMassMeV = 80379.0
 
 // ZBosonMassMeV is the Z boson mass in MeV.
@@ -416,6 +508,8 @@ var WBosonMassMeV = 80379.0
 //
 // References:
 //   - Particle Data Group 2020
+//
+// Deprecated: Use the Mass field of particles.Lookup("Z0").
 var ZBosonMassMeV = 91187.6
 
 // HiggsMassMeV is the Higgs boson mass in MeV.
@@ -426,4 +520,6 @@ var ZBosonMassMeV = 91187.6
 //
 // References:
 //   - ATLAS and CMS Collaborations, combined result
+//
+// Deprecated: Use the Mass field of particles.Lookup("H0").
 var HiggsMassMeV = 125090.0
@@ -0,0 +1,176 @@
+package particles
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// entry is one particle of the data table. Masses and widths are in MeV,
+// lifetimes in seconds and magnetic moments in nuclear magnetons; the
+// ...Of fields name a constant of the constants package to take a value
+// from instead, so the two agree exactly. Asymmetric uncertainties are
+// symmetrized.
+type entry struct {
+	kind            Kind
+	id              int
+	name, sym       string
+	anti, antiSym   string // empty for self-conjugate particles
+	charge3         int    // charge in units of e/3
+	spin2           int    // spin in units of ℏ/2
+	mass, dm        float64
+	massOf          string
+	tau, dtau       float64
+	width, dwidth   float64
+	lifetimeOf      string
+	moment, dmoment float64
+	momentOf        string
+}
+
+var data = []entry{
+	// Leptons
+	{kind: Lepton, id: 11, name: "e-", sym: "e⁻", anti: "e+", antiSym: "e⁺", charge3: -3, spin2: 1, massOf: "ElectronMass", momentOf: "ElectronMagneticMoment"},
+	{kind: Lepton, id: 12, name: "nu_e", sym: "ν_e", anti: "anti-nu_e", antiSym: "ν̄_e", spin2: 1},
+	{kind: Lepton, id: 13, name: "mu-", sym: "μ⁻", anti: "mu+", antiSym: "μ⁺", charge3: -3, spin2: 1, massOf: "MuonMass", lifetimeOf: "MuonMeanLifetime", moment: -8.89059703, dmoment: 0.00000020},
+	{kind: Lepton, id: 14, name: "nu_mu", sym: "ν_μ", anti: "anti-nu_mu", antiSym: "ν̄_μ", spin2: 1},
+	{kind: Lepton, id: 15, name: "tau-", sym: "τ⁻", anti: "tau+", antiSym: "τ⁺", charge3: -3, spin2: 1, massOf: "TauMass", lifetimeOf: "TauMeanLifetime"},
+	{kind: Lepton, id: 16, name: "nu_tau", sym: "ν_τ", anti: "anti-nu_tau", antiSym: "ν̄_τ", spin2: 1},
+
+	// Quarks
+	{kind: Quark, id: 1, name: "d", sym: "d", anti: "anti-d", antiSym: "d̄", charge3: -1, spin2: 1, mass: 4.67, dm: 0.33},
+	{kind: Quark, id: 2, name: "u", sym: "u", anti: "anti-u", antiSym: "ū", charge3: 2, spin2: 1, mass: 2.16, dm: 0.38},
+	{kind: Quark, id: 3, name: "s", sym: "s", anti: "anti-s", antiSym: "s̄", charge3: -1, spin2: 1, mass: 93, dm: 8},
+	{kind: Quark, id: 4, name: "c", sym: "c", anti: "anti-c", antiSym: "c̄", charge3: 2, spin2: 1, mass: 1270, dm: 20},
+	{kind: Quark, id: 5, name: "b", sym: "b", anti: "anti-b", antiSym: "b̄", charge3: -1, spin2: 1, mass: 4180, dm: 25},
+	{kind: Quark, id: 6, name: "t", sym: "t", anti: "anti-t", antiSym: "t̄", charge3: 2, spin2: 1, mass: 172760, dm: 300},
+
+	// Gauge and scalar bosons
+	{kind: GaugeBoson, id: 21, name: "g", sym: "g", spin2: 2},
+	{kind: GaugeBoson, id: 22, name: "gamma", sym: "γ", spin2: 2},
+	{kind: GaugeBoson, id: 23, name: "Z0", sym: "Z⁰", spin2: 2, mass: 91187.6, dm: 2.1, width: 2495.2, dwidth: 2.3},
+	{kind: GaugeBoson, id: 24, name: "W+", sym: "W⁺", anti: "W-", antiSym: "W⁻", charge3: 3, spin2: 2, mass: 80379, dm: 12, width: 2085, dwidth: 42},
+	{kind: ScalarBoson, id: 25, name: "H0", sym: "H⁰", mass: 125100, dm: 140, width: 3.2, dwidth: 2.5},
+
+	// Mesons
+	{kind: Meson, id: 111, name: "pi0", sym: "π⁰", mass: 134.9768, dm: 0.0005, tau: 8.43e-17, dtau: 0.13e-17},
+	{kind: Meson, id: 211, name: "pi+", sym: "π⁺", anti: "pi-", antiSym: "π⁻", charge3: 3, mass: 139.57039, dm: 0.00018, tau: 2.6033e-8, dtau: 0.0005e-8},
+	{kind: Meson, id: 221, name: "eta", sym: "η", mass: 547.862, dm: 0.017, width: 1.31e-3, dwidth: 0.05e-3},
+	{kind: Meson, id: 113, name: "rho0", sym: "ρ⁰", spin2: 2, mass: 775.26, dm: 0.25, width: 149.1, dwidth: 0.8},
+	{kind: Meson, id: 321, name: "K+", sym: "K⁺", anti: "K-", antiSym: "K⁻", charge3: 3, mass: 493.677, dm: 0.016, tau: 1.2380e-8, dtau: 0.0020e-8},
+	{kind: Meson, id: 310, name: "K_S0", sym: "K⁰_S", mass: 497.611, dm: 0.013, tau: 0.8954e-10, dtau: 0.0004e-10},
+	{kind: Meson, id: 130, name: "K_L0", sym: "K⁰_L", mass: 497.611, dm: 0.013, tau: 5.116e-8, dtau: 0.021e-8},
+	{kind: Meson, id: 421, name: "D0", sym: "D⁰", anti: "anti-D0", antiSym: "D̄⁰", mass: 1864.83, dm: 0.05, tau: 410.1e-15, dtau: 1.5e-15},
+	{kind: Meson, id: 443, name: "J/psi", sym: "J/ψ", spin2: 2, mass: 3096.900, dm: 0.006, width: 92.9e-3, dwidth: 2.8e-3},
+	{kind: Meson, id: 521, name: "B+", sym: "B⁺", anti: "B-", antiSym: "B⁻", charge3: 3, mass: 5279.34, dm: 0.12, tau: 1.638e-12, dtau: 0.004e-12},
+
+	// Baryons
+	{kind: Baryon, id: 2212, name: "p", sym: "p", anti: "anti-p", antiSym: "p̄", charge3: 3, spin2: 1, massOf: "ProtonMass", momentOf: "ProtonMagneticMoment"},
+	{kind: Baryon, id: 2112, name: "n", sym: "n", anti: "anti-n", antiSym: "n̄", spin2: 1, massOf: "NeutronMass", lifetimeOf: "NeutronMeanLifetime", momentOf: "NeutronMagneticMoment"},
+	{kind: Baryon, id: 2224, name: "Delta++", sym: "Δ⁺⁺", anti: "anti-Delta--", antiSym: "Δ̄⁻⁻", charge3: 6, spin2: 3, mass: 1232, dm: 2, width: 117, dwidth: 3},
+	{kind: Baryon, id: 3122, name: "Lambda", sym: "Λ", anti: "anti-Lambda", antiSym: "Λ̄", spin2: 1, mass: 1115.683, dm: 0.006, tau: 2.632e-10, dtau: 0.020e-10, moment: -0.613, dmoment: 0.004},
+	{kind: Baryon, id: 3222, name: "Sigma+", sym: "Σ⁺", anti: "anti-Sigma-", antiSym: "Σ̄⁻", charge3: 3, spin2: 1, mass: 1189.37, dm: 0.07, tau: 0.8018e-10, dtau: 0.0026e-10, moment: 2.458, dmoment: 0.010},
+	{kind: Baryon, id: 3312, name: "Xi-", sym: "Ξ⁻", anti: "anti-Xi+", antiSym: "Ξ̄⁺", charge3: -3, spin2: 1, mass: 1321.71, dm: 0.07, tau: 1.639e-10, dtau: 0.015e-10, moment: -0.6507, dmoment: 0.0025},
+	{kind: Baryon, id: 3334, name: "Omega-", sym: "Ω⁻", anti: "anti-Omega+", antiSym: "Ω̄⁺", charge3: -3, spin2: 3, mass: 1672.45, dm: 0.29, tau: 0.821e-10, dtau: 0.011e-10, moment: -2.02, dmoment: 0.05},
+}
+
+// -----------------------------------------------------------------------------
+// Catalog construction
+// -----------------------------------------------------------------------------
+
+var (
+	e  = constants.ElementaryCharge.Val()
+	c2 = constants.SpeedOfLight.Val() * constants.SpeedOfLight.Val()
+
+	nuclearMagneton = constants.NuclearMagneton.Val()
+	momentDim       = constants.NuclearMagneton.Dim()
+)
+
+var catalog, byID, byName = build(data)
+
+func build(entries []entry) ([]Particle, map[int]int, map[string]int) {
+	var out []Particle
+	ids, names := map[int]int{}, map[string]int{}
+	add := func(p Particle) {
+		if _, dup := ids[p.ID]; dup {
+			panic(fmt.Sprintf("particles: duplicate PDG ID %d", p.ID))
+		}
+		ids[p.ID] = len(out)
+		for _, k := range []string{p.Name, p.Symbol} {
+			if i, dup := names[k]; dup && i != len(out) {
+				panic(fmt.Sprintf("particles: duplicate name %q", k))
+			}
+			names[k] = len(out)
+		}
+		out = append(out, p)
+	}
+	for _, en := range entries {
+		p := particle(en)
+		p.SelfConjugate = en.anti == ""
+		add(p)
+		if !p.SelfConjugate {
+			a := p
+			a.Name, a.Symbol, a.ID = en.anti, en.antiSym, -p.ID
+			a.Charge = units.Coulomb(-p.Charge.Val())
+			a.MagneticMoment = p.MagneticMoment.Scale(-1)
+			add(a)
+		}
+	}
+	return out, ids, names
+}
+
+// particle builds a particle from a table entry.
+func particle(en entry) Particle {
+	p := Particle{
+		Name:   en.name,
+		Symbol: en.sym,
+		ID:     en.id,
+		Kind:   en.kind,
+		Charge: units.Coulomb(float64(en.charge3) / 3 * e),
+		Spin:   float64(en.spin2) / 2,
+	}
+	input := func(q string) string { return q + "(" + en.sym + ")" }
+
+	switch {
+	case en.massOf != "":
+		p.Mass = get(en.massOf)
+	default:
+		p.Mass = measure(input("m"), units.Kilogram(en.mass*1e6*e/c2).Value, en.dm*1e6*e/c2)
+	}
+
+	switch {
+	case en.lifetimeOf != "":
+		p.Lifetime = get(en.lifetimeOf)
+	case en.width > 0:
+		// τ = ℏ/Γ
+		gamma := measure(input("Γ"), units.Joule(en.width*1e6*e).Value, en.dwidth*1e6*e)
+		p.Lifetime = units.Exact(constants.PlanckReduced).Divide(gamma)
+	default:
+		p.Lifetime = measure(input("τ"), units.Second(en.tau).Value, en.dtau)
+	}
+
+	switch {
+	case en.momentOf != "":
+		p.MagneticMoment = get(en.momentOf)
+	default:
+		p.MagneticMoment = measure(input("μ"), units.NewValue(en.moment*nuclearMagneton, momentDim), en.dmoment*nuclearMagneton)
+	}
+	return p
+}
+
+func get(name string) units.Measurement {
+	m, err := constants.Get(name)
+	if err != nil {
+		panic(err)
+	}
+	return m.Measurement
+}
+
+func measure(name string, v units.Value, u float64) units.Measurement {
+	m, err := units.Measure(name, v, units.NewValue(math.Abs(u), v.Dim()))
+	if err != nil {
+		panic(fmt.Sprintf("particles: %s: %v", name, err))
+	}
+	return m
+}
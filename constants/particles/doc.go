@@ -0,0 +1,52 @@
+// Package particles provides a catalog of the elementary particles and
+// common hadrons with their Particle Data Group properties: mass, charge,
+// spin, mean lifetime, magnetic moment and PDG Monte Carlo number.
+//
+// The catalog covers the leptons, quarks, gauge and Higgs bosons, the light
+// and heavy-flavour mesons most often met in practice, and the nucleons and
+// hyperons. Every particle that is not its own antiparticle is listed with
+// its antiparticle, which has the negated PDG number, opposite charge and
+// magnetic moment. Masses, lifetimes and moments are units.Measurements; the
+// electron, muon, tau, proton and neutron take theirs from the constants
+// package, so the two agree exactly and share uncertainty inputs. For
+// resonances listed by width Γ the lifetime is derived as ℏ/Γ.
+//
+// For flavor physics, MSbarMass gives the running quark masses with their
+// renormalization scales, CKM the measured magnitudes of the quark-mixing
+// matrix, and Wolfenstein builds the full complex matrix, unitary to
+// rounding error, from the Wolfenstein parameters. Oscillation holds the
+// PMNS mixing angles and mass splittings of the neutrino sector for either
+// mass ordering and evaluates vacuum oscillation probabilities; the
+// conversion of Δm²L/E from SI to the natural-unit phase goes through ℏc.
+// AlphaS and AlphaEM run the strong and electromagnetic couplings at one
+// loop from their PDG values at m_Z to any scale, switching the number of
+// active fermions at their mass thresholds.
+//
+// Example usage:
+//
+//	import "github.com/sakiphan/qsim-core/constants/particles"
+//
+//	pi, _ := particles.Lookup("pi+") // or "π⁺"
+//	tau := pi.Lifetime               // 26.033 ns ± 5 ps
+//
+//	positron, _ := particles.ByID(-11)
+//	e := positron.Antiparticle() // the electron
+//
+//	for _, b := range particles.OfKind(particles.Baryon) {
+//	    fmt.Println(b, b.Mass)
+//	}
+//
+//	v := particles.WolfensteinPDG2020.Matrix()
+//	j := v.Jarlskog() // 3.0 × 10⁻⁵
+//
+//	// ν_μ → ν_e appearance at T2K
+//	p, _ := particles.OscillationPDG2020Normal.Probability(particles.NuMu, particles.NuE,
+//	    units.Kilometer(295), units.GigaelectronVolt(0.6))
+//
+//	as, _ := particles.AlphaS.At(units.GigaelectronVolt(10)) // 0.173 ± 0.002
+//
+// References:
+//   - Zyla et al. (Particle Data Group), "Review of Particle Physics",
+//     Prog. Theor. Exp. Phys. 2020, 083C01 (2020)
+//   - CODATA 2018 recommended values
+package particles
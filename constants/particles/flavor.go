@@ -0,0 +1,139 @@
+package particles
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Quark masses
+// -----------------------------------------------------------------------------
+
+// topMSbar is the MS-bar top mass m̄_t(m̄_t), 162.5 +2.1 -1.5 GeV, which
+// differs from the directly measured mass in the catalog by about 10 GeV.
+var topMSbar = measure("m̄(t)", units.MegaelectronVoltPerC2(162500).Value, 1800*1e6*e/c2)
+
+// MSbarMass returns the running mass of a quark in the MS-bar scheme and
+// the scale μ at which it is quoted: 2 GeV for u, d and s, and the mass
+// itself, m̄(m̄), for c, b and t. For all but the top quark this is the
+// catalog mass. Returns an error if flavor is not one of "u", "d", "s",
+// "c", "b" or "t".
+func MSbarMass(flavor string) (units.Measurement, units.Energy, error) {
+	switch flavor {
+	case "u", "d", "s":
+		q, _ := Lookup(flavor)
+		return q.Mass, units.GigaelectronVolt(2), nil
+	case "c", "b":
+		q, _ := Lookup(flavor)
+		return q.Mass, units.Joule(q.Mass.Value().Val() * c2), nil
+	case "t":
+		return topMSbar, units.Joule(topMSbar.Value().Val() * c2), nil
+	}
+	return units.Measurement{}, units.Energy{}, fmt.Errorf("unknown quark flavor %q", flavor)
+}
+
+// -----------------------------------------------------------------------------
+// Mixing matrices
+// -----------------------------------------------------------------------------
+
+// MixingMatrix is a 3×3 unitary flavor-mixing matrix. For the CKM matrix
+// the rows are u, c, t and the columns d, s, b; for the PMNS matrix the
+// rows are e, μ, τ and the columns the mass states 1, 2, 3.
+type MixingMatrix [3][3]complex128
+
+// StandardMixing returns the mixing matrix in the standard (PDG)
+// parametrization by three mixing angles and one CP-violating phase δ,
+// all in radians:
+//
+//	V = R₂₃(θ₂₃) · U₁₃(θ₁₃, δ) · R₁₂(θ₁₂)
+func StandardMixing(theta12, theta23, theta13, delta float64) MixingMatrix {
+	s12, c12 := math.Sincos(theta12)
+	s23, c23 := math.Sincos(theta23)
+	s13, c13 := math.Sincos(theta13)
+	p := cmplx.Exp(complex(0, delta))
+	s13p := complex(s13, 0) * p
+	return MixingMatrix{
+		{complex(c12*c13, 0), complex(s12*c13, 0), complex(s13, 0) / p},
+		{complex(-s12*c23, 0) - complex(c12*s23, 0)*s13p, complex(c12*c23, 0) - complex(s12*s23, 0)*s13p, complex(s23*c13, 0)},
+		{complex(s12*s23, 0) - complex(c12*c23, 0)*s13p, complex(-c12*s23, 0) - complex(s12*c23, 0)*s13p, complex(c23*c13, 0)},
+	}
+}
+
+// Abs returns the magnitudes of the elements.
+func (m MixingMatrix) Abs() [3][3]float64 {
+	var a [3][3]float64
+	for i := range m {
+		for j := range m[i] {
+			a[i][j] = cmplx.Abs(m[i][j])
+		}
+	}
+	return a
+}
+
+// Jarlskog returns the Jarlskog invariant J = Im(V₁₂ V₂₃ V₁₃* V₂₂*), the
+// parametrization-independent measure of CP violation.
+func (m MixingMatrix) Jarlskog() float64 {
+	return imag(m[0][1] * m[1][2] * cmplx.Conj(m[0][2]) * cmplx.Conj(m[1][1]))
+}
+
+// -----------------------------------------------------------------------------
+// CKM matrix
+// -----------------------------------------------------------------------------
+
+// Wolfenstein holds the Wolfenstein parameters of the CKM matrix, with
+// ρ̄ + iη̄ = -(V_ud V_ub*)/(V_cd V_cb*), which is independent of phase
+// convention.
+type Wolfenstein struct {
+	Lambda, A, RhoBar, EtaBar units.Measurement
+}
+
+// WolfensteinPDG2020 holds the Wolfenstein parameters of the PDG 2020
+// global fit. Asymmetric uncertainties are symmetrized.
+var WolfensteinPDG2020 = Wolfenstein{
+	Lambda: measure("λ", units.Dimensionless(0.22650), 0.00048),
+	A:      measure("A", units.Dimensionless(0.790), 0.015),
+	RhoBar: measure("ρ̄", units.Dimensionless(0.141), 0.017),
+	EtaBar: measure("η̄", units.Dimensionless(0.357), 0.011),
+}
+
+// Matrix returns the CKM matrix built from the central values of the
+// parameters. It uses the exact relations
+//
+//	s₁₂ = λ,  s₂₃ = Aλ²,  s₁₃e^{iδ} = Aλ³(ρ̄+iη̄)√(1-A²λ⁴) / (√(1-λ²)[1-A²λ⁴(ρ̄+iη̄)])
+//
+// rather than the expansion in λ, so the result is unitary to rounding
+// error at any order.
+func (w Wolfenstein) Matrix() MixingMatrix {
+	l, a := w.Lambda.Value().Val(), w.A.Value().Val()
+	rho := complex(w.RhoBar.Value().Val(), w.EtaBar.Value().Val())
+	al4 := a * a * l * l * l * l
+	s13 := complex(a*l*l*l*math.Sqrt(1-al4), 0) * rho /
+		(complex(math.Sqrt(1-l*l), 0) * (1 - complex(al4, 0)*rho))
+	return StandardMixing(math.Asin(l), math.Asin(a*l*l), math.Asin(cmplx.Abs(s13)), cmplx.Phase(s13))
+}
+
+// ckm lists the magnitudes |V_ij| of the PDG 2020 global fit with their
+// symmetrized uncertainties.
+var ckm = [3][3][2]float64{
+	{{0.97401, 0.00011}, {0.22650, 0.00048}, {0.00361, 0.00010}},
+	{{0.22636, 0.00048}, {0.97320, 0.00011}, {0.04053, 0.00072}},
+	{{0.00854, 0.00020}, {0.03978, 0.00071}, {0.999172, 0.000030}},
+}
+
+// CKM returns the magnitudes of the CKM matrix elements |V_ij| from the
+// PDG 2020 global fit, rows u, c, t and columns d, s, b. Each element is
+// an independent input named like "|V_us|"; the fit's correlations are not
+// carried.
+func CKM() [3][3]units.Measurement {
+	up, down := [3]string{"u", "c", "t"}, [3]string{"d", "s", "b"}
+	var v [3][3]units.Measurement
+	for i := range ckm {
+		for j := range ckm[i] {
+			v[i][j] = measure("|V_"+up[i]+down[j]+"|", units.Dimensionless(ckm[i][j][0]), ckm[i][j][1])
+		}
+	}
+	return v
+}
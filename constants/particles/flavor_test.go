@@ -0,0 +1,80 @@
+package particles
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestMSbarMass(t *testing.T) {
+	m, mu, err := MSbarMass("s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := Lookup("s"); m.Value().Val() != s.Mass.Value().Val() {
+		t.Errorf("m̄_s = %v, want the catalog mass", m)
+	}
+	if got := mu.Val() / 1.602176634e-10; !almostEqual(got, 2, 1e-12) {
+		t.Errorf("scale for s = %v GeV, want 2", got)
+	}
+	mt, mut, _ := MSbarMass("t")
+	if top, _ := Lookup("t"); mt.Value().Val() >= top.Mass.Value().Val() {
+		t.Errorf("m̄_t = %v exceeds the direct top mass", mt)
+	}
+	if !almostEqual(mut.Val(), mt.Value().Val()*299792458*299792458, 1e-12) {
+		t.Errorf("scale for t = %v, want m̄_t c²", mut)
+	}
+	if _, _, err := MSbarMass("e-"); err == nil {
+		t.Error("MSbarMass(e-): expected error")
+	}
+}
+
+func TestStandardMixingUnitary(t *testing.T) {
+	v := StandardMixing(0.58, 0.84, 0.15, 3.4)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			var sum complex128
+			for k := 0; k < 3; k++ {
+				sum += v[i][k] * cmplx.Conj(v[j][k])
+			}
+			want := complex(0, 0)
+			if i == j {
+				want = 1
+			}
+			if cmplx.Abs(sum-want) > 1e-14 {
+				t.Errorf("(V V†)[%d][%d] = %v, want %v", i, j, sum, want)
+			}
+		}
+	}
+	// J = c₁₂s₁₂c₂₃s₂₃c₁₃²s₁₃ sin δ
+	s12, c12 := math.Sincos(0.58)
+	s23, c23 := math.Sincos(0.84)
+	s13, c13 := math.Sincos(0.15)
+	want := c12 * s12 * c23 * s23 * c13 * c13 * s13 * math.Sin(3.4)
+	if got := v.Jarlskog(); !almostEqual(got, want, 1e-12) {
+		t.Errorf("Jarlskog = %g, want %g", got, want)
+	}
+}
+
+func TestWolfenstein(t *testing.T) {
+	v := WolfensteinPDG2020.Matrix()
+	abs := v.Abs()
+	fit := CKM()
+	for i := range abs {
+		for j := range abs[i] {
+			m := fit[i][j]
+			if d := math.Abs(abs[i][j] - m.Value().Val()); d > 2*m.Uncertainty().Val() {
+				t.Errorf("|V[%d][%d]| = %.6f from the Wolfenstein parameters, fit %v", i, j, abs[i][j], m)
+			}
+		}
+	}
+	// PDG 2020: J = (3.00 +0.15 -0.09) × 10⁻⁵
+	if got := v.Jarlskog(); !almostEqual(got, 3.00e-5, 0.05) {
+		t.Errorf("Jarlskog = %g, want 3.00e-5", got)
+	}
+	// ρ̄ + iη̄ = -(V_ud V_ub*)/(V_cd V_cb*)
+	apex := -(v[0][0] * cmplx.Conj(v[0][2])) / (v[1][0] * cmplx.Conj(v[1][2]))
+	if !almostEqual(real(apex), 0.141, 1e-9) || !almostEqual(imag(apex), 0.357, 1e-9) {
+		t.Errorf("unitarity triangle apex = %v, want 0.141 + 0.357i", apex)
+	}
+}
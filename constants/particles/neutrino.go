@@ -0,0 +1,138 @@
+package particles
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Flavor is a neutrino flavor, the row index of the PMNS matrix.
+type Flavor int
+
+const (
+	NuE Flavor = iota
+	NuMu
+	NuTau
+)
+
+// String returns the flavor's symbol, such as "ν_μ".
+func (f Flavor) String() string {
+	switch f {
+	case NuE:
+		return "ν_e"
+	case NuMu:
+		return "ν_μ"
+	case NuTau:
+		return "ν_τ"
+	}
+	return fmt.Sprintf("Flavor(%d)", int(f))
+}
+
+// Oscillation holds the parameters of three-flavor neutrino oscillation.
+// Mass splittings are Δm²_ij = m_i² - m_j², stored as energies squared,
+// Δm²c⁴ in J²; the sign of DeltaMSq32 is the mass ordering.
+type Oscillation struct {
+	SinSqTheta12, SinSqTheta23, SinSqTheta13 units.Measurement
+	DeltaCP                                  units.Measurement // in radians
+	DeltaMSq21, DeltaMSq32                   units.Measurement
+}
+
+// eV2 converts a value in eV² to J².
+const eV2 = 1.602176634e-19 * 1.602176634e-19
+
+var energySq = units.ElectronVolt(1).Value.Power(2).Dim()
+
+func oscillation(s12, ds12, s23, ds23, s13, ds13, dm21, ddm21, dm32, ddm32 float64) Oscillation {
+	return Oscillation{
+		SinSqTheta12: measure("sin²θ₁₂", units.Dimensionless(s12), ds12),
+		SinSqTheta23: measure("sin²θ₂₃", units.Dimensionless(s23), ds23),
+		SinSqTheta13: measure("sin²θ₁₃", units.Dimensionless(s13), ds13),
+		DeltaCP:      measure("δ_CP", units.Dimensionless(1.36*math.Pi), 0.20*math.Pi),
+		DeltaMSq21:   measure("Δm²₂₁", units.NewValue(dm21*eV2, energySq), ddm21*eV2),
+		DeltaMSq32:   measure("Δm²₃₂", units.NewValue(dm32*eV2, energySq), ddm32*eV2),
+	}
+}
+
+// OscillationPDG2020Normal and OscillationPDG2020Inverted hold the PDG
+// 2020 oscillation parameters for normal (m₁ < m₂ < m₃) and inverted
+// (m₃ < m₁ < m₂) mass ordering. Asymmetric uncertainties are symmetrized.
+var (
+	OscillationPDG2020Normal   = oscillation(0.307, 0.013, 0.545, 0.021, 2.18e-2, 0.07e-2, 7.53e-5, 0.18e-5, 2.453e-3, 0.034e-3)
+	OscillationPDG2020Inverted = oscillation(0.307, 0.013, 0.547, 0.021, 2.18e-2, 0.07e-2, 7.53e-5, 0.18e-5, -2.546e-3, 0.037e-3)
+)
+
+// DeltaMSq31 returns Δm²₃₁ = Δm²₃₂ + Δm²₂₁.
+func (o Oscillation) DeltaMSq31() units.Measurement {
+	d, _ := o.DeltaMSq32.Add(o.DeltaMSq21)
+	return d
+}
+
+// PMNS returns the PMNS matrix built from the central values of the
+// parameters. Majorana phases, which do not affect oscillation, are
+// omitted.
+func (o Oscillation) PMNS() MixingMatrix {
+	angle := func(s units.Measurement) float64 { return math.Asin(math.Sqrt(s.Value().Val())) }
+	return StandardMixing(angle(o.SinSqTheta12), angle(o.SinSqTheta23), angle(o.SinSqTheta13), o.DeltaCP.Value().Val())
+}
+
+// Probability returns the three-flavor vacuum oscillation probability
+//
+//	P(ν_α → ν_β) = |Σᵢ U*_αi U_βi exp(-i m_i²c⁴ L / 2ℏcE)|²
+//
+// after a baseline l at neutrino energy e, using the central values of the
+// parameters. For antineutrinos use CPT symmetry: P(ν̄_α → ν̄_β) equals
+// P(ν_β → ν_α). Returns an error if the energy is not positive or the
+// baseline is negative.
+func (o Oscillation) Probability(alpha, beta Flavor, l units.Length, e units.Energy) (float64, error) {
+	k, err := phasePerMassSq(l, e)
+	if err != nil {
+		return 0, err
+	}
+	if alpha < NuE || alpha > NuTau || beta < NuE || beta > NuTau {
+		return 0, fmt.Errorf("invalid flavors %v, %v", alpha, beta)
+	}
+	u := o.PMNS()
+	dm21, dm32 := o.DeltaMSq21.Value().Val(), o.DeltaMSq32.Value().Val()
+	mSq := [3]float64{0, dm21, dm21 + dm32} // only differences matter
+	var amp complex128
+	for i := range mSq {
+		amp += cmplx.Conj(u[alpha][i]) * u[beta][i] * cmplx.Exp(complex(0, -2*k*mSq[i]))
+	}
+	return real(amp * cmplx.Conj(amp)), nil
+}
+
+// TwoFlavorProbability returns the two-flavor vacuum appearance
+// probability
+//
+//	P = sin²2θ sin²(Δm²c⁴ L / 4ℏcE)
+//
+// for mixing sinSq2Theta = sin²2θ and mass splitting deltaMSq, given as an
+// energy squared such as OscillationPDG2020Normal.DeltaMSq31().Value();
+// the survival probability is 1 - P. Returns an error if deltaMSq is not
+// an energy squared, the energy is not positive or the baseline is
+// negative.
+func TwoFlavorProbability(sinSq2Theta float64, deltaMSq units.Value, l units.Length, e units.Energy) (float64, error) {
+	if deltaMSq.Dim() != energySq {
+		return 0, fmt.Errorf("mass splitting must be an energy squared, got %v", deltaMSq.Dim())
+	}
+	k, err := phasePerMassSq(l, e)
+	if err != nil {
+		return 0, err
+	}
+	s := math.Sin(k * deltaMSq.Val())
+	return sinSq2Theta * s * s, nil
+}
+
+// phasePerMassSq returns L/4ℏcE, the oscillation phase per unit Δm²c⁴.
+func phasePerMassSq(l units.Length, e units.Energy) (float64, error) {
+	if e.Val() <= 0 {
+		return 0, fmt.Errorf("neutrino energy must be positive, got %v", e)
+	}
+	if l.Val() < 0 {
+		return 0, fmt.Errorf("baseline must be non-negative, got %v", l)
+	}
+	return l.Val() / (4 * constants.HbarC.Val() * e.Val()), nil
+}
@@ -0,0 +1,77 @@
+package particles
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestProbabilityUnitarity(t *testing.T) {
+	for _, o := range []Oscillation{OscillationPDG2020Normal, OscillationPDG2020Inverted} {
+		for _, alpha := range []Flavor{NuE, NuMu, NuTau} {
+			var sum float64
+			for _, beta := range []Flavor{NuE, NuMu, NuTau} {
+				p, err := o.Probability(alpha, beta, units.Kilometer(1300), units.GigaelectronVolt(2.5))
+				if err != nil {
+					t.Fatal(err)
+				}
+				sum += p
+			}
+			if !almostEqual(sum, 1, 1e-12) {
+				t.Errorf("Σ_β P(%v → ν_β) = %v, want 1", alpha, sum)
+			}
+		}
+	}
+	p, _ := OscillationPDG2020Normal.Probability(NuMu, NuMu, units.Meter(0), units.GigaelectronVolt(1))
+	if !almostEqual(p, 1, 1e-12) {
+		t.Errorf("P(ν_μ → ν_μ) at L = 0 is %v, want 1", p)
+	}
+}
+
+func TestProbabilityReactor(t *testing.T) {
+	// At a 1.6 km reactor baseline ν_e disappearance is driven by θ₁₃ and
+	// Δm²₃₁; the two-flavor formula holds to the size of the solar term.
+	o := OscillationPDG2020Normal
+	l, e := units.Kilometer(1.6), units.MegaelectronVolt(4)
+	p, _ := o.Probability(NuE, NuE, l, e)
+	s13 := o.SinSqTheta13.Value().Val()
+	p2, err := TwoFlavorProbability(4*s13*(1-s13), o.DeltaMSq31().Value(), l, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs((1-p)-p2) > 2e-3 {
+		t.Errorf("1 - P(ν_e → ν_e) = %v, two-flavor %v", 1-p, p2)
+	}
+	if p2 < 0.05 || p2 > 0.09 {
+		t.Errorf("reactor disappearance = %v, want near the oscillation maximum 0.085", p2)
+	}
+}
+
+func TestProbabilityCP(t *testing.T) {
+	// δ_CP ≈ 1.36π enhances ν_μ → ν_e over its antineutrino counterpart,
+	// P(ν̄_μ → ν̄_e) = P(ν_e → ν_μ).
+	o := OscillationPDG2020Normal
+	l, e := units.Kilometer(295), units.GigaelectronVolt(0.6)
+	nu, _ := o.Probability(NuMu, NuE, l, e)
+	anti, _ := o.Probability(NuE, NuMu, l, e)
+	if nu <= anti {
+		t.Errorf("P(ν_μ → ν_e) = %v, P(ν̄_μ → ν̄_e) = %v; want ν enhanced", nu, anti)
+	}
+	if surv, _ := o.Probability(NuMu, NuMu, l, e); surv > 0.05 {
+		t.Errorf("P(ν_μ → ν_μ) at the first maximum = %v, want near 0", surv)
+	}
+}
+
+func TestProbabilityErrors(t *testing.T) {
+	o := OscillationPDG2020Normal
+	if _, err := o.Probability(NuE, NuMu, units.Kilometer(1), units.GigaelectronVolt(0)); err == nil {
+		t.Error("zero energy: expected error")
+	}
+	if _, err := o.Probability(NuE, Flavor(3), units.Kilometer(1), units.GigaelectronVolt(1)); err == nil {
+		t.Error("invalid flavor: expected error")
+	}
+	if _, err := TwoFlavorProbability(1, units.Dimensionless(2.5e-3), units.Kilometer(1), units.GigaelectronVolt(1)); err == nil {
+		t.Error("dimensionless Δm²: expected error")
+	}
+}
@@ -0,0 +1,139 @@
+package particles
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Kind classifies particles.
+type Kind int
+
+const (
+	Lepton Kind = iota
+	Quark
+	GaugeBoson
+	ScalarBoson
+	Meson
+	Baryon
+)
+
+// String returns the name of the kind, such as "lepton".
+func (k Kind) String() string {
+	switch k {
+	case Lepton:
+		return "lepton"
+	case Quark:
+		return "quark"
+	case GaugeBoson:
+		return "gauge boson"
+	case ScalarBoson:
+		return "scalar boson"
+	case Meson:
+		return "meson"
+	case Baryon:
+		return "baryon"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Particle holds the properties of a particle or antiparticle.
+type Particle struct {
+	Name   string // PDG-style ASCII name, such as "pi+" or "anti-p"
+	Symbol string // typeset symbol, such as "π⁺" or "p̄"
+	ID     int    // PDG Monte Carlo particle number; negative for antiparticles
+	Kind   Kind
+
+	// Mass is the rest mass. Quark masses are MS-bar masses (light quarks
+	// at 2 GeV, c and b at their own scale) except for the top quark's
+	// direct measurement; neutrino masses are zero.
+	Mass units.Measurement
+
+	Charge units.Charge
+	Spin   float64 // in units of ℏ
+
+	// Lifetime is the mean lifetime, from the width Γ as ℏ/Γ for
+	// resonances, and zero for stable particles and quarks.
+	Lifetime units.Measurement
+
+	// MagneticMoment is zero where it is not measured or not meaningful.
+	MagneticMoment units.Measurement
+
+	// SelfConjugate reports whether the particle is its own antiparticle.
+	SelfConjugate bool
+}
+
+// ChargeNumber returns the charge in units of the elementary charge.
+func (p Particle) ChargeNumber() float64 {
+	return p.Charge.Val() / constants.ElementaryCharge.Val()
+}
+
+// Stable returns true if the particle's lifetime is zero, i.e. it is
+// stable or, for quarks, confined.
+func (p Particle) Stable() bool {
+	return p.Lifetime.Value().Val() == 0
+}
+
+// Antiparticle returns the antiparticle: same mass, spin and lifetime,
+// opposite charge and magnetic moment. A self-conjugate particle is its
+// own antiparticle.
+func (p Particle) Antiparticle() Particle {
+	if p.SelfConjugate {
+		return p
+	}
+	a, err := ByID(-p.ID)
+	if err != nil {
+		panic(err) // the catalog holds both members of every pair
+	}
+	return a
+}
+
+// String returns the particle's symbol.
+func (p Particle) String() string {
+	return p.Symbol
+}
+
+// -----------------------------------------------------------------------------
+// Queries
+// -----------------------------------------------------------------------------
+
+// ByID returns the particle with the given PDG Monte Carlo number, such as
+// 11 for the electron or -11 for the positron. Returns an error if the
+// number is not in the catalog.
+func ByID(id int) (Particle, error) {
+	i, ok := byID[id]
+	if !ok {
+		return Particle{}, fmt.Errorf("no particle with PDG ID %d", id)
+	}
+	return catalog[i], nil
+}
+
+// Lookup returns the particle with the given name or symbol, such as
+// "pi+" or "π⁺". Matching is exact: "B+" is the meson and "b" the quark.
+// Returns an error if there is no such particle.
+func Lookup(name string) (Particle, error) {
+	i, ok := byName[name]
+	if !ok {
+		return Particle{}, fmt.Errorf("unknown particle %q", name)
+	}
+	return catalog[i], nil
+}
+
+// All returns every particle in the catalog, each followed by its
+// antiparticle, grouped by kind.
+func All() []Particle {
+	return append([]Particle(nil), catalog...)
+}
+
+// OfKind returns the particles and antiparticles of one kind, in catalog
+// order.
+func OfKind(k Kind) []Particle {
+	var out []Particle
+	for _, p := range catalog {
+		if p.Kind == k {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,146 @@
+package particles
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestLookup(t *testing.T) {
+	a, err := Lookup("pi+")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Lookup("π⁺")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.ID != 211 || b.ID != 211 {
+		t.Errorf("Lookup(pi+) = %d, Lookup(π⁺) = %d; want 211", a.ID, b.ID)
+	}
+	if p, _ := Lookup("B+"); p.Kind != Meson {
+		t.Errorf("Lookup(B+) kind = %v, want meson", p.Kind)
+	}
+	if p, _ := Lookup("b"); p.Kind != Quark {
+		t.Errorf("Lookup(b) kind = %v, want quark", p.Kind)
+	}
+	for _, name := range []string{"", "pion", "Pi+"} {
+		if _, err := Lookup(name); err == nil {
+			t.Errorf("Lookup(%q): expected error", name)
+		}
+	}
+	if _, err := ByID(99999); err == nil {
+		t.Error("ByID(99999): expected error")
+	}
+}
+
+// constantValue returns the value of a registered constant in SI units.
+func constantValue(name string) float64 {
+	m, _ := constants.Get(name)
+	return m.Value().Val()
+}
+
+func TestConstantsAgree(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		want float64
+	}{
+		{"e-", constantValue("ElectronMass")},
+		{"mu-", constantValue("MuonMass")},
+		{"p", constantValue("ProtonMass")},
+		{"n", constantValue("NeutronMass")},
+	} {
+		p, _ := Lookup(tc.name)
+		if got := p.Mass.Value().Val(); got != tc.want {
+			t.Errorf("%s mass = %g, want %g", tc.name, got, tc.want)
+		}
+	}
+	pi, _ := Lookup("pi+")
+	if got := pi.Mass.Value().Val() * 299792458 * 299792458 / 1.602176634e-13; !almostEqual(got, 139.57039, 1e-9) {
+		t.Errorf("π⁺ mass = %v MeV, want 139.57039", got)
+	}
+}
+
+func TestAntiparticle(t *testing.T) {
+	positron, err := ByID(-11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if positron.Name != "e+" || positron.ChargeNumber() != 1 {
+		t.Errorf("ByID(-11) = %s with charge %v, want e+ with +1", positron.Name, positron.ChargeNumber())
+	}
+	e := positron.Antiparticle()
+	if e.ID != 11 || e.Antiparticle().ID != -11 {
+		t.Errorf("antiparticle of the positron = %d", e.ID)
+	}
+	if positron.Mass.Value().Val() != e.Mass.Value().Val() {
+		t.Error("positron and electron masses differ")
+	}
+	if positron.MagneticMoment.Value().Val() != -e.MagneticMoment.Value().Val() {
+		t.Error("positron moment is not the electron's negated")
+	}
+
+	gamma, _ := Lookup("gamma")
+	if !gamma.SelfConjugate || !gamma.Stable() || gamma.Antiparticle().ID != 22 {
+		t.Errorf("photon: self-conjugate %v, stable %v", gamma.SelfConjugate, gamma.Stable())
+	}
+	for _, p := range All() {
+		if a := p.Antiparticle(); a.Antiparticle().ID != p.ID || a.Mass.Value().Val() != p.Mass.Value().Val() {
+			t.Errorf("%s: antiparticle does not round-trip", p.Name)
+		}
+	}
+}
+
+func TestQuarks(t *testing.T) {
+	if got := len(OfKind(Quark)); got != 12 {
+		t.Errorf("OfKind(Quark) has %d entries, want 12", got)
+	}
+	u, _ := Lookup("u")
+	d, _ := Lookup("d")
+	if q := 2*u.ChargeNumber() + d.ChargeNumber(); !almostEqual(q, 1, 1e-12) {
+		t.Errorf("uud charge = %v, want 1", q)
+	}
+	if q := u.ChargeNumber() + 2*d.ChargeNumber(); math.Abs(q) > 1e-12 {
+		t.Errorf("udd charge = %v, want 0", q)
+	}
+	if u.Spin != 0.5 || !u.Stable() {
+		t.Errorf("u: spin %v, stable %v", u.Spin, u.Stable())
+	}
+}
+
+func TestLifetimeFromWidth(t *testing.T) {
+	w, _ := Lookup("W+")
+	// ℏ/Γ with Γ = 2.085 GeV
+	if got := w.Lifetime.Value().Val(); !almostEqual(got, 3.157e-25, 1e-3) {
+		t.Errorf("W lifetime = %g s, want 3.157e-25", got)
+	}
+	if !almostEqual(w.Lifetime.Relative(), 42.0/2085, 1e-9) {
+		t.Errorf("W lifetime relative uncertainty = %g, want %g", w.Lifetime.Relative(), 42.0/2085)
+	}
+	n, _ := Lookup("n")
+	if got := n.Lifetime.Value().Val(); got != constantValue("NeutronMeanLifetime") {
+		t.Errorf("neutron lifetime = %g s", got)
+	}
+}
+
+func TestMagneticMoment(t *testing.T) {
+	mu, _ := Lookup("mu-")
+	if got := mu.MagneticMoment.Value().Val(); !almostEqual(got, -4.49044830e-26, 1e-8) {
+		t.Errorf("muon moment = %g J/T, want -4.49044830e-26", got)
+	}
+	p, _ := Lookup("p")
+	if got := p.MagneticMoment.Value().Val() / nuclearMagneton; !almostEqual(got, 2.79284734, 1e-8) {
+		t.Errorf("proton moment = %v μ_N, want 2.79284734", got)
+	}
+}
+
+func TestKindString(t *testing.T) {
+	if Baryon.String() != "baryon" || Kind(42).String() != "Kind(42)" {
+		t.Errorf("Kind.String: %q, %q", Baryon, Kind(42))
+	}
+}
@@ -0,0 +1,136 @@
+package particles
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Coupling is a gauge coupling α(Q) run at one loop from a reference
+// value. Between fermion mass thresholds it obeys
+//
+//	1/α(Q) = 1/α(μ) + β₀/2π · ln(Q/μ)
+//
+// with β₀ counting the fermions lighter than Q. Copy a Coupling and set
+// Alpha and Scale to run from a different reference value.
+type Coupling struct {
+	Alpha units.Measurement // α at the reference scale
+	Scale units.Energy      // reference scale μ
+
+	beta0      float64 // β₀ with no fermions active
+	thresholds []threshold
+}
+
+// threshold is a fermion's rest energy in J and its contribution to β₀
+// above it.
+type threshold struct {
+	energy, beta0 float64
+}
+
+// AlphaS is the strong coupling, α_s(m_Z) = 0.1179(10) in the MS-bar
+// scheme (PDG 2020), with β₀ = 11 - 2n_f/3 and quark thresholds at the
+// MS-bar masses. One-loop running puts the Landau pole near 0.15 GeV;
+// below a few GeV the result is at best qualitative.
+var AlphaS = Coupling{
+	Alpha:      get("StrongCouplingConstant"),
+	Scale:      restEnergy("Z0"),
+	beta0:      11,
+	thresholds: quarkThresholds(func(charge3 int) float64 { return -2.0 / 3 }),
+}
+
+// AlphaEM is the electromagnetic coupling, α(m_Z)⁻¹ = 127.952(9) in the
+// MS-bar scheme (PDG 2020), with β₀ = -(4/3) Σ N_c q_f² over the charged
+// leptons and quarks; the W boson is not included. Quark loops stand in
+// for the hadronic vacuum polarization, with thresholds at the MS-bar
+// masses; run down to zero momentum this reproduces the Thomson-limit
+// α⁻¹ = 137.036 to about 1%.
+var AlphaEM = Coupling{
+	Alpha: measure("α(m_Z)", units.Dimensionless(1/127.952), 0.009/(127.952*127.952)),
+	Scale: restEnergy("Z0"),
+	thresholds: append(
+		quarkThresholds(func(charge3 int) float64 { return -4.0 / 9 * float64(charge3*charge3) }),
+		leptonThresholds()...),
+}
+
+// At returns the coupling at scale q, with the uncertainty of the
+// reference value propagated. Returns an error if q is not positive or
+// lies beyond a Landau pole.
+func (c Coupling) At(q units.Energy) (units.Measurement, error) {
+	if q.Val() <= 0 {
+		return units.Measurement{}, fmt.Errorf("scale must be positive, got %v", q)
+	}
+	shift := c.integral(c.Scale.Val(), q.Val())
+	inv, _ := units.Exact(units.Dimensionless(1)).Divide(c.Alpha).Add(units.Exact(units.Dimensionless(shift)))
+	if inv.Value().Val() <= 0 {
+		return units.Measurement{}, fmt.Errorf("coupling diverges between %v and %v (Landau pole)", c.Scale, q)
+	}
+	return units.Exact(units.Dimensionless(1)).Divide(inv), nil
+}
+
+// integral returns ∫ β₀/2π d ln Q from a to b.
+func (c Coupling) integral(a, b float64) float64 {
+	sign := 1.0
+	if b < a {
+		a, b, sign = b, a, -1
+	}
+	points := []float64{a, b}
+	for _, t := range c.thresholds {
+		if t.energy > a && t.energy < b {
+			points = append(points, t.energy)
+		}
+	}
+	sort.Float64s(points)
+	var sum float64
+	for i := 1; i < len(points); i++ {
+		lo, hi := points[i-1], points[i]
+		sum += c.beta0At(math.Sqrt(lo*hi)) / (2 * math.Pi) * math.Log(hi/lo)
+	}
+	return sign * sum
+}
+
+// beta0At returns β₀ with the fermions lighter than q active.
+func (c Coupling) beta0At(q float64) float64 {
+	b := c.beta0
+	for _, t := range c.thresholds {
+		if q > t.energy {
+			b += t.beta0
+		}
+	}
+	return b
+}
+
+func restEnergy(name string) units.Energy {
+	p, err := Lookup(name)
+	if err != nil {
+		panic(err)
+	}
+	return units.Joule(p.Mass.Value().Val() * c2)
+}
+
+// quarkThresholds returns a threshold at each quark's MS-bar mass with
+// the β₀ contribution given by beta0 from its charge in units of e/3.
+func quarkThresholds(beta0 func(charge3 int) float64) []threshold {
+	var out []threshold
+	for _, en := range data {
+		if en.kind != Quark {
+			continue
+		}
+		m, _, err := MSbarMass(en.name)
+		if err != nil {
+			panic(err)
+		}
+		out = append(out, threshold{m.Value().Val() * c2, beta0(en.charge3)})
+	}
+	return out
+}
+
+// leptonThresholds returns a threshold at each charged lepton's mass.
+func leptonThresholds() []threshold {
+	var out []threshold
+	for _, name := range []string{"e-", "mu-", "tau-"} {
+		out = append(out, threshold{restEnergy(name).Val(), -4.0 / 3})
+	}
+	return out
+}
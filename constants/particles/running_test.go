@@ -0,0 +1,60 @@
+package particles
+
+import (
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestAlphaS(t *testing.T) {
+	mz := AlphaS.Scale
+	a, err := AlphaS.At(mz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(a.Value().Val(), 0.1179, 1e-12) || !almostEqual(a.Uncertainty().Val(), 0.0010, 1e-9) {
+		t.Errorf("α_s(m_Z) = %v, want 0.1179 ± 0.0010", a)
+	}
+	// Asymptotic freedom, and one-loop values within the spread of the
+	// PDG summary plot: α_s(10 GeV) ≈ 0.18, α_s(1 TeV) ≈ 0.089.
+	lo, _ := AlphaS.At(units.GigaelectronVolt(10))
+	hi, _ := AlphaS.At(units.GigaelectronVolt(1000))
+	if !almostEqual(lo.Value().Val(), 0.18, 0.05) || !almostEqual(hi.Value().Val(), 0.089, 0.02) {
+		t.Errorf("α_s(10 GeV) = %v, α_s(1 TeV) = %v", lo, hi)
+	}
+	if lo.Relative() <= a.Relative() {
+		t.Errorf("relative uncertainty should grow toward low scales: %g at 10 GeV, %g at m_Z", lo.Relative(), a.Relative())
+	}
+	if _, err := AlphaS.At(units.GigaelectronVolt(0.05)); err == nil {
+		t.Error("α_s below the Landau pole: expected error")
+	}
+	if _, err := AlphaS.At(units.GigaelectronVolt(0)); err == nil {
+		t.Error("zero scale: expected error")
+	}
+}
+
+func TestAlphaEM(t *testing.T) {
+	a0, err := AlphaEM.At(units.ElectronVolt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := 1 / a0.Value().Val(); !almostEqual(got, 137.036, 0.015) {
+		t.Errorf("1/α at low momentum = %v, want 137.036 within 1.5%%", got)
+	}
+	// Above all thresholds but the top, only the decades of Q matter:
+	// 1/α changes by β₀/2π · ln 10 with β₀ = -(4/3)(3 + 11/3) = -80/9.
+	a1, _ := AlphaEM.At(units.GigaelectronVolt(10))
+	a2, _ := AlphaEM.At(units.GigaelectronVolt(100))
+	want := 80.0 / 9 / (2 * 3.141592653589793) * 2.302585092994046
+	if got := 1/a1.Value().Val() - 1/a2.Value().Val(); !almostEqual(got, want, 1e-9) {
+		t.Errorf("Δ(1/α) from 10 to 100 GeV = %v, want %v", got, want)
+	}
+
+	// A copy run from a different reference value
+	c := AlphaS
+	c.Alpha = units.Exact(units.Dimensionless(0.118))
+	a, _ := c.At(c.Scale)
+	if !a.IsExact() || a.Value().Val() != 0.118 {
+		t.Errorf("reparameterized α_s(m_Z) = %v", a)
+	}
+}
@@ -0,0 +1,56 @@
+package planets
+
+import (
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// entry is one row of the data table.
+type entry struct {
+	name, parent string
+	mass         units.Mass
+	radius       units.Length
+	a            units.Length
+	ecc          float64
+	rotation     units.Time
+	gm           units.StandardGravitationalParameter
+}
+
+func kg24(m float64) units.Mass  { return units.Kilogram(m * 1e24) }
+func km(r float64) units.Length  { return units.Kilometer(r) }
+func mkm(a float64) units.Length { return units.Kilometer(a * 1e6) }
+func gm(mu float64) units.StandardGravitationalParameter {
+	return units.CubicKilometerPerSecond2(mu)
+}
+
+// data lists masses, mean radii, orbital elements and sidereal rotation
+// periods from the NASA planetary fact sheets, moon masses and orbits
+// from the JPL satellite tables, and gravitational parameters GM in km³/s²
+// from the JPL planetary and satellite ephemerides (DE440 and the
+// satellite solutions); Pluto's excludes Charon.
+var data = []entry{
+	// Planets and Pluto
+	{"Mercury", "Sun", kg24(0.330103), km(2439.4), mkm(57.909), 0.2056, units.Hour(1407.6), gm(22031.868551)},
+	{"Venus", "Sun", kg24(4.86731), km(6051.8), mkm(108.210), 0.0068, units.Hour(-5832.6), gm(324858.592)},
+	{"Earth", "Sun", constants.EarthMass, constants.EarthRadius, mkm(149.598), 0.0167, units.Hour(23.9345), constants.EarthMassParameter},
+	{"Mars", "Sun", kg24(0.641691), km(3389.5), mkm(227.956), 0.0935, units.Hour(24.6229), gm(42828.375816)},
+	{"Jupiter", "Sun", kg24(1898.125), km(69911), mkm(778.479), 0.0487, units.Hour(9.9250), gm(126712764.1)},
+	{"Saturn", "Sun", kg24(568.317), km(58232), mkm(1432.041), 0.0520, units.Hour(10.656), gm(37940584.8418)},
+	{"Uranus", "Sun", kg24(86.8099), km(25362), mkm(2867.043), 0.0469, units.Hour(-17.24), gm(5794556.4)},
+	{"Neptune", "Sun", kg24(102.4092), km(24622), mkm(4514.953), 0.0097, units.Hour(16.11), gm(6836527.10058)},
+	{"Pluto", "Sun", kg24(0.01303), km(1188.3), mkm(5869.656), 0.2444, units.Hour(-153.2928), gm(869.6)},
+
+	// Moons
+	{"Moon", "Earth", kg24(0.07346), km(1737.4), km(384400), 0.0549, units.Day(27.321661), gm(4902.800118)},
+	{"Phobos", "Mars", kg24(1.0659e-8), km(11.08), km(9376), 0.0151, units.Day(0.31891), gm(0.0007087)},
+	{"Deimos", "Mars", kg24(1.4762e-9), km(6.2), km(23463.2), 0.00033, units.Day(1.26244), gm(0.0000962)},
+	{"Io", "Jupiter", kg24(0.08931938), km(1821.6), km(421700), 0.0041, units.Day(1.769138), gm(5959.91)},
+	{"Europa", "Jupiter", kg24(0.04799844), km(1560.8), km(671034), 0.0094, units.Day(3.551181), gm(3202.72)},
+	{"Ganymede", "Jupiter", kg24(0.1481858), km(2634.1), km(1070412), 0.0013, units.Day(7.154553), gm(9887.83)},
+	{"Callisto", "Jupiter", kg24(0.1075938), km(2410.3), km(1882709), 0.0074, units.Day(16.689018), gm(7179.29)},
+	{"Enceladus", "Saturn", kg24(1.08022e-4), km(252.1), km(238042), 0.0047, units.Day(1.370218), gm(7.21)},
+	{"Titan", "Saturn", kg24(0.1345180), km(2574.7), km(1221870), 0.0288, units.Day(15.945421), gm(8978.14)},
+	{"Titania", "Uranus", kg24(3.4e-3), km(788.9), km(436300), 0.0011, units.Day(-8.705867), gm(228.2)},
+	{"Triton", "Neptune", kg24(0.0214), km(1353.4), km(354759), 0.000016, units.Day(-5.876854), gm(1428.5)},
+	{"Charon", "Pluto", kg24(1.586e-3), km(606), km(19591), 0.0002, units.Day(-6.3872), gm(106.1)},
+}
@@ -0,0 +1,34 @@
+// Package planets provides physical and orbital data for the eight
+// planets, Pluto and the major moons of the Solar System.
+//
+// Each Body carries its mass, mean radius, the semi-major axis and
+// eccentricity of its orbit about its parent, and its sidereal rotation
+// period as unit-typed values, with surface gravity and escape velocity
+// derived from the mass and mean radius. Bodies are looked up by name;
+// Earth's mass and radius are those of the constants package.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/astro/orbit"
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/constants/planets"
+//	)
+//
+//	mars, _ := planets.Get("Mars")
+//	g := mars.SurfaceGravity // 3.73 m/s²
+//
+//	// Hill sphere of Jupiter
+//	jup, _ := planets.Get("Jupiter")
+//	r, _ := orbit.HillRadius(constants.SolarMass, jup.Mass, jup.SemiMajorAxis, jup.Eccentricity)
+//
+//	for _, m := range planets.Moons("Jupiter") {
+//	    fmt.Println(m.Name, m.EscapeVelocity)
+//	}
+//
+// References:
+//   - Williams, "Planetary Fact Sheet", NASA Goddard Space Flight Center
+//     (https://nssdc.gsfc.nasa.gov/planetary/factsheet/)
+//   - JPL Solar System Dynamics, "Planetary Satellite Physical Parameters"
+//     and "Planetary Satellite Mean Elements" (https://ssd.jpl.nasa.gov/sats/)
+package planets
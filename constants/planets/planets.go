@@ -0,0 +1,123 @@
+package planets
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Body holds the physical and orbital data of a planet, dwarf planet or
+// moon.
+type Body struct {
+	Name   string
+	Parent string // "Sun" for planets, the planet's name for moons
+
+	Mass   units.Mass
+	Radius units.Length // volumetric mean radius
+
+	// GM is the gravitational parameter, measured from orbits far more
+	// precisely than G, and so than Mass.
+	GM units.StandardGravitationalParameter
+
+	// SemiMajorAxis and Eccentricity describe the mean orbit about the
+	// parent.
+	SemiMajorAxis units.Length
+	Eccentricity  float64
+
+	// RotationPeriod is the sidereal rotation period, negative for
+	// retrograde rotation as for Venus, Uranus and Pluto. The moons rotate
+	// synchronously, so this is their orbital period, signed like their
+	// parent's rotation except for Triton, whose orbit is retrograde.
+	RotationPeriod units.Time
+
+	// SurfaceGravity and EscapeVelocity follow from GM and Radius as
+	// GM/R² and √(2GM/R), without the centrifugal term of rotation.
+	SurfaceGravity units.Acceleration
+	EscapeVelocity units.Velocity
+}
+
+// IsMoon returns true if the body orbits a planet rather than the Sun.
+func (b Body) IsMoon() bool {
+	return b.Parent != "Sun"
+}
+
+// String returns the body's name.
+func (b Body) String() string {
+	return b.Name
+}
+
+// Get returns the body with the given name, in any case, such as "Mars" or
+// "titan". Returns an error if the body is not in the catalog.
+func Get(name string) (Body, error) {
+	i, ok := byName[strings.ToLower(name)]
+	if !ok {
+		return Body{}, fmt.Errorf("unknown body %q", name)
+	}
+	return catalog[i], nil
+}
+
+// All returns every body in the catalog: the planets and Pluto in order
+// of distance from the Sun, then the moons grouped by parent.
+func All() []Body {
+	return append([]Body(nil), catalog...)
+}
+
+// Planets returns the eight planets and Pluto in order of distance from
+// the Sun.
+func Planets() []Body {
+	var out []Body
+	for _, b := range catalog {
+		if !b.IsMoon() {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// Moons returns the catalogued moons of a planet, in any case, ordered by
+// distance from it. Returns nil for an unknown planet or one without
+// catalogued moons.
+func Moons(planet string) []Body {
+	var out []Body
+	for _, b := range catalog {
+		if strings.EqualFold(b.Parent, planet) {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// -----------------------------------------------------------------------------
+// Catalog construction
+// -----------------------------------------------------------------------------
+
+var catalog, byName = build(data)
+
+func build(entries []entry) ([]Body, map[string]int) {
+	bodies := make([]Body, 0, len(entries))
+	index := make(map[string]int, len(entries))
+	for _, e := range entries {
+		gm := e.gm.Val()
+		r := e.radius.Val()
+		key := strings.ToLower(e.name)
+		if _, dup := index[key]; dup {
+			panic(fmt.Sprintf("planets: duplicate body %q", e.name))
+		}
+		index[key] = len(bodies)
+		bodies = append(bodies, Body{
+			Name:           e.name,
+			Parent:         e.parent,
+			Mass:           e.mass,
+			Radius:         e.radius,
+			GM:             e.gm,
+			SemiMajorAxis:  e.a,
+			Eccentricity:   e.ecc,
+			RotationPeriod: e.rotation,
+			SurfaceGravity: units.MeterPerSecond2(gm / (r * r)),
+			EscapeVelocity: units.MeterPerSecond(math.Sqrt(2 * gm / r)),
+		})
+	}
+	return bodies, index
+}
@@ -0,0 +1,95 @@
+package planets
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestGet(t *testing.T) {
+	for _, name := range []string{"Mars", "mars", "MARS"} {
+		b, err := Get(name)
+		if err != nil || b.Name != "Mars" {
+			t.Errorf("Get(%q) = %v, %v", name, b, err)
+		}
+	}
+	if _, err := Get("Vulcan"); err == nil {
+		t.Error("Get(Vulcan): expected error")
+	}
+	earth, _ := Get("Earth")
+	if earth.Mass.Val() != constants.EarthMass.Val() || earth.Radius.Val() != constants.EarthRadius.Val() {
+		t.Error("Earth should use the constants package's mass and radius")
+	}
+}
+
+func TestDerived(t *testing.T) {
+	// Surface gravity from GM/R² at the mean radius, and escape velocity
+	// in km/s, against the fact sheets.
+	for _, tc := range []struct {
+		name    string
+		g, vEsc float64
+	}{
+		{"Earth", 9.820, 11.19},
+		{"Moon", 1.62, 2.38},
+		{"Mars", 3.73, 5.03},
+		{"Jupiter", 25.9, 60.2},
+		{"Titan", 1.35, 2.64},
+	} {
+		b, _ := Get(tc.name)
+		if !almostEqual(b.SurfaceGravity.Val(), tc.g, 0.01) {
+			t.Errorf("%s: g = %v, want %v m/s²", tc.name, b.SurfaceGravity.Val(), tc.g)
+		}
+		if !almostEqual(b.EscapeVelocity.Val()/1e3, tc.vEsc, 0.01) {
+			t.Errorf("%s: v_esc = %v, want %v km/s", tc.name, b.EscapeVelocity.Val()/1e3, tc.vEsc)
+		}
+	}
+}
+
+func TestGM(t *testing.T) {
+	// GM and Mass come from independent tables; they must agree through
+	// G to within the precision of the masses, a few percent for Deimos.
+	g := constants.GravitationalConstant.Val()
+	for _, b := range All() {
+		if !almostEqual(b.GM.Val()/g, b.Mass.Val(), 0.03) {
+			t.Errorf("%s: GM/G = %v kg, mass %v kg", b, b.GM.Val()/g, b.Mass.Val())
+		}
+	}
+	earth, _ := Get("Earth")
+	if earth.GM.Val() != constants.EarthMassParameter.Val() {
+		t.Error("Earth should use the constants package's GM")
+	}
+}
+
+func TestMoons(t *testing.T) {
+	moons := Moons("jupiter")
+	if len(moons) != 4 {
+		t.Fatalf("Moons(jupiter) has %d entries, want the 4 Galilean moons", len(moons))
+	}
+	for i := 1; i < len(moons); i++ {
+		if moons[i].SemiMajorAxis.Val() <= moons[i-1].SemiMajorAxis.Val() {
+			t.Errorf("%s is listed after %s but orbits closer", moons[i], moons[i-1])
+		}
+	}
+	if Moons("Mercury") != nil || Moons("Vulcan") != nil {
+		t.Error("Moons should be nil for Mercury and unknown planets")
+	}
+	ps := Planets()
+	if len(ps) != 9 || ps[0].Name != "Mercury" || ps[8].Name != "Pluto" {
+		t.Errorf("Planets() = %v", ps)
+	}
+	for _, b := range All() {
+		if b.IsMoon() {
+			if _, err := Get(b.Parent); err != nil {
+				t.Errorf("%s: unknown parent %q", b, b.Parent)
+			}
+		}
+	}
+	if v, _ := Get("Venus"); v.RotationPeriod.Val() >= 0 {
+		t.Error("Venus should rotate retrograde")
+	}
+}
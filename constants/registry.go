@@ -0,0 +1,80 @@
+package constants
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Lookup returns the constant with the given name, matched against its
+// variable name and its description while ignoring case, spaces and
+// punctuation and a trailing parenthetical: "fine structure constant",
+// "Fine-structure constant (α ≈ 1/137)" and "FineStructureConstant" all
+// find α. Returns an error if no constant matches.
+func Lookup(name string) (Measurement, error) {
+	i, ok := byKey[key(name)]
+	if !ok {
+		return Measurement{}, fmt.Errorf("unknown constant %q", name)
+	}
+	return registry[i].clone(), nil
+}
+
+// LookupSymbol returns the constant with the given symbol, such as "α",
+// "k_B" or "M☉". Symbols are case-sensitive: "m_p" is the proton mass and
+// "m_P" the Planck mass. Returns an error if no constant has the symbol.
+func LookupSymbol(symbol string) (Measurement, error) {
+	i, ok := bySymbol[symbol]
+	if !ok {
+		return Measurement{}, fmt.Errorf("no constant with symbol %q", symbol)
+	}
+	return registry[i].clone(), nil
+}
+
+// All returns every registered constant, grouped by topic: universal
+// constants, astronomical and cosmological constants, Planck units, then
+// particle properties.
+//
+// Example:
+//
+//	for _, c := range constants.All() {
+//	    fmt.Printf("%-6s %-40s %v\n", c.Symbol, c.Description, c)
+//	}
+func All() []Measurement {
+	out := make([]Measurement, len(registry))
+	for i, m := range registry {
+		out[i] = m.clone()
+	}
+	return out
+}
+
+// Indices into registry by variable name, lookup key and symbol.
+var byName, byKey, bySymbol = indices(registry)
+
+func indices(reg []Measurement) (names, keys, symbols map[string]int) {
+	names, keys, symbols = map[string]int{}, map[string]int{}, map[string]int{}
+	add := func(m map[string]int, k string, i int) {
+		if j, ok := m[k]; ok && j != i {
+			panic(fmt.Sprintf("constants: %s and %s share the key %q", reg[j].Name, reg[i].Name, k))
+		}
+		m[k] = i
+	}
+	for i, m := range reg {
+		add(names, m.Name, i)
+		add(keys, key(m.Name), i)
+		add(keys, key(m.Description), i)
+		add(symbols, m.Symbol, i)
+	}
+	return names, keys, symbols
+}
+
+// key normalizes a constant's name for lookup: without any parenthetical,
+// lower case, letters and digits only.
+func key(name string) string {
+	name, _, _ = strings.Cut(name, " (")
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
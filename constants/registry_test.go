@@ -0,0 +1,58 @@
+package constants
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"fine structure constant", "Fine-structure constant", "FineStructureConstant"} {
+		m, err := Lookup(name)
+		if err != nil {
+			t.Fatalf("Lookup(%q): %v", name, err)
+		}
+		if m.Symbol != "α" || m.Value() != FineStructureConstant {
+			t.Errorf("Lookup(%q) = %s %v, want α", name, m.Symbol, m.Value())
+		}
+	}
+	if m, err := Lookup("speed of light in vacuum"); err != nil || m.Name != "SpeedOfLight" {
+		t.Errorf("Lookup(speed of light in vacuum) = %v, %v", m.Name, err)
+	}
+	if _, err := Lookup("phlogiston"); err == nil {
+		t.Error("expected error for unknown name")
+	}
+
+	m, err := LookupSymbol("α")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "FineStructureConstant" || m.Description == "" || len(m.References) == 0 {
+		t.Errorf("LookupSymbol(α) = %+v", m)
+	}
+	if p, _ := LookupSymbol("m_p"); p.Name != "ProtonMass" {
+		t.Errorf("m_p = %s, want ProtonMass", p.Name)
+	}
+	if p, _ := LookupSymbol("m_P"); p.Name != "PlanckMass" {
+		t.Errorf("m_P = %s, want PlanckMass", p.Name)
+	}
+	if _, err := LookupSymbol("ж"); err == nil {
+		t.Error("expected error for unknown symbol")
+	}
+}
+
+func TestAll(t *testing.T) {
+	all := All()
+	if len(all) != len(catalog) {
+		t.Fatalf("All() has %d constants, want %d", len(all), len(catalog))
+	}
+	all[0].References[0] = "changed"
+	for _, m := range all {
+		s, err := LookupSymbol(m.Symbol)
+		if err != nil || s.Name != m.Name {
+			t.Errorf("LookupSymbol(%q) = %s, %v; want %s", m.Symbol, s.Name, err, m.Name)
+		}
+		if l, err := Lookup(m.Description); err != nil || l.Name != m.Name {
+			t.Errorf("Lookup(%q) = %s, %v; want %s", m.Description, l.Name, err, m.Name)
+		}
+	}
+	if c, _ := Get(all[0].Name); c.References[0] == "changed" {
+		t.Error("All() shares references with the registry")
+	}
+}
@@ -0,0 +1,160 @@
+package spectra
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Band is a named range of wavelengths, from Min (inclusive) to Max
+// (exclusive). Max is +Inf for an unbounded band.
+type Band struct {
+	Name     string
+	Min, Max units.Length
+}
+
+// Contains returns true if wavelength l lies in the band.
+func (b Band) Contains(l units.Length) bool {
+	return l.Val() >= b.Min.Val() && l.Val() < b.Max.Val()
+}
+
+// Frequencies returns the band's frequency range, lowest first; the
+// highest frequency of a band starting at zero wavelength is +Inf.
+func (b Band) Frequencies() (lo, hi units.Frequency) {
+	return Frequency(b.Max), Frequency(b.Min)
+}
+
+// String returns the band's name.
+func (b Band) String() string {
+	return b.Name
+}
+
+// Filter is an astronomical photometric passband, described by its
+// effective wavelength and full width at half maximum. Its Band spans
+// the half-maximum points.
+type Filter struct {
+	Band
+	Effective units.Length
+	Width     units.Length
+}
+
+// Frequency returns the frequency c/λ of light of wavelength l in vacuum,
+// +Inf for zero wavelength.
+func Frequency(l units.Length) units.Frequency {
+	return units.Hertz(constants.SpeedOfLight.Val() / l.Val())
+}
+
+// Wavelength returns the vacuum wavelength c/f of light of frequency f,
+// +Inf for zero frequency.
+func Wavelength(f units.Frequency) units.Length {
+	return units.Meter(constants.SpeedOfLight.Val() / f.Val())
+}
+
+// -----------------------------------------------------------------------------
+// Band tables
+// -----------------------------------------------------------------------------
+
+func nm(x float64) units.Length { return units.Nanometer(x) }
+
+var inf = units.Meter(math.Inf(1))
+
+// Regions lists the regions of the electromagnetic spectrum in order of
+// increasing wavelength. They cover all positive wavelengths.
+var Regions = []Band{
+	{"gamma ray", units.Meter(0), nm(0.01)},
+	{"X-ray", nm(0.01), nm(10)},
+	{"ultraviolet", nm(10), nm(380)},
+	{"visible", nm(380), nm(760)},
+	{"infrared", nm(760), units.Millimeter(1)},
+	{"microwave", units.Millimeter(1), units.Meter(1)},
+	{"radio", units.Meter(1), inf},
+}
+
+// Colors lists the spectral colors of the visible region in order of
+// increasing wavelength. They cover the visible region.
+var Colors = []Band{
+	{"violet", nm(380), nm(450)},
+	{"blue", nm(450), nm(485)},
+	{"cyan", nm(485), nm(500)},
+	{"green", nm(500), nm(565)},
+	{"yellow", nm(565), nm(590)},
+	{"orange", nm(590), nm(625)},
+	{"red", nm(625), nm(760)},
+}
+
+func filter(name string, effective, width float64) Filter {
+	return Filter{
+		Band:      Band{name, units.Micrometer(effective - width/2), units.Micrometer(effective + width/2)},
+		Effective: units.Micrometer(effective),
+		Width:     units.Micrometer(width),
+	}
+}
+
+// Filters lists the Johnson-Cousins UBVRI and Bessell-Brett JHK passbands
+// in order of effective wavelength, with effective wavelengths and widths
+// in μm from Bessell (2005).
+var Filters = []Filter{
+	filter("U", 0.366, 0.065),
+	filter("B", 0.438, 0.090),
+	filter("V", 0.545, 0.085),
+	filter("R", 0.641, 0.150),
+	filter("I", 0.798, 0.150),
+	filter("J", 1.22, 0.213),
+	filter("H", 1.63, 0.307),
+	filter("K", 2.19, 0.390),
+}
+
+// -----------------------------------------------------------------------------
+// Classification
+// -----------------------------------------------------------------------------
+
+// WhichBand returns the region of the spectrum containing wavelength l.
+// Returns an error if l is not positive.
+func WhichBand(l units.Length) (Band, error) {
+	if !(l.Val() > 0) {
+		return Band{}, fmt.Errorf("wavelength must be positive, got %v", l)
+	}
+	return find(Regions, l)
+}
+
+// WhichBandFrequency returns the region of the spectrum containing
+// frequency f. Returns an error if f is not positive.
+func WhichBandFrequency(f units.Frequency) (Band, error) {
+	if !(f.Val() > 0) {
+		return Band{}, fmt.Errorf("frequency must be positive, got %v", f)
+	}
+	return WhichBand(Wavelength(f))
+}
+
+// WhichColor returns the spectral color of visible wavelength l. Returns
+// an error if l is outside the visible region.
+func WhichColor(l units.Length) (Band, error) {
+	c, err := find(Colors, l)
+	if err != nil {
+		return Band{}, fmt.Errorf("wavelength %v is not visible", l)
+	}
+	return c, nil
+}
+
+// FilterByName returns the photometric filter with the given name, such
+// as "V" or "K". Returns an error if there is no such filter.
+func FilterByName(name string) (Filter, error) {
+	for _, f := range Filters {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return Filter{}, fmt.Errorf("unknown filter %q", name)
+}
+
+// find returns the band of bands containing l.
+func find(bands []Band, l units.Length) (Band, error) {
+	for _, b := range bands {
+		if b.Contains(l) {
+			return b, nil
+		}
+	}
+	return Band{}, fmt.Errorf("no band contains %v", l)
+}
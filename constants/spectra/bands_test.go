@@ -0,0 +1,102 @@
+package spectra
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestWhichBand(t *testing.T) {
+	for _, tc := range []struct {
+		l    units.Length
+		want string
+	}{
+		{units.Nanometer(0.001), "gamma ray"},
+		{units.Angstrom(1.54), "X-ray"}, // Cu Kα
+		{units.Nanometer(121.6), "ultraviolet"},
+		{units.Nanometer(380), "visible"},
+		{units.Nanometer(656.3), "visible"},
+		{units.Micrometer(10), "infrared"},
+		{units.Millimeter(1.9), "microwave"}, // CMB peak
+		{units.Centimeter(21), "microwave"},
+		{units.Meter(300), "radio"},
+		{units.Meter(1e9), "radio"},
+	} {
+		b, err := WhichBand(tc.l)
+		if err != nil || b.Name != tc.want {
+			t.Errorf("WhichBand(%v) = %v, %v; want %s", tc.l, b, err, tc.want)
+		}
+	}
+	if _, err := WhichBand(units.Meter(0)); err == nil {
+		t.Error("WhichBand(0): expected error")
+	}
+	if b, _ := WhichBandFrequency(units.Hertz(2.45e9)); b.Name != "microwave" {
+		t.Errorf("WhichBandFrequency(2.45 GHz) = %v, want microwave", b)
+	}
+	if _, err := WhichBandFrequency(units.Hertz(-1)); err == nil {
+		t.Error("WhichBandFrequency(-1 Hz): expected error")
+	}
+}
+
+func TestTablesContiguous(t *testing.T) {
+	for _, table := range [][]Band{Regions, Colors} {
+		for i := 1; i < len(table); i++ {
+			if table[i].Min != table[i-1].Max {
+				t.Errorf("gap or overlap between %v and %v", table[i-1], table[i])
+			}
+		}
+	}
+	visible, _ := WhichBand(units.Nanometer(500))
+	if Colors[0].Min != visible.Min || Colors[len(Colors)-1].Max != visible.Max {
+		t.Error("colors should span the visible region")
+	}
+	for i := 1; i < len(Filters); i++ {
+		if Filters[i].Effective.Val() <= Filters[i-1].Effective.Val() {
+			t.Errorf("filter %v is out of order", Filters[i])
+		}
+	}
+}
+
+func TestWhichColor(t *testing.T) {
+	for _, tc := range []struct {
+		nm   float64
+		want string
+	}{{405, "violet"}, {470, "blue"}, {532, "green"}, {589, "yellow"}, {633, "red"}} {
+		c, err := WhichColor(units.Nanometer(tc.nm))
+		if err != nil || c.Name != tc.want {
+			t.Errorf("WhichColor(%v nm) = %v, %v; want %s", tc.nm, c, err, tc.want)
+		}
+	}
+	if _, err := WhichColor(units.Nanometer(1064)); err == nil {
+		t.Error("WhichColor(1064 nm): expected error")
+	}
+}
+
+func TestFilters(t *testing.T) {
+	v, err := FilterByName("V")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Contains(units.Nanometer(550)) || v.Contains(units.Nanometer(650)) {
+		t.Errorf("V band = %v to %v", v.Min, v.Max)
+	}
+	lo, hi := v.Frequencies()
+	if !almostEqual(lo.Val(), 299792458/v.Max.Val(), 1e-12) || hi.Val() <= lo.Val() {
+		t.Errorf("V frequencies = %v to %v", lo, hi)
+	}
+	if _, err := FilterByName("Z"); err == nil {
+		t.Error("FilterByName(Z): expected error")
+	}
+	gamma := Regions[0]
+	if _, hi := gamma.Frequencies(); !math.IsInf(hi.Val(), 1) {
+		t.Errorf("gamma-ray upper frequency = %v, want +Inf", hi)
+	}
+	if !almostEqual(Wavelength(Frequency(units.Nanometer(500))).Val(), 500e-9, 1e-15) {
+		t.Error("Wavelength and Frequency should be inverse")
+	}
+}
@@ -0,0 +1,46 @@
+// Package spectra provides reference data for the electromagnetic
+// spectrum.
+//
+// Regions divides the spectrum into its conventional named regions, from
+// gamma rays to radio, without gaps or overlaps, so WhichBand classifies
+// any wavelength and WhichBandFrequency any frequency. Colors divides the
+// visible region into the spectral colors, and Filters lists the standard
+// astronomical photometric passbands, Johnson-Cousins UBVRI and the
+// near-infrared JHK, by effective wavelength and width. Band boundaries
+// are conventions rather than physical constants; those used here follow
+// ISO 21348 for the regions.
+//
+// HydrogenicWavelength, Series and SeriesLimit give the lines of
+// hydrogen-like atoms and ions, such as the Lyman, Balmer and Paschen
+// series, from the Rydberg formula with the reduced-mass correction of an
+// analytic.HydrogenLike system. Lines lists prominent reference lines, such
+// as Hα, the sodium D doublet and the calcium H and K lines, with vacuum
+// and air wavelengths.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/constants/spectra"
+//	    "github.com/sakiphan/qsim-core/quantum/analytic"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	b, _ := spectra.WhichBand(units.Nanometer(121.6))      // ultraviolet
+//	b, _ = spectra.WhichBandFrequency(units.Hertz(2.45e9)) // microwave
+//	c, _ := spectra.WhichColor(units.Nanometer(532))       // green
+//
+//	v, _ := spectra.FilterByName("V")
+//	lo, hi := v.Frequencies()
+//
+//	// Balmer lines of deuterium
+//	d, _ := analytic.NewHydrogenLike(1, constants.DeuteronMass)
+//	balmer, _ := spectra.Series(d, spectra.Balmer, 5)
+//
+// References:
+//   - ISO 21348:2007, "Space environment (natural and artificial) —
+//     Process for determining solar irradiances"
+//   - Bessell. "Standard photometric systems", Annu. Rev. Astron.
+//     Astrophys. 43, 293 (2005), Table 1
+//   - Kramida et al. NIST Atomic Spectra Database, version 5.8 (2020)
+package spectra
@@ -0,0 +1,132 @@
+package spectra
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/quantum/analytic"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Hydrogenic series
+// -----------------------------------------------------------------------------
+
+// Lower levels n₁ of the named hydrogen series.
+const (
+	Lyman    = 1
+	Balmer   = 2
+	Paschen  = 3
+	Brackett = 4
+	Pfund    = 5
+)
+
+// HydrogenicWavelength returns the vacuum wavelength of the transition
+// n2 → n1 of the hydrogen-like system h, from the Rydberg formula with the
+// reduced-mass correction:
+//
+//	1/λ = R_∞ (μ/m_e) Z² (1/n₁² - 1/n₂²)
+//
+// Fine structure and QED shifts, of relative size (Zα)², are neglected.
+// Returns an error unless 1 ≤ n1 < n2.
+//
+// Example:
+//
+//	// Hα, the first Balmer line of hydrogen
+//	l, _ := spectra.HydrogenicWavelength(analytic.Hydrogen(), spectra.Balmer, 3) // 656.47 nm
+func HydrogenicWavelength(h analytic.HydrogenLike, n1, n2 int) (units.Length, error) {
+	if n1 < 1 || n2 <= n1 {
+		return units.Length{}, fmt.Errorf("transition requires 1 ≤ n1 < n2, got n1 = %d, n2 = %d", n1, n2)
+	}
+	return wavelength(h, 1/float64(n1*n1)-1/float64(n2*n2)), nil
+}
+
+// Series returns the wavelengths of the first count lines of the series
+// ending on level n1, longest first: n1+1 → n1, n1+2 → n1, and so on.
+// Returns an error if n1 < 1 or count < 1.
+func Series(h analytic.HydrogenLike, n1, count int) ([]units.Length, error) {
+	if n1 < 1 || count < 1 {
+		return nil, fmt.Errorf("series requires n1 ≥ 1 and count ≥ 1, got n1 = %d, count = %d", n1, count)
+	}
+	lines := make([]units.Length, count)
+	for i := range lines {
+		lines[i], _ = HydrogenicWavelength(h, n1, n1+1+i)
+	}
+	return lines, nil
+}
+
+// SeriesLimit returns the short-wavelength limit n2 → ∞ of the series
+// ending on level n1, the ionization threshold from that level. Returns an
+// error if n1 < 1.
+func SeriesLimit(h analytic.HydrogenLike, n1 int) (units.Length, error) {
+	if n1 < 1 {
+		return units.Length{}, fmt.Errorf("series requires n1 ≥ 1, got %d", n1)
+	}
+	return wavelength(h, 1/float64(n1*n1)), nil
+}
+
+// electron supplies m_e, the mass the Rydberg constant R∞ assumes.
+var electron, _ = particles.Lookup("e-")
+
+func wavelength(h analytic.HydrogenLike, levels float64) units.Length {
+	z := float64(h.Z)
+	r := constants.RydbergConstant.Val() * h.ReducedMass.Val() / electron.Mass.Value().Val()
+	return units.Meter(1 / (r * z * z * levels))
+}
+
+// -----------------------------------------------------------------------------
+// Reference lines
+// -----------------------------------------------------------------------------
+
+// Line is a prominent spectral line. Air is the wavelength in standard
+// air, as conventionally quoted between 200 nm and 2 μm, and zero for
+// lines outside that range.
+type Line struct {
+	Name    string
+	Species string
+	Vacuum  units.Length
+	Air     units.Length
+}
+
+// Frequency returns the line's frequency c/λ_vac.
+func (l Line) Frequency() units.Frequency {
+	return Frequency(l.Vacuum)
+}
+
+// String returns the line's name.
+func (l Line) String() string {
+	return l.Name
+}
+
+func line(name, species string, vacuum, air float64) Line {
+	return Line{name, species, nm(vacuum), nm(air)}
+}
+
+// Lines lists prominent reference lines in order of wavelength, with
+// wavelengths in nm from the NIST Atomic Spectra Database.
+var Lines = []Line{
+	line("Lyα", "H I", 121.567, 0),
+	line("Ca II K", "Ca II", 393.478, 393.366),
+	line("Ca II H", "Ca II", 396.959, 396.847),
+	line("Hδ", "H I", 410.288, 410.174),
+	line("Hγ", "H I", 434.168, 434.047),
+	line("Hβ", "H I", 486.271, 486.135),
+	line("[O III]", "O III", 500.824, 500.684),
+	line("He I D3", "He I", 587.725, 587.562),
+	line("Na D2", "Na I", 589.158, 588.995),
+	line("Na D1", "Na I", 589.756, 589.592),
+	line("Hα", "H I", 656.461, 656.279),
+	{"H I 21 cm", "H I", units.Meter(constants.SpeedOfLight.Val() / 1420.405751768e6), units.Length{}},
+}
+
+// LineByName returns the reference line with the given name, such as "Hα"
+// or "Na D1". Returns an error if there is no such line.
+func LineByName(name string) (Line, error) {
+	for _, l := range Lines {
+		if l.Name == name {
+			return l, nil
+		}
+	}
+	return Line{}, fmt.Errorf("unknown line %q", name)
+}
@@ -0,0 +1,99 @@
+package spectra
+
+import (
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/quantum/analytic"
+)
+
+func TestHydrogenicWavelength(t *testing.T) {
+	h := analytic.Hydrogen()
+	he, _ := analytic.NewHydrogenLike(2, constants.AlphaParticleMass)
+	for _, tc := range []struct {
+		name   string
+		h      analytic.HydrogenLike
+		n1, n2 int
+		nm     float64
+		tol    float64
+	}{
+		// The Rydberg formula omits fine structure, which moves these
+		// lines by parts in 10⁵ for hydrogen and Z² times more for He⁺.
+		{"Lyα", h, Lyman, 2, 121.567, 2e-5},
+		{"Hα", h, Balmer, 3, 656.461, 2e-5},
+		{"Hβ", h, Balmer, 4, 486.271, 2e-5},
+		{"Paα", h, Paschen, 4, 1875.6, 1e-4},
+		{"He II 30.4 nm", he, 1, 2, 30.378, 1e-4},
+		{"He II 468.6 nm", he, 3, 4, 468.70, 1e-4},
+	} {
+		l, err := HydrogenicWavelength(tc.h, tc.n1, tc.n2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !almostEqual(l.Val()*1e9, tc.nm, tc.tol) {
+			t.Errorf("%s = %.4f nm, want %v", tc.name, l.Val()*1e9, tc.nm)
+		}
+	}
+
+	// The reduced-mass correction separates hydrogen and deuterium Hα by
+	// 0.179 nm.
+	d, _ := analytic.NewHydrogenLike(1, constants.DeuteronMass)
+	ha, _ := HydrogenicWavelength(h, Balmer, 3)
+	da, _ := HydrogenicWavelength(d, Balmer, 3)
+	if shift := (ha.Val() - da.Val()) * 1e9; !almostEqual(shift, 0.1786, 2e-3) {
+		t.Errorf("H-D isotope shift of Hα = %v nm, want 0.1786", shift)
+	}
+
+	for _, n := range [][2]int{{0, 1}, {2, 2}, {3, 2}} {
+		if _, err := HydrogenicWavelength(h, n[0], n[1]); err == nil {
+			t.Errorf("HydrogenicWavelength(%d, %d): expected error", n[0], n[1])
+		}
+	}
+}
+
+func TestSeries(t *testing.T) {
+	h := analytic.Hydrogen()
+	balmer, err := Series(h, Balmer, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	limit, _ := SeriesLimit(h, Balmer)
+	if !almostEqual(limit.Val()*1e9, 364.7, 1e-4) {
+		t.Errorf("Balmer limit = %v nm, want 364.7", limit.Val()*1e9)
+	}
+	for i, l := range balmer {
+		if l.Val() <= limit.Val() || (i > 0 && l.Val() >= balmer[i-1].Val()) {
+			t.Errorf("Balmer line %d = %v out of order", i, l)
+		}
+	}
+	if b, _ := WhichBand(balmer[0]); b.Name != "visible" {
+		t.Errorf("Hα band = %v", b)
+	}
+	if _, err := Series(h, 0, 3); err == nil {
+		t.Error("Series(n1 = 0): expected error")
+	}
+	if _, err := SeriesLimit(h, 0); err == nil {
+		t.Error("SeriesLimit(0): expected error")
+	}
+}
+
+func TestLines(t *testing.T) {
+	for i, l := range Lines {
+		if i > 0 && l.Vacuum.Val() <= Lines[i-1].Vacuum.Val() {
+			t.Errorf("%v is out of order", l)
+		}
+		// n_air ≈ 1.00027-1.00029 across the optical
+		if l.Air.Val() != 0 {
+			if n := l.Vacuum.Val() / l.Air.Val(); n < 1.00026 || n > 1.00030 {
+				t.Errorf("%v: λ_vac/λ_air = %v", l, n)
+			}
+		}
+	}
+	hi, err := LineByName("H I 21 cm")
+	if err != nil || !almostEqual(hi.Vacuum.Val(), 0.211061, 1e-6) || !almostEqual(hi.Frequency().Val(), 1420.405751768e6, 1e-12) {
+		t.Errorf("21 cm line = %v, %v", hi.Vacuum, err)
+	}
+	if _, err := LineByName("Hz"); err == nil {
+		t.Error("LineByName(Hz): expected error")
+	}
+}
@@ -0,0 +1,251 @@
+package constants
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Consistency Check
+// -----------------------------------------------------------------------------
+
+// Inconsistency is a constant whose value disagrees with the value derived
+// from other constants by an exact relation.
+type Inconsistency struct {
+	Name     string  // variable name of the constant, such as "PlanckLength"
+	Relation string  // the relation checked, such as "l_P = √(ℏG/c³)"
+	Stated   float64 // value of the constant, in SI units
+	Derived  float64 // value from the relation, in SI units
+
+	// Tolerance is the combined standard uncertainty of the two values plus
+	// one unit in the last digit of the stated value, which covers
+	// constants such as ℏ that are exact but quoted truncated.
+	Tolerance float64
+}
+
+// Error returns a description of the inconsistency.
+func (i Inconsistency) Error() string {
+	return fmt.Sprintf("%s: stated %.10g, %s gives %.10g (off by %.2g, tolerance %.2g)",
+		i.Name, i.Stated, i.Relation, i.Derived, math.Abs(i.Stated-i.Derived), i.Tolerance)
+}
+
+// relation re-derives one constant from others.
+type relation struct {
+	name    string
+	formula string
+	derive  func() units.Measurement
+}
+
+// measured returns the registered measurement of a constant for use in a
+// relation. Relations name only registered constants, so a failed lookup
+// is a programming error.
+func measured(name string) units.Measurement {
+	c, err := Get(name)
+	if err != nil {
+		panic(err)
+	}
+	return c.Measurement
+}
+
+// number returns an exact dimensionless measurement.
+func number(v float64) units.Measurement {
+	return units.Exact(units.Dimensionless(v))
+}
+
+// sqrt returns the square root of a measurement of positive value.
+func sqrt(x units.Measurement) units.Measurement {
+	r, err := x.Sqrt()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// hbar returns h/2π. The stored ℏ is truncated to ten digits but flagged
+// exact, so relations use h instead.
+func hbar() units.Measurement {
+	return measured("PlanckConstant").Scale(1 / (2 * math.Pi))
+}
+
+// wienX is the root of x = 5(1 - e⁻ˣ), which fixes b = hc/(x k_B).
+const wienX = 4.965114231744276
+
+var relations = []relation{
+	{"PlanckReduced", "ℏ = h/2π", hbar},
+	{"UniversalGasConstant", "R = N_A k_B", func() units.Measurement {
+		return measured("AvogadroConstant").Multiply(measured("BoltzmannConstant"))
+	}},
+	{"MolarMassConstant", "M_u = N_A m_u", func() units.Measurement {
+		return measured("AvogadroConstant").Multiply(measured("AtomicMassUnit"))
+	}},
+	{"FineStructureConstant", "α = e²/4πε₀ℏc", func() units.Measurement {
+		return measured("ElementaryCharge").Power(2).
+			Divide(measured("VacuumPermittivity").Multiply(hbar()).Multiply(measured("SpeedOfLight")).Scale(4 * math.Pi))
+	}},
+	{"VacuumPermeability", "μ₀ = 1/ε₀c²", func() units.Measurement {
+		return number(1).Divide(measured("VacuumPermittivity").Multiply(measured("SpeedOfLight").Power(2)))
+	}},
+	{"CoulombConstant", "k_e = 1/4πε₀", func() units.Measurement {
+		return number(1).Divide(measured("VacuumPermittivity").Scale(4 * math.Pi))
+	}},
+	{"StefanBoltzmannConstant", "σ = 2π⁵k_B⁴/15h³c²", func() units.Measurement {
+		return measured("BoltzmannConstant").Power(4).Scale(2 * math.Pow(math.Pi, 5) / 15).
+			Divide(measured("PlanckConstant").Power(3).Multiply(measured("SpeedOfLight").Power(2)))
+	}},
+	{"WienDisplacementConstant", "b = hc/x k_B", func() units.Measurement {
+		return measured("PlanckConstant").Multiply(measured("SpeedOfLight")).Divide(measured("BoltzmannConstant").Scale(wienX))
+	}},
+	{"RydbergConstant", "R_∞ = α²m_e c/2h", func() units.Measurement {
+		return measured("FineStructureConstant").Power(2).Multiply(measured("ElectronMass")).Multiply(measured("SpeedOfLight")).
+			Divide(measured("PlanckConstant").Scale(2))
+	}},
+	{"BohrRadius", "a₀ = ℏ/m_e cα", func() units.Measurement {
+		return hbar().Divide(measured("ElectronMass").Multiply(measured("SpeedOfLight")).Multiply(measured("FineStructureConstant")))
+	}},
+	{"BohrMagneton", "μ_B = eℏ/2m_e", func() units.Measurement {
+		return measured("ElementaryCharge").Multiply(hbar()).Divide(measured("ElectronMass").Scale(2))
+	}},
+	{"NuclearMagneton", "μ_N = eℏ/2m_p", func() units.Measurement {
+		return measured("ElementaryCharge").Multiply(hbar()).Divide(measured("ProtonMass").Scale(2))
+	}},
+	{"ElectronGyromagneticRatio", "γ_e = 2|μ_e|/ℏ", func() units.Measurement {
+		return measured("ElectronMagneticMoment").Scale(-2).Divide(hbar())
+	}},
+	{"ProtonGyromagneticRatio", "γ_p = 2μ_p/ℏ", func() units.Measurement {
+		return measured("ProtonMagneticMoment").Scale(2).Divide(hbar())
+	}},
+	{"NeutronGyromagneticRatio", "γ_n = 2|μ_n|/ℏ", func() units.Measurement {
+		return measured("NeutronMagneticMoment").Scale(-2).Divide(hbar())
+	}},
+	{"ElectronMagneticMomentAnomaly", "a_e = |g_e|/2 - 1", func() units.Measurement {
+		a, _ := measured("ElectronGFactor").Scale(-0.5).Subtract(number(1))
+		return a
+	}},
+	{"HartreeEnergy", "E_h = α²m_e c²", func() units.Measurement {
+		return measured("FineStructureConstant").Power(2).Multiply(measured("ElectronMass")).Multiply(measured("SpeedOfLight").Power(2))
+	}},
+	{"BohrVelocity", "v₀ = αc", func() units.Measurement {
+		return measured("FineStructureConstant").Multiply(measured("SpeedOfLight"))
+	}},
+	{"AtomicUnitOfTime", "ℏ/E_h", func() units.Measurement {
+		return hbar().Divide(measured("HartreeEnergy"))
+	}},
+	{"AtomicUnitOfElectricField", "E_h/ea₀", func() units.Measurement {
+		return measured("HartreeEnergy").Divide(measured("ElementaryCharge").Multiply(measured("BohrRadius")))
+	}},
+	{"AtomicUnitOfForce", "E_h/a₀", func() units.Measurement {
+		return measured("HartreeEnergy").Divide(measured("BohrRadius"))
+	}},
+	{"HbarC", "ℏc", func() units.Measurement {
+		return hbar().Multiply(measured("SpeedOfLight"))
+	}},
+	{"HbarCSquared", "(ℏc)²", func() units.Measurement {
+		return hbar().Multiply(measured("SpeedOfLight")).Power(2)
+	}},
+	{"AtomicMassUnitEnergyMeV", "E = m_u c²", func() units.Measurement {
+		return measured("AtomicMassUnit").Multiply(measured("SpeedOfLight").Power(2))
+	}},
+	{"ElectronComptonWavelength", "λ_C = h/m_e c", func() units.Measurement {
+		return measured("PlanckConstant").Divide(measured("ElectronMass").Multiply(measured("SpeedOfLight")))
+	}},
+	{"ElectronRestEnergy", "E = m_e c²", func() units.Measurement {
+		return measured("ElectronMass").Multiply(measured("SpeedOfLight").Power(2))
+	}},
+	{"ProtonRestEnergy", "E = m_p c²", func() units.Measurement {
+		return measured("ProtonMass").Multiply(measured("SpeedOfLight").Power(2))
+	}},
+	{"NeutronRestEnergy", "E = m_n c²", func() units.Measurement {
+		return measured("NeutronMass").Multiply(measured("SpeedOfLight").Power(2))
+	}},
+	{"ProtonElectronMassRatio", "m_p/m_e", func() units.Measurement {
+		return measured("ProtonMass").Divide(measured("ElectronMass"))
+	}},
+	{"NeutronProtonMassRatio", "m_n/m_p", func() units.Measurement {
+		return measured("NeutronMass").Divide(measured("ProtonMass"))
+	}},
+	{"PlanckLength", "l_P = √(ℏG/c³)", func() units.Measurement {
+		return sqrt(hbar().Multiply(measured("GravitationalConstant")).Divide(measured("SpeedOfLight").Power(3)))
+	}},
+	{"PlanckMass", "m_P = √(ℏc/G)", func() units.Measurement {
+		return sqrt(hbar().Multiply(measured("SpeedOfLight")).Divide(measured("GravitationalConstant")))
+	}},
+	{"PlanckTime", "t_P = √(ℏG/c⁵)", func() units.Measurement {
+		return sqrt(hbar().Multiply(measured("GravitationalConstant")).Divide(measured("SpeedOfLight").Power(5)))
+	}},
+	{"PlanckTemperature", "T_P = √(ℏc⁵/G)/k_B", func() units.Measurement {
+		return sqrt(hbar().Multiply(measured("SpeedOfLight").Power(5)).Divide(measured("GravitationalConstant"))).
+			Divide(measured("BoltzmannConstant"))
+	}},
+	{"LightYear", "ly = c × 365.25 d", func() units.Measurement {
+		return measured("SpeedOfLight").Multiply(units.Exact(units.Day(365.25).Value))
+	}},
+	{"Parsec", "pc = 648000 au/π", func() units.Measurement {
+		return measured("AstronomicalUnit").Scale(648000 / math.Pi)
+	}},
+	{"SolarMass", "M☉ = GM☉/G", func() units.Measurement {
+		return units.Exact(SolarMassParameter.Value).Divide(measured("GravitationalConstant"))
+	}},
+	{"EarthMass", "M⊕ = GM⊕/G", func() units.Measurement {
+		return units.Exact(EarthMassParameter.Value).Divide(measured("GravitationalConstant"))
+	}},
+	{"SolarConstant", "S = L☉/4π au²", func() units.Measurement {
+		return measured("SolarLuminosity").Divide(measured("AstronomicalUnit").Power(2).Scale(4 * math.Pi))
+	}},
+	{"HubbleTime", "t_H = 1/H₀", func() units.Measurement {
+		return number(1).Divide(measured("HubbleConstant"))
+	}},
+	{"CriticalDensity", "ρ_c = 3H₀²/8πG", func() units.Measurement {
+		return measured("HubbleConstant").Power(2).Scale(3 / (8 * math.Pi)).Divide(measured("GravitationalConstant"))
+	}},
+}
+
+// Verify re-derives the constants that follow from others by an exact
+// relation — R = N_A k_B, α = e²/4πε₀ℏc, σ from k_B, h and c, the Planck
+// units from ℏ, G and c, and so on — and returns those whose stated value
+// disagrees with the derived one by more than their combined uncertainty.
+// It returns nil if the constants are consistent, as the package's tests
+// require; it exists to catch transcription errors when values are
+// updated to a new CODATA adjustment.
+//
+// Example:
+//
+//	for _, bad := range constants.Verify() {
+//	    fmt.Println(bad)
+//	}
+func Verify() []Inconsistency {
+	var out []Inconsistency
+	for _, r := range relations {
+		stated := measured(r.name)
+		derived := r.derive()
+		if stated.Value().Dim() != derived.Value().Dim() {
+			panic(fmt.Sprintf("constants: %s: relation %s has dimension %v, want %v",
+				r.name, r.formula, derived.Value().Dim(), stated.Value().Dim()))
+		}
+		diff, _ := stated.Subtract(derived)
+		tol := diff.Uncertainty().Val() + lastDigit(stated.Value().Val())
+		if math.Abs(diff.Value().Val()) > tol {
+			out = append(out, Inconsistency{
+				Name:      r.name,
+				Relation:  r.formula,
+				Stated:    stated.Value().Val(),
+				Derived:   derived.Value().Val(),
+				Tolerance: tol,
+			})
+		}
+	}
+	return out
+}
+
+// lastDigit returns one unit in the last significant digit of x as
+// written in its shortest decimal form: 1e-43 for 1.054571817e-34.
+func lastDigit(x float64) float64 {
+	s := strconv.FormatFloat(math.Abs(x), 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(s, "e")
+	e, _ := strconv.Atoi(exp)
+	digits := len(strings.Replace(mantissa, ".", "", 1))
+	return math.Pow(10, float64(e-digits+1))
+}
@@ -0,0 +1,40 @@
+package constants
+
+import (
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestVerify(t *testing.T) {
+	for _, bad := range Verify() {
+		t.Error(bad)
+	}
+
+	// A transcription error in a Planck unit, 1.616255 → 1.616525, is
+	// caught.
+	i := byName["PlanckLength"]
+	saved := registry[i].Measurement
+	defer func() { registry[i].Measurement = saved }()
+	registry[i].Measurement, _ = units.Measure("l_P", units.Meter(1.616525e-35).Value, units.Meter(0.000018e-35).Value)
+	got := Verify()
+	if len(got) != 1 || got[0].Name != "PlanckLength" {
+		t.Fatalf("Verify() = %v, want the PlanckLength inconsistency", got)
+	}
+	if !almostEqual(got[0].Derived/1e-35, 1.616255, 1e-6) {
+		t.Errorf("derived l_P = %v, want 1.616255e-35", got[0].Derived)
+	}
+}
+
+func TestLastDigit(t *testing.T) {
+	for _, tc := range []struct{ x, want float64 }{
+		{1.054571817e-34, 1e-43},
+		{8.314462618, 1e-9},
+		{299792458, 1},
+		{-2.5e3, 100},
+	} {
+		if got := lastDigit(tc.x); !almostEqual(got/tc.want, 1, 1e-12) {
+			t.Errorf("lastDigit(%v) = %v, want %v", tc.x, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package ballistics
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// maxSteps bounds the number of integration steps taken by Simulate.
+const maxSteps = 10_000_000
+
+// -----------------------------------------------------------------------------
+// Atmosphere
+// -----------------------------------------------------------------------------
+
+// SeaLevelDensity is the ISA air density at sea level (1.225 kg/m³).
+var SeaLevelDensity = units.KilogramPerMeter3(1.225)
+
+// ScaleHeight is a typical density scale height of the lower atmosphere (8.5 km).
+var ScaleHeight = units.Meter(8500)
+
+// DensityProfile returns the ambient fluid density at a given altitude.
+type DensityProfile func(altitude units.Length) units.Density
+
+// ConstantDensity returns a profile with the same density at every altitude.
+func ConstantDensity(rho units.Density) DensityProfile {
+	return func(units.Length) units.Density { return rho }
+}
+
+// ExponentialAtmosphere returns an isothermal atmosphere profile.
+//
+// Formula:
+//
+//	ρ(h) = ρ₀ exp(-h / H)
+func ExponentialAtmosphere(rho0 units.Density, scaleHeight units.Length) DensityProfile {
+	return func(h units.Length) units.Density {
+		return units.KilogramPerMeter3(rho0.Val() * math.Exp(-h.Val()/scaleHeight.Val()))
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Configuration
+// -----------------------------------------------------------------------------
+
+// Projectile describes the aerodynamic properties of a body in flight.
+type Projectile struct {
+	Mass            units.Mass
+	DragCoefficient float64    // C_d, dimensionless
+	Area            units.Area // reference cross-sectional area
+}
+
+func (p Projectile) validate() error {
+	if p.Mass.Val() <= 0 {
+		return fmt.Errorf("projectile mass must be positive, got %g kg", p.Mass.Val())
+	}
+	if p.DragCoefficient < 0 {
+		return fmt.Errorf("drag coefficient must be non-negative, got %g", p.DragCoefficient)
+	}
+	if p.Area.Val() < 0 {
+		return fmt.Errorf("reference area must be non-negative, got %g m²", p.Area.Val())
+	}
+	return nil
+}
+
+// Environment describes the field the projectile moves through.
+// A nil Density is treated as vacuum.
+type Environment struct {
+	Gravity units.Acceleration // magnitude of downward gravitational acceleration
+	Density DensityProfile
+}
+
+// Launch describes the initial conditions of a shot.
+type Launch struct {
+	Speed  units.Velocity
+	Angle  float64      // elevation above the horizontal (radians)
+	Height units.Length // launch altitude above ground
+}
+
+func (l Launch) validate() error {
+	if l.Speed.Val() < 0 {
+		return fmt.Errorf("launch speed must be non-negative, got %g m/s", l.Speed.Val())
+	}
+	if l.Height.Val() < 0 {
+		return fmt.Errorf("launch height must be non-negative, got %g m", l.Height.Val())
+	}
+	if l.Angle < -math.Pi/2 || l.Angle > math.Pi/2 {
+		return fmt.Errorf("launch angle must be in [-π/2, π/2], got %g rad", l.Angle)
+	}
+	if l.Speed.Val() == 0 && l.Height.Val() == 0 {
+		return fmt.Errorf("launch must have a non-zero speed or height")
+	}
+	return nil
+}
+
+// State is a sampled point along a trajectory.
+type State struct {
+	Time     units.Time
+	Position vector.Vector3 // (downrange, altitude, 0) [L]
+	Velocity vector.Vector3 // [LT⁻¹]
+}
+
+// Trajectory summarizes a flight from launch to ground impact.
+type Trajectory struct {
+	Range        units.Length   // downrange distance at impact
+	Apex         units.Length   // maximum altitude above ground
+	TimeOfFlight units.Time     // time from launch to impact
+	ImpactSpeed  units.Velocity // speed at impact
+	ImpactAngle  float64        // angle below the horizontal at impact (radians)
+
+	// Path holds the integrated states, one per step, ending at impact.
+	// It is nil for analytic trajectories.
+	Path []State
+}
+
+// -----------------------------------------------------------------------------
+// Analytic Solutions
+// -----------------------------------------------------------------------------
+
+// Vacuum returns the closed-form trajectory of a drag-free projectile.
+//
+// Formula:
+//
+//	t_f = (v sin θ + √(v² sin²θ + 2 g h₀)) / g
+//	R = v cos θ · t_f
+//	y_max = h₀ + v² sin²θ / (2g)    (θ > 0)
+//
+// Example:
+//
+//	traj, _ := ballistics.Vacuum(ballistics.Launch{
+//	    Speed: units.MeterPerSecond(20),
+//	    Angle: math.Pi / 4,
+//	}, constants.StandardGravity)
+//	// traj.Range ≈ 40.79 m
+func Vacuum(launch Launch, gravity units.Acceleration) (Trajectory, error) {
+	if err := launch.validate(); err != nil {
+		return Trajectory{}, err
+	}
+	g := gravity.Val()
+	if g <= 0 {
+		return Trajectory{}, fmt.Errorf("gravity must be positive, got %g m/s²", g)
+	}
+
+	v, h0 := launch.Speed.Val(), launch.Height.Val()
+	vx, vy := v*math.Cos(launch.Angle), v*math.Sin(launch.Angle)
+
+	tf := (vy + math.Sqrt(vy*vy+2*g*h0)) / g
+	apex := h0
+	if vy > 0 {
+		apex += vy * vy / (2 * g)
+	}
+	vyImpact := vy - g*tf
+
+	return Trajectory{
+		Range:        units.Meter(vx * tf),
+		Apex:         units.Meter(apex),
+		TimeOfFlight: units.Second(tf),
+		ImpactSpeed:  units.MeterPerSecond(math.Hypot(vx, vyImpact)),
+		ImpactAngle:  math.Atan2(-vyImpact, vx),
+	}, nil
+}
+
+// TerminalVelocity returns the speed at which drag balances gravity for a
+// projectile falling through fluid of density rho.
+//
+// Formula:
+//
+//	v_t = √(2 m g / (ρ C_d A))
+func TerminalVelocity(p Projectile, rho units.Density, gravity units.Acceleration) (units.Velocity, error) {
+	if err := p.validate(); err != nil {
+		return units.Velocity{}, err
+	}
+	k := rho.Val() * p.DragCoefficient * p.Area.Val()
+	if k <= 0 {
+		return units.Velocity{}, fmt.Errorf("terminal velocity requires positive density, drag coefficient, and area")
+	}
+	return units.MeterPerSecond(math.Sqrt(2 * p.Mass.Val() * gravity.Val() / k)), nil
+}
+
+// -----------------------------------------------------------------------------
+// Numerical Integration
+// -----------------------------------------------------------------------------
+
+// Simulate integrates the trajectory of a projectile with quadratic drag
+// from launch until it returns to the ground, using fixed RK4 steps of size
+// dt. The final step is shortened so that the impact lands on y = 0.
+//
+// Returns an error if the inputs are invalid or the projectile has not
+// landed after a large number of steps.
+//
+// Formula:
+//
+//	m dv/dt = -m g ŷ - ½ ρ(y) C_d A |v| v
+func Simulate(p Projectile, env Environment, launch Launch, dt units.Time) (Trajectory, error) {
+	if err := p.validate(); err != nil {
+		return Trajectory{}, err
+	}
+	if err := launch.validate(); err != nil {
+		return Trajectory{}, err
+	}
+	g := env.Gravity.Val()
+	if g <= 0 {
+		return Trajectory{}, fmt.Errorf("gravity must be positive, got %g m/s²", g)
+	}
+	h := dt.Val()
+	if h <= 0 {
+		return Trajectory{}, fmt.Errorf("time step must be positive, got %g s", h)
+	}
+
+	// Drag acceleration per unit density: ½ C_d A / m.
+	k := 0.5 * p.DragCoefficient * p.Area.Val() / p.Mass.Val()
+	f := func(t float64, y, dydt []float64) {
+		vx, vy := y[2], y[3]
+		a := 0.0
+		if env.Density != nil {
+			a = k * env.Density(units.Meter(y[1])).Val() * math.Hypot(vx, vy)
+		}
+		dydt[0] = vx
+		dydt[1] = vy
+		dydt[2] = -a * vx
+		dydt[3] = -g - a*vy
+	}
+
+	v := launch.Speed.Val()
+	y := []float64{0, launch.Height.Val(), v * math.Cos(launch.Angle), v * math.Sin(launch.Angle)}
+	prev := make([]float64, 4)
+	rk := &solver.RK4{}
+
+	t := 0.0
+	apex := y[1]
+	path := []State{state(t, y)}
+	for i := 0; i < maxSteps; i++ {
+		copy(prev, y)
+		rk.Step(f, t, h, y)
+
+		if prev[3] > 0 && y[3] <= 0 {
+			// Velocity turned over during this step; estimate the peak from
+			// the mean vertical deceleration over the step.
+			decel := (prev[3] - y[3]) / h
+			apex = math.Max(apex, prev[1]+prev[3]*prev[3]/(2*decel))
+		}
+		apex = math.Max(apex, y[1])
+
+		if y[1] < 0 {
+			// Shorten the final step with regula falsi iterations on altitude.
+			lo, hi, ylo, yhi := 0.0, h, prev[1], y[1]
+			s := h
+			for j := 0; j < 8; j++ {
+				s = lo + (hi-lo)*ylo/(ylo-yhi)
+				copy(y, prev)
+				rk.Step(f, t, s, y)
+				if math.Abs(y[1]) < 1e-9 {
+					break
+				}
+				if y[1] > 0 {
+					lo, ylo = s, y[1]
+				} else {
+					hi, yhi = s, y[1]
+				}
+			}
+			t += s
+			y[1] = 0
+			path = append(path, state(t, y))
+			return Trajectory{
+				Range:        units.Meter(y[0]),
+				Apex:         units.Meter(apex),
+				TimeOfFlight: units.Second(t),
+				ImpactSpeed:  units.MeterPerSecond(math.Hypot(y[2], y[3])),
+				ImpactAngle:  math.Atan2(-y[3], y[2]),
+				Path:         path,
+			}, nil
+		}
+
+		t += h
+		path = append(path, state(t, y))
+	}
+	return Trajectory{}, fmt.Errorf("projectile did not land within %d steps of %g s", maxSteps, h)
+}
+
+func state(t float64, y []float64) State {
+	return State{
+		Time:     units.Second(t),
+		Position: vector.NewPosition(units.Meter(y[0]), units.Meter(y[1]), units.Meter(0)),
+		Velocity: vector.NewVelocity(units.MeterPerSecond(y[2]), units.MeterPerSecond(y[3]), units.MeterPerSecond(0)),
+	}
+}
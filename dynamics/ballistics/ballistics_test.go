@@ -0,0 +1,153 @@
+package ballistics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+var baseball = Projectile{
+	Mass:            units.Gram(145),
+	DragCoefficient: 0.35,
+	Area:            units.SquareCentimeter(42),
+}
+
+func TestVacuum(t *testing.T) {
+	g := constants.StandardGravity
+	traj, err := Vacuum(Launch{Speed: units.MeterPerSecond(20), Angle: math.Pi / 4}, g)
+	if err != nil {
+		t.Fatalf("Vacuum() error = %v", err)
+	}
+	// R = v²/g, y_max = v²/(4g), t_f = √2 v/g at 45°
+	if !almostEqual(traj.Range.Val(), 400/g.Val(), 1e-9) {
+		t.Errorf("Range = %v, want %v", traj.Range.Val(), 400/g.Val())
+	}
+	if !almostEqual(traj.Apex.Val(), 100/g.Val(), 1e-9) {
+		t.Errorf("Apex = %v, want %v", traj.Apex.Val(), 100/g.Val())
+	}
+	if !almostEqual(traj.TimeOfFlight.Val(), math.Sqrt2*20/g.Val(), 1e-9) {
+		t.Errorf("TimeOfFlight = %v, want %v", traj.TimeOfFlight.Val(), math.Sqrt2*20/g.Val())
+	}
+	if !almostEqual(traj.ImpactSpeed.Val(), 20, 1e-9) || !almostEqual(traj.ImpactAngle, math.Pi/4, 1e-9) {
+		t.Errorf("impact = (%v m/s, %v rad), want (20, π/4)", traj.ImpactSpeed.Val(), traj.ImpactAngle)
+	}
+}
+
+func TestVacuum_FromHeight(t *testing.T) {
+	// Horizontal launch from 20 m: t_f = √(2h/g)
+	g := units.MeterPerSecond2(10)
+	traj, _ := Vacuum(Launch{Speed: units.MeterPerSecond(5), Height: units.Meter(20)}, g)
+	if !almostEqual(traj.TimeOfFlight.Val(), 2, 1e-12) || !almostEqual(traj.Range.Val(), 10, 1e-12) {
+		t.Errorf("(t_f, R) = (%v, %v), want (2, 10)", traj.TimeOfFlight.Val(), traj.Range.Val())
+	}
+	if traj.Apex.Val() != 20 {
+		t.Errorf("Apex = %v, want 20", traj.Apex.Val())
+	}
+}
+
+func TestSimulate_MatchesVacuum(t *testing.T) {
+	launch := Launch{Speed: units.MeterPerSecond(30), Angle: 0.6, Height: units.Meter(2)}
+	env := Environment{Gravity: constants.StandardGravity}
+
+	want, _ := Vacuum(launch, env.Gravity)
+	got, err := Simulate(baseball, env, launch, units.Millisecond(10))
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if !almostEqual(got.Range.Val(), want.Range.Val(), 1e-6) {
+		t.Errorf("Range = %v, want %v", got.Range.Val(), want.Range.Val())
+	}
+	if !almostEqual(got.Apex.Val(), want.Apex.Val(), 1e-6) {
+		t.Errorf("Apex = %v, want %v", got.Apex.Val(), want.Apex.Val())
+	}
+	if !almostEqual(got.TimeOfFlight.Val(), want.TimeOfFlight.Val(), 1e-6) {
+		t.Errorf("TimeOfFlight = %v, want %v", got.TimeOfFlight.Val(), want.TimeOfFlight.Val())
+	}
+	last := got.Path[len(got.Path)-1]
+	if last.Position.Y.Val() != 0 || last.Time.Val() != got.TimeOfFlight.Val() {
+		t.Errorf("final path state = %+v, want impact", last)
+	}
+}
+
+func TestSimulate_VerticalDrop(t *testing.T) {
+	// Falling from rest with quadratic drag in uniform air:
+	//   t = (v_t/g) arccosh(exp(g h / v_t²)),  v = v_t tanh(g t / v_t)
+	g := constants.StandardGravity
+	rho := SeaLevelDensity
+	h0 := 100.0
+
+	vt, err := TerminalVelocity(baseball, rho, g)
+	if err != nil {
+		t.Fatalf("TerminalVelocity() error = %v", err)
+	}
+	env := Environment{Gravity: g, Density: ConstantDensity(rho)}
+	traj, err := Simulate(baseball, env, Launch{Height: units.Meter(h0), Angle: -math.Pi / 2}, units.Millisecond(5))
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+
+	v, gv := vt.Val(), g.Val()
+	tf := v / gv * math.Acosh(math.Exp(gv*h0/(v*v)))
+	if !almostEqual(traj.TimeOfFlight.Val(), tf, 1e-6) {
+		t.Errorf("TimeOfFlight = %v, want %v", traj.TimeOfFlight.Val(), tf)
+	}
+	if !almostEqual(traj.ImpactSpeed.Val(), v*math.Tanh(gv*tf/v), 1e-6) {
+		t.Errorf("ImpactSpeed = %v, want %v", traj.ImpactSpeed.Val(), v*math.Tanh(gv*tf/v))
+	}
+}
+
+func TestSimulate_DragShortensRange(t *testing.T) {
+	launch := Launch{Speed: units.MeterPerSecond(45), Angle: 35 * math.Pi / 180}
+	vac, _ := Vacuum(launch, constants.StandardGravity)
+
+	thin := Environment{Gravity: constants.StandardGravity, Density: ExponentialAtmosphere(SeaLevelDensity, ScaleHeight)}
+	air, err := Simulate(baseball, thin, launch, units.Millisecond(1))
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if air.Range.Val() >= vac.Range.Val() || air.Apex.Val() >= vac.Apex.Val() {
+		t.Errorf("drag range/apex (%v, %v) should be below vacuum (%v, %v)",
+			air.Range.Val(), air.Apex.Val(), vac.Range.Val(), vac.Apex.Val())
+	}
+	// With drag the descent is steeper than the ascent.
+	if air.ImpactAngle <= launch.Angle {
+		t.Errorf("ImpactAngle = %v, want > launch angle %v", air.ImpactAngle, launch.Angle)
+	}
+}
+
+func TestExponentialAtmosphere(t *testing.T) {
+	rho := ExponentialAtmosphere(SeaLevelDensity, ScaleHeight)
+	if got := rho(ScaleHeight).Val(); !almostEqual(got, 1.225/math.E, 1e-12) {
+		t.Errorf("ρ(H) = %v, want %v", got, 1.225/math.E)
+	}
+	if got := rho(units.Meter(0)).Dim(); got != (units.Dimension{L: -3, M: 1}) {
+		t.Errorf("density dimension = %v", got)
+	}
+}
+
+func TestValidation(t *testing.T) {
+	env := Environment{Gravity: constants.StandardGravity}
+	good := Launch{Speed: units.MeterPerSecond(10), Angle: 0.5}
+
+	if _, err := Simulate(Projectile{}, env, good, units.Millisecond(1)); err == nil {
+		t.Error("Simulate should reject zero mass")
+	}
+	if _, err := Simulate(baseball, env, good, units.Second(0)); err == nil {
+		t.Error("Simulate should reject a zero time step")
+	}
+	if _, err := Simulate(baseball, Environment{}, good, units.Millisecond(1)); err == nil {
+		t.Error("Simulate should reject zero gravity")
+	}
+	if _, err := Vacuum(Launch{Angle: 0.5}, env.Gravity); err == nil {
+		t.Error("Vacuum should reject a launch with no speed or height")
+	}
+	if _, err := TerminalVelocity(baseball, units.KilogramPerMeter3(0), env.Gravity); err == nil {
+		t.Error("TerminalVelocity should reject zero density")
+	}
+}
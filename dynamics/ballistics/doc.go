@@ -0,0 +1,45 @@
+// Package ballistics computes projectile trajectories under uniform gravity
+// and quadratic aerodynamic drag.
+//
+// The drag force opposes the velocity with magnitude
+//
+//	F_d = ½ ρ(h) C_d A v²
+//
+// where the air density ρ(h) is supplied by a DensityProfile. Vacuum
+// trajectories have closed-form range, apex, and time of flight (see
+// Vacuum); trajectories with drag are integrated numerically with a
+// fourth-order Runge-Kutta scheme (see Simulate).
+//
+// Trajectories are planar: X is the downrange distance and Y the altitude
+// above the launch ground level.
+//
+// Example usage:
+//
+//	import (
+//	    "fmt"
+//	    "math"
+//
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/dynamics/ballistics"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// A baseball hit at 45 m/s and 35°
+//	ball := ballistics.Projectile{
+//	    Mass:            units.Gram(145),
+//	    DragCoefficient: 0.35,
+//	    Area:            units.SquareCentimeter(42),
+//	}
+//	launch := ballistics.Launch{Speed: units.MeterPerSecond(45), Angle: 35 * math.Pi / 180}
+//	env := ballistics.Environment{
+//	    Gravity: constants.StandardGravity,
+//	    Density: ballistics.ExponentialAtmosphere(ballistics.SeaLevelDensity, ballistics.ScaleHeight),
+//	}
+//
+//	traj, _ := ballistics.Simulate(ball, env, launch, units.Millisecond(1))
+//	fmt.Println(traj.Range, traj.Apex, traj.TimeOfFlight)
+//
+// References:
+//   - Taylor. "Classical Mechanics", 1st ed., Ch. 2
+//   - McCoy. "Modern Exterior Ballistics", 2nd ed., Ch. 3
+package ballistics
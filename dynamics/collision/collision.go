@@ -0,0 +1,245 @@
+package collision
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	lengthDim   = units.Dimension{L: 1}
+	velocityDim = units.Dimension{L: 1, T: -1}
+)
+
+// Sphere is a rigid, non-rotating sphere with a position, velocity,
+// radius, and mass.
+type Sphere struct {
+	Position vector.Vector3 // center position [L]
+	Velocity vector.Vector3 // center-of-mass velocity [LT⁻¹]
+	Radius   units.Length
+	Mass     units.Mass
+}
+
+// NewSphere creates a sphere, checking that position and velocity have the
+// correct dimensions and that radius and mass are positive.
+func NewSphere(position, velocity vector.Vector3, radius units.Length, mass units.Mass) (Sphere, error) {
+	if position.Dim() != lengthDim {
+		return Sphere{}, fmt.Errorf("position must have dimension %s, got %s", lengthDim, position.Dim())
+	}
+	if velocity.Dim() != velocityDim {
+		return Sphere{}, fmt.Errorf("velocity must have dimension %s, got %s", velocityDim, velocity.Dim())
+	}
+	if radius.Val() <= 0 {
+		return Sphere{}, fmt.Errorf("radius must be positive, got %g m", radius.Val())
+	}
+	if mass.Val() <= 0 {
+		return Sphere{}, fmt.Errorf("mass must be positive, got %g kg", mass.Val())
+	}
+	return Sphere{Position: position, Velocity: velocity, Radius: radius, Mass: mass}, nil
+}
+
+// KineticEnergy returns the translational kinetic energy ½mv².
+func (s Sphere) KineticEnergy() units.Energy {
+	return units.Joule(0.5 * s.Mass.Val() * s.Velocity.MagnitudeSquared().Val())
+}
+
+// Plane is an infinite static plane. Everything on the side opposite the
+// normal is treated as solid, so a sphere whose center falls behind the
+// plane is still pushed back out along the normal.
+type Plane struct {
+	Normal vector.Vector3 // dimensionless unit normal pointing out of the solid
+	Point  vector.Vector3 // any point on the plane [L]
+}
+
+// NewPlane creates a plane through point with the given outward normal.
+// The normal may have any dimension and need not be normalized; it is
+// stored as a dimensionless unit vector.
+func NewPlane(normal, point vector.Vector3) (Plane, error) {
+	if point.Dim() != lengthDim {
+		return Plane{}, fmt.Errorf("point must have dimension %s, got %s", lengthDim, point.Dim())
+	}
+	n := normal.ToArray()
+	l := math.Sqrt(n[0]*n[0] + n[1]*n[1] + n[2]*n[2])
+	if l == 0 {
+		return Plane{}, fmt.Errorf("plane normal must be non-zero")
+	}
+	return Plane{Normal: direction([3]float64{n[0] / l, n[1] / l, n[2] / l}), Point: point}, nil
+}
+
+// Contact describes an overlap between two bodies.
+type Contact struct {
+	Normal vector.Vector3 // dimensionless unit normal pointing from the first body to the second
+	Depth  units.Length   // penetration depth, ≥ 0
+	Point  vector.Vector3 // contact point [L]
+}
+
+// -----------------------------------------------------------------------------
+// Detection
+// -----------------------------------------------------------------------------
+
+// SphereSphere tests two spheres for overlap. It reports false if the
+// spheres are separated or merely touching.
+//
+// Formula:
+//
+//	d = |r_b - r_a|,  overlap when d < R_a + R_b
+//	depth = R_a + R_b - d
+func SphereSphere(a, b Sphere) (Contact, bool) {
+	pa, pb := a.Position.ToArray(), b.Position.ToArray()
+	ra, rb := a.Radius.Val(), b.Radius.Val()
+
+	d := sub(pb, pa)
+	dist := norm(d)
+	if dist >= ra+rb {
+		return Contact{}, false
+	}
+
+	n := [3]float64{1, 0, 0} // arbitrary axis for coincident centers
+	if dist > 0 {
+		n = [3]float64{d[0] / dist, d[1] / dist, d[2] / dist}
+	}
+	depth := ra + rb - dist
+	// Midpoint of the overlap region along the normal.
+	s := ra - 0.5*depth
+	point := [3]float64{pa[0] + n[0]*s, pa[1] + n[1]*s, pa[2] + n[2]*s}
+
+	return Contact{
+		Normal: direction(n),
+		Depth:  units.Meter(depth),
+		Point:  position(point),
+	}, true
+}
+
+// SpherePlane tests a sphere against a static plane. The contact normal
+// points from the sphere into the plane, i.e. opposite the plane normal.
+//
+// Formula:
+//
+//	d = n̂ · (r - p),  overlap when d < R
+//	depth = R - d
+func SpherePlane(s Sphere, p Plane) (Contact, bool) {
+	c, q, n := s.Position.ToArray(), p.Point.ToArray(), p.Normal.ToArray()
+	r := s.Radius.Val()
+
+	d := dot(n, sub(c, q))
+	if d >= r {
+		return Contact{}, false
+	}
+
+	point := [3]float64{c[0] - n[0]*d, c[1] - n[1]*d, c[2] - n[2]*d}
+	return Contact{
+		Normal: direction([3]float64{-n[0], -n[1], -n[2]}),
+		Depth:  units.Meter(r - d),
+		Point:  position(point),
+	}, true
+}
+
+// -----------------------------------------------------------------------------
+// Response
+// -----------------------------------------------------------------------------
+
+// ResolveSphereSphere applies a restitution impulse to two colliding spheres
+// and separates them along the contact normal in proportion to their inverse
+// masses. Linear momentum is conserved. If the spheres are already moving
+// apart, only the positional correction is applied.
+//
+// Returns an error if restitution is outside [0, 1].
+//
+// Formula:
+//
+//	v_n = (v_b - v_a) · n̂
+//	j = -(1 + e) v_n / (1/m_a + 1/m_b)
+//	v_a ← v_a - (j/m_a) n̂,  v_b ← v_b + (j/m_b) n̂
+func ResolveSphereSphere(a, b *Sphere, c Contact, restitution float64) error {
+	if err := checkRestitution(restitution); err != nil {
+		return err
+	}
+	invA, invB := 1/a.Mass.Val(), 1/b.Mass.Val()
+	n := c.Normal.ToArray()
+
+	va, vb := a.Velocity.ToArray(), b.Velocity.ToArray()
+	if j := impulse(sub(vb, va), n, invA+invB, restitution); j != 0 {
+		a.Velocity = velocity(axpy(-j*invA, n, va))
+		b.Velocity = velocity(axpy(j*invB, n, vb))
+	}
+
+	share := c.Depth.Val() / (invA + invB)
+	a.Position = position(axpy(-share*invA, n, a.Position.ToArray()))
+	b.Position = position(axpy(share*invB, n, b.Position.ToArray()))
+	return nil
+}
+
+// ResolveSpherePlane applies a restitution impulse to a sphere colliding
+// with a static plane (of infinite mass) and moves the sphere out of the
+// plane. Returns an error if restitution is outside [0, 1].
+//
+// Formula:
+//
+//	v ← v - (1 + e)(v · n̂) n̂    when v · n̂ < 0
+func ResolveSpherePlane(s *Sphere, c Contact, restitution float64) error {
+	if err := checkRestitution(restitution); err != nil {
+		return err
+	}
+	inv := 1 / s.Mass.Val()
+	n := c.Normal.ToArray()
+
+	v := s.Velocity.ToArray()
+	// The plane is at rest, so the relative velocity is -v.
+	if j := impulse([3]float64{-v[0], -v[1], -v[2]}, n, inv, restitution); j != 0 {
+		s.Velocity = velocity(axpy(-j*inv, n, v))
+	}
+	s.Position = position(axpy(-c.Depth.Val(), n, s.Position.ToArray()))
+	return nil
+}
+
+// impulse returns the scalar impulse along n for relative velocity vrel of
+// the second body with respect to the first, or 0 if they are separating.
+func impulse(vrel, n [3]float64, invMassSum, e float64) float64 {
+	vn := dot(vrel, n)
+	if vn >= 0 {
+		return 0
+	}
+	return -(1 + e) * vn / invMassSum
+}
+
+func checkRestitution(e float64) error {
+	if e < 0 || e > 1 || math.IsNaN(e) {
+		return fmt.Errorf("coefficient of restitution must be in [0, 1], got %g", e)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+func position(x [3]float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x[0]), units.Meter(x[1]), units.Meter(x[2]))
+}
+
+func velocity(x [3]float64) vector.Vector3 {
+	return vector.NewVelocity(units.MeterPerSecond(x[0]), units.MeterPerSecond(x[1]), units.MeterPerSecond(x[2]))
+}
+
+func direction(x [3]float64) vector.Vector3 {
+	return vector.Vector3{X: units.Dimensionless(x[0]), Y: units.Dimensionless(x[1]), Z: units.Dimensionless(x[2])}
+}
+
+// axpy returns a·x + y.
+func axpy(a float64, x, y [3]float64) [3]float64 {
+	return [3]float64{a*x[0] + y[0], a*x[1] + y[1], a*x[2] + y[2]}
+}
+
+func sub(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func norm(a [3]float64) float64 {
+	return math.Sqrt(dot(a, a))
+}
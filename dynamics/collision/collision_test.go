@@ -0,0 +1,186 @@
+package collision
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func ball(x, y, vx, vy, r, m float64) Sphere {
+	s, err := NewSphere(
+		vector.NewPosition(units.Meter(x), units.Meter(y), units.Meter(0)),
+		vector.NewVelocity(units.MeterPerSecond(vx), units.MeterPerSecond(vy), units.MeterPerSecond(0)),
+		units.Meter(r), units.Kilogram(m),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func momentum(spheres ...Sphere) [3]float64 {
+	var p [3]float64
+	for _, s := range spheres {
+		v := s.Velocity.ToArray()
+		for i := range p {
+			p[i] += s.Mass.Val() * v[i]
+		}
+	}
+	return p
+}
+
+func TestNewSphere_Validation(t *testing.T) {
+	pos := vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(0))
+	vel := vector.Zero(velocityDim)
+	if _, err := NewSphere(vel, vel, units.Meter(1), units.Kilogram(1)); err == nil {
+		t.Error("NewSphere should reject a velocity as position")
+	}
+	if _, err := NewSphere(pos, pos, units.Meter(1), units.Kilogram(1)); err == nil {
+		t.Error("NewSphere should reject a position as velocity")
+	}
+	if _, err := NewSphere(pos, vel, units.Meter(0), units.Kilogram(1)); err == nil {
+		t.Error("NewSphere should reject zero radius")
+	}
+	if _, err := NewSphere(pos, vel, units.Meter(1), units.Kilogram(-1)); err == nil {
+		t.Error("NewSphere should reject negative mass")
+	}
+}
+
+func TestSphereSphere_Detection(t *testing.T) {
+	a := ball(0, 0, 0, 0, 1, 1)
+	b := ball(1.5, 0, 0, 0, 1, 1)
+	c, ok := SphereSphere(a, b)
+	if !ok {
+		t.Fatal("SphereSphere() should detect overlap")
+	}
+	if !almostEqual(c.Depth.Val(), 0.5, 1e-12) {
+		t.Errorf("Depth = %v, want 0.5", c.Depth.Val())
+	}
+	if c.Normal.ToArray() != [3]float64{1, 0, 0} || !c.Normal.X.IsDimensionless() {
+		t.Errorf("Normal = %v, want dimensionless (1, 0, 0)", c.Normal)
+	}
+	if !almostEqual(c.Point.X.Val(), 0.75, 1e-12) {
+		t.Errorf("Point.X = %v, want 0.75", c.Point.X.Val())
+	}
+
+	if _, ok := SphereSphere(a, ball(2, 0, 0, 0, 1, 1)); ok {
+		t.Error("touching spheres should not be reported as overlapping")
+	}
+}
+
+func TestResolveSphereSphere_ElasticHeadOn(t *testing.T) {
+	// Equal masses exchange velocities in an elastic head-on collision.
+	a := ball(0, 0, 2, 0, 1, 1)
+	b := ball(1.9, 0, 0, 0, 1, 1)
+	c, _ := SphereSphere(a, b)
+	e0 := a.KineticEnergy().Val() + b.KineticEnergy().Val()
+
+	if err := ResolveSphereSphere(&a, &b, c, 1); err != nil {
+		t.Fatalf("ResolveSphereSphere() error = %v", err)
+	}
+	if !almostEqual(a.Velocity.X.Val(), 0, 1e-12) || !almostEqual(b.Velocity.X.Val(), 2, 1e-12) {
+		t.Errorf("velocities = (%v, %v), want (0, 2)", a.Velocity.X.Val(), b.Velocity.X.Val())
+	}
+	e1 := a.KineticEnergy().Val() + b.KineticEnergy().Val()
+	if !almostEqual(e0, e1, 1e-12) {
+		t.Errorf("kinetic energy %v → %v, want conserved", e0, e1)
+	}
+	if _, ok := SphereSphere(a, b); ok {
+		t.Error("spheres should be separated after resolution")
+	}
+}
+
+func TestResolveSphereSphere_Inelastic(t *testing.T) {
+	// e = 0: the spheres move together at the center-of-mass velocity.
+	a := ball(0, 0, 3, 0, 1, 2)
+	b := ball(1.5, 0, 0, 0, 1, 1)
+	c, _ := SphereSphere(a, b)
+	_ = ResolveSphereSphere(&a, &b, c, 0)
+	if !almostEqual(a.Velocity.X.Val(), 2, 1e-12) || !almostEqual(b.Velocity.X.Val(), 2, 1e-12) {
+		t.Errorf("velocities = (%v, %v), want (2, 2)", a.Velocity.X.Val(), b.Velocity.X.Val())
+	}
+}
+
+func TestResolveSphereSphere_ObliqueMomentum(t *testing.T) {
+	a := ball(0, 0, 1.5, 0.3, 0.5, 3)
+	b := ball(0.8, 0.4, -0.2, 0, 0.5, 1)
+	c, ok := SphereSphere(a, b)
+	if !ok {
+		t.Fatal("SphereSphere() should detect overlap")
+	}
+	p0 := momentum(a, b)
+	_ = ResolveSphereSphere(&a, &b, c, 0.7)
+	p1 := momentum(a, b)
+	for i := range p0 {
+		if !almostEqual(p0[i], p1[i], 1e-12) {
+			t.Errorf("momentum[%d] %v → %v, want conserved", i, p0[i], p1[i])
+		}
+	}
+	// Relative normal velocity is reversed and scaled by e.
+	n := c.Normal.ToArray()
+	va, vb := a.Velocity.ToArray(), b.Velocity.ToArray()
+	vn := dot(sub(vb, va), n)
+	if !almostEqual(vn, 0.7*-dot([3]float64{-1.7, -0.3, 0}, n), 1e-12) {
+		t.Errorf("post-collision normal speed = %v", vn)
+	}
+}
+
+func TestResolveSphereSphere_Separating(t *testing.T) {
+	a := ball(0, 0, -1, 0, 1, 1)
+	b := ball(1.5, 0, 1, 0, 1, 1)
+	c, _ := SphereSphere(a, b)
+	_ = ResolveSphereSphere(&a, &b, c, 1)
+	if a.Velocity.X.Val() != -1 || b.Velocity.X.Val() != 1 {
+		t.Error("separating spheres should not receive an impulse")
+	}
+}
+
+func TestSpherePlane_Bounce(t *testing.T) {
+	floor, err := NewPlane(
+		vector.NewPosition(units.Meter(0), units.Meter(2), units.Meter(0)),
+		vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(0)),
+	)
+	if err != nil {
+		t.Fatalf("NewPlane() error = %v", err)
+	}
+	s := ball(0, 0.1, 1, -5, 0.25, 0.5)
+
+	c, ok := SpherePlane(s, floor)
+	if !ok {
+		t.Fatal("SpherePlane() should detect overlap")
+	}
+	if !almostEqual(c.Depth.Val(), 0.15, 1e-12) || c.Normal.Y.Val() != -1 {
+		t.Errorf("contact depth = %v, normal = %v", c.Depth.Val(), c.Normal)
+	}
+
+	if err := ResolveSpherePlane(&s, c, 0.8); err != nil {
+		t.Fatalf("ResolveSpherePlane() error = %v", err)
+	}
+	if !almostEqual(s.Velocity.Y.Val(), 4, 1e-12) || !almostEqual(s.Velocity.X.Val(), 1, 1e-12) {
+		t.Errorf("velocity = %v, want (1, 4, 0)", s.Velocity)
+	}
+	if !almostEqual(s.Position.Y.Val(), 0.25, 1e-12) {
+		t.Errorf("position.Y = %v, want 0.25", s.Position.Y.Val())
+	}
+	if _, ok := SpherePlane(ball(0, 1, 0, 0, 0.25, 1), floor); ok {
+		t.Error("sphere above the plane should not collide")
+	}
+}
+
+func TestResolve_InvalidRestitution(t *testing.T) {
+	a := ball(0, 0, 1, 0, 1, 1)
+	b := ball(1, 0, 0, 0, 1, 1)
+	c, _ := SphereSphere(a, b)
+	if err := ResolveSphereSphere(&a, &b, c, 1.5); err == nil {
+		t.Error("ResolveSphereSphere should reject e > 1")
+	}
+	if err := ResolveSpherePlane(&a, c, -0.1); err == nil {
+		t.Error("ResolveSpherePlane should reject e < 0")
+	}
+}
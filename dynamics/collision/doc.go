@@ -0,0 +1,37 @@
+// Package collision provides collision detection and impulse-based response
+// for spheres and static planes.
+//
+// Detection functions report a Contact describing the separating normal,
+// penetration depth, and contact point. Resolve functions apply an impulse
+// along the contact normal scaled by a coefficient of restitution e, where
+// e = 1 is perfectly elastic and e = 0 is perfectly inelastic, and push the
+// bodies apart so they no longer overlap.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/dynamics/collision"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Two billiard balls in a head-on collision
+//	a, _ := collision.NewSphere(
+//	    vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(0)),
+//	    vector.NewVelocity(units.MeterPerSecond(2), units.MeterPerSecond(0), units.MeterPerSecond(0)),
+//	    units.Millimeter(28.6), units.Gram(170),
+//	)
+//	b, _ := collision.NewSphere(
+//	    vector.NewPosition(units.Millimeter(57), units.Meter(0), units.Meter(0)),
+//	    vector.Zero(units.Dimension{L: 1, T: -1}),
+//	    units.Millimeter(28.6), units.Gram(170),
+//	)
+//
+//	if c, ok := collision.SphereSphere(a, b); ok {
+//	    _ = collision.ResolveSphereSphere(&a, &b, c, 0.95)
+//	}
+//
+// References:
+//   - Goldstein, Poole, Safko. "Classical Mechanics", 3rd ed., Ch. 3
+//   - Ericson. "Real-Time Collision Detection", 1st ed., Ch. 4-5
+package collision
@@ -0,0 +1,47 @@
+// Package frames links named coordinate frames into a tree and transforms
+// kinematic states between any two of them.
+//
+// Each frame other than a root is defined relative to its parent by a
+// Motion, a function of time returning a Transform. A Transform gives the
+// position, velocity and acceleration of the frame origin, the rotation
+// from frame axes to parent axes, and the frame's angular velocity and
+// angular acceleration, all in parent coordinates. Static, Translating
+// and Rotating build the common cases; any other time dependence can be
+// supplied as a custom Motion.
+//
+// Converting a state from child coordinates (r, v, a) to parent
+// coordinates applies the full kinematic transport theorem:
+//
+//	r_p = R₀ + R r
+//	v_p = V₀ + ω × (R r) + R v
+//	a_p = A₀ + α × (R r) + ω × (ω × R r) + 2 ω × (R v) + R a
+//
+// The last line contains the Euler, centrifugal and Coriolis terms. A
+// particle at rest on a rotating platform is therefore accelerating in the
+// inertial frame, and a free particle is seen to curve in the rotating
+// one. CoriolisAcceleration and CentrifugalAcceleration give the
+// fictitious accelerations for equations of motion written directly in a
+// rotating frame.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/dynamics/frames"
+//	    "github.com/sakiphan/qsim-core/math/rotation"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	inertial := frames.NewFrame("ECI")
+//	spin := vector.Vector3{X: units.RadianPerSecond(0).Value, Y: units.RadianPerSecond(0).Value, Z: units.RadianPerSecond(7.292115e-5).Value}
+//	earth := inertial.NewChild("ECEF", frames.Rotating(spin, rotation.Identity()))
+//
+//	// A point at rest on the equator, seen from the inertial frame
+//	s := frames.State{Position: vector.NewPosition(units.Kilometer(6378), units.Meter(0), units.Meter(0))}
+//	si, _ := frames.Convert(s, earth, inertial, units.Hour(6))
+//	// si.Velocity ≈ 465 m/s eastward, si.Acceleration ≈ 0.034 m/s² inward
+//
+// References:
+//   - Goldstein, Poole, Safko. "Classical Mechanics", 3rd ed., Sec. 4.9-4.10
+//   - Kane, Levinson. "Dynamics: Theory and Applications", Ch. 2
+package frames
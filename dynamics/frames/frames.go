@@ -0,0 +1,205 @@
+package frames
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/math/rotation"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	lengthDim       = units.Dimension{L: 1}
+	velocityDim     = units.Dimension{L: 1, T: -1}
+	accelerationDim = units.Dimension{L: 1, T: -2}
+	rateDim         = units.Dimension{T: -1}
+	rateDotDim      = units.Dimension{T: -2}
+)
+
+// -----------------------------------------------------------------------------
+// Transforms and Motions
+// -----------------------------------------------------------------------------
+
+// Transform locates a frame relative to its parent at one instant. All
+// vectors are in parent coordinates. Zero-valued fields (vector.Vector3{}
+// or rotation.Quaternion{}) mean zero offset, rate or the identity
+// rotation.
+type Transform struct {
+	Origin              vector.Vector3      // frame origin [L]
+	Velocity            vector.Vector3      // origin velocity [LT⁻¹]
+	Acceleration        vector.Vector3      // origin acceleration [LT⁻²]
+	Rotation            rotation.Quaternion // frame axes to parent axes
+	AngularVelocity     vector.Vector3      // ω [T⁻¹]
+	AngularAcceleration vector.Vector3      // α [T⁻²]
+}
+
+// Motion gives a frame's Transform relative to its parent at time t.
+type Motion func(t units.Time) Transform
+
+// Static returns a Motion that always yields tr.
+func Static(tr Transform) Motion {
+	return func(units.Time) Transform { return tr }
+}
+
+// Translating returns a Motion for a non-rotating frame whose origin moves
+// uniformly, origin(t) = origin + velocity·t.
+func Translating(origin, velocity vector.Vector3) Motion {
+	return func(t units.Time) Transform {
+		o, v := origin.ToArray(), velocity.ToArray()
+		return Transform{
+			Origin:   position(add(o, scale(v, t.Val()))),
+			Velocity: velocity,
+		}
+	}
+}
+
+// Rotating returns a Motion for a frame sharing its parent's origin and
+// spinning at constant angular velocity ω (in parent coordinates), with
+// orientation initial at t = 0.
+//
+// Formula:
+//
+//	R(t) = exp(ω t) R(0)
+func Rotating(omega vector.Vector3, initial rotation.Quaternion) Motion {
+	return func(t units.Time) Transform {
+		w := omega.ToArray()
+		turn := rotation.Identity()
+		if rate := norm(w); rate > 0 {
+			turn, _ = rotation.FromAxisAngle(omega, units.Radian(rate*t.Val()))
+		}
+		return Transform{Rotation: turn.Mul(initial.Normalize()), AngularVelocity: omega}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Frames
+// -----------------------------------------------------------------------------
+
+// Frame is a named coordinate frame. A frame created with NewFrame is the
+// root of a tree; other frames are attached with NewChild.
+type Frame struct {
+	name   string
+	parent *Frame
+	motion Motion
+}
+
+// NewFrame returns a root frame, typically an inertial frame.
+func NewFrame(name string) *Frame {
+	return &Frame{name: name}
+}
+
+// NewChild returns a frame attached to f that moves as m relative to it.
+func (f *Frame) NewChild(name string, m Motion) *Frame {
+	return &Frame{name: name, parent: f, motion: m}
+}
+
+// Name returns the frame's name.
+func (f *Frame) Name() string {
+	return f.name
+}
+
+// Parent returns the parent frame, or nil for a root.
+func (f *Frame) Parent() *Frame {
+	return f.parent
+}
+
+// String returns the path from the root, e.g. "ECI/ECEF/station".
+func (f *Frame) String() string {
+	if f.parent == nil {
+		return f.name
+	}
+	return f.parent.String() + "/" + f.name
+}
+
+// Relative returns the Transform of frame f relative to frame to at time t,
+// composing the motions along the tree.
+//
+// Returns an error if the frames are in different trees or a Motion
+// yields vectors of the wrong dimension.
+func (f *Frame) Relative(to *Frame, t units.Time) (Transform, error) {
+	if f.root() != to.root() {
+		return Transform{}, fmt.Errorf("frames %q and %q are not connected", f, to)
+	}
+	from, err := f.toRoot(t)
+	if err != nil {
+		return Transform{}, err
+	}
+	back, err := to.toRoot(t)
+	if err != nil {
+		return Transform{}, err
+	}
+	return back.inverse().compose(from).transform(), nil
+}
+
+func (f *Frame) root() *Frame {
+	for f.parent != nil {
+		f = f.parent
+	}
+	return f
+}
+
+// toRoot returns the pose of f relative to its root.
+func (f *Frame) toRoot(t units.Time) (pose, error) {
+	p := identityPose()
+	for ; f.parent != nil; f = f.parent {
+		step, err := newPose(f.motion(t))
+		if err != nil {
+			return pose{}, fmt.Errorf("frame %q: %w", f.name, err)
+		}
+		p = step.compose(p)
+	}
+	return p, nil
+}
+
+// -----------------------------------------------------------------------------
+// States
+// -----------------------------------------------------------------------------
+
+// State is the kinematic state of a point in some frame. A zero-valued
+// Velocity or Acceleration is treated as zero.
+type State struct {
+	Position     vector.Vector3 // [L]
+	Velocity     vector.Vector3 // [LT⁻¹]
+	Acceleration vector.Vector3 // [LT⁻²]
+}
+
+// Convert expresses a state given in frame from in frame to at time t.
+// Velocities and accelerations are those observed in each frame, so the
+// result includes transport, Coriolis, centrifugal and Euler terms.
+//
+// Returns an error if the frames are not connected or the state has the
+// wrong dimensions.
+func Convert(s State, from, to *Frame, t units.Time) (State, error) {
+	r, err := checked(s.Position, lengthDim, "position", false)
+	if err != nil {
+		return State{}, err
+	}
+	v, err := checked(s.Velocity, velocityDim, "velocity", true)
+	if err != nil {
+		return State{}, err
+	}
+	a, err := checked(s.Acceleration, accelerationDim, "acceleration", true)
+	if err != nil {
+		return State{}, err
+	}
+	tr, err := from.Relative(to, t)
+	if err != nil {
+		return State{}, err
+	}
+	p, _ := newPose(tr)
+	r, v, a = p.apply(r, v, a)
+	return State{Position: position(r), Velocity: velocity(v), Acceleration: acceleration(a)}, nil
+}
+
+// CoriolisAcceleration returns -2 ω × v, the Coriolis acceleration of a
+// body moving with velocity v in a frame rotating at ω.
+func CoriolisAcceleration(omega, v vector.Vector3) vector.Vector3 {
+	return acceleration(scale(cross(omega.ToArray(), v.ToArray()), -2))
+}
+
+// CentrifugalAcceleration returns -ω × (ω × r), the centrifugal
+// acceleration at position r in a frame rotating at ω.
+func CentrifugalAcceleration(omega, r vector.Vector3) vector.Vector3 {
+	w := omega.ToArray()
+	return acceleration(scale(cross(w, cross(w, r.ToArray())), -1))
+}
@@ -0,0 +1,167 @@
+package frames
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/rotation"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func rate(x, y, z float64) vector.Vector3 {
+	return vector.Vector3{X: units.RadianPerSecond(x).Value, Y: units.RadianPerSecond(y).Value, Z: units.RadianPerSecond(z).Value}
+}
+
+func vecNear(a, b vector.Vector3, tolerance float64) bool {
+	x, y := a.ToArray(), b.ToArray()
+	return a.Dim() == b.Dim() && norm(sub(x, y)) <= tolerance
+}
+
+func TestRotatingEarth(t *testing.T) {
+	const omega = 7.292115e-5
+	inertial := NewFrame("ECI")
+	earth := inertial.NewChild("ECEF", Rotating(rate(0, 0, omega), rotation.Identity()))
+	if earth.String() != "ECI/ECEF" || earth.Parent() != inertial || earth.Name() != "ECEF" {
+		t.Errorf("frame naming = %q", earth)
+	}
+
+	R := 6378e3
+	s := State{Position: vector.NewPosition(units.Meter(R), units.Meter(0), units.Meter(0))}
+	at := units.Second(math.Pi / 2 / omega) // a quarter turn
+	si, err := Convert(s, earth, inertial, at)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	// The point has moved to +y and travels in -x at ωR.
+	want := State{
+		Position:     vector.NewPosition(units.Meter(0), units.Meter(R), units.Meter(0)),
+		Velocity:     vector.NewVelocity(units.MeterPerSecond(-omega*R), units.MeterPerSecond(0), units.MeterPerSecond(0)),
+		Acceleration: vector.NewAcceleration(units.MeterPerSecond2(0), units.MeterPerSecond2(-omega*omega*R), units.MeterPerSecond2(0)),
+	}
+	if !vecNear(si.Position, want.Position, 1e-6) || !vecNear(si.Velocity, want.Velocity, 1e-9) || !vecNear(si.Acceleration, want.Acceleration, 1e-12) {
+		t.Errorf("Convert() = %+v, want %+v", si, want)
+	}
+
+	// A particle at rest in the inertial frame appears to circle; its
+	// apparent acceleration is the sum of the fictitious terms.
+	sr, _ := Convert(State{Position: want.Position}, inertial, earth, at)
+	w := rate(0, 0, omega)
+	fict := add(CentrifugalAcceleration(w, sr.Position).ToArray(), CoriolisAcceleration(w, sr.Velocity).ToArray())
+	if !vecNear(sr.Acceleration, acceleration(fict), 1e-15) {
+		t.Errorf("rotating-frame acceleration = %v, want %v", sr.Acceleration, fict)
+	}
+	if !vecNear(sr.Position, s.Position, 1e-6) {
+		t.Errorf("rotating-frame position = %v, want %v", sr.Position, s.Position)
+	}
+}
+
+// chain builds a root with a spinning, translating and nodding descendant.
+func chain() (root, leaf, sibling *Frame) {
+	root = NewFrame("root")
+	turntable := root.NewChild("turntable", Rotating(rate(0, 0, 0.3), rotation.Identity()))
+	cart := turntable.NewChild("cart", Translating(
+		vector.NewPosition(units.Meter(2), units.Meter(0), units.Meter(0.5)),
+		vector.NewVelocity(units.MeterPerSecond(0), units.MeterPerSecond(0.4), units.MeterPerSecond(0)),
+	))
+	// A pendulum-like nod about x with non-constant rate.
+	leaf = cart.NewChild("arm", func(t units.Time) Transform {
+		th := 0.2 * math.Sin(1.5*t.Val())
+		q, _ := rotation.FromAxisAngle(rate(1, 0, 0), units.Radian(th))
+		return Transform{
+			Origin:              vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(1)),
+			Rotation:            q,
+			AngularVelocity:     rate(0.3*math.Cos(1.5*t.Val()), 0, 0),
+			AngularAcceleration: vector.Vector3{X: units.NewValue(-0.45*math.Sin(1.5*t.Val()), rateDotDim), Y: units.NewValue(0, rateDotDim), Z: units.NewValue(0, rateDotDim)},
+		}
+	})
+	sibling = root.NewChild("tilted", Static(Transform{
+		Origin:   vector.NewPosition(units.Meter(-1), units.Meter(3), units.Meter(0)),
+		Rotation: rotation.FromEuler(units.Degree(40), units.Degree(10), units.Degree(-25)),
+	}))
+	return root, leaf, sibling
+}
+
+func TestFiniteDifference(t *testing.T) {
+	root, leaf, _ := chain()
+	// A point moving uniformly in the leaf frame.
+	r0 := [3]float64{0.3, -0.2, 0.7}
+	v0 := [3]float64{0.1, 0.05, -0.2}
+	at := func(tt float64) State {
+		s, err := Convert(State{Position: position(add(r0, scale(v0, tt))), Velocity: velocity(v0)}, leaf, root, units.Second(tt))
+		if err != nil {
+			t.Fatalf("Convert() error = %v", err)
+		}
+		return s
+	}
+	const t0, h = 1.3, 1e-4
+	s, lo, hi := at(t0), at(t0-h), at(t0+h)
+	dr := scale(sub(hi.Position.ToArray(), lo.Position.ToArray()), 1/(2*h))
+	dv := scale(sub(hi.Velocity.ToArray(), lo.Velocity.ToArray()), 1/(2*h))
+	if d := norm(sub(dr, s.Velocity.ToArray())); d > 1e-7 {
+		t.Errorf("velocity = %v, finite difference %v", s.Velocity, dr)
+	}
+	if d := norm(sub(dv, s.Acceleration.ToArray())); d > 1e-7 {
+		t.Errorf("acceleration = %v, finite difference %v", s.Acceleration, dv)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	_, leaf, sibling := chain()
+	s := State{
+		Position:     vector.NewPosition(units.Meter(1), units.Meter(2), units.Meter(3)),
+		Velocity:     vector.NewVelocity(units.MeterPerSecond(-1), units.MeterPerSecond(0.5), units.MeterPerSecond(2)),
+		Acceleration: vector.NewAcceleration(units.MeterPerSecond2(0.1), units.MeterPerSecond2(0), units.MeterPerSecond2(-9.8)),
+	}
+	at := units.Second(2.7)
+	mid, err := Convert(s, leaf, sibling, at)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	back, _ := Convert(mid, sibling, leaf, at)
+	if !vecNear(back.Position, s.Position, 1e-12) || !vecNear(back.Velocity, s.Velocity, 1e-12) || !vecNear(back.Acceleration, s.Acceleration, 1e-12) {
+		t.Errorf("round trip = %+v, want %+v", back, s)
+	}
+	// Relative transforms compose to the identity.
+	tr, _ := leaf.Relative(leaf, at)
+	if p, _ := newPose(tr); norm(p.o) > 1e-12 || norm(p.w) > 1e-15 || math.Abs(math.Abs(p.r.W)-1) > 1e-12 {
+		t.Errorf("self-relative transform = %+v", tr)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	a, b := NewFrame("a"), NewFrame("b")
+	s := State{Position: vector.NewPosition(units.Meter(1), units.Meter(0), units.Meter(0))}
+	if _, err := Convert(s, a, b, units.Second(0)); err == nil {
+		t.Error("Convert() should reject disconnected frames")
+	}
+	if _, err := Convert(State{Position: rate(1, 0, 0)}, a, a, units.Second(0)); err == nil {
+		t.Error("Convert() should reject a non-length position")
+	}
+	bad := a.NewChild("bad", Static(Transform{Origin: rate(1, 0, 0)}))
+	if _, err := Convert(s, bad, a, units.Second(0)); err == nil {
+		t.Error("Convert() should reject a malformed Transform")
+	}
+	// Zero-valued transform fields are the identity.
+	same := a.NewChild("same", Static(Transform{}))
+	if got, err := Convert(s, same, a, units.Second(5)); err != nil || !vecNear(got.Position, s.Position, 0) {
+		t.Errorf("identity frame Convert() = %+v, %v", got, err)
+	}
+}
+
+func TestTranslatingGalilean(t *testing.T) {
+	root := NewFrame("lab")
+	train := root.NewChild("train", Translating(vector.Vector3{}, vector.NewVelocity(units.MeterPerSecond(30), units.MeterPerSecond(0), units.MeterPerSecond(0))))
+	s := State{
+		Position: vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(0)),
+		Velocity: vector.NewVelocity(units.MeterPerSecond(5), units.MeterPerSecond(0), units.MeterPerSecond(0)),
+	}
+	got, _ := Convert(s, train, root, units.Second(10))
+	if !almostEqual(got.Velocity.X.Val(), 35, 1e-15) || !almostEqual(got.Position.X.Val(), 300, 1e-15) {
+		t.Errorf("Galilean transform = %+v, want x = 300 m, v = 35 m/s", got)
+	}
+}
@@ -0,0 +1,155 @@
+package frames
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/rotation"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// pose is a Transform in SI components, mapping child coordinates into
+// parent coordinates.
+type pose struct {
+	r       rotation.Quaternion
+	o, v, a [3]float64
+	w, wdot [3]float64
+}
+
+func identityPose() pose {
+	return pose{r: rotation.Identity()}
+}
+
+func newPose(tr Transform) (pose, error) {
+	p := pose{r: tr.Rotation.Normalize()} // zero quaternion → identity
+	fields := []struct {
+		v    vector.Vector3
+		dim  units.Dimension
+		name string
+		out  *[3]float64
+	}{
+		{tr.Origin, lengthDim, "origin", &p.o},
+		{tr.Velocity, velocityDim, "velocity", &p.v},
+		{tr.Acceleration, accelerationDim, "acceleration", &p.a},
+		{tr.AngularVelocity, rateDim, "angular velocity", &p.w},
+		{tr.AngularAcceleration, rateDotDim, "angular acceleration", &p.wdot},
+	}
+	for _, f := range fields {
+		c, err := checked(f.v, f.dim, f.name, true)
+		if err != nil {
+			return pose{}, err
+		}
+		*f.out = c
+	}
+	return p, nil
+}
+
+func (p pose) transform() Transform {
+	return Transform{
+		Origin:              position(p.o),
+		Velocity:            velocity(p.v),
+		Acceleration:        acceleration(p.a),
+		Rotation:            p.r,
+		AngularVelocity:     vector.Vector3{X: units.NewValue(p.w[0], rateDim), Y: units.NewValue(p.w[1], rateDim), Z: units.NewValue(p.w[2], rateDim)},
+		AngularAcceleration: vector.Vector3{X: units.NewValue(p.wdot[0], rateDotDim), Y: units.NewValue(p.wdot[1], rateDotDim), Z: units.NewValue(p.wdot[2], rateDotDim)},
+	}
+}
+
+// rotate returns R x.
+func (p pose) rotate(x [3]float64) [3]float64 {
+	return p.r.Rotate(vector.Vector3{
+		X: units.Dimensionless(x[0]), Y: units.Dimensionless(x[1]), Z: units.Dimensionless(x[2]),
+	}).ToArray()
+}
+
+// apply maps a child-frame state to the parent frame.
+func (p pose) apply(r, v, a [3]float64) (rp, vp, ap [3]float64) {
+	d := p.rotate(r)
+	dv := p.rotate(v)
+	wd := cross(p.w, d)
+	rp = add(p.o, d)
+	vp = add(add(p.v, wd), dv)
+	ap = add(add(add(p.a, cross(p.wdot, d)), cross(p.w, wd)), add(scale(cross(p.w, dv), 2), p.rotate(a)))
+	return rp, vp, ap
+}
+
+// compose returns the pose of inner's child relative to p's parent, where
+// inner maps into p's child frame.
+func (p pose) compose(inner pose) pose {
+	rw := p.rotate(inner.w)
+	o, v, a := p.apply(inner.o, inner.v, inner.a)
+	return pose{
+		r:    p.r.Mul(inner.r).Normalize(),
+		o:    o,
+		v:    v,
+		a:    a,
+		w:    add(p.w, rw),
+		wdot: add(add(p.wdot, p.rotate(inner.wdot)), cross(p.w, rw)),
+	}
+}
+
+// inverse returns the pose of the parent relative to the child.
+func (p pose) inverse() pose {
+	q := pose{r: p.r.Conjugate()}
+	q.w = scale(q.rotate(p.w), -1)
+	q.wdot = scale(q.rotate(p.wdot), -1)
+	// The parent origin, at rest in the parent, seen from the child.
+	d := scale(p.o, -1)
+	rel := sub(scale(p.v, -1), cross(p.w, d))
+	acc := sub(sub(sub(scale(p.a, -1), cross(p.wdot, d)), cross(p.w, cross(p.w, d))), scale(cross(p.w, rel), 2))
+	q.o, q.v, q.a = q.rotate(d), q.rotate(rel), q.rotate(acc)
+	return q
+}
+
+// -----------------------------------------------------------------------------
+// Component Helpers
+// -----------------------------------------------------------------------------
+
+// checked returns the components of v after checking its dimension. With
+// allowZero, the zero-valued vector.Vector3{} stands for a zero vector.
+func checked(v vector.Vector3, dim units.Dimension, name string, allowZero bool) ([3]float64, error) {
+	if allowZero && v == (vector.Vector3{}) {
+		return [3]float64{}, nil
+	}
+	if v.Dim() != dim {
+		return [3]float64{}, fmt.Errorf("%s must have dimension %s, got %s", name, dim, v.Dim())
+	}
+	return v.ToArray(), nil
+}
+
+func position(x [3]float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x[0]), units.Meter(x[1]), units.Meter(x[2]))
+}
+
+func velocity(x [3]float64) vector.Vector3 {
+	return vector.NewVelocity(units.MeterPerSecond(x[0]), units.MeterPerSecond(x[1]), units.MeterPerSecond(x[2]))
+}
+
+func acceleration(x [3]float64) vector.Vector3 {
+	return vector.NewAcceleration(units.MeterPerSecond2(x[0]), units.MeterPerSecond2(x[1]), units.MeterPerSecond2(x[2]))
+}
+
+func add(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] + b[0], a[1] + b[1], a[2] + b[2]}
+}
+
+func sub(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func scale(a [3]float64, k float64) [3]float64 {
+	return [3]float64{a[0] * k, a[1] * k, a[2] * k}
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+func norm(a [3]float64) float64 {
+	return math.Sqrt(a[0]*a[0] + a[1]*a[1] + a[2]*a[2])
+}
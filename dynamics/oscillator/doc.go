@@ -0,0 +1,33 @@
+// Package oscillator models the damped, driven harmonic oscillator
+//
+//	m ẍ + c ẋ + k x = F(t)
+//
+// with unit-typed mass, spring constant, and damping coefficient.
+//
+// Analytic solutions cover free motion in every damping regime
+// (undamped, underdamped, critically damped, overdamped) and the
+// steady-state response to a sinusoidal drive (amplitude and phase
+// resonance curves, Q factor). Arbitrary drives are handled numerically
+// by Simulate with a fourth-order Runge-Kutta scheme.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/dynamics/oscillator"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	osc, _ := oscillator.New(units.Kilogram(0.5), units.NewtonPerMeter(200), units.NewtonSecondPerMeter(0.4))
+//	w0 := osc.NaturalFrequency() // 20 rad/s
+//	q := osc.QualityFactor()     // 25
+//
+//	// Free decay from a 5 cm displacement
+//	x, v := osc.FreeResponse(units.Centimeter(5), units.MeterPerSecond(0), units.Second(1))
+//
+//	// Steady-state amplitude under a 1 N drive at resonance
+//	a := osc.Amplitude(units.Newton(1), w0)
+//
+// References:
+//   - Taylor. "Classical Mechanics", 1st ed., Ch. 5
+//   - French. "Vibrations and Waves", 1st ed., Ch. 3-4
+package oscillator
@@ -0,0 +1,274 @@
+package oscillator
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// criticalTolerance is the tolerance on |ζ - 1| within which motion is
+// treated as critically damped.
+const criticalTolerance = 1e-12
+
+// Regime classifies the free motion of a damped oscillator.
+type Regime int
+
+const (
+	Undamped         Regime = iota // ζ = 0
+	Underdamped                    // 0 < ζ < 1
+	CriticallyDamped               // ζ = 1
+	Overdamped                     // ζ > 1
+)
+
+// String returns the name of the damping regime.
+func (r Regime) String() string {
+	switch r {
+	case Undamped:
+		return "undamped"
+	case Underdamped:
+		return "underdamped"
+	case CriticallyDamped:
+		return "critically damped"
+	case Overdamped:
+		return "overdamped"
+	default:
+		return fmt.Sprintf("Regime(%d)", int(r))
+	}
+}
+
+// Oscillator is a linear mass-spring-damper system.
+type Oscillator struct {
+	Mass    units.Mass
+	Spring  units.SpringConstant
+	Damping units.DampingCoefficient
+}
+
+// New creates an oscillator. Returns an error if the mass or spring
+// constant is not positive or the damping coefficient is negative.
+func New(m units.Mass, k units.SpringConstant, c units.DampingCoefficient) (Oscillator, error) {
+	if m.Val() <= 0 {
+		return Oscillator{}, fmt.Errorf("mass must be positive, got %g kg", m.Val())
+	}
+	if k.Val() <= 0 {
+		return Oscillator{}, fmt.Errorf("spring constant must be positive, got %g N/m", k.Val())
+	}
+	if c.Val() < 0 {
+		return Oscillator{}, fmt.Errorf("damping coefficient must be non-negative, got %g N·s/m", c.Val())
+	}
+	return Oscillator{Mass: m, Spring: k, Damping: c}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Characteristic Parameters
+// -----------------------------------------------------------------------------
+
+// NaturalFrequency returns the undamped angular frequency ω₀ = √(k/m).
+func (o Oscillator) NaturalFrequency() units.AngularVelocity {
+	return units.RadianPerSecond(o.omega0())
+}
+
+// DampingRatio returns the dimensionless damping ratio ζ = c / (2√(km)).
+func (o Oscillator) DampingRatio() float64 {
+	return o.Damping.Val() / (2 * math.Sqrt(o.Spring.Val()*o.Mass.Val()))
+}
+
+// QualityFactor returns Q = 1/(2ζ) = √(km)/c. It is +Inf for an undamped oscillator.
+func (o Oscillator) QualityFactor() float64 {
+	return 1 / (2 * o.DampingRatio())
+}
+
+// Regime returns the damping regime of the oscillator.
+func (o Oscillator) Regime() Regime {
+	zeta := o.DampingRatio()
+	switch {
+	case zeta == 0:
+		return Undamped
+	case math.Abs(zeta-1) < criticalTolerance:
+		return CriticallyDamped
+	case zeta < 1:
+		return Underdamped
+	default:
+		return Overdamped
+	}
+}
+
+// DampedFrequency returns the angular frequency of free oscillation,
+// ω_d = ω₀ √(1 - ζ²). Returns an error if the oscillator does not oscillate
+// (ζ ≥ 1).
+func (o Oscillator) DampedFrequency() (units.AngularVelocity, error) {
+	zeta := o.DampingRatio()
+	if r := o.Regime(); r == CriticallyDamped || r == Overdamped {
+		return units.AngularVelocity{}, fmt.Errorf("%s oscillator has no damped frequency (ζ = %g)", r, zeta)
+	}
+	return units.RadianPerSecond(o.omega0() * math.Sqrt(1-zeta*zeta)), nil
+}
+
+// DecayTime returns the amplitude e-folding time τ = 2m/c of an
+// underdamped oscillator. It is +Inf for an undamped oscillator.
+func (o Oscillator) DecayTime() units.Time {
+	return units.Second(2 * o.Mass.Val() / o.Damping.Val())
+}
+
+// Energy returns the total mechanical energy ½kx² + ½mv².
+func (o Oscillator) Energy(x units.Length, v units.Velocity) units.Energy {
+	return units.Joule(0.5*o.Spring.Val()*x.Val()*x.Val() + 0.5*o.Mass.Val()*v.Val()*v.Val())
+}
+
+// -----------------------------------------------------------------------------
+// Free Response
+// -----------------------------------------------------------------------------
+
+// FreeResponse returns the displacement and velocity at time t of the
+// undriven oscillator released with displacement x0 and velocity v0.
+//
+// Formula:
+//
+//	underdamped: x = e^(-ζω₀t) [x₀ cos ω_d t + (v₀ + ζω₀x₀)/ω_d sin ω_d t]
+//	critical:    x = e^(-ω₀t) [x₀ + (v₀ + ω₀x₀) t]
+//	overdamped:  x = A e^(r₁t) + B e^(r₂t),  r₁,₂ = -ω₀(ζ ∓ √(ζ² - 1))
+func (o Oscillator) FreeResponse(x0 units.Length, v0 units.Velocity, t units.Time) (units.Length, units.Velocity) {
+	x, v := o.freeResponse(x0.Val(), v0.Val(), t.Val())
+	return units.Meter(x), units.MeterPerSecond(v)
+}
+
+func (o Oscillator) freeResponse(x0, v0, t float64) (x, v float64) {
+	w0, zeta := o.omega0(), o.DampingRatio()
+
+	switch o.Regime() {
+	case CriticallyDamped:
+		c, d := x0, v0+w0*x0
+		e := math.Exp(-w0 * t)
+		return e * (c + d*t), e * (d - w0*(c+d*t))
+
+	case Overdamped:
+		s := math.Sqrt(zeta*zeta - 1)
+		r1, r2 := -w0*(zeta-s), -w0*(zeta+s)
+		a := (v0 - r2*x0) / (r1 - r2)
+		b := x0 - a
+		e1, e2 := math.Exp(r1*t), math.Exp(r2*t)
+		return a*e1 + b*e2, a*r1*e1 + b*r2*e2
+
+	default: // undamped or underdamped
+		gamma := zeta * w0
+		wd := w0 * math.Sqrt(1-zeta*zeta)
+		c, d := x0, (v0+gamma*x0)/wd
+		e := math.Exp(-gamma * t)
+		cos, sin := math.Cos(wd*t), math.Sin(wd*t)
+		return e * (c*cos + d*sin), e * ((-gamma*c+wd*d)*cos + (-gamma*d-wd*c)*sin)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Driven Steady State
+// -----------------------------------------------------------------------------
+
+// Amplitude returns the steady-state displacement amplitude under a
+// sinusoidal drive F₀ cos ωt.
+//
+// Formula:
+//
+//	A(ω) = (F₀/m) / √((ω₀² - ω²)² + (2ζω₀ω)²)
+func (o Oscillator) Amplitude(f0 units.Force, w units.AngularVelocity) units.Length {
+	w0, zeta, wv := o.omega0(), o.DampingRatio(), w.Val()
+	d := math.Hypot(w0*w0-wv*wv, 2*zeta*w0*wv)
+	return units.Meter(f0.Val() / o.Mass.Val() / d)
+}
+
+// Phase returns the phase lag δ ∈ [0, π] of the steady-state displacement
+// behind a sinusoidal drive at angular frequency ω.
+//
+// Formula:
+//
+//	δ(ω) = atan2(2ζω₀ω, ω₀² - ω²)
+func (o Oscillator) Phase(w units.AngularVelocity) float64 {
+	w0, zeta, wv := o.omega0(), o.DampingRatio(), w.Val()
+	return math.Atan2(2*zeta*w0*wv, w0*w0-wv*wv)
+}
+
+// ResonanceFrequency returns the drive frequency that maximizes the
+// displacement amplitude, ω_r = ω₀ √(1 - 2ζ²). Returns an error if
+// ζ ≥ 1/√2, where the amplitude decreases monotonically with ω.
+func (o Oscillator) ResonanceFrequency() (units.AngularVelocity, error) {
+	zeta := o.DampingRatio()
+	if 2*zeta*zeta >= 1 {
+		return units.AngularVelocity{}, fmt.Errorf("no amplitude resonance for ζ = %g ≥ 1/√2", zeta)
+	}
+	return units.RadianPerSecond(o.omega0() * math.Sqrt(1-2*zeta*zeta)), nil
+}
+
+// Bandwidth returns the full width at half maximum of the power resonance
+// curve, Δω = c/m = ω₀/Q.
+func (o Oscillator) Bandwidth() units.AngularVelocity {
+	return units.RadianPerSecond(o.Damping.Val() / o.Mass.Val())
+}
+
+// -----------------------------------------------------------------------------
+// Numerical Driver
+// -----------------------------------------------------------------------------
+
+// Drive is an external force applied to the oscillator as a function of time.
+type Drive func(t units.Time) units.Force
+
+// SinusoidalDrive returns the drive F(t) = F₀ cos ωt.
+func SinusoidalDrive(f0 units.Force, w units.AngularVelocity) Drive {
+	return func(t units.Time) units.Force {
+		return units.Newton(f0.Val() * math.Cos(w.Val()*t.Val()))
+	}
+}
+
+// State is the oscillator displacement and velocity at a point in time.
+type State struct {
+	Time         units.Time
+	Displacement units.Length
+	Velocity     units.Velocity
+}
+
+// Simulate integrates the equation of motion under an arbitrary drive for n
+// RK4 steps of size dt, returning n+1 states including the initial one.
+// A nil drive is treated as free motion.
+//
+// Example:
+//
+//	drive := oscillator.SinusoidalDrive(units.Newton(1), osc.NaturalFrequency())
+//	states, _ := osc.Simulate(units.Meter(0), units.MeterPerSecond(0), drive, units.Millisecond(1), 10000)
+func (o Oscillator) Simulate(x0 units.Length, v0 units.Velocity, drive Drive, dt units.Time, n int) ([]State, error) {
+	h := dt.Val()
+	if h <= 0 {
+		return nil, fmt.Errorf("time step must be positive, got %g s", h)
+	}
+	if n <= 0 {
+		return nil, fmt.Errorf("number of steps must be positive, got %d", n)
+	}
+
+	m, k, c := o.Mass.Val(), o.Spring.Val(), o.Damping.Val()
+	f := func(t float64, y, dydt []float64) {
+		force := 0.0
+		if drive != nil {
+			force = drive(units.Second(t)).Val()
+		}
+		dydt[0] = y[1]
+		dydt[1] = (force - c*y[1] - k*y[0]) / m
+	}
+
+	y := []float64{x0.Val(), v0.Val()}
+	states := make([]State, 0, n+1)
+	states = append(states, State{Time: units.Second(0), Displacement: x0, Velocity: v0})
+
+	rk := &solver.RK4{}
+	for i := 0; i < n; i++ {
+		t := float64(i) * h
+		rk.Step(f, t, h, y)
+		states = append(states, State{
+			Time:         units.Second(t + h),
+			Displacement: units.Meter(y[0]),
+			Velocity:     units.MeterPerSecond(y[1]),
+		})
+	}
+	return states, nil
+}
+
+func (o Oscillator) omega0() float64 {
+	return math.Sqrt(o.Spring.Val() / o.Mass.Val())
+}
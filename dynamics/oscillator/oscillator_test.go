@@ -0,0 +1,175 @@
+package oscillator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func mustNew(t *testing.T, m, k, c float64) Oscillator {
+	t.Helper()
+	o, err := New(units.Kilogram(m), units.NewtonPerMeter(k), units.NewtonSecondPerMeter(c))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return o
+}
+
+func TestNew_Validation(t *testing.T) {
+	if _, err := New(units.Kilogram(0), units.NewtonPerMeter(1), units.NewtonSecondPerMeter(0)); err == nil {
+		t.Error("New should reject zero mass")
+	}
+	if _, err := New(units.Kilogram(1), units.NewtonPerMeter(-1), units.NewtonSecondPerMeter(0)); err == nil {
+		t.Error("New should reject a negative spring constant")
+	}
+	if _, err := New(units.Kilogram(1), units.NewtonPerMeter(1), units.NewtonSecondPerMeter(-1)); err == nil {
+		t.Error("New should reject negative damping")
+	}
+}
+
+func TestCharacteristicParameters(t *testing.T) {
+	o := mustNew(t, 0.5, 200, 0.4)
+	if !almostEqual(o.NaturalFrequency().Val(), 20, 1e-12) {
+		t.Errorf("ω₀ = %v, want 20", o.NaturalFrequency().Val())
+	}
+	if !almostEqual(o.DampingRatio(), 0.02, 1e-12) {
+		t.Errorf("ζ = %v, want 0.02", o.DampingRatio())
+	}
+	if !almostEqual(o.QualityFactor(), 25, 1e-9) {
+		t.Errorf("Q = %v, want 25", o.QualityFactor())
+	}
+	wd, err := o.DampedFrequency()
+	if err != nil || !almostEqual(wd.Val(), 20*math.Sqrt(1-0.0004), 1e-12) {
+		t.Errorf("ω_d = %v, %v", wd.Val(), err)
+	}
+	if !almostEqual(o.Bandwidth().Val(), o.NaturalFrequency().Val()/o.QualityFactor(), 1e-12) {
+		t.Errorf("Bandwidth = %v, want ω₀/Q", o.Bandwidth().Val())
+	}
+	if !almostEqual(o.DecayTime().Val(), 2.5, 1e-12) {
+		t.Errorf("DecayTime = %v, want 2.5", o.DecayTime().Val())
+	}
+}
+
+func TestRegime(t *testing.T) {
+	tests := []struct {
+		c    float64
+		want Regime
+	}{
+		{0, Undamped},
+		{1, Underdamped},
+		{4, CriticallyDamped}, // c = 2√(km) with m = 1, k = 4
+		{10, Overdamped},
+	}
+	for _, tt := range tests {
+		o := mustNew(t, 1, 4, tt.c)
+		if got := o.Regime(); got != tt.want {
+			t.Errorf("Regime(c=%v) = %v, want %v", tt.c, got, tt.want)
+		}
+	}
+	if _, err := mustNew(t, 1, 4, 10).DampedFrequency(); err == nil {
+		t.Error("DampedFrequency should fail for an overdamped oscillator")
+	}
+	if !math.IsInf(mustNew(t, 1, 4, 0).QualityFactor(), 1) {
+		t.Error("undamped Q should be +Inf")
+	}
+}
+
+func TestFreeResponse_AllRegimes(t *testing.T) {
+	// Each analytic regime must agree with RK4 integration of the same motion.
+	x0, v0 := units.Centimeter(5), units.MeterPerSecond(-0.3)
+	for _, c := range []float64{0, 0.8, 4, 12} {
+		o := mustNew(t, 1, 4, c)
+		states, err := o.Simulate(x0, v0, nil, units.Millisecond(1), 3000)
+		if err != nil {
+			t.Fatalf("Simulate() error = %v", err)
+		}
+		for i := 0; i < len(states); i += 500 {
+			s := states[i]
+			x, v := o.FreeResponse(x0, v0, s.Time)
+			if !almostEqual(x.Val(), s.Displacement.Val(), 1e-10) || !almostEqual(v.Val(), s.Velocity.Val(), 1e-10) {
+				t.Errorf("%v at t=%v: analytic (%v, %v), numeric (%v, %v)",
+					o.Regime(), s.Time.Val(), x.Val(), v.Val(), s.Displacement.Val(), s.Velocity.Val())
+			}
+		}
+	}
+}
+
+func TestFreeResponse_EnergyDecay(t *testing.T) {
+	// Undamped motion conserves energy; damped motion loses it.
+	x0, v0 := units.Meter(1), units.MeterPerSecond(0)
+	free := mustNew(t, 2, 8, 0)
+	x, v := free.FreeResponse(x0, v0, units.Second(7.3))
+	if !almostEqual(free.Energy(x, v).Val(), 4, 1e-12) {
+		t.Errorf("undamped energy = %v, want 4", free.Energy(x, v).Val())
+	}
+
+	damped := mustNew(t, 2, 8, 0.5)
+	x, v = damped.FreeResponse(x0, v0, units.Second(7.3))
+	if damped.Energy(x, v).Val() >= 4 {
+		t.Errorf("damped energy = %v, want < 4", damped.Energy(x, v).Val())
+	}
+}
+
+func TestResonanceCurve(t *testing.T) {
+	o := mustNew(t, 1, 100, 0.5) // ω₀ = 10, ζ = 0.025
+	f0 := units.Newton(2)
+
+	// Static limit: A(0) = F₀/k
+	if a := o.Amplitude(f0, units.RadianPerSecond(0)); !almostEqual(a.Val(), 0.02, 1e-15) {
+		t.Errorf("A(0) = %v, want 0.02", a.Val())
+	}
+	// At ω₀: A = F₀/(cω₀) = Q F₀/k, phase = π/2
+	w0 := o.NaturalFrequency()
+	if a := o.Amplitude(f0, w0); !almostEqual(a.Val(), 0.4, 1e-12) {
+		t.Errorf("A(ω₀) = %v, want 0.4", a.Val())
+	}
+	if !almostEqual(o.Phase(w0), math.Pi/2, 1e-12) {
+		t.Errorf("δ(ω₀) = %v, want π/2", o.Phase(w0))
+	}
+	if p := o.Phase(units.RadianPerSecond(1000)); !almostEqual(p, math.Pi, 1e-3) {
+		t.Errorf("δ(∞) = %v, want ≈ π", p)
+	}
+
+	// The amplitude peaks at ω_r.
+	wr, err := o.ResonanceFrequency()
+	if err != nil {
+		t.Fatalf("ResonanceFrequency() error = %v", err)
+	}
+	peak := o.Amplitude(f0, wr).Val()
+	for _, dw := range []float64{-1e-3, 1e-3} {
+		if o.Amplitude(f0, units.RadianPerSecond(wr.Val()+dw)).Val() >= peak {
+			t.Errorf("amplitude at ω_r%+g exceeds the peak", dw)
+		}
+	}
+
+	if _, err := mustNew(t, 1, 100, 15).ResonanceFrequency(); err == nil {
+		t.Error("ResonanceFrequency should fail for ζ ≥ 1/√2")
+	}
+}
+
+func TestSimulate_DrivenSteadyState(t *testing.T) {
+	// After transients decay the response amplitude matches A(ω).
+	o := mustNew(t, 1, 100, 4) // ζ = 0.2, decay time 0.5 s
+	f0, w := units.Newton(1), units.RadianPerSecond(8)
+	states, err := o.Simulate(units.Meter(0), units.MeterPerSecond(0), SinusoidalDrive(f0, w), units.Millisecond(1), 20000)
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+
+	peak := 0.0
+	for _, s := range states[15000:] {
+		peak = math.Max(peak, math.Abs(s.Displacement.Val()))
+	}
+	if want := o.Amplitude(f0, w).Val(); !almostEqual(peak, want, 1e-5) {
+		t.Errorf("steady-state amplitude = %v, want %v", peak, want)
+	}
+
+	if _, err := o.Simulate(units.Meter(0), units.MeterPerSecond(0), nil, units.Second(0), 10); err == nil {
+		t.Error("Simulate should reject a zero time step")
+	}
+}
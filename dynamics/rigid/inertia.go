@@ -0,0 +1,131 @@
+package rigid
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/math/matrix"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// inertiaDim is the dimension of a moment of inertia, [L²M].
+var inertiaDim = units.Dimension{L: 2, M: 1}
+
+// InertiaTensor is a 3×3 moment of inertia tensor with dimension [L²M],
+// expressed about a body's center of mass in its body-fixed frame.
+type InertiaTensor struct{ matrix.Matrix3 }
+
+// NewInertiaTensor wraps a Matrix3 as an inertia tensor.
+// Returns an error if the matrix does not have dimension [L²M] or is not symmetric.
+func NewInertiaTensor(m matrix.Matrix3) (InertiaTensor, error) {
+	if m.Dim() != inertiaDim {
+		return InertiaTensor{}, fmt.Errorf("inertia tensor must have dimension %s, got %s", inertiaDim, m.Dim())
+	}
+	a := m.ToArray()
+	for i := 0; i < 3; i++ {
+		for j := i + 1; j < 3; j++ {
+			if !almostEqual(a[i][j], a[j][i], 1e-12) {
+				return InertiaTensor{}, fmt.Errorf("inertia tensor must be symmetric: [%d][%d]=%g, [%d][%d]=%g",
+					i, j, a[i][j], j, i, a[j][i])
+			}
+		}
+	}
+	return InertiaTensor{m}, nil
+}
+
+// PrincipalInertia returns a diagonal inertia tensor with principal moments
+// I₁, I₂, I₃ about the body x, y, and z axes.
+func PrincipalInertia(i1, i2, i3 units.MomentOfInertia) InertiaTensor {
+	return InertiaTensor{matrix.Diagonal(i1.Val(), i2.Val(), i3.Val(), inertiaDim)}
+}
+
+// SolidSphere returns the inertia tensor of a uniform solid sphere,
+// I = (2/5) m r² about every axis.
+func SolidSphere(m units.Mass, r units.Length) InertiaTensor {
+	i := 0.4 * m.Val() * r.Val() * r.Val()
+	return PrincipalInertia(units.KilogramMeter2(i), units.KilogramMeter2(i), units.KilogramMeter2(i))
+}
+
+// HollowSphere returns the inertia tensor of a thin spherical shell,
+// I = (2/3) m r² about every axis.
+func HollowSphere(m units.Mass, r units.Length) InertiaTensor {
+	i := 2.0 / 3.0 * m.Val() * r.Val() * r.Val()
+	return PrincipalInertia(units.KilogramMeter2(i), units.KilogramMeter2(i), units.KilogramMeter2(i))
+}
+
+// SolidCylinder returns the inertia tensor of a uniform solid cylinder of
+// radius r and height h whose symmetry axis is the body z axis.
+//
+// Formula:
+//
+//	I_z = ½ m r²
+//	I_x = I_y = m (3r² + h²) / 12
+func SolidCylinder(m units.Mass, r, h units.Length) InertiaTensor {
+	r2, h2 := r.Val()*r.Val(), h.Val()*h.Val()
+	ixy := m.Val() * (3*r2 + h2) / 12
+	iz := 0.5 * m.Val() * r2
+	return PrincipalInertia(units.KilogramMeter2(ixy), units.KilogramMeter2(ixy), units.KilogramMeter2(iz))
+}
+
+// Box returns the inertia tensor of a uniform rectangular cuboid with side
+// lengths a, b, c along the body x, y, and z axes.
+//
+// Formula:
+//
+//	I_x = m (b² + c²) / 12
+//	I_y = m (a² + c²) / 12
+//	I_z = m (a² + b²) / 12
+func Box(m units.Mass, a, b, c units.Length) InertiaTensor {
+	a2, b2, c2 := a.Val()*a.Val(), b.Val()*b.Val(), c.Val()*c.Val()
+	k := m.Val() / 12
+	return PrincipalInertia(
+		units.KilogramMeter2(k*(b2+c2)),
+		units.KilogramMeter2(k*(a2+c2)),
+		units.KilogramMeter2(k*(a2+b2)),
+	)
+}
+
+// ParallelAxis returns the inertia tensor about a point displaced by d from
+// the center of mass (parallel axis / Steiner theorem).
+//
+// Formula:
+//
+//	I' = I + m (|d|² E - d ⊗ d)
+//
+// where E is the identity. Returns an error if d is not a length vector.
+func (it InertiaTensor) ParallelAxis(m units.Mass, d vector.Vector3) (InertiaTensor, error) {
+	if d.Dim() != (units.Dimension{L: 1}) {
+		return InertiaTensor{}, fmt.Errorf("displacement must have dimension [L^1], got %s", d.Dim())
+	}
+	x := d.ToArray()
+	d2 := x[0]*x[0] + x[1]*x[1] + x[2]*x[2]
+	a := it.ToArray()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			delta := 0.0
+			if i == j {
+				delta = 1
+			}
+			a[i][j] += m.Val() * (d2*delta - x[i]*x[j])
+		}
+	}
+	return InertiaTensor{matrix.New(a, inertiaDim)}, nil
+}
+
+// MomentAbout returns the scalar moment of inertia about an axis through the
+// center of mass, I_n = n̂ · I · n̂. The axis need not be normalized.
+func (it InertiaTensor) MomentAbout(axis vector.Vector3) (units.MomentOfInertia, error) {
+	n := axis.ToArray()
+	n2 := n[0]*n[0] + n[1]*n[1] + n[2]*n[2]
+	if n2 == 0 {
+		return units.MomentOfInertia{}, fmt.Errorf("axis must be non-zero")
+	}
+	a := it.ToArray()
+	sum := 0.0
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			sum += n[i] * a[i][j] * n[j]
+		}
+	}
+	return units.KilogramMeter2(sum / n2), nil
+}
@@ -0,0 +1,175 @@
+package rigid
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/matrix"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func omega(x, y, z float64) vector.Vector3 {
+	return vector.Vector3{
+		X: units.RadianPerSecond(x).Value,
+		Y: units.RadianPerSecond(y).Value,
+		Z: units.RadianPerSecond(z).Value,
+	}
+}
+
+func TestShapes(t *testing.T) {
+	s := SolidSphere(units.Kilogram(2), units.Meter(0.5)).ToArray()
+	if !almostEqual(s[0][0], 0.2, 1e-12) || s[0][1] != 0 {
+		t.Errorf("SolidSphere Ixx = %v, want 0.2", s[0][0])
+	}
+
+	c := SolidCylinder(units.Kilogram(12), units.Meter(1), units.Meter(2)).ToArray()
+	if !almostEqual(c[2][2], 6, 1e-12) || !almostEqual(c[0][0], 7, 1e-12) {
+		t.Errorf("SolidCylinder diag = (%v, %v), want (7, 6)", c[0][0], c[2][2])
+	}
+
+	b := Box(units.Kilogram(12), units.Meter(1), units.Meter(2), units.Meter(3)).ToArray()
+	want := [3]float64{13, 10, 5}
+	for i := 0; i < 3; i++ {
+		if !almostEqual(b[i][i], want[i], 1e-12) {
+			t.Errorf("Box I[%d][%d] = %v, want %v", i, i, b[i][i], want[i])
+		}
+	}
+}
+
+func TestNewInertiaTensor_Validation(t *testing.T) {
+	if _, err := NewInertiaTensor(matrix.Identity()); err == nil {
+		t.Error("NewInertiaTensor should reject a dimensionless matrix")
+	}
+	asym := matrix.New([3][3]float64{{1, 2, 0}, {0, 1, 0}, {0, 0, 1}}, inertiaDim)
+	if _, err := NewInertiaTensor(asym); err == nil {
+		t.Error("NewInertiaTensor should reject an asymmetric matrix")
+	}
+}
+
+func TestParallelAxis(t *testing.T) {
+	// Sphere displaced along x: Iyy and Izz grow by m d², Ixx is unchanged.
+	m := units.Kilogram(2)
+	it := SolidSphere(m, units.Meter(1))
+	d := vector.NewPosition(units.Meter(3), units.Meter(0), units.Meter(0))
+	shifted, err := it.ParallelAxis(m, d)
+	if err != nil {
+		t.Fatalf("ParallelAxis() error = %v", err)
+	}
+	a := shifted.ToArray()
+	if !almostEqual(a[0][0], 0.8, 1e-12) || !almostEqual(a[1][1], 18.8, 1e-12) || !almostEqual(a[2][2], 18.8, 1e-12) {
+		t.Errorf("ParallelAxis diag = (%v, %v, %v), want (0.8, 18.8, 18.8)", a[0][0], a[1][1], a[2][2])
+	}
+
+	if _, err := it.ParallelAxis(m, omega(1, 0, 0)); err == nil {
+		t.Error("ParallelAxis should reject a non-length displacement")
+	}
+}
+
+func TestMomentAbout(t *testing.T) {
+	it := Box(units.Kilogram(12), units.Meter(1), units.Meter(2), units.Meter(3))
+	axis := vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(2))
+	i, err := it.MomentAbout(axis)
+	if err != nil {
+		t.Fatalf("MomentAbout() error = %v", err)
+	}
+	if !almostEqual(i.Val(), 5, 1e-12) {
+		t.Errorf("MomentAbout(z) = %v, want 5", i.Val())
+	}
+}
+
+func TestNewBody_Validation(t *testing.T) {
+	it := SolidSphere(units.Kilogram(1), units.Meter(1))
+	v := vector.NewVelocity(units.MeterPerSecond(1), units.MeterPerSecond(0), units.MeterPerSecond(0))
+	if _, err := NewBody(it, v); err == nil {
+		t.Error("NewBody should reject a non-angular-velocity vector")
+	}
+	singular := PrincipalInertia(units.KilogramMeter2(1), units.KilogramMeter2(1), units.KilogramMeter2(0))
+	if _, err := NewBody(singular, omega(0, 0, 1)); err == nil {
+		t.Error("NewBody should reject a singular inertia tensor")
+	}
+}
+
+func TestBody_EnergyAndMomentum(t *testing.T) {
+	it := PrincipalInertia(units.KilogramMeter2(1), units.KilogramMeter2(2), units.KilogramMeter2(3))
+	b, err := NewBody(it, omega(1, 1, 1))
+	if err != nil {
+		t.Fatalf("NewBody() error = %v", err)
+	}
+	l := b.AngularMomentum().ToArray()
+	if l != [3]float64{1, 2, 3} {
+		t.Errorf("AngularMomentum() = %v, want [1 2 3]", l)
+	}
+	if !almostEqual(b.RotationalEnergy().Val(), 3, 1e-12) {
+		t.Errorf("RotationalEnergy() = %v, want 3", b.RotationalEnergy().Val())
+	}
+}
+
+func TestBody_TorqueFreeConservation(t *testing.T) {
+	// Spin near the intermediate axis: ω flips sign periodically
+	// (Dzhanibekov effect) while |L| and E stay fixed.
+	it := PrincipalInertia(units.KilogramMeter2(1), units.KilogramMeter2(2), units.KilogramMeter2(3))
+	b, _ := NewBody(it, omega(0.01, 1, 0.01))
+	start := b.Invariants()
+
+	flipped := false
+	for i := 0; i < 20000; i++ {
+		b.Step(units.Millisecond(1))
+		if b.AngularVelocity.Y.Val() < -0.5 {
+			flipped = true
+		}
+	}
+
+	if err := b.Invariants().CheckConservation(start, 1e-8); err != nil {
+		t.Error(err)
+	}
+	if !flipped {
+		t.Error("rotation about the intermediate axis should be unstable")
+	}
+}
+
+func TestBody_StableMajorAxis(t *testing.T) {
+	it := PrincipalInertia(units.KilogramMeter2(1), units.KilogramMeter2(2), units.KilogramMeter2(3))
+	b, _ := NewBody(it, omega(0.01, 0.01, 1))
+	for i := 0; i < 20000; i++ {
+		b.Step(units.Millisecond(1))
+	}
+	if b.AngularVelocity.Z.Val() < 0.99 {
+		t.Errorf("rotation about the major axis drifted: ωz = %v", b.AngularVelocity.Z.Val())
+	}
+}
+
+func TestBody_ApplyTorque(t *testing.T) {
+	// Constant torque about a principal axis: ω = τ t / I.
+	b, _ := NewBody(SolidSphere(units.Kilogram(5), units.Meter(1)), omega(0, 0, 0))
+	tau := vector.Vector3{
+		X: units.NewtonMeter(0).Value,
+		Y: units.NewtonMeter(0).Value,
+		Z: units.NewtonMeter(4).Value,
+	}
+	for i := 0; i < 100; i++ {
+		if err := b.ApplyTorque(tau, units.Millisecond(10)); err != nil {
+			t.Fatalf("ApplyTorque() error = %v", err)
+		}
+	}
+	if !almostEqual(b.AngularVelocity.Z.Val(), 2, 1e-10) {
+		t.Errorf("ωz after 1 s = %v, want 2", b.AngularVelocity.Z.Val())
+	}
+
+	f := vector.NewForce(units.Newton(1), units.Newton(0), units.Newton(0))
+	if err := b.ApplyTorque(f, units.Second(1)); err == nil {
+		t.Error("ApplyTorque should reject a force vector")
+	}
+}
+
+func TestInvariants_Drift(t *testing.T) {
+	ref := Invariants{units.KilogramMeter2PerSecond(2), units.Joule(10)}
+	cur := Invariants{units.KilogramMeter2PerSecond(2.002), units.Joule(9.9)}
+	dL, dE := cur.Drift(ref)
+	if math.Abs(dL-1e-3) > 1e-12 || math.Abs(dE+1e-2) > 1e-12 {
+		t.Errorf("Drift() = (%v, %v), want (1e-3, -1e-2)", dL, dE)
+	}
+	if err := cur.CheckConservation(ref, 1e-3); err == nil {
+		t.Error("CheckConservation should fail when energy drifts by 1%")
+	}
+}
@@ -0,0 +1,38 @@
+// Package electrostatics computes electric fields, potentials, forces and
+// energies of static point charges and dipoles in vacuum.
+//
+// A single PointCharge obeys Coulomb's law
+//
+//	E(r) = k_e q (r - r_q) / |r - r_q|³,   V(r) = k_e q / |r - r_q|
+//
+// and a Distribution of charges is handled by superposition. Fields are
+// returned as Vector3 values with dimension [LMT⁻³I⁻¹] (V/m), forces with
+// dimension [LMT⁻²] (N); all positions must have dimension [L].
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/em/electrostatics"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	e := constants.ElementaryCharge
+//	proton := electrostatics.PointCharge{
+//	    Charge:   e,
+//	    Position: vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(0)),
+//	}
+//
+//	// Field 1 Å from a proton (≈ 1.44e11 V/m)
+//	r := vector.NewPosition(units.Angstrom(1), units.Meter(0), units.Meter(0))
+//	field, _ := proton.Field(r)
+//
+//	// Energy of a proton-electron pair 1 Å apart (≈ -14.4 eV)
+//	electron := electrostatics.PointCharge{Charge: units.Coulomb(-e.Val()), Position: r}
+//	u, _ := electrostatics.Distribution{proton, electron}.PotentialEnergy()
+//
+// References:
+//   - Griffiths. "Introduction to Electrodynamics", 4th ed., Ch. 2-3
+//   - Jackson. "Classical Electrodynamics", 3rd ed., Ch. 1 and 4
+package electrostatics
@@ -0,0 +1,297 @@
+package electrostatics
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	lengthDim = units.Dimension{L: 1}
+	fieldDim  = units.Dimension{L: 1, M: 1, T: -3, I: -1} // V/m
+	dipoleDim = units.Dimension{L: 1, T: 1, I: 1}         // C·m
+)
+
+// ke is Coulomb's constant 1/4πε₀ in N·m²/C².
+var ke = constants.CoulombConstant.Val()
+
+// -----------------------------------------------------------------------------
+// Point Charges
+// -----------------------------------------------------------------------------
+
+// PointCharge is a charge q fixed at a position.
+type PointCharge struct {
+	Charge   units.Charge
+	Position vector.Vector3 // [L]
+}
+
+// NewPointCharge creates a point charge, checking that position has
+// dimension [L].
+func NewPointCharge(q units.Charge, position vector.Vector3) (PointCharge, error) {
+	if err := checkPosition(position); err != nil {
+		return PointCharge{}, err
+	}
+	return PointCharge{Charge: q, Position: position}, nil
+}
+
+// Field returns the electric field of the charge at r in V/m.
+// Returns an error if r does not have dimension [L] or coincides with the
+// charge.
+//
+// Formula:
+//
+//	E = k_e q (r - r_q) / |r - r_q|³
+func (q PointCharge) Field(r vector.Vector3) (vector.Vector3, error) {
+	d, dist, err := separation(r, q.Position)
+	if err != nil {
+		return vector.Vector3{}, err
+	}
+	s := ke * q.Charge.Val() / (dist * dist * dist)
+	return field(scale(s, d)), nil
+}
+
+// Potential returns the electric potential V = k_e q / |r - r_q| of the
+// charge at r, taking V = 0 at infinity. Returns an error if r does not
+// have dimension [L] or coincides with the charge.
+func (q PointCharge) Potential(r vector.Vector3) (units.Voltage, error) {
+	_, dist, err := separation(r, q.Position)
+	if err != nil {
+		return units.Voltage{}, err
+	}
+	return units.Volt(ke * q.Charge.Val() / dist), nil
+}
+
+// CoulombForce returns the force exerted on charge on by charge by.
+// Returns an error if either position does not have dimension [L] or the
+// charges coincide.
+//
+// Formula:
+//
+//	F = k_e q₁ q₂ (r₁ - r₂) / |r₁ - r₂|³
+func CoulombForce(on, by PointCharge) (vector.Vector3, error) {
+	e, err := by.Field(on.Position)
+	if err != nil {
+		return vector.Vector3{}, err
+	}
+	return force(scale(on.Charge.Val(), e.ToArray())), nil
+}
+
+// -----------------------------------------------------------------------------
+// Charge Distributions
+// -----------------------------------------------------------------------------
+
+// Distribution is a collection of point charges whose fields and
+// potentials superpose.
+type Distribution []PointCharge
+
+// TotalCharge returns the net charge Σ qᵢ.
+func (d Distribution) TotalCharge() units.Charge {
+	sum := 0.0
+	for _, q := range d {
+		sum += q.Charge.Val()
+	}
+	return units.Coulomb(sum)
+}
+
+// DipoleMoment returns the electric dipole moment p = Σ qᵢ rᵢ in C·m about
+// the origin. It is independent of the origin only when the total charge
+// is zero.
+func (d Distribution) DipoleMoment() vector.Vector3 {
+	var p [3]float64
+	for _, q := range d {
+		p = axpy(q.Charge.Val(), q.Position.ToArray(), p)
+	}
+	return dipole(p)
+}
+
+// Field returns the total electric field at r in V/m.
+// Returns an error if r does not have dimension [L] or coincides with any
+// charge.
+func (d Distribution) Field(r vector.Vector3) (vector.Vector3, error) {
+	var e [3]float64
+	for _, q := range d {
+		qe, err := q.Field(r)
+		if err != nil {
+			return vector.Vector3{}, err
+		}
+		e = axpy(1, qe.ToArray(), e)
+	}
+	return field(e), nil
+}
+
+// Potential returns the total electric potential at r.
+// Returns an error if r does not have dimension [L] or coincides with any
+// charge.
+func (d Distribution) Potential(r vector.Vector3) (units.Voltage, error) {
+	sum := 0.0
+	for _, q := range d {
+		v, err := q.Potential(r)
+		if err != nil {
+			return units.Voltage{}, err
+		}
+		sum += v.Val()
+	}
+	return units.Volt(sum), nil
+}
+
+// ForceOn returns the total force the distribution exerts on a test charge.
+// Returns an error if the test charge coincides with any member charge.
+func (d Distribution) ForceOn(q PointCharge) (vector.Vector3, error) {
+	e, err := d.Field(q.Position)
+	if err != nil {
+		return vector.Vector3{}, err
+	}
+	return force(scale(q.Charge.Val(), e.ToArray())), nil
+}
+
+// PotentialEnergy returns the work needed to assemble the distribution
+// from charges at infinite separation. Returns an error if two charges
+// coincide or a position does not have dimension [L].
+//
+// Formula:
+//
+//	U = Σᵢ<ⱼ k_e qᵢ qⱼ / |rᵢ - rⱼ|
+func (d Distribution) PotentialEnergy() (units.Energy, error) {
+	sum := 0.0
+	for i := range d {
+		for j := i + 1; j < len(d); j++ {
+			_, dist, err := separation(d[i].Position, d[j].Position)
+			if err != nil {
+				return units.Energy{}, fmt.Errorf("charges %d and %d: %w", i, j, err)
+			}
+			sum += ke * d[i].Charge.Val() * d[j].Charge.Val() / dist
+		}
+	}
+	return units.Joule(sum), nil
+}
+
+// -----------------------------------------------------------------------------
+// Dipoles
+// -----------------------------------------------------------------------------
+
+// Dipole is an ideal (point) electric dipole.
+type Dipole struct {
+	Moment   vector.Vector3 // dipole moment p [LTI] (C·m)
+	Position vector.Vector3 // [L]
+}
+
+// NewDipole creates an ideal dipole, checking that moment has dimension
+// [LTI] and position has dimension [L].
+//
+// Example:
+//
+//	// Water molecule: p ≈ 6.2e-30 C·m along z
+//	cm := units.Dimension{L: 1, T: 1, I: 1}
+//	p := vector.Vector3{X: units.NewValue(0, cm), Y: units.NewValue(0, cm), Z: units.NewValue(6.2e-30, cm)}
+//	water, _ := electrostatics.NewDipole(p, origin)
+func NewDipole(moment, position vector.Vector3) (Dipole, error) {
+	if moment.Dim() != dipoleDim {
+		return Dipole{}, fmt.Errorf("dipole moment must have dimension %s, got %s", dipoleDim, moment.Dim())
+	}
+	if err := checkPosition(position); err != nil {
+		return Dipole{}, err
+	}
+	return Dipole{Moment: moment, Position: position}, nil
+}
+
+// Field returns the electric field of the dipole at r in V/m.
+// Returns an error if r does not have dimension [L] or coincides with the
+// dipole.
+//
+// Formula:
+//
+//	E = k_e [3(p·r̂)r̂ - p] / r³
+func (p Dipole) Field(r vector.Vector3) (vector.Vector3, error) {
+	d, dist, err := separation(r, p.Position)
+	if err != nil {
+		return vector.Vector3{}, err
+	}
+	n := scale(1/dist, d)
+	m := p.Moment.ToArray()
+	pn := dot(m, n)
+	s := ke / (dist * dist * dist)
+	return field(scale(s, axpy(-1, m, scale(3*pn, n)))), nil
+}
+
+// Potential returns the electric potential V = k_e (p·r̂) / r² of the
+// dipole at r. Returns an error if r does not have dimension [L] or
+// coincides with the dipole.
+func (p Dipole) Potential(r vector.Vector3) (units.Voltage, error) {
+	d, dist, err := separation(r, p.Position)
+	if err != nil {
+		return units.Voltage{}, err
+	}
+	return units.Volt(ke * dot(p.Moment.ToArray(), d) / (dist * dist * dist)), nil
+}
+
+// PotentialEnergy returns the energy U = -p·E of the dipole in a uniform
+// external field E given in V/m.
+func (p Dipole) PotentialEnergy(e vector.Vector3) units.Energy {
+	return units.Joule(-dot(p.Moment.ToArray(), e.ToArray()))
+}
+
+// Torque returns the torque τ = p × E on the dipole in a uniform external
+// field E given in V/m.
+func (p Dipole) Torque(e vector.Vector3) vector.Vector3 {
+	return p.Moment.Cross(e)
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+func checkPosition(r vector.Vector3) error {
+	if r.Dim() != lengthDim {
+		return fmt.Errorf("position must have dimension %s, got %s", lengthDim, r.Dim())
+	}
+	return nil
+}
+
+// separation returns r - s as an array in meters and its length, or an
+// error if either is not a position or the points coincide.
+func separation(r, s vector.Vector3) ([3]float64, float64, error) {
+	if err := checkPosition(r); err != nil {
+		return [3]float64{}, 0, err
+	}
+	if err := checkPosition(s); err != nil {
+		return [3]float64{}, 0, err
+	}
+	d := axpy(-1, s.ToArray(), r.ToArray())
+	dist := math.Sqrt(dot(d, d))
+	if dist == 0 {
+		return [3]float64{}, 0, fmt.Errorf("field point coincides with source at %v", s)
+	}
+	return d, dist, nil
+}
+
+func field(x [3]float64) vector.Vector3 {
+	return fromArray(x, fieldDim)
+}
+
+func force(x [3]float64) vector.Vector3 {
+	return vector.NewForce(units.Newton(x[0]), units.Newton(x[1]), units.Newton(x[2]))
+}
+
+func dipole(x [3]float64) vector.Vector3 {
+	return fromArray(x, dipoleDim)
+}
+
+func fromArray(x [3]float64, dim units.Dimension) vector.Vector3 {
+	return vector.Vector3{X: units.NewValue(x[0], dim), Y: units.NewValue(x[1], dim), Z: units.NewValue(x[2], dim)}
+}
+
+// axpy returns a·x + y.
+func axpy(a float64, x, y [3]float64) [3]float64 {
+	return [3]float64{a*x[0] + y[0], a*x[1] + y[1], a*x[2] + y[2]}
+}
+
+func scale(a float64, x [3]float64) [3]float64 {
+	return [3]float64{a * x[0], a * x[1], a * x[2]}
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
@@ -0,0 +1,176 @@
+package electrostatics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func pos(x, y, z float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x), units.Meter(y), units.Meter(z))
+}
+
+func TestPointCharge(t *testing.T) {
+	e := constants.ElementaryCharge
+	proton := PointCharge{Charge: e, Position: pos(0, 0, 0)}
+
+	f, err := proton.Field(pos(1e-10, 0, 0))
+	if err != nil {
+		t.Fatalf("Field() error = %v", err)
+	}
+	if f.Dim() != fieldDim || !almostEqual(f.X.Val(), 1.4400e11, 1e-4) || f.Y.Val() != 0 {
+		t.Errorf("Field() = %v, want ≈ (1.44e11, 0, 0) V/m", f)
+	}
+	v, _ := proton.Potential(pos(0, 3e-10, 4e-10))
+	if !almostEqual(v.Val(), ke*e.Val()/5e-10, 1e-12) {
+		t.Errorf("Potential() = %v, want k_e e / 5 Å", v)
+	}
+
+	// Like charges repel; Newton's third law holds.
+	a := PointCharge{Charge: units.Microcoulomb(2), Position: pos(0, 0, 0)}
+	b := PointCharge{Charge: units.Microcoulomb(3), Position: pos(0, 0.5, 0)}
+	fab, _ := CoulombForce(b, a)
+	fba, _ := CoulombForce(a, b)
+	if fab.Dim() != units.Newton(1).Dim() || !almostEqual(fab.Y.Val(), ke*6e-12/0.25, 1e-12) {
+		t.Errorf("CoulombForce() = %v, want +y repulsion", fab)
+	}
+	if fab.Y.Val() != -fba.Y.Val() {
+		t.Errorf("forces not equal and opposite: %v, %v", fab, fba)
+	}
+
+	if _, err := proton.Field(pos(0, 0, 0)); err == nil {
+		t.Error("Field should reject the source position")
+	}
+	if _, err := proton.Field(vector.Zero(units.Dimension{T: 1})); err == nil {
+		t.Error("Field should reject a non-length position")
+	}
+	if _, err := NewPointCharge(e, vector.Zero(units.Dimension{})); err == nil {
+		t.Error("NewPointCharge should reject a dimensionless position")
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	// Symmetric pair: field cancels at the midpoint, potential adds.
+	q := units.Coulomb(1e-9)
+	pair := Distribution{
+		{Charge: q, Position: pos(-1, 0, 0)},
+		{Charge: q, Position: pos(1, 0, 0)},
+	}
+	f, _ := pair.Field(pos(0, 0, 0))
+	if math.Abs(f.X.Val()) > 1e-12 {
+		t.Errorf("midpoint field = %v, want 0", f)
+	}
+	v, _ := pair.Potential(pos(0, 0, 0))
+	if !almostEqual(v.Val(), 2*ke*1e-9, 1e-12) {
+		t.Errorf("midpoint potential = %v, want 2k_e q", v)
+	}
+	if pair.TotalCharge().Val() != 2e-9 {
+		t.Errorf("TotalCharge() = %v, want 2 nC", pair.TotalCharge())
+	}
+
+	// Hydrogen-like pair 1 Å apart: U ≈ -14.40 eV.
+	e := constants.ElementaryCharge.Val()
+	atom := Distribution{
+		{Charge: units.Coulomb(e), Position: pos(0, 0, 0)},
+		{Charge: units.Coulomb(-e), Position: pos(1e-10, 0, 0)},
+	}
+	u, err := atom.PotentialEnergy()
+	if err != nil || !almostEqual(u.ToElectronVolts(), -14.400, 1e-4) {
+		t.Errorf("PotentialEnergy() = %v eV, %v; want -14.40", u.ToElectronVolts(), err)
+	}
+
+	// Square of alternating charges: U = k q² (-4/a + 2/(a√2)).
+	a := 0.1
+	sq := Distribution{
+		{Charge: q, Position: pos(0, 0, 0)},
+		{Charge: units.Coulomb(-1e-9), Position: pos(a, 0, 0)},
+		{Charge: q, Position: pos(a, a, 0)},
+		{Charge: units.Coulomb(-1e-9), Position: pos(0, a, 0)},
+	}
+	u, _ = sq.PotentialEnergy()
+	if want := ke * 1e-18 * (-4/a + 2/(a*math.Sqrt2)); !almostEqual(u.Val(), want, 1e-12) {
+		t.Errorf("square PotentialEnergy() = %v, want %v", u.Val(), want)
+	}
+
+	// Force on a test charge equals q·E.
+	test := PointCharge{Charge: units.Coulomb(-2e-9), Position: pos(0, 2, 0)}
+	fe, _ := pair.Field(test.Position)
+	ft, _ := pair.ForceOn(test)
+	if !almostEqual(ft.Y.Val(), -2e-9*fe.Y.Val(), 1e-12) {
+		t.Errorf("ForceOn() = %v, want qE", ft)
+	}
+
+	// Dipole moment of ±q separated by d along z is q d ẑ.
+	dip := Distribution{
+		{Charge: q, Position: pos(0, 0, 0.005)},
+		{Charge: units.Coulomb(-1e-9), Position: pos(0, 0, -0.005)},
+	}
+	if p := dip.DipoleMoment(); p.Dim() != dipoleDim || !almostEqual(p.Z.Val(), 1e-11, 1e-12) {
+		t.Errorf("DipoleMoment() = %v, want 1e-11 C·m ẑ", p)
+	}
+
+	dup := Distribution{pair[0], pair[0]}
+	if _, err := dup.PotentialEnergy(); err == nil {
+		t.Error("PotentialEnergy should reject coincident charges")
+	}
+	if _, err := pair.Field(pos(1, 0, 0)); err == nil {
+		t.Error("Field should reject a point on a charge")
+	}
+}
+
+func TestDipole(t *testing.T) {
+	// A physical ±q pair converges to the ideal dipole far away.
+	q, d := 1e-9, 1e-4
+	pair := Distribution{
+		{Charge: units.Coulomb(q), Position: pos(0, 0, d/2)},
+		{Charge: units.Coulomb(-q), Position: pos(0, 0, -d/2)},
+	}
+	p, err := NewDipole(pair.DipoleMoment(), pos(0, 0, 0))
+	if err != nil {
+		t.Fatalf("NewDipole() error = %v", err)
+	}
+	for _, r := range []vector.Vector3{pos(0, 0, 1), pos(1, 0, 0), pos(0.3, -0.4, 0.5)} {
+		want, _ := pair.Field(r)
+		got, _ := p.Field(r)
+		wa, ga := want.ToArray(), got.ToArray()
+		for i := range wa {
+			if math.Abs(ga[i]-wa[i]) > 1e-6*math.Sqrt(want.MagnitudeSquared().Val()) {
+				t.Errorf("Field(%v) = %v, want %v", r, got, want)
+				break
+			}
+		}
+		vw, _ := pair.Potential(r)
+		vg, _ := p.Potential(r)
+		if !almostEqual(vg.Val(), vw.Val(), 1e-6) && math.Abs(vw.Val()) > 1e-15 {
+			t.Errorf("Potential(%v) = %v, want %v", r, vg, vw)
+		}
+	}
+
+	// On axis E = 2k p / r³; in the equatorial plane E = -k p / r³.
+	axial, _ := p.Field(pos(0, 0, 2))
+	equatorial, _ := p.Field(pos(2, 0, 0))
+	if !almostEqual(axial.Z.Val(), 2*ke*q*d/8, 1e-12) || !almostEqual(equatorial.Z.Val(), -ke*q*d/8, 1e-12) {
+		t.Errorf("axial, equatorial = %v, %v", axial, equatorial)
+	}
+
+	// In a uniform field along x: U = -pE cos θ, τ = p × E.
+	ex := vector.Vector3{X: units.NewValue(1e3, fieldDim), Y: units.NewValue(0, fieldDim), Z: units.NewValue(0, fieldDim)}
+	if u := p.PotentialEnergy(ex); u.Val() != 0 {
+		t.Errorf("PotentialEnergy(⊥) = %v, want 0", u)
+	}
+	tau := p.Torque(ex)
+	if tau.Dim() != units.NewtonMeter(1).Dim() || !almostEqual(tau.Y.Val(), q*d*1e3, 1e-12) {
+		t.Errorf("Torque() = %v, want p E ŷ", tau)
+	}
+
+	if _, err := NewDipole(pos(0, 0, 1), pos(0, 0, 0)); err == nil {
+		t.Error("NewDipole should reject a length-valued moment")
+	}
+}
@@ -0,0 +1,37 @@
+// Package magnetostatics computes the magnetic fields of steady currents.
+//
+// Arbitrary circuits are described by a CurrentPath, a polyline carrying a
+// current I, whose field is found by integrating the Biot-Savart law
+//
+//	B(r) = (μ₀ I / 4π) ∮ dl × (r - l) / |r - l|³
+//
+// numerically. The standard geometries have closed forms: the infinite
+// straight wire (InfiniteWire), the circular loop on its axis (Loop) and
+// the finite solenoid on its axis (Solenoid). All fields are returned as
+// Vector3 values with dimension [MT⁻²I⁻¹] (T); positions must have
+// dimension [L] and directions may have any dimension.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/em/magnetostatics"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	origin := vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(0))
+//	zAxis, _ := vector.New(units.Dimensionless(0), units.Dimensionless(0), units.Dimensionless(1))
+//
+//	// 10 A loop of radius 5 cm in the xy-plane
+//	loop, _ := magnetostatics.NewLoop(units.Ampere(10), origin, zAxis, units.Centimeter(5))
+//	b := loop.AxisField(units.Centimeter(2))
+//
+//	// The same loop as a 360-sided polygon, integrated numerically
+//	path, _ := loop.Path(360)
+//	r := vector.NewPosition(units.Centimeter(1), units.Meter(0), units.Centimeter(2))
+//	b2, _ := path.Field(r, 8)
+//
+// References:
+//   - Griffiths. "Introduction to Electrodynamics", 4th ed., Ch. 5
+//   - Jackson. "Classical Electrodynamics", 3rd ed., Ch. 5
+package magnetostatics
@@ -0,0 +1,324 @@
+package magnetostatics
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	lengthDim = units.Dimension{L: 1}
+	teslaDim  = units.Dimension{M: 1, T: -2, I: -1}
+)
+
+// mu0 is the vacuum permeability in T·m/A.
+var mu0 = constants.VacuumPermeability.Val()
+
+// -----------------------------------------------------------------------------
+// Biot-Savart Integration
+// -----------------------------------------------------------------------------
+
+// CurrentPath is a thin wire following a polyline through Points and
+// carrying Current from the first point toward the last. A closed path
+// also includes the segment from the last point back to the first.
+type CurrentPath struct {
+	Current units.Current
+	Points  []vector.Vector3 // vertices [L]
+	Closed  bool
+}
+
+// NewCurrentPath creates a current path, checking that there are at least
+// two points and that all have dimension [L].
+func NewCurrentPath(i units.Current, points []vector.Vector3, closed bool) (CurrentPath, error) {
+	if len(points) < 2 {
+		return CurrentPath{}, fmt.Errorf("current path needs at least 2 points, got %d", len(points))
+	}
+	for k, p := range points {
+		if p.Dim() != lengthDim {
+			return CurrentPath{}, fmt.Errorf("point %d must have dimension %s, got %s", k, lengthDim, p.Dim())
+		}
+	}
+	return CurrentPath{Current: i, Points: points, Closed: closed}, nil
+}
+
+// Length returns the total length of the path.
+func (p CurrentPath) Length() units.Length {
+	total := 0.0
+	p.segments(func(a, b [3]float64) {
+		d := sub(b, a)
+		total += math.Sqrt(dot(d, d))
+	})
+	return units.Meter(total)
+}
+
+// Field returns the magnetic field of the path at r, integrating the
+// Biot-Savart law with the midpoint rule on steps sub-elements per
+// segment. Sub-elements whose midpoint coincides with r are skipped.
+// Returns an error if r does not have dimension [L] or steps < 1.
+//
+// The midpoint rule converges as O(h²) in the sub-element length h; keep
+// h well below the distance from r to the wire.
+func (p CurrentPath) Field(r vector.Vector3, steps int) (vector.Vector3, error) {
+	if r.Dim() != lengthDim {
+		return vector.Vector3{}, fmt.Errorf("field point must have dimension %s, got %s", lengthDim, r.Dim())
+	}
+	if steps < 1 {
+		return vector.Vector3{}, fmt.Errorf("steps must be at least 1, got %d", steps)
+	}
+	x := r.ToArray()
+	var b [3]float64
+	p.segments(func(a, c [3]float64) {
+		dl := scale(1/float64(steps), sub(c, a))
+		for k := 0; k < steps; k++ {
+			mid := add(a, scale(float64(k)+0.5, dl))
+			d := sub(x, mid)
+			dist2 := dot(d, d)
+			if dist2 == 0 {
+				continue
+			}
+			b = add(b, scale(1/(dist2*math.Sqrt(dist2)), cross(dl, d)))
+		}
+	})
+	return tesla(scale(mu0*p.Current.Val()/(4*math.Pi), b)), nil
+}
+
+// segments calls f with the endpoints of each straight segment in meters.
+func (p CurrentPath) segments(f func(a, b [3]float64)) {
+	for k := 0; k+1 < len(p.Points); k++ {
+		f(p.Points[k].ToArray(), p.Points[k+1].ToArray())
+	}
+	if p.Closed && len(p.Points) > 2 {
+		f(p.Points[len(p.Points)-1].ToArray(), p.Points[0].ToArray())
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Infinite Straight Wire
+// -----------------------------------------------------------------------------
+
+// InfiniteWire is an infinitely long straight wire through Point carrying
+// Current along Direction.
+type InfiniteWire struct {
+	Current   units.Current
+	Point     vector.Vector3 // any point on the wire [L]
+	Direction vector.Vector3 // dimensionless unit vector along the current
+}
+
+// NewInfiniteWire creates an infinite wire. The direction may have any
+// dimension and need not be normalized; it is stored as a dimensionless
+// unit vector. Returns an error if point is not a position or direction
+// is zero.
+func NewInfiniteWire(i units.Current, point, direction vector.Vector3) (InfiniteWire, error) {
+	if point.Dim() != lengthDim {
+		return InfiniteWire{}, fmt.Errorf("point must have dimension %s, got %s", lengthDim, point.Dim())
+	}
+	u, err := unit(direction)
+	if err != nil {
+		return InfiniteWire{}, err
+	}
+	return InfiniteWire{Current: i, Point: point, Direction: dimensionless(u)}, nil
+}
+
+// Field returns the magnetic field of the wire at r, circling the wire by
+// the right-hand rule. Returns an error if r does not have dimension [L]
+// or lies on the wire.
+//
+// Formula:
+//
+//	B = μ₀ I / (2π s) φ̂
+//
+// where s is the perpendicular distance from the wire.
+func (w InfiniteWire) Field(r vector.Vector3) (vector.Vector3, error) {
+	if r.Dim() != lengthDim {
+		return vector.Vector3{}, fmt.Errorf("field point must have dimension %s, got %s", lengthDim, r.Dim())
+	}
+	u := w.Direction.ToArray()
+	d := sub(r.ToArray(), w.Point.ToArray())
+	s := sub(d, scale(dot(d, u), u)) // perpendicular offset
+	s2 := dot(s, s)
+	if s2 == 0 {
+		return vector.Vector3{}, fmt.Errorf("field point lies on the wire")
+	}
+	return tesla(scale(mu0*w.Current.Val()/(2*math.Pi*s2), cross(u, s))), nil
+}
+
+// -----------------------------------------------------------------------------
+// Circular Loop
+// -----------------------------------------------------------------------------
+
+// Loop is a circular current loop. Current circulates counterclockwise
+// when viewed from the tip of Normal, so the field on the axis points
+// along Normal for positive current.
+type Loop struct {
+	Current units.Current
+	Center  vector.Vector3 // [L]
+	Normal  vector.Vector3 // dimensionless unit normal
+	Radius  units.Length
+}
+
+// NewLoop creates a circular loop. The normal may have any dimension and
+// need not be normalized. Returns an error if center is not a position,
+// normal is zero or radius is not positive.
+func NewLoop(i units.Current, center, normal vector.Vector3, radius units.Length) (Loop, error) {
+	if center.Dim() != lengthDim {
+		return Loop{}, fmt.Errorf("center must have dimension %s, got %s", lengthDim, center.Dim())
+	}
+	n, err := unit(normal)
+	if err != nil {
+		return Loop{}, err
+	}
+	if !(radius.Val() > 0) {
+		return Loop{}, fmt.Errorf("radius must be positive, got %g m", radius.Val())
+	}
+	return Loop{Current: i, Center: center, Normal: dimensionless(n), Radius: radius}, nil
+}
+
+// AxisField returns the field at signed distance z from the center along
+// the normal.
+//
+// Formula:
+//
+//	B = μ₀ I R² / (2 (R² + z²)^{3/2}) n̂
+func (l Loop) AxisField(z units.Length) vector.Vector3 {
+	r2 := l.Radius.Val() * l.Radius.Val()
+	zz := z.Val()
+	b := mu0 * l.Current.Val() * r2 / (2 * math.Pow(r2+zz*zz, 1.5))
+	return tesla(scale(b, l.Normal.ToArray()))
+}
+
+// MagneticMoment returns the magnetic dipole moment m = I π R² n̂ in A·m².
+func (l Loop) MagneticMoment() vector.Vector3 {
+	m := l.Current.Val() * math.Pi * l.Radius.Val() * l.Radius.Val()
+	return fromArray(scale(m, l.Normal.ToArray()), units.Dimension{L: 2, I: 1})
+}
+
+// Path returns the loop approximated as a closed regular polygon with n
+// vertices on the circle, for use with CurrentPath.Field away from the
+// axis. Returns an error if n < 3.
+func (l Loop) Path(n int) (CurrentPath, error) {
+	if n < 3 {
+		return CurrentPath{}, fmt.Errorf("polygon needs at least 3 vertices, got %d", n)
+	}
+	nh := l.Normal.ToArray()
+	// Any vector not parallel to the normal gives an in-plane basis e1, e2
+	// with e1 × e2 = n̂.
+	ref := [3]float64{1, 0, 0}
+	if math.Abs(nh[0]) > 0.9 {
+		ref = [3]float64{0, 1, 0}
+	}
+	e1 := sub(ref, scale(dot(ref, nh), nh))
+	e1 = scale(1/math.Sqrt(dot(e1, e1)), e1)
+	e2 := cross(nh, e1)
+	c, rad := l.Center.ToArray(), l.Radius.Val()
+	points := make([]vector.Vector3, n)
+	for k := range points {
+		phi := 2 * math.Pi * float64(k) / float64(n)
+		points[k] = position(add(c, add(scale(rad*math.Cos(phi), e1), scale(rad*math.Sin(phi), e2))))
+	}
+	return CurrentPath{Current: l.Current, Points: points, Closed: true}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Solenoid
+// -----------------------------------------------------------------------------
+
+// Solenoid is a uniformly wound cylindrical coil of Turns turns centered
+// on Center, with the winding sense of a Loop about Axis.
+type Solenoid struct {
+	Current units.Current
+	Turns   int
+	Center  vector.Vector3 // [L]
+	Axis    vector.Vector3 // dimensionless unit vector
+	Length  units.Length
+	Radius  units.Length
+}
+
+// NewSolenoid creates a solenoid. Returns an error if center is not a
+// position, axis is zero, turns < 1, or length or radius is not positive.
+func NewSolenoid(i units.Current, turns int, center, axis vector.Vector3, length, radius units.Length) (Solenoid, error) {
+	if center.Dim() != lengthDim {
+		return Solenoid{}, fmt.Errorf("center must have dimension %s, got %s", lengthDim, center.Dim())
+	}
+	a, err := unit(axis)
+	if err != nil {
+		return Solenoid{}, err
+	}
+	if turns < 1 {
+		return Solenoid{}, fmt.Errorf("turns must be at least 1, got %d", turns)
+	}
+	if !(length.Val() > 0) || !(radius.Val() > 0) {
+		return Solenoid{}, fmt.Errorf("length and radius must be positive, got %g m and %g m", length.Val(), radius.Val())
+	}
+	return Solenoid{Current: i, Turns: turns, Center: center, Axis: dimensionless(a), Length: length, Radius: radius}, nil
+}
+
+// InteriorField returns the field μ₀ n I deep inside an ideal (infinitely
+// long) solenoid with n = N/L turns per unit length.
+func (s Solenoid) InteriorField() units.MagneticField {
+	return units.Tesla(mu0 * float64(s.Turns) / s.Length.Val() * s.Current.Val())
+}
+
+// AxisField returns the field at signed distance z from the center along
+// the axis of the finite solenoid.
+//
+// Formula:
+//
+//	B = (μ₀ n I / 2) [(z + L/2)/√((z + L/2)² + R²) - (z - L/2)/√((z - L/2)² + R²)] â
+func (s Solenoid) AxisField(z units.Length) vector.Vector3 {
+	half, r2, zz := s.Length.Val()/2, s.Radius.Val()*s.Radius.Val(), z.Val()
+	geom := (zz+half)/math.Sqrt((zz+half)*(zz+half)+r2) - (zz-half)/math.Sqrt((zz-half)*(zz-half)+r2)
+	return tesla(scale(s.InteriorField().Val()*geom/2, s.Axis.ToArray()))
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+// unit returns v normalized as an array, ignoring its dimension.
+func unit(v vector.Vector3) ([3]float64, error) {
+	x := v.ToArray()
+	n := math.Sqrt(dot(x, x))
+	if n == 0 || math.IsNaN(n) || math.IsInf(n, 0) {
+		return [3]float64{}, fmt.Errorf("direction must be a finite nonzero vector, got %v", v)
+	}
+	return scale(1/n, x), nil
+}
+
+func tesla(x [3]float64) vector.Vector3 {
+	return fromArray(x, teslaDim)
+}
+
+func position(x [3]float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x[0]), units.Meter(x[1]), units.Meter(x[2]))
+}
+
+func dimensionless(x [3]float64) vector.Vector3 {
+	return fromArray(x, units.Dimension{})
+}
+
+func fromArray(x [3]float64, dim units.Dimension) vector.Vector3 {
+	return vector.Vector3{X: units.NewValue(x[0], dim), Y: units.NewValue(x[1], dim), Z: units.NewValue(x[2], dim)}
+}
+
+func add(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] + b[0], a[1] + b[1], a[2] + b[2]}
+}
+
+func sub(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func scale(a float64, x [3]float64) [3]float64 {
+	return [3]float64{a * x[0], a * x[1], a * x[2]}
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
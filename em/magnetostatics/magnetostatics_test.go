@@ -0,0 +1,168 @@
+package magnetostatics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func pos(x, y, z float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x), units.Meter(y), units.Meter(z))
+}
+
+func dir(x, y, z float64) vector.Vector3 {
+	return vector.Vector3{X: units.Dimensionless(x), Y: units.Dimensionless(y), Z: units.Dimensionless(z)}
+}
+
+func TestInfiniteWire(t *testing.T) {
+	// 1 A along z, 1 m away on +x: B = 2e-7 T along +y.
+	w, err := NewInfiniteWire(units.Ampere(1), pos(0, 0, 0), pos(0, 0, 3))
+	if err != nil {
+		t.Fatalf("NewInfiniteWire() error = %v", err)
+	}
+	b, err := w.Field(pos(1, 0, 5))
+	if err != nil {
+		t.Fatalf("Field() error = %v", err)
+	}
+	if b.Dim() != units.Tesla(1).Dim() || !almostEqual(b.Y.Val(), 2e-7, 1e-9) || b.X.Val() != 0 || b.Z.Val() != 0 {
+		t.Errorf("Field() = %v, want (0, 2e-7, 0) T", b)
+	}
+
+	// A long finite wire integrated numerically approaches the same field.
+	path, _ := NewCurrentPath(units.Ampere(1), []vector.Vector3{pos(0, 0, -1e3), pos(0, 0, 1e3)}, false)
+	bn, _ := path.Field(pos(1, 0, 0), 200000)
+	if !almostEqual(bn.Y.Val(), 2e-7, 1e-5) {
+		t.Errorf("numerical wire field = %v, want 2e-7 T", bn)
+	}
+
+	if _, err := w.Field(pos(0, 0, 7)); err == nil {
+		t.Error("Field should reject points on the wire")
+	}
+	if _, err := NewInfiniteWire(units.Ampere(1), pos(0, 0, 0), dir(0, 0, 0)); err == nil {
+		t.Error("NewInfiniteWire should reject a zero direction")
+	}
+}
+
+func TestLoop(t *testing.T) {
+	i, r := units.Ampere(10), units.Centimeter(5)
+	loop, err := NewLoop(i, pos(0, 0, 0), dir(0, 0, 2), r)
+	if err != nil {
+		t.Fatalf("NewLoop() error = %v", err)
+	}
+
+	// Center: B = μ₀I/2R.
+	b0 := loop.AxisField(units.Meter(0))
+	if !almostEqual(b0.Z.Val(), mu0*10/(2*0.05), 1e-12) {
+		t.Errorf("center field = %v, want μ₀I/2R", b0)
+	}
+
+	// Numerical Biot-Savart over a fine polygon matches on the axis.
+	path, err := loop.Path(720)
+	if err != nil {
+		t.Fatalf("Path() error = %v", err)
+	}
+	for _, z := range []float64{0, 0.02, -0.1} {
+		want := loop.AxisField(units.Meter(z))
+		got, _ := path.Field(pos(0, 0, z), 4)
+		if !almostEqual(got.Z.Val(), want.Z.Val(), 1e-4) || math.Abs(got.X.Val()) > 1e-12 {
+			t.Errorf("z = %v: numerical %v, analytic %v", z, got, want)
+		}
+	}
+	if !almostEqual(path.Length().Val(), 2*math.Pi*0.05, 1e-4) {
+		t.Errorf("polygon length = %v, want 2πR", path.Length())
+	}
+
+	// Far on the axis the loop is a dipole: B = μ₀ m / (2π z³).
+	m := loop.MagneticMoment()
+	far := loop.AxisField(units.Meter(10))
+	if !almostEqual(far.Z.Val(), mu0*m.Z.Val()/(2*math.Pi*1000), 1e-4) {
+		t.Errorf("far field = %v, want dipole limit", far)
+	}
+
+	// A tilted loop's polygon still produces a field along its normal.
+	tilted, _ := NewLoop(i, pos(1, 2, 3), dir(1, 1, 0), r)
+	tp, _ := tilted.Path(360)
+	axisPoint := pos(1+0.03/math.Sqrt2, 2+0.03/math.Sqrt2, 3)
+	bt, _ := tp.Field(axisPoint, 4)
+	want := tilted.AxisField(units.Meter(0.03))
+	if !almostEqual(bt.X.Val(), want.X.Val(), 1e-4) || !almostEqual(bt.Y.Val(), want.Y.Val(), 1e-4) || math.Abs(bt.Z.Val()) > 1e-9*want.X.Val() {
+		t.Errorf("tilted loop field = %v, want %v", bt, want)
+	}
+
+	if _, err := NewLoop(i, pos(0, 0, 0), dir(0, 0, 1), units.Meter(0)); err == nil {
+		t.Error("NewLoop should reject zero radius")
+	}
+	if _, err := loop.Path(2); err == nil {
+		t.Error("Path should reject fewer than 3 vertices")
+	}
+}
+
+func TestSolenoid(t *testing.T) {
+	// 1000 turns over 1 m at 2 A: μ₀nI ≈ 2.513 mT.
+	s, err := NewSolenoid(units.Ampere(2), 1000, pos(0, 0, 0), dir(0, 0, 1), units.Meter(1), units.Centimeter(1))
+	if err != nil {
+		t.Fatalf("NewSolenoid() error = %v", err)
+	}
+	if !almostEqual(s.InteriorField().Val(), 2.513274e-3, 1e-6) {
+		t.Errorf("InteriorField() = %v, want ≈ 2.513 mT", s.InteriorField())
+	}
+	center := s.AxisField(units.Meter(0))
+	if !almostEqual(center.Z.Val(), s.InteriorField().Val(), 1e-3) {
+		t.Errorf("center field = %v, want ≈ μ₀nI", center)
+	}
+	// At either end of a long solenoid the field is half the interior value.
+	end := s.AxisField(units.Meter(0.5))
+	if !almostEqual(end.Z.Val(), s.InteriorField().Val()/2, 1e-3) {
+		t.Errorf("end field = %v, want ≈ μ₀nI/2", end)
+	}
+
+	// Summing single-turn loops reproduces the finite-solenoid formula.
+	short, _ := NewSolenoid(units.Ampere(1), 200, pos(0, 0, 0), dir(0, 0, 1), units.Centimeter(10), units.Centimeter(3))
+	sum := 0.0
+	for k := 0; k < 200; k++ {
+		zk := -0.05 + (float64(k)+0.5)*0.1/200
+		l, _ := NewLoop(units.Ampere(1), pos(0, 0, zk), dir(0, 0, 1), units.Centimeter(3))
+		sum += l.AxisField(units.Meter(0.02 - zk)).Z.Val()
+	}
+	if got := short.AxisField(units.Meter(0.02)).Z.Val(); !almostEqual(got, sum, 1e-4) {
+		t.Errorf("AxisField = %v, loop sum = %v", got, sum)
+	}
+
+	if _, err := NewSolenoid(units.Ampere(1), 0, pos(0, 0, 0), dir(0, 0, 1), units.Meter(1), units.Meter(1)); err == nil {
+		t.Error("NewSolenoid should reject zero turns")
+	}
+}
+
+func TestCurrentPath(t *testing.T) {
+	// A square loop of side a: B at center = 2√2 μ₀ I / (π a).
+	a := 0.2
+	sq, err := NewCurrentPath(units.Ampere(3), []vector.Vector3{
+		pos(-a/2, -a/2, 0), pos(a/2, -a/2, 0), pos(a/2, a/2, 0), pos(-a/2, a/2, 0),
+	}, true)
+	if err != nil {
+		t.Fatalf("NewCurrentPath() error = %v", err)
+	}
+	b, _ := sq.Field(pos(0, 0, 0), 2000)
+	if want := 2 * math.Sqrt2 * mu0 * 3 / (math.Pi * a); !almostEqual(b.Z.Val(), want, 1e-6) {
+		t.Errorf("square loop center = %v, want %v", b.Z.Val(), want)
+	}
+	if !almostEqual(sq.Length().Val(), 4*a, 1e-12) {
+		t.Errorf("Length() = %v, want 4a", sq.Length())
+	}
+
+	if _, err := NewCurrentPath(units.Ampere(1), []vector.Vector3{pos(0, 0, 0)}, false); err == nil {
+		t.Error("NewCurrentPath should reject a single point")
+	}
+	if _, err := NewCurrentPath(units.Ampere(1), []vector.Vector3{pos(0, 0, 0), dir(1, 0, 0)}, false); err == nil {
+		t.Error("NewCurrentPath should reject non-length points")
+	}
+	if _, err := sq.Field(pos(0, 0, 0), 0); err == nil {
+		t.Error("Field should reject zero steps")
+	}
+}
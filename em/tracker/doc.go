@@ -0,0 +1,44 @@
+// Package tracker integrates the motion of a charged particle in
+// prescribed electric and magnetic fields.
+//
+// The particle obeys the Lorentz force law
+//
+//	d(γmv)/dt = q(E + v × B)
+//
+// with γ = 1 unless relativistic tracking is enabled. Each Step uses the
+// Boris push: half an electric kick, an exact-magnitude rotation about B,
+// and the second half kick, wrapped in a drift-kick-drift position update.
+// The rotation never changes |v|, so the energy in a pure magnetic field is
+// conserved to round-off over arbitrarily many gyrations.
+//
+// Fields are arbitrary functions of position and time (FieldFunc); the
+// fields of packages electrostatics and magnetostatics are easily wrapped.
+// Closed-form helpers give the cyclotron frequency, gyroradius and the
+// E × B and general force drifts against which tracked orbits can be
+// checked with DriftVelocity.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants/particles"
+//	    "github.com/sakiphan/qsim-core/em/tracker"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	bz, _ := vector.New(units.Tesla(0).Value, units.Tesla(0).Value, units.Tesla(1).Value)
+//	b, _ := tracker.UniformMagnetic(bz)
+//	p, _ := particles.Lookup("p")
+//	proton := tracker.Particle{Charge: p.Charge, Mass: units.Mass{Value: p.Mass.Value()}}
+//	t, _ := tracker.New(proton, tracker.Fields{B: b},
+//	    vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(0)),
+//	    vector.NewVelocity(units.MeterPerSecond(1e6), units.MeterPerSecond(0), units.MeterPerSecond(0)),
+//	    false)
+//	states, _ := t.Run(units.Nanosecond(1), 1000)
+//
+// References:
+//   - Boris. "Relativistic plasma simulation — optimization of a hybrid
+//     code", Proc. 4th Conf. Num. Sim. Plasmas (1970)
+//   - Birdsall, Langdon. "Plasma Physics via Computer Simulation", Ch. 4
+//   - Chen. "Introduction to Plasma Physics and Controlled Fusion", 3rd ed., Ch. 2
+package tracker
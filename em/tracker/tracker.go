@@ -0,0 +1,321 @@
+package tracker
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	lengthDim   = units.Dimension{L: 1}
+	velocityDim = units.Dimension{L: 1, T: -1}
+	electricDim = units.Dimension{L: 1, M: 1, T: -3, I: -1} // V/m
+	teslaDim    = units.Dimension{M: 1, T: -2, I: -1}
+	forceDim    = units.Dimension{L: 1, M: 1, T: -2}
+)
+
+// c is the speed of light in m/s.
+var c = constants.SpeedOfLight.Val()
+
+// -----------------------------------------------------------------------------
+// Fields
+// -----------------------------------------------------------------------------
+
+// FieldFunc returns a field vector at position r (meters) and time t.
+type FieldFunc func(r vector.Vector3, t units.Time) vector.Vector3
+
+// Fields holds the electric field E in V/m and magnetic field B in T acting
+// on the particle. A nil function means that field is zero.
+type Fields struct {
+	E FieldFunc
+	B FieldFunc
+}
+
+// UniformElectric returns a FieldFunc for a constant field E.
+// Returns an error if E does not have dimension [LMT⁻³I⁻¹].
+func UniformElectric(e vector.Vector3) (FieldFunc, error) {
+	if e.Dim() != electricDim {
+		return nil, fmt.Errorf("electric field must have dimension %s, got %s", electricDim, e.Dim())
+	}
+	return func(vector.Vector3, units.Time) vector.Vector3 { return e }, nil
+}
+
+// UniformMagnetic returns a FieldFunc for a constant field B.
+// Returns an error if B does not have dimension [MT⁻²I⁻¹].
+func UniformMagnetic(b vector.Vector3) (FieldFunc, error) {
+	if b.Dim() != teslaDim {
+		return nil, fmt.Errorf("magnetic field must have dimension %s, got %s", teslaDim, b.Dim())
+	}
+	return func(vector.Vector3, units.Time) vector.Vector3 { return b }, nil
+}
+
+// eval returns the field in SI units, checking its dimension.
+func eval(f FieldFunc, dim units.Dimension, r vector.Vector3, t units.Time) ([3]float64, error) {
+	if f == nil {
+		return [3]float64{}, nil
+	}
+	v := f(r, t)
+	if v.Dim() != dim {
+		return [3]float64{}, fmt.Errorf("field at %v must have dimension %s, got %s", r, dim, v.Dim())
+	}
+	return v.ToArray(), nil
+}
+
+// -----------------------------------------------------------------------------
+// Tracker
+// -----------------------------------------------------------------------------
+
+// Particle is a point charge with rest mass.
+type Particle struct {
+	Charge units.Charge
+	Mass   units.Mass
+}
+
+// State is the particle's phase-space state at a given time.
+type State struct {
+	Time     units.Time
+	Position vector.Vector3 // [L]
+	Velocity vector.Vector3 // [LT⁻¹]
+}
+
+// Tracker advances a charged particle through Fields with the Boris push.
+//
+// Tracker is not safe for concurrent use.
+type Tracker struct {
+	Particle     Particle
+	Fields       Fields
+	Relativistic bool // use d(γmv)/dt rather than m dv/dt
+
+	t    float64
+	x, u [3]float64 // position and u = γv (v when non-relativistic)
+}
+
+// New creates a tracker for particle p starting at the given position and
+// velocity at time zero. Returns an error if the mass is not positive, the
+// position or velocity has the wrong dimension, or a relativistic
+// particle's speed is not below c.
+func New(p Particle, f Fields, position, velocity vector.Vector3, relativistic bool) (*Tracker, error) {
+	if !(p.Mass.Val() > 0) {
+		return nil, fmt.Errorf("mass must be positive, got %g kg", p.Mass.Val())
+	}
+	if position.Dim() != lengthDim {
+		return nil, fmt.Errorf("position must have dimension %s, got %s", lengthDim, position.Dim())
+	}
+	if velocity.Dim() != velocityDim {
+		return nil, fmt.Errorf("velocity must have dimension %s, got %s", velocityDim, velocity.Dim())
+	}
+	v := velocity.ToArray()
+	u := v
+	if relativistic {
+		beta2 := dot(v, v) / (c * c)
+		if beta2 >= 1 {
+			return nil, fmt.Errorf("speed %g m/s must be less than c", math.Sqrt(dot(v, v)))
+		}
+		u = scale(1/math.Sqrt(1-beta2), v)
+	}
+	return &Tracker{Particle: p, Fields: f, Relativistic: relativistic, x: position.ToArray(), u: u}, nil
+}
+
+// State returns the current time, position and velocity.
+func (tr *Tracker) State() State {
+	return State{
+		Time:     units.Second(tr.t),
+		Position: position(tr.x),
+		Velocity: velocity(scale(1/tr.gamma(), tr.u)),
+	}
+}
+
+// LorentzFactor returns γ = 1/√(1 - v²/c²), or 1 for a non-relativistic
+// tracker.
+func (tr *Tracker) LorentzFactor() float64 {
+	return tr.gamma()
+}
+
+// KineticEnergy returns (γ - 1)mc², or ½mv² for a non-relativistic tracker.
+func (tr *Tracker) KineticEnergy() units.Energy {
+	m := tr.Particle.Mass.Val()
+	if !tr.Relativistic {
+		return units.Joule(0.5 * m * dot(tr.u, tr.u))
+	}
+	// (γ - 1) = u²/c² / (γ + 1) avoids cancellation at low speed.
+	g := tr.gamma()
+	return units.Joule(m * dot(tr.u, tr.u) / (g + 1))
+}
+
+// Step advances the particle by dt. Returns an error if dt is not positive
+// or a field function returns a vector of the wrong dimension.
+//
+// The position drifts half a step, the velocity is updated by the Boris
+// push with the fields at the midpoint, and the position drifts the
+// remaining half step:
+//
+//	u⁻ = u + (q Δt / 2m) E
+//	u⁺ = u⁻ rotated about B by θ = 2 arctan(q Δt |B| / 2γ⁻m)
+//	u' = u⁺ + (q Δt / 2m) E
+func (tr *Tracker) Step(dt units.Time) error {
+	h := dt.Val()
+	if !(h > 0) {
+		return fmt.Errorf("time step must be positive, got %g s", h)
+	}
+	mid := add(tr.x, scale(h/(2*tr.gamma()), tr.u))
+	tm := units.Second(tr.t + h/2)
+	e, err := eval(tr.Fields.E, electricDim, position(mid), tm)
+	if err != nil {
+		return err
+	}
+	b, err := eval(tr.Fields.B, teslaDim, position(mid), tm)
+	if err != nil {
+		return err
+	}
+
+	qm := tr.Particle.Charge.Val() / tr.Particle.Mass.Val() * h / 2
+	um := add(tr.u, scale(qm, e))
+	t := scale(qm/tr.gammaOf(um), b)
+	s := scale(2/(1+dot(t, t)), t)
+	up := add(um, cross(add(um, cross(um, t)), s))
+	tr.u = add(up, scale(qm, e))
+
+	tr.x = add(mid, scale(h/(2*tr.gamma()), tr.u))
+	tr.t += h
+	return nil
+}
+
+// Run takes n steps of size dt and returns the n + 1 states including the
+// starting one. Returns an error if n < 1 or a step fails.
+func (tr *Tracker) Run(dt units.Time, n int) ([]State, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("number of steps must be at least 1, got %d", n)
+	}
+	states := make([]State, 0, n+1)
+	states = append(states, tr.State())
+	for i := 0; i < n; i++ {
+		if err := tr.Step(dt); err != nil {
+			return states, fmt.Errorf("step %d: %w", i, err)
+		}
+		states = append(states, tr.State())
+	}
+	return states, nil
+}
+
+func (tr *Tracker) gamma() float64 {
+	return tr.gammaOf(tr.u)
+}
+
+func (tr *Tracker) gammaOf(u [3]float64) float64 {
+	if !tr.Relativistic {
+		return 1
+	}
+	return math.Sqrt(1 + dot(u, u)/(c*c))
+}
+
+// -----------------------------------------------------------------------------
+// Gyration and Drifts
+// -----------------------------------------------------------------------------
+
+// CyclotronFrequency returns the angular gyration frequency ω_c = |q|B/m of
+// a non-relativistic particle; divide by γ for a relativistic one.
+// Returns an error if the mass is not positive.
+func CyclotronFrequency(p Particle, b units.MagneticField) (units.AngularVelocity, error) {
+	if !(p.Mass.Val() > 0) {
+		return units.AngularVelocity{}, fmt.Errorf("mass must be positive, got %g kg", p.Mass.Val())
+	}
+	return units.RadianPerSecond(math.Abs(p.Charge.Val() * b.Val() / p.Mass.Val())), nil
+}
+
+// Gyroradius returns the Larmor radius r = p⊥ / |q|B of a particle with
+// momentum p⊥ perpendicular to B; this holds relativistically with
+// p⊥ = γmv⊥. Returns an error if q or B is zero.
+func Gyroradius(q units.Charge, pPerp units.Momentum, b units.MagneticField) (units.Length, error) {
+	qb := math.Abs(q.Val() * b.Val())
+	if qb == 0 {
+		return units.Length{}, fmt.Errorf("charge and magnetic field must be nonzero")
+	}
+	return units.Meter(math.Abs(pPerp.Val()) / qb), nil
+}
+
+// ExBDrift returns the guiding-center drift v_E = E × B / B² in crossed
+// fields, independent of charge and mass. Returns an error if E or B has
+// the wrong dimension or B is zero.
+func ExBDrift(e, b vector.Vector3) (vector.Vector3, error) {
+	if e.Dim() != electricDim {
+		return vector.Vector3{}, fmt.Errorf("electric field must have dimension %s, got %s", electricDim, e.Dim())
+	}
+	return drift(e.ToArray(), 1, b)
+}
+
+// ForceDrift returns the guiding-center drift v_F = F × B / (qB²) caused by
+// a constant force F on charge q, for example gravity with F = mg.
+// Returns an error if F or B has the wrong dimension, or q or B is zero.
+func ForceDrift(q units.Charge, f, b vector.Vector3) (vector.Vector3, error) {
+	if f.Dim() != forceDim {
+		return vector.Vector3{}, fmt.Errorf("force must have dimension %s, got %s", forceDim, f.Dim())
+	}
+	if q.Val() == 0 {
+		return vector.Vector3{}, fmt.Errorf("charge must be nonzero")
+	}
+	return drift(f.ToArray(), q.Val(), b)
+}
+
+// drift returns f × B / (q B²) as a velocity.
+func drift(f [3]float64, q float64, b vector.Vector3) (vector.Vector3, error) {
+	if b.Dim() != teslaDim {
+		return vector.Vector3{}, fmt.Errorf("magnetic field must have dimension %s, got %s", teslaDim, b.Dim())
+	}
+	bb := b.ToArray()
+	b2 := dot(bb, bb)
+	if b2 == 0 {
+		return vector.Vector3{}, fmt.Errorf("magnetic field must be nonzero")
+	}
+	return velocity(scale(1/(q*b2), cross(f, bb))), nil
+}
+
+// DriftVelocity returns the mean velocity (x_last - x_first)/(t_last -
+// t_first) over a tracked orbit. Sampled over a whole number of gyration
+// periods it measures the guiding-center drift. Returns an error if fewer
+// than two states are given or no time elapses.
+func DriftVelocity(states []State) (vector.Vector3, error) {
+	if len(states) < 2 {
+		return vector.Vector3{}, fmt.Errorf("need at least 2 states, got %d", len(states))
+	}
+	first, last := states[0], states[len(states)-1]
+	dt := last.Time.Val() - first.Time.Val()
+	if dt == 0 {
+		return vector.Vector3{}, fmt.Errorf("states span no time")
+	}
+	return velocity(scale(1/dt, sub(last.Position.ToArray(), first.Position.ToArray()))), nil
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+func position(x [3]float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x[0]), units.Meter(x[1]), units.Meter(x[2]))
+}
+
+func velocity(x [3]float64) vector.Vector3 {
+	return vector.NewVelocity(units.MeterPerSecond(x[0]), units.MeterPerSecond(x[1]), units.MeterPerSecond(x[2]))
+}
+
+func add(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] + b[0], a[1] + b[1], a[2] + b[2]}
+}
+
+func sub(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func scale(a float64, x [3]float64) [3]float64 {
+	return [3]float64{a * x[0], a * x[1], a * x[2]}
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
@@ -0,0 +1,191 @@
+package tracker
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func fieldOf(dim units.Dimension, x, y, z float64) vector.Vector3 {
+	return vector.Vector3{X: units.NewValue(x, dim), Y: units.NewValue(y, dim), Z: units.NewValue(z, dim)}
+}
+
+var (
+	proton   = catalogParticle("p")
+	electron = catalogParticle("e-")
+	origin   = position([3]float64{})
+)
+
+// catalogParticle returns the charge and mass of a particle from the
+// catalog.
+func catalogParticle(name string) Particle {
+	p, _ := particles.Lookup(name)
+	return Particle{Charge: p.Charge, Mass: units.Mass{Value: p.Mass.Value()}}
+}
+
+func TestGyration(t *testing.T) {
+	b, _ := UniformMagnetic(fieldOf(teslaDim, 0, 0, 1))
+	v0 := 1e6
+	tr, err := New(proton, Fields{B: b}, origin, velocity([3]float64{v0, 0, 0}), false)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	wc, _ := CyclotronFrequency(proton, units.Tesla(1))
+	if !almostEqual(wc.Val(), 9.5788e7, 1e-4) {
+		t.Errorf("CyclotronFrequency() = %v, want ≈ 9.5788e7 rad/s", wc)
+	}
+	r, _ := Gyroradius(proton.Charge, units.KilogramMeterPerSecond(proton.Mass.Val()*v0), units.Tesla(1))
+
+	period := 2 * math.Pi / wc.Val()
+	states, err := tr.Run(units.Second(period/200), 2000)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(states) != 2001 {
+		t.Fatalf("len(states) = %d, want 2001", len(states))
+	}
+
+	// A proton moving along +x in +z field is pushed toward -y and circles
+	// the center (0, -r, 0) at constant speed.
+	ke0 := 0.5 * proton.Mass.Val() * v0 * v0
+	for _, s := range states {
+		p := s.Position.ToArray()
+		if d := math.Hypot(p[0], p[1]+r.Val()); !almostEqual(d, r.Val(), 1e-4) {
+			t.Fatalf("t = %v: distance from center = %v, want %v", s.Time, d, r.Val())
+		}
+	}
+	if !almostEqual(tr.KineticEnergy().Val(), ke0, 1e-12) {
+		t.Errorf("energy drift: %v, want %v", tr.KineticEnergy().Val(), ke0)
+	}
+	if drift, _ := DriftVelocity(states); math.Sqrt(drift.MagnitudeSquared().Val()) > 1e-3*v0 {
+		t.Errorf("pure gyration drift = %v, want ≈ 0", drift)
+	}
+}
+
+func TestRelativisticGyration(t *testing.T) {
+	// A γ = 10 electron gyrates at ω_c/γ on a radius p/(eB).
+	gamma := 10.0
+	v0 := c * math.Sqrt(1-1/(gamma*gamma))
+	b, _ := UniformMagnetic(fieldOf(teslaDim, 0, 0, 0.1))
+	tr, err := New(electron, Fields{B: b}, origin, velocity([3]float64{0, v0, 0}), true)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !almostEqual(tr.LorentzFactor(), gamma, 1e-12) {
+		t.Errorf("LorentzFactor() = %v, want %v", tr.LorentzFactor(), gamma)
+	}
+	wc, _ := CyclotronFrequency(electron, units.Tesla(0.1))
+	r, _ := Gyroradius(electron.Charge, units.KilogramMeterPerSecond(gamma*electron.Mass.Val()*v0), units.Tesla(0.1))
+	period := 2 * math.Pi * gamma / wc.Val()
+
+	ke0 := tr.KineticEnergy().Val()
+	if !almostEqual(ke0, (gamma-1)*electron.Mass.Val()*c*c, 1e-12) {
+		t.Errorf("KineticEnergy() = %v, want (γ-1)mc²", ke0)
+	}
+	states, _ := tr.Run(units.Second(period/500), 500)
+	end := states[len(states)-1]
+	if d := math.Sqrt(end.Position.MagnitudeSquared().Val()); d > 1e-4*r.Val() {
+		t.Errorf("after one relativistic period |x| = %v, want ≈ 0 (r = %v)", d, r.Val())
+	}
+	var maxDist float64
+	for _, s := range states {
+		maxDist = math.Max(maxDist, math.Sqrt(s.Position.MagnitudeSquared().Val()))
+	}
+	if !almostEqual(maxDist, 2*r.Val(), 1e-4) {
+		t.Errorf("orbit diameter = %v, want %v", maxDist, 2*r.Val())
+	}
+	if !almostEqual(tr.KineticEnergy().Val(), ke0, 1e-12) {
+		t.Errorf("relativistic energy drift: %v, want %v", tr.KineticEnergy().Val(), ke0)
+	}
+
+	// The same orbit without the relativistic correction is γ times smaller.
+	nr, _ := New(electron, Fields{B: b}, origin, velocity([3]float64{0, v0, 0}), false)
+	nr.Run(units.Second(period/500/gamma), 500)
+	if d := math.Sqrt(nr.State().Position.MagnitudeSquared().Val()); d > 1e-4*r.Val()/gamma {
+		t.Errorf("non-relativistic period mismatch: |x| = %v", d)
+	}
+}
+
+func TestDrifts(t *testing.T) {
+	e := fieldOf(electricDim, 0, 1e5, 0)
+	bv := fieldOf(teslaDim, 0, 0, 1)
+	want, err := ExBDrift(e, bv)
+	if err != nil || !almostEqual(want.X.Val(), 1e5, 1e-12) || want.Y.Val() != 0 {
+		t.Fatalf("ExBDrift() = %v, %v; want (1e5, 0, 0) m/s", want, err)
+	}
+
+	// Both signs of charge drift the same way; sample whole periods.
+	ef, _ := UniformElectric(e)
+	bf, _ := UniformMagnetic(bv)
+	for _, p := range []Particle{proton, electron} {
+		tr, _ := New(p, Fields{E: ef, B: bf}, origin, velocity([3]float64{}), false)
+		wc, _ := CyclotronFrequency(p, units.Tesla(1))
+		n := 400
+		// Boris gyrates at 2 arctan(ω dt / 2) per step; pick dt so n steps
+		// is exactly one discrete period.
+		dt := 2 * math.Tan(math.Pi/float64(n)) / wc.Val()
+		states, _ := tr.Run(units.Second(dt), 5*n)
+		got, _ := DriftVelocity(states)
+		if !almostEqual(got.X.Val(), want.X.Val(), 1e-9) || math.Abs(got.Y.Val()) > 1e-9*want.X.Val() {
+			t.Errorf("charge %v: tracked drift = %v, want %v", p.Charge, got, want)
+		}
+	}
+
+	// Gravity drift F × B / qB² reverses with charge sign.
+	g := vector.NewForce(units.Newton(0), units.Newton(-proton.Mass.Val()*9.81), units.Newton(0))
+	dp, _ := ForceDrift(proton.Charge, g, bv)
+	de, _ := ForceDrift(electron.Charge, g, bv)
+	if dp.X.Val() >= 0 || de.X.Val() <= 0 {
+		t.Errorf("gravity drifts = %v, %v; want opposite signs", dp, de)
+	}
+
+	if _, err := ExBDrift(bv, bv); err == nil {
+		t.Error("ExBDrift should reject a magnetic field as E")
+	}
+	if _, err := ExBDrift(e, fieldOf(teslaDim, 0, 0, 0)); err == nil {
+		t.Error("ExBDrift should reject zero B")
+	}
+	if _, err := ForceDrift(units.Coulomb(0), g, bv); err == nil {
+		t.Error("ForceDrift should reject zero charge")
+	}
+	if _, err := DriftVelocity([]State{{}}); err == nil {
+		t.Error("DriftVelocity should reject a single state")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := New(Particle{Charge: proton.Charge}, Fields{}, origin, velocity([3]float64{}), false); err == nil {
+		t.Error("New should reject zero mass")
+	}
+	if _, err := New(proton, Fields{}, velocity([3]float64{}), velocity([3]float64{}), false); err == nil {
+		t.Error("New should reject a non-length position")
+	}
+	if _, err := New(proton, Fields{}, origin, velocity([3]float64{c, 0, 0}), true); err == nil {
+		t.Error("New should reject v ≥ c when relativistic")
+	}
+	if _, err := UniformElectric(fieldOf(teslaDim, 0, 0, 1)); err == nil {
+		t.Error("UniformElectric should reject a tesla-valued field")
+	}
+
+	bad := func(vector.Vector3, units.Time) vector.Vector3 { return fieldOf(electricDim, 0, 0, 1) }
+	tr, _ := New(proton, Fields{B: bad}, origin, velocity([3]float64{}), false)
+	if err := tr.Step(units.Nanosecond(1)); err == nil {
+		t.Error("Step should reject a field function of the wrong dimension")
+	}
+	if err := tr.Step(units.Second(0)); err == nil {
+		t.Error("Step should reject a zero time step")
+	}
+	if _, err := tr.Run(units.Nanosecond(1), 0); err == nil {
+		t.Error("Run should reject zero steps")
+	}
+	if _, err := Gyroradius(proton.Charge, units.KilogramMeterPerSecond(1), units.Tesla(0)); err == nil {
+		t.Error("Gyroradius should reject zero B")
+	}
+}
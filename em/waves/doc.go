@@ -0,0 +1,33 @@
+// Package waves relates the fields, intensity and momentum of
+// electromagnetic plane waves in vacuum, the power radiated by accelerated
+// charges and oscillating dipoles, and free-space radio links.
+//
+// For a linearly polarized plane wave with peak field E₀ the peak magnetic
+// field is B₀ = E₀/c and the time-averaged intensity is
+//
+//	I = ½ c ε₀ E₀²
+//
+// Intensities are Irradiance values (W/m²); radiation pressures are
+// Pressure values; radiated powers are Power values.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/em/waves"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Sunlight at Earth: 1361 W/m² has E₀ ≈ 1.01 kV/m
+//	e0 := waves.FieldAmplitude(units.WattPerMeter2(1361))
+//
+//	// Pressure on a perfect mirror facing the Sun (≈ 9.08 μPa)
+//	p, _ := waves.RadiationPressure(units.WattPerMeter2(1361), 1)
+//
+//	// 2.4 GHz link over 100 m
+//	loss, _ := waves.FreeSpacePathLoss(units.Meter(100), units.Gigahertz(2.4)) // ≈ 80.1 dB
+//
+// References:
+//   - Griffiths. "Introduction to Electrodynamics", 4th ed., Ch. 9 and 11
+//   - Jackson. "Classical Electrodynamics", 3rd ed., Ch. 7 and 9
+//   - Friis. "A Note on a Simple Transmission Formula", Proc. IRE 34, 254 (1946)
+package waves
@@ -0,0 +1,161 @@
+package waves
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	c    = constants.SpeedOfLight.Val()
+	eps0 = constants.VacuumPermittivity.Val()
+)
+
+// dipoleDim is the dimension of an electric dipole moment, [LTI] (C·m).
+var dipoleDim = units.Dimension{L: 1, T: 1, I: 1}
+
+// -----------------------------------------------------------------------------
+// Plane Waves
+// -----------------------------------------------------------------------------
+
+// ImpedanceOfFreeSpace returns Z₀ = 1/(ε₀c) ≈ 376.73 Ω, the ratio E/H of a
+// plane wave in vacuum.
+func ImpedanceOfFreeSpace() units.Resistance {
+	return units.Ohm(1 / (eps0 * c))
+}
+
+// Wavelength returns the vacuum wavelength λ = c/f.
+// Returns an error if f is not positive.
+func Wavelength(f units.Frequency) (units.Length, error) {
+	if !(f.Val() > 0) {
+		return units.Length{}, fmt.Errorf("frequency must be positive, got %g Hz", f.Val())
+	}
+	return units.Meter(c / f.Val()), nil
+}
+
+// MagneticAmplitude returns the magnetic field B = E/c accompanying an
+// electric field E in a vacuum plane wave.
+func MagneticAmplitude(e units.ElectricField) units.MagneticField {
+	return units.Tesla(e.Val() / c)
+}
+
+// ElectricAmplitude returns the electric field E = cB accompanying a
+// magnetic field B in a vacuum plane wave.
+func ElectricAmplitude(b units.MagneticField) units.ElectricField {
+	return units.VoltPerMeter(b.Val() * c)
+}
+
+// Intensity returns the time-averaged intensity I = ½ c ε₀ E₀² of a
+// linearly polarized plane wave with peak electric field E₀.
+func Intensity(e0 units.ElectricField) units.Irradiance {
+	return units.WattPerMeter2(0.5 * c * eps0 * e0.Val() * e0.Val())
+}
+
+// FieldAmplitude returns the peak electric field E₀ = √(2I / cε₀) of a
+// linearly polarized plane wave of intensity I; the inverse of Intensity.
+// Negative intensities give NaN.
+func FieldAmplitude(i units.Irradiance) units.ElectricField {
+	return units.VoltPerMeter(math.Sqrt(2 * i.Val() / (c * eps0)))
+}
+
+// EnergyDensity returns the time-averaged electromagnetic energy density
+// u = I/c of a plane wave, in J/m³ (equal to Pa).
+func EnergyDensity(i units.Irradiance) units.Pressure {
+	return units.Pascal(i.Val() / c)
+}
+
+// RadiationPressure returns the pressure exerted by a plane wave of
+// intensity I at normal incidence on a surface with reflectivity R,
+// from 0 (perfect absorber) to 1 (perfect mirror).
+// Returns an error if I is negative or R is outside [0, 1].
+//
+// Formula:
+//
+//	P = (1 + R) I / c
+func RadiationPressure(i units.Irradiance, reflectivity float64) (units.Pressure, error) {
+	if i.Val() < 0 {
+		return units.Pressure{}, fmt.Errorf("intensity must be non-negative, got %g W/m²", i.Val())
+	}
+	if !(reflectivity >= 0 && reflectivity <= 1) {
+		return units.Pressure{}, fmt.Errorf("reflectivity must be in [0, 1], got %g", reflectivity)
+	}
+	return units.Pascal((1 + reflectivity) * i.Val() / c), nil
+}
+
+// -----------------------------------------------------------------------------
+// Radiating Sources
+// -----------------------------------------------------------------------------
+
+// LarmorPower returns the total power radiated by a non-relativistic point
+// charge q with acceleration a.
+//
+// Formula:
+//
+//	P = q² a² / (6π ε₀ c³)
+func LarmorPower(q units.Charge, a units.Acceleration) units.Power {
+	qa := q.Val() * a.Val()
+	return units.Watt(qa * qa / (6 * math.Pi * eps0 * c * c * c))
+}
+
+// DipolePower returns the time-averaged power radiated by an electric
+// dipole p(t) = p₀ cos ωt, with p₀ in C·m.
+// Returns an error if p₀ does not have dimension [LTI].
+//
+// Formula:
+//
+//	⟨P⟩ = p₀² ω⁴ / (12π ε₀ c³)
+func DipolePower(p0 units.Value, omega units.AngularVelocity) (units.Power, error) {
+	if p0.Dim() != dipoleDim {
+		return units.Power{}, fmt.Errorf("dipole moment must have dimension %s, got %s", dipoleDim, p0.Dim())
+	}
+	w2 := omega.Val() * omega.Val()
+	return units.Watt(p0.Val() * p0.Val() * w2 * w2 / (12 * math.Pi * eps0 * c * c * c)), nil
+}
+
+// -----------------------------------------------------------------------------
+// Radio Links
+// -----------------------------------------------------------------------------
+
+// FreeSpacePathLoss returns the free-space path loss in dB between
+// isotropic antennas a distance d apart at frequency f.
+// Returns an error unless d and f are positive.
+//
+// Formula:
+//
+//	FSPL = 20 log₁₀(4π d f / c)
+func FreeSpacePathLoss(d units.Length, f units.Frequency) (float64, error) {
+	if err := checkLink(d, f); err != nil {
+		return 0, err
+	}
+	return 20 * math.Log10(4*math.Pi*d.Val()*f.Val()/c), nil
+}
+
+// FriisReceivedPower returns the power received over a free-space link
+// with transmitted power Pₜ and linear (not dB) antenna gains Gₜ and Gᵣ.
+// Returns an error unless d, f and both gains are positive.
+//
+// Formula:
+//
+//	Pᵣ = Pₜ Gₜ Gᵣ (λ / 4π d)²
+func FriisReceivedPower(pt units.Power, gt, gr float64, d units.Length, f units.Frequency) (units.Power, error) {
+	if err := checkLink(d, f); err != nil {
+		return units.Power{}, err
+	}
+	if !(gt > 0) || !(gr > 0) {
+		return units.Power{}, fmt.Errorf("antenna gains must be positive, got %g and %g", gt, gr)
+	}
+	ratio := c / (4 * math.Pi * d.Val() * f.Val())
+	return units.Watt(pt.Val() * gt * gr * ratio * ratio), nil
+}
+
+func checkLink(d units.Length, f units.Frequency) error {
+	if !(d.Val() > 0) {
+		return fmt.Errorf("distance must be positive, got %g m", d.Val())
+	}
+	if !(f.Val() > 0) {
+		return fmt.Errorf("frequency must be positive, got %g Hz", f.Val())
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package waves
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestPlaneWave(t *testing.T) {
+	if z := ImpedanceOfFreeSpace(); !almostEqual(z.Val(), 376.730313, 1e-8) {
+		t.Errorf("Z₀ = %v, want 376.730313 Ω", z)
+	}
+	lam, err := Wavelength(units.Megahertz(100))
+	if err != nil || !almostEqual(lam.Val(), 2.99792458, 1e-12) {
+		t.Errorf("Wavelength(100 MHz) = %v, %v; want 2.998 m", lam, err)
+	}
+
+	e := units.VoltPerMeter(300)
+	b := MagneticAmplitude(e)
+	if b.Dim() != units.Tesla(1).Dim() || !almostEqual(b.Val(), 1.000692e-6, 1e-6) {
+		t.Errorf("B = %v, want ≈ 1.0007 μT", b)
+	}
+	if back := ElectricAmplitude(b); !almostEqual(back.Val(), 300, 1e-14) {
+		t.Errorf("E(B(E)) = %v, want 300 V/m", back)
+	}
+
+	// Sunlight: 1361 W/m² ↔ E₀ ≈ 1012.6 V/m.
+	sun := units.WattPerMeter2(1361)
+	e0 := FieldAmplitude(sun)
+	if !almostEqual(e0.Val(), 1012.6, 1e-4) {
+		t.Errorf("FieldAmplitude(1361 W/m²) = %v, want ≈ 1012.6 V/m", e0)
+	}
+	if i := Intensity(e0); !almostEqual(i.Val(), 1361, 1e-12) || i.Dim() != sun.Dim() {
+		t.Errorf("Intensity(E₀) = %v, want 1361 W/m²", i)
+	}
+	// I = ½ E₀ B₀ / μ₀ as well.
+	if i := 0.5 * e0.Val() * MagneticAmplitude(e0).Val() / constants.VacuumPermeability.Val(); !almostEqual(i, 1361, 1e-9) {
+		t.Errorf("E₀B₀/2μ₀ = %v, want 1361", i)
+	}
+	if u := EnergyDensity(sun); !almostEqual(u.Val(), 1361/299792458.0, 1e-12) {
+		t.Errorf("EnergyDensity() = %v", u)
+	}
+}
+
+func TestRadiationPressure(t *testing.T) {
+	sun := units.WattPerMeter2(1361)
+	absorb, _ := RadiationPressure(sun, 0)
+	mirror, err := RadiationPressure(sun, 1)
+	if err != nil || !almostEqual(absorb.Val(), 4.54e-6, 1e-3) || !almostEqual(mirror.Val(), 2*absorb.Val(), 1e-14) {
+		t.Errorf("pressures = %v, %v; want 4.54 μPa and double", absorb, mirror)
+	}
+	if _, err := RadiationPressure(sun, 1.5); err == nil {
+		t.Error("RadiationPressure should reject R > 1")
+	}
+	if _, err := RadiationPressure(units.WattPerMeter2(-1), 0); err == nil {
+		t.Error("RadiationPressure should reject negative intensity")
+	}
+}
+
+func TestRadiatingSources(t *testing.T) {
+	e := constants.ElementaryCharge
+	a := units.MeterPerSecond2(1e20)
+	p := LarmorPower(e, a)
+	want := e.Val() * e.Val() * 1e40 / (6 * math.Pi * eps0 * math.Pow(c, 3))
+	if !almostEqual(p.Val(), want, 1e-12) || p.Dim() != units.Watt(1).Dim() {
+		t.Errorf("LarmorPower() = %v, want %v W", p, want)
+	}
+
+	// A charge in circular motion x = d cos ωt has a = ω²d, and its dipole
+	// p₀ = qd radiates the time average of the Larmor power: ½ q²ω⁴d² / 6πε₀c³.
+	d, omega := 1e-10, 1e15
+	pd, err := DipolePower(units.NewValue(e.Val()*d, dipoleDim), units.RadianPerSecond(omega))
+	if err != nil {
+		t.Fatalf("DipolePower() error = %v", err)
+	}
+	larmor := LarmorPower(e, units.MeterPerSecond2(omega*omega*d))
+	if !almostEqual(pd.Val(), larmor.Val()/2, 1e-12) {
+		t.Errorf("DipolePower() = %v, want half peak Larmor %v", pd, larmor)
+	}
+	if _, err := DipolePower(units.Coulomb(1).Value, units.RadianPerSecond(omega)); err == nil {
+		t.Error("DipolePower should reject a charge as dipole moment")
+	}
+}
+
+func TestLinks(t *testing.T) {
+	loss, err := FreeSpacePathLoss(units.Meter(100), units.Gigahertz(2.4))
+	if err != nil || !almostEqual(loss, 80.05, 1e-3) {
+		t.Errorf("FSPL = %v dB, %v; want ≈ 80.05", loss, err)
+	}
+	// Doubling distance adds 6.02 dB.
+	loss2, _ := FreeSpacePathLoss(units.Meter(200), units.Gigahertz(2.4))
+	if !almostEqual(loss2-loss, 20*math.Log10(2), 1e-12) {
+		t.Errorf("distance doubling adds %v dB", loss2-loss)
+	}
+
+	// Friis with unit gains agrees with the path loss.
+	pr, _ := FriisReceivedPower(units.Watt(1), 1, 1, units.Meter(100), units.Gigahertz(2.4))
+	if !almostEqual(-10*math.Log10(pr.Val()), loss, 1e-12) {
+		t.Errorf("Friis loss = %v dB, want %v", -10*math.Log10(pr.Val()), loss)
+	}
+	pg, _ := FriisReceivedPower(units.Watt(1), 10, 100, units.Meter(100), units.Gigahertz(2.4))
+	if !almostEqual(pg.Val(), 1000*pr.Val(), 1e-12) {
+		t.Errorf("gains not applied: %v", pg)
+	}
+
+	if _, err := FreeSpacePathLoss(units.Meter(0), units.Gigahertz(1)); err == nil {
+		t.Error("FreeSpacePathLoss should reject zero distance")
+	}
+	if _, err := FriisReceivedPower(units.Watt(1), 0, 1, units.Meter(1), units.Gigahertz(1)); err == nil {
+		t.Error("FriisReceivedPower should reject zero gain")
+	}
+	if _, err := Wavelength(units.Hertz(0)); err == nil {
+		t.Error("Wavelength should reject zero frequency")
+	}
+}
@@ -0,0 +1,137 @@
+package fluid
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Bernoulli Equation
+// -----------------------------------------------------------------------------
+
+// Point is the state of an incompressible fluid at one point on a
+// streamline: static pressure, flow speed and elevation.
+type Point struct {
+	Pressure units.Pressure
+	Velocity units.Velocity
+	Height   units.Length
+}
+
+// TotalPressure returns p + ½ρv² + ρgz, which is constant along a
+// streamline of steady, inviscid, incompressible flow.
+func TotalPressure(rho units.Density, g units.Acceleration, p Point) units.Pressure {
+	v := p.Velocity.Val()
+	return units.Pascal(p.Pressure.Val() + 0.5*rho.Val()*v*v + rho.Val()*g.Val()*p.Height.Val())
+}
+
+// Bernoulli returns p with its single unset (zero-value) field computed so
+// that its total pressure equals that of the fully specified point known.
+// A solved velocity is the non-negative flow speed. Returns an error
+// unless exactly one field of p is unset and known is complete, if ρ or g
+// is not positive, or if no real speed satisfies the balance.
+//
+// Formula:
+//
+//	p₁ + ½ρv₁² + ρgz₁ = p₂ + ½ρv₂² + ρgz₂
+//
+// Example:
+//
+//	// Torricelli: efflux 2 m below the free surface of an open tank
+//	out, _ := fluid.Bernoulli(fluid.Water.Density, constants.StandardGravity,
+//	    fluid.Point{Pressure: units.Atmosphere(1), Velocity: units.MeterPerSecond(0), Height: units.Meter(2)},
+//	    fluid.Point{Pressure: units.Atmosphere(1), Height: units.Meter(0)})
+//	fmt.Println(out.Velocity) // ≈ 6.26 m/s
+func Bernoulli(rho units.Density, g units.Acceleration, known, p Point) (Point, error) {
+	if !(rho.Val() > 0) || !(g.Val() > 0) {
+		return Point{}, fmt.Errorf("density and gravity must be positive, got %g kg/m³ and %g m/s²", rho.Val(), g.Val())
+	}
+	if n := unsetFields(known); n != 0 {
+		return Point{}, fmt.Errorf("known point must be fully specified, got %d unset fields", n)
+	}
+	if n := unsetFields(p); n != 1 {
+		return Point{}, fmt.Errorf("exactly one field of the unknown point must be unset, got %d", n)
+	}
+
+	r, gv := rho.Val(), g.Val()
+	total := TotalPressure(rho, g, known).Val()
+	v := p.Velocity.Val()
+	switch {
+	case p.Pressure.Value == (units.Value{}):
+		p.Pressure = units.Pascal(total - 0.5*r*v*v - r*gv*p.Height.Val())
+	case p.Velocity.Value == (units.Value{}):
+		dynamic := total - p.Pressure.Val() - r*gv*p.Height.Val()
+		if dynamic < 0 {
+			return Point{}, fmt.Errorf("no real flow speed: dynamic pressure would be %g Pa", dynamic)
+		}
+		p.Velocity = units.MeterPerSecond(math.Sqrt(2 * dynamic / r))
+	default:
+		p.Height = units.Meter((total - p.Pressure.Val() - 0.5*r*v*v) / (r * gv))
+	}
+	return p, nil
+}
+
+func unsetFields(p Point) int {
+	n := 0
+	for _, v := range []units.Value{p.Pressure.Value, p.Velocity.Value, p.Height.Value} {
+		if v == (units.Value{}) {
+			n++
+		}
+	}
+	return n
+}
+
+// -----------------------------------------------------------------------------
+// Pipe Flow
+// -----------------------------------------------------------------------------
+
+// FlowVelocity returns the mean speed v = Q/A of a flow rate Q through a
+// cross-section of area A. Returns an error if A is not positive.
+func FlowVelocity(q units.VolumetricFlowRate, a units.Area) (units.Velocity, error) {
+	if !(a.Val() > 0) {
+		return units.Velocity{}, fmt.Errorf("area must be positive, got %g m²", a.Val())
+	}
+	return units.MeterPerSecond(q.Val() / a.Val()), nil
+}
+
+// PipeArea returns the cross-sectional area πd²/4 of a pipe of inner
+// diameter d.
+func PipeArea(d units.Length) units.Area {
+	return units.SquareMeter(math.Pi * d.Val() * d.Val() / 4)
+}
+
+// HagenPoiseuille returns the volumetric flow rate of laminar flow driven
+// by pressure drop Δp through a pipe of radius r and length L. Valid for
+// Reynolds numbers (based on diameter) below about 2300. Returns an error
+// if μ, r or L is not positive.
+//
+// Formula:
+//
+//	Q = π r⁴ Δp / (8 μ L)
+func HagenPoiseuille(mu units.Viscosity, radius, length units.Length, dp units.Pressure) (units.VolumetricFlowRate, error) {
+	if err := checkPipe(mu, radius, length); err != nil {
+		return units.VolumetricFlowRate{}, err
+	}
+	return units.CubicMeterPerSecond(math.Pi * math.Pow(radius.Val(), 4) * dp.Val() / (8 * mu.Val() * length.Val())), nil
+}
+
+// PoiseuillePressureDrop returns the pressure drop Δp = 8 μ L Q / (π r⁴)
+// needed to drive laminar flow rate Q through a pipe of radius r and
+// length L. Returns an error if μ, r or L is not positive.
+func PoiseuillePressureDrop(mu units.Viscosity, radius, length units.Length, q units.VolumetricFlowRate) (units.Pressure, error) {
+	if err := checkPipe(mu, radius, length); err != nil {
+		return units.Pressure{}, err
+	}
+	return units.Pascal(8 * mu.Val() * length.Val() * q.Val() / (math.Pi * math.Pow(radius.Val(), 4))), nil
+}
+
+func checkPipe(mu units.Viscosity, radius, length units.Length) error {
+	if !(mu.Val() > 0) {
+		return fmt.Errorf("viscosity must be positive, got %g Pa⋅s", mu.Val())
+	}
+	if !(radius.Val() > 0) || !(length.Val() > 0) {
+		return fmt.Errorf("pipe radius and length must be positive, got %g m and %g m", radius.Val(), length.Val())
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// Package fluid provides dimensionless numbers and simple flow relations
+// for Newtonian fluids.
+//
+// Fluid collects the material properties the numbers depend on; Water and
+// Air give values at 20 °C and 1 atm. The Reynolds, Mach, Froude, Prandtl
+// and Rayleigh numbers are returned as dimensionless units.Value so they
+// compose with the rest of the units package.
+//
+// Stokes drag and terminal velocity assume creeping flow around a sphere,
+// valid for particle Reynolds numbers below about 1; check the result with
+// Reynolds.
+//
+// Bernoulli solves the steady, inviscid, incompressible energy balance
+// along a streamline for whichever of pressure, speed or height is left
+// unset at a Point. HagenPoiseuille gives laminar flow through a circular
+// pipe, with flow rates as units.VolumetricFlowRate.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/fluid"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Water at 1 m/s in a 5 cm pipe is turbulent
+//	re, _ := fluid.Reynolds(fluid.Water, units.MeterPerSecond(1), units.Centimeter(5)) // ≈ 5e4
+//
+//	// A 20 μm water droplet falling in air
+//	v, _ := fluid.TerminalVelocity(fluid.Air, units.KilogramPerMeter3(1000),
+//	    units.Micrometer(10), constants.StandardGravity) // ≈ 1.2 cm/s
+//
+//	// 1 m of 2 mm tubing at 1 kPa carries ≈ 23.5 mL/min of water
+//	q, _ := fluid.HagenPoiseuille(fluid.Water.Viscosity, units.Millimeter(1), units.Meter(1), units.Pascal(1000))
+//
+// References:
+//   - White. "Fluid Mechanics", 8th ed., Ch. 1, 5, 7
+//   - Incropera et al. "Fundamentals of Heat and Mass Transfer", 7th ed.,
+//     Table A.6, A.4
+package fluid
@@ -0,0 +1,192 @@
+package fluid
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// expansionDim is the dimension of a thermal expansion coefficient, [Θ⁻¹].
+var expansionDim = units.Dimension{Θ: -1}
+
+// Fluid holds the material properties of a Newtonian fluid.
+type Fluid struct {
+	Density      units.Density
+	Viscosity    units.Viscosity // dynamic viscosity μ
+	SpecificHeat units.SpecificHeat
+	Conductivity units.ThermalConductivity
+	Expansion    units.Value // volumetric thermal expansion coefficient β [K⁻¹]
+	SoundSpeed   units.Velocity
+}
+
+// Properties at 20 °C and 1 atm.
+var (
+	Water = Fluid{
+		Density:      units.KilogramPerMeter3(998.2),
+		Viscosity:    units.PascalSecond(1.002e-3),
+		SpecificHeat: units.JoulePerKilogramKelvin(4182),
+		Conductivity: units.WattPerMeterKelvin(0.598),
+		Expansion:    units.NewValue(2.07e-4, expansionDim),
+		SoundSpeed:   units.MeterPerSecond(1482),
+	}
+	Air = Fluid{
+		Density:      units.KilogramPerMeter3(1.204),
+		Viscosity:    units.PascalSecond(1.825e-5),
+		SpecificHeat: units.JoulePerKilogramKelvin(1006),
+		Conductivity: units.WattPerMeterKelvin(0.02514),
+		Expansion:    units.NewValue(1/293.15, expansionDim),
+		SoundSpeed:   units.MeterPerSecond(343.2),
+	}
+)
+
+// KinematicViscosity returns ν = μ/ρ.
+// Returns an error if the density is not positive.
+func (f Fluid) KinematicViscosity() (units.KinematicViscosity, error) {
+	if !(f.Density.Val() > 0) {
+		return units.KinematicViscosity{}, fmt.Errorf("density must be positive, got %g kg/m³", f.Density.Val())
+	}
+	return f.Viscosity.DivideDensity(f.Density), nil
+}
+
+// ThermalDiffusivity returns α = k/(ρ c_p) in m²/s.
+// Returns an error if the density or specific heat is not positive.
+func (f Fluid) ThermalDiffusivity() (units.Value, error) {
+	if !(f.Density.Val() > 0) || !(f.SpecificHeat.Val() > 0) {
+		return units.Value{}, fmt.Errorf("density and specific heat must be positive, got %g kg/m³ and %g J/(kg⋅K)", f.Density.Val(), f.SpecificHeat.Val())
+	}
+	return f.Conductivity.Divide(f.Density.Multiply(f.SpecificHeat.Value)), nil
+}
+
+func (f Fluid) checkViscosity() error {
+	if !(f.Viscosity.Val() > 0) {
+		return fmt.Errorf("viscosity must be positive, got %g Pa⋅s", f.Viscosity.Val())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Dimensionless Numbers
+// -----------------------------------------------------------------------------
+
+// Reynolds returns the Reynolds number, the ratio of inertial to viscous
+// forces, for flow at speed v past a body or through a channel of
+// characteristic length L. Returns an error if the viscosity or L is not
+// positive.
+//
+// Formula:
+//
+//	Re = ρ v L / μ
+func Reynolds(f Fluid, v units.Velocity, l units.Length) (units.Value, error) {
+	if err := f.checkViscosity(); err != nil {
+		return units.Value{}, err
+	}
+	if !(l.Val() > 0) {
+		return units.Value{}, fmt.Errorf("characteristic length must be positive, got %g m", l.Val())
+	}
+	return units.Dimensionless(f.Density.Val() * math.Abs(v.Val()) * l.Val() / f.Viscosity.Val()), nil
+}
+
+// Mach returns the Mach number M = v/c for speed v and sound speed c.
+// Returns an error if c is not positive.
+func Mach(v, soundSpeed units.Velocity) (units.Value, error) {
+	if !(soundSpeed.Val() > 0) {
+		return units.Value{}, fmt.Errorf("sound speed must be positive, got %g m/s", soundSpeed.Val())
+	}
+	return units.Dimensionless(math.Abs(v.Val()) / soundSpeed.Val()), nil
+}
+
+// Froude returns the Froude number, the ratio of flow speed to gravity
+// wave speed, for flow at speed v with characteristic length (e.g. depth)
+// L under gravity g. Returns an error if g or L is not positive.
+//
+// Formula:
+//
+//	Fr = v / √(g L)
+func Froude(v units.Velocity, l units.Length, g units.Acceleration) (units.Value, error) {
+	if !(g.Val() > 0) || !(l.Val() > 0) {
+		return units.Value{}, fmt.Errorf("gravity and length must be positive, got %g m/s² and %g m", g.Val(), l.Val())
+	}
+	return units.Dimensionless(math.Abs(v.Val()) / math.Sqrt(g.Val()*l.Val())), nil
+}
+
+// Prandtl returns the Prandtl number, the ratio of momentum to thermal
+// diffusivity. Returns an error if the conductivity is not positive.
+//
+// Formula:
+//
+//	Pr = c_p μ / k
+func Prandtl(f Fluid) (units.Value, error) {
+	if !(f.Conductivity.Val() > 0) {
+		return units.Value{}, fmt.Errorf("thermal conductivity must be positive, got %g W/(m⋅K)", f.Conductivity.Val())
+	}
+	return f.SpecificHeat.Multiply(f.Viscosity.Value).Divide(f.Conductivity.Value), nil
+}
+
+// Rayleigh returns the Rayleigh number governing natural convection across
+// a layer of thickness L with temperature difference ΔT. Convection in a
+// layer heated from below sets in above Ra ≈ 1708. Returns an error if a
+// property is not positive or the expansion coefficient has the wrong
+// dimension.
+//
+// Formula:
+//
+//	Ra = g β ΔT L³ / (ν α)
+func Rayleigh(f Fluid, dt units.TemperatureDelta, l units.Length, g units.Acceleration) (units.Value, error) {
+	if f.Expansion.Dim() != expansionDim {
+		return units.Value{}, fmt.Errorf("expansion coefficient must have dimension %v, got %v", expansionDim, f.Expansion.Dim())
+	}
+	if err := f.checkViscosity(); err != nil {
+		return units.Value{}, err
+	}
+	nu, err := f.KinematicViscosity()
+	if err != nil {
+		return units.Value{}, err
+	}
+	alpha, err := f.ThermalDiffusivity()
+	if err != nil {
+		return units.Value{}, err
+	}
+	if !(alpha.Val() > 0) {
+		return units.Value{}, fmt.Errorf("thermal conductivity must be positive, got %g W/(m⋅K)", f.Conductivity.Val())
+	}
+	ra := g.Val() * f.Expansion.Val() * math.Abs(dt.Val()) * math.Pow(l.Val(), 3) / (nu.Val() * alpha.Val())
+	return units.Dimensionless(ra), nil
+}
+
+// -----------------------------------------------------------------------------
+// Stokes Flow
+// -----------------------------------------------------------------------------
+
+// StokesDrag returns the drag force F = 6π μ r v on a sphere of radius r
+// moving at speed v through the fluid in creeping flow.
+// Returns an error if the viscosity or radius is not positive.
+func StokesDrag(f Fluid, radius units.Length, v units.Velocity) (units.Force, error) {
+	if err := f.checkViscosity(); err != nil {
+		return units.Force{}, err
+	}
+	if !(radius.Val() > 0) {
+		return units.Force{}, fmt.Errorf("radius must be positive, got %g m", radius.Val())
+	}
+	return units.Newton(6 * math.Pi * f.Viscosity.Val() * radius.Val() * v.Val()), nil
+}
+
+// TerminalVelocity returns the settling speed of a sphere of density ρ_p
+// and radius r, at which Stokes drag balances gravity less buoyancy. The
+// result is negative for a sphere lighter than the fluid, which rises.
+// Returns an error if the viscosity or radius is not positive.
+//
+// Formula:
+//
+//	v_t = 2 (ρ_p - ρ_f) g r² / (9 μ)
+func TerminalVelocity(f Fluid, particleDensity units.Density, radius units.Length, g units.Acceleration) (units.Velocity, error) {
+	if err := f.checkViscosity(); err != nil {
+		return units.Velocity{}, err
+	}
+	if !(radius.Val() > 0) {
+		return units.Velocity{}, fmt.Errorf("radius must be positive, got %g m", radius.Val())
+	}
+	r := radius.Val()
+	dRho := particleDensity.Val() - f.Density.Val()
+	return units.MeterPerSecond(2 * dRho * g.Val() * r * r / (9 * f.Viscosity.Val())), nil
+}
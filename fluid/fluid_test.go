@@ -0,0 +1,165 @@
+package fluid
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestDimensionlessNumbers(t *testing.T) {
+	re, err := Reynolds(Water, units.MeterPerSecond(1), units.Centimeter(5))
+	if err != nil || !re.IsDimensionless() || !almostEqual(re.Val(), 998.2*0.05/1.002e-3, 1e-12) {
+		t.Errorf("Reynolds() = %v, %v", re, err)
+	}
+	nu, _ := Water.KinematicViscosity()
+	if !almostEqual(nu.Val(), 1.004e-6, 1e-3) {
+		t.Errorf("ν(water) = %v, want ≈ 1.004e-6 m²/s", nu)
+	}
+
+	if m, _ := Mach(units.MeterPerSecond(686.4), Air.SoundSpeed); !almostEqual(m.Val(), 2, 1e-12) {
+		t.Errorf("Mach() = %v, want 2", m)
+	}
+	// Critical flow in 1 m of water: v = √g.
+	fr, _ := Froude(units.MeterPerSecond(math.Sqrt(9.80665)), units.Meter(1), constants.StandardGravity)
+	if !almostEqual(fr.Val(), 1, 1e-12) {
+		t.Errorf("Froude() = %v, want 1", fr)
+	}
+
+	// Tabulated Prandtl numbers: water ≈ 7.0, air ≈ 0.73.
+	if pr, err := Prandtl(Water); err != nil || !pr.IsDimensionless() || !almostEqual(pr.Val(), 7.0, 0.01) {
+		t.Errorf("Pr(water) = %v, %v; want ≈ 7.0", pr, err)
+	}
+	if pr, _ := Prandtl(Air); !almostEqual(pr.Val(), 0.73, 0.01) {
+		t.Errorf("Pr(air) = %v, want ≈ 0.73", pr)
+	}
+
+	// Ra = g β ΔT L³ / (ν α) and scales as L³.
+	ra, err := Rayleigh(Air, units.KelvinDelta(10), units.Centimeter(1), constants.StandardGravity)
+	if err != nil || !ra.IsDimensionless() || !almostEqual(ra.Val(), 1063, 1e-3) {
+		t.Errorf("Rayleigh() = %v, %v; want ≈ 1063", ra, err)
+	}
+	ra2, _ := Rayleigh(Air, units.KelvinDelta(10), units.Centimeter(2), constants.StandardGravity)
+	if !almostEqual(ra2.Val()/ra.Val(), 8, 1e-12) {
+		t.Errorf("Ra(2L)/Ra(L) = %v, want 8", ra2.Val()/ra.Val())
+	}
+
+	bad := Air
+	bad.Expansion = units.Dimensionless(1)
+	if _, err := Rayleigh(bad, units.KelvinDelta(1), units.Meter(1), constants.StandardGravity); err == nil {
+		t.Error("Rayleigh should reject a dimensionless expansion coefficient")
+	}
+	if _, err := Reynolds(Fluid{}, units.MeterPerSecond(1), units.Meter(1)); err == nil {
+		t.Error("Reynolds should reject zero viscosity")
+	}
+	if _, err := Mach(units.MeterPerSecond(1), units.MeterPerSecond(0)); err == nil {
+		t.Error("Mach should reject zero sound speed")
+	}
+}
+
+func TestStokes(t *testing.T) {
+	r := units.Micrometer(10)
+	v, err := TerminalVelocity(Air, units.KilogramPerMeter3(1000), r, constants.StandardGravity)
+	if err != nil || !almostEqual(v.Val(), 2*(1000-1.204)*9.80665*1e-10/(9*1.825e-5), 1e-12) {
+		t.Errorf("TerminalVelocity() = %v, %v", v, err)
+	}
+	// At terminal velocity drag balances weight less buoyancy.
+	drag, _ := StokesDrag(Air, r, v)
+	vol := 4.0 / 3 * math.Pi * math.Pow(r.Val(), 3)
+	if w := (1000 - 1.204) * vol * 9.80665; !almostEqual(drag.Val(), w, 1e-12) {
+		t.Errorf("drag = %v, want %v N", drag, w)
+	}
+	// Stokes flow is self-consistent: Re ≪ 1.
+	if re, _ := Reynolds(Air, v, units.Micrometer(20)); re.Val() > 0.1 {
+		t.Errorf("droplet Re = %v, want ≪ 1", re)
+	}
+	// A bubble rises.
+	if b, _ := TerminalVelocity(Water, Air.Density, units.Micrometer(50), constants.StandardGravity); b.Val() >= 0 {
+		t.Errorf("bubble velocity = %v, want negative", b)
+	}
+	if _, err := StokesDrag(Air, units.Meter(0), v); err == nil {
+		t.Error("StokesDrag should reject zero radius")
+	}
+}
+
+func TestBernoulli(t *testing.T) {
+	rho, g := Water.Density, constants.StandardGravity
+	atm := units.Atmosphere(1)
+	surface := Point{Pressure: atm, Velocity: units.MeterPerSecond(0), Height: units.Meter(2)}
+
+	// Torricelli: v = √(2gh).
+	out, err := Bernoulli(rho, g, surface, Point{Pressure: atm, Height: units.Meter(0)})
+	if err != nil || !almostEqual(out.Velocity.Val(), math.Sqrt(2*9.80665*2), 1e-12) {
+		t.Errorf("efflux = %v, %v; want √(2gh)", out.Velocity, err)
+	}
+	// Hydrostatic pressure at the bottom of the still tank.
+	bottom, _ := Bernoulli(rho, g, surface, Point{Velocity: units.MeterPerSecond(0), Height: units.Meter(0)})
+	if !almostEqual(bottom.Pressure.Val()-atm.Val(), 998.2*9.80665*2, 1e-12) {
+		t.Errorf("gauge pressure = %v, want ρgh", bottom.Pressure.Val()-atm.Val())
+	}
+	// Height solve inverts the velocity solve.
+	back, _ := Bernoulli(rho, g, out, Point{Pressure: atm, Velocity: units.MeterPerSecond(0)})
+	if !almostEqual(back.Height.Val(), 2, 1e-12) {
+		t.Errorf("height = %v, want 2 m", back.Height)
+	}
+	for _, p := range []Point{out, bottom, back} {
+		if !almostEqual(TotalPressure(rho, g, p).Val(), TotalPressure(rho, g, surface).Val(), 1e-12) {
+			t.Errorf("total pressure not conserved at %+v", p)
+		}
+	}
+
+	// Venturi: continuity then Bernoulli gives the throat pressure.
+	q := PipeArea(units.Centimeter(10)).MultiplyVelocity(units.MeterPerSecond(1))
+	vt, _ := FlowVelocity(q, PipeArea(units.Centimeter(5)))
+	if !almostEqual(vt.Val(), 4, 1e-12) {
+		t.Errorf("throat velocity = %v, want 4 m/s", vt)
+	}
+	inlet := Point{Pressure: units.Pascal(2e5), Velocity: units.MeterPerSecond(1), Height: units.Meter(0)}
+	throat, _ := Bernoulli(rho, g, inlet, Point{Velocity: vt, Height: units.Meter(0)})
+	if !almostEqual(2e5-throat.Pressure.Val(), 0.5*998.2*15, 1e-12) {
+		t.Errorf("venturi drop = %v Pa", 2e5-throat.Pressure.Val())
+	}
+
+	if _, err := Bernoulli(rho, g, surface, Point{Pressure: atm, Height: units.Meter(5)}); err == nil {
+		t.Error("Bernoulli should reject flow uphill from rest")
+	}
+	if _, err := Bernoulli(rho, g, surface, Point{Pressure: atm}); err == nil {
+		t.Error("Bernoulli should reject two unknowns")
+	}
+	if _, err := Bernoulli(rho, g, Point{Pressure: atm}, out); err == nil {
+		t.Error("Bernoulli should reject an incomplete known point")
+	}
+}
+
+func TestPoiseuille(t *testing.T) {
+	mu := units.Centipoise(1)
+	r, l := units.Millimeter(1), units.Meter(1)
+	q, err := HagenPoiseuille(mu, r, l, units.Pascal(1000))
+	if err != nil || !almostEqual(q.Val(), math.Pi*1e-12*1000/(8*1e-3), 1e-12) {
+		t.Errorf("HagenPoiseuille() = %v, %v", q, err)
+	}
+	if dp, _ := PoiseuillePressureDrop(mu, r, l, q); !almostEqual(dp.Val(), 1000, 1e-12) {
+		t.Errorf("PoiseuillePressureDrop() = %v, want 1000 Pa", dp)
+	}
+	// Flow scales as r⁴.
+	q2, _ := HagenPoiseuille(mu, units.Millimeter(2), l, units.Pascal(1000))
+	if !almostEqual(q2.Val()/q.Val(), 16, 1e-12) {
+		t.Errorf("Q(2r)/Q(r) = %v, want 16", q2.Val()/q.Val())
+	}
+	// The flow is laminar.
+	v, _ := FlowVelocity(q, PipeArea(units.Millimeter(2)))
+	if re, _ := Reynolds(Water, v, units.Millimeter(2)); re.Val() > 2300 {
+		t.Errorf("Re = %v, want laminar", re)
+	}
+	if _, err := HagenPoiseuille(mu, units.Meter(0), l, units.Pascal(1)); err == nil {
+		t.Error("HagenPoiseuille should reject zero radius")
+	}
+	if _, err := FlowVelocity(q, units.SquareMeter(0)); err == nil {
+		t.Error("FlowVelocity should reject zero area")
+	}
+}
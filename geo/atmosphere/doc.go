@@ -0,0 +1,41 @@
+// Package atmosphere implements the International Standard Atmosphere
+// (ISA), identical to the U.S. Standard Atmosphere 1976 below 86 km.
+//
+// The model divides the atmosphere into seven layers with constant
+// temperature gradients in geopotential altitude H. Pressure follows from
+// hydrostatic balance, density from the ideal gas law, and dynamic
+// viscosity from Sutherland's law:
+//
+//	T = T_b + L (H - H_b)
+//	p = p_b (T_b / T)^(g₀ / R L)          (L ≠ 0)
+//	p = p_b exp(-g₀ (H - H_b) / R T_b)    (L = 0)
+//	ρ = p / R T,  a = √(γ R T)
+//
+// Inputs are geometric altitudes above mean sea level, from -5 km to
+// 86 km.
+//
+// DensityAt has the signature of ballistics.DensityProfile and can be used
+// directly as a projectile environment. The returned State provides the
+// density and viscosity needed to build a fluid.Fluid for drag and
+// Reynolds-number calculations at altitude.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/dynamics/ballistics"
+//	    "github.com/sakiphan/qsim-core/geo/atmosphere"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	s, _ := atmosphere.ISA(units.Kilometer(11))
+//	// s.Temperature = 216.77 K, s.Pressure ≈ 22.7 kPa, s.SpeedOfSound ≈ 295 m/s
+//
+//	env := ballistics.Environment{
+//	    Gravity: units.MeterPerSecond2(9.80665),
+//	    Density: atmosphere.DensityAt,
+//	}
+//
+// References:
+//   - NOAA, NASA, USAF. "U.S. Standard Atmosphere, 1976", NASA-TM-X-74335
+//   - ISO 2533:1975. "Standard Atmosphere"
+package atmosphere
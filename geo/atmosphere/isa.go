@@ -0,0 +1,127 @@
+package atmosphere
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Model constants of the 1976 standard.
+const (
+	g0        = 9.80665    // m/s²
+	gasR      = 287.052874 // specific gas constant of dry air, J/(kg⋅K)
+	gamma     = 1.4        // ratio of specific heats
+	earthR    = 6356766.0  // effective Earth radius for geopotential altitude, m
+	beta      = 1.458e-6   // Sutherland constant, kg/(m⋅s⋅K^½)
+	sutherS   = 110.4      // Sutherland temperature, K
+	minHeight = -5000.0    // m, geometric
+	maxHeight = 86000.0    // m, geometric
+)
+
+// Sea-level reference values.
+var (
+	SeaLevelTemperature = constants.ISASeaLevel.Temperature
+	SeaLevelPressure    = constants.ISASeaLevel.Pressure
+	SeaLevelDensity     = constants.ISASeaLevel.Density
+)
+
+// layer is the base of an atmospheric layer: geopotential altitude (m),
+// temperature (K) and temperature gradient (K/m).
+type layer struct {
+	base, temp, lapse float64
+}
+
+var layers = []layer{
+	{0, 288.15, -0.0065},
+	{11000, 216.65, 0},
+	{20000, 216.65, 0.001},
+	{32000, 228.65, 0.0028},
+	{47000, 270.65, 0},
+	{51000, 270.65, -0.0028},
+	{71000, 214.65, -0.002},
+}
+
+// basePressure holds the pressure at the base of each layer.
+var basePressure = func() []float64 {
+	p := make([]float64, len(layers))
+	p[0] = SeaLevelPressure.Val()
+	for i := 1; i < len(layers); i++ {
+		p[i] = layerPressure(layers[i-1], p[i-1], layers[i].base)
+	}
+	return p
+}()
+
+// State is the atmospheric state at an altitude.
+type State struct {
+	Temperature  units.Temperature
+	Pressure     units.Pressure
+	Density      units.Density
+	SpeedOfSound units.Velocity
+	Viscosity    units.Viscosity
+}
+
+// ISA returns the standard atmosphere at geometric altitude h.
+//
+// Returns an error if h lies outside -5 km to 86 km.
+//
+// Example:
+//
+//	s, _ := atmosphere.ISA(units.Meter(0))
+//	// 288.15 K, 101325 Pa, 1.225 kg/m³, 340.29 m/s, 1.789e-5 Pa⋅s
+func ISA(h units.Length) (State, error) {
+	if !(h.Val() >= minHeight && h.Val() <= maxHeight) {
+		return State{}, fmt.Errorf("altitude must be within %g to %g km, got %g m", minHeight/1e3, maxHeight/1e3, h.Val())
+	}
+	H := GeopotentialAltitude(h).Val()
+	i := len(layers) - 1
+	for i > 0 && H < layers[i].base {
+		i--
+	}
+	l := layers[i]
+	T := l.temp + l.lapse*(H-l.base)
+	p := layerPressure(l, basePressure[i], H)
+	return State{
+		Temperature:  units.Kelvin(T),
+		Pressure:     units.Pascal(p),
+		Density:      units.KilogramPerMeter3(p / (gasR * T)),
+		SpeedOfSound: units.MeterPerSecond(math.Sqrt(gamma * gasR * T)),
+		Viscosity:    units.PascalSecond(beta * math.Pow(T, 1.5) / (T + sutherS)),
+	}, nil
+}
+
+// DensityAt returns the standard-atmosphere density at geometric altitude
+// h. Altitudes below -5 km are clamped to -5 km, and the density above
+// 86 km is taken as zero. It satisfies ballistics.DensityProfile.
+func DensityAt(h units.Length) units.Density {
+	switch {
+	case h.Val() > maxHeight:
+		return units.KilogramPerMeter3(0)
+	case h.Val() < minHeight:
+		h = units.Meter(minHeight)
+	}
+	s, _ := ISA(h)
+	return s.Density
+}
+
+// GeopotentialAltitude converts a geometric altitude to geopotential
+// altitude, the height at which the potential energy per unit mass would
+// equal g₀H in a uniform field.
+//
+// Formula:
+//
+//	H = r₀ h / (r₀ + h),  r₀ = 6356.766 km
+func GeopotentialAltitude(h units.Length) units.Length {
+	return units.Meter(earthR * h.Val() / (earthR + h.Val()))
+}
+
+// layerPressure returns the pressure at geopotential altitude H within
+// layer l, whose base pressure is pb.
+func layerPressure(l layer, pb, H float64) float64 {
+	if l.lapse == 0 {
+		return pb * math.Exp(-g0*(H-l.base)/(gasR*l.temp))
+	}
+	T := l.temp + l.lapse*(H-l.base)
+	return pb * math.Pow(l.temp/T, g0/(gasR*l.lapse))
+}
@@ -0,0 +1,89 @@
+package atmosphere
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/dynamics/ballistics"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestSeaLevel(t *testing.T) {
+	s, err := ISA(units.Meter(0))
+	if err != nil {
+		t.Fatalf("ISA(0) error = %v", err)
+	}
+	if s.Temperature != SeaLevelTemperature || s.Pressure != SeaLevelPressure {
+		t.Errorf("sea level T, p = %v, %v", s.Temperature, s.Pressure)
+	}
+	if !almostEqual(s.Density.Val(), SeaLevelDensity.Val(), 1e-5) {
+		t.Errorf("sea level density = %v, want 1.225 kg/m³", s.Density)
+	}
+	if !almostEqual(s.SpeedOfSound.Val(), 340.294, 1e-5) {
+		t.Errorf("sea level speed of sound = %v, want 340.294 m/s", s.SpeedOfSound)
+	}
+	if !almostEqual(s.Viscosity.Val(), 1.7894e-5, 1e-4) {
+		t.Errorf("sea level viscosity = %v, want 1.7894e-5 Pa⋅s", s.Viscosity)
+	}
+}
+
+func TestLayers(t *testing.T) {
+	// U.S. Standard Atmosphere 1976, Table I, at geometric altitudes.
+	tests := []struct {
+		h, T, p, rho float64
+	}{
+		{-2000, 301.154, 127774, 1.47808},
+		{5000, 255.676, 54048.3, 0.736429},
+		{11000, 216.774, 22699.9, 0.364801},
+		{20000, 216.650, 5529.29, 0.0889097},
+		{32000, 228.490, 889.063, 0.0135551},
+		{50000, 270.650, 79.7787, 0.00102687},
+		{60000, 247.021, 21.9587, 0.000309676},
+		{80000, 198.639, 1.05247, 1.84580e-5},
+		{86000, 186.946, 0.373384, 6.95788e-6},
+	}
+	for _, tt := range tests {
+		s, err := ISA(units.Meter(tt.h))
+		if err != nil {
+			t.Fatalf("ISA(%v m) error = %v", tt.h, err)
+		}
+		if !almostEqual(s.Temperature.Val(), tt.T, 1e-5) || !almostEqual(s.Pressure.Val(), tt.p, 1e-4) || !almostEqual(s.Density.Val(), tt.rho, 1e-4) {
+			t.Errorf("ISA(%v m) = %v, %v, %v; want %v K, %v Pa, %v kg/m³", tt.h, s.Temperature, s.Pressure, s.Density, tt.T, tt.p, tt.rho)
+		}
+	}
+	// Pressure is continuous across layer boundaries.
+	for _, l := range layers[1:] {
+		h := earthR * l.base / (earthR - l.base)
+		lo, _ := ISA(units.Meter(h - 1e-3))
+		hi, _ := ISA(units.Meter(h + 1e-3))
+		if !almostEqual(lo.Pressure.Val(), hi.Pressure.Val(), 1e-6) {
+			t.Errorf("pressure jump at H = %v m: %v vs %v", l.base, lo.Pressure, hi.Pressure)
+		}
+	}
+	for _, h := range []float64{-6000, 86001, math.NaN()} {
+		if _, err := ISA(units.Meter(h)); err == nil {
+			t.Errorf("ISA(%v m) should fail", h)
+		}
+	}
+}
+
+func TestDensityAt(t *testing.T) {
+	var profile ballistics.DensityProfile = DensityAt
+	s, _ := ISA(units.Kilometer(3))
+	if profile(units.Kilometer(3)) != s.Density {
+		t.Error("DensityAt should match ISA")
+	}
+	if DensityAt(units.Kilometer(120)).Val() != 0 {
+		t.Error("DensityAt above 86 km should be zero")
+	}
+	if low, _ := ISA(units.Kilometer(-5)); DensityAt(units.Kilometer(-8)) != low.Density {
+		t.Error("DensityAt below -5 km should clamp")
+	}
+	if g := GeopotentialAltitude(units.Kilometer(86)); !almostEqual(g.Val(), 84852, 1e-4) {
+		t.Errorf("GeopotentialAltitude(86 km) = %v, want 84.852 km", g)
+	}
+}
@@ -0,0 +1,35 @@
+// Package geo models the figure and normal gravity field of the Earth
+// using the WGS84 reference ellipsoid.
+//
+// Geodetic latitude φ is the angle between the equatorial plane and the
+// ellipsoid normal. Geocentric latitude ψ is the angle to the line from
+// the Earth's center. They differ by up to 11.5′ at mid-latitudes.
+// Positions are converted to and from Earth-centered, Earth-fixed (ECEF)
+// Cartesian vectors, with x toward the prime meridian on the equator and z
+// toward the north pole. Longitudes are positive east and altitudes are
+// heights above the ellipsoid.
+//
+// Normal gravity includes the centrifugal acceleration of the Earth's
+// rotation. It is the value a plumb line or accelerometer at rest would
+// measure on an ideal ellipsoidal Earth, and is directed along the
+// negative ellipsoid normal.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/geo"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	g, _ := geo.GravityAt(units.Degree(45), units.Meter(0))    // 9.80620 m/s²
+//	g1, _ := geo.GravityAt(units.Degree(45), units.Meter(1e4)) // 9.77542 m/s²
+//
+//	// Gravity vector in ECEF for a launch site
+//	gv, _ := geo.GravityVector(units.Degree(28.5), units.Degree(-80.6), units.Meter(0))
+//
+// References:
+//   - NIMA TR8350.2. "Department of Defense World Geodetic System 1984",
+//     3rd ed., Ch. 3-4
+//   - Bowring. "Transformation from spatial to geographical coordinates",
+//     Survey Review 23, 323 (1976)
+package geo
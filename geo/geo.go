@@ -0,0 +1,198 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// WGS84 Ellipsoid
+// -----------------------------------------------------------------------------
+
+// WGS84 defining and derived parameters.
+var (
+	// SemiMajorAxis is the equatorial radius a.
+	SemiMajorAxis = units.Meter(6378137.0)
+
+	// SemiMinorAxis is the polar radius b = a(1 - f).
+	SemiMinorAxis = units.Meter(6356752.3142)
+
+	// RotationRate is the Earth's angular velocity.
+	RotationRate = units.RadianPerSecond(7.292115e-5)
+
+	// EquatorialGravity and PolarGravity are the normal gravity at the
+	// equator and at the poles.
+	EquatorialGravity = units.MeterPerSecond2(9.7803253359)
+	PolarGravity      = units.MeterPerSecond2(9.8321849378)
+)
+
+const (
+	// Flattening is f = (a - b)/a.
+	Flattening = 1 / 298.257223563
+
+	// e2 is the first eccentricity squared, e² = f(2 - f).
+	e2 = Flattening * (2 - Flattening)
+
+	// somigliana is k = bγ_p/(aγ_e) - 1.
+	somigliana = 0.00193185265241
+
+	// m is ω²a²b/GM.
+	m = 0.00344978650684
+)
+
+// -----------------------------------------------------------------------------
+// Latitudes and ECEF
+// -----------------------------------------------------------------------------
+
+// GeocentricLatitude returns the geocentric latitude ψ of a point on the
+// ellipsoid surface with geodetic latitude φ.
+//
+// Formula:
+//
+//	tan ψ = (1 - e²) tan φ
+func GeocentricLatitude(geodetic units.Angle) (units.Angle, error) {
+	if err := checkLatitude(geodetic); err != nil {
+		return units.Angle{}, err
+	}
+	phi := geodetic.Val()
+	return units.Radian(math.Atan2((1-e2)*math.Sin(phi), math.Cos(phi))), nil
+}
+
+// GeodeticLatitude returns the geodetic latitude φ of a point on the
+// ellipsoid surface with geocentric latitude ψ.
+func GeodeticLatitude(geocentric units.Angle) (units.Angle, error) {
+	if err := checkLatitude(geocentric); err != nil {
+		return units.Angle{}, err
+	}
+	psi := geocentric.Val()
+	return units.Radian(math.Atan2(math.Sin(psi), (1-e2)*math.Cos(psi))), nil
+}
+
+// ToECEF returns the ECEF position of a point at geodetic latitude φ,
+// longitude λ and height h above the ellipsoid.
+//
+// Formula:
+//
+//	N = a / √(1 - e² sin²φ)
+//	x = (N + h) cos φ cos λ,  y = (N + h) cos φ sin λ,  z = (N(1 - e²) + h) sin φ
+func ToECEF(lat, lon units.Angle, h units.Length) (vector.Vector3, error) {
+	if err := checkLatitude(lat); err != nil {
+		return vector.Vector3{}, err
+	}
+	sp, cp := math.Sincos(lat.Val())
+	sl, cl := math.Sincos(lon.Val())
+	n := primeVerticalRadius(sp)
+	return vector.NewPosition(
+		units.Meter((n+h.Val())*cp*cl),
+		units.Meter((n+h.Val())*cp*sl),
+		units.Meter((n*(1-e2)+h.Val())*sp),
+	), nil
+}
+
+// FromECEF returns the geodetic latitude, longitude and height of an ECEF
+// position. It uses Bowring's method refined by two iterations, which is
+// accurate to well under a millimeter for points near the Earth.
+//
+// Returns an error if r is not a position vector.
+func FromECEF(r vector.Vector3) (lat, lon units.Angle, h units.Length, err error) {
+	if r.Dim() != (units.Dimension{L: 1}) {
+		return units.Angle{}, units.Angle{}, units.Length{}, fmt.Errorf("position must have dimension [L], got %s", r.Dim())
+	}
+	x, y, z := r.X.Val(), r.Y.Val(), r.Z.Val()
+	a, b := SemiMajorAxis.Val(), SemiMinorAxis.Val()
+	p := math.Hypot(x, y)
+	ep2 := (a*a - b*b) / (b * b)
+
+	// Bowring's initial guess from the parametric latitude.
+	beta := math.Atan2(a*z, b*p)
+	var phi float64
+	for i := 0; i < 3; i++ {
+		sb, cb := math.Sincos(beta)
+		phi = math.Atan2(z+ep2*b*sb*sb*sb, p-e2*a*cb*cb*cb)
+		beta = math.Atan2((1-Flattening)*math.Sin(phi), math.Cos(phi))
+	}
+
+	sp, cp := math.Sincos(phi)
+	n := primeVerticalRadius(sp)
+	var height float64
+	if cp > 1e-10 {
+		height = p/cp - n
+	} else {
+		height = math.Abs(z) - b
+	}
+	return units.Radian(phi), units.Radian(math.Atan2(y, x)), units.Meter(height), nil
+}
+
+// -----------------------------------------------------------------------------
+// Normal Gravity
+// -----------------------------------------------------------------------------
+
+// NormalGravity returns the normal gravity on the ellipsoid surface at
+// geodetic latitude φ (Somigliana's closed formula).
+//
+// Formula:
+//
+//	γ = γ_e (1 + k sin²φ) / √(1 - e² sin²φ)
+func NormalGravity(lat units.Angle) (units.Acceleration, error) {
+	if err := checkLatitude(lat); err != nil {
+		return units.Acceleration{}, err
+	}
+	s2 := math.Pow(math.Sin(lat.Val()), 2)
+	return units.MeterPerSecond2(EquatorialGravity.Val() * (1 + somigliana*s2) / math.Sqrt(1-e2*s2)), nil
+}
+
+// GravityAt returns the magnitude of normal gravity at geodetic latitude φ
+// and height h above the ellipsoid, using the WGS84 second-order expansion
+// in height. The expansion is intended for heights within the atmosphere;
+// far above it a point-mass field with the J₂ term is more appropriate.
+//
+// Formula:
+//
+//	γ_h = γ [1 - (2/a)(1 + f + m - 2f sin²φ) h + 3h²/a²]
+//
+// Example:
+//
+//	g, _ := geo.GravityAt(units.Degree(0), units.Kilometer(10)) // 9.7495 m/s²
+func GravityAt(lat units.Angle, h units.Length) (units.Acceleration, error) {
+	g0, err := NormalGravity(lat)
+	if err != nil {
+		return units.Acceleration{}, err
+	}
+	a, f := SemiMajorAxis.Val(), Flattening
+	s2 := math.Pow(math.Sin(lat.Val()), 2)
+	hv := h.Val()
+	return units.MeterPerSecond2(g0.Val() * (1 - 2/a*(1+f+m-2*f*s2)*hv + 3*hv*hv/(a*a))), nil
+}
+
+// GravityVector returns the normal gravity acceleration as an ECEF vector
+// at geodetic latitude φ, longitude λ and height h. It points along the
+// inward ellipsoid normal (-cos φ cos λ, -cos φ sin λ, -sin φ).
+func GravityVector(lat, lon units.Angle, h units.Length) (vector.Vector3, error) {
+	g, err := GravityAt(lat, h)
+	if err != nil {
+		return vector.Vector3{}, err
+	}
+	sp, cp := math.Sincos(lat.Val())
+	sl, cl := math.Sincos(lon.Val())
+	gv := g.Val()
+	return vector.NewAcceleration(
+		units.MeterPerSecond2(-gv*cp*cl),
+		units.MeterPerSecond2(-gv*cp*sl),
+		units.MeterPerSecond2(-gv*sp),
+	), nil
+}
+
+// primeVerticalRadius returns N = a/√(1 - e² sin²φ) given sin φ.
+func primeVerticalRadius(sinLat float64) float64 {
+	return SemiMajorAxis.Val() / math.Sqrt(1-e2*sinLat*sinLat)
+}
+
+func checkLatitude(lat units.Angle) error {
+	if !(math.Abs(lat.Val()) <= math.Pi/2) {
+		return fmt.Errorf("latitude must be within ±90°, got %g°", lat.ToDegrees())
+	}
+	return nil
+}
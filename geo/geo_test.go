@@ -0,0 +1,112 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestNormalGravity(t *testing.T) {
+	tests := []struct {
+		lat  float64 // degrees
+		want float64 // m/s²
+	}{
+		{0, 9.7803253359},
+		{45, 9.8061977694},
+		{90, 9.8321849378},
+		{-90, 9.8321849378},
+	}
+	for _, tt := range tests {
+		g, err := NormalGravity(units.Degree(tt.lat))
+		if err != nil || !almostEqual(g.Val(), tt.want, 1e-10) {
+			t.Errorf("NormalGravity(%v°) = %v, %v; want %v", tt.lat, g, err, tt.want)
+		}
+	}
+	if _, err := NormalGravity(units.Degree(91)); err == nil {
+		t.Error("NormalGravity should reject |φ| > 90°")
+	}
+}
+
+func TestGravityAt(t *testing.T) {
+	g0, _ := GravityAt(units.Degree(45), units.Meter(0))
+	if n, _ := NormalGravity(units.Degree(45)); g0 != n {
+		t.Errorf("GravityAt(h = 0) = %v, want %v", g0, n)
+	}
+	// Free-air gradient ≈ 3.086 µm/s² per meter.
+	g1, _ := GravityAt(units.Degree(45), units.Meter(1000))
+	if d := (g0.Val() - g1.Val()) / 1000; !almostEqual(d, 3.086e-6, 1e-3) {
+		t.Errorf("free-air gradient = %v s⁻², want ≈ 3.086e-6", d)
+	}
+	if g, _ := GravityAt(units.Degree(0), units.Kilometer(10)); !almostEqual(g.Val(), 9.74952, 1e-5) {
+		t.Errorf("GravityAt(0°, 10 km) = %v, want ≈ 9.7495", g)
+	}
+	gv, err := GravityVector(units.Degree(30), units.Degree(60), units.Meter(0))
+	if err != nil {
+		t.Fatalf("GravityVector() error = %v", err)
+	}
+	n, _ := NormalGravity(units.Degree(30))
+	if mag := math.Sqrt(gv.MagnitudeSquared().Val()); !almostEqual(mag, n.Val(), 1e-14) || gv.Z.Val() >= 0 {
+		t.Errorf("GravityVector() = %v, want downward with magnitude %v", gv, n)
+	}
+	if gv.Dim() != units.MeterPerSecond2(1).Dim() {
+		t.Errorf("GravityVector dimension = %v", gv.Dim())
+	}
+}
+
+func TestLatitudes(t *testing.T) {
+	// Maximum difference φ - ψ ≈ 11.5′ near 45°.
+	psi, _ := GeocentricLatitude(units.Degree(45))
+	if d := 45*60 - psi.ToArcminutes(); !almostEqual(d, 11.545, 1e-4) {
+		t.Errorf("φ - ψ at 45° = %v′, want ≈ 11.545′", d)
+	}
+	if phi, _ := GeodeticLatitude(psi); !almostEqual(phi.ToDegrees(), 45, 1e-14) {
+		t.Errorf("GeodeticLatitude round trip = %v°", phi.ToDegrees())
+	}
+	for _, deg := range []float64{0, 90, -90} {
+		if psi, _ := GeocentricLatitude(units.Degree(deg)); math.Abs(psi.ToDegrees()-deg) > 1e-12 {
+			t.Errorf("GeocentricLatitude(%v°) = %v°", deg, psi.ToDegrees())
+		}
+	}
+	if _, err := GeodeticLatitude(units.Degree(-100)); err == nil {
+		t.Error("GeodeticLatitude should reject |ψ| > 90°")
+	}
+}
+
+func TestECEF(t *testing.T) {
+	r, _ := ToECEF(units.Degree(0), units.Degree(0), units.Meter(0))
+	if r.X.Val() != SemiMajorAxis.Val() || r.Y.Val() != 0 || r.Z.Val() != 0 {
+		t.Errorf("ToECEF(0, 0, 0) = %v, want (a, 0, 0)", r)
+	}
+	pole, _ := ToECEF(units.Degree(90), units.Degree(0), units.Meter(0))
+	if !almostEqual(pole.Z.Val(), SemiMinorAxis.Val(), 1e-10) {
+		t.Errorf("north pole z = %v, want b", pole.Z)
+	}
+
+	tests := []struct{ lat, lon, h float64 }{
+		{45, 10, 0},
+		{-33.9, 151.2, 50},
+		{28.5, -80.6, 4e5},
+		{89.99, 0, 1000},
+		{-60, 250, -100},
+	}
+	for _, tt := range tests {
+		r, _ := ToECEF(units.Degree(tt.lat), units.Degree(tt.lon), units.Meter(tt.h))
+		lat, lon, h, err := FromECEF(r)
+		if err != nil {
+			t.Fatalf("FromECEF() error = %v", err)
+		}
+		dlon := math.Remainder(lon.ToDegrees()-tt.lon, 360)
+		if math.Abs(lat.ToDegrees()-tt.lat) > 1e-9 || math.Abs(dlon) > 1e-9 || math.Abs(h.Val()-tt.h) > 1e-4 {
+			t.Errorf("FromECEF(ToECEF(%v)) = %v°, %v°, %v m", tt, lat.ToDegrees(), lon.ToDegrees(), h.Val())
+		}
+	}
+	gv, _ := GravityVector(units.Degree(0), units.Degree(0), units.Meter(0))
+	if _, _, _, err := FromECEF(gv); err == nil {
+		t.Error("FromECEF should reject a non-position vector")
+	}
+}
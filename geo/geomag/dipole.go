@@ -0,0 +1,171 @@
+package geomag
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// ReferenceRadius is the IGRF reference radius a.
+var ReferenceRadius = units.Kilometer(6371.2)
+
+// Dipole holds the degree-1 Gauss coefficients of a geomagnetic field
+// model.
+type Dipole struct {
+	G10, G11, H11 units.MagneticField
+}
+
+// IGRF2020 is the dipole part of IGRF-13 at epoch 2020.0.
+var IGRF2020 = Dipole{
+	G10: units.Nanotesla(-29404.8),
+	G11: units.Nanotesla(-1450.9),
+	H11: units.Nanotesla(4652.5),
+}
+
+// gauss returns g = (g₁¹, h₁¹, g₁⁰) in teslas. The dipole moment points
+// along g, so the boreal pole lies along -g.
+func (d Dipole) gauss() [3]float64 {
+	return [3]float64{d.G11.Val(), d.H11.Val(), d.G10.Val()}
+}
+
+// EquatorialField returns B₀ = √(g₁⁰² + g₁¹² + h₁¹²), the field strength at
+// the reference radius on the dipole equator.
+func (d Dipole) EquatorialField() units.MagneticField {
+	g := d.gauss()
+	return units.Tesla(math.Sqrt(g[0]*g[0] + g[1]*g[1] + g[2]*g[2]))
+}
+
+// Pole returns the geocentric latitude and the longitude of the boreal
+// geomagnetic pole, where the dipole axis meets the surface in the
+// northern hemisphere.
+//
+// Formula:
+//
+//	cos θ₀ = -g₁⁰/B₀,  λ₀ = atan2(-h₁¹, -g₁¹)
+func (d Dipole) Pole() (lat, lon units.Angle) {
+	g := d.gauss()
+	b0 := d.EquatorialField().Val()
+	return units.Radian(math.Asin(-g[2] / b0)), units.Radian(math.Atan2(-g[1], -g[0]))
+}
+
+// Field returns the magnetic field vector at ECEF position r.
+//
+// Returns an error if r is not a nonzero position vector.
+//
+// Example:
+//
+//	r, _ := geo.ToECEF(units.Degree(0), units.Degree(0), units.Meter(0))
+//	b, _ := geomag.IGRF2020.Field(r)
+func (d Dipole) Field(r vector.Vector3) (vector.Vector3, error) {
+	rv, rn, err := position(r)
+	if err != nil {
+		return vector.Vector3{}, err
+	}
+	g := d.gauss()
+	k := math.Pow(ReferenceRadius.Val()/rn, 3)
+	gr := (g[0]*rv[0] + g[1]*rv[1] + g[2]*rv[2]) / rn
+	b := func(i int) units.MagneticField { return units.Tesla(k * (3*gr*rv[i]/rn - g[i])) }
+	return vector.Vector3{X: b(0).Value, Y: b(1).Value, Z: b(2).Value}, nil
+}
+
+// MagneticLatitude returns the dipole latitude λₘ of ECEF position r, the
+// angle between r and the dipole equatorial plane, positive toward the
+// boreal geomagnetic pole.
+func (d Dipole) MagneticLatitude(r vector.Vector3) (units.Angle, error) {
+	rv, rn, err := position(r)
+	if err != nil {
+		return units.Angle{}, err
+	}
+	g := d.gauss()
+	b0 := d.EquatorialField().Val()
+	s := -(g[0]*rv[0] + g[1]*rv[1] + g[2]*rv[2]) / (b0 * rn)
+	return units.Radian(math.Asin(math.Max(-1, math.Min(1, s)))), nil
+}
+
+// LShell returns the McIlwain parameter of the dipole field line through
+// ECEF position r, the equatorial crossing distance in reference radii.
+//
+// Formula:
+//
+//	L = r / (a cos²λₘ)
+func (d Dipole) LShell(r vector.Vector3) (float64, error) {
+	lat, err := d.MagneticLatitude(r)
+	if err != nil {
+		return 0, err
+	}
+	_, rn, _ := position(r)
+	c := math.Cos(lat.Val())
+	return rn / (ReferenceRadius.Val() * c * c), nil
+}
+
+// ParseCoefficients reads a dipole from Gauss coefficients in nanoteslas,
+// one per line in the form
+//
+//	g 1 0 -29404.8
+//	g 1 1  -1450.9
+//	h 1 1   4652.5
+//
+// Blank lines and lines starting with '#' are skipped, as are terms of
+// degree above one, so the degree-1 rows of a full IGRF table can be
+// supplied unchanged.
+//
+// Returns an error for malformed lines or if g₁⁰ is missing.
+func ParseCoefficients(r io.Reader) (Dipole, error) {
+	var d Dipole
+	var haveG10 bool
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		f := strings.Fields(sc.Text())
+		if len(f) == 0 || strings.HasPrefix(f[0], "#") {
+			continue
+		}
+		if len(f) != 4 || (f[0] != "g" && f[0] != "h") {
+			return Dipole{}, fmt.Errorf("line %d: want \"g|h n m value\", got %q", line, sc.Text())
+		}
+		n, err1 := strconv.Atoi(f[1])
+		m, err2 := strconv.Atoi(f[2])
+		v, err3 := strconv.ParseFloat(f[3], 64)
+		if err1 != nil || err2 != nil || err3 != nil || m < 0 || m > n {
+			return Dipole{}, fmt.Errorf("line %d: invalid coefficient %q", line, sc.Text())
+		}
+		if n != 1 {
+			continue
+		}
+		switch {
+		case f[0] == "g" && m == 0:
+			d.G10, haveG10 = units.Nanotesla(v), true
+		case f[0] == "g" && m == 1:
+			d.G11 = units.Nanotesla(v)
+		case f[0] == "h" && m == 1:
+			d.H11 = units.Nanotesla(v)
+		default:
+			return Dipole{}, fmt.Errorf("line %d: h₁⁰ is not defined", line)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return Dipole{}, err
+	}
+	if !haveG10 {
+		return Dipole{}, fmt.Errorf("missing g 1 0 coefficient")
+	}
+	return d, nil
+}
+
+// position returns the components and length of a position vector.
+func position(r vector.Vector3) ([3]float64, float64, error) {
+	if r.Dim() != (units.Dimension{L: 1}) {
+		return [3]float64{}, 0, fmt.Errorf("position must have dimension [L], got %s", r.Dim())
+	}
+	rv := r.ToArray()
+	rn := math.Sqrt(rv[0]*rv[0] + rv[1]*rv[1] + rv[2]*rv[2])
+	if rn == 0 {
+		return [3]float64{}, 0, fmt.Errorf("field is undefined at the origin")
+	}
+	return rv, rn, nil
+}
@@ -0,0 +1,113 @@
+package geomag
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// at returns the ECEF position at spherical latitude, longitude and radius.
+func at(lat, lon float64, r units.Length) vector.Vector3 {
+	p, l := units.Degree(lat).Val(), units.Degree(lon).Val()
+	return vector.NewPosition(
+		units.Meter(r.Val()*math.Cos(p)*math.Cos(l)),
+		units.Meter(r.Val()*math.Cos(p)*math.Sin(l)),
+		units.Meter(r.Val()*math.Sin(p)),
+	)
+}
+
+func magnitude(v vector.Vector3) float64 {
+	return math.Sqrt(v.MagnitudeSquared().Val())
+}
+
+func TestPole(t *testing.T) {
+	d := IGRF2020
+	if b0 := d.EquatorialField().ToNanoteslas(); !almostEqual(b0, 29805.8, 1e-5) {
+		t.Errorf("B₀ = %v nT, want ≈ 29805.8 nT", b0)
+	}
+	lat, lon := d.Pole()
+	// Geocentric latitude; the published 80.65°N is geodetic.
+	if !almostEqual(lat.ToDegrees(), 80.589, 1e-5) || !almostEqual(lon.ToDegrees(), -72.680, 1e-5) {
+		t.Errorf("Pole() = %v°, %v°; want 80.589°N, 72.680°W", lat.ToDegrees(), lon.ToDegrees())
+	}
+}
+
+func TestField(t *testing.T) {
+	d := IGRF2020
+	lat, lon := d.Pole()
+	a := ReferenceRadius
+	b0 := d.EquatorialField().Val()
+
+	// At the boreal pole the field is 2B₀ and points straight down.
+	pole := at(lat.ToDegrees(), lon.ToDegrees(), a)
+	b, err := d.Field(pole)
+	if err != nil {
+		t.Fatalf("Field() error = %v", err)
+	}
+	if !almostEqual(magnitude(b), 2*b0, 1e-12) {
+		t.Errorf("|B| at pole = %v, want 2B₀", magnitude(b))
+	}
+	if cos := b.Dot(pole).Val() / (magnitude(b) * a.Val()); !almostEqual(cos, -1, 1e-12) {
+		t.Errorf("polar field direction cos = %v, want -1", cos)
+	}
+	if b.Dim() != units.Tesla(1).Dim() {
+		t.Errorf("field dimension = %v", b.Dim())
+	}
+
+	// On the dipole equator |B| = B₀, northward, and falls off as r⁻³.
+	eq := at(lat.ToDegrees()-90, lon.ToDegrees(), a)
+	be, _ := d.Field(eq)
+	if !almostEqual(magnitude(be), b0, 1e-12) || math.Abs(be.Dot(eq).Val()) > 1e-9*b0*a.Val() {
+		t.Errorf("equatorial field = %v, want horizontal B₀", be)
+	}
+	far, _ := d.Field(eq.Scale(2))
+	if !almostEqual(magnitude(far), b0/8, 1e-12) {
+		t.Errorf("|B| at 2a = %v, want B₀/8", magnitude(far))
+	}
+
+	if _, err := d.Field(vector.Zero(units.Dimension{L: 1})); err == nil {
+		t.Error("Field should reject the origin")
+	}
+	if _, err := d.Field(be); err == nil {
+		t.Error("Field should reject a non-position vector")
+	}
+}
+
+func TestLShell(t *testing.T) {
+	d := IGRF2020
+	lat, lon := d.Pole()
+	// A point at 60° magnetic latitude on the surface lies on L = 4.
+	p := at(lat.ToDegrees()-30, lon.ToDegrees(), ReferenceRadius)
+	if ml, _ := d.MagneticLatitude(p); !almostEqual(ml.ToDegrees(), 60, 1e-12) {
+		t.Errorf("MagneticLatitude() = %v°, want 60°", ml.ToDegrees())
+	}
+	if l, err := d.LShell(p); err != nil || !almostEqual(l, 4, 1e-12) {
+		t.Errorf("LShell() = %v, %v; want 4", l, err)
+	}
+}
+
+func TestParseCoefficients(t *testing.T) {
+	src := `# IGRF-13, epoch 2020
+g 1 0 -29404.8
+g 1 1  -1450.9
+h 1 1   4652.5
+
+g 2 0  -2499.6
+`
+	d, err := ParseCoefficients(strings.NewReader(src))
+	if err != nil || d != IGRF2020 {
+		t.Errorf("ParseCoefficients() = %+v, %v; want IGRF2020", d, err)
+	}
+	for _, bad := range []string{"g 1 1 -1450.9\n", "g 1 0\n", "x 1 0 1\n", "g 1 2 5\n", "h 1 0 3\n", "g 1 0 abc\n"} {
+		if _, err := ParseCoefficients(strings.NewReader(bad)); err == nil {
+			t.Errorf("ParseCoefficients(%q) should fail", bad)
+		}
+	}
+}
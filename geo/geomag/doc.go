@@ -0,0 +1,41 @@
+// Package geomag models the Earth's main magnetic field as a tilted,
+// centered dipole.
+//
+// The dipole is fixed by the three degree-1 Gauss coefficients g₁⁰, g₁¹ and
+// h₁¹ of the International Geomagnetic Reference Field (IGRF). With
+// reference radius a = 6371.2 km, the field at an Earth-centered,
+// Earth-fixed (ECEF) position r is
+//
+//	B(r) = (a/r)³ [3(g·r̂) r̂ - g],   g = (g₁¹, h₁¹, g₁⁰)
+//
+// The field at the surface on the dipole equator is B₀ = |g| ≈ 30 µT. The
+// dipole reproduces the real field to within about 10-20% at the surface,
+// improving with altitude as the higher harmonics fall off faster.
+// Positions and latitudes here are geocentric (spherical). That
+// suits studies of radiation-belt trapping, where drift shells are
+// labeled by the McIlwain parameter L = r/(a cos²λₘ).
+//
+// ParseCoefficients reads Gauss coefficients from text, so the model can
+// be updated to any IGRF or WMM epoch.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/geo"
+//	    "github.com/sakiphan/qsim-core/geo/geomag"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	d := geomag.IGRF2020
+//	lat, lon := d.Pole() // ≈ 80.59°N (geocentric), 72.68°W
+//
+//	// Field at the ISS altitude above Cape Canaveral
+//	r, _ := geo.ToECEF(units.Degree(28.5), units.Degree(-80.6), units.Kilometer(420))
+//	b, _ := d.Field(r)
+//	l, _ := d.LShell(r)
+//
+// References:
+//   - Alken et al. "International Geomagnetic Reference Field: the
+//     thirteenth generation", Earth Planets Space 73, 49 (2021)
+//   - Walt. "Introduction to Geomagnetically Trapped Radiation", Ch. 2-3
+package geomag
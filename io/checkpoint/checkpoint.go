@@ -0,0 +1,115 @@
+package checkpoint
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// Version is the format version written by Save. Load accepts this and
+// earlier versions.
+const Version = 1
+
+var magic = []byte("QSIMCKPT")
+
+// Checkpoint is an ordered set of named sections of encoded state.
+type Checkpoint struct {
+	names    []string
+	sections map[string]*bytes.Buffer
+}
+
+// New returns an empty checkpoint.
+func New() *Checkpoint {
+	return &Checkpoint{sections: map[string]*bytes.Buffer{}}
+}
+
+// Section returns an Encoder appending to the named section, creating it
+// if needed.
+func (c *Checkpoint) Section(name string) *Encoder {
+	buf, ok := c.sections[name]
+	if !ok {
+		buf = &bytes.Buffer{}
+		c.sections[name] = buf
+		c.names = append(c.names, name)
+	}
+	return &Encoder{buf: buf}
+}
+
+// Open returns a Decoder reading the named section from its start.
+func (c *Checkpoint) Open(name string) (*Decoder, error) {
+	buf, ok := c.sections[name]
+	if !ok {
+		return nil, fmt.Errorf("checkpoint has no section %q", name)
+	}
+	return &Decoder{b: buf.Bytes(), section: name}, nil
+}
+
+// Names returns the section names in creation order.
+func (c *Checkpoint) Names() []string {
+	return append([]string(nil), c.names...)
+}
+
+// Save writes the checkpoint to w.
+func (c *Checkpoint) Save(w io.Writer) error {
+	var out bytes.Buffer
+	out.Write(magic)
+	out.Write(binary.LittleEndian.AppendUint16(nil, Version))
+	out.Write(binary.AppendUvarint(nil, uint64(len(c.names))))
+	for _, name := range c.names {
+		payload := c.sections[name].Bytes()
+		out.Write(binary.AppendUvarint(nil, uint64(len(name))))
+		out.WriteString(name)
+		out.Write(binary.AppendUvarint(nil, uint64(len(payload))))
+		out.Write(payload)
+	}
+	out.Write(binary.LittleEndian.AppendUint32(nil, crc32.ChecksumIEEE(out.Bytes())))
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(out.Bytes()); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// Load reads a checkpoint written by Save.
+func Load(r io.Reader) (*Checkpoint, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < len(magic)+2+4 || !bytes.Equal(b[:len(magic)], magic) {
+		return nil, fmt.Errorf("not a checkpoint file")
+	}
+	body, sum := b[:len(b)-4], binary.LittleEndian.Uint32(b[len(b)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return nil, fmt.Errorf("checkpoint checksum mismatch")
+	}
+	if v := binary.LittleEndian.Uint16(body[len(magic):]); v > Version {
+		return nil, fmt.Errorf("checkpoint format version %d is newer than supported version %d", v, Version)
+	}
+
+	d := &Decoder{b: body[len(magic)+2:], section: "header"}
+	c := New()
+	n := d.uvarint()
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		name := d.String()
+		payload := d.Bytes()
+		if d.err != nil {
+			break
+		}
+		if _, dup := c.sections[name]; dup {
+			return nil, fmt.Errorf("checkpoint has duplicate section %q", name)
+		}
+		c.Section(name).buf.Write(payload)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(d.b) != 0 {
+		return nil, fmt.Errorf("checkpoint has %d trailing bytes", len(d.b))
+	}
+	return c, nil
+}
@@ -0,0 +1,191 @@
+package checkpoint
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/dynamics/rigid"
+	"github.com/sakiphan/qsim-core/math/field"
+	"github.com/sakiphan/qsim-core/math/random"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// saveLoad round-trips a checkpoint through its binary encoding.
+func saveLoad(t *testing.T, cp *Checkpoint) *Checkpoint {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := cp.Save(&buf); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	back, err := Load(&buf)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return back
+}
+
+func TestPrimitives(t *testing.T) {
+	cp := New()
+	e := cp.Section("values")
+	e.Uint64(1 << 40)
+	e.Int(-12)
+	e.Bool(true)
+	e.Float64(math.Inf(-1))
+	e.Float64s([]float64{1.5, math.SmallestNonzeroFloat64})
+	e.String("Θ-state")
+	e.Value(units.Joule(4.2).Value)
+	e.Vector3(vector.NewPosition(units.Meter(1), units.Meter(2), units.Meter(3)))
+	e.VectorN(vector.FromSlice([]float64{4, 5}, units.Dimension{T: -1}))
+	cp.Section("empty")
+
+	back := saveLoad(t, cp)
+	if names := back.Names(); len(names) != 2 || names[0] != "values" || names[1] != "empty" {
+		t.Errorf("Names() = %v", names)
+	}
+	d, _ := back.Open("values")
+	if d.Uint64() != 1<<40 || d.Int() != -12 || !d.Bool() || !math.IsInf(d.Float64(), -1) {
+		t.Error("scalar round trip failed")
+	}
+	if f := d.Float64s(); len(f) != 2 || f[1] != math.SmallestNonzeroFloat64 {
+		t.Errorf("Float64s() = %v", f)
+	}
+	if s := d.String(); s != "Θ-state" {
+		t.Errorf("String() = %q", s)
+	}
+	if v := d.Quantity(units.Joule(1).Dim()); v.Val() != 4.2 {
+		t.Errorf("Quantity() = %v", v)
+	}
+	if v := d.Vector3(); v.Z.Val() != 3 || v.Dim() != (units.Dimension{L: 1}) {
+		t.Errorf("Vector3() = %v", v)
+	}
+	if v := d.VectorN(); v.Len() != 2 || v.Dim() != (units.Dimension{T: -1}) {
+		t.Errorf("VectorN() = %v", v)
+	}
+	if d.Err() != nil || d.Remaining() != 0 {
+		t.Errorf("Err() = %v, Remaining() = %d", d.Err(), d.Remaining())
+	}
+
+	// Reading past the end or with the wrong dimension is sticky.
+	d.Float64()
+	if d.Err() == nil {
+		t.Error("reading past the end should fail")
+	}
+	d, _ = back.Open("values")
+	d.Uint64()
+	d.Int()
+	d.Bool()
+	d.Float64()
+	d.Float64s()
+	_ = d.String()
+	d.Quantity(units.Dimension{L: 1})
+	if err := d.Err(); err == nil || !strings.Contains(err.Error(), "expected dimension") {
+		t.Errorf("Quantity() with wrong dimension: Err() = %v", err)
+	}
+	if _, err := back.Open("missing"); err == nil {
+		t.Error("Open() should reject a missing section")
+	}
+}
+
+func TestResumeSimulation(t *testing.T) {
+	// A stochastic ODE run checkpointed halfway must resume bit-for-bit.
+	f := func(_ float64, y, dydt []float64) {
+		dydt[0] = y[1]
+		dydt[1] = -y[0]
+	}
+	kick := func(rng *rand.Rand, y []float64) { y[1] += 1e-3 * rng.NormFloat64() }
+	run := func(src *random.Source, y []float64, t0 float64, n int) float64 {
+		rng := rand.New(src)
+		s := &solver.RK4{}
+		for i := 0; i < n; i++ {
+			s.Step(f, t0, 0.01, y)
+			kick(rng, y)
+			t0 += 0.01
+		}
+		return t0
+	}
+
+	src := random.NewSource(99)
+	y := []float64{1, 0}
+	tm := run(src, y, 0, 500)
+
+	cp := New()
+	e := cp.Section("integrator")
+	e.Value(units.Second(tm).Value)
+	e.Float64s(y)
+	if err := e.Marshaler(src); err != nil {
+		t.Fatalf("Marshaler() error = %v", err)
+	}
+	back := saveLoad(t, cp)
+
+	run(src, y, tm, 500)
+
+	d, _ := back.Open("integrator")
+	t2 := d.Quantity(units.Dimension{T: 1}).Val()
+	y2 := d.Float64s()
+	src2 := &random.Source{}
+	d.Unmarshaler(src2)
+	if err := d.Err(); err != nil {
+		t.Fatalf("restore error = %v", err)
+	}
+	run(src2, y2, t2, 500)
+	if y2[0] != y[0] || y2[1] != y[1] {
+		t.Errorf("resumed state = %v, want %v", y2, y)
+	}
+}
+
+func TestGridAndBody(t *testing.T) {
+	g, _ := field.NewGrid(3, 2, 2, units.Millimeter(1), vector.Zero(units.Dimension{L: 1}), units.Kelvin(1).Dim())
+	g.Set(2, 1, 1, units.Kelvin(310).Value)
+	body, _ := rigid.NewBody(rigid.PrincipalInertia(units.KilogramMeter2(1), units.KilogramMeter2(2), units.KilogramMeter2(3)),
+		vector.Vector3{X: units.RadianPerSecond(0.1).Value, Y: units.RadianPerSecond(2).Value, Z: units.RadianPerSecond(0).Value})
+	body.Step(units.Second(1))
+
+	cp := New()
+	cp.Section("state").Grid(g)
+	cp.Section("state").Body(body)
+	d, _ := saveLoad(t, cp).Open("state")
+	g2, b2 := d.Grid(), d.Body()
+	if err := d.Err(); err != nil {
+		t.Fatalf("restore error = %v", err)
+	}
+	if v := g2.At(2, 1, 1); v.Val() != 310 || v.Dim() != g.Dim() || g2.Spacing().Val() != 1e-3 {
+		t.Errorf("restored grid At(2, 1, 1) = %v", v)
+	}
+	if b2.AngularVelocity != body.AngularVelocity || b2.Inertia() != body.Inertia() {
+		t.Errorf("restored body ω = %v, want %v", b2.AngularVelocity, body.AngularVelocity)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	cp := New()
+	cp.Section("a").Int(1)
+	var buf bytes.Buffer
+	cp.Save(&buf)
+	b := buf.Bytes()
+
+	bad := append([]byte(nil), b...)
+	bad[len(bad)-6] ^= 1
+	if _, err := Load(bytes.NewReader(bad)); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Errorf("Load() corrupted: %v", err)
+	}
+	if _, err := Load(strings.NewReader("QSIMCKPX\x01\x00\x00\x00\x00\x00\x00")); err == nil {
+		t.Error("Load() should reject a bad magic")
+	}
+
+	// A file from a newer format version is refused.
+	var newer bytes.Buffer
+	newer.Write(magic)
+	newer.Write([]byte{Version + 1, 0, 0})
+	body := newer.Bytes()
+	body = binary.LittleEndian.AppendUint32(body, crc32.ChecksumIEEE(body))
+	if _, err := Load(bytes.NewReader(body)); err == nil || !strings.Contains(err.Error(), "newer") {
+		t.Errorf("Load() newer version: %v", err)
+	}
+}
@@ -0,0 +1,360 @@
+package checkpoint
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/dynamics/rigid"
+	"github.com/sakiphan/qsim-core/math/field"
+	"github.com/sakiphan/qsim-core/math/matrix"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Encoder appends values to a checkpoint section.
+type Encoder struct {
+	buf *bytes.Buffer
+}
+
+// Uint64 appends an unsigned integer.
+func (e *Encoder) Uint64(x uint64) {
+	e.buf.Write(binary.AppendUvarint(nil, x))
+}
+
+// Int appends a signed integer.
+func (e *Encoder) Int(x int) {
+	e.buf.Write(binary.AppendVarint(nil, int64(x)))
+}
+
+// Bool appends a boolean.
+func (e *Encoder) Bool(x bool) {
+	if x {
+		e.buf.WriteByte(1)
+	} else {
+		e.buf.WriteByte(0)
+	}
+}
+
+// Float64 appends a float64 bit-exactly.
+func (e *Encoder) Float64(x float64) {
+	e.buf.Write(binary.LittleEndian.AppendUint64(nil, math.Float64bits(x)))
+}
+
+// Float64s appends a slice of float64, such as an integrator state vector.
+func (e *Encoder) Float64s(x []float64) {
+	e.Uint64(uint64(len(x)))
+	for _, v := range x {
+		e.Float64(v)
+	}
+}
+
+// String appends a string.
+func (e *Encoder) String(s string) {
+	e.Uint64(uint64(len(s)))
+	e.buf.WriteString(s)
+}
+
+// Bytes appends a byte slice.
+func (e *Encoder) Bytes(b []byte) {
+	e.Uint64(uint64(len(b)))
+	e.buf.Write(b)
+}
+
+// Dimension appends a dimension.
+func (e *Encoder) Dimension(d units.Dimension) {
+	e.buf.Write([]byte{byte(d.L), byte(d.M), byte(d.T), byte(d.I), byte(d.Θ), byte(d.N), byte(d.J)})
+}
+
+// Value appends a unit-safe value with its dimension.
+func (e *Encoder) Value(v units.Value) {
+	e.Dimension(v.Dim())
+	e.Float64(v.Val())
+}
+
+// Vector3 appends a vector with its dimension.
+func (e *Encoder) Vector3(v vector.Vector3) {
+	e.Dimension(v.Dim())
+	for _, x := range v.ToArray() {
+		e.Float64(x)
+	}
+}
+
+// VectorN appends an N-vector with its dimension.
+func (e *Encoder) VectorN(v vector.VectorN) {
+	e.Dimension(v.Dim())
+	e.Float64s(v.ToSlice())
+}
+
+// Matrix3 appends a 3×3 matrix with its dimension.
+func (e *Encoder) Matrix3(m matrix.Matrix3) {
+	e.Dimension(m.Dim())
+	for _, row := range m.ToArray() {
+		for _, x := range row {
+			e.Float64(x)
+		}
+	}
+}
+
+// Grid appends a field grid: its size, spacing, origin, dimension and
+// values.
+func (e *Encoder) Grid(g *field.Grid) {
+	nx, ny, nz := g.Size()
+	e.Int(nx)
+	e.Int(ny)
+	e.Int(nz)
+	e.Value(g.Spacing().Value)
+	e.Vector3(g.Origin())
+	e.Dimension(g.Dim())
+	e.Float64s(g.Values())
+}
+
+// Body appends a rigid body's inertia tensor and angular velocity.
+func (e *Encoder) Body(b *rigid.Body) {
+	e.Matrix3(b.Inertia().Matrix3)
+	e.Vector3(b.AngularVelocity)
+}
+
+// Marshaler appends the binary encoding of m, such as the state of a
+// random.Source.
+func (e *Encoder) Marshaler(m encoding.BinaryMarshaler) error {
+	b, err := m.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	e.Bytes(b)
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Decoder
+// -----------------------------------------------------------------------------
+
+// Decoder reads values from a checkpoint section in the order they were
+// written. After the first failure every method returns a zero value and
+// Err reports the error, so a sequence of reads needs one check at the end.
+type Decoder struct {
+	b       []byte
+	section string
+	err     error
+}
+
+// Err returns the first error encountered, if any.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// Remaining returns the number of unread bytes in the section.
+func (d *Decoder) Remaining() int {
+	return len(d.b)
+}
+
+func (d *Decoder) fail(format string, args ...any) {
+	if d.err == nil {
+		d.err = fmt.Errorf("section %q: %s", d.section, fmt.Sprintf(format, args...))
+	}
+}
+
+func (d *Decoder) take(n uint64) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.b)) {
+		d.fail("unexpected end of data")
+		return nil
+	}
+	out := d.b[:n]
+	d.b = d.b[n:]
+	return out
+}
+
+func (d *Decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	x, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		d.fail("malformed integer")
+		return 0
+	}
+	d.b = d.b[n:]
+	return x
+}
+
+// Uint64 reads an unsigned integer.
+func (d *Decoder) Uint64() uint64 {
+	return d.uvarint()
+}
+
+// Int reads a signed integer.
+func (d *Decoder) Int() int {
+	if d.err != nil {
+		return 0
+	}
+	x, n := binary.Varint(d.b)
+	if n <= 0 {
+		d.fail("malformed integer")
+		return 0
+	}
+	d.b = d.b[n:]
+	return int(x)
+}
+
+// Bool reads a boolean.
+func (d *Decoder) Bool() bool {
+	b := d.take(1)
+	return b != nil && b[0] != 0
+}
+
+// Float64 reads a float64.
+func (d *Decoder) Float64() float64 {
+	b := d.take(8)
+	if b == nil {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b))
+}
+
+// Float64s reads a slice of float64.
+func (d *Decoder) Float64s() []float64 {
+	n := d.uvarint()
+	if n > uint64(len(d.b))/8 {
+		d.fail("slice of %d values exceeds remaining data", n)
+		return nil
+	}
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = d.Float64()
+	}
+	return out
+}
+
+// String reads a string.
+func (d *Decoder) String() string {
+	return string(d.take(d.uvarint()))
+}
+
+// Bytes reads a byte slice.
+func (d *Decoder) Bytes() []byte {
+	return append([]byte(nil), d.take(d.uvarint())...)
+}
+
+// Dimension reads a dimension.
+func (d *Decoder) Dimension() units.Dimension {
+	b := d.take(7)
+	if b == nil {
+		return units.Dimension{}
+	}
+	return units.Dimension{
+		L: int8(b[0]), M: int8(b[1]), T: int8(b[2]), I: int8(b[3]), Θ: int8(b[4]), N: int8(b[5]), J: int8(b[6]),
+	}
+}
+
+// Value reads a unit-safe value.
+func (d *Decoder) Value() units.Value {
+	dim := d.Dimension()
+	return units.NewValue(d.Float64(), dim)
+}
+
+// Quantity reads a value that must have dimension dim, failing otherwise.
+//
+// Example:
+//
+//	t := units.Second(d.Quantity(units.Dimension{T: 1}).Val())
+func (d *Decoder) Quantity(dim units.Dimension) units.Value {
+	v := d.Value()
+	if d.err == nil && v.Dim() != dim {
+		d.fail("expected dimension %s, got %s", dim, v.Dim())
+	}
+	return v
+}
+
+// Vector3 reads a vector.
+func (d *Decoder) Vector3() vector.Vector3 {
+	dim := d.Dimension()
+	return vector.Vector3{
+		X: units.NewValue(d.Float64(), dim),
+		Y: units.NewValue(d.Float64(), dim),
+		Z: units.NewValue(d.Float64(), dim),
+	}
+}
+
+// VectorN reads an N-vector.
+func (d *Decoder) VectorN() vector.VectorN {
+	dim := d.Dimension()
+	return vector.FromSlice(d.Float64s(), dim)
+}
+
+// Matrix3 reads a 3×3 matrix.
+func (d *Decoder) Matrix3() matrix.Matrix3 {
+	dim := d.Dimension()
+	var a [3][3]float64
+	for i := range a {
+		for j := range a[i] {
+			a[i][j] = d.Float64()
+		}
+	}
+	return matrix.New(a, dim)
+}
+
+// Grid reads a field grid. Returns nil on failure.
+func (d *Decoder) Grid() *field.Grid {
+	nx, ny, nz := d.Int(), d.Int(), d.Int()
+	h := d.Quantity(units.Dimension{L: 1})
+	origin := d.Vector3()
+	dim := d.Dimension()
+	values := d.Float64s()
+	if d.err != nil {
+		return nil
+	}
+	g, err := field.NewGrid(nx, ny, nz, units.Meter(h.Val()), origin, dim)
+	if err != nil {
+		d.fail("grid: %v", err)
+		return nil
+	}
+	if len(values) != nx*ny*nz {
+		d.fail("grid has %d values for %d×%d×%d nodes", len(values), nx, ny, nz)
+		return nil
+	}
+	for k := 0; k < nz; k++ {
+		for j := 0; j < ny; j++ {
+			for i := 0; i < nx; i++ {
+				g.Set(i, j, k, units.NewValue(values[(k*ny+j)*nx+i], dim))
+			}
+		}
+	}
+	return g
+}
+
+// Body reads a rigid body. Returns nil on failure.
+func (d *Decoder) Body() *rigid.Body {
+	m := d.Matrix3()
+	omega := d.Vector3()
+	if d.err != nil {
+		return nil
+	}
+	inertia, err := rigid.NewInertiaTensor(m)
+	if err != nil {
+		d.fail("body: %v", err)
+		return nil
+	}
+	b, err := rigid.NewBody(inertia, omega)
+	if err != nil {
+		d.fail("body: %v", err)
+		return nil
+	}
+	return b
+}
+
+// Unmarshaler reads bytes written by Encoder.Marshaler into u.
+func (d *Decoder) Unmarshaler(u encoding.BinaryUnmarshaler) {
+	b := d.Bytes()
+	if d.err != nil {
+		return
+	}
+	if err := u.UnmarshalBinary(b); err != nil {
+		d.fail("%v", err)
+	}
+}
@@ -0,0 +1,43 @@
+// Package checkpoint saves simulation state to a versioned binary file and
+// restores it, so long runs survive restarts.
+//
+// A Checkpoint is an ordered set of named sections. Each section is
+// written through an Encoder and read back through a Decoder in the same
+// order: scalars, strings, unit-safe Values and vectors, matrices, field
+// grids, rigid bodies, and any encoding.BinaryMarshaler, such as the
+// random.Source driving a stochastic run. Integrator internals beyond the
+// state vector — the current time, step size and any controller history —
+// are saved the same way, as plain values in their own section.
+//
+// The file layout is
+//
+//	"QSIMCKPT"  magic (8 bytes)
+//	version     uint16, little-endian
+//	count       uvarint number of sections
+//	sections    name (uvarint length + UTF-8) and payload (uvarint length + bytes)
+//	crc         CRC-32 (IEEE) of everything above, uint32 little-endian
+//
+// Values are stored little-endian in SI base units with their dimension,
+// so restoring a quantity of the wrong kind is reported as an error
+// rather than silently reinterpreted. Load rejects files with a newer
+// format version or a bad checksum.
+//
+// Example usage:
+//
+//	import "github.com/sakiphan/qsim-core/io/checkpoint"
+//
+//	cp := checkpoint.New()
+//	e := cp.Section("integrator")
+//	e.Value(t.Value)
+//	e.Float64s(y)
+//	e.Marshaler(src)
+//	cp.Save(f)
+//
+//	// ... after a restart
+//	cp, _ = checkpoint.Load(f)
+//	d, _ := cp.Open("integrator")
+//	t := d.Quantity(units.Dimension{T: 1})
+//	y := d.Float64s()
+//	d.Unmarshaler(src)
+//	if err := d.Err(); err != nil { ... }
+package checkpoint
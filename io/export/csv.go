@@ -0,0 +1,31 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes the table as CSV with a header row labelling each column
+// with its SI unit, such as "v_x [m s^-1]". Values are written in SI base
+// units with the shortest exact representation.
+func WriteCSV(w io.Writer, t *Table) error {
+	cw := csv.NewWriter(w)
+	row := make([]string, len(t.columns))
+	for j, c := range t.columns {
+		row[j] = c.Name + " [" + c.Dim.Symbol() + "]"
+	}
+	if err := cw.Write(row); err != nil {
+		return err
+	}
+	for i := 0; i < t.Rows(); i++ {
+		for j, c := range t.columns {
+			row[j] = strconv.FormatFloat(c.Data[i], 'g', -1, 64)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
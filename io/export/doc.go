@@ -0,0 +1,34 @@
+// Package export writes simulation results as tables for analysis outside
+// Go, in CSV and Apache Parquet formats.
+//
+// A Table is a set of equally long float64 columns, each carrying a name
+// and a physical dimension, with values in SI base units. Tables are built
+// column by column or directly from timeseries output; vector series
+// expand into one column per component.
+//
+// WriteCSV labels each column with its SI unit, "name [kg m^2 s^-2]",
+// which pandas and spreadsheets read as an ordinary header. WriteParquet
+// writes a single row group of required DOUBLE columns, PLAIN-encoded and
+// uncompressed, readable by pyarrow, pandas and Spark; each column's unit
+// is stored in the file's key-value metadata under "<name>.unit".
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/io/export"
+//	    "github.com/sakiphan/qsim-core/timeseries"
+//	)
+//
+//	t := export.FromVectorSeries("r", positions) // columns t, r_x, r_y, r_z
+//	t.AddSeries("E", energy)                       // sampled at the same times
+//
+//	f, _ := os.Create("run.parquet")
+//	defer f.Close()
+//	export.WriteParquet(f, t)
+//
+//	// In Python: pandas.read_parquet("run.parquet")
+//
+// References:
+//   - RFC 4180. "Common Format and MIME Type for CSV Files"
+//   - Apache Parquet format specification, https://parquet.apache.org/docs/file-format/
+package export
@@ -0,0 +1,218 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/timeseries"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// testTable returns a trajectory table with columns t, r_x, r_y, r_z and E.
+func testTable(t *testing.T) *Table {
+	r := timeseries.NewVector(units.Dimension{L: 1})
+	e := timeseries.New(units.Joule(1).Dim())
+	for i := 0; i < 20; i++ {
+		tm := units.Second(0.1 * float64(i))
+		r.Append(tm, vector.NewPosition(units.Meter(float64(i)), units.Meter(-0.5), units.Meter(math.Pi)))
+		e.Append(tm, units.Joule(1e-3*float64(i*i)).Value)
+	}
+	tab := FromVectorSeries("r", r)
+	if err := tab.AddSeries("E", e); err != nil {
+		t.Fatalf("AddSeries() error = %v", err)
+	}
+	return tab
+}
+
+// -----------------------------------------------------------------------------
+// Table Tests
+// -----------------------------------------------------------------------------
+
+func TestTable(t *testing.T) {
+	tab := testTable(t)
+	if tab.Rows() != 20 || len(tab.Columns()) != 5 {
+		t.Fatalf("table is %d×%d, want 20×5", tab.Rows(), len(tab.Columns()))
+	}
+	if err := tab.AddColumn("E", units.Dimension{}, make([]float64, 20)); err == nil {
+		t.Error("AddColumn() should reject a duplicate name")
+	}
+	if err := tab.AddColumn("q", units.Dimension{}, make([]float64, 3)); err == nil {
+		t.Error("AddColumn() should reject a column of the wrong length")
+	}
+	mixed := []units.Value{units.Meter(1).Value, units.Second(1).Value}
+	if err := NewTable().AddValues("x", mixed); err == nil {
+		t.Error("AddValues() should reject mixed dimensions")
+	}
+	shifted := timeseries.New(units.Dimension{})
+	for i := 0; i < 20; i++ {
+		shifted.Append(units.Second(float64(i)), units.Dimensionless(0))
+	}
+	if err := tab.AddSeries("s", shifted); err == nil {
+		t.Error("AddSeries() should reject a series sampled at other times")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	tab := NewTable()
+	tab.AddValues("v", []units.Value{units.MeterPerSecond(1.5).Value, units.MeterPerSecond(-2).Value})
+	tab.AddColumn("n", units.Dimension{}, []float64{1e-30, 7})
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, tab); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	want := "v [m s^-1],n [1]\n1.5,1e-30\n-2,7\n"
+	if buf.String() != want {
+		t.Errorf("WriteCSV() = %q, want %q", buf.String(), want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Parquet Tests
+// -----------------------------------------------------------------------------
+
+// thriftReader decodes Thrift compact structs generically: struct fields
+// map to int64, string, []any or map[int16]any.
+type thriftReader struct {
+	b []byte
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	u, n := binary.Uvarint(r.b)
+	r.b = r.b[n:]
+	return u
+}
+
+func (r *thriftReader) varint() int64 {
+	u := r.uvarint()
+	return int64(u>>1) ^ -int64(u&1)
+}
+
+func (r *thriftReader) value(typ byte) any {
+	switch typ {
+	case thriftI32, thriftI64:
+		return r.varint()
+	case thriftBinary:
+		n := r.uvarint()
+		s := string(r.b[:n])
+		r.b = r.b[n:]
+		return s
+	case thriftList:
+		h := r.b[0]
+		r.b = r.b[1:]
+		n := int(h >> 4)
+		if n == 15 {
+			n = int(r.uvarint())
+		}
+		out := make([]any, n)
+		for i := range out {
+			out[i] = r.value(h & 0x0f)
+		}
+		return out
+	case thriftStruct:
+		return r.structure()
+	}
+	panic("unsupported thrift type")
+}
+
+func (r *thriftReader) structure() map[int16]any {
+	fields := map[int16]any{}
+	var id int16
+	for {
+		h := r.b[0]
+		r.b = r.b[1:]
+		if h == 0 {
+			return fields
+		}
+		if d := int16(h >> 4); d != 0 {
+			id += d
+		} else {
+			id = int16(r.varint())
+		}
+		fields[id] = r.value(h & 0x0f)
+	}
+}
+
+func TestWriteParquet(t *testing.T) {
+	tab := testTable(t)
+	var buf bytes.Buffer
+	if err := WriteParquet(&buf, tab); err != nil {
+		t.Fatalf("WriteParquet() error = %v", err)
+	}
+	file := buf.Bytes()
+	if !bytes.HasPrefix(file, parquetMagic) || !bytes.HasSuffix(file, parquetMagic) {
+		t.Fatal("file must begin and end with PAR1")
+	}
+	n := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footer := &thriftReader{file[len(file)-8-n : len(file)-8]}
+	meta := footer.structure()
+	if len(footer.b) != 0 {
+		t.Errorf("footer has %d trailing bytes", len(footer.b))
+	}
+
+	if meta[3] != int64(20) {
+		t.Errorf("num_rows = %v, want 20", meta[3])
+	}
+	schema := meta[2].([]any)
+	if len(schema) != 6 || schema[0].(map[int16]any)[5] != int64(5) {
+		t.Fatalf("schema = %v, want root with 5 children", schema)
+	}
+	for i, name := range []string{"t", "r_x", "r_y", "r_z", "E"} {
+		if got := schema[i+1].(map[int16]any)[4]; got != name {
+			t.Errorf("schema[%d] name = %v, want %s", i+1, got, name)
+		}
+	}
+
+	unitMeta := map[string]string{}
+	for _, kv := range meta[5].([]any) {
+		f := kv.(map[int16]any)
+		unitMeta[f[1].(string)] = f[2].(string)
+	}
+	if unitMeta["E.unit"] != "kg m^2 s^-2" || unitMeta["r_y.unit"] != "m" || unitMeta["t.unit"] != "s" {
+		t.Errorf("unit metadata = %v", unitMeta)
+	}
+
+	// Read column E back through its page header.
+	chunks := meta[4].([]any)[0].(map[int16]any)[1].([]any)
+	cm := chunks[4].(map[int16]any)[3].(map[int16]any)
+	off := cm[9].(int64)
+	page := &thriftReader{file[off:]}
+	header := page.structure()
+	if header[1] != int64(parquetDataPage) || header[5].(map[int16]any)[1] != int64(20) {
+		t.Fatalf("page header = %v", header)
+	}
+	if size := int64(len(file[off:]) - len(page.b)); size+header[3].(int64) != cm[7].(int64) {
+		t.Errorf("header + page = %d + %v bytes, want %v", size, header[3], cm[7])
+	}
+	for i := 0; i < 20; i++ {
+		got := math.Float64frombits(binary.LittleEndian.Uint64(page.b[8*i:]))
+		if want := 1e-3 * float64(i*i); got != want {
+			t.Errorf("E[%d] = %v, want %v", i, got, want)
+		}
+	}
+
+	if err := WriteParquet(&buf, NewTable()); err == nil {
+		t.Error("WriteParquet() should reject an empty table")
+	}
+}
+
+func TestThriftLongList(t *testing.T) {
+	// Lists of 15 or more elements and field-id jumps over 15 use the long forms.
+	var w thriftWriter
+	w.beginList(1, thriftI32, 20)
+	for i := 0; i < 20; i++ {
+		w.i32Element(int32(-i))
+	}
+	w.binary(40, strings.Repeat("x", 200))
+	w.stop()
+	got := (&thriftReader{w.buf.Bytes()}).structure()
+	if l := got[1].([]any); len(l) != 20 || l[19] != int64(-19) {
+		t.Errorf("list = %v", l)
+	}
+	if s := got[40].(string); len(s) != 200 {
+		t.Errorf("field 40 length = %d, want 200", len(s))
+	}
+}
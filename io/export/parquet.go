@@ -0,0 +1,227 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Parquet enum values used by the writer.
+const (
+	parquetDouble       = 5 // Type.DOUBLE
+	parquetRequired     = 0 // FieldRepetitionType.REQUIRED
+	parquetPlain        = 0 // Encoding.PLAIN
+	parquetRLE          = 3 // Encoding.RLE
+	parquetUncompressed = 0 // CompressionCodec.UNCOMPRESSED
+	parquetDataPage     = 0 // PageType.DATA_PAGE
+)
+
+var parquetMagic = []byte("PAR1")
+
+// WriteParquet writes the table as an Apache Parquet file with one row
+// group and one uncompressed data page per column. The table must have at
+// least one column.
+func WriteParquet(w io.Writer, t *Table) error {
+	if len(t.columns) == 0 {
+		return fmt.Errorf("cannot write a table without columns")
+	}
+	rows := int64(t.Rows())
+
+	file := bytes.NewBuffer(append([]byte(nil), parquetMagic...))
+	offsets := make([]int64, len(t.columns))
+	sizes := make([]int64, len(t.columns))
+	for j, c := range t.columns {
+		data := make([]byte, 8*len(c.Data))
+		for i, x := range c.Data {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(x))
+		}
+
+		var header thriftWriter
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(data)))
+		header.i32(3, int32(len(data)))
+		header.beginStruct(5) // DataPageHeader
+		header.i32(1, int32(len(c.Data)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.endStruct()
+		header.stop()
+
+		offsets[j] = int64(file.Len())
+		sizes[j] = int64(header.buf.Len() + len(data))
+		file.Write(header.buf.Bytes())
+		file.Write(data)
+	}
+
+	var meta thriftWriter
+	meta.i32(1, 1) // version
+	meta.beginList(2, thriftStruct, len(t.columns)+1)
+	meta.beginElement() // root SchemaElement
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(t.columns)))
+	meta.endElement()
+	for _, c := range t.columns {
+		meta.beginElement()
+		meta.i32(1, parquetDouble)
+		meta.i32(3, parquetRequired)
+		meta.binary(4, c.Name)
+		meta.endElement()
+	}
+	meta.i64(3, rows)
+
+	var total int64
+	for _, s := range sizes {
+		total += s
+	}
+	meta.beginList(4, thriftStruct, 1)
+	meta.beginElement() // RowGroup
+	meta.beginList(1, thriftStruct, len(t.columns))
+	for j, c := range t.columns {
+		meta.beginElement() // ColumnChunk
+		meta.i64(2, offsets[j])
+		meta.beginStruct(3) // ColumnMetaData
+		meta.i32(1, parquetDouble)
+		meta.beginList(2, thriftI32, 1)
+		meta.i32Element(parquetPlain)
+		meta.beginList(3, thriftBinary, 1)
+		meta.binaryElement(c.Name)
+		meta.i32(4, parquetUncompressed)
+		meta.i64(5, rows)
+		meta.i64(6, sizes[j])
+		meta.i64(7, sizes[j])
+		meta.i64(9, offsets[j])
+		meta.endStruct()
+		meta.endElement()
+	}
+	meta.i64(2, total)
+	meta.i64(3, rows)
+	meta.endElement()
+
+	meta.beginList(5, thriftStruct, len(t.columns))
+	for _, c := range t.columns {
+		meta.beginElement() // KeyValue
+		meta.binary(1, c.Name+".unit")
+		meta.binary(2, c.Dim.Symbol())
+		meta.endElement()
+	}
+	meta.binary(6, "qsim-core")
+	meta.stop()
+
+	file.Write(meta.buf.Bytes())
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(meta.buf.Len()))
+	file.Write(length[:])
+	file.Write(parquetMagic)
+
+	_, err := w.Write(file.Bytes())
+	return err
+}
+
+// -----------------------------------------------------------------------------
+// Thrift Compact Protocol
+// -----------------------------------------------------------------------------
+
+// Compact protocol type codes.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes structs in the Thrift compact protocol, the
+// serialization of Parquet page headers and file metadata. Fields must be
+// written in increasing id order within each struct.
+type thriftWriter struct {
+	buf  bytes.Buffer
+	last []int16 // last field id of each enclosing struct; the top is current
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	if len(w.last) == 0 {
+		w.last = []int16{0}
+	}
+	top := &w.last[len(w.last)-1]
+	if d := id - *top; d > 0 && d <= 15 {
+		w.buf.WriteByte(byte(d)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.varint(int64(id))
+	}
+	*top = id
+}
+
+// varint writes a zigzag-encoded variable-length integer.
+func (w *thriftWriter) varint(v int64) {
+	w.uvarint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+func (w *thriftWriter) uvarint(u uint64) {
+	var b [binary.MaxVarintLen64]byte
+	w.buf.Write(b[:binary.PutUvarint(b[:], u)])
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.varint(v)
+}
+
+func (w *thriftWriter) binary(id int16, s string) {
+	w.field(id, thriftBinary)
+	w.binaryElement(s)
+}
+
+func (w *thriftWriter) beginStruct(id int16) {
+	w.field(id, thriftStruct)
+	w.last = append(w.last, 0)
+}
+
+func (w *thriftWriter) endStruct() {
+	w.stop()
+	w.last = w.last[:len(w.last)-1]
+}
+
+// stop terminates the current struct.
+func (w *thriftWriter) stop() {
+	w.buf.WriteByte(0)
+}
+
+func (w *thriftWriter) beginList(id int16, elem byte, n int) {
+	w.field(id, thriftList)
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | elem)
+	} else {
+		w.buf.WriteByte(0xf0 | elem)
+		w.uvarint(uint64(n))
+	}
+}
+
+// beginElement starts a struct element of a list.
+func (w *thriftWriter) beginElement() {
+	if len(w.last) == 0 {
+		w.last = []int16{0}
+	}
+	w.last = append(w.last, 0)
+}
+
+func (w *thriftWriter) endElement() {
+	w.endStruct()
+}
+
+func (w *thriftWriter) i32Element(v int32) {
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) binaryElement(s string) {
+	w.uvarint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
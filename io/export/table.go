@@ -0,0 +1,120 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/timeseries"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Column is a named column of values in SI base units sharing a dimension.
+type Column struct {
+	Name string
+	Dim  units.Dimension
+	Data []float64
+}
+
+// Table is an ordered set of equally long columns with unique names.
+type Table struct {
+	columns []Column
+}
+
+// NewTable returns an empty table.
+func NewTable() *Table {
+	return &Table{}
+}
+
+// FromSeries returns a table with a time column "t" and the series values
+// in a column with the given name.
+func FromSeries(name string, s *timeseries.Series) *Table {
+	t := &Table{}
+	t.columns = []Column{
+		{Name: "t", Dim: units.Dimension{T: 1}, Data: s.Times()},
+		{Name: name, Dim: s.Dim(), Data: s.Values()},
+	}
+	return t
+}
+
+// FromVectorSeries returns a table with a time column "t" and the
+// components of the series in columns name_x, name_y and name_z.
+func FromVectorSeries(name string, s *timeseries.VectorSeries) *Table {
+	t := &Table{}
+	x := s.X()
+	t.columns = []Column{
+		{Name: "t", Dim: units.Dimension{T: 1}, Data: x.Times()},
+		{Name: name + "_x", Dim: s.Dim(), Data: x.Values()},
+		{Name: name + "_y", Dim: s.Dim(), Data: s.Y().Values()},
+		{Name: name + "_z", Dim: s.Dim(), Data: s.Z().Values()},
+	}
+	return t
+}
+
+// Rows returns the number of rows.
+func (t *Table) Rows() int {
+	if len(t.columns) == 0 {
+		return 0
+	}
+	return len(t.columns[0].Data)
+}
+
+// Columns returns the columns in order. The data slices are shared with
+// the table.
+func (t *Table) Columns() []Column {
+	return append([]Column(nil), t.columns...)
+}
+
+// AddColumn appends a column of values in SI base units of dim. The data
+// is copied. The name must be non-empty and unique, and the length must
+// match the existing columns.
+func (t *Table) AddColumn(name string, dim units.Dimension, data []float64) error {
+	if name == "" {
+		return fmt.Errorf("column name must not be empty")
+	}
+	for _, c := range t.columns {
+		if c.Name == name {
+			return fmt.Errorf("duplicate column %q", name)
+		}
+	}
+	if len(t.columns) > 0 && len(data) != t.Rows() {
+		return fmt.Errorf("column %q has %d rows, table has %d", name, len(data), t.Rows())
+	}
+	t.columns = append(t.columns, Column{Name: name, Dim: dim, Data: append([]float64(nil), data...)})
+	return nil
+}
+
+// AddValues appends a column of unit-safe values, which must share one
+// dimension.
+func (t *Table) AddValues(name string, values []units.Value) error {
+	if len(values) == 0 {
+		return t.AddColumn(name, units.Dimension{}, nil)
+	}
+	dim := values[0].Dim()
+	data := make([]float64, len(values))
+	for i, v := range values {
+		if v.Dim() != dim {
+			return fmt.Errorf("column %q values must have same dimension: [0]=%s, [%d]=%s", name, dim, i, v.Dim())
+		}
+		data[i] = v.Val()
+	}
+	return t.AddColumn(name, dim, data)
+}
+
+// AddSeries appends the values of a series sampled at the same times as
+// the table's "t" column.
+func (t *Table) AddSeries(name string, s *timeseries.Series) error {
+	if s.Len() != t.Rows() {
+		return fmt.Errorf("series %q has %d samples, table has %d rows", name, s.Len(), t.Rows())
+	}
+	for _, c := range t.columns {
+		if c.Name != "t" {
+			continue
+		}
+		times := s.Times()
+		for i, tm := range c.Data {
+			if times[i] != tm {
+				return fmt.Errorf("series %q sample %d at %g s, table row at %g s", name, i, times[i], tm)
+			}
+		}
+	}
+	return t.AddColumn(name, s.Dim(), s.Values())
+}
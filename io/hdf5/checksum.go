@@ -0,0 +1,71 @@
+package hdf5
+
+import "math/bits"
+
+// checksum returns Bob Jenkins' lookup3 hash (hashlittle) of b with a zero
+// initial value, the checksum HDF5 stores in its version 2 metadata
+// structures.
+func checksum(b []byte) uint32 {
+	a := 0xdeadbeef + uint32(len(b))
+	bb, c := a, a
+	for len(b) > 12 {
+		a += le32(b[0:])
+		bb += le32(b[4:])
+		c += le32(b[8:])
+		a, bb, c = mix(a, bb, c)
+		b = b[12:]
+	}
+	if len(b) == 0 {
+		return c
+	}
+	var tail [12]byte
+	copy(tail[:], b)
+	a += le32(tail[0:])
+	bb += le32(tail[4:])
+	c += le32(tail[8:])
+	return final(a, bb, c)
+}
+
+func le32(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+}
+
+func mix(a, b, c uint32) (uint32, uint32, uint32) {
+	a -= c
+	a ^= bits.RotateLeft32(c, 4)
+	c += b
+	b -= a
+	b ^= bits.RotateLeft32(a, 6)
+	a += c
+	c -= b
+	c ^= bits.RotateLeft32(b, 8)
+	b += a
+	a -= c
+	a ^= bits.RotateLeft32(c, 16)
+	c += b
+	b -= a
+	b ^= bits.RotateLeft32(a, 19)
+	a += c
+	c -= b
+	c ^= bits.RotateLeft32(b, 4)
+	b += a
+	return a, b, c
+}
+
+func final(a, b, c uint32) uint32 {
+	c ^= b
+	c -= bits.RotateLeft32(b, 14)
+	a ^= c
+	a -= bits.RotateLeft32(c, 11)
+	b ^= a
+	b -= bits.RotateLeft32(a, 25)
+	c ^= b
+	c -= bits.RotateLeft32(b, 16)
+	a ^= c
+	a -= bits.RotateLeft32(c, 4)
+	b ^= a
+	b -= bits.RotateLeft32(a, 14)
+	c ^= b
+	c -= bits.RotateLeft32(b, 24)
+	return c
+}
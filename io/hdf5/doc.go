@@ -0,0 +1,46 @@
+// Package hdf5 reads and writes HDF5 files holding simulation output, the
+// format shared with h5py, MATLAB, ParaView and most scientific tooling.
+//
+// A File is an in-memory tree of Groups and Datasets. Datasets hold
+// float64 values in row-major order with an arbitrary shape; groups and
+// datasets carry attributes of type string, float64 or int64, or slices of
+// those. WriteTo encodes the tree and Read decodes it.
+//
+// The implementation is self-contained and covers the subset of the format
+// needed for this: superblock version 2, version 2 object headers with
+// Jenkins lookup3 checksums, compact link storage, and contiguous
+// uncompressed datasets. This is the layout the HDF5 library produces
+// with the latest file format (h5py.File(..., libver="latest")) for
+// moderately sized groups, and files written here open in HDF5 1.8 and
+// later. Chunked or filtered datasets, dense link storage and the older
+// symbol-table groups are not supported by Read.
+//
+// Physical quantities record their dimension in two attributes: "units",
+// the SI unit symbol for readers, and "dimension", the seven base-dimension
+// exponents, which Dataset.Dim reads back. WriteSeries, WriteVectorSeries
+// and WriteGrid store timeseries, trajectories and field grids in this
+// form, and the matching Read functions restore them.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/io/hdf5"
+//	)
+//
+//	f := hdf5.NewFile()
+//	f.Root().SetAttr("scenario", "two-body")
+//	run, _ := f.Root().CreateGroup("run")
+//	hdf5.WriteVectorSeries(run, "earth", earthPositions)
+//	hdf5.WriteGrid(run, "potential", phi)
+//
+//	out, _ := os.Create("run.h5")
+//	f.WriteTo(out)
+//	out.Close()
+//
+//	// In Python:
+//	//   h5py.File("run.h5")["run/earth/value"].attrs["units"]  -> "m"
+//
+// References:
+//   - The HDF Group. "HDF5 File Format Specification Version 3.0"
+//   - Jenkins. "lookup3.c", http://burtleburtle.net/bob/c/lookup3.c (2006)
+package hdf5
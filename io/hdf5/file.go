@@ -0,0 +1,216 @@
+package hdf5
+
+import (
+	"fmt"
+	"strings"
+)
+
+// File is an in-memory HDF5 file: a tree of groups and datasets rooted at
+// the root group "/".
+type File struct {
+	root *Group
+}
+
+// NewFile returns an empty file.
+func NewFile() *File {
+	return &File{root: &Group{}}
+}
+
+// Root returns the root group.
+func (f *File) Root() *Group {
+	return f.root
+}
+
+// Group is an HDF5 group holding named child groups and datasets, kept in
+// creation order, and attributes.
+type Group struct {
+	attributes
+	children []link
+}
+
+// link names a child group or dataset; exactly one of the two is set.
+type link struct {
+	name    string
+	group   *Group
+	dataset *Dataset
+}
+
+// Dataset is an HDF5 dataset of float64 values stored row-major (C order)
+// with the given shape, and its attributes.
+type Dataset struct {
+	attributes
+	shape []int
+	data  []float64
+}
+
+// Shape returns the extent of each axis.
+func (d *Dataset) Shape() []int {
+	return append([]int(nil), d.shape...)
+}
+
+// Data returns a copy of the values in row-major order.
+func (d *Dataset) Data() []float64 {
+	return append([]float64(nil), d.data...)
+}
+
+// Names returns the names of the group's children in creation order.
+func (g *Group) Names() []string {
+	names := make([]string, len(g.children))
+	for i, c := range g.children {
+		names[i] = c.name
+	}
+	return names
+}
+
+// CreateGroup adds an empty child group.
+func (g *Group) CreateGroup(name string) (*Group, error) {
+	if err := g.checkName(name); err != nil {
+		return nil, err
+	}
+	child := &Group{}
+	g.children = append(g.children, link{name: name, group: child})
+	return child, nil
+}
+
+// CreateDataset adds a dataset holding data with the given shape. With no
+// shape the dataset is one-dimensional. The data is copied; its length
+// must equal the product of the shape.
+//
+// Example:
+//
+//	// 100 positions as a 100×3 table
+//	d, _ := g.CreateDataset("position", xyz, 100, 3)
+func (g *Group) CreateDataset(name string, data []float64, shape ...int) (*Dataset, error) {
+	if err := g.checkName(name); err != nil {
+		return nil, err
+	}
+	if len(shape) == 0 {
+		shape = []int{len(data)}
+	}
+	n := 1
+	for _, s := range shape {
+		if s < 0 {
+			return nil, fmt.Errorf("dataset %q has negative extent in shape %v", name, shape)
+		}
+		n *= s
+	}
+	if n != len(data) {
+		return nil, fmt.Errorf("dataset %q has %d values, shape %v needs %d", name, len(data), shape, n)
+	}
+	d := &Dataset{shape: append([]int(nil), shape...), data: append([]float64(nil), data...)}
+	g.children = append(g.children, link{name: name, dataset: d})
+	return d, nil
+}
+
+// Group returns the group at a slash-separated path relative to g.
+func (g *Group) Group(path string) (*Group, error) {
+	l, err := g.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+	if l.group == nil {
+		return nil, fmt.Errorf("%q is a dataset, not a group", path)
+	}
+	return l.group, nil
+}
+
+// Dataset returns the dataset at a slash-separated path relative to g.
+func (g *Group) Dataset(path string) (*Dataset, error) {
+	l, err := g.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+	if l.dataset == nil {
+		return nil, fmt.Errorf("%q is a group, not a dataset", path)
+	}
+	return l.dataset, nil
+}
+
+func (g *Group) lookup(path string) (link, error) {
+	cur := link{group: g}
+	for _, name := range strings.Split(strings.Trim(path, "/"), "/") {
+		if cur.group == nil {
+			return link{}, fmt.Errorf("%q: %q is not a group", path, cur.name)
+		}
+		found := false
+		for _, c := range cur.group.children {
+			if c.name == name {
+				cur, found = c, true
+				break
+			}
+		}
+		if !found {
+			return link{}, fmt.Errorf("%q: no object named %q", path, name)
+		}
+	}
+	return cur, nil
+}
+
+func (g *Group) checkName(name string) error {
+	if name == "" || name == "." || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid object name %q", name)
+	}
+	for _, c := range g.children {
+		if c.name == name {
+			return fmt.Errorf("object %q already exists", name)
+		}
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Attributes
+// -----------------------------------------------------------------------------
+
+// attributes holds the named metadata values of a group or dataset in
+// creation order. Values are string, []string, float64, []float64, int64
+// or []int64.
+type attributes struct {
+	names  []string
+	values map[string]any
+}
+
+// SetAttr sets an attribute, replacing any existing value of that name.
+// value must be a string, []string, float64, []float64, int, int64,
+// []int or []int64.
+func (a *attributes) SetAttr(name string, value any) error {
+	if name == "" {
+		return fmt.Errorf("attribute name must not be empty")
+	}
+	switch v := value.(type) {
+	case string, []string, float64, int64:
+	case []float64:
+		value = append([]float64(nil), v...)
+	case []int64:
+		value = append([]int64(nil), v...)
+	case int:
+		value = int64(v)
+	case []int:
+		ints := make([]int64, len(v))
+		for i, x := range v {
+			ints[i] = int64(x)
+		}
+		value = ints
+	default:
+		return fmt.Errorf("attribute %q has unsupported type %T", name, value)
+	}
+	if a.values == nil {
+		a.values = map[string]any{}
+	}
+	if _, ok := a.values[name]; !ok {
+		a.names = append(a.names, name)
+	}
+	a.values[name] = value
+	return nil
+}
+
+// Attr returns the attribute with the given name, if present.
+func (a *attributes) Attr(name string) (any, bool) {
+	v, ok := a.values[name]
+	return v, ok
+}
+
+// AttrNames returns the attribute names in creation order.
+func (a *attributes) AttrNames() []string {
+	return append([]string(nil), a.names...)
+}
@@ -0,0 +1,218 @@
+package hdf5
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/field"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/timeseries"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// roundTrip encodes f and decodes the result.
+func roundTrip(t *testing.T, f *File) *File {
+	t.Helper()
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	back, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	return back
+}
+
+func TestChecksum(t *testing.T) {
+	// Reference values from lookup3.c
+	if got := checksum(nil); got != 0xdeadbeef {
+		t.Errorf("checksum(\"\") = %#x, want 0xdeadbeef", got)
+	}
+	if got := checksum([]byte("Four score and seven years ago")); got != 0x17770551 {
+		t.Errorf("checksum(\"Four score...\") = %#x, want 0x17770551", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// File Tests
+// -----------------------------------------------------------------------------
+
+func TestRoundTrip(t *testing.T) {
+	f := NewFile()
+	root := f.Root()
+	root.SetAttr("title", "orbit test")
+	root.SetAttr("seed", 42)
+	root.SetAttr("tags", []string{"kepler", "rk4"})
+
+	run, _ := root.CreateGroup("run")
+	nested, _ := run.CreateGroup("particles")
+	matrix := []float64{1, 2, 3, 4, 5, 6}
+	d, err := nested.CreateDataset("m", matrix, 2, 3)
+	if err != nil {
+		t.Fatalf("CreateDataset() error = %v", err)
+	}
+	d.SetAttr("scale", 0.5)
+	d.SetAttr("limits", []float64{-1, math.Inf(1)})
+	d.SetAttr("counts", []int{3, -7})
+	run.CreateDataset("empty", nil)
+	run.CreateGroup(strings.Repeat("long", 100))
+
+	back := roundTrip(t, f).Root()
+	if v, _ := back.Attr("title"); v != "orbit test" {
+		t.Errorf("title = %v", v)
+	}
+	if v, _ := back.Attr("seed"); v != int64(42) {
+		t.Errorf("seed = %v (%T)", v, v)
+	}
+	if v, _ := back.Attr("tags"); !reflect.DeepEqual(v, []string{"kepler", "rk4"}) {
+		t.Errorf("tags = %v", v)
+	}
+
+	m, err := back.Dataset("run/particles/m")
+	if err != nil {
+		t.Fatalf("Dataset() error = %v", err)
+	}
+	if !reflect.DeepEqual(m.Shape(), []int{2, 3}) || !reflect.DeepEqual(m.Data(), matrix) {
+		t.Errorf("m = %v %v", m.Shape(), m.Data())
+	}
+	if v, _ := m.Attr("limits"); !reflect.DeepEqual(v, []float64{-1, math.Inf(1)}) {
+		t.Errorf("limits = %v", v)
+	}
+	if v, _ := m.Attr("counts"); !reflect.DeepEqual(v, []int64{3, -7}) {
+		t.Errorf("counts = %v", v)
+	}
+	if names := m.AttrNames(); !reflect.DeepEqual(names, []string{"scale", "limits", "counts"}) {
+		t.Errorf("AttrNames() = %v", names)
+	}
+
+	r, _ := back.Group("run")
+	if names := r.Names(); len(names) != 3 || names[0] != "particles" || names[1] != "empty" {
+		t.Errorf("run children = %v", names)
+	}
+	if e, err := r.Dataset("empty"); err != nil || len(e.Data()) != 0 {
+		t.Errorf("empty dataset = %v, %v", e, err)
+	}
+	if _, err := back.Dataset("run/particles"); err == nil {
+		t.Error("Dataset() should reject a group path")
+	}
+	if _, err := back.Group("run/missing"); err == nil {
+		t.Error("Group() should reject a missing path")
+	}
+}
+
+func TestCreateErrors(t *testing.T) {
+	g := NewFile().Root()
+	if _, err := g.CreateDataset("x", []float64{1, 2, 3}, 2, 2); err == nil {
+		t.Error("CreateDataset() should reject a shape that does not match the data")
+	}
+	g.CreateGroup("a")
+	if _, err := g.CreateGroup("a"); err == nil {
+		t.Error("CreateGroup() should reject a duplicate name")
+	}
+	if _, err := g.CreateGroup("a/b"); err == nil {
+		t.Error("CreateGroup() should reject a name containing '/'")
+	}
+	if err := g.SetAttr("bad", struct{}{}); err == nil {
+		t.Error("SetAttr() should reject an unsupported type")
+	}
+}
+
+func TestReadCorrupt(t *testing.T) {
+	f := NewFile()
+	f.Root().CreateDataset("x", []float64{1, 2})
+	var buf bytes.Buffer
+	f.WriteTo(&buf)
+	b := buf.Bytes()
+
+	// Flip a byte inside the root object header (after the raw data).
+	bad := append([]byte(nil), b...)
+	bad[len(bad)-10] ^= 0xff
+	if _, err := Read(bytes.NewReader(bad)); err == nil {
+		t.Error("Read() should detect a corrupted object header")
+	}
+	bad = append([]byte(nil), b...)
+	bad[30] ^= 0xff
+	if _, err := Read(bytes.NewReader(bad)); err == nil {
+		t.Error("Read() should detect a corrupted superblock")
+	}
+	if _, err := Read(strings.NewReader("not an HDF5 file")); err == nil {
+		t.Error("Read() should reject a file without the signature")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Physics Tests
+// -----------------------------------------------------------------------------
+
+func TestSeriesRoundTrip(t *testing.T) {
+	e := timeseries.New(units.Joule(1).Dim())
+	r := timeseries.NewVector(units.Dimension{L: 1})
+	for i := 0; i < 10; i++ {
+		tm := units.Second(0.25 * float64(i))
+		e.Append(tm, units.Joule(float64(i*i)).Value)
+		r.Append(tm, vector.NewPosition(units.Meter(float64(i)), units.Meter(1), units.Meter(-float64(i))))
+	}
+	f := NewFile()
+	if err := WriteSeries(f.Root(), "energy", e); err != nil {
+		t.Fatalf("WriteSeries() error = %v", err)
+	}
+	if err := WriteVectorSeries(f.Root(), "earth", r); err != nil {
+		t.Fatalf("WriteVectorSeries() error = %v", err)
+	}
+	root := roundTrip(t, f).Root()
+
+	e2, err := ReadSeries(root, "energy")
+	if err != nil {
+		t.Fatalf("ReadSeries() error = %v", err)
+	}
+	if e2.Dim() != e.Dim() || !reflect.DeepEqual(e2.Values(), e.Values()) || !reflect.DeepEqual(e2.Times(), e.Times()) {
+		t.Errorf("ReadSeries() = %v %v", e2.Times(), e2.Values())
+	}
+	d, _ := root.Dataset("energy/value")
+	if u, _ := d.Attr(AttrUnits); u != "kg m^2 s^-2" {
+		t.Errorf("units attribute = %v", u)
+	}
+
+	r2, err := ReadVectorSeries(root, "earth")
+	if err != nil {
+		t.Fatalf("ReadVectorSeries() error = %v", err)
+	}
+	if tm, v := r2.At(7); tm.Val() != 1.75 || v.X.Val() != 7 || v.Z.Val() != -7 || v.Dim() != r.Dim() {
+		t.Errorf("ReadVectorSeries()[7] = %v, %v", tm, v)
+	}
+	if v, _ := root.Dataset("earth/value"); !reflect.DeepEqual(v.Shape(), []int{10, 3}) {
+		t.Errorf("trajectory shape = %v, want [10 3]", v.Shape())
+	}
+	if _, err := ReadVectorSeries(root, "energy"); err == nil {
+		t.Error("ReadVectorSeries() should reject a scalar series")
+	}
+}
+
+func TestGridRoundTrip(t *testing.T) {
+	origin := vector.NewPosition(units.Meter(-1), units.Meter(0), units.Meter(2))
+	g, _ := field.NewGrid(4, 3, 2, units.Centimeter(5), origin, units.Volt(1).Dim())
+	phi, _ := g.Sample(func(r vector.Vector3) units.Value {
+		return units.NewValue(r.X.Val()+10*r.Y.Val()+100*r.Z.Val(), units.Volt(1).Dim())
+	})
+	f := NewFile()
+	if err := WriteGrid(f.Root(), "phi", phi); err != nil {
+		t.Fatalf("WriteGrid() error = %v", err)
+	}
+	back, err := ReadGrid(roundTrip(t, f).Root(), "phi")
+	if err != nil {
+		t.Fatalf("ReadGrid() error = %v", err)
+	}
+	if nx, ny, nz := back.Size(); nx != 4 || ny != 3 || nz != 2 || back.Spacing().Val() != 0.05 {
+		t.Errorf("grid = %d×%d×%d at %v", nx, ny, nz, back.Spacing())
+	}
+	if v := back.At(3, 2, 1); v.Val() != phi.At(3, 2, 1).Val() || v.Dim() != phi.Dim() {
+		t.Errorf("At(3, 2, 1) = %v, want %v", v, phi.At(3, 2, 1))
+	}
+	if o := back.Origin(); o.X.Val() != -1 || o.Z.Val() != 2 {
+		t.Errorf("Origin() = %v", o)
+	}
+}
@@ -0,0 +1,207 @@
+package hdf5
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/math/field"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/timeseries"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Attribute names recording the physical dimension of a dataset.
+const (
+	// AttrUnits holds the SI unit symbol, such as "kg m^2 s^-2", for
+	// human readers and plotting tools.
+	AttrUnits = "units"
+	// AttrDimension holds the seven base-dimension exponents in the order
+	// L, M, T, I, Θ, N, J; Dim reads it back.
+	AttrDimension = "dimension"
+)
+
+// CreateQuantity adds a dataset of values in SI base units of dim and
+// records the dimension in its attributes.
+func (g *Group) CreateQuantity(name string, data []float64, dim units.Dimension, shape ...int) (*Dataset, error) {
+	d, err := g.CreateDataset(name, data, shape...)
+	if err != nil {
+		return nil, err
+	}
+	d.SetDim(dim)
+	return d, nil
+}
+
+// SetDim records dim in the dataset's attributes.
+func (d *Dataset) SetDim(dim units.Dimension) {
+	d.SetAttr(AttrUnits, dim.Symbol())
+	d.SetAttr(AttrDimension, []int64{
+		int64(dim.L), int64(dim.M), int64(dim.T), int64(dim.I), int64(dim.Θ), int64(dim.N), int64(dim.J),
+	})
+}
+
+// Dim returns the dimension recorded by SetDim. Returns an error if the
+// attribute is missing or malformed.
+func (d *Dataset) Dim() (units.Dimension, error) {
+	v, ok := d.Attr(AttrDimension)
+	if !ok {
+		return units.Dimension{}, fmt.Errorf("dataset has no %q attribute", AttrDimension)
+	}
+	e, ok := v.([]int64)
+	if !ok || len(e) != 7 {
+		return units.Dimension{}, fmt.Errorf("%q attribute must hold 7 integers, got %v", AttrDimension, v)
+	}
+	return units.Dimension{
+		L: int8(e[0]), M: int8(e[1]), T: int8(e[2]), I: int8(e[3]), Θ: int8(e[4]), N: int8(e[5]), J: int8(e[6]),
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Time Series and Trajectories
+// -----------------------------------------------------------------------------
+
+// WriteSeries stores a series as a group holding datasets "t" (N) and
+// "value" (N), each with its dimension recorded.
+func WriteSeries(g *Group, name string, s *timeseries.Series) error {
+	sub, err := g.CreateGroup(name)
+	if err != nil {
+		return err
+	}
+	if _, err := sub.CreateQuantity("t", s.Times(), units.Dimension{T: 1}); err != nil {
+		return err
+	}
+	_, err = sub.CreateQuantity("value", s.Values(), s.Dim())
+	return err
+}
+
+// ReadSeries reads a series stored by WriteSeries.
+func ReadSeries(g *Group, name string) (*timeseries.Series, error) {
+	t, v, err := readSampled(g, name, 1)
+	if err != nil {
+		return nil, err
+	}
+	dim, err := v.Dim()
+	if err != nil {
+		return nil, err
+	}
+	return timeseries.FromSlices(t.data, v.data, dim)
+}
+
+// WriteVectorSeries stores a vector series, such as a particle
+// trajectory, as a group holding datasets "t" (N) and "value" (N×3).
+func WriteVectorSeries(g *Group, name string, s *timeseries.VectorSeries) error {
+	sub, err := g.CreateGroup(name)
+	if err != nil {
+		return err
+	}
+	n := s.Len()
+	if _, err := sub.CreateQuantity("t", s.X().Times(), units.Dimension{T: 1}); err != nil {
+		return err
+	}
+	xyz := make([]float64, 0, 3*n)
+	for i := 0; i < n; i++ {
+		_, v := s.At(i)
+		xyz = append(xyz, v.X.Val(), v.Y.Val(), v.Z.Val())
+	}
+	_, err = sub.CreateQuantity("value", xyz, s.Dim(), n, 3)
+	return err
+}
+
+// ReadVectorSeries reads a vector series stored by WriteVectorSeries.
+func ReadVectorSeries(g *Group, name string) (*timeseries.VectorSeries, error) {
+	t, v, err := readSampled(g, name, 3)
+	if err != nil {
+		return nil, err
+	}
+	dim, err := v.Dim()
+	if err != nil {
+		return nil, err
+	}
+	s := timeseries.NewVector(dim)
+	for i, tm := range t.data {
+		x := v.data[3*i : 3*i+3]
+		err := s.Append(units.Second(tm), vector.Vector3{
+			X: units.NewValue(x[0], dim),
+			Y: units.NewValue(x[1], dim),
+			Z: units.NewValue(x[2], dim),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// readSampled returns the "t" and "value" datasets of a sampled group,
+// checking that value has width columns per sample.
+func readSampled(g *Group, name string, width int) (t, v *Dataset, err error) {
+	sub, err := g.Group(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if t, err = sub.Dataset("t"); err != nil {
+		return nil, nil, err
+	}
+	if v, err = sub.Dataset("value"); err != nil {
+		return nil, nil, err
+	}
+	if len(v.data) != width*len(t.data) {
+		return nil, nil, fmt.Errorf("%q: %d values for %d samples of width %d", name, len(v.data), len(t.data), width)
+	}
+	return t, v, nil
+}
+
+// -----------------------------------------------------------------------------
+// Fields
+// -----------------------------------------------------------------------------
+
+// WriteGrid stores a field grid as an nz×ny×nx dataset (x fastest, as in
+// the grid) with "spacing" (m) and "origin" (m) attributes.
+func WriteGrid(g *Group, name string, grid *field.Grid) error {
+	nx, ny, nz := grid.Size()
+	d, err := g.CreateQuantity(name, grid.Values(), grid.Dim(), nz, ny, nx)
+	if err != nil {
+		return err
+	}
+	o := grid.Origin()
+	d.SetAttr("spacing", grid.Spacing().Val())
+	d.SetAttr("origin", []float64{o.X.Val(), o.Y.Val(), o.Z.Val()})
+	return nil
+}
+
+// ReadGrid reads a field grid stored by WriteGrid.
+func ReadGrid(g *Group, name string) (*field.Grid, error) {
+	d, err := g.Dataset(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.shape) != 3 {
+		return nil, fmt.Errorf("%q: grid dataset must be 3D, got shape %v", name, d.shape)
+	}
+	dim, err := d.Dim()
+	if err != nil {
+		return nil, err
+	}
+	h, _ := d.Attr("spacing")
+	spacing, ok := h.(float64)
+	if !ok {
+		return nil, fmt.Errorf("%q: missing spacing attribute", name)
+	}
+	o, _ := d.Attr("origin")
+	origin, ok := o.([]float64)
+	if !ok || len(origin) != 3 {
+		return nil, fmt.Errorf("%q: missing origin attribute", name)
+	}
+	nz, ny, nx := d.shape[0], d.shape[1], d.shape[2]
+	grid, err := field.NewGrid(nx, ny, nz, units.Meter(spacing),
+		vector.NewPosition(units.Meter(origin[0]), units.Meter(origin[1]), units.Meter(origin[2])), dim)
+	if err != nil {
+		return nil, err
+	}
+	for k := 0; k < nz; k++ {
+		for j := 0; j < ny; j++ {
+			for i := 0; i < nx; i++ {
+				grid.Set(i, j, k, units.NewValue(d.data[(k*ny+j)*nx+i], dim))
+			}
+		}
+	}
+	return grid, nil
+}
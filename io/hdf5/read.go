@@ -0,0 +1,481 @@
+package hdf5
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Read decodes an HDF5 file. It supports the subset written by WriteTo and
+// by the HDF5 library with the latest file format: superblock version 2
+// or 3, version 2 object headers, compact link storage, and contiguous or
+// compact datasets of little-endian integers or floats, which are
+// converted to float64. Soft and external links are skipped. Files using
+// symbol-table groups, dense link storage, chunked layouts or filters are
+// rejected with an error.
+func Read(r io.Reader) (*File, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	d := &decoder{b: b}
+	root, err := d.superblock()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := d.object(root, 0)
+	if err != nil {
+		return nil, err
+	}
+	if obj.group == nil {
+		return nil, fmt.Errorf("hdf5: root object is not a group")
+	}
+	return &File{root: obj.group}, nil
+}
+
+// maxDepth bounds group nesting to guard against cyclic hard links.
+const maxDepth = 64
+
+type decoder struct {
+	b []byte
+}
+
+func (d *decoder) superblock() (uint64, error) {
+	b := d.b
+	if len(b) < superblockSize || !bytes.Equal(b[:8], signature) {
+		return 0, fmt.Errorf("hdf5: missing file signature")
+	}
+	if v := b[8]; v != 2 && v != 3 {
+		return 0, fmt.Errorf("hdf5: unsupported superblock version %d", v)
+	}
+	if b[9] != 8 || b[10] != 8 {
+		return 0, fmt.Errorf("hdf5: unsupported offset/length sizes %d/%d", b[9], b[10])
+	}
+	if binary.LittleEndian.Uint32(b[44:]) != checksum(b[:44]) {
+		return 0, fmt.Errorf("hdf5: superblock checksum mismatch")
+	}
+	if base := binary.LittleEndian.Uint64(b[12:]); base != 0 {
+		return 0, fmt.Errorf("hdf5: unsupported base address %d", base)
+	}
+	return binary.LittleEndian.Uint64(b[36:]), nil
+}
+
+// object decodes the group or dataset whose header is at addr.
+func (d *decoder) object(addr uint64, depth int) (link, error) {
+	if depth > maxDepth {
+		return link{}, fmt.Errorf("hdf5: groups nested deeper than %d", maxDepth)
+	}
+	msgs, err := d.header(addr)
+	if err != nil {
+		return link{}, err
+	}
+
+	var attrs attributes
+	var shape []int
+	var dtype []byte
+	var layout []byte
+	var children []link
+	for _, m := range msgs {
+		switch m.typ {
+		case msgDataspace:
+			if shape, err = parseDataspace(m.data); err != nil {
+				return link{}, err
+			}
+		case msgDatatype:
+			dtype = m.data
+		case msgLayout:
+			layout = m.data
+		case msgLinkInfo:
+			if len(m.data) < 2 {
+				return link{}, fmt.Errorf("hdf5: short link info message")
+			}
+			off := 2
+			if m.data[1]&1 != 0 {
+				off += 8
+			}
+			if len(m.data) < off+8 {
+				return link{}, fmt.Errorf("hdf5: short link info message")
+			}
+			if binary.LittleEndian.Uint64(m.data[off:]) != undefined {
+				return link{}, fmt.Errorf("hdf5: dense link storage is not supported")
+			}
+		case msgLink:
+			name, target, hard, err := parseLink(m.data)
+			if err != nil {
+				return link{}, err
+			}
+			if !hard {
+				continue
+			}
+			child, err := d.object(target, depth+1)
+			if err != nil {
+				return link{}, fmt.Errorf("%s: %w", name, err)
+			}
+			child.name = name
+			children = append(children, child)
+		case msgAttribute:
+			name, value, err := parseAttribute(m.data)
+			if err != nil {
+				return link{}, err
+			}
+			attrs.SetAttr(name, value)
+		case 0x11:
+			return link{}, fmt.Errorf("hdf5: symbol-table groups are not supported")
+		}
+	}
+
+	if layout == nil {
+		return link{group: &Group{attributes: attrs, children: children}}, nil
+	}
+	if shape == nil {
+		shape = []int{}
+	}
+	data, err := d.layout(layout, dtype, shape)
+	if err != nil {
+		return link{}, err
+	}
+	return link{dataset: &Dataset{attributes: attrs, shape: shape, data: data}}, nil
+}
+
+type message struct {
+	typ  byte
+	data []byte
+}
+
+// header returns the messages of the version 2 object header at addr,
+// following continuation blocks.
+func (d *decoder) header(addr uint64) ([]message, error) {
+	b, err := d.slice(addr, 6)
+	if err != nil {
+		return nil, err
+	}
+	if string(b[:4]) != "OHDR" || b[4] != 2 {
+		return nil, fmt.Errorf("hdf5: unsupported object header at %d", addr)
+	}
+	flags := b[5]
+	off := addr + 6
+	if flags&0x20 != 0 {
+		off += 16 // access, modification, change and birth times
+	}
+	if flags&0x10 != 0 {
+		off += 4 // attribute phase-change values
+	}
+	width := uint64(1) << (flags & 3)
+	sb, err := d.slice(off, width)
+	if err != nil {
+		return nil, err
+	}
+	var size uint64
+	for i := int(width) - 1; i >= 0; i-- {
+		size = size<<8 | uint64(sb[i])
+	}
+	off += width
+	if err := d.verify(addr, off+size-addr); err != nil {
+		return nil, err
+	}
+
+	var msgs []message
+	type block struct{ start, end uint64 }
+	blocks := []block{{off, off + size}}
+	for len(blocks) > 0 {
+		blk := blocks[0]
+		blocks = blocks[1:]
+		for p := blk.start; p+4 <= blk.end; {
+			h, err := d.slice(p, 4)
+			if err != nil {
+				return nil, err
+			}
+			n := uint64(binary.LittleEndian.Uint16(h[1:]))
+			p += 4
+			if flags&0x04 != 0 {
+				p += 2 // creation order
+			}
+			data, err := d.slice(p, n)
+			if err != nil {
+				return nil, err
+			}
+			p += n
+			if h[0] != msgContinuation {
+				msgs = append(msgs, message{typ: h[0], data: data})
+				continue
+			}
+			if len(data) < 16 {
+				return nil, fmt.Errorf("hdf5: short continuation message")
+			}
+			caddr := binary.LittleEndian.Uint64(data)
+			clen := binary.LittleEndian.Uint64(data[8:])
+			sig, err := d.slice(caddr, 4)
+			if err != nil || string(sig) != "OCHK" || clen < 8 {
+				return nil, fmt.Errorf("hdf5: bad continuation block at %d", caddr)
+			}
+			if err := d.verify(caddr, clen-4); err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, block{caddr + 4, caddr + clen - 4})
+		}
+	}
+	return msgs, nil
+}
+
+// verify checks the checksum stored after the n bytes at addr.
+func (d *decoder) verify(addr, n uint64) error {
+	b, err := d.slice(addr, n+4)
+	if err != nil {
+		return err
+	}
+	if binary.LittleEndian.Uint32(b[n:]) != checksum(b[:n]) {
+		return fmt.Errorf("hdf5: checksum mismatch in metadata at %d", addr)
+	}
+	return nil
+}
+
+func (d *decoder) slice(addr, n uint64) ([]byte, error) {
+	if addr > uint64(len(d.b)) || n > uint64(len(d.b))-addr {
+		return nil, fmt.Errorf("hdf5: read of %d bytes at %d past end of file", n, addr)
+	}
+	return d.b[addr : addr+n], nil
+}
+
+func (d *decoder) layout(layout, dtype []byte, shape []int) ([]float64, error) {
+	if len(layout) < 2 || (layout[0] != 3 && layout[0] != 4) {
+		return nil, fmt.Errorf("hdf5: unsupported data layout")
+	}
+	n := 1
+	for _, s := range shape {
+		n *= s
+	}
+	var raw []byte
+	switch layout[1] {
+	case 0: // compact
+		if len(layout) < 4 {
+			return nil, fmt.Errorf("hdf5: short compact layout")
+		}
+		size := int(binary.LittleEndian.Uint16(layout[2:]))
+		if len(layout) < 4+size {
+			return nil, fmt.Errorf("hdf5: short compact layout")
+		}
+		raw = layout[4 : 4+size]
+	case 1: // contiguous
+		if len(layout) < 18 {
+			return nil, fmt.Errorf("hdf5: short contiguous layout")
+		}
+		addr := binary.LittleEndian.Uint64(layout[2:])
+		size := binary.LittleEndian.Uint64(layout[10:])
+		if addr != undefined {
+			var err error
+			if raw, err = d.slice(addr, size); err != nil {
+				return nil, err
+			}
+		} else {
+			raw = make([]byte, size)
+		}
+	default:
+		return nil, fmt.Errorf("hdf5: unsupported layout class %d", layout[1])
+	}
+	values, err := decodeValues(dtype, raw, n)
+	if err != nil {
+		return nil, err
+	}
+	switch v := values.(type) {
+	case []float64:
+		return v, nil
+	case []int64:
+		out := make([]float64, len(v))
+		for i, x := range v {
+			out[i] = float64(x)
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("hdf5: string datasets are not supported")
+}
+
+// -----------------------------------------------------------------------------
+// Message Parsing
+// -----------------------------------------------------------------------------
+
+// parseDataspace returns the dimensions of a version 1 or 2 dataspace; a
+// scalar dataspace returns a non-nil empty shape.
+func parseDataspace(b []byte) ([]int, error) {
+	if len(b) < 4 {
+		return nil, fmt.Errorf("hdf5: short dataspace message")
+	}
+	rank := int(b[1])
+	off := 4
+	switch b[0] {
+	case 1:
+		off = 8
+	case 2:
+		if b[3] == 2 {
+			return nil, fmt.Errorf("hdf5: null dataspace is not supported")
+		}
+	default:
+		return nil, fmt.Errorf("hdf5: unsupported dataspace version %d", b[0])
+	}
+	if len(b) < off+8*rank {
+		return nil, fmt.Errorf("hdf5: short dataspace message")
+	}
+	shape := make([]int, rank)
+	for i := range shape {
+		shape[i] = int(binary.LittleEndian.Uint64(b[off+8*i:]))
+	}
+	return shape, nil
+}
+
+// parseLink returns the name and, for hard links, the target address of a
+// link message.
+func parseLink(b []byte) (name string, addr uint64, hard bool, err error) {
+	if len(b) < 2 || b[0] != 1 {
+		return "", 0, false, fmt.Errorf("hdf5: unsupported link message")
+	}
+	flags := b[1]
+	p := 2
+	kind := byte(0)
+	if flags&0x08 != 0 {
+		kind = b[p]
+		p++
+	}
+	if flags&0x04 != 0 {
+		p += 8
+	}
+	if flags&0x10 != 0 {
+		p++
+	}
+	width := 1 << (flags & 3)
+	if len(b) < p+width {
+		return "", 0, false, fmt.Errorf("hdf5: short link message")
+	}
+	var n int
+	for i := width - 1; i >= 0; i-- {
+		n = n<<8 | int(b[p+i])
+	}
+	p += width
+	if len(b) < p+n {
+		return "", 0, false, fmt.Errorf("hdf5: short link message")
+	}
+	name = string(b[p : p+n])
+	p += n
+	if kind != 0 {
+		return name, 0, false, nil
+	}
+	if len(b) < p+8 {
+		return "", 0, false, fmt.Errorf("hdf5: short link message")
+	}
+	return name, binary.LittleEndian.Uint64(b[p:]), true, nil
+}
+
+// parseAttribute decodes a version 1, 2 or 3 attribute message.
+func parseAttribute(b []byte) (string, any, error) {
+	if len(b) < 8 {
+		return "", nil, fmt.Errorf("hdf5: short attribute message")
+	}
+	version := b[0]
+	nameSize := int(binary.LittleEndian.Uint16(b[2:]))
+	typeSize := int(binary.LittleEndian.Uint16(b[4:]))
+	spaceSize := int(binary.LittleEndian.Uint16(b[6:]))
+	p := 8
+	pad := func(n int) int { return n }
+	switch version {
+	case 1:
+		pad = func(n int) int { return (n + 7) &^ 7 }
+	case 2:
+	case 3:
+		p++ // name encoding
+	default:
+		return "", nil, fmt.Errorf("hdf5: unsupported attribute version %d", version)
+	}
+	if len(b) < p+pad(nameSize)+pad(typeSize)+pad(spaceSize) || nameSize < 1 {
+		return "", nil, fmt.Errorf("hdf5: short attribute message")
+	}
+	name := string(bytes.TrimRight(b[p:p+nameSize], "\x00"))
+	p += pad(nameSize)
+	dtype := b[p : p+typeSize]
+	p += pad(typeSize)
+	shape, err := parseDataspace(b[p : p+spaceSize])
+	if err != nil {
+		return "", nil, err
+	}
+	p += pad(spaceSize)
+
+	n := 1
+	for _, s := range shape {
+		n *= s
+	}
+	values, err := decodeValues(dtype, b[p:], n)
+	if err != nil {
+		return "", nil, fmt.Errorf("attribute %q: %w", name, err)
+	}
+	if len(shape) > 0 {
+		return name, values, nil
+	}
+	switch v := values.(type) {
+	case []float64:
+		return name, v[0], nil
+	case []int64:
+		return name, v[0], nil
+	case []string:
+		return name, v[0], nil
+	}
+	return name, values, nil
+}
+
+// decodeValues decodes n elements of raw data: floats as []float64,
+// integers as []int64 and strings as []string.
+func decodeValues(dtype, raw []byte, n int) (any, error) {
+	if len(dtype) < 8 {
+		return nil, fmt.Errorf("hdf5: short datatype message")
+	}
+	class := dtype[0] & 0x0f
+	bits0 := dtype[1]
+	size := int(binary.LittleEndian.Uint32(dtype[4:]))
+	if size <= 0 || len(raw) < n*size {
+		return nil, fmt.Errorf("hdf5: data shorter than %d elements of %d bytes", n, size)
+	}
+	switch class {
+	case classFloat:
+		if bits0&1 != 0 {
+			return nil, fmt.Errorf("hdf5: big-endian floats are not supported")
+		}
+		out := make([]float64, n)
+		for i := range out {
+			switch size {
+			case 4:
+				out[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(raw[4*i:])))
+			case 8:
+				out[i] = math.Float64frombits(binary.LittleEndian.Uint64(raw[8*i:]))
+			default:
+				return nil, fmt.Errorf("hdf5: unsupported %d-byte float", size)
+			}
+		}
+		return out, nil
+	case classFixed:
+		if bits0&1 != 0 {
+			return nil, fmt.Errorf("hdf5: big-endian integers are not supported")
+		}
+		if size != 1 && size != 2 && size != 4 && size != 8 {
+			return nil, fmt.Errorf("hdf5: unsupported %d-byte integer", size)
+		}
+		signed := bits0&0x08 != 0
+		out := make([]int64, n)
+		for i := range out {
+			var u uint64
+			for k := size - 1; k >= 0; k-- {
+				u = u<<8 | uint64(raw[i*size+k])
+			}
+			if shift := 64 - 8*size; signed && shift > 0 {
+				out[i] = int64(u<<shift) >> shift
+			} else {
+				out[i] = int64(u)
+			}
+		}
+		return out, nil
+	case classString:
+		out := make([]string, n)
+		for i := range out {
+			out[i] = string(bytes.TrimRight(raw[i*size:(i+1)*size], "\x00 "))
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("hdf5: unsupported datatype class %d", class)
+}
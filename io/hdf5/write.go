@@ -0,0 +1,264 @@
+package hdf5
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// File format constants. Addresses and lengths are 8 bytes.
+var signature = []byte("\x89HDF\r\n\x1a\n")
+
+const (
+	superblockSize = 48
+	undefined      = math.MaxUint64 // undefined address
+)
+
+// Object header message types.
+const (
+	msgDataspace    = 0x01
+	msgLinkInfo     = 0x02
+	msgDatatype     = 0x03
+	msgFillValue    = 0x05
+	msgLink         = 0x06
+	msgLayout       = 0x08
+	msgGroupInfo    = 0x0a
+	msgAttribute    = 0x0c
+	msgContinuation = 0x10
+)
+
+// Datatype classes.
+const (
+	classFixed  = 0
+	classFloat  = 1
+	classString = 3
+)
+
+// WriteTo encodes the file in HDF5 format (superblock version 2, version 2
+// object headers, compact link storage, contiguous datasets) and writes it
+// to w.
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	e := &encoder{}
+	e.buf.Write(make([]byte, superblockSize))
+	root, err := e.group(f.root)
+	if err != nil {
+		return 0, err
+	}
+
+	sb := e.buf.Bytes()[:superblockSize]
+	copy(sb, signature)
+	sb[8] = 2 // version
+	sb[9] = 8 // size of offsets
+	sb[10] = 8
+	binary.LittleEndian.PutUint64(sb[12:], 0) // base address
+	binary.LittleEndian.PutUint64(sb[20:], undefined)
+	binary.LittleEndian.PutUint64(sb[28:], uint64(e.buf.Len()))
+	binary.LittleEndian.PutUint64(sb[36:], root)
+	binary.LittleEndian.PutUint32(sb[44:], checksum(sb[:44]))
+
+	n, err := w.Write(e.buf.Bytes())
+	return int64(n), err
+}
+
+// encoder lays objects out sequentially, children before their parents so
+// that every object header can record the addresses it links to.
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (e *encoder) group(g *Group) (uint64, error) {
+	var h header
+	// Link Info: version 0, no creation order, no dense storage.
+	h.message(msgLinkInfo, 0, cat([]byte{0, 0}, u64(undefined), u64(undefined)))
+	// Group Info: version 0, default phase-change values.
+	h.message(msgGroupInfo, 0, []byte{0, 0})
+	for _, c := range g.children {
+		var addr uint64
+		var err error
+		if c.group != nil {
+			addr, err = e.group(c.group)
+		} else {
+			addr, err = e.dataset(c.dataset)
+		}
+		if err != nil {
+			return 0, err
+		}
+		h.message(msgLink, 0, linkMessage(c.name, addr))
+	}
+	if err := h.attributes(&g.attributes); err != nil {
+		return 0, err
+	}
+	return e.object(&h), nil
+}
+
+func (e *encoder) dataset(d *Dataset) (uint64, error) {
+	addr, size := uint64(undefined), uint64(8*len(d.data))
+	if len(d.data) > 0 {
+		addr = uint64(e.buf.Len())
+		raw := make([]byte, size)
+		for i, x := range d.data {
+			binary.LittleEndian.PutUint64(raw[8*i:], math.Float64bits(x))
+		}
+		e.buf.Write(raw)
+	}
+
+	var h header
+	h.message(msgDataspace, 0, dataspace(d.shape))
+	h.message(msgDatatype, 1, float64Type())
+	// Fill Value: version 3, late allocation, write fill value if set, undefined.
+	h.message(msgFillValue, 1, []byte{3, 0x0a})
+	// Data Layout: version 3, contiguous.
+	h.message(msgLayout, 0, cat([]byte{3, 1}, u64(addr), u64(size)))
+	if err := h.attributes(&d.attributes); err != nil {
+		return 0, err
+	}
+	return e.object(&h), nil
+}
+
+// object appends a version 2 object header and returns its address.
+func (e *encoder) object(h *header) uint64 {
+	addr := uint64(e.buf.Len())
+	var b bytes.Buffer
+	b.WriteString("OHDR")
+	b.WriteByte(2)    // version
+	b.WriteByte(0x02) // 4-byte chunk size, nothing optional stored
+	b.Write(u32(uint32(h.buf.Len())))
+	b.Write(h.buf.Bytes())
+	b.Write(u32(checksum(b.Bytes())))
+	e.buf.Write(b.Bytes())
+	return addr
+}
+
+// header accumulates the messages of an object header.
+type header struct {
+	buf bytes.Buffer
+}
+
+func (h *header) message(typ byte, flags byte, data []byte) {
+	h.buf.WriteByte(typ)
+	h.buf.Write(u16(uint16(len(data))))
+	h.buf.WriteByte(flags)
+	h.buf.Write(data)
+}
+
+func (h *header) attributes(a *attributes) error {
+	for _, name := range a.names {
+		dtype, space, data, err := encodeAttr(a.values[name])
+		if err != nil {
+			return fmt.Errorf("attribute %q: %v", name, err)
+		}
+		var m bytes.Buffer
+		m.WriteByte(3) // version
+		m.WriteByte(0)
+		m.Write(u16(uint16(len(name) + 1)))
+		m.Write(u16(uint16(len(dtype))))
+		m.Write(u16(uint16(len(space))))
+		m.WriteByte(1) // UTF-8 name
+		m.WriteString(name)
+		m.WriteByte(0)
+		m.Write(dtype)
+		m.Write(space)
+		m.Write(data)
+		if m.Len() > math.MaxUint16 {
+			return fmt.Errorf("attribute %q exceeds the 64 KiB header message limit", name)
+		}
+		h.message(msgAttribute, 0, m.Bytes())
+	}
+	return nil
+}
+
+// encodeAttr returns the datatype, dataspace and raw data of a value.
+func encodeAttr(v any) (dtype, space, data []byte, err error) {
+	switch v := v.(type) {
+	case string:
+		return stringType(len(v)), dataspace(nil), padString(v, len(v)), nil
+	case []string:
+		size := 0
+		for _, s := range v {
+			size = max(size, len(s))
+		}
+		for _, s := range v {
+			data = append(data, padString(s, size)...)
+		}
+		return stringType(size), dataspace([]int{len(v)}), data, nil
+	case float64:
+		return float64Type(), dataspace(nil), u64(math.Float64bits(v)), nil
+	case []float64:
+		for _, x := range v {
+			data = append(data, u64(math.Float64bits(x))...)
+		}
+		return float64Type(), dataspace([]int{len(v)}), data, nil
+	case int64:
+		return int64Type(), dataspace(nil), u64(uint64(v)), nil
+	case []int64:
+		for _, x := range v {
+			data = append(data, u64(uint64(x))...)
+		}
+		return int64Type(), dataspace([]int{len(v)}), data, nil
+	}
+	return nil, nil, nil, fmt.Errorf("unsupported type %T", v)
+}
+
+// dataspace encodes a version 2 dataspace message; a nil shape is scalar.
+func dataspace(shape []int) []byte {
+	if shape == nil {
+		return []byte{2, 0, 0, 0}
+	}
+	b := []byte{2, byte(len(shape)), 0, 1}
+	for _, s := range shape {
+		b = append(b, u64(uint64(s))...)
+	}
+	return b
+}
+
+// float64Type encodes an IEEE 754 little-endian binary64 datatype.
+func float64Type() []byte {
+	return cat(
+		[]byte{1<<4 | classFloat, 0x20, 63, 0}, // implied MSB mantissa, sign at bit 63
+		u32(8),
+		u16(0), u16(64), // bit offset, precision
+		[]byte{52, 11, 0, 52}, // exponent location, size; mantissa location, size
+		u32(1023),
+	)
+}
+
+// int64Type encodes a signed little-endian 64-bit integer datatype.
+func int64Type() []byte {
+	return cat([]byte{1<<4 | classFixed, 0x08, 0, 0}, u32(8), u16(0), u16(64))
+}
+
+// stringType encodes a null-padded UTF-8 fixed-length string datatype.
+// Empty strings use one byte, the minimum datatype size.
+func stringType(n int) []byte {
+	return cat([]byte{1<<4 | classString, 0x11, 0, 0}, u32(uint32(max(n, 1))))
+}
+
+func padString(s string, n int) []byte {
+	b := make([]byte, max(n, 1))
+	copy(b, s)
+	return b
+}
+
+// linkMessage encodes a version 1 hard link with a UTF-8 name.
+func linkMessage(name string, addr uint64) []byte {
+	b := []byte{1}
+	switch n := len(name); {
+	case n <= math.MaxUint8:
+		b = append(b, 0x10, 1, byte(n))
+	default:
+		b = append(b, 0x11, 1)
+		b = append(b, u16(uint16(n))...)
+	}
+	b = append(b, name...)
+	return append(b, u64(addr)...)
+}
+
+func u16(v uint16) []byte { return binary.LittleEndian.AppendUint16(nil, v) }
+func u32(v uint32) []byte { return binary.LittleEndian.AppendUint32(nil, v) }
+func u64(v uint64) []byte { return binary.LittleEndian.AppendUint64(nil, v) }
+
+func cat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
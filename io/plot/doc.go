@@ -0,0 +1,33 @@
+// Package plot turns simulation output into quick-look plots: gnuplot
+// scripts and Plotly JSON figures with axis labels derived from the
+// physical dimensions of the data.
+//
+// A Figure holds one or more traces sharing an x and a y Axis. Each axis
+// knows the dimension of its data and labels itself "name [unit]", using
+// the SI derived unit when one matches exactly (J, N, W, Pa, V, T, ...)
+// and base-unit symbols otherwise. Figures are built from timeseries, from
+// vector series (one trace per component) or from a Histogram of values.
+//
+// Gnuplot writes a self-contained script with the data inlined, to be run
+// with "gnuplot -p script.gp". Plotly writes the JSON figure
+// specification, {"data": [...], "layout": {...}}, which Plotly.js,
+// plotly.py (plotly.io.from_json) and Jupyter render directly.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/io/plot"
+//	    "github.com/sakiphan/qsim-core/timeseries"
+//	)
+//
+//	fig, _ := plot.Series("E", kinetic, potential, total)
+//	fig.Title = "Energy exchange"
+//	fig.Gnuplot(os.Stdout) // ylabel "E [J]", xlabel "t [s]"
+//
+//	h, _ := plot.NewHistogram(speeds, 50)
+//	h.Figure("v").Plotly(f)
+//
+// References:
+//   - Williams, Kelley et al. "gnuplot 5.4 reference manual"
+//   - Plotly. "JSON chart schema", https://plotly.com/chart-studio-help/json-chart-schema/
+package plot
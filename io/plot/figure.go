@@ -0,0 +1,145 @@
+package plot
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/timeseries"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Style selects how traces are drawn.
+type Style int
+
+const (
+	Lines  Style = iota // connected line through the points
+	Points              // markers only
+	Bars                // vertical bars centred on x, as for histograms
+)
+
+// Axis describes one axis of a figure.
+type Axis struct {
+	Name string          // quantity name, such as "E" or "speed"
+	Dim  units.Dimension // dimension of the data along the axis
+	Log  bool            // logarithmic scale
+}
+
+// Label returns the axis label "name [unit]", or just the name for
+// dimensionless data.
+func (a Axis) Label() string {
+	if a.Dim == (units.Dimension{}) {
+		return a.Name
+	}
+	if a.Name == "" {
+		return "[" + UnitLabel(a.Dim) + "]"
+	}
+	return a.Name + " [" + UnitLabel(a.Dim) + "]"
+}
+
+// Trace is one data set of a figure, with x and y in SI base units of the
+// figure axes.
+type Trace struct {
+	Name string
+	X, Y []float64
+}
+
+// Figure is a 2D plot of one or more traces sharing axes.
+type Figure struct {
+	Title  string
+	X, Y   Axis
+	Style  Style
+	Traces []Trace
+
+	// BarWidth is the width of each bar for the Bars style, in SI base
+	// units of the x axis.
+	BarWidth float64
+}
+
+// AddTrace appends a trace. x and y must have equal lengths.
+func (f *Figure) AddTrace(name string, x, y []float64) error {
+	if len(x) != len(y) {
+		return fmt.Errorf("trace %q has %d x values and %d y values", name, len(x), len(y))
+	}
+	f.Traces = append(f.Traces, Trace{Name: name, X: append([]float64(nil), x...), Y: append([]float64(nil), y...)})
+	return nil
+}
+
+// Series returns a line plot of one or more series against time. All
+// series must share a dimension; name labels the y axis. Traces are named
+// name, name 2, name 3, ... in order.
+func Series(name string, series ...*timeseries.Series) (*Figure, error) {
+	if len(series) == 0 {
+		return nil, fmt.Errorf("at least one series is required")
+	}
+	dim := series[0].Dim()
+	f := &Figure{
+		X: Axis{Name: "t", Dim: units.Dimension{T: 1}},
+		Y: Axis{Name: name, Dim: dim},
+	}
+	for i, s := range series {
+		if s.Dim() != dim {
+			return nil, fmt.Errorf("series dimensions differ: [0]=%s, [%d]=%s", dim, i, s.Dim())
+		}
+		trace := name
+		if i > 0 {
+			trace = fmt.Sprintf("%s %d", name, i+1)
+		}
+		f.AddTrace(trace, s.Times(), s.Values())
+	}
+	return f, nil
+}
+
+// VectorSeries returns a line plot of the x, y and z components of a
+// vector series against time, with traces named name_x, name_y, name_z.
+func VectorSeries(name string, s *timeseries.VectorSeries) *Figure {
+	f := &Figure{
+		X: Axis{Name: "t", Dim: units.Dimension{T: 1}},
+		Y: Axis{Name: name, Dim: s.Dim()},
+	}
+	for _, c := range []struct {
+		suffix string
+		s      *timeseries.Series
+	}{{"_x", s.X()}, {"_y", s.Y()}, {"_z", s.Z()}} {
+		f.AddTrace(name+c.suffix, c.s.Times(), c.s.Values())
+	}
+	return f
+}
+
+// -----------------------------------------------------------------------------
+// Unit Labels
+// -----------------------------------------------------------------------------
+
+// derived lists the SI derived units with special names used for labels.
+// Hertz and becquerel are omitted because s⁻¹ is equally an angular rate.
+var derived = []struct {
+	dim    units.Dimension
+	symbol string
+}{
+	{units.Dimension{L: 1, M: 1, T: -2}, "N"},
+	{units.Dimension{L: 2, M: 1, T: -2}, "J"},
+	{units.Dimension{L: 2, M: 1, T: -3}, "W"},
+	{units.Dimension{L: -1, M: 1, T: -2}, "Pa"},
+	{units.Dimension{T: 1, I: 1}, "C"},
+	{units.Dimension{L: 2, M: 1, T: -3, I: -1}, "V"},
+	{units.Dimension{L: 2, M: 1, T: -3, I: -2}, "Ω"},
+	{units.Dimension{L: -2, M: -1, T: 3, I: 2}, "S"},
+	{units.Dimension{L: -2, M: -1, T: 4, I: 2}, "F"},
+	{units.Dimension{M: 1, T: -2, I: -1}, "T"},
+	{units.Dimension{L: 2, M: 1, T: -2, I: -1}, "Wb"},
+	{units.Dimension{L: 2, M: 1, T: -2, I: -2}, "H"},
+}
+
+// UnitLabel returns the SI unit for dim, using the name of a derived unit
+// where one matches exactly and base-unit symbols otherwise.
+//
+// Example:
+//
+//	plot.UnitLabel(units.Joule(1).Dim())          // "J"
+//	plot.UnitLabel(units.MeterPerSecond(1).Dim()) // "m s^-1"
+func UnitLabel(dim units.Dimension) string {
+	for _, d := range derived {
+		if d.dim == dim {
+			return d.symbol
+		}
+	}
+	return dim.Symbol()
+}
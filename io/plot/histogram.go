@@ -0,0 +1,112 @@
+package plot
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Histogram counts values sharing a dimension into equal-width bins.
+type Histogram struct {
+	edges  []float64
+	counts []int
+	dim    units.Dimension
+}
+
+// NewHistogram bins values into the given number of equal-width bins
+// spanning their minimum to maximum. Values must be finite and share a
+// dimension.
+//
+// Example:
+//
+//	// Speeds of gas particles
+//	h, _ := plot.NewHistogram(speeds, 40)
+func NewHistogram(values []units.Value, bins int) (*Histogram, error) {
+	if bins < 1 {
+		return nil, fmt.Errorf("histogram needs at least one bin, got %d", bins)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("histogram needs at least one value")
+	}
+	dim := values[0].Dim()
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i, v := range values {
+		if v.Dim() != dim {
+			return nil, fmt.Errorf("histogram values must have same dimension: [0]=%s, [%d]=%s", dim, i, v.Dim())
+		}
+		x := v.Val()
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, fmt.Errorf("histogram value [%d] is not finite: %g", i, x)
+		}
+		lo, hi = math.Min(lo, x), math.Max(hi, x)
+	}
+	if lo == hi {
+		// A single distinct value gets a unit-width bin around it.
+		lo, hi = lo-0.5, hi+0.5
+	}
+
+	h := &Histogram{edges: make([]float64, bins+1), counts: make([]int, bins), dim: dim}
+	w := (hi - lo) / float64(bins)
+	for i := range h.edges {
+		h.edges[i] = lo + float64(i)*w
+	}
+	h.edges[bins] = hi
+	for _, v := range values {
+		// The maximum falls in the last, closed bin.
+		k := min(int((v.Val()-lo)/w), bins-1)
+		h.counts[k]++
+	}
+	return h, nil
+}
+
+// Dim returns the dimension of the binned values.
+func (h *Histogram) Dim() units.Dimension {
+	return h.dim
+}
+
+// Edges returns the bin edges (in SI base units); bin i spans
+// [edges[i], edges[i+1]).
+func (h *Histogram) Edges() []float64 {
+	return append([]float64(nil), h.edges...)
+}
+
+// Counts returns the number of values in each bin.
+func (h *Histogram) Counts() []int {
+	return append([]int(nil), h.counts...)
+}
+
+// Density returns the counts normalized to unit area, an estimate of the
+// probability density in the inverse of the value dimension.
+func (h *Histogram) Density() []float64 {
+	total := 0
+	for _, c := range h.counts {
+		total += c
+	}
+	w := h.edges[1] - h.edges[0]
+	out := make([]float64, len(h.counts))
+	for i, c := range h.counts {
+		out[i] = float64(c) / (float64(total) * w)
+	}
+	return out
+}
+
+// Figure returns a bar plot of the counts against the bin centres, with
+// name labelling the x axis.
+func (h *Histogram) Figure(name string) *Figure {
+	n := len(h.counts)
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i, c := range h.counts {
+		x[i] = 0.5 * (h.edges[i] + h.edges[i+1])
+		y[i] = float64(c)
+	}
+	f := &Figure{
+		X:        Axis{Name: name, Dim: h.dim},
+		Y:        Axis{Name: "count"},
+		Style:    Bars,
+		BarWidth: h.edges[1] - h.edges[0],
+	}
+	f.AddTrace(name, x, y)
+	return f
+}
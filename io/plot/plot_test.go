@@ -0,0 +1,164 @@
+package plot
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/timeseries"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func TestUnitLabel(t *testing.T) {
+	tests := []struct {
+		dim  units.Dimension
+		want string
+	}{
+		{units.Joule(1).Dim(), "J"},
+		{units.Volt(1).Dim(), "V"},
+		{units.Newton(1).Dim(), "N"},
+		{units.MeterPerSecond(1).Dim(), "m s^-1"},
+		{units.Dimension{T: -1}, "s^-1"},
+	}
+	for _, tt := range tests {
+		if got := UnitLabel(tt.dim); got != tt.want {
+			t.Errorf("UnitLabel(%v) = %q, want %q", tt.dim, got, tt.want)
+		}
+	}
+	if got := (Axis{Name: "count"}).Label(); got != "count" {
+		t.Errorf("dimensionless Label() = %q, want \"count\"", got)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Figure Tests
+// -----------------------------------------------------------------------------
+
+func energySeries() (*timeseries.Series, *timeseries.Series) {
+	k := timeseries.New(units.Joule(1).Dim())
+	u := timeseries.New(units.Joule(1).Dim())
+	for i := 0; i < 3; i++ {
+		tm := units.Second(0.5 * float64(i))
+		k.Append(tm, units.Joule(float64(i)).Value)
+		u.Append(tm, units.Joule(2-float64(i)).Value)
+	}
+	return k, u
+}
+
+func TestGnuplot(t *testing.T) {
+	k, u := energySeries()
+	fig, err := Series("E", k, u)
+	if err != nil {
+		t.Fatalf("Series() error = %v", err)
+	}
+	fig.Title = `Energy "exchange"`
+	var buf bytes.Buffer
+	if err := fig.Gnuplot(&buf); err != nil {
+		t.Fatalf("Gnuplot() error = %v", err)
+	}
+	script := buf.String()
+	for _, want := range []string{
+		`set title "Energy \"exchange\""`,
+		`set xlabel "t [s]"`,
+		`set ylabel "E [J]"`,
+		"$d1 << EOD\n0 2\n0.5 1\n1 0\nEOD\n",
+		`plot $d0 with lines title "E", $d1 with lines title "E 2"`,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("Gnuplot() script missing %q:\n%s", want, script)
+		}
+	}
+
+	if _, err := Series("x", k, timeseries.New(units.Dimension{L: 1})); err == nil {
+		t.Error("Series() should reject mixed dimensions")
+	}
+}
+
+func TestPlotly(t *testing.T) {
+	r := timeseries.NewVector(units.Dimension{L: 1, T: -1})
+	r.Append(units.Second(0), vector.NewVelocity(units.MeterPerSecond(1), units.MeterPerSecond(2), units.MeterPerSecond(3)))
+	fig := VectorSeries("v", r)
+	fig.Y.Log = true
+
+	var buf bytes.Buffer
+	if err := fig.Plotly(&buf); err != nil {
+		t.Fatalf("Plotly() error = %v", err)
+	}
+	var got struct {
+		Data []struct {
+			Type, Mode, Name string
+			Y                []float64
+		}
+		Layout struct {
+			XAxis, YAxis struct {
+				Title struct{ Text string }
+				Type  string
+			}
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Plotly() produced invalid JSON: %v", err)
+	}
+	if len(got.Data) != 3 || got.Data[2].Name != "v_z" || got.Data[2].Y[0] != 3 || got.Data[0].Mode != "lines" {
+		t.Errorf("Plotly() data = %+v", got.Data)
+	}
+	if got.Layout.YAxis.Title.Text != "v [m s^-1]" || got.Layout.YAxis.Type != "log" || got.Layout.XAxis.Title.Text != "t [s]" {
+		t.Errorf("Plotly() layout = %+v", got.Layout)
+	}
+
+	fig.Traces[0].Y[0] = math.NaN()
+	if err := fig.Plotly(&buf); err == nil {
+		t.Error("Plotly() should reject NaN")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Histogram Tests
+// -----------------------------------------------------------------------------
+
+func TestHistogram(t *testing.T) {
+	var values []units.Value
+	for _, x := range []float64{0, 1, 1.5, 2, 2.5, 3.9, 4} {
+		values = append(values, units.MeterPerSecond(x).Value)
+	}
+	h, err := NewHistogram(values, 4)
+	if err != nil {
+		t.Fatalf("NewHistogram() error = %v", err)
+	}
+	if c := h.Counts(); c[0] != 1 || c[1] != 2 || c[2] != 2 || c[3] != 2 {
+		t.Errorf("Counts() = %v, want [1 2 2 2]", c)
+	}
+	area, e := 0.0, h.Edges()
+	for _, d := range h.Density() {
+		area += d * (e[1] - e[0])
+	}
+	if !almostEqual(area, 1, 1e-12) {
+		t.Errorf("∫ density = %v, want 1", area)
+	}
+
+	fig := h.Figure("v")
+	var buf bytes.Buffer
+	fig.Gnuplot(&buf)
+	if s := buf.String(); !strings.Contains(s, "set boxwidth 1 absolute") || !strings.Contains(s, `$d0 with boxes title "v"`) || !strings.Contains(s, `set xlabel "v [m s^-1]"`) {
+		t.Errorf("histogram script:\n%s", s)
+	}
+	buf.Reset()
+	fig.Plotly(&buf)
+	if !strings.Contains(buf.String(), `"type":"bar"`) || !strings.Contains(buf.String(), `"width":1`) {
+		t.Errorf("histogram Plotly() = %s", buf.String())
+	}
+
+	if _, err := NewHistogram([]units.Value{units.Meter(1).Value, units.Second(1).Value}, 2); err == nil {
+		t.Error("NewHistogram() should reject mixed dimensions")
+	}
+	if h, _ := NewHistogram(values[:1], 3); h.Counts()[1] != 1 {
+		t.Errorf("single-value Counts() = %v, want the middle bin", h.Counts())
+	}
+}
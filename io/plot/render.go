@@ -0,0 +1,130 @@
+package plot
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Gnuplot writes a gnuplot script that draws the figure, with each trace's
+// data inlined as a datablock.
+//
+// Example output:
+//
+//	set title "Energy"
+//	set xlabel "t [s]"
+//	set ylabel "E [J]"
+//	set grid
+//	$d0 << EOD
+//	0 1.5
+//	...
+//	EOD
+//	plot $d0 with lines title "E"
+func (f *Figure) Gnuplot(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if f.Title != "" {
+		fmt.Fprintf(bw, "set title %s\n", gnuplotString(f.Title))
+	}
+	fmt.Fprintf(bw, "set xlabel %s\n", gnuplotString(f.X.Label()))
+	fmt.Fprintf(bw, "set ylabel %s\n", gnuplotString(f.Y.Label()))
+	if f.X.Log {
+		fmt.Fprintln(bw, "set logscale x")
+	}
+	if f.Y.Log {
+		fmt.Fprintln(bw, "set logscale y")
+	}
+	fmt.Fprintln(bw, "set grid")
+
+	with := "lines"
+	switch f.Style {
+	case Points:
+		with = "points"
+	case Bars:
+		with = "boxes"
+		fmt.Fprintln(bw, "set style fill solid 0.5")
+		fmt.Fprintf(bw, "set boxwidth %s absolute\n", formatFloat(f.BarWidth))
+	}
+
+	plots := make([]string, len(f.Traces))
+	for i, tr := range f.Traces {
+		fmt.Fprintf(bw, "$d%d << EOD\n", i)
+		for k := range tr.X {
+			fmt.Fprintf(bw, "%s %s\n", formatFloat(tr.X[k]), formatFloat(tr.Y[k]))
+		}
+		fmt.Fprintln(bw, "EOD")
+		plots[i] = fmt.Sprintf("$d%d with %s title %s", i, with, gnuplotString(tr.Name))
+	}
+	if len(plots) > 0 {
+		fmt.Fprintf(bw, "plot %s\n", strings.Join(plots, ", "))
+	}
+	return bw.Flush()
+}
+
+// Plotly writes the figure as Plotly JSON, {"data": [...], "layout": {...}}.
+// JSON has no representation for NaN or infinities, so such values make
+// it return an error.
+func (f *Figure) Plotly(w io.Writer) error {
+	type title struct {
+		Text string `json:"text"`
+	}
+	type axis struct {
+		Title title  `json:"title"`
+		Type  string `json:"type,omitempty"`
+	}
+	type trace struct {
+		Type  string    `json:"type"`
+		Mode  string    `json:"mode,omitempty"`
+		Name  string    `json:"name"`
+		X     []float64 `json:"x"`
+		Y     []float64 `json:"y"`
+		Width float64   `json:"width,omitempty"`
+	}
+	type layout struct {
+		Title *title `json:"title,omitempty"`
+		XAxis axis   `json:"xaxis"`
+		YAxis axis   `json:"yaxis"`
+	}
+	scale := func(log bool) string {
+		if log {
+			return "log"
+		}
+		return ""
+	}
+
+	var fig struct {
+		Data   []trace `json:"data"`
+		Layout layout  `json:"layout"`
+	}
+	fig.Data = make([]trace, len(f.Traces))
+	for i, tr := range f.Traces {
+		t := trace{Type: "scatter", Mode: "lines", Name: tr.Name, X: tr.X, Y: tr.Y}
+		switch f.Style {
+		case Points:
+			t.Mode = "markers"
+		case Bars:
+			t.Type, t.Mode, t.Width = "bar", "", f.BarWidth
+		}
+		fig.Data[i] = t
+	}
+	if f.Title != "" {
+		fig.Layout.Title = &title{f.Title}
+	}
+	fig.Layout.XAxis = axis{Title: title{f.X.Label()}, Type: scale(f.X.Log)}
+	fig.Layout.YAxis = axis{Title: title{f.Y.Label()}, Type: scale(f.Y.Log)}
+
+	enc := json.NewEncoder(w)
+	return enc.Encode(fig)
+}
+
+// gnuplotString quotes s as a gnuplot double-quoted string.
+func gnuplotString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
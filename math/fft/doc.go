@@ -0,0 +1,40 @@
+// Package fft computes discrete Fourier transforms of sampled physical
+// signals.
+//
+// Transform and Inverse operate on plain complex128 slices of any length:
+// powers of two use an iterative radix-2 Cooley-Tukey transform, and other
+// lengths use Bluestein's chirp-z algorithm on top of it, so every length
+// costs O(N log N). Transform is unnormalized; Inverse divides by N.
+//
+// Forward carries the sampling metadata through the transform. A series of
+// N samples spaced Δt apart becomes a Spectrum whose bin k sits at
+// frequency k/(NΔt) (negative for k > N/2) and whose coefficients
+// approximate the continuous Fourier transform,
+//
+//	X(fₖ) ≈ Δt Σₙ xₙ e^(-2πi kn/N),
+//
+// so they have the dimension of the samples times time: a voltage record
+// gives a spectrum in V/Hz. With this scaling Parseval's theorem reads
+// Σ|xₙ|² Δt = Σ|Xₖ|² Δf, and PowerSpectralDensity returns |Xₖ|²/(NΔt).
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/math/fft"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// 1 s of a displacement signal sampled at 1 kHz
+//	x := vector.FromSlice(samples, units.Meter(1).Dim())
+//	s, _ := fft.Forward(x, units.Millisecond(1))
+//	k := s.Peak()
+//	f := s.Frequency(k)     // dominant frequency
+//	a := s.Amplitude(k)     // its sinusoidal amplitude, in m
+//	back := s.InverseReal()  // the original samples
+//
+// References:
+//   - Press et al. "Numerical Recipes", 3rd ed., Ch. 12
+//   - Bluestein. "A linear filtering approach to the computation of the
+//     discrete Fourier transform", IEEE Trans. Audio Electroacoust. 18, 451 (1970)
+package fft
@@ -0,0 +1,111 @@
+package fft
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+)
+
+// Transform returns the unnormalized discrete Fourier transform of x,
+//
+//	Xₖ = Σₙ xₙ e^(-2πi kn/N).
+//
+// x may have any length and is not modified. An empty input returns an
+// empty result.
+func Transform(x []complex128) []complex128 {
+	return dft(x, -1)
+}
+
+// Inverse returns the inverse discrete Fourier transform of X,
+//
+//	xₙ = (1/N) Σₖ Xₖ e^(2πi kn/N),
+//
+// so that Inverse(Transform(x)) reproduces x to round-off.
+func Inverse(x []complex128) []complex128 {
+	out := dft(x, 1)
+	scale := complex(1/float64(len(out)), 0)
+	for i := range out {
+		out[i] *= scale
+	}
+	return out
+}
+
+// dft transforms a copy of x with kernel e^(sign·2πi kn/N).
+func dft(x []complex128, sign float64) []complex128 {
+	out := append([]complex128(nil), x...)
+	switch n := len(out); {
+	case n <= 1:
+		return out
+	case n&(n-1) == 0:
+		radix2(out, sign)
+		return out
+	default:
+		return bluestein(out, sign)
+	}
+}
+
+// radix2 transforms x in place by iterative Cooley-Tukey. len(x) must be a
+// power of two.
+func radix2(x []complex128, sign float64) {
+	n := len(x)
+	shift := 64 - bits.TrailingZeros(uint(n))
+	for i := range x {
+		if j := int(bits.Reverse64(uint64(i)) >> shift); j > i {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+	for size := 2; size <= n; size <<= 1 {
+		half := size / 2
+		// Twiddles are evaluated directly rather than by repeated
+		// multiplication to keep the error at O(ε log N).
+		for k := 0; k < half; k++ {
+			s, c := math.Sincos(sign * 2 * math.Pi * float64(k) / float64(size))
+			w := complex(c, s)
+			for start := 0; start < n; start += size {
+				a, b := x[start+k], w*x[start+k+half]
+				x[start+k], x[start+k+half] = a+b, a-b
+			}
+		}
+	}
+}
+
+// bluestein transforms x of arbitrary length by rewriting the DFT as a
+// convolution with the chirp e^(sign·πi k²/N), evaluated with power-of-two
+// transforms.
+func bluestein(x []complex128, sign float64) []complex128 {
+	n := len(x)
+	m := 1 << bits.Len(uint(2*n-2))
+
+	chirp := make([]complex128, n)
+	for k := range chirp {
+		// k² mod 2N keeps the phase argument small for large N.
+		k2 := (k * k) % (2 * n)
+		s, c := math.Sincos(sign * math.Pi * float64(k2) / float64(n))
+		chirp[k] = complex(c, s)
+	}
+
+	a := make([]complex128, m)
+	b := make([]complex128, m)
+	for k := 0; k < n; k++ {
+		a[k] = x[k] * chirp[k]
+	}
+	b[0] = cmplx.Conj(chirp[0])
+	for k := 1; k < n; k++ {
+		b[k] = cmplx.Conj(chirp[k])
+		b[m-k] = b[k]
+	}
+
+	radix2(a, -1)
+	radix2(b, -1)
+	for i := range a {
+		a[i] *= b[i]
+	}
+	radix2(a, 1)
+
+	scale := complex(1/float64(m), 0)
+	out := make([]complex128, n)
+	for k := range out {
+		out[k] = a[k] * scale * chirp[k]
+	}
+	return out
+}
@@ -0,0 +1,154 @@
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+// naive evaluates the DFT directly in O(N²).
+func naive(x []complex128) []complex128 {
+	n := len(x)
+	out := make([]complex128, n)
+	for k := range out {
+		for j, v := range x {
+			out[k] += v * cmplx.Exp(complex(0, -2*math.Pi*float64(k*j)/float64(n)))
+		}
+	}
+	return out
+}
+
+// -----------------------------------------------------------------------------
+// Transform Tests
+// -----------------------------------------------------------------------------
+
+func TestTransformMatchesDFT(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 8, 12, 17, 64, 100} {
+		x := make([]complex128, n)
+		for i := range x {
+			x[i] = complex(math.Sin(1.3*float64(i*i)), math.Cos(0.7*float64(i)))
+		}
+		got, want := Transform(x), naive(x)
+		for k := range want {
+			if cmplx.Abs(got[k]-want[k]) > 1e-9*float64(n) {
+				t.Errorf("N=%d: Transform()[%d] = %v, want %v", n, k, got[k], want[k])
+				break
+			}
+		}
+		back := Inverse(got)
+		for i := range x {
+			if cmplx.Abs(back[i]-x[i]) > 1e-12 {
+				t.Errorf("N=%d: Inverse(Transform())[%d] = %v, want %v", n, i, back[i], x[i])
+				break
+			}
+		}
+	}
+	if len(Transform(nil)) != 0 {
+		t.Error("Transform(nil) should be empty")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Spectrum Tests
+// -----------------------------------------------------------------------------
+
+func TestForwardSinusoid(t *testing.T) {
+	// 3 V at 50 Hz plus a 1 V offset, 1000 samples at 1 kHz (Bluestein path).
+	const n, fs = 1000, 1000.0
+	samples := make([]float64, n)
+	for i := range samples {
+		samples[i] = 1 + 3*math.Cos(2*math.Pi*50*float64(i)/fs+0.4)
+	}
+	volt := units.Volt(1).Dim()
+	s, err := Forward(vector.FromSlice(samples, volt), units.Millisecond(1))
+	if err != nil {
+		t.Fatalf("Forward() error = %v", err)
+	}
+
+	if s.Dim() != (units.Dimension{L: 2, M: 1, T: -2, I: -1}) {
+		t.Errorf("Dim() = %v, want V·s", s.Dim())
+	}
+	if !almostEqual(s.Resolution().Val(), 1, 1e-12) || !almostEqual(s.Nyquist().Val(), 500, 1e-9) {
+		t.Errorf("Resolution(), Nyquist() = %v, %v, want 1 Hz, 500 Hz", s.Resolution(), s.Nyquist())
+	}
+	k := s.Peak()
+	if k != 50 || !almostEqual(s.Frequency(k).Val(), 50, 1e-9) {
+		t.Fatalf("Peak() = %d at %v, want bin 50 at 50 Hz", k, s.Frequency(k))
+	}
+	if a := s.Amplitude(k); !almostEqual(a.Val(), 3, 1e-9) || a.Dim() != volt {
+		t.Errorf("Amplitude() = %v, want 3 V", a)
+	}
+	if !almostEqual(s.Phase(k).Val(), 0.4, 1e-9) {
+		t.Errorf("Phase() = %v, want 0.4 rad", s.Phase(k))
+	}
+	if a := s.Amplitude(0).Val(); !almostEqual(a, 1, 1e-9) {
+		t.Errorf("DC Amplitude() = %v, want 1 V", a)
+	}
+	// The negative-frequency image carries the conjugate coefficient.
+	if f := s.Frequency(n - 50).Val(); f != -50 {
+		t.Errorf("Frequency(N-50) = %v, want -50 Hz", f)
+	}
+	if d := cmplx.Abs(s.At(n-50) - cmplx.Conj(s.At(50))); d > 1e-9 {
+		t.Errorf("X(-f) - X(f)* = %v, want 0", d)
+	}
+
+	back := s.InverseReal()
+	for i, v := range samples {
+		if !almostEqual(back.At(i).Val(), v, 1e-10) {
+			t.Fatalf("InverseReal()[%d] = %v, want %v", i, back.At(i), v)
+		}
+	}
+	if back.Dim() != volt {
+		t.Errorf("InverseReal() dimension = %v, want V", back.Dim())
+	}
+}
+
+func TestParseval(t *testing.T) {
+	// Σ|x|²Δt = Σ|X|²Δf, and the PSD integrates to the mean square.
+	const n = 256
+	dt := 1e-3
+	samples := make([]float64, n)
+	energy := 0.0
+	for i := range samples {
+		samples[i] = math.Exp(-float64(i-100)*float64(i-100)/200) * math.Sin(0.3*float64(i))
+		energy += samples[i] * samples[i] * dt
+	}
+	s, _ := Forward(vector.FromSlice(samples, units.Dimension{L: 1}), units.Second(dt))
+	df := s.Resolution().Val()
+	sum, psd := 0.0, 0.0
+	for k := 0; k < n; k++ {
+		sum += s.Magnitude(k).Multiply(s.Magnitude(k)).Val() * df
+		psd += s.PowerSpectralDensity(k).Val() * df
+	}
+	if !almostEqual(sum/energy, 1, 1e-12) {
+		t.Errorf("Σ|X|²Δf = %v, want %v", sum, energy)
+	}
+	if !almostEqual(psd, energy/(n*dt), 1e-12) {
+		t.Errorf("∫PSD df = %v, want %v", psd, energy/(n*dt))
+	}
+	if got := s.PowerSpectralDensity(1).Dim(); got != (units.Dimension{L: 2, T: 1}) {
+		t.Errorf("PowerSpectralDensity() dimension = %v, want m²/Hz", got)
+	}
+}
+
+func TestForwardErrors(t *testing.T) {
+	x := vector.FromSlice([]float64{1, 2}, units.Dimension{})
+	if _, err := Forward(x, units.Second(0)); err == nil {
+		t.Error("Forward() should reject a non-positive sample interval")
+	}
+	if _, err := Forward(vector.VectorN{}, units.Second(1)); err == nil {
+		t.Error("Forward() should reject an empty series")
+	}
+	psi := vector.NewComplexN([]complex128{1, 1i, -1, -1i}, units.Dimension{})
+	s, err := ForwardComplex(psi, units.Second(1))
+	if err != nil || s.Peak() != 1 || !almostEqual(s.Magnitude(1).Val(), 4, 1e-12) {
+		t.Errorf("ForwardComplex(e^{iπn/2}) peak = %d, |X₁| = %v, %v", s.Peak(), s.Magnitude(1), err)
+	}
+}
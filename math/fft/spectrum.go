@@ -0,0 +1,185 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Spectrum is the discrete Fourier transform of a uniformly sampled signal,
+// scaled to approximate the continuous transform. Bin k lies at frequency
+// k Δf for k ≤ N/2 and (k - N) Δf above, with Δf = 1/(NΔt); coefficients
+// have the sample dimension times time and are stored in SI base units.
+type Spectrum struct {
+	c   []complex128
+	dt  float64
+	dim units.Dimension
+}
+
+// Forward transforms real samples x taken at interval dt.
+//
+// Example:
+//
+//	// Strain from a detector sampled at 4096 Hz
+//	h := vector.FromSlice(strain, units.Dimension{})
+//	s, _ := fft.Forward(h, units.Second(1.0/4096))
+//	asd := s.PowerSpectralDensity(k) // Hz⁻¹
+func Forward(x vector.VectorN, dt units.Time) (Spectrum, error) {
+	values := x.ToSlice()
+	c := make([]complex128, len(values))
+	for i, v := range values {
+		c[i] = complex(v, 0)
+	}
+	return newSpectrum(c, x.Dim(), dt)
+}
+
+// ForwardComplex transforms complex samples x taken at interval dt, such as
+// an analytic signal or a sampled wavefunction.
+func ForwardComplex(x vector.ComplexN, dt units.Time) (Spectrum, error) {
+	return newSpectrum(x.ToSlice(), x.Dim(), dt)
+}
+
+func newSpectrum(x []complex128, dim units.Dimension, dt units.Time) (Spectrum, error) {
+	if len(x) == 0 {
+		return Spectrum{}, fmt.Errorf("cannot transform an empty series")
+	}
+	if !(dt.Val() > 0) {
+		return Spectrum{}, fmt.Errorf("sample interval must be positive, got %g s", dt.Val())
+	}
+	c := Transform(x)
+	scale := complex(dt.Val(), 0)
+	for i := range c {
+		c[i] *= scale
+	}
+	return Spectrum{c: c, dt: dt.Val(), dim: timesTime(dim)}, nil
+}
+
+// Len returns the number of frequency bins, equal to the number of samples.
+func (s Spectrum) Len() int {
+	return len(s.c)
+}
+
+// Dim returns the dimension of the coefficients: the sample dimension
+// times time.
+func (s Spectrum) Dim() units.Dimension {
+	return s.dim
+}
+
+// SampleInterval returns the sampling interval Δt of the original series.
+func (s Spectrum) SampleInterval() units.Time {
+	return units.Second(s.dt)
+}
+
+// Resolution returns the bin spacing Δf = 1/(NΔt).
+func (s Spectrum) Resolution() units.Frequency {
+	return units.Hertz(1 / (float64(len(s.c)) * s.dt))
+}
+
+// Nyquist returns the Nyquist frequency 1/(2Δt), the highest frequency the
+// sampling resolves.
+func (s Spectrum) Nyquist() units.Frequency {
+	return units.Hertz(0.5 / s.dt)
+}
+
+// Frequency returns the frequency of bin k. Bins above N/2 hold negative
+// frequencies. Panics if k is out of range.
+func (s Spectrum) Frequency(k int) units.Frequency {
+	n := len(s.c)
+	if k < 0 || k >= n {
+		panic(fmt.Sprintf("fft: bin %d out of range [0, %d)", k, n))
+	}
+	if k > n/2 {
+		k -= n
+	}
+	return units.Hertz(float64(k) / (float64(n) * s.dt))
+}
+
+// At returns coefficient k (in SI base units).
+// Panics if k is out of range.
+func (s Spectrum) At(k int) complex128 {
+	return s.c[k]
+}
+
+// Coefficients returns the coefficients as a ComplexN.
+func (s Spectrum) Coefficients() vector.ComplexN {
+	return vector.NewComplexN(s.c, s.dim)
+}
+
+// Magnitude returns |Xₖ|, with the dimension of the coefficients.
+func (s Spectrum) Magnitude(k int) units.Value {
+	return units.NewValue(cmplx.Abs(s.c[k]), s.dim)
+}
+
+// Phase returns arg Xₖ in (-π, π].
+func (s Spectrum) Phase(k int) units.Angle {
+	return units.Radian(cmplx.Phase(s.c[k]))
+}
+
+// Amplitude returns the amplitude, in the sample dimension, of the real
+// sinusoid at bin k: 2|Xₖ|/(NΔt), or |Xₖ|/(NΔt) for the DC and Nyquist
+// bins. It is exact for a real signal whose frequency falls on a bin.
+func (s Spectrum) Amplitude(k int) units.Value {
+	n := len(s.c)
+	a := cmplx.Abs(s.c[k]) / (float64(n) * s.dt)
+	if k != 0 && 2*k != n {
+		a *= 2
+	}
+	return units.NewValue(a, s.sampleDim())
+}
+
+// PowerSpectralDensity returns the two-sided power spectral density
+// |Xₖ|²/(NΔt) at bin k, with the squared sample dimension per hertz. Its
+// sum over all bins times Δf equals the mean square of the samples.
+func (s Spectrum) PowerSpectralDensity(k int) units.Value {
+	a := cmplx.Abs(s.c[k])
+	sample := units.NewValue(1, s.sampleDim())
+	return units.NewValue(a*a/(float64(len(s.c))*s.dt), timesTime(sample.Multiply(sample).Dim()))
+}
+
+// Peak returns the positive-frequency bin 1 ≤ k ≤ N/2 of largest magnitude,
+// or 0 if the series has fewer than two samples.
+func (s Spectrum) Peak() int {
+	best, peak := 0, -math.MaxFloat64
+	for k := 1; k <= len(s.c)/2; k++ {
+		if a := cmplx.Abs(s.c[k]); a > peak {
+			best, peak = k, a
+		}
+	}
+	return best
+}
+
+// Inverse transforms the spectrum back to complex samples in the original
+// dimension.
+func (s Spectrum) Inverse() vector.ComplexN {
+	x := Inverse(s.c)
+	scale := complex(1/s.dt, 0)
+	for i := range x {
+		x[i] *= scale
+	}
+	return vector.NewComplexN(x, s.sampleDim())
+}
+
+// InverseReal transforms the spectrum back to real samples, discarding the
+// imaginary parts. Use it for spectra of real signals, where those parts
+// are round-off.
+func (s Spectrum) InverseReal() vector.VectorN {
+	x := s.Inverse()
+	values := make([]float64, x.Len())
+	for i := range values {
+		values[i] = real(x.At(i))
+	}
+	return vector.FromSlice(values, x.Dim())
+}
+
+// sampleDim returns the dimension of the original samples.
+func (s Spectrum) sampleDim() units.Dimension {
+	return units.NewValue(1, s.dim).Divide(units.Second(1).Value).Dim()
+}
+
+// timesTime returns dim multiplied by [T].
+func timesTime(dim units.Dimension) units.Dimension {
+	return units.NewValue(1, dim).Multiply(units.Second(1).Value).Dim()
+}
@@ -0,0 +1,55 @@
+// Package field represents scalar and vector fields over position space and
+// applies the differential operators of vector calculus to them
+// numerically.
+//
+// A ScalarField maps a position to a Value and a VectorField maps a
+// position to a Vector3. Gradient, Divergence, Curl and Laplacian return
+// new fields that evaluate second-order central differences with a fixed
+// step h, so their truncation error is O(h²). The results carry the
+// correct dimension: differentiating a field by position divides its
+// dimension by length, so the gradient of a potential in V is a field in
+// V/m.
+//
+// The step should be small compared with the length scale over which the
+// field varies but large enough that round-off, which grows like ε/h (ε/h²
+// for the Laplacian), stays negligible. For smooth fields h ≈ 1e-4 to 1e-5
+// of the length scale is a good choice.
+//
+// A Grid stores a scalar field sampled on a regular lattice of cubic cells
+// for PDE-style simulations. It interpolates trilinearly between nodes and
+// provides the same operators as finite differences over the stored
+// values, with results again carrying the correct dimension.
+// SolvePoisson inverts the Laplacian on a grid by successive
+// over-relaxation; GravitationalPotential and ElectricPotential wrap it for
+// ∇²Φ = 4πGρ and ∇²V = -ρ/ε₀, and FieldFromPotential returns -∇ of the
+// result. Grid operators and the solver run on a parallel.Pool once one is
+// set with SetPool, giving the same results as serial execution.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/math/field"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// E = -∇φ for the potential of a point charge
+//	phi := field.ScalarField(func(r vector.Vector3) units.Value {
+//	    v, _ := charge.Potential(r)
+//	    return v.Value
+//	})
+//	grad, _ := field.Gradient(phi, units.Micrometer(1))
+//	e := grad(r).Negate() // V/m
+//
+//	// Superposition of two sources
+//	total, _ := field.SumVector(units.VoltPerMeter(1).Dim(), e1, e2)
+//
+//	// Sample φ on a 64³ grid and differentiate the samples
+//	g, _ := field.NewGrid(64, 64, 64, units.Millimeter(1), corner, units.Volt(1).Dim())
+//	samples, _ := g.Sample(phi)
+//	lap := samples.Laplacian() // V/m²
+//
+// References:
+//   - Griffiths. "Introduction to Electrodynamics", 4th ed., Ch. 1
+//   - Press et al. "Numerical Recipes", 3rd ed., Sec. 5.7
+package field
@@ -0,0 +1,188 @@
+package field
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// ScalarField returns the value of a scalar quantity at position r.
+// A field must return the same dimension at every point.
+type ScalarField func(r vector.Vector3) units.Value
+
+// VectorField returns the value of a vector quantity at position r.
+// A field must return the same dimension at every point.
+type VectorField func(r vector.Vector3) vector.Vector3
+
+// -----------------------------------------------------------------------------
+// Differential Operators
+// -----------------------------------------------------------------------------
+
+// Gradient returns ∇f, evaluated by central differences with step h.
+// The result has the dimension of f divided by length.
+//
+// Formula:
+//
+//	∂f/∂xᵢ ≈ [f(r + h êᵢ) - f(r - h êᵢ)] / 2h
+func Gradient(f ScalarField, h units.Length) (VectorField, error) {
+	if err := checkStep(h); err != nil {
+		return nil, err
+	}
+	return func(r vector.Vector3) vector.Vector3 {
+		return vector.Vector3{
+			X: partial(f, r, 0, h),
+			Y: partial(f, r, 1, h),
+			Z: partial(f, r, 2, h),
+		}
+	}, nil
+}
+
+// Divergence returns ∇·F, evaluated by central differences with step h.
+// The result has the dimension of F divided by length.
+//
+// Example:
+//
+//	// Gauss's law: ρ = ε₀ ∇·E
+//	div, _ := field.Divergence(e, units.Micrometer(1))
+func Divergence(f VectorField, h units.Length) (ScalarField, error) {
+	if err := checkStep(h); err != nil {
+		return nil, err
+	}
+	return func(r vector.Vector3) units.Value {
+		sum := partial(component(f, 0), r, 0, h)
+		for k := 1; k < 3; k++ {
+			sum, _ = sum.Add(partial(component(f, k), r, k, h))
+		}
+		return sum
+	}, nil
+}
+
+// Curl returns ∇×F, evaluated by central differences with step h.
+// The result has the dimension of F divided by length.
+//
+// Example:
+//
+//	// Ampère's law: J = ∇×B / μ₀
+//	curl, _ := field.Curl(b, units.Micrometer(1))
+func Curl(f VectorField, h units.Length) (VectorField, error) {
+	if err := checkStep(h); err != nil {
+		return nil, err
+	}
+	d := func(i, j int, r vector.Vector3) units.Value {
+		return partial(component(f, i), r, j, h) // ∂Fᵢ/∂xⱼ
+	}
+	return func(r vector.Vector3) vector.Vector3 {
+		x, _ := d(2, 1, r).Subtract(d(1, 2, r))
+		y, _ := d(0, 2, r).Subtract(d(2, 0, r))
+		z, _ := d(1, 0, r).Subtract(d(0, 1, r))
+		return vector.Vector3{X: x, Y: y, Z: z}
+	}, nil
+}
+
+// Laplacian returns ∇²f, evaluated by the three-point second difference
+// with step h along each axis. The result has the dimension of f divided
+// by length squared.
+//
+// Formula:
+//
+//	∇²f ≈ Σᵢ [f(r + h êᵢ) - 2f(r) + f(r - h êᵢ)] / h²
+func Laplacian(f ScalarField, h units.Length) (ScalarField, error) {
+	if err := checkStep(h); err != nil {
+		return nil, err
+	}
+	return func(r vector.Vector3) units.Value {
+		f0 := f(r)
+		h2 := h.Value.Multiply(h.Value)
+		sum := units.NewValue(0, f0.Divide(h2).Dim())
+		for k := 0; k < 3; k++ {
+			fp, fm := f(shift(r, k, h.Val())), f(shift(r, k, -h.Val()))
+			d2 := fp.Val() - 2*f0.Val() + fm.Val()
+			sum, _ = sum.Add(units.NewValue(d2, f0.Dim()).Divide(h2))
+		}
+		return sum
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Superposition
+// -----------------------------------------------------------------------------
+
+// SumScalar returns the pointwise sum of scalar fields of dimension dim,
+// such as the total potential of several sources. Returns an error if no
+// fields are given. A field returning another dimension breaks the
+// ScalarField contract; the sum panics when it meets one, naming the field.
+//
+// Example:
+//
+//	phi, _ := field.SumScalar(units.Volt(1).Dim(), phi1, phi2)
+func SumScalar(dim units.Dimension, fields ...ScalarField) (ScalarField, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields to sum")
+	}
+	return func(r vector.Vector3) units.Value {
+		sum := units.NewValue(0, dim)
+		for i, f := range fields {
+			v := f(r)
+			if v.Dim() != dim {
+				panic(fmt.Sprintf("field: scalar field %d has dimension %s, want %s", i, v.Dim(), dim))
+			}
+			sum, _ = sum.Add(v)
+		}
+		return sum
+	}, nil
+}
+
+// SumVector returns the pointwise sum of vector fields of dimension dim,
+// such as the total electric field of several sources. Returns an error if
+// no fields are given. A field returning another dimension breaks the
+// VectorField contract; the sum panics when it meets one, naming the
+// field.
+func SumVector(dim units.Dimension, fields ...VectorField) (VectorField, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields to sum")
+	}
+	return func(r vector.Vector3) vector.Vector3 {
+		sum := vector.Zero(dim)
+		for i, f := range fields {
+			v := f(r)
+			if v.Dim() != dim {
+				panic(fmt.Sprintf("field: vector field %d has dimension %s, want %s", i, v.Dim(), dim))
+			}
+			sum, _ = sum.Add(v)
+		}
+		return sum
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+// partial returns ∂f/∂xₖ at r by central difference.
+func partial(f ScalarField, r vector.Vector3, k int, h units.Length) units.Value {
+	d, _ := f(shift(r, k, h.Val())).Subtract(f(shift(r, k, -h.Val())))
+	return d.Divide(h.Value.Scale(2))
+}
+
+// component returns the scalar field of component k of f.
+func component(f VectorField, k int) ScalarField {
+	return func(r vector.Vector3) units.Value {
+		return f(r).Components()[k]
+	}
+}
+
+// shift returns r displaced by d meters along axis k.
+func shift(r vector.Vector3, k int, d float64) vector.Vector3 {
+	x := r.ToArray()
+	x[k] += d
+	return toPosition(x)
+}
+
+// checkStep returns an error unless the difference step is positive.
+func checkStep(h units.Length) error {
+	if !(h.Val() > 0) {
+		return fmt.Errorf("step must be positive, got %g m", h.Val())
+	}
+	return nil
+}
@@ -0,0 +1,449 @@
+package field
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/em/electrostatics"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/parallel"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func pos(x, y, z float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x), units.Meter(y), units.Meter(z))
+}
+
+var voltPerMeter = units.Dimension{L: 1, M: 1, T: -3, I: -1}
+
+func TestGradientPointCharge(t *testing.T) {
+	q, _ := electrostatics.NewPointCharge(units.Coulomb(1e-9), pos(0, 0, 0))
+	phi := ScalarField(func(r vector.Vector3) units.Value {
+		v, _ := q.Potential(r)
+		return v.Value
+	})
+	grad, err := Gradient(phi, units.Micrometer(1))
+	if err != nil {
+		t.Fatalf("Gradient() error = %v", err)
+	}
+
+	r := pos(0.3, -0.4, 1.2)
+	e := grad(r).Negate()
+	want, _ := q.Field(r)
+	if e.Dim() != voltPerMeter {
+		t.Errorf("-∇φ dimension = %v, want V/m", e.Dim())
+	}
+	got, w := e.ToArray(), want.ToArray()
+	for k := 0; k < 3; k++ {
+		if !almostEqual(got[k]/w[k], 1, 1e-6) {
+			t.Errorf("-∇φ[%d] = %v, want %v", k, got[k], w[k])
+		}
+	}
+	if _, err := Gradient(phi, units.Meter(0)); err == nil {
+		t.Error("Gradient() should fail with zero step")
+	}
+}
+
+func TestDivergenceCurl(t *testing.T) {
+	h := units.Millimeter(1)
+	// F = (x², xy, -z) N: ∇·F = 2x + x - 1, ∇×F = (0, 0, y)
+	f := VectorField(func(r vector.Vector3) vector.Vector3 {
+		x := r.ToArray()
+		return vector.NewForce(units.Newton(x[0]*x[0]), units.Newton(x[0]*x[1]), units.Newton(-x[2]))
+	})
+	div, _ := Divergence(f, h)
+	curl, _ := Curl(f, h)
+
+	r := pos(2, 3, 5)
+	if d := div(r); !almostEqual(d.Val(), 5, 1e-9) || d.Dim() != (units.Dimension{M: 1, T: -2}) {
+		t.Errorf("∇·F = %v, want 5 N/m", d)
+	}
+	c := curl(r).ToArray()
+	if !almostEqual(c[0], 0, 1e-9) || !almostEqual(c[1], 0, 1e-9) || !almostEqual(c[2], 3, 1e-9) {
+		t.Errorf("∇×F = %v, want (0, 0, 3)", c)
+	}
+
+	// Rigid rotation v = ω × r has ∇×v = 2ω and ∇·v = 0.
+	omega := vector.Vector3{X: units.RadianPerSecond(0).Value, Y: units.RadianPerSecond(0).Value, Z: units.RadianPerSecond(1.5).Value}
+	v := VectorField(func(r vector.Vector3) vector.Vector3 { return omega.Cross(r) })
+	curl, _ = Curl(v, h)
+	div, _ = Divergence(v, h)
+	if w := curl(r); !almostEqual(w.Z.Val(), 3, 1e-9) || w.Dim() != (units.Dimension{T: -1}) {
+		t.Errorf("∇×(ω×r) = %v, want (0, 0, 3) s⁻¹", w)
+	}
+	if d := div(r); !almostEqual(d.Val(), 0, 1e-9) {
+		t.Errorf("∇·(ω×r) = %v, want 0", d)
+	}
+}
+
+func TestLaplacian(t *testing.T) {
+	// ∇²(k r²) = 6k
+	f := ScalarField(func(r vector.Vector3) units.Value {
+		return units.Volt(2).Value.Multiply(r.MagnitudeSquared())
+	})
+	lap, err := Laplacian(f, units.Millimeter(1))
+	if err != nil {
+		t.Fatalf("Laplacian() error = %v", err)
+	}
+	got := lap(pos(1, -2, 0.5))
+	if !almostEqual(got.Val(), 12, 1e-6) || got.Dim() != units.Volt(1).Dim() {
+		t.Errorf("∇²(2 V r²) = %v, want 12 V", got)
+	}
+
+	// The Coulomb potential is harmonic away from the charge: ∇²(1/r) = 0.
+	inv := ScalarField(func(r vector.Vector3) units.Value {
+		m, _ := r.Magnitude()
+		return units.Dimensionless(1).Divide(m)
+	})
+	lap, _ = Laplacian(inv, units.Millimeter(1))
+	if got := lap(pos(0.5, 0.2, -0.3)); !almostEqual(got.Val(), 0, 1e-4) || got.Dim() != (units.Dimension{L: -3}) {
+		t.Errorf("∇²(1/r) = %v, want 0 m⁻³", got)
+	}
+}
+
+func TestSuperposition(t *testing.T) {
+	q1, _ := electrostatics.NewPointCharge(units.Coulomb(1e-9), pos(-1, 0, 0))
+	q2, _ := electrostatics.NewPointCharge(units.Coulomb(-1e-9), pos(1, 0, 0))
+	e := func(q electrostatics.PointCharge) VectorField {
+		return func(r vector.Vector3) vector.Vector3 {
+			v, _ := q.Field(r)
+			return v
+		}
+	}
+	total, err := SumVector(units.VoltPerMeter(1).Dim(), e(q1), e(q2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// On the midplane of a dipole the field points from + to -, along +x.
+	got := total(pos(0, 0, 0)).ToArray()
+	want := 2 * constants.CoulombConstant.Val() * 1e-9
+	if !almostEqual(got[0]/want, 1, 1e-12) || got[1] != 0 || got[2] != 0 {
+		t.Errorf("dipole midpoint field = %v, want (%v, 0, 0)", got, want)
+	}
+
+	if _, err := SumScalar(units.Volt(1).Dim()); err == nil {
+		t.Error("SumScalar() should reject an empty list of fields")
+	}
+	if _, err := SumVector(units.VoltPerMeter(1).Dim()); err == nil {
+		t.Error("SumVector() should reject an empty list of fields")
+	}
+
+	mixed, err := SumScalar(units.Volt(1).Dim(),
+		func(vector.Vector3) units.Value { return units.Volt(1).Value },
+		func(vector.Vector3) units.Value { return units.Meter(1).Value },
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("SumScalar() should panic on mismatched dimensions")
+		}
+	}()
+	mixed(pos(0, 0, 0))
+}
+
+// -----------------------------------------------------------------------------
+// Grid Tests
+// -----------------------------------------------------------------------------
+
+func quadratic(r vector.Vector3) units.Value {
+	x := r.ToArray()
+	return units.Volt(x[0]*x[0] + 2*x[1]*x[1] + 3*x[2]*x[2]).Value
+}
+
+func TestGridSampleInterpolate(t *testing.T) {
+	g, err := NewGrid(5, 4, 3, units.Centimeter(10), pos(-0.2, 0, 1), units.Volt(1).Dim())
+	if err != nil {
+		t.Fatalf("NewGrid() error = %v", err)
+	}
+	linear := ScalarField(func(r vector.Vector3) units.Value {
+		x := r.ToArray()
+		return units.Volt(1 + 2*x[0] - x[1] + 0.5*x[2]).Value
+	})
+	s, err := g.Sample(linear)
+	if err != nil {
+		t.Fatalf("Sample() error = %v", err)
+	}
+	if p := s.Position(4, 3, 2).ToArray(); !almostEqual(p[0], 0.2, 1e-15) || !almostEqual(p[1], 0.3, 1e-15) || !almostEqual(p[2], 1.2, 1e-15) {
+		t.Errorf("Position(4, 3, 2) = %v, want (0.2, 0.3, 1.2)", p)
+	}
+
+	// Trilinear interpolation is exact for linear fields.
+	r := pos(0.037, 0.21, 1.15)
+	got, err := s.Interpolate(r)
+	if err != nil {
+		t.Fatalf("Interpolate() error = %v", err)
+	}
+	if want := linear(r); !almostEqual(got.Val(), want.Val(), 1e-12) || got.Dim() != want.Dim() {
+		t.Errorf("Interpolate() = %v, want %v", got, want)
+	}
+	// The far corner is inside.
+	if _, err := s.Interpolate(pos(0.2, 0.3, 1.2)); err != nil {
+		t.Errorf("Interpolate(corner) error = %v", err)
+	}
+	if _, err := s.Interpolate(pos(0.5, 0, 1)); err == nil {
+		t.Error("Interpolate() should fail outside the grid")
+	}
+
+	if err := s.Set(0, 0, 0, units.Meter(1).Value); err == nil {
+		t.Error("Set() should fail with mismatched dimension")
+	}
+	if _, err := NewGrid(0, 1, 1, units.Meter(1), pos(0, 0, 0), units.Dimension{}); err == nil {
+		t.Error("NewGrid() should fail with no nodes")
+	}
+	if _, err := NewGrid(1, 1, 1, units.Meter(-1), pos(0, 0, 0), units.Dimension{}); err == nil {
+		t.Error("NewGrid() should fail with negative spacing")
+	}
+}
+
+func TestGridDifferences(t *testing.T) {
+	g, _ := NewGrid(6, 5, 4, units.Centimeter(5), pos(0.1, -0.1, 0), units.Volt(1).Dim())
+	s, _ := g.Sample(quadratic)
+
+	// The stencils are exact for quadratics, boundaries included.
+	lap := s.Laplacian()
+	if lap.Dim() != (units.Dimension{L: 0, M: 1, T: -3, I: -1}) {
+		t.Errorf("Laplacian() dimension = %v, want V/m²", lap.Dim())
+	}
+	for _, v := range lap.Values() {
+		if !almostEqual(v, 12, 1e-9) {
+			t.Fatalf("Laplacian() = %v, want 12 V/m² everywhere", v)
+		}
+	}
+
+	grad := s.Gradient()
+	if grad[0].Dim() != voltPerMeter {
+		t.Errorf("Gradient() dimension = %v, want V/m", grad[0].Dim())
+	}
+	p := s.Position(5, 0, 3).ToArray()
+	want := [3]float64{2 * p[0], 4 * p[1], 6 * p[2]}
+	for a := 0; a < 3; a++ {
+		if got := grad[a].At(5, 0, 3).Val(); !almostEqual(got, want[a], 1e-9) {
+			t.Errorf("∂f/∂x%d at corner = %v, want %v", a, got, want[a])
+		}
+	}
+
+	div, err := GridDivergence(grad[0], grad[1], grad[2])
+	if err != nil {
+		t.Fatalf("GridDivergence() error = %v", err)
+	}
+	if v := div.At(2, 2, 2); !almostEqual(v.Val(), 12, 1e-9) || v.Dim() != lap.Dim() {
+		t.Errorf("∇·∇f = %v, want 12 V/m²", v)
+	}
+	if _, err := GridDivergence(grad[0], grad[1], s); err == nil {
+		t.Error("GridDivergence() should fail with mismatched components")
+	}
+
+	// A planar grid has no z derivative.
+	flat, _ := NewGrid(6, 5, 1, units.Centimeter(5), pos(0, 0, 0), units.Volt(1).Dim())
+	fs, _ := flat.Sample(quadratic)
+	if v := fs.Laplacian().At(3, 2, 0).Val(); !almostEqual(v, 6, 1e-9) {
+		t.Errorf("planar Laplacian = %v, want 6", v)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Poisson Tests
+// -----------------------------------------------------------------------------
+
+// sineMode returns sin(πx/L) sin(πy/L) sin(πz/L) on [0, L]³, which vanishes
+// on the boundary and satisfies ∇²u = -3π²/L² u.
+func sineMode(l float64) func(r vector.Vector3) float64 {
+	return func(r vector.Vector3) float64 {
+		x := r.ToArray()
+		return math.Sin(math.Pi*x[0]/l) * math.Sin(math.Pi*x[1]/l) * math.Sin(math.Pi*x[2]/l)
+	}
+}
+
+func TestElectricPotential(t *testing.T) {
+	const l, n = 1.0, 21
+	mode := sineMode(l)
+	eps0 := constants.VacuumPermittivity.Val()
+	g, _ := NewGrid(n, n, n, units.Meter(l/(n-1)), pos(0, 0, 0), chargeDensityDim)
+	// ρ = ε₀ 3π²/L² V₀ u gives V = V₀ u with V₀ = 1 V.
+	rho, _ := g.Sample(func(r vector.Vector3) units.Value {
+		return units.NewValue(eps0*3*math.Pi*math.Pi/(l*l)*mode(r), chargeDensityDim)
+	})
+	v, err := ElectricPotential(rho, 1e-10)
+	if err != nil {
+		t.Fatalf("ElectricPotential() error = %v", err)
+	}
+	if v.Dim() != units.Volt(1).Dim() {
+		t.Errorf("potential dimension = %v, want V", v.Dim())
+	}
+	// Second-order discretization error: ≈ π²h²/4 relative.
+	if got := v.At(10, 10, 10).Val(); !almostEqual(got, 1, 0.01) {
+		t.Errorf("V(center) = %v, want 1 V", got)
+	}
+	e := FieldFromPotential(v)
+	if e[0].Dim() != voltPerMeter {
+		t.Errorf("field dimension = %v, want V/m", e[0].Dim())
+	}
+	// Eₓ = -∂V/∂x = -(π/L) cos(πx/L)… at x = L/4 on the center lines.
+	want := -math.Pi / l * math.Cos(math.Pi/4) * math.Sin(math.Pi/2) * math.Sin(math.Pi/2)
+	if got := e[0].At(5, 10, 10).Val(); !almostEqual(got/want, 1, 0.02) {
+		t.Errorf("Eₓ(L/4) = %v, want %v", got, want)
+	}
+
+	if _, err := ElectricPotential(rho, 0); err == nil {
+		t.Error("ElectricPotential() should fail with zero tolerance")
+	}
+	if _, err := ElectricPotential(v, 1e-6); err == nil {
+		t.Error("ElectricPotential() should reject a non-charge-density grid")
+	}
+}
+
+func TestGravitationalPotential(t *testing.T) {
+	// A dense cube at the center of a larger box.
+	const n = 25
+	g, _ := NewGrid(n, n, n, units.Meter(1), pos(0, 0, 0), densityDim)
+	for i := 10; i <= 14; i++ {
+		for j := 10; j <= 14; j++ {
+			for k := 10; k <= 14; k++ {
+				g.Set(i, j, k, units.NewValue(5000, densityDim))
+			}
+		}
+	}
+	phi, err := GravitationalPotential(g, 1e-8)
+	if err != nil {
+		t.Fatalf("GravitationalPotential() error = %v", err)
+	}
+	if phi.Dim() != (units.Dimension{L: 2, T: -2}) {
+		t.Errorf("Φ dimension = %v, want J/kg", phi.Dim())
+	}
+	if c := phi.At(12, 12, 12).Val(); !(c < 0) || c > phi.At(12, 12, 3).Val() {
+		t.Errorf("Φ should be deepest at the mass, got Φ(center) = %v", c)
+	}
+	acc := FieldFromPotential(phi)
+	if acc[0].Dim() != (units.Dimension{L: 1, T: -2}) {
+		t.Errorf("g dimension = %v, want m/s²", acc[0].Dim())
+	}
+	// Attraction: gₓ points towards the mass on either side.
+	if left, right := acc[0].At(6, 12, 12).Val(), acc[0].At(18, 12, 12).Val(); !(left > 0 && right < 0) {
+		t.Errorf("gₓ = %v left, %v right; want towards the center", left, right)
+	}
+	// Far from a compact mass the field approaches -GM/r².
+	m := 125 * 5000.0
+	want := -constants.GravitationalConstant.Val() * m / 36
+	if got := acc[0].At(18, 12, 12).Val(); !almostEqual(got/want, 1, 0.3) {
+		t.Errorf("gₓ at 6 m = %v, want ≈ %v", got, want)
+	}
+
+	flat, _ := NewGrid(2, 5, 1, units.Meter(1), pos(0, 0, 0), densityDim)
+	if _, err := GravitationalPotential(flat, 1e-6); err == nil {
+		t.Error("GravitationalPotential() should reject an axis with two nodes")
+	}
+}
+
+func TestSolvePoissonBoundary(t *testing.T) {
+	// Laplace's equation between plates at 0 V and 10 V: V is linear.
+	g, _ := NewGrid(11, 5, 1, units.Centimeter(1), pos(0, 0, 0), units.Dimension{M: 1, T: -3, I: -1})
+	bc, _ := NewGrid(11, 5, 1, units.Centimeter(1), pos(0, 0, 0), units.Volt(1).Dim())
+	for j := 0; j < 5; j++ {
+		for i := 0; i < 11; i++ {
+			if i == 0 || i == 10 || j == 0 || j == 4 {
+				bc.Set(i, j, 0, units.Volt(float64(i)).Value)
+			}
+		}
+	}
+	v, err := SolvePoisson(g, bc, 1e-12)
+	if err != nil {
+		t.Fatalf("SolvePoisson() error = %v", err)
+	}
+	if got := v.At(3, 2, 0).Val(); !almostEqual(got, 3, 1e-9) {
+		t.Errorf("V = %v, want 3 V", got)
+	}
+	if _, err := SolvePoisson(g, g, 1e-6); err == nil {
+		t.Error("SolvePoisson() should reject a boundary grid of the wrong dimension")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Parallel Tests
+// -----------------------------------------------------------------------------
+
+// bumpGrid returns an n³ grid sampling a smooth, asymmetric bump.
+func bumpGrid(n int) *Grid {
+	g, _ := NewGrid(n, n, n, units.Centimeter(1), pos(0, 0, 0), units.Kelvin(1).Dim())
+	for k := 0; k < n; k++ {
+		for j := 0; j < n; j++ {
+			for i := 0; i < n; i++ {
+				x, y, z := float64(i)/float64(n), float64(j)/float64(n), float64(k)/float64(n)
+				g.Set(i, j, k, units.Kelvin(math.Exp(-10*((x-0.3)*(x-0.3)+(y-0.5)*(y-0.5)+(z-0.6)*(z-0.6)))+x*y).Value)
+			}
+		}
+	}
+	return g
+}
+
+func TestGridParallel(t *testing.T) {
+	pool := parallel.NewPool(4)
+	defer pool.Close()
+
+	serial := bumpGrid(17)
+	par := serial.Clone()
+	par.SetPool(pool)
+
+	// Parallel operators give bit-identical results.
+	same := func(name string, a, b *Grid) {
+		av, bv := a.Values(), b.Values()
+		for i := range av {
+			if av[i] != bv[i] {
+				t.Errorf("%s differs at node %d: %v vs %v", name, i, av[i], bv[i])
+				return
+			}
+		}
+	}
+	same("Laplacian", serial.Laplacian(), par.Laplacian())
+	gs, gp := serial.Gradient(), par.Gradient()
+	for a := 0; a < 3; a++ {
+		same("Gradient", gs[a], gp[a])
+	}
+	divS, _ := GridDivergence(gs[0], gs[1], gs[2])
+	divP, _ := GridDivergence(gp[0], gp[1], gp[2])
+	same("GridDivergence", divS, divP)
+
+	us, err := SolvePoisson(serial, nil, 1e-10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	up, _ := SolvePoisson(par, nil, 1e-10)
+	same("SolvePoisson", us, up)
+}
+
+func BenchmarkGridLaplacian(b *testing.B) {
+	g := bumpGrid(96)
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			pool := parallel.NewPool(workers)
+			defer pool.Close()
+			g.SetPool(pool)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				g.Laplacian()
+			}
+		})
+	}
+}
+
+func BenchmarkSolvePoisson(b *testing.B) {
+	src := bumpGrid(33)
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			pool := parallel.NewPool(workers)
+			defer pool.Close()
+			src.SetPool(pool)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				SolvePoisson(src, nil, 1e-6)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Package matrix provides unit-safe dense matrix operations for physics
+// calculations.
+//
+// A Matrix3 stores nine float64 elements (in SI base units) that share a
+// single Dimension, so tensors such as the inertia tensor [L²M] or the
+// stress tensor [L⁻¹MT⁻²] carry their units through every operation.
+// Products combine dimensions, inverses take the reciprocal dimension, and
+// SymmetricEigen returns eigenvalues in the matrix dimension with
+// dimensionless eigenvectors, such as principal moments and axes.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/math/matrix"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Inertia tensor of a body (kg⋅m²)
+//	inertia := matrix.New([3][3]float64{
+//	    {2, 0, 0},
+//	    {0, 3, 0},
+//	    {0, 0, 4},
+//	}, units.Dimension{L: 2, M: 1})
+//
+//	// Angular momentum: L = Iω
+//	omega := vector.Vector3{X: units.RadianPerSecond(1).Value, ...}
+//	L := inertia.MulVector(omega) // [L²MT⁻¹]
+//
+//	// Principal moments and axes
+//	moments, axes, _ := inertia.SymmetricEigen()
+package matrix
@@ -0,0 +1,322 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Matrix3 represents a 3×3 matrix whose elements share a single physical
+// dimension. Elements are stored in SI base units, row-major.
+type Matrix3 struct {
+	m   [3][3]float64
+	dim units.Dimension
+}
+
+// New creates a Matrix3 from row-major element values (in SI base units)
+// and a shared dimension.
+//
+// Example:
+//
+//	stress := matrix.New([3][3]float64{
+//	    {1e6, 0, 0},
+//	    {0, 2e6, 0},
+//	    {0, 0, 3e6},
+//	}, units.Pascal(1).Dim())
+func New(values [3][3]float64, dim units.Dimension) Matrix3 {
+	return Matrix3{m: values, dim: dim}
+}
+
+// FromValues creates a Matrix3 from unit-safe elements.
+// All elements must have the same dimension.
+func FromValues(values [3][3]units.Value) (Matrix3, error) {
+	dim := values[0][0].Dim()
+	var m [3][3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if values[i][j].Dim() != dim {
+				return Matrix3{}, fmt.Errorf("matrix elements must have same dimension: [0][0]=%s, [%d][%d]=%s",
+					dim, i, j, values[i][j].Dim())
+			}
+			m[i][j] = values[i][j].Val()
+		}
+	}
+	return Matrix3{m: m, dim: dim}, nil
+}
+
+// Identity returns the dimensionless 3×3 identity matrix.
+func Identity() Matrix3 {
+	return Diagonal(1, 1, 1, units.Dimension{})
+}
+
+// Diagonal returns a diagonal matrix with the given elements (in SI base
+// units) and dimension.
+//
+// Example:
+//
+//	// Principal moments of inertia (kg⋅m²)
+//	I := matrix.Diagonal(2, 3, 4, units.Dimension{L: 2, M: 1})
+func Diagonal(d0, d1, d2 float64, dim units.Dimension) Matrix3 {
+	return Matrix3{
+		m:   [3][3]float64{{d0, 0, 0}, {0, d1, 0}, {0, 0, d2}},
+		dim: dim,
+	}
+}
+
+// Outer returns the outer (dyadic) product u ⊗ v, with elements uᵢvⱼ and
+// the product of the two vector dimensions. It lives here rather than on
+// Vector3 because the vector package cannot import matrix.
+//
+// Example:
+//
+//	// Projector onto the plane normal to a unit vector n̂: P = E - n̂ ⊗ n̂
+//	P, _ := matrix.Identity().Subtract(matrix.Outer(n, n))
+//
+//	// Point-mass contribution to an inertia tensor: m (r² E - r ⊗ r), in [L²]
+//	rr := matrix.Outer(r, r)
+//	r2 := r.MagnitudeSquared().Val()
+//	term, _ := matrix.Diagonal(r2, r2, r2, rr.Dim()).Subtract(rr)
+func Outer(u, v vector.Vector3) Matrix3 {
+	a, b := u.ToArray(), v.ToArray()
+	var m [3][3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i][j] = a[i] * b[j]
+		}
+	}
+	return Matrix3{m: m, dim: mulDim(u.Dim(), v.Dim())}
+}
+
+// At returns the element at row i, column j as a unit-safe Value.
+// Panics if i or j is outside [0, 2].
+func (a Matrix3) At(i, j int) units.Value {
+	return units.NewValue(a.m[i][j], a.dim)
+}
+
+// Dim returns the dimension shared by all elements.
+func (a Matrix3) Dim() units.Dimension {
+	return a.dim
+}
+
+// ToArray returns the matrix elements as a float64 array (in SI base units).
+func (a Matrix3) ToArray() [3][3]float64 {
+	return a.m
+}
+
+// MulVector returns the matrix-vector product A·v.
+// The result has dimension equal to the product of the matrix and vector dimensions.
+//
+// Example:
+//
+//	// L = Iω: [L²M] × [T⁻¹] = [L²MT⁻¹]
+//	L := inertia.MulVector(omega)
+func (a Matrix3) MulVector(v vector.Vector3) vector.Vector3 {
+	x := v.ToArray()
+	dim := mulDim(a.dim, v.Dim())
+	var out [3]units.Value
+	for i := 0; i < 3; i++ {
+		out[i] = units.NewValue(a.m[i][0]*x[0]+a.m[i][1]*x[1]+a.m[i][2]*x[2], dim)
+	}
+	return vector.Vector3{X: out[0], Y: out[1], Z: out[2]}
+}
+
+// Determinant returns det(A). The result has the matrix dimension cubed.
+func (a Matrix3) Determinant() units.Value {
+	m := a.m
+	det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+	return units.NewValue(det, mulDim(mulDim(a.dim, a.dim), a.dim))
+}
+
+// Inverse returns A⁻¹ via the adjugate. The result has the reciprocal
+// dimension, so A⁻¹·(A·v) recovers v with its original dimension.
+// Returns an error if the matrix is singular.
+func (a Matrix3) Inverse() (Matrix3, error) {
+	m := a.m
+	det := a.Determinant().Val()
+	if det == 0 {
+		return Matrix3{}, fmt.Errorf("cannot invert singular matrix")
+	}
+
+	inv := [3][3]float64{
+		{m[1][1]*m[2][2] - m[1][2]*m[2][1], m[0][2]*m[2][1] - m[0][1]*m[2][2], m[0][1]*m[1][2] - m[0][2]*m[1][1]},
+		{m[1][2]*m[2][0] - m[1][0]*m[2][2], m[0][0]*m[2][2] - m[0][2]*m[2][0], m[0][2]*m[1][0] - m[0][0]*m[1][2]},
+		{m[1][0]*m[2][1] - m[1][1]*m[2][0], m[0][1]*m[2][0] - m[0][0]*m[2][1], m[0][0]*m[1][1] - m[0][1]*m[1][0]},
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			inv[i][j] /= det
+		}
+	}
+	return Matrix3{m: inv, dim: invDim(a.dim)}, nil
+}
+
+// Transpose returns Aᵀ.
+func (a Matrix3) Transpose() Matrix3 {
+	var t [3][3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			t[i][j] = a.m[j][i]
+		}
+	}
+	return Matrix3{m: t, dim: a.dim}
+}
+
+// Mul returns the matrix product A·B. The result has the product of the
+// two dimensions.
+//
+// Example:
+//
+//	// Rotate an inertia tensor into another frame: I' = R I Rᵀ
+//	rotated := R.Mul(inertia).Mul(R.Transpose())
+func (a Matrix3) Mul(b Matrix3) Matrix3 {
+	var p [3][3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			p[i][j] = a.m[i][0]*b.m[0][j] + a.m[i][1]*b.m[1][j] + a.m[i][2]*b.m[2][j]
+		}
+	}
+	return Matrix3{m: p, dim: mulDim(a.dim, b.dim)}
+}
+
+// Add returns A + B. Matrices must have the same dimension.
+func (a Matrix3) Add(b Matrix3) (Matrix3, error) {
+	if a.dim != b.dim {
+		return Matrix3{}, fmt.Errorf("cannot add matrices with dimensions %s and %s", a.dim, b.dim)
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			a.m[i][j] += b.m[i][j]
+		}
+	}
+	return a, nil
+}
+
+// Subtract returns A - B. Matrices must have the same dimension.
+func (a Matrix3) Subtract(b Matrix3) (Matrix3, error) {
+	return a.Add(b.Scale(-1))
+}
+
+// Scale returns the matrix multiplied by a dimensionless scalar.
+func (a Matrix3) Scale(k float64) Matrix3 {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			a.m[i][j] *= k
+		}
+	}
+	return a
+}
+
+// Trace returns the sum of the diagonal elements, tr(A).
+func (a Matrix3) Trace() units.Value {
+	return units.NewValue(a.m[0][0]+a.m[1][1]+a.m[2][2], a.dim)
+}
+
+// IsSymmetric reports whether |aᵢⱼ - aⱼᵢ| ≤ tolerance·max|aₖₗ| for all
+// off-diagonal pairs.
+func (a Matrix3) IsSymmetric(tolerance float64) bool {
+	scale := 0.0
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			scale = math.Max(scale, math.Abs(a.m[i][j]))
+		}
+	}
+	for i := 0; i < 3; i++ {
+		for j := i + 1; j < 3; j++ {
+			if math.Abs(a.m[i][j]-a.m[j][i]) > tolerance*scale {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// SymmetricEigen returns the eigenvalues of a real symmetric matrix in
+// ascending order, with the matching orthonormal eigenvectors. Eigenvalues
+// carry the matrix dimension and eigenvectors are dimensionless. The
+// eigenvectors form a right-handed set.
+//
+// The decomposition uses cyclic Jacobi rotations, which converge
+// quadratically and give eigenvectors orthogonal to machine precision even
+// for repeated eigenvalues.
+//
+// Returns an error if the matrix is not symmetric to within 1e-12 relative.
+//
+// Example:
+//
+//	// Principal moments and axes of an inertia tensor
+//	moments, axes, _ := inertia.SymmetricEigen()
+func (a Matrix3) SymmetricEigen() ([3]units.Value, [3]vector.Vector3, error) {
+	if !a.IsSymmetric(1e-12) {
+		return [3]units.Value{}, [3]vector.Vector3{}, fmt.Errorf("matrix must be symmetric")
+	}
+	m := a.m
+	v := Identity().m
+	for sweep := 0; sweep < 50; sweep++ {
+		off := m[0][1]*m[0][1] + m[0][2]*m[0][2] + m[1][2]*m[1][2]
+		diag := m[0][0]*m[0][0] + m[1][1]*m[1][1] + m[2][2]*m[2][2]
+		if off <= 1e-32*diag || off == 0 {
+			break
+		}
+		for p := 0; p < 2; p++ {
+			for q := p + 1; q < 3; q++ {
+				if m[p][q] == 0 {
+					continue
+				}
+				// Rotation angle that zeroes m[p][q].
+				theta := (m[q][q] - m[p][p]) / (2 * m[p][q])
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < 3; k++ {
+					mkp, mkq := m[k][p], m[k][q]
+					m[k][p], m[k][q] = c*mkp-s*mkq, s*mkp+c*mkq
+				}
+				for k := 0; k < 3; k++ {
+					mpk, mqk := m[p][k], m[q][k]
+					m[p][k], m[q][k] = c*mpk-s*mqk, s*mpk+c*mqk
+				}
+				for k := 0; k < 3; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p], v[k][q] = c*vkp-s*vkq, s*vkp+c*vkq
+				}
+			}
+		}
+	}
+
+	order := [3]int{0, 1, 2}
+	for i := 1; i < 3; i++ {
+		for j := i; j > 0 && m[order[j]][order[j]] < m[order[j-1]][order[j-1]]; j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+
+	var values [3]units.Value
+	var vectors [3]vector.Vector3
+	for i, k := range order {
+		values[i] = units.NewValue(m[k][k], a.dim)
+		vectors[i] = vector.Vector3{
+			X: units.Dimensionless(v[0][k]),
+			Y: units.Dimensionless(v[1][k]),
+			Z: units.Dimensionless(v[2][k]),
+		}
+	}
+	// Make the basis right-handed.
+	if vectors[0].Cross(vectors[1]).Dot(vectors[2]).Val() < 0 {
+		vectors[2] = vectors[2].Negate()
+	}
+	return values, vectors, nil
+}
+
+// mulDim returns the dimension of a product of quantities with dimensions a and b.
+func mulDim(a, b units.Dimension) units.Dimension {
+	return units.NewValue(1, a).Multiply(units.NewValue(1, b)).Dim()
+}
+
+// invDim returns the reciprocal dimension of d.
+func invDim(d units.Dimension) units.Dimension {
+	return units.Dimensionless(1).Divide(units.NewValue(1, d)).Dim()
+}
@@ -0,0 +1,231 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+// -----------------------------------------------------------------------------
+// Constructor Tests
+// -----------------------------------------------------------------------------
+
+func TestFromValues(t *testing.T) {
+	m := units.Meter(1).Value
+	values := [3][3]units.Value{{m, m, m}, {m, m, m}, {m, m, m}}
+
+	a, err := FromValues(values)
+	if err != nil {
+		t.Fatalf("FromValues() error = %v", err)
+	}
+	if a.Dim() != (units.Dimension{L: 1}) {
+		t.Errorf("FromValues() dimension = %v, want [L^1]", a.Dim())
+	}
+
+	values[1][2] = units.Second(1).Value
+	if _, err := FromValues(values); err == nil {
+		t.Error("FromValues() should fail with mixed dimensions")
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	id := Identity()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if id.At(i, j).Val() != want {
+				t.Errorf("Identity()[%d][%d] = %v, want %v", i, j, id.At(i, j).Val(), want)
+			}
+		}
+	}
+	if !id.At(0, 0).IsDimensionless() {
+		t.Error("Identity() should be dimensionless")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Algebra Tests
+// -----------------------------------------------------------------------------
+
+func TestMulVector(t *testing.T) {
+	// L = Iω
+	inertia := Diagonal(2, 3, 4, units.Dimension{L: 2, M: 1})
+	omega := vector.Vector3{
+		X: units.RadianPerSecond(1).Value,
+		Y: units.RadianPerSecond(2).Value,
+		Z: units.RadianPerSecond(3).Value,
+	}
+
+	L := inertia.MulVector(omega)
+	if L.X.Val() != 2 || L.Y.Val() != 6 || L.Z.Val() != 12 {
+		t.Errorf("MulVector() = (%v, %v, %v), want (2, 6, 12)", L.X.Val(), L.Y.Val(), L.Z.Val())
+	}
+	if L.Dim() != (units.Dimension{L: 2, M: 1, T: -1}) {
+		t.Errorf("MulVector() dimension = %v, want [L²MT⁻¹]", L.Dim())
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	a := New([3][3]float64{{2, 0, 1}, {1, 3, 2}, {1, 1, 1}}, units.Dimension{L: 1})
+	det := a.Determinant()
+	// 2(3-2) - 0 + 1(1-3) = 0
+	if !almostEqual(det.Val(), 0, 1e-14) {
+		t.Errorf("Determinant() = %v, want 0", det.Val())
+	}
+	if det.Dim() != (units.Dimension{L: 3}) {
+		t.Errorf("Determinant() dimension = %v, want [L^3]", det.Dim())
+	}
+	if _, err := a.Inverse(); err == nil {
+		t.Error("Inverse() should fail for singular matrix")
+	}
+}
+
+func TestInverse(t *testing.T) {
+	a := New([3][3]float64{{4, 7, 2}, {3, 6, 1}, {2, 5, 3}}, units.Dimension{L: 2, M: 1})
+	inv, err := a.Inverse()
+	if err != nil {
+		t.Fatalf("Inverse() error = %v", err)
+	}
+	if inv.Dim() != (units.Dimension{L: -2, M: -1}) {
+		t.Errorf("Inverse() dimension = %v, want [L⁻²M⁻¹]", inv.Dim())
+	}
+
+	// A⁻¹(Av) = v
+	v := vector.NewVelocity(units.MeterPerSecond(1), units.MeterPerSecond(-2), units.MeterPerSecond(5))
+	back := inv.MulVector(a.MulVector(v))
+	if back.Dim() != v.Dim() {
+		t.Errorf("A⁻¹Av dimension = %v, want %v", back.Dim(), v.Dim())
+	}
+	got, want := back.ToArray(), v.ToArray()
+	for k := 0; k < 3; k++ {
+		if !almostEqual(got[k], want[k], 1e-12) {
+			t.Errorf("A⁻¹Av[%d] = %v, want %v", k, got[k], want[k])
+		}
+	}
+}
+
+func TestTransposeMul(t *testing.T) {
+	a := New([3][3]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}, units.Dimension{L: 1})
+	if at := a.Transpose(); at.At(0, 2).Val() != 7 || at.At(2, 0).Val() != 3 || at.Dim() != a.Dim() {
+		t.Errorf("Transpose() = %v", at.ToArray())
+	}
+	inv, _ := a.Inverse()
+	p := a.Mul(inv)
+	if !p.At(0, 0).IsDimensionless() {
+		t.Errorf("A·A⁻¹ dimension = %v, want dimensionless", p.Dim())
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if !almostEqual(p.At(i, j).Val(), want, 1e-12) {
+				t.Errorf("(A·A⁻¹)[%d][%d] = %v, want %v", i, j, p.At(i, j).Val(), want)
+			}
+		}
+	}
+	if tr := a.Trace(); tr.Val() != 16 || tr.Dim() != a.Dim() {
+		t.Errorf("Trace() = %v, want 16 m", tr)
+	}
+}
+
+func TestAddScale(t *testing.T) {
+	a := Diagonal(1, 2, 3, units.Dimension{L: 1})
+	sum, err := a.Add(a.Scale(2))
+	if err != nil || sum.At(2, 2).Val() != 9 {
+		t.Errorf("A + 2A = %v, %v", sum.ToArray(), err)
+	}
+	if diff, _ := sum.Subtract(a); diff.At(1, 1).Val() != 4 {
+		t.Errorf("3A - A = %v", diff.ToArray())
+	}
+	if a.At(0, 0).Val() != 1 {
+		t.Error("Scale/Add should not modify the receiver")
+	}
+	if _, err := a.Add(Identity()); err == nil {
+		t.Error("Add() should fail with mismatched dimensions")
+	}
+}
+
+func TestSymmetricEigen(t *testing.T) {
+	// Inertia tensor of a 1×2×3 box rotated about z by 30°.
+	dim := units.Dimension{L: 2, M: 1}
+	c, s := math.Cos(math.Pi/6), math.Sin(math.Pi/6)
+	r := New([3][3]float64{{c, -s, 0}, {s, c, 0}, {0, 0, 1}}, units.Dimension{})
+	inertia := r.Mul(Diagonal(13, 10, 5, dim)).Mul(r.Transpose())
+
+	values, vectors, err := inertia.SymmetricEigen()
+	if err != nil {
+		t.Fatalf("SymmetricEigen() error = %v", err)
+	}
+	for i, want := range []float64{5, 10, 13} {
+		if !almostEqual(values[i].Val(), want, 1e-12) || values[i].Dim() != dim {
+			t.Errorf("eigenvalue[%d] = %v, want %v", i, values[i], want)
+		}
+		// A v = λ v
+		av := inertia.MulVector(vectors[i]).ToArray()
+		v := vectors[i].ToArray()
+		for k := 0; k < 3; k++ {
+			if !almostEqual(av[k], values[i].Val()*v[k], 1e-12) {
+				t.Errorf("A v[%d] = %v, want %v", i, av, values[i].Val())
+				break
+			}
+		}
+	}
+	if d := vectors[0].Cross(vectors[1]).Dot(vectors[2]).Val(); !almostEqual(d, 1, 1e-12) {
+		t.Errorf("eigenvectors triple product = %v, want 1", d)
+	}
+
+	// A repeated eigenvalue still yields an orthonormal basis.
+	deg := New([3][3]float64{{2, 1, 1}, {1, 2, 1}, {1, 1, 2}}, units.Dimension{})
+	values, vectors, _ = deg.SymmetricEigen()
+	if !almostEqual(values[0].Val(), 1, 1e-12) || !almostEqual(values[1].Val(), 1, 1e-12) || !almostEqual(values[2].Val(), 4, 1e-12) {
+		t.Errorf("eigenvalues = %v, want 1, 1, 4", values)
+	}
+	if d := vectors[0].Dot(vectors[1]).Val(); !almostEqual(d, 0, 1e-12) {
+		t.Errorf("degenerate eigenvectors not orthogonal: %v", d)
+	}
+
+	if _, _, err := New([3][3]float64{{1, 2, 0}, {0, 1, 0}, {0, 0, 1}}, dim).SymmetricEigen(); err == nil {
+		t.Error("SymmetricEigen() should fail for a non-symmetric matrix")
+	}
+}
+
+func TestOuter(t *testing.T) {
+	r := vector.NewPosition(units.Meter(1), units.Meter(2), units.Meter(3))
+	f := vector.NewForce(units.Newton(4), units.Newton(5), units.Newton(6))
+	d := Outer(r, f)
+	if d.At(1, 2).Val() != 12 || d.At(2, 1).Val() != 15 {
+		t.Errorf("Outer()[1][2], [2][1] = %v, %v, want 12, 15", d.At(1, 2), d.At(2, 1))
+	}
+	if d.Dim() != (units.Dimension{L: 2, M: 1, T: -2}) {
+		t.Errorf("Outer() dimension = %v, want [L²MT⁻²]", d.Dim())
+	}
+	// tr(u ⊗ v) = u · v
+	if d.Trace().Val() != r.Dot(f).Val() {
+		t.Errorf("tr(r ⊗ F) = %v, want %v", d.Trace(), r.Dot(f))
+	}
+
+	// Two unit point masses at (±1, 0, 0) m: I = Σ m (r² E - r ⊗ r) = diag(0, 2, 2)
+	dim := units.Dimension{L: 2, M: 1}
+	inertia := New([3][3]float64{}, dim)
+	for _, x := range []float64{1, -1} {
+		p := vector.NewPosition(units.Meter(x), units.Meter(0), units.Meter(0))
+		rr := Outer(p, p)
+		r2 := p.MagnitudeSquared().Val()
+		term, _ := Diagonal(r2, r2, r2, rr.Dim()).Subtract(rr)
+		inertia, _ = inertia.Add(New(term.ToArray(), dim))
+	}
+	if inertia.At(0, 0).Val() != 0 || inertia.At(1, 1).Val() != 2 || inertia.At(2, 2).Val() != 2 {
+		t.Errorf("point-mass inertia = %v, want diag(0, 2, 2)", inertia.ToArray())
+	}
+}
@@ -0,0 +1,34 @@
+// Package random provides a pseudo-random source whose state can be saved
+// and restored, so that stochastic simulations resume exactly where they
+// stopped.
+//
+// Source implements math/rand.Source64 with the xoshiro256** generator:
+// 256 bits of state, period 2²⁵⁶ - 1, and statistical quality well beyond
+// what Monte Carlo physics needs. Wrap it with rand.New to use the usual
+// sampling methods; the samplers in math/vector and quantum accept the
+// resulting *rand.Rand. MarshalBinary captures the state and
+// UnmarshalBinary restores it.
+//
+// The generator is not cryptographically secure.
+//
+// Example usage:
+//
+//	import (
+//	    "math/rand"
+//
+//	    "github.com/sakiphan/qsim-core/math/random"
+//	)
+//
+//	src := random.NewSource(42)
+//	rng := rand.New(src)
+//	dir := vector.RandomUnit(rng)
+//
+//	state, _ := src.MarshalBinary() // save ...
+//	src.UnmarshalBinary(state)      // ... and later resume the same stream
+//
+// References:
+//   - Blackman, Vigna. "Scrambled linear pseudorandom number generators",
+//     ACM Trans. Math. Softw. 47, 36 (2021)
+//   - Steele, Lea, Flood. "Fast splittable pseudorandom number generators",
+//     OOPSLA 2014 (SplitMix64 seeding)
+package random
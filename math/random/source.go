@@ -0,0 +1,115 @@
+package random
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// stateSize is the length of the binary state encoding in bytes.
+const stateSize = 32
+
+// Source is a xoshiro256** pseudo-random source implementing
+// rand.Source64.
+//
+// Source is not safe for concurrent use.
+type Source struct {
+	s [4]uint64
+}
+
+// NewSource returns a source seeded with seed.
+func NewSource(seed int64) *Source {
+	src := &Source{}
+	src.Seed(seed)
+	return src
+}
+
+// Seed resets the state from seed by SplitMix64, which never yields the
+// all-zero state.
+func (s *Source) Seed(seed int64) {
+	x := uint64(seed)
+	for i := range s.s {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+		z = (z ^ z>>27) * 0x94d049bb133111eb
+		s.s[i] = z ^ z>>31
+	}
+}
+
+// Uint64 returns a uniformly distributed 64-bit value.
+func (s *Source) Uint64() uint64 {
+	result := bits.RotateLeft64(s.s[1]*5, 7) * 9
+	t := s.s[1] << 17
+	s.s[2] ^= s.s[0]
+	s.s[3] ^= s.s[1]
+	s.s[1] ^= s.s[2]
+	s.s[0] ^= s.s[3]
+	s.s[2] ^= t
+	s.s[3] = bits.RotateLeft64(s.s[3], 45)
+	return result
+}
+
+// Int63 returns a uniformly distributed non-negative 63-bit value.
+func (s *Source) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// Jump advances the state by 2¹²⁸ draws, giving a stream that does not
+// overlap the original for any practical run length. Successive jumps
+// derive independent streams for parallel workers from one seed.
+//
+// Example:
+//
+//	streams := make([]*random.Source, workers)
+//	src := random.NewSource(seed)
+//	for i := range streams {
+//	    streams[i] = src.Clone()
+//	    src.Jump()
+//	}
+func (s *Source) Jump() {
+	jump := [4]uint64{0x180ec6d33cfd0aba, 0xd5a61266f0c9392c, 0xa9582618e03fc9aa, 0x39abdc4529b1661c}
+	var t [4]uint64
+	for _, j := range jump {
+		for b := 0; b < 64; b++ {
+			if j&(1<<b) != 0 {
+				for i := range t {
+					t[i] ^= s.s[i]
+				}
+			}
+			s.Uint64()
+		}
+	}
+	s.s = t
+}
+
+// Clone returns an independent copy of the source in the same state.
+func (s *Source) Clone() *Source {
+	c := *s
+	return &c
+}
+
+// MarshalBinary encodes the generator state in 32 bytes.
+func (s *Source) MarshalBinary() ([]byte, error) {
+	b := make([]byte, stateSize)
+	for i, x := range s.s {
+		binary.LittleEndian.PutUint64(b[8*i:], x)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary restores a state encoded by MarshalBinary.
+func (s *Source) UnmarshalBinary(b []byte) error {
+	if len(b) != stateSize {
+		return fmt.Errorf("random source state must be %d bytes, got %d", stateSize, len(b))
+	}
+	var st [4]uint64
+	for i := range st {
+		st[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+	if st == ([4]uint64{}) {
+		return fmt.Errorf("random source state must not be all zero")
+	}
+	s.s = st
+	return nil
+}
@@ -0,0 +1,35 @@
+// Package rotation represents 3D rotations as unit quaternions and converts
+// them to and from axis-angle pairs, Euler angles and rotation matrices.
+//
+// Rotations are active. q.Rotate(v) turns the vector v about the rotation
+// axis by the rotation angle, following the right-hand rule. It computes
+// q v q* and leaves the dimension of v unchanged. Composition reads like
+// matrix products: q.Mul(r) applies r first, then q.
+//
+// Euler angles use the aerospace z-y′-x″ (yaw, pitch, roll) sequence. The
+// attitude is the rotation from the reference frame to the body frame, so
+// a body-frame vector v has reference-frame components q.Rotate(v).
+// Integrate advances an attitude under a body-frame angular velocity.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/math/rotation"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// 90° about z maps x to y
+//	z := vector.NewPosition(units.Meter(0), units.Meter(0), units.Meter(1))
+//	q, _ := rotation.FromAxisAngle(z, units.Degree(90))
+//	v := q.Rotate(vector.NewVelocity(units.MeterPerSecond(3), units.MeterPerSecond(0), units.MeterPerSecond(0)))
+//	// v = (0, 3, 0) m/s
+//
+//	// Halfway between two attitudes
+//	mid := rotation.Slerp(rotation.Identity(), q, 0.5) // 45° about z
+//
+// References:
+//   - Shuster. "A Survey of Attitude Representations", J. Astronaut. Sci.
+//     41, 439 (1993)
+//   - Shoemake. "Animating Rotation with Quaternion Curves", SIGGRAPH '85
+package rotation
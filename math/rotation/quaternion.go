@@ -0,0 +1,276 @@
+package rotation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/matrix"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Quaternion is a quaternion w + xi + yj + zk. Rotations are represented by
+// unit quaternions; q and -q describe the same rotation.
+type Quaternion struct {
+	W, X, Y, Z float64
+}
+
+// Identity returns the quaternion of the null rotation.
+func Identity() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// -----------------------------------------------------------------------------
+// Constructors
+// -----------------------------------------------------------------------------
+
+// FromAxisAngle returns the rotation by angle θ about an axis. The axis
+// may have any dimension and need not be normalized.
+//
+// Formula:
+//
+//	q = (cos θ/2, n̂ sin θ/2)
+//
+// Returns an error if the axis is zero.
+func FromAxisAngle(axis vector.Vector3, angle units.Angle) (Quaternion, error) {
+	n := axis.ToArray()
+	norm := math.Sqrt(n[0]*n[0] + n[1]*n[1] + n[2]*n[2])
+	if norm == 0 {
+		return Quaternion{}, fmt.Errorf("rotation axis must be non-zero")
+	}
+	s, c := math.Sincos(angle.Val() / 2)
+	k := s / norm
+	return Quaternion{W: c, X: n[0] * k, Y: n[1] * k, Z: n[2] * k}, nil
+}
+
+// FromEuler returns the rotation with yaw ψ about z, then pitch θ about the
+// new y, then roll φ about the newest x (intrinsic z-y′-x″).
+//
+// Formula:
+//
+//	q = q_z(ψ) q_y(θ) q_x(φ)
+func FromEuler(yaw, pitch, roll units.Angle) Quaternion {
+	sy, cy := math.Sincos(yaw.Val() / 2)
+	sp, cp := math.Sincos(pitch.Val() / 2)
+	sr, cr := math.Sincos(roll.Val() / 2)
+	return Quaternion{
+		W: cy*cp*cr + sy*sp*sr,
+		X: cy*cp*sr - sy*sp*cr,
+		Y: cy*sp*cr + sy*cp*sr,
+		Z: sy*cp*cr - cy*sp*sr,
+	}
+}
+
+// FromMatrix returns the rotation described by a dimensionless proper
+// orthogonal matrix, using Shepperd's method.
+//
+// Returns an error if the matrix has a dimension, is not orthogonal to
+// within 1e-9, or has determinant -1.
+func FromMatrix(m matrix.Matrix3) (Quaternion, error) {
+	if m.Dim() != (units.Dimension{}) {
+		return Quaternion{}, fmt.Errorf("rotation matrix must be dimensionless, got %s", m.Dim())
+	}
+	a := m.ToArray()
+	rrt := m.Mul(m.Transpose()).ToArray()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if math.Abs(rrt[i][j]-want) > 1e-9 {
+				return Quaternion{}, fmt.Errorf("matrix is not orthogonal")
+			}
+		}
+	}
+	if m.Determinant().Val() < 0 {
+		return Quaternion{}, fmt.Errorf("matrix is a reflection (det = -1)")
+	}
+
+	tr := a[0][0] + a[1][1] + a[2][2]
+	var q Quaternion
+	switch {
+	case tr >= a[0][0] && tr >= a[1][1] && tr >= a[2][2]:
+		s := 2 * math.Sqrt(1+tr)
+		q = Quaternion{W: s / 4, X: (a[2][1] - a[1][2]) / s, Y: (a[0][2] - a[2][0]) / s, Z: (a[1][0] - a[0][1]) / s}
+	case a[0][0] >= a[1][1] && a[0][0] >= a[2][2]:
+		s := 2 * math.Sqrt(1+2*a[0][0]-tr)
+		q = Quaternion{W: (a[2][1] - a[1][2]) / s, X: s / 4, Y: (a[0][1] + a[1][0]) / s, Z: (a[0][2] + a[2][0]) / s}
+	case a[1][1] >= a[2][2]:
+		s := 2 * math.Sqrt(1+2*a[1][1]-tr)
+		q = Quaternion{W: (a[0][2] - a[2][0]) / s, X: (a[0][1] + a[1][0]) / s, Y: s / 4, Z: (a[1][2] + a[2][1]) / s}
+	default:
+		s := 2 * math.Sqrt(1+2*a[2][2]-tr)
+		q = Quaternion{W: (a[1][0] - a[0][1]) / s, X: (a[0][2] + a[2][0]) / s, Y: (a[1][2] + a[2][1]) / s, Z: s / 4}
+	}
+	return q.Normalize(), nil
+}
+
+// -----------------------------------------------------------------------------
+// Algebra
+// -----------------------------------------------------------------------------
+
+// Mul returns the Hamilton product q·r, the rotation r followed by q.
+func (q Quaternion) Mul(r Quaternion) Quaternion {
+	return Quaternion{
+		W: q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+		X: q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		Y: q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		Z: q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+	}
+}
+
+// Conjugate returns q* = (w, -x, -y, -z), the inverse of a unit quaternion.
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{W: q.W, X: -q.X, Y: -q.Y, Z: -q.Z}
+}
+
+// Norm returns |q|.
+func (q Quaternion) Norm() float64 {
+	return math.Sqrt(q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+}
+
+// Normalize returns q/|q|, with the identity for a zero quaternion.
+func (q Quaternion) Normalize() Quaternion {
+	n := q.Norm()
+	if n == 0 {
+		return Identity()
+	}
+	return Quaternion{W: q.W / n, X: q.X / n, Y: q.Y / n, Z: q.Z / n}
+}
+
+// Dot returns the four-dimensional inner product of q and r.
+func (q Quaternion) Dot(r Quaternion) float64 {
+	return q.W*r.W + q.X*r.X + q.Y*r.Y + q.Z*r.Z
+}
+
+// -----------------------------------------------------------------------------
+// Application and Conversion
+// -----------------------------------------------------------------------------
+
+// Rotate returns the vector v rotated by the unit quaternion q. The result
+// has the same dimension as v.
+//
+// Formula:
+//
+//	v' = v + 2w (u × v) + 2 u × (u × v),   u = (x, y, z)
+func (q Quaternion) Rotate(v vector.Vector3) vector.Vector3 {
+	x := v.ToArray()
+	u := [3]float64{q.X, q.Y, q.Z}
+	t := cross(u, x)
+	for i := range t {
+		t[i] *= 2
+	}
+	ut := cross(u, t)
+	dim := v.Dim()
+	return vector.Vector3{
+		X: units.NewValue(x[0]+q.W*t[0]+ut[0], dim),
+		Y: units.NewValue(x[1]+q.W*t[1]+ut[1], dim),
+		Z: units.NewValue(x[2]+q.W*t[2]+ut[2], dim),
+	}
+}
+
+// Matrix returns the dimensionless rotation matrix R with R·v = q.Rotate(v).
+func (q Quaternion) Matrix() matrix.Matrix3 {
+	w, x, y, z := q.W, q.X, q.Y, q.Z
+	return matrix.New([3][3]float64{
+		{1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y)},
+		{2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x)},
+		{2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y)},
+	}, units.Dimension{})
+}
+
+// AxisAngle returns the dimensionless unit rotation axis and the rotation
+// angle in [0, π]. For the null rotation the axis is x̂.
+func (q Quaternion) AxisAngle() (vector.Vector3, units.Angle) {
+	if q.W < 0 {
+		q = Quaternion{W: -q.W, X: -q.X, Y: -q.Y, Z: -q.Z}
+	}
+	s := math.Sqrt(q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	angle := 2 * math.Atan2(s, q.W)
+	if s == 0 {
+		return vector.UnitX(units.Dimension{}), units.Radian(0)
+	}
+	return vector.Vector3{
+		X: units.Dimensionless(q.X / s),
+		Y: units.Dimensionless(q.Y / s),
+		Z: units.Dimensionless(q.Z / s),
+	}, units.Radian(angle)
+}
+
+// Euler returns the z-y′-x″ yaw, pitch and roll angles of the rotation,
+// with pitch in [-π/2, π/2]. At pitch ±π/2 (gimbal lock) roll is set to
+// zero and yaw absorbs the combined rotation.
+func (q Quaternion) Euler() (yaw, pitch, roll units.Angle) {
+	w, x, y, z := q.W, q.X, q.Y, q.Z
+	sp := 2 * (w*y - x*z)
+	if math.Abs(sp) >= 1-1e-12 {
+		return units.Radian(-2 * math.Copysign(1, sp) * math.Atan2(x, w)), units.Radian(math.Copysign(math.Pi/2, sp)), units.Radian(0)
+	}
+	return units.Radian(math.Atan2(2*(w*z+x*y), 1-2*(y*y+z*z))),
+		units.Radian(math.Asin(sp)),
+		units.Radian(math.Atan2(2*(w*x+y*z), 1-2*(x*x+y*y)))
+}
+
+// -----------------------------------------------------------------------------
+// Interpolation and Kinematics
+// -----------------------------------------------------------------------------
+
+// Slerp returns the spherical linear interpolation from q0 (t = 0) to q1
+// (t = 1) along the shorter arc, at constant angular rate.
+//
+// Formula:
+//
+//	slerp(q₀, q₁; t) = [sin((1-t)Ω) q₀ + sin(tΩ) q₁] / sin Ω,   cos Ω = q₀·q₁
+func Slerp(q0, q1 Quaternion, t float64) Quaternion {
+	d := q0.Dot(q1)
+	if d < 0 {
+		q1, d = Quaternion{W: -q1.W, X: -q1.X, Y: -q1.Y, Z: -q1.Z}, -d
+	}
+	var a, b float64
+	if d > 1-1e-10 {
+		// Nearly identical: linear interpolation avoids 0/0.
+		a, b = 1-t, t
+	} else {
+		omega := math.Acos(d)
+		s := math.Sin(omega)
+		a, b = math.Sin((1-t)*omega)/s, math.Sin(t*omega)/s
+	}
+	return Quaternion{
+		W: a*q0.W + b*q1.W,
+		X: a*q0.X + b*q1.X,
+		Y: a*q0.Y + b*q1.Y,
+		Z: a*q0.Z + b*q1.Z,
+	}.Normalize()
+}
+
+// Integrate returns the attitude after rotating for dt at constant
+// body-frame angular velocity ω. The update is exact for constant ω.
+//
+// Formula:
+//
+//	q(t + dt) = q(t) · exp(½ ω dt)
+//
+// Returns an error if ω does not have dimension [T⁻¹].
+func (q Quaternion) Integrate(omega vector.Vector3, dt units.Time) (Quaternion, error) {
+	if omega.Dim() != (units.Dimension{T: -1}) {
+		return Quaternion{}, fmt.Errorf("angular velocity must have dimension [T⁻¹], got %s", omega.Dim())
+	}
+	w := omega.ToArray()
+	rate := math.Sqrt(w[0]*w[0] + w[1]*w[1] + w[2]*w[2])
+	if rate == 0 {
+		return q, nil
+	}
+	s, c := math.Sincos(rate * dt.Val() / 2)
+	k := s / rate
+	step := Quaternion{W: c, X: w[0] * k, Y: w[1] * k, Z: w[2] * k}
+	return q.Mul(step).Normalize(), nil
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
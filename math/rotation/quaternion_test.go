@@ -0,0 +1,173 @@
+package rotation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/matrix"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func axis(x, y, z float64) vector.Vector3 {
+	return vector.Vector3{X: units.Dimensionless(x), Y: units.Dimensionless(y), Z: units.Dimensionless(z)}
+}
+
+func vecEqual(a, b vector.Vector3, tolerance float64) bool {
+	x, y := a.ToArray(), b.ToArray()
+	for i := range x {
+		if !almostEqual(x[i], y[i], tolerance) {
+			return false
+		}
+	}
+	return a.Dim() == b.Dim()
+}
+
+func sameRotation(q, r Quaternion, tolerance float64) bool {
+	return almostEqual(math.Abs(q.Dot(r)), 1, tolerance)
+}
+
+func TestAxisAngle(t *testing.T) {
+	q, err := FromAxisAngle(axis(0, 0, 2), units.Degree(90))
+	if err != nil {
+		t.Fatalf("FromAxisAngle() error = %v", err)
+	}
+	v := vector.NewVelocity(units.MeterPerSecond(3), units.MeterPerSecond(0), units.MeterPerSecond(0))
+	want := vector.NewVelocity(units.MeterPerSecond(0), units.MeterPerSecond(3), units.MeterPerSecond(0))
+	if got := q.Rotate(v); !vecEqual(got, want, 1e-15) {
+		t.Errorf("Rotate() = %v, want %v", got, want)
+	}
+
+	n, angle := q.AxisAngle()
+	if !vecEqual(n, axis(0, 0, 1), 1e-15) || !almostEqual(angle.ToDegrees(), 90, 1e-12) {
+		t.Errorf("AxisAngle() = %v, %v°", n, angle.ToDegrees())
+	}
+	if _, a := Identity().AxisAngle(); a.Val() != 0 {
+		t.Errorf("identity angle = %v, want 0", a)
+	}
+	if _, err := FromAxisAngle(vector.Zero(units.Dimension{}), units.Degree(10)); err == nil {
+		t.Error("FromAxisAngle() should reject a zero axis")
+	}
+}
+
+func TestComposition(t *testing.T) {
+	qx, _ := FromAxisAngle(axis(1, 0, 0), units.Degree(90))
+	qz, _ := FromAxisAngle(axis(0, 0, 1), units.Degree(90))
+	// x-rotation first, then z: ŷ → ẑ → ẑ.
+	p := vector.NewPosition(units.Meter(0), units.Meter(1), units.Meter(0))
+	got := qz.Mul(qx).Rotate(p)
+	if want := qz.Rotate(qx.Rotate(p)); !vecEqual(got, want, 1e-15) || !almostEqual(got.Z.Val(), 1, 1e-15) {
+		t.Errorf("(qz·qx)·ŷ = %v, want ẑ", got)
+	}
+	if back := qx.Conjugate().Rotate(qx.Rotate(p)); !vecEqual(back, p, 1e-15) {
+		t.Errorf("q*·q·v = %v, want %v", back, p)
+	}
+	// 120° about (1,1,1) cycles the axes.
+	q, _ := FromAxisAngle(axis(1, 1, 1), units.Degree(120))
+	if got := q.Rotate(axis(1, 0, 0)); !vecEqual(got, axis(0, 1, 0), 1e-15) {
+		t.Errorf("cyclic rotation x̂ -> %v, want ŷ", got)
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	q := FromEuler(units.Degree(30), units.Degree(-20), units.Degree(75))
+	m := q.Matrix()
+	v := vector.NewPosition(units.Meter(1), units.Meter(-2), units.Meter(0.5))
+	if got := m.MulVector(v); !vecEqual(got, q.Rotate(v), 1e-14) {
+		t.Errorf("Matrix()·v = %v, want %v", got, q.Rotate(v))
+	}
+	if !almostEqual(m.Determinant().Val(), 1, 1e-14) {
+		t.Errorf("det R = %v, want 1", m.Determinant().Val())
+	}
+	// Round trip through every branch of Shepperd's method.
+	for _, deg := range []float64{0, 100, 179, 180} {
+		for _, n := range []vector.Vector3{axis(1, 0, 0), axis(0, 1, 0), axis(0, 0, 1), axis(1, -2, 3)} {
+			q, _ := FromAxisAngle(n, units.Degree(deg))
+			back, err := FromMatrix(q.Matrix())
+			if err != nil || !sameRotation(back, q, 1e-12) {
+				t.Errorf("FromMatrix(%v°, %v) = %+v, %v; want %+v", deg, n, back, err, q)
+			}
+		}
+	}
+	if _, err := FromMatrix(matrix.Diagonal(1, 1, -1, units.Dimension{})); err == nil {
+		t.Error("FromMatrix() should reject a reflection")
+	}
+	if _, err := FromMatrix(matrix.Diagonal(1, 2, 1, units.Dimension{})); err == nil {
+		t.Error("FromMatrix() should reject a non-orthogonal matrix")
+	}
+	if _, err := FromMatrix(matrix.Diagonal(1, 1, 1, units.Dimension{L: 1})); err == nil {
+		t.Error("FromMatrix() should reject a dimensioned matrix")
+	}
+}
+
+func TestEuler(t *testing.T) {
+	yaw, pitch, roll := units.Degree(30), units.Degree(-20), units.Degree(75)
+	q := FromEuler(yaw, pitch, roll)
+	qz, _ := FromAxisAngle(axis(0, 0, 1), yaw)
+	qy, _ := FromAxisAngle(axis(0, 1, 0), pitch)
+	qx, _ := FromAxisAngle(axis(1, 0, 0), roll)
+	if want := qz.Mul(qy).Mul(qx); !sameRotation(q, want, 1e-14) {
+		t.Errorf("FromEuler() = %+v, want %+v", q, want)
+	}
+	y, p, r := q.Euler()
+	if !almostEqual(y.ToDegrees(), 30, 1e-12) || !almostEqual(p.ToDegrees(), -20, 1e-12) || !almostEqual(r.ToDegrees(), 75, 1e-12) {
+		t.Errorf("Euler() = %v°, %v°, %v°", y.ToDegrees(), p.ToDegrees(), r.ToDegrees())
+	}
+	// Gimbal lock keeps the rotation even though the angles are not unique.
+	for _, pd := range []float64{90, -90} {
+		lock := FromEuler(units.Degree(30), units.Degree(pd), units.Degree(0))
+		y, p, r := lock.Euler()
+		if !sameRotation(FromEuler(y, p, r), lock, 1e-12) {
+			t.Errorf("gimbal lock at %v°: Euler() = %v, %v, %v", pd, y, p, r)
+		}
+	}
+}
+
+func TestSlerp(t *testing.T) {
+	q, _ := FromAxisAngle(axis(0, 0, 1), units.Degree(90))
+	mid := Slerp(Identity(), q, 0.5)
+	want, _ := FromAxisAngle(axis(0, 0, 1), units.Degree(45))
+	if !sameRotation(mid, want, 1e-14) {
+		t.Errorf("Slerp(½) = %+v, want 45°", mid)
+	}
+	if !sameRotation(Slerp(Identity(), q, 0), Identity(), 1e-15) || !sameRotation(Slerp(Identity(), q, 1), q, 1e-15) {
+		t.Error("Slerp endpoints mismatch")
+	}
+	// -q is the same rotation: interpolation takes the short way.
+	neg := Quaternion{W: -q.W, X: -q.X, Y: -q.Y, Z: -q.Z}
+	if _, a := Slerp(Identity(), neg, 0.5).AxisAngle(); !almostEqual(a.ToDegrees(), 45, 1e-12) {
+		t.Errorf("Slerp to -q angle = %v°, want 45°", a.ToDegrees())
+	}
+	if s := Slerp(q, q, 0.3); !sameRotation(s, q, 1e-15) {
+		t.Errorf("Slerp(q, q) = %+v", s)
+	}
+}
+
+func TestIntegrate(t *testing.T) {
+	// Spin at 1 rad/s about body z for π/2 s.
+	omega := vector.Vector3{X: units.RadianPerSecond(0).Value, Y: units.RadianPerSecond(0).Value, Z: units.RadianPerSecond(1).Value}
+	q, err := Identity().Integrate(omega, units.Second(math.Pi/2))
+	if err != nil {
+		t.Fatalf("Integrate() error = %v", err)
+	}
+	want, _ := FromAxisAngle(axis(0, 0, 1), units.Degree(90))
+	if !sameRotation(q, want, 1e-15) {
+		t.Errorf("Integrate() = %+v, want %+v", q, want)
+	}
+	// Body-frame rates: after pitching up 90°, a body-z spin is about the
+	// reference x axis.
+	pitched := FromEuler(units.Degree(0), units.Degree(90), units.Degree(0))
+	q, _ = pitched.Integrate(omega, units.Second(0.1))
+	dq := q.Mul(pitched.Conjugate())
+	n, _ := dq.AxisAngle()
+	if !almostEqual(math.Abs(n.X.Val()), 1, 1e-12) {
+		t.Errorf("reference-frame spin axis = %v, want ±x̂", n)
+	}
+	if _, err := Identity().Integrate(axis(0, 0, 1), units.Second(1)); err == nil {
+		t.Error("Integrate() should reject a dimensionless rate")
+	}
+}
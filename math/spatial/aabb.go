@@ -0,0 +1,161 @@
+package spatial
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// AABB is an axis-aligned bounding box in position space, stored as its
+// minimum and maximum corners in meters. A box with Min = Max is a single
+// point.
+type AABB struct {
+	min, max [3]float64
+}
+
+// NewAABB returns the smallest box containing both corners a and b, in
+// any order. Both must be position vectors.
+func NewAABB(a, b vector.Vector3) (AABB, error) {
+	return BoundingBox(a, b)
+}
+
+// BoundingBox returns the smallest box containing all the points.
+// At least one point is required and all must be position vectors.
+func BoundingBox(points ...vector.Vector3) (AABB, error) {
+	if len(points) == 0 {
+		return AABB{}, fmt.Errorf("bounding box requires at least one point")
+	}
+	var box AABB
+	for i, p := range points {
+		x, err := position(p)
+		if err != nil {
+			return AABB{}, err
+		}
+		if i == 0 {
+			box.min, box.max = x, x
+			continue
+		}
+		for k := 0; k < 3; k++ {
+			box.min[k] = math.Min(box.min[k], x[k])
+			box.max[k] = math.Max(box.max[k], x[k])
+		}
+	}
+	return box, nil
+}
+
+// Min returns the corner with the smallest coordinates.
+func (b AABB) Min() vector.Vector3 {
+	return toVector(b.min)
+}
+
+// Max returns the corner with the largest coordinates.
+func (b AABB) Max() vector.Vector3 {
+	return toVector(b.max)
+}
+
+// Center returns the midpoint of the box.
+func (b AABB) Center() vector.Vector3 {
+	var c [3]float64
+	for k := 0; k < 3; k++ {
+		c[k] = (b.min[k] + b.max[k]) / 2
+	}
+	return toVector(c)
+}
+
+// Size returns the edge lengths of the box as a position vector.
+func (b AABB) Size() vector.Vector3 {
+	var s [3]float64
+	for k := 0; k < 3; k++ {
+		s[k] = b.max[k] - b.min[k]
+	}
+	return toVector(s)
+}
+
+// Volume returns the volume enclosed by the box.
+func (b AABB) Volume() units.Volume {
+	return units.CubicMeter((b.max[0] - b.min[0]) * (b.max[1] - b.min[1]) * (b.max[2] - b.min[2]))
+}
+
+// Contains reports whether p lies inside the box or on its boundary.
+// Non-position vectors are never contained.
+func (b AABB) Contains(p vector.Vector3) bool {
+	x, err := position(p)
+	if err != nil {
+		return false
+	}
+	for k := 0; k < 3; k++ {
+		if x[k] < b.min[k] || x[k] > b.max[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersects reports whether two boxes overlap. Boxes that only touch on
+// a face, edge or corner intersect.
+func (b AABB) Intersects(other AABB) bool {
+	for k := 0; k < 3; k++ {
+		if b.max[k] < other.min[k] || other.max[k] < b.min[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns the smallest box containing both boxes.
+func (b AABB) Union(other AABB) AABB {
+	for k := 0; k < 3; k++ {
+		b.min[k] = math.Min(b.min[k], other.min[k])
+		b.max[k] = math.Max(b.max[k], other.max[k])
+	}
+	return b
+}
+
+// Intersection returns the overlap of two boxes. The second result is
+// false, and the box is meaningless, if they do not intersect.
+func (b AABB) Intersection(other AABB) (AABB, bool) {
+	if !b.Intersects(other) {
+		return AABB{}, false
+	}
+	for k := 0; k < 3; k++ {
+		b.min[k] = math.Max(b.min[k], other.min[k])
+		b.max[k] = math.Min(b.max[k], other.max[k])
+	}
+	return b, true
+}
+
+// Expand returns the box grown by margin on every side. A negative margin
+// shrinks the box; each extent stops shrinking at its center.
+func (b AABB) Expand(margin units.Length) AABB {
+	d := margin.Val()
+	for k := 0; k < 3; k++ {
+		lo, hi := b.min[k]-d, b.max[k]+d
+		if lo > hi {
+			lo = (b.min[k] + b.max[k]) / 2
+			hi = lo
+		}
+		b.min[k], b.max[k] = lo, hi
+	}
+	return b
+}
+
+// String returns a human-readable representation of the box.
+func (b AABB) String() string {
+	return fmt.Sprintf("[%v, %v]", b.Min(), b.Max())
+}
+
+// position returns the components of p in meters, or an error if p is not
+// a position vector.
+func position(p vector.Vector3) ([3]float64, error) {
+	if p.Dim() != (units.Dimension{L: 1}) {
+		return [3]float64{}, fmt.Errorf("expected a position vector, got dimension %s", p.Dim())
+	}
+	return p.ToArray(), nil
+}
+
+// toVector returns a position vector with the given components in meters.
+func toVector(x [3]float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x[0]), units.Meter(x[1]), units.Meter(x[2]))
+}
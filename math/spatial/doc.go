@@ -0,0 +1,35 @@
+// Package spatial provides axis-aligned bounding boxes and a uniform
+// spatial-hash grid over position vectors, for broad-phase collision tests
+// and short-range neighbor queries in particle simulations.
+//
+// A Grid buckets points into cubic cells of a fixed size. A query for all
+// points within radius r of p only visits the cells overlapping the ball,
+// so with a cell size close to the interaction cutoff the cost of finding
+// all interacting pairs grows linearly with the number of particles rather
+// than quadratically.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/math/spatial"
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Broad phase: do two bodies' boxes overlap?
+//	a, _ := spatial.BoundingBox(bodyA...)
+//	b, _ := spatial.BoundingBox(bodyB...)
+//	overlap := a.Intersects(b)
+//
+//	// Neighbor search with a 1 nm cutoff
+//	cutoff := units.Nanometer(1)
+//	grid, _ := spatial.NewGrid(cutoff)
+//	for i, r := range positions {
+//	    grid.Insert(i, r)
+//	}
+//	pairs, _ := grid.Pairs(cutoff) // each interacting pair once
+//
+// References:
+//   - Ericson. "Real-Time Collision Detection", Ch. 4 and 7
+//   - Allen, Tildesley. "Computer Simulation of Liquids", 2nd ed., Sec. 5.3
+package spatial
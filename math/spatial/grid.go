@@ -0,0 +1,143 @@
+package spatial
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Grid is a uniform spatial hash: points are bucketed by the cubic cell of
+// edge CellSize containing them. Cells are allocated on demand, so the
+// grid is unbounded and memory scales with the number of occupied cells.
+//
+// A Grid is not safe for concurrent modification.
+type Grid struct {
+	cell    float64
+	cells   map[cellKey][]int // indices into entries
+	entries []entry
+}
+
+type cellKey [3]int64
+
+type entry struct {
+	id  int
+	pos [3]float64
+}
+
+// NewGrid returns an empty grid with the given cell size. For neighbor
+// queries with a fixed cutoff, a cell size equal to the cutoff is a good
+// default.
+func NewGrid(cellSize units.Length) (*Grid, error) {
+	if !(cellSize.Val() > 0) {
+		return nil, fmt.Errorf("cell size must be positive, got %g m", cellSize.Val())
+	}
+	return &Grid{cell: cellSize.Val(), cells: make(map[cellKey][]int)}, nil
+}
+
+// CellSize returns the cell edge length.
+func (g *Grid) CellSize() units.Length {
+	return units.Meter(g.cell)
+}
+
+// Len returns the number of inserted points.
+func (g *Grid) Len() int {
+	return len(g.entries)
+}
+
+// Insert adds a point with a caller-chosen id, typically its index in the
+// particle array. Ids need not be unique or contiguous.
+func (g *Grid) Insert(id int, p vector.Vector3) error {
+	x, err := position(p)
+	if err != nil {
+		return err
+	}
+	k := g.key(x)
+	g.cells[k] = append(g.cells[k], len(g.entries))
+	g.entries = append(g.entries, entry{id: id, pos: x})
+	return nil
+}
+
+// Clear removes all points, keeping the cell size. Call it before
+// re-inserting positions at each time step.
+func (g *Grid) Clear() {
+	g.cells = make(map[cellKey][]int)
+	g.entries = g.entries[:0]
+}
+
+// Neighbors returns the ids of all points within radius of p (inclusive),
+// in insertion order.
+func (g *Grid) Neighbors(p vector.Vector3, radius units.Length) ([]int, error) {
+	x, err := position(p)
+	if err != nil {
+		return nil, err
+	}
+	if radius.Val() < 0 {
+		return nil, fmt.Errorf("radius must be non-negative, got %g m", radius.Val())
+	}
+	var found []int
+	g.visit(x, radius.Val(), func(i int) {
+		found = append(found, i)
+	})
+	sort.Ints(found)
+	ids := make([]int, len(found))
+	for n, i := range found {
+		ids[n] = g.entries[i].id
+	}
+	return ids, nil
+}
+
+// Pairs returns every pair of points separated by at most radius, each
+// pair once with the earlier-inserted point first. Pairs are ordered by
+// insertion order of the first point, then of the second.
+func (g *Grid) Pairs(radius units.Length) ([][2]int, error) {
+	if radius.Val() < 0 {
+		return nil, fmt.Errorf("radius must be non-negative, got %g m", radius.Val())
+	}
+	var pairs [][2]int
+	var near []int
+	for i, e := range g.entries {
+		near = near[:0]
+		g.visit(e.pos, radius.Val(), func(j int) {
+			if j > i {
+				near = append(near, j)
+			}
+		})
+		sort.Ints(near)
+		for _, j := range near {
+			pairs = append(pairs, [2]int{e.id, g.entries[j].id})
+		}
+	}
+	return pairs, nil
+}
+
+// visit calls fn with the index of every entry within r of x.
+func (g *Grid) visit(x [3]float64, r float64, fn func(i int)) {
+	lo := g.key([3]float64{x[0] - r, x[1] - r, x[2] - r})
+	hi := g.key([3]float64{x[0] + r, x[1] + r, x[2] + r})
+	r2 := r * r
+	for a := lo[0]; a <= hi[0]; a++ {
+		for b := lo[1]; b <= hi[1]; b++ {
+			for c := lo[2]; c <= hi[2]; c++ {
+				for _, i := range g.cells[cellKey{a, b, c}] {
+					y := g.entries[i].pos
+					dx, dy, dz := y[0]-x[0], y[1]-x[1], y[2]-x[2]
+					if dx*dx+dy*dy+dz*dz <= r2 {
+						fn(i)
+					}
+				}
+			}
+		}
+	}
+}
+
+// key returns the cell containing x.
+func (g *Grid) key(x [3]float64) cellKey {
+	return cellKey{
+		int64(math.Floor(x[0] / g.cell)),
+		int64(math.Floor(x[1] / g.cell)),
+		int64(math.Floor(x[2] / g.cell)),
+	}
+}
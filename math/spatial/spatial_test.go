@@ -0,0 +1,156 @@
+package spatial
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func pos(x, y, z float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x), units.Meter(y), units.Meter(z))
+}
+
+// -----------------------------------------------------------------------------
+// AABB Tests
+// -----------------------------------------------------------------------------
+
+func TestBoundingBox(t *testing.T) {
+	b, err := BoundingBox(pos(1, 5, -2), pos(3, 0, 4), pos(2, 2, 2))
+	if err != nil {
+		t.Fatalf("BoundingBox() error = %v", err)
+	}
+	if b.Min().ToArray() != [3]float64{1, 0, -2} || b.Max().ToArray() != [3]float64{3, 5, 4} {
+		t.Errorf("BoundingBox() = %v, want [(1, 0, -2), (3, 5, 4)]", b)
+	}
+	if b.Center().ToArray() != [3]float64{2, 2.5, 1} {
+		t.Errorf("Center() = %v, want (2, 2.5, 1)", b.Center())
+	}
+	if v := b.Volume(); v.Val() != 60 {
+		t.Errorf("Volume() = %v, want 60 m³", v)
+	}
+	if !b.Contains(pos(3, 5, 4)) || b.Contains(pos(0, 1, 1)) {
+		t.Error("Contains() should include the boundary and exclude outside points")
+	}
+
+	if _, err := BoundingBox(); err == nil {
+		t.Error("BoundingBox() should fail with no points")
+	}
+	if _, err := NewAABB(pos(0, 0, 0), vector.Zero(units.Dimension{T: 1})); err == nil {
+		t.Error("NewAABB() should fail for non-position vectors")
+	}
+}
+
+func TestAABBSetOperations(t *testing.T) {
+	a, _ := NewAABB(pos(0, 0, 0), pos(2, 2, 2))
+	b, _ := NewAABB(pos(3, 1, 1), pos(1, 3, 3))
+	c, _ := NewAABB(pos(5, 5, 5), pos(6, 6, 6))
+
+	if !a.Intersects(b) || a.Intersects(c) {
+		t.Error("Intersects() wrong")
+	}
+	i, ok := a.Intersection(b)
+	if !ok || i.Min().ToArray() != [3]float64{1, 1, 1} || i.Max().ToArray() != [3]float64{2, 2, 2} {
+		t.Errorf("Intersection() = %v, %v, want [(1, 1, 1), (2, 2, 2)]", i, ok)
+	}
+	if _, ok := a.Intersection(c); ok {
+		t.Error("Intersection() of disjoint boxes should report false")
+	}
+	u := a.Union(c)
+	if u.Min().ToArray() != [3]float64{0, 0, 0} || u.Max().ToArray() != [3]float64{6, 6, 6} {
+		t.Errorf("Union() = %v", u)
+	}
+	// Touching boxes intersect.
+	d, _ := NewAABB(pos(2, 0, 0), pos(4, 1, 1))
+	if !a.Intersects(d) {
+		t.Error("Intersects() should be true for touching boxes")
+	}
+	if e := a.Expand(units.Meter(1)); e.Size().ToArray() != [3]float64{4, 4, 4} {
+		t.Errorf("Expand(1) size = %v, want (4, 4, 4)", e.Size())
+	}
+	if e := a.Expand(units.Meter(-5)); e.Center().ToArray() != a.Center().ToArray() || e.Volume().Val() != 0 {
+		t.Errorf("Expand(-5) = %v, want the center point", e)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Grid Tests
+// -----------------------------------------------------------------------------
+
+func TestGridNeighbors(t *testing.T) {
+	g, err := NewGrid(units.Meter(1))
+	if err != nil {
+		t.Fatalf("NewGrid() error = %v", err)
+	}
+	g.Insert(10, pos(0, 0, 0))
+	g.Insert(11, pos(0.9, 0, 0))
+	g.Insert(12, pos(-0.5, -0.5, 0))
+	g.Insert(13, pos(3, 3, 3))
+
+	ids, err := g.Neighbors(pos(0, 0, 0), units.Meter(1))
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	if len(ids) != 3 || ids[0] != 10 || ids[1] != 11 || ids[2] != 12 {
+		t.Errorf("Neighbors() = %v, want [10 11 12]", ids)
+	}
+	// A radius larger than a cell reaches further cells.
+	if ids, _ := g.Neighbors(pos(0, 0, 0), units.Meter(6)); len(ids) != 4 {
+		t.Errorf("Neighbors(6 m) = %v, want all 4", ids)
+	}
+
+	if _, err := NewGrid(units.Meter(0)); err == nil {
+		t.Error("NewGrid() should fail with zero cell size")
+	}
+	if err := g.Insert(0, vector.Zero(units.Dimension{})); err == nil {
+		t.Error("Insert() should fail for non-position vectors")
+	}
+	g.Clear()
+	if g.Len() != 0 {
+		t.Errorf("Len() after Clear() = %d, want 0", g.Len())
+	}
+}
+
+func TestGridPairsMatchBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	cutoff := units.Meter(0.15)
+	g, _ := NewGrid(cutoff)
+	points := make([]vector.Vector3, 300)
+	for i := range points {
+		points[i] = pos(rng.Float64(), rng.Float64(), rng.Float64()-0.5)
+		g.Insert(i, points[i])
+	}
+	pairs, err := g.Pairs(cutoff)
+	if err != nil {
+		t.Fatalf("Pairs() error = %v", err)
+	}
+
+	want := 0
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			if d, _ := points[i].DistanceTo(points[j]); d.Val() <= cutoff.Val() {
+				want++
+			}
+		}
+	}
+	if len(pairs) != want {
+		t.Errorf("Pairs() found %d pairs, brute force %d", len(pairs), want)
+	}
+	for _, p := range pairs {
+		if p[0] >= p[1] {
+			t.Fatalf("pair %v not ordered by insertion", p)
+		}
+		if d, _ := points[p[0]].DistanceTo(points[p[1]]); !(d.Val() <= cutoff.Val()) {
+			t.Fatalf("pair %v at distance %v exceeds cutoff", p, d)
+		}
+	}
+	if almostEqual(float64(want), 0, 0.5) {
+		t.Error("test configuration produced no pairs")
+	}
+}
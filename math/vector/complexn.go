@@ -0,0 +1,227 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strings"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// ComplexN is a vector of complex components sharing a single physical
+// dimension, such as quantum amplitudes (dimensionless) or the Jones
+// vector of an optical field (V/m). Components are stored in SI base units.
+//
+// The inner product is antilinear in its first argument, ⟨u|w⟩ = Σ uᵢ* wᵢ,
+// following the physics convention.
+type ComplexN struct {
+	v   []complex128
+	dim units.Dimension
+}
+
+// NewComplexN creates a ComplexN from component values (in SI base units)
+// and a shared dimension. The slice is copied.
+//
+// Example:
+//
+//	// |+⟩ = (|0⟩ + |1⟩)/√2
+//	plus := vector.NewComplexN([]complex128{1 / math.Sqrt2, 1 / math.Sqrt2}, units.Dimension{})
+func NewComplexN(values []complex128, dim units.Dimension) ComplexN {
+	return ComplexN{v: append([]complex128(nil), values...), dim: dim}
+}
+
+// Len returns the number of components.
+func (u ComplexN) Len() int {
+	return len(u.v)
+}
+
+// At returns component i (in SI base units).
+// Panics if i is out of range.
+func (u ComplexN) At(i int) complex128 {
+	return u.v[i]
+}
+
+// Dim returns the dimension shared by all components.
+func (u ComplexN) Dim() units.Dimension {
+	return u.dim
+}
+
+// ToSlice returns a copy of the component values (in SI base units).
+func (u ComplexN) ToSlice() []complex128 {
+	return append([]complex128(nil), u.v...)
+}
+
+// String returns a human-readable representation of the vector.
+func (u ComplexN) String() string {
+	parts := make([]string, len(u.v))
+	for i, z := range u.v {
+		parts[i] = fmt.Sprintf("%.4g", z)
+	}
+	return "(" + strings.Join(parts, ", ") + ") " + u.dim.String()
+}
+
+// Add returns u + w. Vectors must have the same length and dimension.
+func (u ComplexN) Add(w ComplexN) (ComplexN, error) {
+	if err := u.check(w, "add"); err != nil {
+		return ComplexN{}, err
+	}
+	out := make([]complex128, len(u.v))
+	for i := range u.v {
+		out[i] = u.v[i] + w.v[i]
+	}
+	return ComplexN{v: out, dim: u.dim}, nil
+}
+
+// Subtract returns u - w. Vectors must have the same length and dimension.
+func (u ComplexN) Subtract(w ComplexN) (ComplexN, error) {
+	return u.Add(w.Scale(-1))
+}
+
+// Scale multiplies the vector by a dimensionless complex scalar, such as a
+// global phase.
+func (u ComplexN) Scale(c complex128) ComplexN {
+	out := make([]complex128, len(u.v))
+	for i, z := range u.v {
+		out[i] = c * z
+	}
+	return ComplexN{v: out, dim: u.dim}
+}
+
+// Conjugate returns the component-wise complex conjugate.
+func (u ComplexN) Conjugate() ComplexN {
+	out := make([]complex128, len(u.v))
+	for i, z := range u.v {
+		out[i] = cmplx.Conj(z)
+	}
+	return ComplexN{v: out, dim: u.dim}
+}
+
+// Inner returns the inner product ⟨u|w⟩ = Σ uᵢ* wᵢ in SI base units of the
+// product dimension, which InnerDim reports. Vectors must have the same
+// length.
+//
+// Example:
+//
+//	// Transition amplitude between two states
+//	amp, _ := phi.Inner(psi)
+//	p := real(amp)*real(amp) + imag(amp)*imag(amp)
+func (u ComplexN) Inner(w ComplexN) (complex128, error) {
+	if len(u.v) != len(w.v) {
+		return 0, fmt.Errorf("vector lengths differ: %d and %d", len(u.v), len(w.v))
+	}
+	var sum complex128
+	for i := range u.v {
+		sum += cmplx.Conj(u.v[i]) * w.v[i]
+	}
+	return sum, nil
+}
+
+// InnerDim returns the dimension of ⟨u|w⟩, the product of the two vector
+// dimensions.
+func (u ComplexN) InnerDim(w ComplexN) units.Dimension {
+	return units.NewValue(1, u.dim).Multiply(units.NewValue(1, w.dim)).Dim()
+}
+
+// Norm returns the Euclidean norm √⟨u|u⟩, in the vector's dimension.
+func (u ComplexN) Norm() units.Value {
+	sum := 0.0
+	for _, z := range u.v {
+		sum = math.Hypot(sum, cmplx.Abs(z))
+	}
+	return units.NewValue(sum, u.dim)
+}
+
+// Normalize returns the dimensionless unit vector u/‖u‖.
+func (u ComplexN) Normalize() (ComplexN, error) {
+	n := u.Norm().Val()
+	if n == 0 {
+		return ComplexN{}, fmt.Errorf("cannot normalize zero vector")
+	}
+	return ComplexN{v: u.Scale(complex(1/n, 0)).v}, nil
+}
+
+// Kron returns the tensor product u ⊗ w, with component i·len(w) + k equal
+// to uᵢwₖ and the product dimension. As in the quantum package, w spans
+// the low-order index.
+//
+// Example:
+//
+//	// |0⟩ ⊗ |+⟩ on two qubits
+//	psi := zero.Kron(plus)
+func (u ComplexN) Kron(w ComplexN) ComplexN {
+	out := make([]complex128, len(u.v)*len(w.v))
+	for i, a := range u.v {
+		for k, b := range w.v {
+			out[i*len(w.v)+k] = a * b
+		}
+	}
+	return ComplexN{v: out, dim: u.InnerDim(w)}
+}
+
+// Apply returns the matrix-vector product M u for a dimensionless square
+// matrix given by its rows. Returns an error if M is not square with the
+// vector's length.
+func (u ComplexN) Apply(rows [][]complex128) (ComplexN, error) {
+	n := len(u.v)
+	if len(rows) != n {
+		return ComplexN{}, fmt.Errorf("matrix has %d rows, want %d", len(rows), n)
+	}
+	out := make([]complex128, n)
+	for i, row := range rows {
+		if len(row) != n {
+			return ComplexN{}, fmt.Errorf("matrix row %d has %d columns, want %d", i, len(row), n)
+		}
+		for j, m := range row {
+			out[i] += m * u.v[j]
+		}
+	}
+	return ComplexN{v: out, dim: u.dim}, nil
+}
+
+// ApplyUnitary returns U u after checking that U†U = I to within tolerance
+// element-wise. A unitary map preserves the norm, so amplitudes stay
+// normalized.
+func (u ComplexN) ApplyUnitary(rows [][]complex128, tolerance float64) (ComplexN, error) {
+	if !IsUnitary(rows, tolerance) {
+		return ComplexN{}, fmt.Errorf("matrix is not unitary")
+	}
+	return u.Apply(rows)
+}
+
+// IsUnitary reports whether the square matrix given by its rows satisfies
+// U†U = I to within tolerance element-wise.
+func IsUnitary(rows [][]complex128, tolerance float64) bool {
+	n := len(rows)
+	for _, row := range rows {
+		if len(row) != n {
+			return false
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			var sum complex128
+			for k := 0; k < n; k++ {
+				sum += cmplx.Conj(rows[k][i]) * rows[k][j]
+			}
+			if i == j {
+				sum--
+			}
+			if cmplx.Abs(sum) > tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// check returns an error if w has a different length or dimension.
+func (u ComplexN) check(w ComplexN, op string) error {
+	if len(u.v) != len(w.v) {
+		return fmt.Errorf("cannot %s vectors of length %d and %d", op, len(u.v), len(w.v))
+	}
+	if u.dim != w.dim {
+		return fmt.Errorf("cannot %s vectors with dimensions %s and %s", op, u.dim, w.dim)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package vector
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestComplexNInner(t *testing.T) {
+	s := 1 / math.Sqrt2
+	plus := NewComplexN([]complex128{complex(s, 0), complex(s, 0)}, units.Dimension{})
+	iplus := NewComplexN([]complex128{complex(s, 0), complex(0, s)}, units.Dimension{})
+
+	amp, err := plus.Inner(iplus)
+	if err != nil {
+		t.Fatalf("Inner() error = %v", err)
+	}
+	if cmplx.Abs(amp-complex(0.5, 0.5)) > 1e-15 {
+		t.Errorf("⟨+|+i⟩ = %v, want 0.5+0.5i", amp)
+	}
+	// Antilinear in the first argument: ⟨w|u⟩ = ⟨u|w⟩*
+	if back, _ := iplus.Inner(plus); cmplx.Abs(back-cmplx.Conj(amp)) > 1e-15 {
+		t.Errorf("⟨+i|+⟩ = %v, want %v", back, cmplx.Conj(amp))
+	}
+	if n := iplus.Norm(); !almostEqual(n.Val(), 1, 1e-15) {
+		t.Errorf("Norm() = %v, want 1", n)
+	}
+	if _, err := plus.Inner(NewComplexN([]complex128{1}, units.Dimension{})); err == nil {
+		t.Error("Inner() should fail with mismatched lengths")
+	}
+
+	// Jones vector of an optical field carries V/m.
+	vPerM := units.Dimension{L: 1, M: 1, T: -3, I: -1}
+	e := NewComplexN([]complex128{3, 4i}, vPerM)
+	if n := e.Norm(); n.Val() != 5 || n.Dim() != vPerM {
+		t.Errorf("Norm() = %v, want 5 V/m", n)
+	}
+	if d := e.InnerDim(e); d != (units.Dimension{L: 2, M: 2, T: -6, I: -2}) {
+		t.Errorf("InnerDim() = %v, want (V/m)²", d)
+	}
+	u, _ := e.Normalize()
+	if n := u.Norm(); !almostEqual(n.Val(), 1, 1e-15) || n.Dim() != (units.Dimension{}) {
+		t.Errorf("Normalize() norm = %v, want dimensionless 1", n)
+	}
+	if _, err := e.Add(plus); err == nil {
+		t.Error("Add() should fail with mismatched dimensions")
+	}
+}
+
+func TestComplexNKronApply(t *testing.T) {
+	zero := NewComplexN([]complex128{1, 0}, units.Dimension{})
+	one := NewComplexN([]complex128{0, 1}, units.Dimension{})
+
+	// |0⟩ ⊗ |1⟩ = |01⟩, index 1
+	k := zero.Kron(one)
+	if k.Len() != 4 || k.At(1) != 1 || k.At(2) != 0 {
+		t.Errorf("|0⟩⊗|1⟩ = %v, want |01⟩", k)
+	}
+
+	s := complex(1/math.Sqrt2, 0)
+	hadamard := [][]complex128{{s, s}, {s, -s}}
+	plus, err := zero.ApplyUnitary(hadamard, 1e-12)
+	if err != nil {
+		t.Fatalf("ApplyUnitary() error = %v", err)
+	}
+	if cmplx.Abs(plus.At(0)-s) > 1e-15 || cmplx.Abs(plus.At(1)-s) > 1e-15 {
+		t.Errorf("H|0⟩ = %v, want |+⟩", plus)
+	}
+	if _, err := zero.ApplyUnitary([][]complex128{{1, 1}, {0, 1}}, 1e-12); err == nil {
+		t.Error("ApplyUnitary() should reject a non-unitary matrix")
+	}
+	if _, err := zero.Apply([][]complex128{{1}}); err == nil {
+		t.Error("Apply() should fail with wrong matrix size")
+	}
+	if !IsUnitary([][]complex128{{0, 1i}, {1i, 0}}, 1e-15) {
+		t.Error("IsUnitary(iX) = false, want true")
+	}
+}
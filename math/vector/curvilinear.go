@@ -0,0 +1,84 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Spherical and cylindrical coordinates follow the physics (ISO 80000-2)
+// convention: θ is the polar angle from +Z in [0, π], φ is the azimuth
+// from +X toward +Y in (-π, π].
+
+// FromSpherical creates a position vector from spherical coordinates
+// (r, θ, φ).
+//
+// Example:
+//
+//	// 1 m along +Y
+//	r := vector.FromSpherical(units.Meter(1), units.Degree(90), units.Degree(90))
+func FromSpherical(r units.Length, theta, phi units.Angle) Vector3 {
+	st, ct := math.Sincos(theta.Val())
+	sp, cp := math.Sincos(phi.Val())
+	return NewPosition(
+		units.Meter(r.Val()*st*cp),
+		units.Meter(r.Val()*st*sp),
+		units.Meter(r.Val()*ct),
+	)
+}
+
+// FromCylindrical creates a position vector from cylindrical coordinates
+// (ρ, φ, z).
+func FromCylindrical(rho units.Length, phi units.Angle, z units.Length) Vector3 {
+	sp, cp := math.Sincos(phi.Val())
+	return NewPosition(units.Meter(rho.Val()*cp), units.Meter(rho.Val()*sp), z)
+}
+
+// ToSpherical returns the spherical coordinates (r, θ, φ) of a position
+// vector. The zero vector maps to r = θ = φ = 0. Returns an error if the
+// vector does not have dimension of length.
+//
+// Example:
+//
+//	r, theta, phi, _ := position.ToSpherical()
+func (v Vector3) ToSpherical() (units.Length, units.Angle, units.Angle, error) {
+	if err := v.checkLength(); err != nil {
+		return units.Length{}, units.Angle{}, units.Angle{}, err
+	}
+	x, y, z := v.X.Val(), v.Y.Val(), v.Z.Val()
+	rho := math.Hypot(x, y)
+	return units.Meter(math.Hypot(rho, z)),
+		units.Radian(math.Atan2(rho, z)),
+		units.Radian(azimuth(y, x)),
+		nil
+}
+
+// ToCylindrical returns the cylindrical coordinates (ρ, φ, z) of a
+// position vector. Returns an error if the vector does not have dimension
+// of length.
+func (v Vector3) ToCylindrical() (units.Length, units.Angle, units.Length, error) {
+	if err := v.checkLength(); err != nil {
+		return units.Length{}, units.Angle{}, units.Length{}, err
+	}
+	x, y := v.X.Val(), v.Y.Val()
+	return units.Meter(math.Hypot(x, y)), units.Radian(azimuth(y, x)), units.Meter(v.Z.Val()), nil
+}
+
+// azimuth returns atan2(y, x) in (-π, π]. Atan2 gives -π for y = -0 and
+// x < 0; that direction is +π in the convention above.
+func azimuth(y, x float64) float64 {
+	phi := math.Atan2(y, x)
+	if phi == -math.Pi {
+		return math.Pi
+	}
+	return phi
+}
+
+// checkLength returns an error unless the vector has dimension of length.
+func (v Vector3) checkLength() error {
+	if v.Dim() != (units.Dimension{L: 1}) {
+		return fmt.Errorf("coordinate conversion requires a position vector, got dimension %s", v.Dim())
+	}
+	return nil
+}
@@ -1,7 +1,11 @@
-// Package vector provides unit-safe 3D vector operations for physics calculations.
+// Package vector provides unit-safe vector operations for physics calculations.
 //
 // Vectors can represent physical quantities like position, velocity, force, etc.,
-// with automatic dimensional tracking through the units package.
+// with automatic dimensional tracking through the units package. Vector3 is the
+// general spatial vector; Vector2 serves planar problems, and VectorN holds an
+// arbitrary number of same-dimension components such as generalized coordinates.
+// ComplexN is its complex counterpart, for quantum amplitudes and optical
+// field phasors.
 //
 // Example usage:
 //
@@ -25,6 +29,12 @@
 //	// Cross product: torque = r × F
 //	torque := r1.Cross(force) // Returns angular momentum dimension
 //
+//	// Planar motion: the 2D cross product is a scalar
+//	p := vector.NewPosition2(units.Meter(1), units.Meter(0))
+//	v := vector.NewVelocity2(units.MeterPerSecond(0), units.MeterPerSecond(2))
+//	h := p.Cross(v) // 2 m²/s
+//	v3 := v.ToVector3() // (0, 2, 0) m/s
+//
 // All vector operations preserve dimensional consistency and prevent
 // incompatible operations at compile time.
 package vector
@@ -0,0 +1,84 @@
+package vector
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Lerp returns the linear interpolation a + t(b - a). t = 0 gives a and
+// t = 1 gives b; values outside [0, 1] extrapolate. The vectors must have
+// the same dimension.
+//
+// Example:
+//
+//	// Position halfway between two samples of a trajectory
+//	mid, _ := vector.Lerp(r0, r1, 0.5)
+func Lerp(a, b Vector3, t float64) (Vector3, error) {
+	d, err := b.Subtract(a)
+	if err != nil {
+		return Vector3{}, err
+	}
+	return a.Add(d.Scale(t))
+}
+
+// Nlerp returns the normalized linear interpolation between two directions:
+// the dimensionless unit vector along Lerp(a, b, t). It is a cheap
+// approximation to spherical interpolation that follows the same path at
+// non-uniform angular speed. Returns an error if the interpolant is zero,
+// as for antiparallel inputs at the midpoint.
+func Nlerp(a, b Vector3, t float64) (Vector3, error) {
+	v, err := Lerp(a, b, t)
+	if err != nil {
+		return Vector3{}, err
+	}
+	return v.Normalize()
+}
+
+// ClampMagnitude returns the vector scaled down, if necessary, so that its
+// magnitude does not exceed max. max must have the vector's dimension and
+// be non-negative.
+//
+// Example:
+//
+//	// Velocity-limited controller
+//	v, _ = v.ClampMagnitude(units.MeterPerSecond(5).Value)
+func (v Vector3) ClampMagnitude(max units.Value) (Vector3, error) {
+	if max.Dim() != v.Dim() {
+		return Vector3{}, fmt.Errorf("cannot clamp vector with dimension %s to magnitude with dimension %s", v.Dim(), max.Dim())
+	}
+	if max.Val() < 0 {
+		return Vector3{}, fmt.Errorf("maximum magnitude must be non-negative, got %g", max.Val())
+	}
+	mag, err := v.Magnitude()
+	if err != nil {
+		return Vector3{}, err
+	}
+	if mag.Val() <= max.Val() {
+		return v, nil
+	}
+	return v.Scale(max.Val() / mag.Val()), nil
+}
+
+// MoveTowards returns the point reached by moving from v towards target by
+// at most maxDelta, stopping at target if it is closer. maxDelta must have
+// the vectors' dimension and be non-negative.
+//
+// Example:
+//
+//	// Slew a velocity towards its set point with a bounded step
+//	v, _ = v.MoveTowards(setPoint, units.MeterPerSecond(0.1).Value)
+func (v Vector3) MoveTowards(target Vector3, maxDelta units.Value) (Vector3, error) {
+	d, err := target.Subtract(v)
+	if err != nil {
+		return Vector3{}, err
+	}
+	step, err := d.ClampMagnitude(maxDelta)
+	if err != nil {
+		return Vector3{}, err
+	}
+	if step == d {
+		return target, nil
+	}
+	return v.Add(step)
+}
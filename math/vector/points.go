@@ -0,0 +1,82 @@
+package vector
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// DistanceTo returns |other - v|, the distance between two points.
+// The vectors must have the same dimension.
+//
+// Example:
+//
+//	d, _ := r1.DistanceTo(r2) // m
+func (v Vector3) DistanceTo(other Vector3) (units.Value, error) {
+	d, err := other.Subtract(v)
+	if err != nil {
+		return units.Value{}, err
+	}
+	return d.Magnitude()
+}
+
+// DistanceSquaredTo returns |other - v|², avoiding the square root.
+// The vectors must have the same dimension.
+func (v Vector3) DistanceSquaredTo(other Vector3) (units.Value, error) {
+	d, err := other.Subtract(v)
+	if err != nil {
+		return units.Value{}, err
+	}
+	return d.MagnitudeSquared(), nil
+}
+
+// Midpoint returns (a + b)/2. The vectors must have the same dimension.
+func Midpoint(a, b Vector3) (Vector3, error) {
+	return Lerp(a, b, 0.5)
+}
+
+// Centroid returns the mass-weighted mean of a set of points,
+// Σ mᵢ rᵢ / Σ mᵢ, such as the center of mass of a system of particles.
+// Points and masses must have the same length, points must share a
+// dimension, masses must be non-negative and their sum positive.
+//
+// Example:
+//
+//	// Center of mass of two particles
+//	cm, _ := vector.Centroid(
+//	    []vector.Vector3{r1, r2},
+//	    []units.Mass{units.Kilogram(3), units.Kilogram(1)},
+//	)
+func Centroid(points []Vector3, masses []units.Mass) (Vector3, error) {
+	if len(points) == 0 {
+		return Vector3{}, fmt.Errorf("centroid requires at least one point")
+	}
+	if len(points) != len(masses) {
+		return Vector3{}, fmt.Errorf("got %d points but %d masses", len(points), len(masses))
+	}
+	dim := points[0].Dim()
+	var sum [3]float64
+	total := 0.0
+	for i, p := range points {
+		if p.Dim() != dim {
+			return Vector3{}, fmt.Errorf("points must have same dimension: [0]=%s, [%d]=%s", dim, i, p.Dim())
+		}
+		m := masses[i].Val()
+		if m < 0 {
+			return Vector3{}, fmt.Errorf("mass must be non-negative, got %g kg", m)
+		}
+		x := p.ToArray()
+		for k := range sum {
+			sum[k] += m * x[k]
+		}
+		total += m
+	}
+	if !(total > 0) {
+		return Vector3{}, fmt.Errorf("total mass must be positive, got %g kg", total)
+	}
+	return Vector3{
+		X: units.NewValue(sum[0]/total, dim),
+		Y: units.NewValue(sum[1]/total, dim),
+		Z: units.NewValue(sum[2]/total, dim),
+	}, nil
+}
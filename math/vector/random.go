@@ -0,0 +1,58 @@
+package vector
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Samplers draw from a caller-supplied *rand.Rand so that results are
+// reproducible for a fixed seed.
+
+// RandomUnit returns a dimensionless unit vector uniformly distributed over
+// the sphere, for isotropic emission directions.
+//
+// It samples cos θ uniformly in [-1, 1] and φ uniformly in [0, 2π)
+// (Archimedes' hat-box theorem).
+//
+// Example:
+//
+//	rng := rand.New(rand.NewSource(1))
+//	dir := vector.RandomUnit(rng)
+func RandomUnit(rng *rand.Rand) Vector3 {
+	z := 2*rng.Float64() - 1
+	s := math.Sqrt(1 - z*z)
+	sp, cp := math.Sincos(2 * math.Pi * rng.Float64())
+	return Vector3{
+		X: units.Dimensionless(s * cp),
+		Y: units.Dimensionless(s * sp),
+		Z: units.Dimensionless(z),
+	}
+}
+
+// RandomInSphere returns a position uniformly distributed inside a ball of
+// the given radius centered on the origin. The distance from the center is
+// radius·u^(1/3) for uniform u, so that equal volumes are equally likely.
+func RandomInSphere(radius units.Length, rng *rand.Rand) Vector3 {
+	r := radius.Val() * math.Cbrt(rng.Float64())
+	u := RandomUnit(rng).ToArray()
+	return NewPosition(units.Meter(r*u[0]), units.Meter(r*u[1]), units.Meter(r*u[2]))
+}
+
+// RandomGaussian returns a vector whose components are independent normal
+// deviates with zero mean and standard deviation sigma. The result carries
+// the dimension of sigma.
+//
+// Example:
+//
+//	// Maxwell-Boltzmann velocity: σ = √(kT/m) per component
+//	sigma := units.MeterPerSecond(math.Sqrt(kT / m)).Value
+//	v := vector.RandomGaussian(sigma, rng)
+func RandomGaussian(sigma units.Value, rng *rand.Rand) Vector3 {
+	return Vector3{
+		X: sigma.Scale(rng.NormFloat64()),
+		Y: sigma.Scale(rng.NormFloat64()),
+		Z: sigma.Scale(rng.NormFloat64()),
+	}
+}
@@ -0,0 +1,177 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Vector2 represents a 2D vector with physical units, for planar problems.
+// Each component is a unit-safe Value, ensuring dimensional consistency.
+type Vector2 struct {
+	X, Y units.Value
+}
+
+// NewVector2 creates a new Vector2 with the specified components.
+// Both components must have the same dimension.
+//
+// Example:
+//
+//	v, _ := vector.NewVector2(units.Meter(3).Value, units.Meter(4).Value)
+func NewVector2(x, y units.Value) (Vector2, error) {
+	if x.Dim() != y.Dim() {
+		return Vector2{}, fmt.Errorf("vector components must have same dimension: x=%s, y=%s",
+			x.Dim(), y.Dim())
+	}
+	return Vector2{X: x, Y: y}, nil
+}
+
+// NewPosition2 creates a planar position vector with Length components.
+func NewPosition2(x, y units.Length) Vector2 {
+	return Vector2{X: x.Value, Y: y.Value}
+}
+
+// NewVelocity2 creates a planar velocity vector with Velocity components.
+func NewVelocity2(vx, vy units.Velocity) Vector2 {
+	return Vector2{X: vx.Value, Y: vy.Value}
+}
+
+// Zero2 creates a zero Vector2 with the specified dimension.
+func Zero2(dim units.Dimension) Vector2 {
+	return Vector2{X: units.NewValue(0, dim), Y: units.NewValue(0, dim)}
+}
+
+// String returns a human-readable representation of the vector.
+func (v Vector2) String() string {
+	return fmt.Sprintf("(%v, %v)", v.X, v.Y)
+}
+
+// Dim returns the dimension of the vector components.
+func (v Vector2) Dim() units.Dimension {
+	return v.X.Dim()
+}
+
+// Add returns the sum of two vectors. Vectors must have the same dimension.
+func (v Vector2) Add(other Vector2) (Vector2, error) {
+	x, err := v.X.Add(other.X)
+	if err != nil {
+		return Vector2{}, err
+	}
+	y, err := v.Y.Add(other.Y)
+	if err != nil {
+		return Vector2{}, err
+	}
+	return Vector2{X: x, Y: y}, nil
+}
+
+// Subtract returns the difference of two vectors. Vectors must have the same dimension.
+func (v Vector2) Subtract(other Vector2) (Vector2, error) {
+	x, err := v.X.Subtract(other.X)
+	if err != nil {
+		return Vector2{}, err
+	}
+	y, err := v.Y.Subtract(other.Y)
+	if err != nil {
+		return Vector2{}, err
+	}
+	return Vector2{X: x, Y: y}, nil
+}
+
+// Scale multiplies the vector by a dimensionless scalar.
+func (v Vector2) Scale(scalar float64) Vector2 {
+	return Vector2{X: v.X.Scale(scalar), Y: v.Y.Scale(scalar)}
+}
+
+// Negate returns the negation of the vector (-v).
+func (v Vector2) Negate() Vector2 {
+	return Vector2{X: v.X.Negate(), Y: v.Y.Negate()}
+}
+
+// Dot returns the dot product of two vectors.
+// Result has dimension equal to the product of component dimensions.
+func (v Vector2) Dot(other Vector2) units.Value {
+	result, _ := v.X.Multiply(other.X).Add(v.Y.Multiply(other.Y))
+	return result
+}
+
+// Cross returns the scalar (z-component) cross product v_x*w_y - v_y*w_x.
+// Result has dimension equal to the product of component dimensions.
+//
+// Example:
+//
+//	// Planar angular momentum: L_z = m (r × v)
+//	lz := r.Cross(v).Multiply(mass.Value)
+func (v Vector2) Cross(other Vector2) units.Value {
+	result, _ := v.X.Multiply(other.Y).Subtract(v.Y.Multiply(other.X))
+	return result
+}
+
+// Perp returns the vector rotated by +90°: (-y, x).
+func (v Vector2) Perp() Vector2 {
+	return Vector2{X: v.Y.Negate(), Y: v.X}
+}
+
+// Rotate returns the vector rotated counter-clockwise by angle.
+func (v Vector2) Rotate(angle units.Angle) Vector2 {
+	s, c := math.Sincos(angle.Val())
+	x, y := v.X.Val(), v.Y.Val()
+	return Vector2{
+		X: units.NewValue(c*x-s*y, v.Dim()),
+		Y: units.NewValue(s*x+c*y, v.Dim()),
+	}
+}
+
+// Angle returns the polar angle of the vector, atan2(y, x), in (-π, π].
+func (v Vector2) Angle() units.Angle {
+	return units.Radian(math.Atan2(v.Y.Val(), v.X.Val()))
+}
+
+// MagnitudeSquared returns the squared magnitude of the vector (v · v).
+func (v Vector2) MagnitudeSquared() units.Value {
+	return v.Dot(v)
+}
+
+// Magnitude returns the magnitude (length) of the vector: |v| = √(v · v).
+// Returns an error if the dimension cannot be square-rooted (odd exponents).
+func (v Vector2) Magnitude() (units.Value, error) {
+	return v.MagnitudeSquared().Sqrt()
+}
+
+// Normalize returns a dimensionless unit vector in the same direction.
+func (v Vector2) Normalize() (Vector2, error) {
+	mag, err := v.Magnitude()
+	if err != nil {
+		return Vector2{}, err
+	}
+	if mag.Val() == 0 {
+		return Vector2{}, fmt.Errorf("cannot normalize zero vector")
+	}
+	return Vector2{X: v.X.Divide(mag), Y: v.Y.Divide(mag)}, nil
+}
+
+// IsZero returns true if both components are zero.
+func (v Vector2) IsZero() bool {
+	return v.X.Val() == 0 && v.Y.Val() == 0
+}
+
+// Components returns the X, Y components as a slice.
+func (v Vector2) Components() []units.Value {
+	return []units.Value{v.X, v.Y}
+}
+
+// ToArray returns the vector components as a float64 array (in SI base units).
+func (v Vector2) ToArray() [2]float64 {
+	return [2]float64{v.X.Val(), v.Y.Val()}
+}
+
+// ToVector3 embeds the vector in the XY plane, with a zero Z component of
+// the same dimension.
+func (v Vector2) ToVector3() Vector3 {
+	return Vector3{X: v.X, Y: v.Y, Z: units.NewValue(0, v.Dim())}
+}
+
+// XY returns the projection of the vector onto the XY plane, dropping Z.
+func (v Vector3) XY() Vector2 {
+	return Vector2{X: v.X, Y: v.Y}
+}
@@ -0,0 +1,64 @@
+package vector
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestNewVector2(t *testing.T) {
+	v, err := NewVector2(units.Meter(3).Value, units.Meter(4).Value)
+	if err != nil {
+		t.Fatalf("NewVector2() error = %v", err)
+	}
+	if mag, _ := v.Magnitude(); mag.Val() != 5 || mag.Dim() != (units.Dimension{L: 1}) {
+		t.Errorf("Magnitude() = %v, want 5 m", mag)
+	}
+	if _, err := NewVector2(units.Meter(1).Value, units.Second(1).Value); err == nil {
+		t.Error("NewVector2() should fail with different dimensions")
+	}
+	if _, err := v.Add(NewVelocity2(units.MeterPerSecond(1), units.MeterPerSecond(1))); err == nil {
+		t.Error("Add() should fail with incompatible dimensions")
+	}
+}
+
+func TestVector2CrossRotate(t *testing.T) {
+	// Planar angular momentum per unit mass: r × v = 2 m²/s
+	r := NewPosition2(units.Meter(1), units.Meter(0))
+	v := NewVelocity2(units.MeterPerSecond(0), units.MeterPerSecond(2))
+	h := r.Cross(v)
+	if h.Val() != 2 || h.Dim() != (units.Dimension{L: 2, T: -1}) {
+		t.Errorf("Cross() = %v, want 2 m²/s", h)
+	}
+	// Agrees with the z-component of the 3D cross product.
+	if z := r.ToVector3().Cross(v.ToVector3()).Z; z.Val() != h.Val() {
+		t.Errorf("3D cross z = %v, want %v", z, h)
+	}
+
+	rot := r.Rotate(units.Degree(90))
+	if !almostEqual(rot.X.Val(), 0, 1e-15) || !almostEqual(rot.Y.Val(), 1, 1e-15) {
+		t.Errorf("Rotate(90°) = %v, want (0, 1)", rot)
+	}
+	if p := r.Perp(); p.X.Val() != 0 || p.Y.Val() != 1 {
+		t.Errorf("Perp() = %v, want (0, 1)", p)
+	}
+	if a := NewPosition2(units.Meter(-1), units.Meter(1)).Angle(); !almostEqual(a.Val(), 3*math.Pi/4, 1e-15) {
+		t.Errorf("Angle() = %v, want 3π/4", a.Val())
+	}
+}
+
+func TestVector2Conversion(t *testing.T) {
+	v := NewPosition(units.Meter(1), units.Meter(2), units.Meter(3))
+	xy := v.XY()
+	if xy.X.Val() != 1 || xy.Y.Val() != 2 || xy.Dim() != v.Dim() {
+		t.Errorf("XY() = %v, want (1, 2) m", xy)
+	}
+	back := xy.ToVector3()
+	if back.Z.Val() != 0 || back.Z.Dim() != v.Dim() {
+		t.Errorf("ToVector3().Z = %v, want 0 m", back.Z)
+	}
+	if _, err := Zero2(units.Dimension{L: 1}).Normalize(); err == nil {
+		t.Error("Normalize() should fail for zero vector")
+	}
+}
@@ -289,6 +289,38 @@ func (v Vector3) ProjectOnto(other Vector3) (Vector3, error) {
 	}, nil
 }
 
+// ProjectOntoPlane returns the component of v lying in the plane with the
+// given normal: v - proj_n(v). The normal need not be normalized and may
+// have any dimension; the result keeps the dimension of v.
+//
+// Example:
+//
+//	// Remove the velocity component along a constraint normal
+//	tangential, _ := velocity.ProjectOntoPlane(normal)
+func (v Vector3) ProjectOntoPlane(normal Vector3) (Vector3, error) {
+	proj, err := v.ProjectOnto(normal)
+	if err != nil {
+		return Vector3{}, err
+	}
+	return v.Subtract(proj)
+}
+
+// Reflect returns v mirrored in the plane with the given normal:
+// v - 2 proj_n(v). The normal need not be normalized and may have any
+// dimension; the result keeps the dimension of v.
+//
+// Example:
+//
+//	// Elastic bounce off a wall
+//	velocity, _ = velocity.Reflect(wallNormal)
+func (v Vector3) Reflect(normal Vector3) (Vector3, error) {
+	proj, err := v.ProjectOnto(normal)
+	if err != nil {
+		return Vector3{}, err
+	}
+	return v.Subtract(proj.Scale(2))
+}
+
 // AngleBetween returns the angle (in radians) between two vectors.
 // Result is dimensionless.
 //
@@ -346,6 +378,72 @@ func (v Vector3) IsPerpendicular(other Vector3, tolerance float64) bool {
 	return math.Abs(dot.Val()) < tolerance
 }
 
+// ScalarTripleProduct returns v · (b × c), the signed volume of the
+// parallelepiped spanned by the three vectors. It is positive when
+// (v, b, c) is right-handed.
+//
+// Example:
+//
+//	// Volume of a cell with edge vectors a1, a2, a3
+//	volume := a1.ScalarTripleProduct(a2, a3)
+func (v Vector3) ScalarTripleProduct(b, c Vector3) units.Value {
+	return v.Dot(b.Cross(c))
+}
+
+// VectorTripleProduct returns v × (b × c), evaluated by the BAC-CAB rule
+// b(v · c) - c(v · b).
+func (v Vector3) VectorTripleProduct(b, c Vector3) Vector3 {
+	vc, vb := v.Dot(c), v.Dot(b)
+	// Both terms have the dimension of v·b·c, so the differences cannot fail.
+	x, _ := b.X.Multiply(vc).Subtract(c.X.Multiply(vb))
+	y, _ := b.Y.Multiply(vc).Subtract(c.Y.Multiply(vb))
+	z, _ := b.Z.Multiply(vc).Subtract(c.Z.Multiply(vb))
+	return Vector3{X: x, Y: y, Z: z}
+}
+
+// AreCollinear reports whether the points a, b, c lie on one line, i.e.
+// |(b - a) × (c - a)| ≤ tolerance·|b - a|·|c - a|. The tolerance is the
+// sine of the largest angle accepted between the two chords, so it does
+// not depend on the scale of the coordinates. Coincident points are
+// collinear; points with different dimensions are not.
+func AreCollinear(a, b, c Vector3, tolerance float64) bool {
+	if a.Dim() != b.Dim() || a.Dim() != c.Dim() {
+		return false
+	}
+	u, w := chord(a, b), chord(a, c)
+	return norm(cross(u, w)) <= tolerance*norm(u)*norm(w)
+}
+
+// AreCoplanar reports whether the points a, b, c, d lie in one plane, i.e.
+// |(b - a) · ((c - a) × (d - a))| ≤ tolerance·|b - a|·|c - a|·|d - a|.
+// The tolerance is relative, as for AreCollinear. Points with different
+// dimensions are not coplanar.
+func AreCoplanar(a, b, c, d Vector3, tolerance float64) bool {
+	if a.Dim() != b.Dim() || a.Dim() != c.Dim() || a.Dim() != d.Dim() {
+		return false
+	}
+	u, v, w := chord(a, b), chord(a, c), chord(a, d)
+	x := cross(v, w)
+	triple := u[0]*x[0] + u[1]*x[1] + u[2]*x[2]
+	return math.Abs(triple) <= tolerance*norm(u)*norm(v)*norm(w)
+}
+
+// chord returns the components of q - p.
+func chord(p, q Vector3) [3]float64 {
+	a, b := p.ToArray(), q.ToArray()
+	return [3]float64{b[0] - a[0], b[1] - a[1], b[2] - a[2]}
+}
+
+// cross returns a × b for raw components.
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+
+// norm returns |a| for raw components.
+func norm(a [3]float64) float64 {
+	return math.Sqrt(a[0]*a[0] + a[1]*a[1] + a[2]*a[2])
+}
+
 // Components returns the X, Y, Z components as a slice.
 func (v Vector3) Components() []units.Value {
 	return []units.Value{v.X, v.Y, v.Z}
@@ -2,6 +2,7 @@ package vector
 
 import (
 	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/sakiphan/qsim-core/units"
@@ -384,6 +385,37 @@ func TestProjectOnto(t *testing.T) {
 	}
 }
 
+func TestReflect(t *testing.T) {
+	// Ball hitting the floor: only the normal component flips.
+	v := NewVelocity(units.MeterPerSecond(3), units.MeterPerSecond(-4), units.MeterPerSecond(1))
+	floor := NewPosition(units.Meter(0), units.Meter(2), units.Meter(0))
+	r, err := v.Reflect(floor)
+	if err != nil {
+		t.Fatalf("Reflect() error = %v", err)
+	}
+	if r.X.Val() != 3 || r.Y.Val() != 4 || r.Z.Val() != 1 || r.Dim() != v.Dim() {
+		t.Errorf("Reflect() = %v, want (3, 4, 1) m/s", r)
+	}
+	if _, err := v.Reflect(Zero(units.Dimension{})); err == nil {
+		t.Error("Reflect() should fail with zero normal")
+	}
+}
+
+func TestProjectOntoPlane(t *testing.T) {
+	v := NewForce(units.Newton(1), units.Newton(2), units.Newton(3))
+	n := NewPosition(units.Meter(1), units.Meter(1), units.Meter(0))
+	p, err := v.ProjectOntoPlane(n)
+	if err != nil {
+		t.Fatalf("ProjectOntoPlane() error = %v", err)
+	}
+	if !almostEqual(p.X.Val(), -0.5, 1e-15) || !almostEqual(p.Y.Val(), 0.5, 1e-15) || p.Z.Val() != 3 {
+		t.Errorf("ProjectOntoPlane() = %v, want (-0.5, 0.5, 3)", p)
+	}
+	if p.Dim() != v.Dim() || !almostEqual(p.Dot(n).Val(), 0, 1e-15) {
+		t.Errorf("ProjectOntoPlane() = %v should keep dimension and be normal to n", p)
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Geometric Relations Tests
 // -----------------------------------------------------------------------------
@@ -482,3 +514,297 @@ func TestPhysics_AngularMomentum(t *testing.T) {
 		t.Errorf("Angular momentum dimension = %v, want %v", L.Dim(), expectedDim)
 	}
 }
+
+// -----------------------------------------------------------------------------
+// Curvilinear Coordinate Tests
+// -----------------------------------------------------------------------------
+
+func TestSpherical(t *testing.T) {
+	v := NewPosition(units.Meter(1), units.Meter(1), units.Meter(math.Sqrt2))
+	r, theta, phi, err := v.ToSpherical()
+	if err != nil {
+		t.Fatalf("ToSpherical() error = %v", err)
+	}
+	if !almostEqual(r.ToMeters(), 2, 1e-15) || !almostEqual(theta.ToDegrees(), 45, 1e-12) || !almostEqual(phi.ToDegrees(), 45, 1e-12) {
+		t.Errorf("ToSpherical() = (%v, %v°, %v°), want (2, 45°, 45°)", r, theta.ToDegrees(), phi.ToDegrees())
+	}
+	back := FromSpherical(r, theta, phi).ToArray()
+	for k, want := range v.ToArray() {
+		if !almostEqual(back[k], want, 1e-15) {
+			t.Errorf("FromSpherical()[%d] = %v, want %v", k, back[k], want)
+		}
+	}
+	if _, _, _, err := NewVelocity(units.MeterPerSecond(1), units.MeterPerSecond(0), units.MeterPerSecond(0)).ToSpherical(); err == nil {
+		t.Error("ToSpherical() should fail for non-position vectors")
+	}
+}
+
+func TestCylindrical(t *testing.T) {
+	v := NewPosition(units.Meter(0), units.Meter(-3), units.Meter(7))
+	rho, phi, z, err := v.ToCylindrical()
+	if err != nil {
+		t.Fatalf("ToCylindrical() error = %v", err)
+	}
+	if rho.ToMeters() != 3 || !almostEqual(phi.ToDegrees(), -90, 1e-12) || z.ToMeters() != 7 {
+		t.Errorf("ToCylindrical() = (%v, %v°, %v), want (3, -90°, 7)", rho, phi.ToDegrees(), z)
+	}
+	back := FromCylindrical(rho, phi, z)
+	if !almostEqual(back.X.Val(), 0, 1e-15) || back.Y.Val() != -3 || back.Z.Val() != 7 {
+		t.Errorf("FromCylindrical() = %v, want (0, -3, 7)", back)
+	}
+}
+
+func TestAzimuthRange(t *testing.T) {
+	// On the negative X axis φ is +π, whatever the sign of the zero Y.
+	for _, y := range []float64{0, math.Copysign(0, -1)} {
+		v := NewPosition(units.Meter(-1), units.Meter(y), units.Meter(0))
+		if _, _, phi, _ := v.ToSpherical(); phi.Val() != math.Pi {
+			t.Errorf("ToSpherical() φ at Y = %v: %v, want π", y, phi.Val())
+		}
+		if _, phi, _, _ := v.ToCylindrical(); phi.Val() != math.Pi {
+			t.Errorf("ToCylindrical() φ at Y = %v: %v, want π", y, phi.Val())
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Interpolation Tests
+// -----------------------------------------------------------------------------
+
+func TestLerp(t *testing.T) {
+	a := NewPosition(units.Meter(0), units.Meter(2), units.Meter(4))
+	b := NewPosition(units.Meter(4), units.Meter(2), units.Meter(0))
+	mid, err := Lerp(a, b, 0.25)
+	if err != nil {
+		t.Fatalf("Lerp() error = %v", err)
+	}
+	if mid.X.Val() != 1 || mid.Y.Val() != 2 || mid.Z.Val() != 3 {
+		t.Errorf("Lerp(0.25) = %v, want (1, 2, 3)", mid)
+	}
+	if _, err := Lerp(a, Zero(units.Dimension{T: 1}), 0.5); err == nil {
+		t.Error("Lerp() should fail with incompatible dimensions")
+	}
+
+	n, err := Nlerp(UnitX(units.Dimension{}), UnitY(units.Dimension{}), 0.5)
+	if err != nil {
+		t.Fatalf("Nlerp() error = %v", err)
+	}
+	if !almostEqual(n.X.Val(), math.Sqrt2/2, 1e-15) || !almostEqual(n.Y.Val(), math.Sqrt2/2, 1e-15) {
+		t.Errorf("Nlerp(0.5) = %v, want (√2/2, √2/2, 0)", n)
+	}
+	if _, err := Nlerp(UnitX(units.Dimension{}), UnitX(units.Dimension{}).Negate(), 0.5); err == nil {
+		t.Error("Nlerp() should fail through the origin")
+	}
+}
+
+func TestClampMagnitude(t *testing.T) {
+	v := NewVelocity(units.MeterPerSecond(3), units.MeterPerSecond(4), units.MeterPerSecond(0))
+	c, err := v.ClampMagnitude(units.MeterPerSecond(2.5).Value)
+	if err != nil {
+		t.Fatalf("ClampMagnitude() error = %v", err)
+	}
+	if !almostEqual(c.X.Val(), 1.5, 1e-15) || !almostEqual(c.Y.Val(), 2, 1e-15) {
+		t.Errorf("ClampMagnitude(2.5) = %v, want (1.5, 2, 0)", c)
+	}
+	if c, _ := v.ClampMagnitude(units.MeterPerSecond(10).Value); c != v {
+		t.Errorf("ClampMagnitude(10) = %v, want unchanged", c)
+	}
+	if _, err := v.ClampMagnitude(units.Meter(1).Value); err == nil {
+		t.Error("ClampMagnitude() should fail with mismatched dimension")
+	}
+}
+
+func TestMoveTowards(t *testing.T) {
+	v := NewPosition(units.Meter(0), units.Meter(0), units.Meter(0))
+	target := NewPosition(units.Meter(0), units.Meter(10), units.Meter(0))
+	step, err := v.MoveTowards(target, units.Meter(4).Value)
+	if err != nil {
+		t.Fatalf("MoveTowards() error = %v", err)
+	}
+	if step.Y.Val() != 4 {
+		t.Errorf("MoveTowards() = %v, want (0, 4, 0)", step)
+	}
+	if end, _ := step.MoveTowards(target, units.Meter(100).Value); end != target {
+		t.Errorf("MoveTowards() overshoot = %v, want %v", end, target)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Triple Product Tests
+// -----------------------------------------------------------------------------
+
+func TestTripleProducts(t *testing.T) {
+	a := NewPosition(units.Meter(2), units.Meter(0), units.Meter(0))
+	b := NewPosition(units.Meter(1), units.Meter(3), units.Meter(0))
+	c := NewPosition(units.Meter(1), units.Meter(1), units.Meter(4))
+
+	vol := a.ScalarTripleProduct(b, c)
+	if vol.Val() != 24 || vol.Dim() != (units.Dimension{L: 3}) {
+		t.Errorf("ScalarTripleProduct() = %v, want 24 m³", vol)
+	}
+	if b.ScalarTripleProduct(a, c).Val() != -24 {
+		t.Error("ScalarTripleProduct() should change sign on swapping")
+	}
+
+	// BAC-CAB: a × (b × c) = b(a·c) - c(a·b)
+	got := a.VectorTripleProduct(b, c)
+	want, _ := b.Scale(a.Dot(c).Val()).Subtract(c.Scale(a.Dot(b).Val()))
+	if got.ToArray() != want.ToArray() || got.Dim() != (units.Dimension{L: 3}) {
+		t.Errorf("VectorTripleProduct() = %v, want %v", got, want)
+	}
+	if cross := a.Cross(b.Cross(c)); cross.ToArray() != got.ToArray() {
+		t.Errorf("VectorTripleProduct() = %v, want a × (b × c) = %v", got, cross)
+	}
+
+	// Mixed dimensions: r × (F × ω) has dimension [L]·[LMT⁻²]·[T⁻¹]
+	p := NewForce(units.Newton(2), units.Newton(0), units.Newton(1))
+	w := Vector3{X: units.Hertz(0).Value, Y: units.Hertz(3).Value, Z: units.Hertz(1).Value}
+	mixed := a.VectorTripleProduct(p, w)
+	if mixed.Dim() != (units.Dimension{L: 2, M: 1, T: -3}) || mixed.ToArray() != a.Cross(p.Cross(w)).ToArray() {
+		t.Errorf("VectorTripleProduct(p, ω) = %v, want %v", mixed, a.Cross(p.Cross(w)))
+	}
+}
+
+func TestCollinearCoplanar(t *testing.T) {
+	p := func(x, y, z float64) Vector3 {
+		return NewPosition(units.Meter(x), units.Meter(y), units.Meter(z))
+	}
+	// Scale-independent: the same configuration at 1 m and 1e7 m.
+	for _, s := range []float64{1, 1e7} {
+		if !AreCollinear(p(0, 0, 0), p(s, s, s), p(3*s, 3*s, 3*s), 1e-12) {
+			t.Errorf("scale %g: points on a line should be collinear", s)
+		}
+		if AreCollinear(p(0, 0, 0), p(s, 0, 0), p(s, 1e-3*s, 0), 1e-6) {
+			t.Errorf("scale %g: bent points should not be collinear", s)
+		}
+		if !AreCoplanar(p(0, 0, 0), p(s, 0, 0), p(0, s, 0), p(5*s, -2*s, 0), 1e-12) {
+			t.Errorf("scale %g: points in z = 0 should be coplanar", s)
+		}
+		if AreCoplanar(p(0, 0, 0), p(s, 0, 0), p(0, s, 0), p(0, 0, s), 1e-6) {
+			t.Errorf("scale %g: tetrahedron should not be coplanar", s)
+		}
+	}
+	if AreCollinear(p(0, 0, 0), p(1, 1, 1), Zero(units.Dimension{T: 1}), 1) {
+		t.Error("AreCollinear() should be false for mixed dimensions")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Distance Tests
+// -----------------------------------------------------------------------------
+
+func TestDistance(t *testing.T) {
+	a := NewPosition(units.Meter(1), units.Meter(2), units.Meter(3))
+	b := NewPosition(units.Meter(4), units.Meter(6), units.Meter(3))
+	d, err := a.DistanceTo(b)
+	if err != nil || d.Val() != 5 || d.Dim() != (units.Dimension{L: 1}) {
+		t.Errorf("DistanceTo() = %v, %v, want 5 m", d, err)
+	}
+	if d2, _ := b.DistanceSquaredTo(a); d2.Val() != 25 || d2.Dim() != (units.Dimension{L: 2}) {
+		t.Errorf("DistanceSquaredTo() = %v, want 25 m²", d2)
+	}
+	if _, err := a.DistanceTo(Zero(units.Dimension{T: 1})); err == nil {
+		t.Error("DistanceTo() should fail with incompatible dimensions")
+	}
+	if m, _ := Midpoint(a, b); m.X.Val() != 2.5 || m.Y.Val() != 4 || m.Z.Val() != 3 {
+		t.Errorf("Midpoint() = %v, want (2.5, 4, 3)", m)
+	}
+}
+
+func TestCentroid(t *testing.T) {
+	points := []Vector3{
+		NewPosition(units.Meter(0), units.Meter(0), units.Meter(0)),
+		NewPosition(units.Meter(4), units.Meter(0), units.Meter(0)),
+		NewPosition(units.Meter(0), units.Meter(8), units.Meter(0)),
+	}
+	masses := []units.Mass{units.Kilogram(2), units.Kilogram(1), units.Kilogram(1)}
+	cm, err := Centroid(points, masses)
+	if err != nil {
+		t.Fatalf("Centroid() error = %v", err)
+	}
+	if cm.X.Val() != 1 || cm.Y.Val() != 2 || cm.Z.Val() != 0 || cm.Dim() != (units.Dimension{L: 1}) {
+		t.Errorf("Centroid() = %v, want (1, 2, 0) m", cm)
+	}
+
+	if _, err := Centroid(points, masses[:2]); err == nil {
+		t.Error("Centroid() should fail with mismatched lengths")
+	}
+	if _, err := Centroid(points, []units.Mass{units.Kilogram(0), units.Kilogram(0), units.Kilogram(0)}); err == nil {
+		t.Error("Centroid() should fail with zero total mass")
+	}
+	if _, err := Centroid(nil, nil); err == nil {
+		t.Error("Centroid() should fail with no points")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Random Sampler Tests
+// -----------------------------------------------------------------------------
+
+func TestRandomUnit(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	const n = 20000
+	var mean [3]float64
+	var zz float64
+	for i := 0; i < n; i++ {
+		u := RandomUnit(rng)
+		if m, _ := u.Magnitude(); !almostEqual(m.Val(), 1, 1e-12) || !m.IsDimensionless() {
+			t.Fatalf("RandomUnit() magnitude = %v, want 1", m)
+		}
+		x := u.ToArray()
+		for k := range mean {
+			mean[k] += x[k] / n
+		}
+		zz += x[2] * x[2] / n
+	}
+	// Isotropy: zero mean, ⟨z²⟩ = 1/3.
+	for k, m := range mean {
+		if math.Abs(m) > 0.02 {
+			t.Errorf("RandomUnit() mean[%d] = %v, want 0", k, m)
+		}
+	}
+	if !almostEqual(zz, 1.0/3, 0.01) {
+		t.Errorf("RandomUnit() <z²> = %v, want 1/3", zz)
+	}
+}
+
+func TestRandomInSphere(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	const n = 20000
+	inner := 0
+	for i := 0; i < n; i++ {
+		p := RandomInSphere(units.Meter(2), rng)
+		if p.Dim() != (units.Dimension{L: 1}) {
+			t.Fatalf("RandomInSphere() dimension = %v, want [L^1]", p.Dim())
+		}
+		r, _ := p.Magnitude()
+		if r.Val() > 2 {
+			t.Fatalf("RandomInSphere() radius = %v, want ≤ 2", r)
+		}
+		if r.Val() < 1 {
+			inner++
+		}
+	}
+	// Half the radius holds 1/8 of the volume.
+	if f := float64(inner) / n; !almostEqual(f, 0.125, 0.01) {
+		t.Errorf("RandomInSphere() inner fraction = %v, want 0.125", f)
+	}
+}
+
+func TestRandomGaussian(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	sigma := units.MeterPerSecond(300).Value
+	const n = 20000
+	var sumSq float64
+	for i := 0; i < n; i++ {
+		v := RandomGaussian(sigma, rng)
+		if v.Dim() != sigma.Dim() {
+			t.Fatalf("RandomGaussian() dimension = %v, want %v", v.Dim(), sigma.Dim())
+		}
+		sumSq += v.MagnitudeSquared().Val() / n
+	}
+	// ⟨|v|²⟩ = 3σ²
+	if !almostEqual(sumSq/(3*300*300), 1, 0.02) {
+		t.Errorf("RandomGaussian() <|v|²>/3σ² = %v, want 1", sumSq/(3*300*300))
+	}
+}
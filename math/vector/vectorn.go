@@ -0,0 +1,219 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// VectorN represents a vector of arbitrary length whose components share a
+// single physical dimension, such as a set of generalized coordinates.
+// Components are stored in SI base units. The zero value is an empty,
+// dimensionless vector.
+type VectorN struct {
+	v   []float64
+	dim units.Dimension
+}
+
+// NewVectorN creates a VectorN from unit-safe components.
+// All components must have the same dimension; at least one is required.
+//
+// Example:
+//
+//	q, _ := vector.NewVectorN(
+//	    units.Meter(1).Value,
+//	    units.Meter(2).Value,
+//	    units.Meter(3).Value,
+//	    units.Meter(4).Value,
+//	)
+func NewVectorN(components ...units.Value) (VectorN, error) {
+	if len(components) == 0 {
+		return VectorN{}, fmt.Errorf("vector must have at least one component")
+	}
+	dim := components[0].Dim()
+	v := make([]float64, len(components))
+	for i, c := range components {
+		if c.Dim() != dim {
+			return VectorN{}, fmt.Errorf("vector components must have same dimension: [0]=%s, [%d]=%s",
+				dim, i, c.Dim())
+		}
+		v[i] = c.Val()
+	}
+	return VectorN{v: v, dim: dim}, nil
+}
+
+// FromSlice creates a VectorN from component values (in SI base units) and
+// a shared dimension. The slice is copied.
+func FromSlice(values []float64, dim units.Dimension) VectorN {
+	return VectorN{v: append([]float64(nil), values...), dim: dim}
+}
+
+// ZeroN creates a zero VectorN of length n with the specified dimension.
+func ZeroN(n int, dim units.Dimension) VectorN {
+	return VectorN{v: make([]float64, n), dim: dim}
+}
+
+// Len returns the number of components.
+func (v VectorN) Len() int {
+	return len(v.v)
+}
+
+// At returns component i as a unit-safe Value.
+// Panics if i is out of range.
+func (v VectorN) At(i int) units.Value {
+	return units.NewValue(v.v[i], v.dim)
+}
+
+// Dim returns the dimension shared by all components.
+func (v VectorN) Dim() units.Dimension {
+	return v.dim
+}
+
+// String returns a human-readable representation of the vector.
+func (v VectorN) String() string {
+	parts := make([]string, len(v.v))
+	for i := range v.v {
+		parts[i] = v.At(i).String()
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// Add returns the sum of two vectors. Vectors must have the same length and
+// dimension.
+func (v VectorN) Add(other VectorN) (VectorN, error) {
+	if err := v.check(other, "add"); err != nil {
+		return VectorN{}, err
+	}
+	sum := make([]float64, len(v.v))
+	for i := range v.v {
+		sum[i] = v.v[i] + other.v[i]
+	}
+	return VectorN{v: sum, dim: v.dim}, nil
+}
+
+// Subtract returns the difference of two vectors. Vectors must have the same
+// length and dimension.
+func (v VectorN) Subtract(other VectorN) (VectorN, error) {
+	if err := v.check(other, "subtract"); err != nil {
+		return VectorN{}, err
+	}
+	diff := make([]float64, len(v.v))
+	for i := range v.v {
+		diff[i] = v.v[i] - other.v[i]
+	}
+	return VectorN{v: diff, dim: v.dim}, nil
+}
+
+// Scale multiplies the vector by a dimensionless scalar.
+func (v VectorN) Scale(scalar float64) VectorN {
+	out := make([]float64, len(v.v))
+	for i, x := range v.v {
+		out[i] = x * scalar
+	}
+	return VectorN{v: out, dim: v.dim}
+}
+
+// Negate returns the negation of the vector (-v).
+func (v VectorN) Negate() VectorN {
+	return v.Scale(-1)
+}
+
+// Dot returns the dot product of two vectors of the same length.
+// Result has dimension equal to the product of component dimensions.
+func (v VectorN) Dot(other VectorN) (units.Value, error) {
+	if len(v.v) != len(other.v) {
+		return units.Value{}, fmt.Errorf("vector lengths differ: %d and %d", len(v.v), len(other.v))
+	}
+	sum := 0.0
+	for i := range v.v {
+		sum += v.v[i] * other.v[i]
+	}
+	return units.NewValue(1, v.dim).Multiply(units.NewValue(sum, other.dim)), nil
+}
+
+// MagnitudeSquared returns the squared magnitude of the vector (v · v).
+func (v VectorN) MagnitudeSquared() units.Value {
+	result, _ := v.Dot(v)
+	return result
+}
+
+// Magnitude returns the magnitude (length) of the vector: |v| = √(v · v).
+// Returns an error if the dimension cannot be square-rooted (odd exponents).
+func (v VectorN) Magnitude() (units.Value, error) {
+	return v.MagnitudeSquared().Sqrt()
+}
+
+// Normalize returns a dimensionless unit vector in the same direction.
+func (v VectorN) Normalize() (VectorN, error) {
+	norm := 0.0
+	for _, x := range v.v {
+		norm = math.Hypot(norm, x)
+	}
+	if norm == 0 {
+		return VectorN{}, fmt.Errorf("cannot normalize zero vector")
+	}
+	return VectorN{v: v.Scale(1 / norm).v}, nil
+}
+
+// IsZero returns true if all components are zero.
+func (v VectorN) IsZero() bool {
+	for _, x := range v.v {
+		if x != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Components returns the components as a slice of unit-safe Values.
+func (v VectorN) Components() []units.Value {
+	out := make([]units.Value, len(v.v))
+	for i := range v.v {
+		out[i] = v.At(i)
+	}
+	return out
+}
+
+// ToSlice returns a copy of the component values (in SI base units).
+func (v VectorN) ToSlice() []float64 {
+	return append([]float64(nil), v.v...)
+}
+
+// ToVector2 converts a length-2 VectorN to a Vector2.
+func (v VectorN) ToVector2() (Vector2, error) {
+	if len(v.v) != 2 {
+		return Vector2{}, fmt.Errorf("cannot convert vector of length %d to Vector2", len(v.v))
+	}
+	return Vector2{X: v.At(0), Y: v.At(1)}, nil
+}
+
+// ToVector3 converts a length-3 VectorN to a Vector3.
+func (v VectorN) ToVector3() (Vector3, error) {
+	if len(v.v) != 3 {
+		return Vector3{}, fmt.Errorf("cannot convert vector of length %d to Vector3", len(v.v))
+	}
+	return Vector3{X: v.At(0), Y: v.At(1), Z: v.At(2)}, nil
+}
+
+// ToVectorN converts the vector to a length-2 VectorN.
+func (v Vector2) ToVectorN() VectorN {
+	return VectorN{v: []float64{v.X.Val(), v.Y.Val()}, dim: v.Dim()}
+}
+
+// ToVectorN converts the vector to a length-3 VectorN.
+func (v Vector3) ToVectorN() VectorN {
+	return VectorN{v: []float64{v.X.Val(), v.Y.Val(), v.Z.Val()}, dim: v.Dim()}
+}
+
+// check returns an error if other has a different length or dimension.
+func (v VectorN) check(other VectorN, op string) error {
+	if len(v.v) != len(other.v) {
+		return fmt.Errorf("cannot %s vectors of length %d and %d", op, len(v.v), len(other.v))
+	}
+	if v.dim != other.dim {
+		return fmt.Errorf("cannot %s vectors with dimensions %s and %s", op, v.dim, other.dim)
+	}
+	return nil
+}
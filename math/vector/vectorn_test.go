@@ -0,0 +1,80 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestNewVectorN(t *testing.T) {
+	q, err := NewVectorN(units.Meter(1).Value, units.Meter(2).Value, units.Meter(2).Value, units.Meter(4).Value)
+	if err != nil {
+		t.Fatalf("NewVectorN() error = %v", err)
+	}
+	if q.Len() != 4 || q.Dim() != (units.Dimension{L: 1}) {
+		t.Errorf("NewVectorN() = %v, want length 4 in m", q)
+	}
+	if mag, _ := q.Magnitude(); mag.Val() != 5 {
+		t.Errorf("Magnitude() = %v, want 5 m", mag)
+	}
+	if _, err := NewVectorN(units.Meter(1).Value, units.Second(1).Value); err == nil {
+		t.Error("NewVectorN() should fail with mixed dimensions")
+	}
+	if _, err := NewVectorN(); err == nil {
+		t.Error("NewVectorN() should fail with no components")
+	}
+}
+
+func TestVectorNAlgebra(t *testing.T) {
+	a := FromSlice([]float64{1, 2, 3, 4}, units.Dimension{L: 1})
+	b := FromSlice([]float64{4, 3, 2, 1}, units.Dimension{L: 1})
+	sum, err := a.Add(b)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	for i, x := range sum.ToSlice() {
+		if x != 5 {
+			t.Errorf("Add()[%d] = %v, want 5", i, x)
+		}
+	}
+	if diff, _ := a.Subtract(a); !diff.IsZero() {
+		t.Errorf("a - a = %v, want zero", diff)
+	}
+	dot, _ := a.Dot(b)
+	if dot.Val() != 20 || dot.Dim() != (units.Dimension{L: 2}) {
+		t.Errorf("Dot() = %v, want 20 m²", dot)
+	}
+	if a.Scale(2).At(3).Val() != 8 || a.At(3).Val() != 4 {
+		t.Error("Scale() should return a scaled copy")
+	}
+	u, _ := a.Normalize()
+	if m, _ := u.Magnitude(); !almostEqual(m.Val(), 1, 1e-15) || !m.IsDimensionless() {
+		t.Errorf("Normalize() magnitude = %v, want 1", m)
+	}
+
+	if _, err := a.Add(ZeroN(3, units.Dimension{L: 1})); err == nil {
+		t.Error("Add() should fail with mismatched lengths")
+	}
+	if _, err := a.Add(ZeroN(4, units.Dimension{T: 1})); err == nil {
+		t.Error("Add() should fail with mismatched dimensions")
+	}
+	if _, err := a.Dot(ZeroN(2, units.Dimension{})); err == nil {
+		t.Error("Dot() should fail with mismatched lengths")
+	}
+}
+
+func TestVectorNConversion(t *testing.T) {
+	v := NewVelocity(units.MeterPerSecond(1), units.MeterPerSecond(2), units.MeterPerSecond(3))
+	n := v.ToVectorN()
+	back, err := n.ToVector3()
+	if err != nil || back != v {
+		t.Errorf("ToVectorN().ToVector3() = %v, %v, want %v", back, err, v)
+	}
+	if _, err := n.ToVector2(); err == nil {
+		t.Error("ToVector2() should fail for length 3")
+	}
+	p := NewPosition2(units.Meter(1), units.Meter(2))
+	if q, err := p.ToVectorN().ToVector2(); err != nil || q != p {
+		t.Errorf("Vector2 round trip = %v, %v, want %v", q, err, p)
+	}
+}
@@ -0,0 +1,152 @@
+package montecarlo
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Distribution is a probability distribution over a physical quantity.
+type Distribution interface {
+	// Dim returns the dimension of the sampled values.
+	Dim() units.Dimension
+	// Sample draws a value in SI base units.
+	Sample(rng *rand.Rand) float64
+}
+
+// -----------------------------------------------------------------------------
+// Fixed
+// -----------------------------------------------------------------------------
+
+type fixed struct {
+	v units.Value
+}
+
+// Fixed returns a distribution that always yields v, for parameters held
+// constant in an experiment.
+func Fixed(v units.Value) Distribution {
+	return fixed{v}
+}
+
+func (d fixed) Dim() units.Dimension          { return d.v.Dim() }
+func (d fixed) Sample(rng *rand.Rand) float64 { return d.v.Val() }
+
+// -----------------------------------------------------------------------------
+// Uniform
+// -----------------------------------------------------------------------------
+
+type uniform struct {
+	lo, hi float64
+	dim    units.Dimension
+}
+
+// Uniform returns the uniform distribution on [lo, hi). Returns an error if
+// the bounds differ in dimension or are not ordered.
+func Uniform(lo, hi units.Value) (Distribution, error) {
+	if lo.Dim() != hi.Dim() {
+		return nil, fmt.Errorf("bounds must have the same dimension, got %s and %s", lo.Dim(), hi.Dim())
+	}
+	if !(lo.Val() < hi.Val()) {
+		return nil, fmt.Errorf("lower bound must be below upper bound, got [%g, %g]", lo.Val(), hi.Val())
+	}
+	return uniform{lo.Val(), hi.Val(), lo.Dim()}, nil
+}
+
+func (d uniform) Dim() units.Dimension { return d.dim }
+
+func (d uniform) Sample(rng *rand.Rand) float64 {
+	return d.lo + (d.hi-d.lo)*rng.Float64()
+}
+
+// -----------------------------------------------------------------------------
+// Normal
+// -----------------------------------------------------------------------------
+
+type normal struct {
+	mean, sigma float64
+	dim         units.Dimension
+}
+
+// Normal returns the Gaussian distribution with the given mean and standard
+// deviation, such as a measured value and its standard uncertainty. Its
+// tails are unbounded, so strictly positive parameters with large relative
+// uncertainty are better described by LogNormal.
+func Normal(mean, sigma units.Value) (Distribution, error) {
+	if mean.Dim() != sigma.Dim() {
+		return nil, fmt.Errorf("mean and standard deviation must have the same dimension, got %s and %s", mean.Dim(), sigma.Dim())
+	}
+	if !(sigma.Val() > 0) {
+		return nil, fmt.Errorf("standard deviation must be positive, got %g", sigma.Val())
+	}
+	return normal{mean.Val(), sigma.Val(), mean.Dim()}, nil
+}
+
+func (d normal) Dim() units.Dimension { return d.dim }
+
+func (d normal) Sample(rng *rand.Rand) float64 {
+	return d.mean + d.sigma*rng.NormFloat64()
+}
+
+// -----------------------------------------------------------------------------
+// Log-normal
+// -----------------------------------------------------------------------------
+
+type logNormal struct {
+	mu, sigma float64
+	dim       units.Dimension
+}
+
+// LogNormal returns the distribution of median·F^z for a standard normal
+// z: a positive quantity known to within a multiplicative factor F > 1 at
+// one standard deviation, such as a cross section "uncertain by a factor
+// of 2".
+func LogNormal(median units.Value, factor float64) (Distribution, error) {
+	if !(median.Val() > 0) {
+		return nil, fmt.Errorf("median must be positive, got %g", median.Val())
+	}
+	if !(factor > 1) || math.IsInf(factor, 1) {
+		return nil, fmt.Errorf("uncertainty factor must be finite and above 1, got %g", factor)
+	}
+	return logNormal{math.Log(median.Val()), math.Log(factor), median.Dim()}, nil
+}
+
+func (d logNormal) Dim() units.Dimension { return d.dim }
+
+func (d logNormal) Sample(rng *rand.Rand) float64 {
+	return math.Exp(d.mu + d.sigma*rng.NormFloat64())
+}
+
+// -----------------------------------------------------------------------------
+// Triangular
+// -----------------------------------------------------------------------------
+
+type triangular struct {
+	lo, mode, hi float64
+	dim          units.Dimension
+}
+
+// Triangular returns the triangular distribution on [lo, hi] peaking at
+// mode, for parameters given as a best estimate with hard limits.
+func Triangular(lo, mode, hi units.Value) (Distribution, error) {
+	if lo.Dim() != mode.Dim() || mode.Dim() != hi.Dim() {
+		return nil, fmt.Errorf("bounds and mode must have the same dimension, got %s, %s and %s", lo.Dim(), mode.Dim(), hi.Dim())
+	}
+	if !(lo.Val() <= mode.Val() && mode.Val() <= hi.Val() && lo.Val() < hi.Val()) {
+		return nil, fmt.Errorf("need lo ≤ mode ≤ hi with lo < hi, got %g, %g, %g", lo.Val(), mode.Val(), hi.Val())
+	}
+	return triangular{lo.Val(), mode.Val(), hi.Val(), lo.Dim()}, nil
+}
+
+func (d triangular) Dim() units.Dimension { return d.dim }
+
+// Sample inverts the cumulative distribution function.
+func (d triangular) Sample(rng *rand.Rand) float64 {
+	u := rng.Float64()
+	width := d.hi - d.lo
+	if c := (d.mode - d.lo) / width; u < c {
+		return d.lo + math.Sqrt(u*width*(d.mode-d.lo))
+	}
+	return d.hi - math.Sqrt((1-u)*width*(d.hi-d.mode))
+}
@@ -0,0 +1,44 @@
+// Package montecarlo runs ensembles of simulations over uncertain,
+// unit-typed parameters and summarizes the spread of their outputs.
+//
+// An Experiment draws each parameter from a Distribution (Fixed, Uniform,
+// Normal, LogNormal, Triangular) carrying a physical dimension, runs a
+// Model once per ensemble member, and collects every output quantity with
+// its dimension. The Result reports means with standard errors and
+// quantiles with distribution-free uncertainties, so it is clear how many
+// members an answer needs, and Sensitivity ranks the parameters by the
+// Spearman rank correlation of each with an output.
+//
+// Parameters are drawn from a caller-supplied *rand.Rand before any model
+// runs. With a repro.Run or a seeded random.Source the ensemble is
+// reproducible, also when the members are evaluated in parallel on a
+// parallel.Pool.
+//
+// Example usage:
+//
+//	import (
+//	    "context"
+//	    "math/rand"
+//
+//	    "github.com/sakiphan/qsim-core/montecarlo"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	exp := montecarlo.NewExperiment(model)
+//	mass, _ := montecarlo.Normal(units.Kilogram(1).Value, units.Gram(5).Value)
+//	drag, _ := montecarlo.LogNormal(units.Dimensionless(0.47), 1.2)
+//	exp.Vary("mass", mass)
+//	exp.Vary("cd", drag)
+//
+//	res, _ := exp.Run(context.Background(), 5000, rand.New(rand.NewSource(1)))
+//	s, _ := res.Summary("range")
+//	p95, _ := res.Quantile("range", 0.95)
+//	sens, _ := res.Sensitivity("range") // e.g. map[cd:-0.93 mass:0.31]
+//
+// References:
+//   - Metropolis, Ulam. "The Monte Carlo method", J. Am. Stat. Assoc. 44,
+//     335 (1949)
+//   - JCGM 101:2008. "Evaluation of measurement data — Supplement 1 to the
+//     GUM: Propagation of distributions using a Monte Carlo method"
+//   - Saltelli et al. "Global Sensitivity Analysis: The Primer", Wiley (2008)
+package montecarlo
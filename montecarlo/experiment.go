@@ -0,0 +1,173 @@
+package montecarlo
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/sakiphan/qsim-core/parallel"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Values holds named quantities: the parameters of one ensemble member or
+// the outputs of one model evaluation.
+type Values map[string]units.Value
+
+// Model runs one member of the ensemble with the given parameters and
+// returns its outputs. Every call must return the same output names with
+// the same dimensions. With a pool set, calls run concurrently and must not
+// share mutable state.
+type Model func(ctx context.Context, params Values) (Values, error)
+
+// Experiment runs a model over parameters drawn from distributions.
+//
+// Example:
+//
+//	// Range of a projectile launched at 45° with an uncertain speed
+//	g := constants.StandardGravity.Val()
+//	exp := montecarlo.NewExperiment(func(ctx context.Context, p montecarlo.Values) (montecarlo.Values, error) {
+//	    v := p["speed"].Val()
+//	    return montecarlo.Values{"range": units.Meter(v * v / g).Value}, nil
+//	})
+//	speed, _ := montecarlo.Normal(units.MeterPerSecond(20).Value, units.MeterPerSecond(0.5).Value)
+//	exp.Vary("speed", speed)
+//	res, _ := exp.Run(ctx, 10000, rand.New(random.NewSource(1)))
+//	s, _ := res.Summary("range") // s.Mean ≈ 40.8 ± 0.02 m
+type Experiment struct {
+	model Model
+	names []string
+	dists []Distribution
+	pool  *parallel.Pool
+}
+
+// NewExperiment creates an experiment running model.
+func NewExperiment(model Model) *Experiment {
+	return &Experiment{model: model}
+}
+
+// Vary adds a parameter drawn from d. Returns an error if the name is
+// empty or already taken.
+func (e *Experiment) Vary(name string, d Distribution) error {
+	if name == "" {
+		return fmt.Errorf("parameter name must not be empty")
+	}
+	for _, n := range e.names {
+		if n == name {
+			return fmt.Errorf("parameter %q already added", name)
+		}
+	}
+	e.names = append(e.names, name)
+	e.dists = append(e.dists, d)
+	return nil
+}
+
+// SetPool makes Run evaluate ensemble members on the given pool; nil
+// restores serial evaluation.
+func (e *Experiment) SetPool(p *parallel.Pool) {
+	e.pool = p
+}
+
+// Run evaluates n ensemble members. All parameters are drawn from rng in
+// member order, and within a member in the order they were added, before
+// any model runs; results thus depend only on the state of rng and not on
+// the pool or scheduling.
+//
+// A model error stops the run and is returned for the lowest failing
+// member. If ctx is done, the members completed so far are returned with
+// an error wrapping the context's error.
+func (e *Experiment) Run(ctx context.Context, n int, rng *rand.Rand) (*Result, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("number of samples must be positive, got %d", n)
+	}
+	params := make([]Values, n)
+	for i := range params {
+		p := make(Values, len(e.names))
+		for k, d := range e.dists {
+			p[e.names[k]] = units.NewValue(d.Sample(rng), d.Dim())
+		}
+		params[i] = p
+	}
+
+	outputs := make([]Values, n)
+	errs := make([]error, n)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	e.pool.For(n, 1, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			if ctx.Err() != nil {
+				return
+			}
+			out, err := e.model(ctx, params[i])
+			if err != nil {
+				errs[i] = err
+				cancel()
+				return
+			}
+			outputs[i] = out
+		}
+	})
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("sample %d: %w", i, err)
+		}
+	}
+	res, err := e.collect(params, outputs)
+	if err != nil {
+		return nil, err
+	}
+	if res.n < n {
+		return res, fmt.Errorf("run stopped after %d of %d samples: %w", res.n, n, context.Cause(ctx))
+	}
+	return res, nil
+}
+
+// collect gathers the completed members into a Result.
+func (e *Experiment) collect(params, outputs []Values) (*Result, error) {
+	res := &Result{
+		paramNames: append([]string(nil), e.names...),
+		columns:    map[string]*column{},
+		varied:     map[string]bool{},
+	}
+	for k, name := range e.names {
+		res.columns[name] = &column{dim: e.dists[k].Dim()}
+		_, fixed := e.dists[k].(fixed)
+		res.varied[name] = !fixed
+	}
+	for i, out := range outputs {
+		if out == nil {
+			continue
+		}
+		if res.n == 0 {
+			for name, v := range out {
+				if _, ok := res.columns[name]; ok {
+					return nil, fmt.Errorf("output %q has the name of a parameter", name)
+				}
+				res.outputNames = append(res.outputNames, name)
+				res.columns[name] = &column{dim: v.Dim()}
+			}
+			sort.Strings(res.outputNames)
+		}
+		if len(out) != len(res.outputNames) {
+			return nil, fmt.Errorf("sample %d: model returned %d outputs, want %d", i, len(out), len(res.outputNames))
+		}
+		for _, name := range res.outputNames {
+			v, ok := out[name]
+			if !ok {
+				return nil, fmt.Errorf("sample %d: model did not return output %q", i, name)
+			}
+			c := res.columns[name]
+			if v.Dim() != c.dim {
+				return nil, fmt.Errorf("sample %d: output %q has dimension %s, want %s", i, name, v.Dim(), c.dim)
+			}
+			c.x = append(c.x, v.Val())
+		}
+		for _, name := range e.names {
+			c := res.columns[name]
+			c.x = append(c.x, params[i][name].Val())
+		}
+		res.n++
+	}
+	return res, nil
+}
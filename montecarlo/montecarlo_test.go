@@ -0,0 +1,208 @@
+package montecarlo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/random"
+	"github.com/sakiphan/qsim-core/parallel"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func TestDistributions(t *testing.T) {
+	m := func(x float64) units.Value { return units.Meter(x).Value }
+	uni, _ := Uniform(m(2), m(4))
+	nor, _ := Normal(m(10), m(0.5))
+	logn, _ := LogNormal(m(3), 2)
+	tri, _ := Triangular(m(0), m(1), m(5))
+
+	tests := []struct {
+		name         string
+		d            Distribution
+		mean, stddev float64
+	}{
+		{"fixed", Fixed(m(7)), 7, 0},
+		{"uniform", uni, 3, 2 / math.Sqrt(12)},
+		{"normal", nor, 10, 0.5},
+		{"lognormal", logn, 3 * math.Exp(0.5*math.Ln2*math.Ln2), 3 * math.Sqrt((math.Exp(math.Ln2*math.Ln2)-1)*math.Exp(math.Ln2*math.Ln2))},
+		{"triangular", tri, 2, math.Sqrt((0 + 1 + 25 - 0 - 0 - 5) / 18.0)},
+	}
+	const n = 200000
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.d.Dim() != units.Meter(1).Dim() {
+				t.Errorf("dimension = %s", tt.d.Dim())
+			}
+			rng := rand.New(random.NewSource(3))
+			var sum, sum2 float64
+			for i := 0; i < n; i++ {
+				x := tt.d.Sample(rng)
+				sum += x
+				sum2 += x * x
+			}
+			mean := sum / n
+			sd := math.Sqrt(sum2/n - mean*mean)
+			if !almostEqual(mean, tt.mean, 5*tt.stddev/math.Sqrt(n)+1e-12) {
+				t.Errorf("mean = %v, want %v", mean, tt.mean)
+			}
+			if !almostEqual(sd, tt.stddev, 0.02*tt.stddev+1e-6) {
+				t.Errorf("standard deviation = %v, want %v", sd, tt.stddev)
+			}
+		})
+	}
+
+	if _, err := Uniform(m(1), units.Second(2).Value); err == nil {
+		t.Error("expected error for mixed dimensions")
+	}
+	if _, err := Normal(m(1), m(-1)); err == nil {
+		t.Error("expected error for negative standard deviation")
+	}
+	if _, err := LogNormal(m(1), 1); err == nil {
+		t.Error("expected error for factor 1")
+	}
+	if _, err := Triangular(m(0), m(6), m(5)); err == nil {
+		t.Error("expected error for mode outside bounds")
+	}
+}
+
+// linear is the model y = 3a + 0.1b + c.
+func linear(ctx context.Context, p Values) (Values, error) {
+	y := 3*p["a"].Val() + 0.1*p["b"].Val() + p["c"].Val()
+	return Values{"y": units.Meter(y).Value, "twice_a": units.Meter(2 * p["a"].Val()).Value}, nil
+}
+
+func newLinear(t *testing.T) *Experiment {
+	a, _ := Normal(units.Meter(10).Value, units.Meter(1).Value)
+	b, _ := Uniform(units.Meter(0).Value, units.Meter(1).Value)
+	e := NewExperiment(linear)
+	e.Vary("a", a)
+	e.Vary("b", b)
+	e.Vary("c", Fixed(units.Meter(5).Value))
+	return e
+}
+
+func TestExperiment(t *testing.T) {
+	e := newLinear(t)
+	if err := e.Vary("a", Fixed(units.Meter(1).Value)); err == nil {
+		t.Error("expected error for duplicate parameter")
+	}
+	res, err := e.Run(context.Background(), 20000, rand.New(random.NewSource(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.N() != 20000 || strings.Join(res.Outputs(), ",") != "twice_a,y" {
+		t.Fatalf("N = %d, outputs = %v", res.N(), res.Outputs())
+	}
+
+	s, err := res.Summary("y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSD := math.Sqrt(9 + 0.01/12)
+	if !almostEqual(s.StdDev.Val(), wantSD, 0.03*wantSD) {
+		t.Errorf("σ = %v, want %v", s.StdDev, wantSD)
+	}
+	if !almostEqual(s.Mean.Uncertainty.Val(), wantSD/math.Sqrt(20000), 1e-3) ||
+		!almostEqual(s.Mean.Value.Val(), 35.05, 4*s.Mean.Uncertainty.Val()) {
+		t.Errorf("mean = %v, want 35.05", s.Mean)
+	}
+	if s.Mean.Value.Dim() != units.Meter(1).Dim() || !(s.Min.Val() < s.Median.Value.Val() && s.Median.Value.Val() < s.Max.Val()) {
+		t.Errorf("summary = %+v", s)
+	}
+
+	// The 84th percentile of a normal lies one σ above the mean; its
+	// uncertainty is about 1.5 times that of the mean.
+	q, _ := res.Quantile("y", 0.8413)
+	if !almostEqual(q.Value.Val(), 35.05+wantSD, 4*q.Uncertainty.Val()) {
+		t.Errorf("84th percentile = %v, want %v", q, 35.05+wantSD)
+	}
+	if r := q.Uncertainty.Val() / s.Mean.Uncertainty.Val(); r < 1.1 || r > 2.2 {
+		t.Errorf("quantile uncertainty / mean uncertainty = %v", r)
+	}
+	if !strings.Contains(q.String(), " ± ") {
+		t.Errorf("String() = %q", q.String())
+	}
+	if _, err := res.Quantile("y", 1.5); err == nil {
+		t.Error("expected error for q > 1")
+	}
+	if _, err := res.Summary("z"); err == nil {
+		t.Error("expected error for unknown output")
+	}
+
+	sens, _ := res.Sensitivity("y")
+	if len(sens) != 2 || sens["a"] < 0.99 || math.Abs(sens["b"]) > 0.1 {
+		t.Errorf("sensitivity = %v", sens)
+	}
+	sens, _ = res.Sensitivity("twice_a")
+	if !almostEqual(sens["a"], 1, 1e-12) {
+		t.Errorf("rank correlation of a monotonic function = %v, want 1", sens["a"])
+	}
+}
+
+func TestExperimentParallel(t *testing.T) {
+	serial, _ := newLinear(t).Run(context.Background(), 1000, rand.New(random.NewSource(9)))
+
+	pool := parallel.NewPool(4)
+	defer pool.Close()
+	e := newLinear(t)
+	e.SetPool(pool)
+	par, err := e.Run(context.Background(), 1000, rand.New(random.NewSource(9)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := serial.Samples("y")
+	b, _ := par.Samples("y")
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("sample %d: %v serial, %v parallel", i, a[i], b[i])
+		}
+	}
+}
+
+func TestExperimentErrors(t *testing.T) {
+	rng := rand.New(random.NewSource(1))
+	e := NewExperiment(func(ctx context.Context, p Values) (Values, error) {
+		if p["x"].Val() > 0.5 {
+			return nil, errors.New("unstable")
+		}
+		return Values{"y": p["x"]}, nil
+	})
+	x, _ := Uniform(units.Dimensionless(0), units.Dimensionless(1))
+	e.Vary("x", x)
+	if _, err := e.Run(context.Background(), 100, rng); err == nil || !strings.Contains(err.Error(), "unstable") {
+		t.Errorf("err = %v, want the model's error", err)
+	}
+
+	calls := 0
+	e = NewExperiment(func(ctx context.Context, p Values) (Values, error) {
+		if calls++; calls == 2 {
+			return Values{"y": units.Second(1).Value}, nil
+		}
+		return Values{"y": units.Meter(1).Value}, nil
+	})
+	if _, err := e.Run(context.Background(), 3, rng); err == nil || !strings.Contains(err.Error(), "dimension") {
+		t.Errorf("err = %v, want a dimension error", err)
+	}
+
+	// Cancelling keeps the completed members.
+	ctx, cancel := context.WithCancel(context.Background())
+	calls = 0
+	e = NewExperiment(func(ctx context.Context, p Values) (Values, error) {
+		if calls++; calls == 10 {
+			cancel()
+		}
+		return Values{"y": units.Meter(1).Value}, nil
+	})
+	res, err := e.Run(ctx, 100, rng)
+	if !errors.Is(err, context.Canceled) || res == nil || res.N() != 10 {
+		t.Errorf("err = %v, result = %v", err, res)
+	}
+}
@@ -0,0 +1,230 @@
+package montecarlo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Estimate is a statistic of an ensemble with its standard uncertainty.
+type Estimate struct {
+	Value       units.Value
+	Uncertainty units.Value
+}
+
+// String formats the estimate as "value ± uncertainty unit".
+func (e Estimate) String() string {
+	return fmt.Sprintf("%.6g ± %.2g %s", e.Value.Val(), e.Uncertainty.Val(), e.Value.Dim().String())
+}
+
+// Summary describes the distribution of one quantity over an ensemble.
+type Summary struct {
+	N      int
+	Mean   Estimate    // sample mean ± standard error σ/√N
+	StdDev units.Value // sample standard deviation σ
+	Min    units.Value
+	Median Estimate
+	Max    units.Value
+}
+
+// Result holds the parameters and outputs of every completed ensemble
+// member. Result caches sorted samples and is not safe for concurrent use.
+type Result struct {
+	n           int
+	paramNames  []string
+	outputNames []string
+	columns     map[string]*column
+	varied      map[string]bool // parameters not drawn from Fixed
+}
+
+// column holds the samples of one quantity in SI base units, in member
+// order.
+type column struct {
+	dim    units.Dimension
+	x      []float64
+	sorted []float64
+}
+
+// N returns the number of completed ensemble members.
+func (r *Result) N() int {
+	return r.n
+}
+
+// Params returns the parameter names in the order they were added.
+func (r *Result) Params() []string {
+	return append([]string(nil), r.paramNames...)
+}
+
+// Outputs returns the output names in alphabetical order.
+func (r *Result) Outputs() []string {
+	return append([]string(nil), r.outputNames...)
+}
+
+// Samples returns the values of a parameter or output, in member order.
+func (r *Result) Samples(name string) ([]units.Value, error) {
+	c, err := r.column(name)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]units.Value, len(c.x))
+	for i, x := range c.x {
+		out[i] = units.NewValue(x, c.dim)
+	}
+	return out, nil
+}
+
+// Summary returns the mean, spread and range of a parameter or output.
+// Needs at least two members.
+func (r *Result) Summary(name string) (Summary, error) {
+	c, err := r.column(name)
+	if err != nil {
+		return Summary{}, err
+	}
+	n := len(c.x)
+	if n < 2 {
+		return Summary{}, fmt.Errorf("summary of %q needs at least 2 samples, got %d", name, n)
+	}
+	mean := 0.0
+	for _, x := range c.x {
+		mean += x
+	}
+	mean /= float64(n)
+	ss := 0.0
+	for _, x := range c.x {
+		ss += (x - mean) * (x - mean)
+	}
+	sigma := math.Sqrt(ss / float64(n-1))
+	s := c.sort()
+	return Summary{
+		N:      n,
+		Mean:   Estimate{units.NewValue(mean, c.dim), units.NewValue(sigma/math.Sqrt(float64(n)), c.dim)},
+		StdDev: units.NewValue(sigma, c.dim),
+		Min:    units.NewValue(s[0], c.dim),
+		Median: c.quantile(0.5),
+		Max:    units.NewValue(s[n-1], c.dim),
+	}, nil
+}
+
+// Quantile returns the q-quantile of a parameter or output, 0 ≤ q ≤ 1, by
+// linear interpolation between order statistics. Its uncertainty is half
+// the width of the distribution-free 68% confidence interval between the
+// order statistics of rank Nq ∓ √(Nq(1-q)), so no assumption about the
+// shape of the distribution is made.
+//
+// References:
+//   - Hyndman, Fan. "Sample quantiles in statistical packages",
+//     Am. Stat. 50, 361 (1996), definition 7
+//   - David, Nagaraja. "Order Statistics", 3rd ed., Sec. 7.1
+func (r *Result) Quantile(name string, q float64) (Estimate, error) {
+	if !(q >= 0 && q <= 1) {
+		return Estimate{}, fmt.Errorf("quantile must be within [0, 1], got %g", q)
+	}
+	c, err := r.column(name)
+	if err != nil {
+		return Estimate{}, err
+	}
+	if len(c.x) == 0 {
+		return Estimate{}, fmt.Errorf("no samples of %q", name)
+	}
+	return c.quantile(q), nil
+}
+
+// Sensitivity returns the Spearman rank correlation between an output and
+// each varied parameter, in [-1, 1]. Rank correlation captures any
+// monotonic dependence, so its magnitude ranks the parameters by influence
+// on the output even for non-linear models. Parameters drawn from Fixed are
+// omitted.
+func (r *Result) Sensitivity(output string) (map[string]float64, error) {
+	c, err := r.column(output)
+	if err != nil {
+		return nil, err
+	}
+	if r.n < 3 {
+		return nil, fmt.Errorf("sensitivity needs at least 3 samples, got %d", r.n)
+	}
+	ry := ranks(c.x)
+	out := map[string]float64{}
+	for _, name := range r.paramNames {
+		if r.varied[name] && name != output {
+			out[name] = correlation(ranks(r.columns[name].x), ry)
+		}
+	}
+	return out, nil
+}
+
+func (r *Result) column(name string) (*column, error) {
+	c, ok := r.columns[name]
+	if !ok {
+		return nil, fmt.Errorf("no parameter or output named %q", name)
+	}
+	return c, nil
+}
+
+// sort returns the samples in ascending order, caching them.
+func (c *column) sort() []float64 {
+	if len(c.sorted) != len(c.x) {
+		c.sorted = append([]float64(nil), c.x...)
+		sort.Float64s(c.sorted)
+	}
+	return c.sorted
+}
+
+func (c *column) quantile(q float64) Estimate {
+	s := c.sort()
+	n := len(s)
+	h := q * float64(n-1)
+	lo := int(math.Floor(h))
+	v := s[lo]
+	if lo+1 < n {
+		v += (h - float64(lo)) * (s[lo+1] - s[lo])
+	}
+	spread := math.Sqrt(float64(n) * q * (1 - q))
+	j := max(int(math.Floor(float64(n)*q-spread)), 0)
+	k := min(int(math.Ceil(float64(n)*q+spread)), n-1)
+	return Estimate{units.NewValue(v, c.dim), units.NewValue((s[k]-s[j])/2, c.dim)}
+}
+
+// ranks returns the rank of each sample, averaging over ties.
+func ranks(x []float64) []float64 {
+	idx := make([]int, len(x))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return x[idx[a]] < x[idx[b]] })
+	r := make([]float64, len(x))
+	for a := 0; a < len(idx); {
+		b := a
+		for b+1 < len(idx) && x[idx[b+1]] == x[idx[a]] {
+			b++
+		}
+		for k := a; k <= b; k++ {
+			r[idx[k]] = float64(a+b) / 2
+		}
+		a = b + 1
+	}
+	return r
+}
+
+// correlation returns the Pearson correlation coefficient of x and y, or
+// zero if either is constant.
+func correlation(x, y []float64) float64 {
+	n := float64(len(x))
+	var mx, my float64
+	for i := range x {
+		mx += x[i]
+		my += y[i]
+	}
+	mx, my = mx/n, my/n
+	var sxy, sxx, syy float64
+	for i := range x {
+		sxy += (x[i] - mx) * (y[i] - my)
+		sxx += (x[i] - mx) * (x[i] - mx)
+		syy += (y[i] - my) * (y[i] - my)
+	}
+	if sxx == 0 || syy == 0 {
+		return 0
+	}
+	return sxy / math.Sqrt(sxx*syy)
+}
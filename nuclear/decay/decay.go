@@ -0,0 +1,193 @@
+package decay
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Lifetime Conversions
+// -----------------------------------------------------------------------------
+
+// DecayConstant returns λ = ln 2 / t½.
+// Returns an error if the half-life is not positive.
+func DecayConstant(halfLife units.Time) (units.Frequency, error) {
+	if err := checkHalfLife(halfLife); err != nil {
+		return units.Frequency{}, err
+	}
+	return units.Hertz(math.Ln2 / halfLife.Val()), nil
+}
+
+// HalfLife returns t½ = ln 2 / λ.
+// Returns an error if the decay constant is not positive.
+func HalfLife(lambda units.Frequency) (units.Time, error) {
+	if !(lambda.Val() > 0) {
+		return units.Time{}, fmt.Errorf("decay constant must be positive, got %g s⁻¹", lambda.Val())
+	}
+	return units.Second(math.Ln2 / lambda.Val()), nil
+}
+
+// MeanLifetime returns τ = t½ / ln 2.
+func MeanLifetime(halfLife units.Time) units.Time {
+	return units.Second(halfLife.Val() / math.Ln2)
+}
+
+// HalfLifeFromMeanLifetime returns t½ = τ ln 2.
+func HalfLifeFromMeanLifetime(tau units.Time) units.Time {
+	return units.Second(tau.Val() * math.Ln2)
+}
+
+func checkHalfLife(halfLife units.Time) error {
+	if !(halfLife.Val() > 0) {
+		return fmt.Errorf("half-life must be positive, got %g s", halfLife.Val())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Single Nuclide
+// -----------------------------------------------------------------------------
+
+// Atoms returns the number of nuclei N = n N_A in an amount of substance.
+func Atoms(amount units.Amount) float64 {
+	return amount.Val() * constants.AvogadroConstant.Val()
+}
+
+// Remaining returns the number of nuclei N(t) = N₀ e^(-λt) left after
+// time t. Returns an error if the half-life is not positive.
+func Remaining(n0 float64, halfLife, t units.Time) (float64, error) {
+	if err := checkHalfLife(halfLife); err != nil {
+		return 0, err
+	}
+	return n0 * math.Exp(-math.Ln2*t.Val()/halfLife.Val()), nil
+}
+
+// Activity returns the activity A = λN of N nuclei.
+// Returns an error if the half-life is not positive.
+func Activity(n float64, halfLife units.Time) (units.Activity, error) {
+	lambda, err := DecayConstant(halfLife)
+	if err != nil {
+		return units.Activity{}, err
+	}
+	return units.Becquerel(lambda.Val() * n), nil
+}
+
+// ActivityAt returns the activity A(t) = A₀ e^(-λt) of a sample with
+// initial activity A₀. Returns an error if the half-life is not positive.
+func ActivityAt(a0 units.Activity, halfLife, t units.Time) (units.Activity, error) {
+	a, err := Remaining(a0.Val(), halfLife, t)
+	if err != nil {
+		return units.Activity{}, err
+	}
+	return units.Becquerel(a), nil
+}
+
+// -----------------------------------------------------------------------------
+// Decay Chains
+// -----------------------------------------------------------------------------
+
+// Nuclide is a member of a decay chain. A zero HalfLife marks a stable
+// nuclide.
+type Nuclide struct {
+	Name     string
+	HalfLife units.Time
+}
+
+// lambda returns the decay constant in s⁻¹, zero for a stable nuclide.
+func (n Nuclide) lambda() float64 {
+	if n.HalfLife.Val() == 0 {
+		return 0
+	}
+	return math.Ln2 / n.HalfLife.Val()
+}
+
+// Chain is a linear decay chain in which each nuclide decays into the next.
+type Chain []Nuclide
+
+// Populations returns the number of nuclei of each member at time t, given
+// initial populations n0 with one entry per member. Returns an error if
+// the lengths differ, t or any half-life is negative, or two radioactive
+// members that feed one another have equal half-lives, for which the
+// Bateman solution is degenerate.
+//
+// Formula, for the contribution of member i to member j ≥ i:
+//
+//	N_j(t) = N_i(0) (∏_{k=i}^{j-1} λ_k) Σ_{m=i}^{j} e^(-λ_m t) / ∏_{p≠m} (λ_p - λ_m)
+func (c Chain) Populations(n0 []float64, t units.Time) ([]float64, error) {
+	if err := c.check(n0, t); err != nil {
+		return nil, err
+	}
+	lambda := make([]float64, len(c))
+	for i, n := range c {
+		lambda[i] = n.lambda()
+	}
+
+	out := make([]float64, len(c))
+	for i := range c {
+		if n0[i] == 0 {
+			continue
+		}
+		feed := n0[i] // N_i(0) ∏ λ_k so far
+		for j := i; j < len(c); j++ {
+			sum := 0.0
+			for m := i; m <= j; m++ {
+				den := 1.0
+				for p := i; p <= j; p++ {
+					if p != m {
+						den *= lambda[p] - lambda[m]
+					}
+				}
+				if den == 0 {
+					return nil, fmt.Errorf("%s and another member of the chain have equal decay constants", c.name(m))
+				}
+				sum += math.Exp(-lambda[m]*t.Val()) / den
+			}
+			out[j] += feed * sum
+			feed *= lambda[j]
+			if feed == 0 {
+				break // stable member: nothing further down is fed
+			}
+		}
+	}
+	return out, nil
+}
+
+// Activities returns the activity λ_j N_j(t) of each member at time t.
+// Errors are as for Populations.
+func (c Chain) Activities(n0 []float64, t units.Time) ([]units.Activity, error) {
+	pops, err := c.Populations(n0, t)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]units.Activity, len(c))
+	for i, n := range c {
+		out[i] = units.Becquerel(n.lambda() * pops[i])
+	}
+	return out, nil
+}
+
+func (c Chain) check(n0 []float64, t units.Time) error {
+	if len(n0) != len(c) {
+		return fmt.Errorf("need %d initial populations, got %d", len(c), len(n0))
+	}
+	if t.Val() < 0 {
+		return fmt.Errorf("time must be non-negative, got %g s", t.Val())
+	}
+	for i, n := range c {
+		if n.HalfLife.Val() < 0 {
+			return fmt.Errorf("%s has negative half-life %g s", c.name(i), n.HalfLife.Val())
+		}
+	}
+	return nil
+}
+
+// name returns the member's name, or its index if unnamed.
+func (c Chain) name(i int) string {
+	if c[i].Name != "" {
+		return c[i].Name
+	}
+	return fmt.Sprintf("member %d", i)
+}
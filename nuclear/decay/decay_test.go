@@ -0,0 +1,157 @@
+package decay
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestConversions(t *testing.T) {
+	th := units.Year(5730) // C-14
+	lambda, err := DecayConstant(th)
+	if err != nil || !almostEqual(lambda.Val(), math.Ln2/th.Val(), 1e-15) {
+		t.Errorf("DecayConstant() = %v, %v", lambda, err)
+	}
+	if back, _ := HalfLife(lambda); !almostEqual(back.Val(), th.Val(), 1e-15) {
+		t.Errorf("HalfLife(λ) = %v, want %v", back, th)
+	}
+	tau := MeanLifetime(th)
+	if !almostEqual(tau.Val()*lambda.Val(), 1, 1e-15) {
+		t.Errorf("τλ = %v, want 1", tau.Val()*lambda.Val())
+	}
+	if back := HalfLifeFromMeanLifetime(tau); !almostEqual(back.Val(), th.Val(), 1e-15) {
+		t.Errorf("HalfLifeFromMeanLifetime(τ) = %v", back)
+	}
+	if _, err := DecayConstant(units.Second(0)); err == nil {
+		t.Error("DecayConstant should reject zero half-life")
+	}
+	if _, err := HalfLife(units.Hertz(-1)); err == nil {
+		t.Error("HalfLife should reject negative decay constant")
+	}
+}
+
+func TestSingleNuclide(t *testing.T) {
+	th := units.Day(8.0252) // I-131
+	n, err := Remaining(1000, th, units.Day(3*8.0252))
+	if err != nil || !almostEqual(n, 125, 1e-12) {
+		t.Errorf("Remaining after 3 half-lives = %v, %v; want 125", n, err)
+	}
+
+	// 1 g of Ra-226 (t½ = 1600 y) is about 1 Ci, the historical definition.
+	ra := Atoms(units.Mole(1 / 226.025))
+	a, err := Activity(ra, units.Year(1600))
+	if err != nil || !almostEqual(a.ToCuries(), 0.989, 2e-3) {
+		t.Errorf("Activity(1 g Ra-226) = %v Ci, %v; want ≈ 0.989", a.ToCuries(), err)
+	}
+	if later, _ := ActivityAt(a, units.Year(1600), units.Year(3200)); !almostEqual(later.Val(), a.Val()/4, 1e-12) {
+		t.Errorf("ActivityAt(2 t½) = %v, want A₀/4", later)
+	}
+	if _, err := Remaining(1, units.Second(-1), units.Second(1)); err == nil {
+		t.Error("Remaining should reject negative half-life")
+	}
+}
+
+func TestChainParentDaughter(t *testing.T) {
+	ta, tb := units.Hour(65.94), units.Hour(6.0067) // Mo-99 → Tc-99m → Tc-99
+	chain := Chain{{Name: "Mo-99", HalfLife: ta}, {Name: "Tc-99m", HalfLife: tb}, {Name: "Tc-99"}}
+	la, lb := math.Ln2/ta.Val(), math.Ln2/tb.Val()
+	n0 := []float64{1e15, 0, 0}
+
+	for _, hours := range []float64{0, 1, 24, 200} {
+		tt := units.Hour(hours)
+		pops, err := chain.Populations(n0, tt)
+		if err != nil {
+			t.Fatalf("Populations() error = %v", err)
+		}
+		s := tt.Val()
+		wantA := 1e15 * math.Exp(-la*s)
+		wantB := 1e15 * la / (lb - la) * (math.Exp(-la*s) - math.Exp(-lb*s))
+		if !almostEqual(pops[0], wantA, 1e-12) || math.Abs(pops[1]-wantB) > 1e-9*1e15 {
+			t.Errorf("t = %v h: pops = %v, want %v, %v", hours, pops[:2], wantA, wantB)
+		}
+		// Nuclei are conserved.
+		if sum := pops[0] + pops[1] + pops[2]; !almostEqual(sum, 1e15, 1e-9) {
+			t.Errorf("t = %v h: total = %v, want 1e15", hours, sum)
+		}
+	}
+
+	// Transient equilibrium: A_B/A_A → λ_B/(λ_B - λ_A).
+	acts, _ := chain.Activities(n0, units.Hour(200))
+	if r := acts[1].Val() / acts[0].Val(); !almostEqual(r, lb/(lb-la), 1e-6) {
+		t.Errorf("A_B/A_A = %v, want %v", r, lb/(lb-la))
+	}
+	if acts[2].Val() != 0 {
+		t.Errorf("stable activity = %v, want 0", acts[2])
+	}
+}
+
+func TestChainGeneral(t *testing.T) {
+	chain := Chain{
+		{Name: "A", HalfLife: units.Second(10)},
+		{Name: "B", HalfLife: units.Second(3)},
+		{Name: "C", HalfLife: units.Second(50)},
+		{Name: "D"},
+	}
+	n0 := []float64{1000, 200, 50, 7}
+	tEnd := units.Second(12)
+	pops, err := chain.Populations(n0, tEnd)
+	if err != nil {
+		t.Fatalf("Populations() error = %v", err)
+	}
+
+	// Compare with an RK4 integration of dN/dt.
+	lambda := []float64{math.Ln2 / 10, math.Ln2 / 3, math.Ln2 / 50, 0}
+	deriv := func(n []float64) []float64 {
+		d := make([]float64, len(n))
+		for i := range n {
+			d[i] = -lambda[i] * n[i]
+			if i > 0 {
+				d[i] += lambda[i-1] * n[i-1]
+			}
+		}
+		return d
+	}
+	n := append([]float64(nil), n0...)
+	h := 1e-3
+	for step := 0; step < 12000; step++ {
+		k1 := deriv(n)
+		k2 := deriv(axpy(h/2, k1, n))
+		k3 := deriv(axpy(h/2, k2, n))
+		k4 := deriv(axpy(h, k3, n))
+		for i := range n {
+			n[i] += h / 6 * (k1[i] + 2*k2[i] + 2*k3[i] + k4[i])
+		}
+	}
+	for i := range n {
+		if !almostEqual(pops[i], n[i], 1e-9) {
+			t.Errorf("N_%d = %v, want %v", i, pops[i], n[i])
+		}
+	}
+
+	p0, _ := chain.Populations(n0, units.Second(0))
+	for i := range n0 {
+		if !almostEqual(p0[i], n0[i], 1e-12) {
+			t.Errorf("Populations(0) = %v, want %v", p0, n0)
+		}
+	}
+	if _, err := chain.Populations(n0[:2], tEnd); err == nil {
+		t.Error("Populations should reject mismatched lengths")
+	}
+	equal := Chain{{HalfLife: units.Second(5)}, {HalfLife: units.Second(5)}}
+	if _, err := equal.Populations([]float64{1, 0}, tEnd); err == nil {
+		t.Error("Populations should reject equal decay constants")
+	}
+}
+
+func axpy(a float64, x, y []float64) []float64 {
+	out := make([]float64, len(y))
+	for i := range y {
+		out[i] = a*x[i] + y[i]
+	}
+	return out
+}
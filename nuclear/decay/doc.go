@@ -0,0 +1,45 @@
+// Package decay models radioactive decay of single nuclides and of linear
+// decay chains.
+//
+// A nuclide's decay is characterized equivalently by its half-life t½,
+// mean lifetime τ or decay constant λ, related by
+//
+//	λ = ln 2 / t½ = 1/τ
+//
+// Populations are numbers of nuclei, held as float64 so that macroscopic
+// samples and expected values are represented directly; use Atoms to
+// convert an amount of substance. Activities are returned as
+// units.Activity in becquerels.
+//
+// A Chain is a sequence of nuclides each decaying into the next with unit
+// branching ratio. Its populations at time t are given by the Bateman
+// equations, superposed over the initial population of every member. A
+// nuclide with zero HalfLife is stable.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/nuclear/decay"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// 1 μg of Co-60 after 10 years
+//	co60 := units.Year(5.2714)
+//	n0 := decay.Atoms(units.Mole(1e-6 / 59.93))
+//	n, _ := decay.Remaining(n0, co60, units.Year(10)) // ≈ 0.268 n0
+//	a, _ := decay.Activity(n, co60)                    // ≈ 11 MBq
+//
+//	// Mo-99 → Tc-99m generator
+//	chain := decay.Chain{
+//	    {Name: "Mo-99", HalfLife: units.Hour(65.94)},
+//	    {Name: "Tc-99m", HalfLife: units.Hour(6.0067)},
+//	    {Name: "Tc-99"}, // effectively stable
+//	}
+//	pops, _ := chain.Populations([]float64{1e15, 0, 0}, units.Hour(24))
+//
+// References:
+//   - Bateman. "Solution of a system of differential equations occurring
+//     in the theory of radioactive transformations", Proc. Camb. Phil. Soc.
+//     15, 423 (1910)
+//   - Krane. "Introductory Nuclear Physics", Ch. 6
+package decay
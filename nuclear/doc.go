@@ -0,0 +1,41 @@
+// Package nuclear provides nuclear binding energies and reaction energetics.
+//
+// Binding energies are estimated with the semi-empirical (liquid-drop)
+// mass formula of Weizsäcker and Bethe, whose coefficients are held in a
+// LiquidDrop value so that alternative fits can be used. Mass-dependent
+// quantities — mass excesses and Q-values for α and β decay, fission and
+// fusion — are written against the MassModel interface, so the same code
+// runs on the liquid-drop estimate or on a table of measured atomic masses
+// such as isotopes.AME2020, and Residual compares the two nuclide by
+// nuclide.
+//
+// All masses are atomic (neutral-atom) masses, as tabulated in mass
+// evaluations, so electron masses balance automatically in reactions that
+// conserve charge. Nuclides are identified by proton number Z and mass
+// number A; the liquid-drop model treats A = 1 (the free neutron and
+// hydrogen-1) exactly, but is otherwise unreliable below A ≈ 20.
+//
+// See the decay subpackage for decay rates and chains.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/nuclear"
+//	)
+//
+//	// Binding energy of Fe-56
+//	b, _ := nuclear.BindingEnergy(26, 56) // ≈ 495 MeV (measured 492.3)
+//
+//	// α decay of U-238 and neutron-induced fission of U-235
+//	qa, _ := nuclear.QAlpha(nuclear.SEMF, 92, 238) // ≈ 8.0 MeV (measured 4.27; shell effects)
+//	qf, _ := nuclear.QValue(nuclear.SEMF,
+//	    []nuclear.Nuclide{nuclear.Neutron, {Z: 92, A: 235}},
+//	    []nuclear.Nuclide{{Z: 56, A: 141}, {Z: 36, A: 92}, nuclear.Neutron, nuclear.Neutron, nuclear.Neutron},
+//	) // ≈ 180 MeV
+//
+// References:
+//   - Krane. "Introductory Nuclear Physics", Ch. 3, 8, 9
+//   - Rohlf. "Modern Physics from α to Z⁰", Ch. 11
+//   - Wang et al. "The AME 2020 atomic mass evaluation", Chin. Phys. C 45,
+//     030003 (2021)
+package nuclear
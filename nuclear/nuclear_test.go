@@ -0,0 +1,142 @@
+package nuclear
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// table is a MassModel of measured atomic masses in u (AME2020).
+type table map[Nuclide]float64
+
+func (t table) AtomicMass(z, a int) (units.Mass, error) {
+	m, ok := t[Nuclide{Z: z, A: a}]
+	if !ok {
+		return units.Mass{}, fmt.Errorf("no mass for Z = %d, A = %d", z, a)
+	}
+	return units.AtomicMassUnit(m), nil
+}
+
+var ame = table{
+	Neutron:         1.00866491595,
+	Hydrogen:        1.00782503223,
+	Deuteron:        2.01410177812,
+	Triton:          3.01604928132,
+	Helium3:         3.01602932197,
+	Alpha:           4.00260325413,
+	{Z: 26, A: 56}:  55.93493633,
+	{Z: 90, A: 234}: 234.0435999,
+	{Z: 92, A: 238}: 238.0507884,
+	{Z: 11, A: 22}:  21.99443742,
+	{Z: 10, A: 22}:  21.99138511,
+	{Z: 82, A: 208}: 207.9766525,
+	{Z: 6, A: 12}:   12,
+	{Z: 20, A: 40}:  39.96259086,
+	{Z: 50, A: 120}: 119.9022016,
+	{Z: 4, A: 7}:    7.016928717,
+	{Z: 3, A: 7}:    7.016003437,
+	{Z: 79, A: 197}: 196.9665701,
+}
+
+func TestLiquidDrop(t *testing.T) {
+	// The liquid-drop model is good to 1-2% for medium and heavy nuclei; the
+	// doubly magic Pb-208 is underbound by shell effects.
+	for _, n := range []Nuclide{{Z: 20, A: 40}, {Z: 26, A: 56}, {Z: 50, A: 120}, {Z: 79, A: 197}, {Z: 82, A: 208}, {Z: 92, A: 238}} {
+		r, err := Residual(SEMF, ame, n.Z, n.A)
+		if err != nil {
+			t.Fatalf("Residual(%v) error = %v", n, err)
+		}
+		b, _ := BindingEnergyOf(ame, n.Z, n.A)
+		if math.Abs(r.Val()/b.Val()) > 0.015 {
+			t.Errorf("%v: SEMF off by %.2f MeV of %.1f MeV", n, r.ToMeV(), b.ToMeV())
+		}
+	}
+
+	// BindingEnergyOf a LiquidDrop recovers its formula.
+	b, _ := BindingEnergy(26, 56)
+	if bm, _ := BindingEnergyOf(SEMF, 26, 56); !almostEqual(bm.Val(), b.Val(), 1e-9) {
+		t.Errorf("BindingEnergyOf(SEMF) = %v, want %v", bm, b)
+	}
+	// B/A peaks near iron.
+	fe, _ := BindingEnergyPerNucleon(26, 56)
+	u, _ := BindingEnergyPerNucleon(92, 238)
+	ca, _ := BindingEnergyPerNucleon(20, 40)
+	if fe.Val() <= u.Val() || fe.Val() <= ca.Val() {
+		t.Errorf("B/A: Fe %v, U %v, Ca %v MeV", fe.ToMeV(), u.ToMeV(), ca.ToMeV())
+	}
+	// Pairing: even-even is more bound than its odd-odd isobar neighbours.
+	ee, _ := BindingEnergy(10, 22)
+	oo, _ := BindingEnergy(11, 22)
+	if ee.Val()-oo.Val() <= 2*SEMF.Pairing.Val()*math.Pow(22, -0.75)-1e-20 {
+		t.Error("pairing term not applied")
+	}
+	if b, _ := BindingEnergy(0, 1); b.Val() != 0 {
+		t.Errorf("B(n) = %v, want 0", b)
+	}
+	if _, err := BindingEnergy(5, 4); err == nil {
+		t.Error("BindingEnergy should reject Z > A")
+	}
+}
+
+func TestMassExcess(t *testing.T) {
+	// Δ(¹²C) = 0 by definition; Δ(n) = 8.0713 MeV in any model.
+	if d, _ := MassExcess(ame, 6, 12); d.Val() != 0 {
+		t.Errorf("Δ(C-12) = %v, want 0", d)
+	}
+	for _, m := range []MassModel{SEMF, ame} {
+		if d, _ := MassExcess(m, 0, 1); !almostEqual(d.ToMeV(), 8.0713, 1e-4) {
+			t.Errorf("Δ(n) = %v MeV, want 8.0713", d.ToMeV())
+		}
+	}
+	if d, _ := MassExcess(ame, 26, 56); !almostEqual(d.ToMeV(), -60.607, 1e-4) {
+		t.Errorf("Δ(Fe-56) = %v MeV, want -60.607", d.ToMeV())
+	}
+}
+
+func TestQValues(t *testing.T) {
+	qa, err := QAlpha(ame, 92, 238)
+	if err != nil || !almostEqual(qa.ToMeV(), 4.270, 1e-3) {
+		t.Errorf("Q_α(U-238) = %v MeV, %v; want 4.270", qa.ToMeV(), err)
+	}
+	// Tritium β⁻: 18.59 keV.
+	if q, _ := QBetaMinus(ame, 1, 3); !almostEqual(q.ToKeV(), 18.59, 1e-3) {
+		t.Errorf("Q_β⁻(H-3) = %v keV, want 18.59", q.ToKeV())
+	}
+	// Na-22 β⁺ and EC differ by 2m_e c².
+	qp, _ := QBetaPlus(ame, 11, 22)
+	qec, _ := QElectronCapture(ame, 11, 22)
+	if !almostEqual(qec.ToMeV(), 2.842, 1e-3) || !almostEqual(qec.ToMeV()-qp.ToMeV(), 1.022, 1e-3) {
+		t.Errorf("Na-22: Q_EC = %v, Q_β⁺ = %v MeV", qec.ToMeV(), qp.ToMeV())
+	}
+	// Be-7 decays only by EC: Q_EC > 0 but Q_β⁺ < 0.
+	if qp, _ := QBetaPlus(ame, 4, 7); qp.Val() >= 0 {
+		t.Errorf("Q_β⁺(Be-7) = %v, want negative", qp)
+	}
+
+	// D-T fusion releases 17.59 MeV.
+	q, err := QValue(ame, []Nuclide{Deuteron, Triton}, []Nuclide{Alpha, Neutron})
+	if err != nil || !almostEqual(q.ToMeV(), 17.589, 1e-3) {
+		t.Errorf("Q(D-T) = %v MeV, %v; want 17.589", q.ToMeV(), err)
+	}
+	// Fission of U-235 into Ba-141 + Kr-92 + 3n releases roughly 170-180 MeV.
+	qf, err := QValue(SEMF, []Nuclide{Neutron, {Z: 92, A: 235}}, []Nuclide{{Z: 56, A: 141}, {Z: 36, A: 92}, Neutron, Neutron, Neutron})
+	if err != nil || qf.ToMeV() < 160 || qf.ToMeV() > 200 {
+		t.Errorf("Q(fission) = %v MeV, %v", qf.ToMeV(), err)
+	}
+
+	if _, err := QValue(ame, []Nuclide{Deuteron}, []Nuclide{Alpha}); err == nil {
+		t.Error("QValue should reject non-conserving reactions")
+	}
+	if _, err := QAlpha(ame, 30, 64); err == nil {
+		t.Error("QAlpha should report a missing table entry")
+	}
+	if _, err := QAlpha(SEMF, 1, 3); err == nil {
+		t.Error("QAlpha should reject an invalid daughter")
+	}
+}
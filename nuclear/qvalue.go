@@ -0,0 +1,109 @@
+package nuclear
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// QValue returns the energy released by a reaction or decay,
+//
+//	Q = (Σ M_reactants - Σ M_products) c²
+//
+// using atomic masses from m. Returns an error if a nuclide is invalid or
+// if Z or A is not conserved; for β decays, which change Z, use the
+// dedicated functions.
+func QValue(m MassModel, reactants, products []Nuclide) (units.Energy, error) {
+	var zIn, aIn, zOut, aOut int
+	for _, n := range reactants {
+		zIn += n.Z
+		aIn += n.A
+	}
+	for _, n := range products {
+		zOut += n.Z
+		aOut += n.A
+	}
+	if zIn != zOut || aIn != aOut {
+		return units.Energy{}, fmt.Errorf("reaction does not conserve Z and A: (%d, %d) → (%d, %d)", zIn, aIn, zOut, aOut)
+	}
+	in, err := totalMass(m, reactants)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	out, err := totalMass(m, products)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule((in - out) * c2), nil
+}
+
+// QAlpha returns the Q-value of α decay (Z, A) → (Z-2, A-4) + ⁴He.
+// The decay is energetically allowed when Q > 0.
+func QAlpha(m MassModel, z, a int) (units.Energy, error) {
+	return QValue(m, []Nuclide{{Z: z, A: a}}, []Nuclide{{Z: z - 2, A: a - 4}, Alpha})
+}
+
+// QBetaMinus returns the Q-value of β⁻ decay (Z, A) → (Z+1, A) + e⁻ + ν̄.
+//
+// Formula:
+//
+//	Q = (M(Z, A) - M(Z+1, A)) c²
+func QBetaMinus(m MassModel, z, a int) (units.Energy, error) {
+	return isobarQ(m, z, a, z+1, 0)
+}
+
+// QBetaPlus returns the Q-value of β⁺ decay (Z, A) → (Z-1, A) + e⁺ + ν.
+//
+// Formula:
+//
+//	Q = (M(Z, A) - M(Z-1, A) - 2m_e) c²
+func QBetaPlus(m MassModel, z, a int) (units.Energy, error) {
+	return isobarQ(m, z, a, z-1, 2*electron.Mass.Value().Val())
+}
+
+// QElectronCapture returns the Q-value of electron capture
+// (Z, A) + e⁻ → (Z-1, A) + ν, neglecting the atomic binding of the
+// captured electron.
+//
+// Formula:
+//
+//	Q = (M(Z, A) - M(Z-1, A)) c²
+func QElectronCapture(m MassModel, z, a int) (units.Energy, error) {
+	return isobarQ(m, z, a, z-1, 0)
+}
+
+// isobarQ returns (M(z, a) - M(zd, a) - extra) c² for a decay to an isobar.
+func isobarQ(m MassModel, z, a, zd int, extra float64) (units.Energy, error) {
+	masses, err := atomicMasses(m, []Nuclide{{Z: z, A: a}, {Z: zd, A: a}})
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule((masses[0] - masses[1] - extra) * c2), nil
+}
+
+func totalMass(m MassModel, nuclides []Nuclide) (float64, error) {
+	masses, err := atomicMasses(m, nuclides)
+	if err != nil {
+		return 0, err
+	}
+	sum := 0.0
+	for _, v := range masses {
+		sum += v
+	}
+	return sum, nil
+}
+
+func atomicMasses(m MassModel, nuclides []Nuclide) ([]float64, error) {
+	out := make([]float64, len(nuclides))
+	for i, n := range nuclides {
+		if err := n.check(); err != nil {
+			return nil, err
+		}
+		mass, err := m.AtomicMass(n.Z, n.A)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = mass.Val()
+	}
+	return out, nil
+}
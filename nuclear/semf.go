@@ -0,0 +1,186 @@
+package nuclear
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// c2 is the square of the speed of light in m²/s².
+var c2 = constants.SpeedOfLight.Val() * constants.SpeedOfLight.Val()
+
+// Particles whose masses enter the mass and Q-value formulas.
+var (
+	electron, _ = particles.Lookup("e-")
+	proton, _   = particles.Lookup("p")
+	neutron, _  = particles.Lookup("n")
+)
+
+// Nuclide identifies a nucleus by proton number Z and mass number A.
+type Nuclide struct {
+	Z, A int
+}
+
+// Common light nuclides.
+var (
+	Neutron  = Nuclide{Z: 0, A: 1}
+	Hydrogen = Nuclide{Z: 1, A: 1}
+	Deuteron = Nuclide{Z: 1, A: 2}
+	Triton   = Nuclide{Z: 1, A: 3}
+	Helium3  = Nuclide{Z: 2, A: 3}
+	Alpha    = Nuclide{Z: 2, A: 4}
+)
+
+// N returns the neutron number A - Z.
+func (n Nuclide) N() int {
+	return n.A - n.Z
+}
+
+func (n Nuclide) check() error {
+	if n.A < 1 || n.Z < 0 || n.Z > n.A {
+		return fmt.Errorf("invalid nuclide Z = %d, A = %d", n.Z, n.A)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Mass Models
+// -----------------------------------------------------------------------------
+
+// MassModel gives the atomic mass of a neutral atom. It is implemented by
+// LiquidDrop and can be implemented by tables of measured masses.
+type MassModel interface {
+	AtomicMass(z, a int) (units.Mass, error)
+}
+
+// BindingEnergyOf returns the binding energy implied by a mass model.
+//
+// Formula:
+//
+//	B = (Z m_H + N m_n - M(Z, A)) c²
+func BindingEnergyOf(m MassModel, z, a int) (units.Energy, error) {
+	n := Nuclide{Z: z, A: a}
+	if err := n.check(); err != nil {
+		return units.Energy{}, err
+	}
+	mass, err := m.AtomicMass(z, a)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule((constituents(n) - mass.Val()) * c2), nil
+}
+
+// MassExcess returns Δ = (M - A u) c², the quantity tabulated in atomic
+// mass evaluations.
+func MassExcess(m MassModel, z, a int) (units.Energy, error) {
+	mass, err := m.AtomicMass(z, a)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule((mass.Val() - float64(a)*constants.AtomicMassUnit.Val()) * c2), nil
+}
+
+// Residual returns B_model - B_reference, the error of a mass model's
+// binding energy against a reference, typically a table of measured masses.
+func Residual(model, reference MassModel, z, a int) (units.Energy, error) {
+	bm, err := BindingEnergyOf(model, z, a)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	br, err := BindingEnergyOf(reference, z, a)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(bm.Val() - br.Val()), nil
+}
+
+// constituents returns Z m_H + N m_n in kg, the mass of the unbound
+// hydrogen atoms and neutrons (electron binding neglected).
+func constituents(n Nuclide) float64 {
+	mh := proton.Mass.Value().Val() + electron.Mass.Value().Val()
+	return float64(n.Z)*mh + float64(n.N())*neutron.Mass.Value().Val()
+}
+
+// -----------------------------------------------------------------------------
+// Liquid-Drop Model
+// -----------------------------------------------------------------------------
+
+// LiquidDrop holds the coefficients of the semi-empirical mass formula.
+type LiquidDrop struct {
+	Volume    units.Energy // a_V
+	Surface   units.Energy // a_S
+	Coulomb   units.Energy // a_C
+	Asymmetry units.Energy // a_A
+	Pairing   units.Energy // a_P
+}
+
+// SEMF is the liquid-drop model with the coefficients of Krane, Ch. 3.
+var SEMF = LiquidDrop{
+	Volume:    units.MegaelectronVolt(15.5),
+	Surface:   units.MegaelectronVolt(16.8),
+	Coulomb:   units.MegaelectronVolt(0.72),
+	Asymmetry: units.MegaelectronVolt(23),
+	Pairing:   units.MegaelectronVolt(34),
+}
+
+// BindingEnergy returns the total binding energy of nuclide (Z, A) in the
+// liquid-drop model. A = 1 has zero binding energy. Returns an error if
+// the nuclide is invalid.
+//
+// Formula:
+//
+//	B = a_V A - a_S A^(2/3) - a_C Z(Z-1)/A^(1/3) - a_A (A-2Z)²/A + δ
+//
+// where δ = +a_P A^(-3/4) for even-even, -a_P A^(-3/4) for odd-odd and 0
+// for odd-A nuclei.
+func (m LiquidDrop) BindingEnergy(z, a int) (units.Energy, error) {
+	n := Nuclide{Z: z, A: a}
+	if err := n.check(); err != nil {
+		return units.Energy{}, err
+	}
+	if a == 1 {
+		return units.Joule(0), nil
+	}
+	fa, fz := float64(a), float64(z)
+	cbrt := math.Cbrt(fa)
+	asym := fa - 2*fz
+	b := m.Volume.Val()*fa -
+		m.Surface.Val()*cbrt*cbrt -
+		m.Coulomb.Val()*fz*(fz-1)/cbrt -
+		m.Asymmetry.Val()*asym*asym/fa
+	switch {
+	case z%2 == 0 && n.N()%2 == 0:
+		b += m.Pairing.Val() * math.Pow(fa, -0.75)
+	case z%2 == 1 && n.N()%2 == 1:
+		b -= m.Pairing.Val() * math.Pow(fa, -0.75)
+	}
+	return units.Joule(b), nil
+}
+
+// AtomicMass returns M = Z m_H + N m_n - B/c² with B from the liquid-drop
+// model, satisfying MassModel.
+func (m LiquidDrop) AtomicMass(z, a int) (units.Mass, error) {
+	b, err := m.BindingEnergy(z, a)
+	if err != nil {
+		return units.Mass{}, err
+	}
+	return units.Kilogram(constituents(Nuclide{Z: z, A: a}) - b.Val()/c2), nil
+}
+
+// BindingEnergy returns the liquid-drop binding energy of nuclide (Z, A)
+// using the SEMF coefficients.
+func BindingEnergy(z, a int) (units.Energy, error) {
+	return SEMF.BindingEnergy(z, a)
+}
+
+// BindingEnergyPerNucleon returns B/A using the SEMF coefficients.
+func BindingEnergyPerNucleon(z, a int) (units.Energy, error) {
+	b, err := BindingEnergy(z, a)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(b.Val() / float64(a)), nil
+}
@@ -0,0 +1,41 @@
+// Package optics provides paraxial and ray-based geometric optics:
+// refraction at interfaces, thin lenses and spherical mirrors, and
+// ray-transfer (ABCD) matrices for systems of elements.
+//
+// Angles are in radians, measured from the surface normal for refraction
+// and from the optical axis for rays. Lens and mirror formulas use the
+// real-is-positive convention: object and image distances are positive on
+// the side where light actually travels, converging elements have positive
+// focal length, and
+//
+//	1/f = 1/dₒ + 1/dᵢ,   m = -dᵢ/dₒ
+//
+// Surface radii follow the lensmaker's convention: R > 0 when the center
+// of curvature lies on the outgoing side of the surface. A flat surface
+// has infinite radius.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/optics"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Light leaving glass into air
+//	theta, _ := optics.CriticalAngle(1.5, 1.0) // ≈ 41.8°
+//
+//	// Biconvex lens, n = 1.5, |R| = 10 cm
+//	f, _ := optics.Lensmaker(1.5, units.Centimeter(10), units.Centimeter(-10)) // 10 cm
+//	img, _ := optics.ThinLens(f, units.Centimeter(30)) // real, inverted, at 15 cm
+//
+//	// Same result by ray transfer: B = 0 at the image plane, A = -0.5
+//	sys := optics.Compose(
+//	    optics.FreeSpace(units.Centimeter(30)),
+//	    optics.ThinLensMatrix(f),
+//	    optics.FreeSpace(units.Centimeter(15)),
+//	)
+//
+// References:
+//   - Hecht. "Optics", 5th ed., Ch. 4-6
+//   - Saleh, Teich. "Fundamentals of Photonics", 2nd ed., Ch. 1
+package optics
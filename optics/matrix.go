@@ -0,0 +1,110 @@
+package optics
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Ray is a paraxial ray at a reference plane: its height above the optical
+// axis and its angle to the axis in radians.
+type Ray struct {
+	Height units.Length
+	Angle  float64
+}
+
+// RayMatrix is a 2×2 ray-transfer (ABCD) matrix in SI units, acting on
+// the column vector (height, angle):
+//
+//	[y']   [A  B] [y]
+//	[θ'] = [C  D] [θ]
+//
+// B is in meters and C in inverse meters; A and D are dimensionless.
+type RayMatrix struct {
+	A, B, C, D float64
+}
+
+// Identity returns the identity ray matrix.
+func Identity() RayMatrix {
+	return RayMatrix{A: 1, D: 1}
+}
+
+// FreeSpace returns the matrix for propagation over distance d in a
+// uniform medium.
+func FreeSpace(d units.Length) RayMatrix {
+	return RayMatrix{A: 1, B: d.Val(), D: 1}
+}
+
+// ThinLensMatrix returns the matrix of a thin lens of focal length f.
+func ThinLensMatrix(f units.Length) RayMatrix {
+	return RayMatrix{A: 1, C: -1 / f.Val(), D: 1}
+}
+
+// MirrorMatrix returns the matrix of a spherical mirror with radius of
+// curvature R (positive for concave), with the axis unfolded after
+// reflection.
+func MirrorMatrix(radius units.Length) RayMatrix {
+	return RayMatrix{A: 1, C: -2 / radius.Val(), D: 1}
+}
+
+// Interface returns the matrix for refraction at a spherical surface of
+// radius R from index n₁ into index n₂; pass an infinite radius for a flat
+// surface. Angles are reduced by the index ratio, so the determinant is
+// n₁/n₂. Returns an error if either index is not positive or R is zero.
+func Interface(n1, n2 float64, radius units.Length) (RayMatrix, error) {
+	if err := checkIndices(n1, n2); err != nil {
+		return RayMatrix{}, err
+	}
+	if radius.Val() == 0 {
+		return RayMatrix{}, fmt.Errorf("surface radius must be nonzero")
+	}
+	return RayMatrix{A: 1, C: (n1 - n2) / (n2 * radius.Val()), D: n1 / n2}, nil
+}
+
+// Compose returns the matrix of the elements traversed in the given order,
+// i.e. Mₙ ⋯ M₂ M₁. With no elements it returns the identity.
+func Compose(elements ...RayMatrix) RayMatrix {
+	m := Identity()
+	for _, e := range elements {
+		m = e.Mul(m)
+	}
+	return m
+}
+
+// Mul returns the matrix product m·n, meaning n is traversed first.
+func (m RayMatrix) Mul(n RayMatrix) RayMatrix {
+	return RayMatrix{
+		A: m.A*n.A + m.B*n.C,
+		B: m.A*n.B + m.B*n.D,
+		C: m.C*n.A + m.D*n.C,
+		D: m.C*n.B + m.D*n.D,
+	}
+}
+
+// Apply returns the ray after passing through the system.
+func (m RayMatrix) Apply(r Ray) Ray {
+	y := r.Height.Val()
+	return Ray{Height: units.Meter(m.A*y + m.B*r.Angle), Angle: m.C*y + m.D*r.Angle}
+}
+
+// Determinant returns AD - BC, which equals n₁/n₂ for a system from index
+// n₁ to index n₂ and 1 when both ends are in the same medium.
+func (m RayMatrix) Determinant() float64 {
+	return m.A*m.D - m.B*m.C
+}
+
+// FocalLength returns the effective focal length f = -1/C of the system.
+// Returns an error if C = 0, meaning the system is afocal.
+func (m RayMatrix) FocalLength() (units.Length, error) {
+	if m.C == 0 {
+		return units.Length{}, fmt.Errorf("system is afocal (C = 0)")
+	}
+	return units.Meter(-1 / m.C), nil
+}
+
+// Imaging reports whether the system maps its input plane onto its output
+// plane (B ≈ 0), in which case A is the lateral magnification.
+func (m RayMatrix) Imaging(tolerance units.Length) bool {
+	return math.Abs(m.B) <= tolerance.Val()
+}
@@ -0,0 +1,146 @@
+package optics
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Refraction
+// -----------------------------------------------------------------------------
+
+// Refract returns the refraction angle θ₂ for light crossing from a medium
+// of index n₁ into one of index n₂ at incidence angle θ₁ (Snell's law).
+// Returns an error if either index is not positive, θ₁ is outside
+// [-π/2, π/2], or the light is totally internally reflected.
+//
+// Formula:
+//
+//	n₁ sin θ₁ = n₂ sin θ₂
+func Refract(n1, n2, theta1 float64) (float64, error) {
+	if err := checkIndices(n1, n2); err != nil {
+		return 0, err
+	}
+	if !(math.Abs(theta1) <= math.Pi/2) {
+		return 0, fmt.Errorf("incidence angle must be in [-π/2, π/2], got %g rad", theta1)
+	}
+	s := n1 / n2 * math.Sin(theta1)
+	if math.Abs(s) > 1 {
+		return 0, fmt.Errorf("total internal reflection at %g rad (critical angle %g rad)", theta1, math.Asin(n2/n1))
+	}
+	return math.Asin(s), nil
+}
+
+// CriticalAngle returns the incidence angle θc = arcsin(n₂/n₁) beyond which
+// light in medium n₁ is totally internally reflected at an interface with
+// medium n₂. Returns an error if either index is not positive or n₁ ≤ n₂,
+// in which case there is no total internal reflection.
+func CriticalAngle(n1, n2 float64) (float64, error) {
+	if err := checkIndices(n1, n2); err != nil {
+		return 0, err
+	}
+	if n1 <= n2 {
+		return 0, fmt.Errorf("no total internal reflection from n₁ = %g into n₂ = %g", n1, n2)
+	}
+	return math.Asin(n2 / n1), nil
+}
+
+// TotalInternalReflection reports whether light in medium n₁ hitting an
+// interface with medium n₂ at angle θ₁ is totally internally reflected.
+func TotalInternalReflection(n1, n2, theta1 float64) bool {
+	return n1/n2*math.Abs(math.Sin(theta1)) > 1
+}
+
+// BrewsterAngle returns the incidence angle θ_B = arctan(n₂/n₁) at which
+// p-polarized light is transmitted without reflection.
+// Returns an error if either index is not positive.
+func BrewsterAngle(n1, n2 float64) (float64, error) {
+	if err := checkIndices(n1, n2); err != nil {
+		return 0, err
+	}
+	return math.Atan2(n2, n1), nil
+}
+
+func checkIndices(n1, n2 float64) error {
+	if !(n1 > 0) || !(n2 > 0) || math.IsInf(n1, 0) || math.IsInf(n2, 0) {
+		return fmt.Errorf("refractive indices must be positive and finite, got %g and %g", n1, n2)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Thin Lenses and Mirrors
+// -----------------------------------------------------------------------------
+
+// Image is the image formed by a lens or mirror.
+type Image struct {
+	Distance      units.Length // positive for a real image, negative for virtual
+	Magnification float64      // negative for an inverted image
+}
+
+// Real reports whether light actually converges on the image.
+func (i Image) Real() bool {
+	return i.Distance.Val() > 0
+}
+
+// Upright reports whether the image has the same orientation as the object.
+func (i Image) Upright() bool {
+	return i.Magnification > 0
+}
+
+// ThinLens returns the image formed by a thin lens of focal length f of an
+// object at distance dₒ. Returns an error if f or dₒ is zero, or if the
+// object lies at the focal point so the image is at infinity.
+//
+// Formula:
+//
+//	1/dᵢ = 1/f - 1/dₒ,   m = -dᵢ/dₒ
+func ThinLens(f, objectDistance units.Length) (Image, error) {
+	fv, do := f.Val(), objectDistance.Val()
+	if fv == 0 || do == 0 {
+		return Image{}, fmt.Errorf("focal length and object distance must be nonzero, got %g m and %g m", fv, do)
+	}
+	if do == fv {
+		return Image{}, fmt.Errorf("object at the focal point (%g m) forms no finite image", fv)
+	}
+	di := fv * do / (do - fv)
+	return Image{Distance: units.Meter(di), Magnification: -di / do}, nil
+}
+
+// Mirror returns the image formed by a spherical mirror of focal length f
+// (positive for concave) of an object at distance dₒ in front of it.
+// Image distances are positive in front of the mirror. Returns an error
+// as for ThinLens.
+func Mirror(f, objectDistance units.Length) (Image, error) {
+	return ThinLens(f, objectDistance)
+}
+
+// MirrorFocalLength returns the paraxial focal length f = R/2 of a
+// spherical mirror with radius of curvature R (positive for concave).
+func MirrorFocalLength(radius units.Length) units.Length {
+	return units.Meter(radius.Val() / 2)
+}
+
+// Lensmaker returns the focal length of a thin lens of index n in air with
+// surface radii R₁ and R₂; pass an infinite radius for a flat surface.
+// Returns an error if n is not positive, either radius is zero, or the
+// surfaces have equal curvature so the lens has no power.
+//
+// Formula:
+//
+//	1/f = (n - 1)(1/R₁ - 1/R₂)
+func Lensmaker(n float64, r1, r2 units.Length) (units.Length, error) {
+	if !(n > 0) {
+		return units.Length{}, fmt.Errorf("refractive index must be positive, got %g", n)
+	}
+	if r1.Val() == 0 || r2.Val() == 0 {
+		return units.Length{}, fmt.Errorf("surface radii must be nonzero, got %g m and %g m", r1.Val(), r2.Val())
+	}
+	power := (n - 1) * (1/r1.Val() - 1/r2.Val())
+	if power == 0 {
+		return units.Length{}, fmt.Errorf("lens has zero optical power")
+	}
+	return units.Meter(1 / power), nil
+}
@@ -0,0 +1,155 @@
+package optics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestRefraction(t *testing.T) {
+	// Air to water at 30°: sin θ₂ = 0.5/1.333.
+	theta2, err := Refract(1, 1.333, math.Pi/6)
+	if err != nil || !almostEqual(theta2, math.Asin(0.5/1.333), 1e-14) {
+		t.Errorf("Refract() = %v, %v", theta2, err)
+	}
+	if back, _ := Refract(1.333, 1, theta2); !almostEqual(back, math.Pi/6, 1e-12) {
+		t.Errorf("reverse refraction = %v, want π/6", back)
+	}
+
+	tc, err := CriticalAngle(1.5, 1)
+	if err != nil || !almostEqual(tc*180/math.Pi, 41.81, 1e-3) {
+		t.Errorf("CriticalAngle() = %v°, %v; want ≈ 41.81°", tc*180/math.Pi, err)
+	}
+	if !TotalInternalReflection(1.5, 1, tc+1e-6) || TotalInternalReflection(1.5, 1, tc-1e-6) {
+		t.Error("TotalInternalReflection should switch at the critical angle")
+	}
+	if _, err := Refract(1.5, 1, tc+0.01); err == nil {
+		t.Error("Refract should report total internal reflection")
+	}
+	if _, err := CriticalAngle(1, 1.5); err == nil {
+		t.Error("CriticalAngle should reject n₁ < n₂")
+	}
+
+	// At Brewster's angle the reflected and refracted rays are perpendicular.
+	tb, _ := BrewsterAngle(1, 1.5)
+	tr, _ := Refract(1, 1.5, tb)
+	if !almostEqual(tb+tr, math.Pi/2, 1e-12) {
+		t.Errorf("θ_B + θ_t = %v, want π/2", tb+tr)
+	}
+	if _, err := Refract(0, 1, 0); err == nil {
+		t.Error("Refract should reject zero index")
+	}
+}
+
+func TestThinLens(t *testing.T) {
+	f, err := Lensmaker(1.5, units.Centimeter(10), units.Centimeter(-10))
+	if err != nil || !almostEqual(f.Val(), 0.1, 1e-12) {
+		t.Fatalf("Lensmaker() = %v, %v; want 10 cm", f, err)
+	}
+	// Plano-convex: 1/f = (n - 1)/R.
+	pc, _ := Lensmaker(1.5, units.Centimeter(10), units.Meter(math.Inf(1)))
+	if !almostEqual(pc.Val(), 0.2, 1e-12) {
+		t.Errorf("plano-convex f = %v, want 20 cm", pc)
+	}
+
+	img, err := ThinLens(f, units.Centimeter(30))
+	if err != nil || !almostEqual(img.Distance.Val(), 0.15, 1e-12) || !almostEqual(img.Magnification, -0.5, 1e-12) {
+		t.Errorf("ThinLens() = %+v, %v; want 15 cm, m = -0.5", img, err)
+	}
+	if !img.Real() || img.Upright() {
+		t.Error("image beyond 2f should be real and inverted")
+	}
+	// Magnifying glass: object inside f gives an upright virtual image.
+	mag, _ := ThinLens(f, units.Centimeter(5))
+	if mag.Real() || !mag.Upright() || !almostEqual(mag.Magnification, 2, 1e-12) {
+		t.Errorf("magnifier image = %+v, want virtual ×2", mag)
+	}
+	// Diverging lens always gives a virtual, upright, reduced image.
+	div, _ := ThinLens(units.Centimeter(-10), units.Centimeter(10))
+	if div.Real() || !almostEqual(div.Magnification, 0.5, 1e-12) {
+		t.Errorf("diverging image = %+v", div)
+	}
+
+	if _, err := ThinLens(f, f); err == nil {
+		t.Error("ThinLens should reject an object at the focal point")
+	}
+	if _, err := Lensmaker(1.5, units.Centimeter(10), units.Centimeter(10)); err == nil {
+		t.Error("Lensmaker should reject a zero-power meniscus")
+	}
+}
+
+func TestMirror(t *testing.T) {
+	f := MirrorFocalLength(units.Centimeter(40))
+	if !almostEqual(f.Val(), 0.2, 1e-12) {
+		t.Errorf("MirrorFocalLength() = %v, want 20 cm", f)
+	}
+	// Object at the center of curvature images onto itself, inverted.
+	img, _ := Mirror(f, units.Centimeter(40))
+	if !almostEqual(img.Distance.Val(), 0.4, 1e-12) || !almostEqual(img.Magnification, -1, 1e-12) {
+		t.Errorf("Mirror() = %+v, want 40 cm, m = -1", img)
+	}
+	// Convex mirror: virtual, upright, reduced.
+	cvx, _ := Mirror(MirrorFocalLength(units.Centimeter(-40)), units.Centimeter(40))
+	if cvx.Real() || !cvx.Upright() || cvx.Magnification >= 1 {
+		t.Errorf("convex mirror image = %+v", cvx)
+	}
+}
+
+func TestRayMatrix(t *testing.T) {
+	f := units.Centimeter(10)
+	// Object 30 cm before the lens images 15 cm after it: B = 0, A = m.
+	sys := Compose(FreeSpace(units.Centimeter(30)), ThinLensMatrix(f), FreeSpace(units.Centimeter(15)))
+	if !sys.Imaging(units.Meter(1e-12)) || !almostEqual(sys.A, -0.5, 1e-12) {
+		t.Errorf("imaging system = %+v, want B = 0, A = -0.5", sys)
+	}
+	if !almostEqual(sys.Determinant(), 1, 1e-12) {
+		t.Errorf("det = %v, want 1", sys.Determinant())
+	}
+
+	// A ray parallel to the axis crosses it at the focal point.
+	r := Compose(ThinLensMatrix(f), FreeSpace(f)).Apply(Ray{Height: units.Millimeter(2)})
+	if math.Abs(r.Height.Val()) > 1e-15 || !almostEqual(r.Angle, -0.02, 1e-12) {
+		t.Errorf("focused ray = %+v, want height 0, angle -0.02", r)
+	}
+
+	// Two thin lenses in contact: 1/f = 1/f₁ + 1/f₂.
+	pair := Compose(ThinLensMatrix(units.Centimeter(10)), ThinLensMatrix(units.Centimeter(-20)))
+	if fe, _ := pair.FocalLength(); !almostEqual(fe.Val(), 0.2, 1e-12) {
+		t.Errorf("contact pair f = %v, want 20 cm", fe)
+	}
+	// Keplerian telescope is afocal with angular magnification -f₁/f₂.
+	tele := Compose(ThinLensMatrix(units.Centimeter(50)), FreeSpace(units.Centimeter(55)), ThinLensMatrix(units.Centimeter(5)))
+	if math.Abs(tele.C) > 1e-12 || !almostEqual(tele.D, -10, 1e-12) {
+		t.Errorf("telescope = %+v, want C = 0, D = -10", tele)
+	}
+	if _, err := ThinLensMatrix(f).Mul(ThinLensMatrix(units.Meter(-0.1))).FocalLength(); err == nil {
+		t.Error("FocalLength should reject an afocal system")
+	}
+
+	// Two spherical interfaces reproduce the lensmaker result for a thin lens.
+	s1, _ := Interface(1, 1.5, units.Centimeter(10))
+	s2, _ := Interface(1.5, 1, units.Centimeter(-10))
+	lens := Compose(s1, s2)
+	if fe, _ := lens.FocalLength(); !almostEqual(fe.Val(), 0.1, 1e-12) {
+		t.Errorf("two-surface lens f = %v, want 10 cm", fe)
+	}
+	if !almostEqual(s1.Determinant(), 1/1.5, 1e-12) {
+		t.Errorf("interface det = %v, want n₁/n₂", s1.Determinant())
+	}
+
+	// A concave mirror behaves like a lens of focal length R/2.
+	if fm, _ := MirrorMatrix(units.Centimeter(40)).FocalLength(); !almostEqual(fm.Val(), 0.2, 1e-12) {
+		t.Errorf("mirror f = %v, want 20 cm", fm)
+	}
+	if Compose() != Identity() {
+		t.Error("Compose() should be the identity")
+	}
+	if _, err := Interface(1, 1.5, units.Meter(0)); err == nil {
+		t.Error("Interface should reject zero radius")
+	}
+}
@@ -0,0 +1,28 @@
+// Package parallel provides a fixed-size goroutine pool for data-parallel
+// loops in the simulation and grid code.
+//
+// Pool.For splits an index range into contiguous chunks and runs them on
+// the pool's workers, returning when all are done. Contiguous chunks keep
+// each worker on its own stretch of memory, and chunks are several times
+// more numerous than workers so uneven work still balances. A nil *Pool
+// runs loops serially on the calling goroutine, so parallelism is opt-in
+// wherever a pool is accepted.
+//
+// Loops in this module write each output element from exactly one chunk
+// and compute it in a fixed order, so results do not depend on the number
+// of workers or on scheduling; see package repro for checking this across
+// runs.
+//
+// Example usage:
+//
+//	import "github.com/sakiphan/qsim-core/parallel"
+//
+//	pool := parallel.NewPool(0) // one worker per CPU
+//	defer pool.Close()
+//
+//	pool.For(len(out), 256, func(lo, hi int) {
+//	    for i := lo; i < hi; i++ {
+//	        out[i] = expensive(i)
+//	    }
+//	})
+package parallel
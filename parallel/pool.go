@@ -0,0 +1,79 @@
+package parallel
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Pool is a fixed set of worker goroutines. It is safe for concurrent use,
+// but For must not be called from within a loop body running on the same
+// pool.
+type Pool struct {
+	workers int
+	jobs    chan job
+	once    sync.Once
+}
+
+type job struct {
+	fn     func(lo, hi int)
+	lo, hi int
+	wg     *sync.WaitGroup
+}
+
+// NewPool starts a pool with the given number of workers; zero or a
+// negative number means runtime.GOMAXPROCS(0).
+func NewPool(workers int) *Pool {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	p := &Pool{workers: workers, jobs: make(chan job)}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range p.jobs {
+				j.fn(j.lo, j.hi)
+				j.wg.Done()
+			}
+		}()
+	}
+	return p
+}
+
+// Workers returns the number of workers, or 1 for a nil pool.
+func (p *Pool) Workers() int {
+	if p == nil {
+		return 1
+	}
+	return p.workers
+}
+
+// For calls fn on contiguous chunks [lo, hi) covering [0, n) and waits for
+// all of them. Chunks hold at least grain indices; loops no longer than one
+// grain, and all loops on a nil or single-worker pool, run serially on the
+// calling goroutine.
+func (p *Pool) For(n, grain int, fn func(lo, hi int)) {
+	if n <= 0 {
+		return
+	}
+	grain = max(grain, 1)
+	if p == nil || p.workers == 1 || n <= grain {
+		fn(0, n)
+		return
+	}
+	// About four chunks per worker balance uneven work without much
+	// scheduling overhead.
+	size := max(grain, (n+4*p.workers-1)/(4*p.workers))
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += size {
+		wg.Add(1)
+		p.jobs <- job{fn: fn, lo: lo, hi: min(lo+size, n), wg: &wg}
+	}
+	wg.Wait()
+}
+
+// Close stops the workers. The pool must not be used afterwards.
+func (p *Pool) Close() {
+	if p == nil {
+		return
+	}
+	p.once.Do(func() { close(p.jobs) })
+}
@@ -0,0 +1,80 @@
+package parallel
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFor(t *testing.T) {
+	pool := NewPool(3)
+	defer pool.Close()
+
+	for _, n := range []int{0, 1, 7, 100, 1001} {
+		hits := make([]int32, n)
+		var calls atomic.Int32
+		pool.For(n, 10, func(lo, hi int) {
+			calls.Add(1)
+			for i := lo; i < hi; i++ {
+				atomic.AddInt32(&hits[i], 1)
+			}
+		})
+		for i, h := range hits {
+			if h != 1 {
+				t.Fatalf("n = %d: index %d visited %d times", n, i, h)
+			}
+		}
+		if n > 10 && calls.Load() < 2 {
+			t.Errorf("n = %d: %d chunks, want several", n, calls.Load())
+		}
+	}
+}
+
+func TestForSerial(t *testing.T) {
+	// A nil pool, a single worker and a short loop all run in one call.
+	for _, p := range []*Pool{nil, NewPool(1)} {
+		calls := 0
+		p.For(1000, 1, func(lo, hi int) {
+			calls++
+			if lo != 0 || hi != 1000 {
+				t.Errorf("chunk = [%d, %d), want [0, 1000)", lo, hi)
+			}
+		})
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+		p.Close()
+	}
+	if (*Pool)(nil).Workers() != 1 {
+		t.Error("nil pool should report one worker")
+	}
+}
+
+func TestForConcurrentCallers(t *testing.T) {
+	pool := NewPool(4)
+	defer pool.Close()
+
+	var wg sync.WaitGroup
+	sums := make([]int64, 8)
+	for c := range sums {
+		wg.Add(1)
+		go func(c int) {
+			defer wg.Done()
+			var sum atomic.Int64
+			pool.For(10000, 100, func(lo, hi int) {
+				local := int64(0)
+				for i := lo; i < hi; i++ {
+					local += int64(i)
+				}
+				sum.Add(local)
+			})
+			sums[c] = sum.Load()
+		}(c)
+	}
+	wg.Wait()
+	for c, s := range sums {
+		if s != 10000*9999/2 {
+			t.Errorf("caller %d: sum = %d", c, s)
+		}
+	}
+}
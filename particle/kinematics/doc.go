@@ -0,0 +1,29 @@
+// Package kinematics provides relativistic kinematics for particle decays
+// and collisions, built on sr.FourVector four-momenta.
+//
+// It covers center-of-mass energies for colliding and fixed-target beams,
+// two-body decay momenta and the boost of decay products into the lab
+// frame, the Mandelstam variables s, t, u of 2 → 2 scattering, and
+// production threshold energies.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants/particles"
+//	    "github.com/sakiphan/qsim-core/particle/kinematics"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Antiproton production p + p → p + p + p + p̄ on a fixed target
+//	proton, _ := particles.Lookup("p")
+//	mp := units.Mass{Value: proton.Mass.Value()}
+//	t, _ := kinematics.ThresholdKineticEnergy(mp, mp, mp, mp, mp, mp) // ≈ 5.63 GeV
+//
+//	// Momentum of each pion in K⁰ → π⁺π⁻
+//	mpi := units.MegaelectronVoltPerC2(139.57039)
+//	p, _ := kinematics.DecayMomentum(units.MegaelectronVoltPerC2(497.611), mpi, mpi) // ≈ 206 MeV/c
+//
+// References:
+//   - Particle Data Group. "Review of Particle Physics", Kinematics (Ch. 49)
+//   - Hagedorn. "Relativistic Kinematics", 1st ed.
+package kinematics
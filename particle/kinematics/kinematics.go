@@ -0,0 +1,205 @@
+package kinematics
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/relativity/sr"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// c is the speed of light in m/s.
+var c = constants.SpeedOfLight.Val()
+
+// energySquaredDim is the dimension of the Mandelstam variables, [L⁴M²T⁻⁴].
+var energySquaredDim = units.Dimension{L: 4, M: 2, T: -4}
+
+// -----------------------------------------------------------------------------
+// Center-of-Mass Energy
+// -----------------------------------------------------------------------------
+
+// CenterOfMassEnergy returns the invariant energy √s of two colliding
+// particles with four-momenta pa and pb.
+//
+// Formula:
+//
+//	s = c² (p_a + p_b)²
+func CenterOfMassEnergy(pa, pb sr.FourVector) (units.Energy, error) {
+	sum, err := pa.Add(pb)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	m, err := sum.Mass()
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(m.Val() * c * c), nil
+}
+
+// FixedTargetEnergy returns √s for a beam particle of total energy E and
+// mass m₁ striking a stationary target of mass m₂.
+// Returns an error if E is below the beam rest energy.
+//
+// Formula:
+//
+//	s = m₁²c⁴ + m₂²c⁴ + 2 E m₂c²
+func FixedTargetEnergy(beamEnergy units.Energy, beamMass, targetMass units.Mass) (units.Energy, error) {
+	e, m1, m2 := beamEnergy.Val(), beamMass.Val()*c*c, targetMass.Val()*c*c
+	if e < m1 {
+		return units.Energy{}, fmt.Errorf("beam energy %g J is below its rest energy %g J", e, m1)
+	}
+	return units.Joule(math.Sqrt(m1*m1 + m2*m2 + 2*e*m2)), nil
+}
+
+// -----------------------------------------------------------------------------
+// Two-Body Decays
+// -----------------------------------------------------------------------------
+
+// DecayMomentum returns the momentum of either daughter in the rest frame
+// of a parent of mass M decaying to daughters of masses m₁ and m₂.
+// Returns an error if M < m₁ + m₂.
+//
+// Formula:
+//
+//	p* = c √[(M² - (m₁ + m₂)²)(M² - (m₁ - m₂)²)] / (2M)
+func DecayMomentum(parent, m1, m2 units.Mass) (units.Momentum, error) {
+	M, a, b := parent.Val(), m1.Val(), m2.Val()
+	if M <= 0 {
+		return units.Momentum{}, fmt.Errorf("parent mass must be positive, got %g kg", M)
+	}
+	if M < a+b {
+		return units.Momentum{}, fmt.Errorf("decay is kinematically forbidden: M = %g kg < m₁ + m₂ = %g kg", M, a+b)
+	}
+	return units.KilogramMeterPerSecond(c * math.Sqrt((M*M-(a+b)*(a+b))*(M*M-(a-b)*(a-b))) / (2 * M)), nil
+}
+
+// TwoBodyDecay returns the lab-frame four-momenta of the daughters of a
+// two-body decay. The first daughter is emitted along direction in the
+// parent rest frame and the second recoils opposite to it; direction may
+// have any dimension and need not be normalized.
+//
+// Returns an error if the parent four-momentum is not timelike, the decay
+// is kinematically forbidden, or direction is zero.
+//
+// Example:
+//
+//	// Rest-frame emission along +x, then boosted with the parent
+//	axis := vector.UnitX(units.Dimension{})
+//	p1, p2, _ := kinematics.TwoBodyDecay(parent, mpi, mpi, axis)
+func TwoBodyDecay(parent sr.FourVector, m1, m2 units.Mass, direction vector.Vector3) (sr.FourVector, sr.FourVector, error) {
+	M, err := parent.Mass()
+	if err != nil {
+		return sr.FourVector{}, sr.FourVector{}, err
+	}
+	p, err := DecayMomentum(M, m1, m2)
+	if err != nil {
+		return sr.FourVector{}, sr.FourVector{}, err
+	}
+	n := direction.ToArray()
+	norm := math.Sqrt(n[0]*n[0] + n[1]*n[1] + n[2]*n[2])
+	if norm == 0 {
+		return sr.FourVector{}, sr.FourVector{}, fmt.Errorf("decay direction must be non-zero")
+	}
+	for i := range n {
+		n[i] *= p.Val() / norm
+	}
+
+	d1, _ := sr.NewFourMomentum(sr.EnergyFromMomentum(m1, p), momentum(n))
+	d2, _ := sr.NewFourMomentum(sr.EnergyFromMomentum(m2, p), momentum([3]float64{-n[0], -n[1], -n[2]}))
+
+	// The lab moves at -v relative to the parent rest frame.
+	v, err := parent.Velocity()
+	if err != nil {
+		return sr.FourVector{}, sr.FourVector{}, err
+	}
+	if d1, err = d1.Boost(v.Negate()); err != nil {
+		return sr.FourVector{}, sr.FourVector{}, err
+	}
+	if d2, err = d2.Boost(v.Negate()); err != nil {
+		return sr.FourVector{}, sr.FourVector{}, err
+	}
+	return d1, d2, nil
+}
+
+// -----------------------------------------------------------------------------
+// Mandelstam Variables
+// -----------------------------------------------------------------------------
+
+// Mandelstam holds the Lorentz-invariant variables of a 2 → 2 process
+// p₁ + p₂ → p₃ + p₄, in units of energy squared.
+type Mandelstam struct {
+	S units.Value // c² (p₁ + p₂)²
+	T units.Value // c² (p₁ - p₃)²
+	U units.Value // c² (p₁ - p₄)²
+}
+
+// Sum returns s + t + u, which equals Σ mᵢ²c⁴ when four-momentum is conserved.
+func (m Mandelstam) Sum() units.Value {
+	return units.NewValue(m.S.Val()+m.T.Val()+m.U.Val(), energySquaredDim)
+}
+
+// MandelstamVariables computes s, t, u for p₁ + p₂ → p₃ + p₄.
+// Returns an error if any argument is not a four-momentum.
+func MandelstamVariables(p1, p2, p3, p4 sr.FourVector) (Mandelstam, error) {
+	for i, p := range []sr.FourVector{p1, p2, p3, p4} {
+		if _, err := p.Energy(); err != nil {
+			return Mandelstam{}, fmt.Errorf("p%d: %w", i+1, err)
+		}
+	}
+	s, _ := p1.Add(p2)
+	t, _ := p1.Subtract(p3)
+	u, _ := p1.Subtract(p4)
+	return Mandelstam{
+		S: units.NewValue(c*c*s.Norm2().Val(), energySquaredDim),
+		T: units.NewValue(c*c*t.Norm2().Val(), energySquaredDim),
+		U: units.NewValue(c*c*u.Norm2().Val(), energySquaredDim),
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Thresholds
+// -----------------------------------------------------------------------------
+
+// ThresholdEnergy returns the minimum total beam energy for a beam particle
+// of mass m₁ striking a stationary target of mass m₂ to produce final-state
+// particles with the given masses. For exothermic reactions the threshold
+// is the beam rest energy.
+//
+// Formula:
+//
+//	E_th = (M² - m₁² - m₂²) c² / (2 m₂),   M = Σ m_final
+func ThresholdEnergy(beamMass, targetMass units.Mass, products ...units.Mass) (units.Energy, error) {
+	if len(products) == 0 {
+		return units.Energy{}, fmt.Errorf("at least one final-state particle is required")
+	}
+	m1, m2 := beamMass.Val(), targetMass.Val()
+	if m2 <= 0 {
+		return units.Energy{}, fmt.Errorf("target mass must be positive, got %g kg", m2)
+	}
+	M := 0.0
+	for _, p := range products {
+		M += p.Val()
+	}
+	e := (M*M - m1*m1 - m2*m2) * c * c / (2 * m2)
+	return units.Joule(math.Max(e, m1*c*c)), nil
+}
+
+// ThresholdKineticEnergy returns the minimum beam kinetic energy for the
+// fixed-target reaction described in ThresholdEnergy.
+func ThresholdKineticEnergy(beamMass, targetMass units.Mass, products ...units.Mass) (units.Energy, error) {
+	e, err := ThresholdEnergy(beamMass, targetMass, products...)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(e.Val() - beamMass.Val()*c*c), nil
+}
+
+func momentum(x [3]float64) vector.Vector3 {
+	return vector.Vector3{
+		X: units.KilogramMeterPerSecond(x[0]).Value,
+		Y: units.KilogramMeterPerSecond(x[1]).Value,
+		Z: units.KilogramMeterPerSecond(x[2]).Value,
+	}
+}
@@ -0,0 +1,206 @@
+package kinematics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/relativity/sr"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b)/math.Max(math.Abs(a), math.Abs(b)) < tolerance
+}
+
+var (
+	mPion = units.MegaelectronVoltPerC2(139.57039)
+	mKaon = units.MegaelectronVoltPerC2(497.611)
+)
+
+// massOf returns the rest mass of a particle from the catalog.
+func massOf(name string) units.Mass {
+	p, _ := particles.Lookup(name)
+	return units.Mass{Value: p.Mass.Value()}
+}
+
+func toMeV(e units.Energy) float64 { return e.Val() / units.MegaelectronVolt(1).Val() }
+
+func toMeVc(p units.Momentum) float64 { return p.Val() / units.MegaelectronVoltPerC(1).Val() }
+
+func beamAlongZ(t *testing.T, m units.Mass, beta float64) sr.FourVector {
+	t.Helper()
+	p, err := sr.FourMomentumOf(m, vector.NewVelocity(units.MeterPerSecond(0), units.MeterPerSecond(0), units.SpeedOfLight(beta)))
+	if err != nil {
+		t.Fatalf("FourMomentumOf() error = %v", err)
+	}
+	return p
+}
+
+func TestCenterOfMassEnergy_Collider(t *testing.T) {
+	// Symmetric head-on beams: √s = 2E
+	m := massOf("p")
+	a := beamAlongZ(t, m, 0.99)
+	b := beamAlongZ(t, m, -0.99)
+	e, _ := a.Energy()
+
+	got, err := CenterOfMassEnergy(a, b)
+	if err != nil {
+		t.Fatalf("CenterOfMassEnergy() error = %v", err)
+	}
+	if !almostEqual(got.Val(), 2*e.Val(), 1e-12) {
+		t.Errorf("√s = %v, want 2E = %v", got.Val(), 2*e.Val())
+	}
+}
+
+func TestFixedTargetEnergy(t *testing.T) {
+	m := massOf("p")
+	beam := beamAlongZ(t, m, 0.999)
+	e, _ := beam.Energy()
+
+	want, _ := CenterOfMassEnergy(beam, sr.FourMomentumAtRest(m))
+	got, err := FixedTargetEnergy(e, m, m)
+	if err != nil {
+		t.Fatalf("FixedTargetEnergy() error = %v", err)
+	}
+	if !almostEqual(got.Val(), want.Val(), 1e-12) {
+		t.Errorf("FixedTargetEnergy = %v, want %v", got.Val(), want.Val())
+	}
+
+	if _, err := FixedTargetEnergy(units.Joule(0), m, m); err == nil {
+		t.Error("FixedTargetEnergy should reject E < mc²")
+	}
+}
+
+func TestDecayMomentum(t *testing.T) {
+	// K⁰ → π⁺π⁻: p* ≈ 206.0 MeV/c (PDG)
+	p, err := DecayMomentum(mKaon, mPion, mPion)
+	if err != nil {
+		t.Fatalf("DecayMomentum() error = %v", err)
+	}
+	if got := toMeVc(p); !almostEqual(got, 206.0, 1e-3) {
+		t.Errorf("p*(K⁰ → ππ) = %v MeV/c, want ≈ 206.0", got)
+	}
+
+	// Massless daughters carry p* = Mc/2.
+	p, _ = DecayMomentum(mKaon, units.Kilogram(0), units.Kilogram(0))
+	if got := toMeVc(p); !almostEqual(got, 497.611/2, 1e-12) {
+		t.Errorf("p*(M → γγ) = %v MeV/c, want M/2", got)
+	}
+
+	if _, err := DecayMomentum(mPion, mKaon, mPion); err == nil {
+		t.Error("DecayMomentum should reject M < m₁ + m₂")
+	}
+}
+
+func TestTwoBodyDecay_Conservation(t *testing.T) {
+	parent := beamAlongZ(t, mKaon, 0.8)
+	dir := vector.NewPosition(units.Meter(1), units.Meter(2), units.Meter(-0.5))
+
+	d1, d2, err := TwoBodyDecay(parent, mPion, mPion, dir)
+	if err != nil {
+		t.Fatalf("TwoBodyDecay() error = %v", err)
+	}
+
+	// Four-momentum is conserved and daughters are on shell.
+	sum, _ := d1.Add(d2)
+	if !almostEqual(sum.T.Val(), parent.T.Val(), 1e-12) {
+		t.Errorf("energy not conserved: %v vs %v", sum.T.Val(), parent.T.Val())
+	}
+	got, want := sum.Space.ToArray(), parent.Space.ToArray()
+	for i := range got {
+		if math.Abs(got[i]-want[i]) > 1e-12*parent.T.Val() {
+			t.Errorf("momentum[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	for _, d := range []sr.FourVector{d1, d2} {
+		m, _ := d.Mass()
+		if !almostEqual(m.Val(), mPion.Val(), 1e-9) {
+			t.Errorf("daughter mass = %v, want %v", m.Val(), mPion.Val())
+		}
+	}
+
+	// Decay at rest: back-to-back daughters with p* each.
+	d1, d2, _ = TwoBodyDecay(sr.FourMomentumAtRest(mKaon), mPion, mPion, dir)
+	p1, _ := d1.Space.Magnitude()
+	pstar, _ := DecayMomentum(mKaon, mPion, mPion)
+	if !almostEqual(p1.Val(), pstar.Val(), 1e-12) {
+		t.Errorf("|p₁| at rest = %v, want p* = %v", p1.Val(), pstar.Val())
+	}
+	if !d1.Space.IsParallel(d2.Space.Negate(), 1e-12) {
+		t.Error("daughters of a decay at rest should be back-to-back")
+	}
+
+	if _, _, err := TwoBodyDecay(parent, mPion, mPion, vector.Zero(units.Dimension{})); err == nil {
+		t.Error("TwoBodyDecay should reject a zero direction")
+	}
+}
+
+func TestMandelstamVariables(t *testing.T) {
+	// Elastic ππ scattering in the CM frame at 90°.
+	pstar := units.MegaelectronVoltPerC(300)
+	e := sr.EnergyFromMomentum(mPion, pstar)
+	zero := units.KilogramMeterPerSecond(0).Value
+	p := pstar.Value
+
+	p1, _ := sr.NewFourMomentum(e, vector.Vector3{X: zero, Y: zero, Z: p})
+	p2, _ := sr.NewFourMomentum(e, vector.Vector3{X: zero, Y: zero, Z: p.Negate()})
+	p3, _ := sr.NewFourMomentum(e, vector.Vector3{X: p, Y: zero, Z: zero})
+	p4, _ := sr.NewFourMomentum(e, vector.Vector3{X: p.Negate(), Y: zero, Z: zero})
+
+	mv, err := MandelstamVariables(p1, p2, p3, p4)
+	if err != nil {
+		t.Fatalf("MandelstamVariables() error = %v", err)
+	}
+	mev2 := math.Pow(units.MegaelectronVolt(1).Val(), 2)
+
+	// s = 4E², t = u = -2p²c² at 90°
+	if got, want := mv.S.Val()/mev2, 4*math.Pow(toMeV(e), 2); !almostEqual(got, want, 1e-9) {
+		t.Errorf("s = %v MeV², want %v", got, want)
+	}
+	if got := mv.T.Val() / mev2; !almostEqual(got, -2*300*300, 1e-9) {
+		t.Errorf("t = %v MeV², want %v", got, -2*300*300)
+	}
+	// s + t + u = Σm²c⁴
+	mc2 := 139.57039
+	if got := mv.Sum().Val() / mev2; !almostEqual(got, 4*mc2*mc2, 1e-9) {
+		t.Errorf("s + t + u = %v MeV², want %v", got, 4*mc2*mc2)
+	}
+	if mv.S.Dim() != (units.Dimension{L: 4, M: 2, T: -4}) {
+		t.Errorf("s dimension = %v, want energy²", mv.S.Dim())
+	}
+}
+
+func TestThresholdEnergy(t *testing.T) {
+	// p + p → p + p + p + p̄: T_th = 6 m_p c² ≈ 5.63 GeV
+	mp := massOf("p")
+	k, err := ThresholdKineticEnergy(mp, mp, mp, mp, mp, mp)
+	if err != nil {
+		t.Fatalf("ThresholdKineticEnergy() error = %v", err)
+	}
+	if got := toMeV(k); !almostEqual(got, 6*constants.ProtonRestEnergyMeV, 1e-9) {
+		t.Errorf("T_th(p̄) = %v MeV, want %v", got, 6*constants.ProtonRestEnergyMeV)
+	}
+
+	// At threshold √s equals the final-state rest energy.
+	e, _ := ThresholdEnergy(mp, mp, mp, mp, mp, mp)
+	s, _ := FixedTargetEnergy(e, mp, mp)
+	if !almostEqual(s.Val(), 4*sr.RestEnergy(mp).Val(), 1e-12) {
+		t.Errorf("√s at threshold = %v, want 4 m_p c²", s.Val())
+	}
+
+	// Exothermic reactions have no threshold beyond the beam rest energy.
+	e, _ = ThresholdEnergy(mKaon, mp, mPion, mp)
+	if !almostEqual(e.Val(), sr.RestEnergy(mKaon).Val(), 1e-12) {
+		t.Errorf("exothermic threshold = %v, want rest energy", e.Val())
+	}
+
+	if _, err := ThresholdEnergy(mp, mp); err == nil {
+		t.Error("ThresholdEnergy should require final-state particles")
+	}
+}
@@ -0,0 +1,33 @@
+// Package scattering provides cross-sections and kinematics for the
+// classic electromagnetic scattering processes: Compton scattering of
+// photons off electrons, Thomson scattering in the low-energy limit, and
+// Rutherford scattering of charged particles off nuclei.
+//
+// Scattering angles are in radians. Differential cross-sections are
+// returned as units.Area per steradian; use ToBarns for nuclear units.
+// Electron properties are taken from the constants package.
+//
+// Example usage:
+//
+//	import (
+//	    "math"
+//
+//	    "github.com/sakiphan/qsim-core/particle/scattering"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// 662 keV gamma (Cs-137) backscattered off an electron
+//	shift := scattering.ComptonShift(math.Pi)                                      // 2λ_C ≈ 4.85 pm
+//	e, _ := scattering.ScatteredPhotonEnergy(units.KiloelectronVolt(662), math.Pi) // ≈ 184 keV
+//
+//	// Geiger-Marsden: 5 MeV alphas on gold at 60°
+//	dsdo, _ := scattering.RutherfordCrossSection(2, 79, units.MegaelectronVolt(5), math.Pi/3)
+//	barns := dsdo.ToBarns() // ≈ 20.7 b/sr
+//
+//	sigmaT := scattering.ThomsonCrossSection() // ≈ 0.665 b
+//
+// References:
+//   - Griffiths. "Introduction to Elementary Particles", 2nd ed., Ch. 3
+//   - Krane. "Introductory Nuclear Physics", Ch. 3, 8
+//   - Jackson. "Classical Electrodynamics", 3rd ed., §14.7
+package scattering
@@ -0,0 +1,130 @@
+package scattering
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Compton Scattering
+// -----------------------------------------------------------------------------
+
+// ComptonShift returns the increase in wavelength Δλ of a photon
+// scattered off a free electron at rest through angle θ.
+//
+// Formula:
+//
+//	Δλ = λ_C (1 - cos θ),   λ_C = h / (m_e c)
+func ComptonShift(theta float64) units.Length {
+	return units.Meter(constants.ElectronComptonWavelength.Val() * (1 - math.Cos(theta)))
+}
+
+// ScatteredPhotonEnergy returns the energy of a photon of incident energy
+// E after Compton scattering through angle θ off a free electron at rest.
+// Returns an error if E is not positive.
+//
+// Formula:
+//
+//	E' = E / (1 + (E / m_e c²)(1 - cos θ))
+func ScatteredPhotonEnergy(e units.Energy, theta float64) (units.Energy, error) {
+	if !(e.Val() > 0) {
+		return units.Energy{}, fmt.Errorf("photon energy must be positive, got %g J", e.Val())
+	}
+	k := e.Val() / constants.ElectronRestEnergy.Val()
+	return units.Joule(e.Val() / (1 + k*(1-math.Cos(theta)))), nil
+}
+
+// RecoilElectronEnergy returns the kinetic energy E - E' transferred to
+// the electron when a photon of energy E Compton scatters through angle θ.
+// At θ = π this is the Compton edge seen in gamma-ray spectra.
+// Returns an error if E is not positive.
+func RecoilElectronEnergy(e units.Energy, theta float64) (units.Energy, error) {
+	scattered, err := ScatteredPhotonEnergy(e, theta)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(e.Val() - scattered.Val()), nil
+}
+
+// -----------------------------------------------------------------------------
+// Thomson Scattering
+// -----------------------------------------------------------------------------
+
+// ClassicalElectronRadius returns r_e = α λ_C / 2π = e²/(4πε₀ m_e c²).
+func ClassicalElectronRadius() units.Length {
+	alpha := constants.FineStructureConstant.Val()
+	return units.Meter(alpha * constants.ElectronComptonWavelength.Val() / (2 * math.Pi))
+}
+
+// ThomsonCrossSection returns the total cross-section for scattering of
+// low-energy (E ≪ m_e c²) electromagnetic radiation off a free electron.
+//
+// Formula:
+//
+//	σ_T = (8π/3) r_e²
+func ThomsonCrossSection() units.Area {
+	re := ClassicalElectronRadius().Val()
+	return units.SquareMeter(8 * math.Pi / 3 * re * re)
+}
+
+// ThomsonDifferential returns the differential Thomson cross-section
+// dσ/dΩ per steradian for unpolarized radiation scattered through angle θ.
+//
+// Formula:
+//
+//	dσ/dΩ = (r_e² / 2)(1 + cos² θ)
+func ThomsonDifferential(theta float64) units.Area {
+	re := ClassicalElectronRadius().Val()
+	cos := math.Cos(theta)
+	return units.SquareMeter(re * re / 2 * (1 + cos*cos))
+}
+
+// -----------------------------------------------------------------------------
+// Rutherford Scattering
+// -----------------------------------------------------------------------------
+
+// RutherfordCrossSection returns the differential cross-section dσ/dΩ per
+// steradian for a nonrelativistic projectile of charge z e and kinetic
+// energy T scattering through angle θ off a fixed nucleus of charge Z e.
+// Returns an error if T is not positive, either charge number is zero, or
+// θ is not in (0, π].
+//
+// Formula:
+//
+//	dσ/dΩ = (z Z e² / (16π ε₀ T))² / sin⁴(θ/2)
+func RutherfordCrossSection(z, Z int, t units.Energy, theta float64) (units.Area, error) {
+	if z == 0 || Z == 0 {
+		return units.Area{}, fmt.Errorf("charge numbers must be nonzero, got z = %d, Z = %d", z, Z)
+	}
+	if !(t.Val() > 0) {
+		return units.Area{}, fmt.Errorf("kinetic energy must be positive, got %g J", t.Val())
+	}
+	if !(theta > 0 && theta <= math.Pi) {
+		return units.Area{}, fmt.Errorf("scattering angle must be in (0, π], got %g rad", theta)
+	}
+	d := closestApproach(z, Z, t) / 4
+	s := math.Sin(theta / 2)
+	return units.SquareMeter(d * d / (s * s * s * s)), nil
+}
+
+// DistanceOfClosestApproach returns the separation d = z Z e²/(4πε₀ T) at
+// which a head-on projectile of kinetic energy T comes to rest. Returns an
+// error if T is not positive or the charges do not repel.
+func DistanceOfClosestApproach(z, Z int, t units.Energy) (units.Length, error) {
+	if z*Z <= 0 {
+		return units.Length{}, fmt.Errorf("charges must repel, got z = %d, Z = %d", z, Z)
+	}
+	if !(t.Val() > 0) {
+		return units.Length{}, fmt.Errorf("kinetic energy must be positive, got %g J", t.Val())
+	}
+	return units.Meter(closestApproach(z, Z, t)), nil
+}
+
+// closestApproach returns |z Z| e² k_e / T in meters.
+func closestApproach(z, Z int, t units.Energy) float64 {
+	e := constants.ElementaryCharge.Val()
+	return math.Abs(float64(z*Z)) * e * e * constants.CoulombConstant.Val() / t.Val()
+}
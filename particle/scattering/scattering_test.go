@@ -0,0 +1,103 @@
+package scattering
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestCompton(t *testing.T) {
+	lc := constants.ElectronComptonWavelength.Val()
+	if s := ComptonShift(math.Pi / 2); !almostEqual(s.Val(), lc, 1e-14) {
+		t.Errorf("ComptonShift(π/2) = %v, want λ_C", s)
+	}
+	if s := ComptonShift(0); s.Val() != 0 {
+		t.Errorf("ComptonShift(0) = %v, want 0", s)
+	}
+
+	// Cs-137: 661.657 keV line, Compton edge at 477.3 keV.
+	e := units.KiloelectronVolt(661.657)
+	back, err := ScatteredPhotonEnergy(e, math.Pi)
+	if err != nil || !almostEqual(back.ToKeV(), 184.3, 1e-3) {
+		t.Errorf("backscatter energy = %v keV, %v; want ≈ 184.3", back.ToKeV(), err)
+	}
+	edge, _ := RecoilElectronEnergy(e, math.Pi)
+	if !almostEqual(edge.ToKeV(), 477.3, 1e-3) {
+		t.Errorf("Compton edge = %v keV, want ≈ 477.3", edge.ToKeV())
+	}
+
+	// Energy and wavelength forms agree: λ' - λ = hc/E' - hc/E.
+	hc := constants.PlanckConstant.Val() * constants.SpeedOfLight.Val()
+	theta := 1.2
+	ep, _ := ScatteredPhotonEnergy(e, theta)
+	if dl := hc/ep.Val() - hc/e.Val(); !almostEqual(dl, ComptonShift(theta).Val(), 1e-8) {
+		t.Errorf("hc/E' - hc/E = %v, want %v", dl, ComptonShift(theta).Val())
+	}
+
+	if _, err := ScatteredPhotonEnergy(units.Joule(0), 1); err == nil {
+		t.Error("ScatteredPhotonEnergy should reject zero energy")
+	}
+}
+
+func TestThomson(t *testing.T) {
+	if re := ClassicalElectronRadius(); !almostEqual(re.Val(), 2.8179403262e-15, 1e-9) {
+		t.Errorf("r_e = %v, want 2.8179e-15 m", re)
+	}
+	sigma := ThomsonCrossSection()
+	if !almostEqual(sigma.ToBarns(), 0.6652458732, 1e-9) {
+		t.Errorf("σ_T = %v b, want 0.66525", sigma.ToBarns())
+	}
+
+	// Integrating dσ/dΩ over the sphere recovers σ_T.
+	n, sum := 2000, 0.0
+	for i := 0; i < n; i++ {
+		th := (float64(i) + 0.5) * math.Pi / float64(n)
+		sum += ThomsonDifferential(th).Val() * 2 * math.Pi * math.Sin(th) * math.Pi / float64(n)
+	}
+	if !almostEqual(sum, sigma.Val(), 1e-6) {
+		t.Errorf("∫dσ/dΩ = %v, want %v", sum, sigma.Val())
+	}
+}
+
+func TestRutherford(t *testing.T) {
+	// 5 MeV alphas on gold: d = 2·79·1.44 MeV fm / 5 MeV ≈ 45.5 fm.
+	ta := units.MegaelectronVolt(5)
+	d, err := DistanceOfClosestApproach(2, 79, ta)
+	if err != nil || !almostEqual(d.Val(), 45.50e-15, 1e-3) {
+		t.Errorf("closest approach = %v, %v; want ≈ 45.5 fm", d, err)
+	}
+
+	// At θ = π, dσ/dΩ = (d/4)².
+	ds, err := RutherfordCrossSection(2, 79, ta, math.Pi)
+	if err != nil || !almostEqual(ds.Val(), d.Val()*d.Val()/16, 1e-12) {
+		t.Errorf("dσ/dΩ(π) = %v, %v", ds, err)
+	}
+	if ds.Dim() != units.Barn(1).Dim() {
+		t.Errorf("cross-section dimension = %v", ds.Dim())
+	}
+	ds60, _ := RutherfordCrossSection(2, 79, ta, math.Pi/3)
+	if !almostEqual(ds60.ToBarns(), 20.70, 1e-3) || !almostEqual(ds60.Val(), 16*ds.Val(), 1e-12) {
+		t.Errorf("dσ/dΩ(60°) = %v b/sr, want ≈ 20.7", ds60.ToBarns())
+	}
+	// Cross-section scales as 1/T².
+	ds2, _ := RutherfordCrossSection(2, 79, units.MegaelectronVolt(10), math.Pi/3)
+	if !almostEqual(ds60.Val()/ds2.Val(), 4, 1e-12) {
+		t.Errorf("energy scaling = %v, want 4", ds60.Val()/ds2.Val())
+	}
+
+	if _, err := RutherfordCrossSection(2, 79, ta, 0); err == nil {
+		t.Error("RutherfordCrossSection should reject θ = 0")
+	}
+	if _, err := RutherfordCrossSection(0, 79, ta, 1); err == nil {
+		t.Error("RutherfordCrossSection should reject a neutral projectile")
+	}
+	if _, err := DistanceOfClosestApproach(-1, 79, ta); err == nil {
+		t.Error("DistanceOfClosestApproach should reject attractive charges")
+	}
+}
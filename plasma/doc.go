@@ -0,0 +1,31 @@
+// Package plasma computes the characteristic length, frequency and speed
+// scales of a plasma from its density, temperature and magnetic field.
+//
+// Densities are units.NumberDensity, temperatures units.Temperature (use
+// TemperatureFromEnergy for temperatures quoted in eV), and frequencies are
+// angular frequencies in rad/s. A Species gives the charge and mass of the
+// particles the quantity refers to; Electron and Proton are predefined.
+//
+// Thermal velocities use the one-dimensional convention v_th = √(k_B T/m);
+// multiply by √2 for the most probable speed.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/plasma"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Tokamak core: n = 10²⁰ m⁻³, T = 10 keV, B = 5 T
+//	n := units.PerMeter3(1e20)
+//	te := plasma.TemperatureFromEnergy(units.KiloelectronVolt(10))
+//	lambdaD, _ := plasma.DebyeLength(n, te)                             // ≈ 74 μm
+//	wpe, _ := plasma.PlasmaFrequency(plasma.Electron, n)                // ≈ 5.6e11 rad/s
+//	wce, _ := plasma.CyclotronFrequency(plasma.Electron, units.Tesla(5)) // ≈ 8.8e11 rad/s
+//	lnL, _ := plasma.CoulombLogarithm(n, te)                            // ≈ 21
+//
+// References:
+//   - Chen. "Introduction to Plasma Physics and Controlled Fusion", 3rd ed.,
+//     Ch. 1-2
+//   - Huba. "NRL Plasma Formulary", Naval Research Laboratory (2019)
+package plasma
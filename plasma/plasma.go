@@ -0,0 +1,161 @@
+package plasma
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	eps0 = constants.VacuumPermittivity.Val()
+	kB   = constants.BoltzmannConstant.Val()
+)
+
+// Species is a kind of charged particle in a plasma.
+type Species struct {
+	Charge units.Charge
+	Mass   units.Mass
+}
+
+// Predefined species.
+var (
+	Electron = species("e-")
+	Proton   = species("p")
+)
+
+// species returns the charge and mass of a particle from the catalog.
+func species(name string) Species {
+	p, _ := particles.Lookup(name)
+	return Species{Charge: p.Charge, Mass: units.Mass{Value: p.Mass.Value()}}
+}
+
+func (s Species) check() error {
+	if !(s.Mass.Val() > 0) {
+		return fmt.Errorf("species mass must be positive, got %g kg", s.Mass.Val())
+	}
+	if s.Charge.Val() == 0 {
+		return fmt.Errorf("species must be charged")
+	}
+	return nil
+}
+
+// TemperatureFromEnergy returns the temperature T = E/k_B corresponding to
+// a thermal energy, e.g. 1 eV ≈ 11 604.5 K.
+func TemperatureFromEnergy(e units.Energy) units.Temperature {
+	return units.Kelvin(e.Val() / kB)
+}
+
+func checkState(n units.NumberDensity, t units.Temperature) error {
+	if !(n.Val() > 0) {
+		return fmt.Errorf("density must be positive, got %g m⁻³", n.Val())
+	}
+	if !(t.Val() > 0) {
+		return fmt.Errorf("temperature must be positive, got %g K", t.Val())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Length Scales
+// -----------------------------------------------------------------------------
+
+// DebyeLength returns the electron Debye length, the distance over which
+// the plasma screens a test charge. Returns an error if n or T is not
+// positive.
+//
+// Formula:
+//
+//	λ_D = √(ε₀ k_B T / (n e²))
+func DebyeLength(n units.NumberDensity, t units.Temperature) (units.Length, error) {
+	if err := checkState(n, t); err != nil {
+		return units.Length{}, err
+	}
+	e := constants.ElementaryCharge.Val()
+	return units.Meter(math.Sqrt(eps0 * kB * t.Val() / (n.Val() * e * e))), nil
+}
+
+// PlasmaParameter returns N_D = (4π/3) n λ_D³, the number of particles in
+// a Debye sphere. A plasma is weakly coupled when N_D ≫ 1.
+// Returns an error if n or T is not positive.
+func PlasmaParameter(n units.NumberDensity, t units.Temperature) (float64, error) {
+	ld, err := DebyeLength(n, t)
+	if err != nil {
+		return 0, err
+	}
+	return 4 * math.Pi / 3 * n.Val() * math.Pow(ld.Val(), 3), nil
+}
+
+// LarmorRadius returns the gyroradius r_L = v_th / ω_c of a thermal
+// particle of species s. Returns an error if T is not positive, B is zero
+// or the species is invalid.
+func LarmorRadius(s Species, t units.Temperature, b units.MagneticField) (units.Length, error) {
+	v, err := ThermalVelocity(s, t)
+	if err != nil {
+		return units.Length{}, err
+	}
+	wc, err := CyclotronFrequency(s, b)
+	if err != nil {
+		return units.Length{}, err
+	}
+	if wc.Val() == 0 {
+		return units.Length{}, fmt.Errorf("magnetic field must be nonzero")
+	}
+	return units.Meter(v.Val() / wc.Val()), nil
+}
+
+// -----------------------------------------------------------------------------
+// Frequencies and Speeds
+// -----------------------------------------------------------------------------
+
+// PlasmaFrequency returns the plasma oscillation frequency of species s at
+// density n. Returns an error if n is not positive or the species is
+// invalid.
+//
+// Formula:
+//
+//	ω_p = √(n q² / (ε₀ m))
+func PlasmaFrequency(s Species, n units.NumberDensity) (units.AngularVelocity, error) {
+	if err := s.check(); err != nil {
+		return units.AngularVelocity{}, err
+	}
+	if !(n.Val() > 0) {
+		return units.AngularVelocity{}, fmt.Errorf("density must be positive, got %g m⁻³", n.Val())
+	}
+	q := s.Charge.Val()
+	return units.RadianPerSecond(math.Sqrt(n.Val() * q * q / (eps0 * s.Mass.Val()))), nil
+}
+
+// CyclotronFrequency returns the gyrofrequency ω_c = |q|B/m of species s.
+// Returns an error if the species is invalid.
+func CyclotronFrequency(s Species, b units.MagneticField) (units.AngularVelocity, error) {
+	if err := s.check(); err != nil {
+		return units.AngularVelocity{}, err
+	}
+	return units.RadianPerSecond(math.Abs(s.Charge.Val() * b.Val() / s.Mass.Val())), nil
+}
+
+// ThermalVelocity returns v_th = √(k_B T / m) for species s.
+// Returns an error if T is not positive or the species mass is not positive.
+func ThermalVelocity(s Species, t units.Temperature) (units.Velocity, error) {
+	if !(s.Mass.Val() > 0) {
+		return units.Velocity{}, fmt.Errorf("species mass must be positive, got %g kg", s.Mass.Val())
+	}
+	if !(t.Val() > 0) {
+		return units.Velocity{}, fmt.Errorf("temperature must be positive, got %g K", t.Val())
+	}
+	return units.MeterPerSecond(math.Sqrt(kB * t.Val() / s.Mass.Val())), nil
+}
+
+// CoulombLogarithm returns ln Λ with Λ = 12π n λ_D³, the logarithm of the
+// ratio of maximum to minimum impact parameters in Coulomb collisions.
+// Returns an error if n or T is not positive.
+func CoulombLogarithm(n units.NumberDensity, t units.Temperature) (float64, error) {
+	nd, err := PlasmaParameter(n, t)
+	if err != nil {
+		return 0, err
+	}
+	return math.Log(9 * nd), nil
+}
@@ -0,0 +1,80 @@
+package plasma
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestLengthScales(t *testing.T) {
+	if te := TemperatureFromEnergy(units.ElectronVolt(1)); !almostEqual(te.Val(), 11604.518, 1e-7) {
+		t.Errorf("1 eV = %v K, want 11604.518", te.Val())
+	}
+
+	// NRL formulary: λ_D = 7.43e2 T_eV^½ n_cm⁻³^-½ cm.
+	n := units.PerCentimeter3(1e12)
+	te := TemperatureFromEnergy(units.ElectronVolt(100))
+	ld, err := DebyeLength(n, te)
+	if err != nil || !almostEqual(ld.Val(), 7.43e2*10/1e6*1e-2, 1e-3) {
+		t.Errorf("DebyeLength() = %v, %v; want ≈ 7.43e-5 m", ld, err)
+	}
+	nd, _ := PlasmaParameter(n, te)
+	if !almostEqual(nd, 4*math.Pi/3*1e18*math.Pow(ld.Val(), 3), 1e-12) || nd < 1 {
+		t.Errorf("PlasmaParameter() = %v", nd)
+	}
+	// ln Λ = ln(12π n λ_D³) ≈ 15-16 for these conditions.
+	if lnL, _ := CoulombLogarithm(n, te); !almostEqual(lnL, math.Log(12*math.Pi*1e18*math.Pow(ld.Val(), 3)), 1e-12) || lnL < 14 || lnL > 17 {
+		t.Errorf("CoulombLogarithm() = %v", lnL)
+	}
+
+	// r_L = v_th/ω_c: NRL gives 2.38 T_eV^½ / B_gauss cm for electrons.
+	rl, err := LarmorRadius(Electron, te, units.Tesla(0.1))
+	if err != nil || !almostEqual(rl.Val(), 2.38*10/1000*1e-2, 2e-3) {
+		t.Errorf("LarmorRadius() = %v, %v; want ≈ 2.38e-4 m", rl, err)
+	}
+
+	if _, err := DebyeLength(units.PerMeter3(0), te); err == nil {
+		t.Error("DebyeLength should reject zero density")
+	}
+	if _, err := LarmorRadius(Proton, te, units.Tesla(0)); err == nil {
+		t.Error("LarmorRadius should reject zero field")
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	// NRL formulary: f_pe = 8.98e3 n_cm⁻³^½ Hz.
+	n := units.PerCentimeter3(1e10)
+	wpe, err := PlasmaFrequency(Electron, n)
+	if err != nil || !almostEqual(wpe.Val()/(2*math.Pi), 8.98e3*1e5, 1e-3) {
+		t.Errorf("f_pe = %v Hz, %v; want 8.98e8", wpe.Val()/(2*math.Pi), err)
+	}
+	// ω_pi/ω_pe = √(m_e/m_p).
+	wpi, _ := PlasmaFrequency(Proton, n)
+	if !almostEqual(wpi.Val()/wpe.Val(), math.Sqrt(Electron.Mass.Val()/Proton.Mass.Val()), 1e-12) {
+		t.Errorf("ω_pi/ω_pe = %v", wpi.Val()/wpe.Val())
+	}
+
+	// f_ce = 28 GHz/T.
+	wce, _ := CyclotronFrequency(Electron, units.Tesla(1))
+	if !almostEqual(wce.Val()/(2*math.Pi), 27.992e9, 1e-4) {
+		t.Errorf("f_ce(1 T) = %v Hz, want 27.99 GHz", wce.Val()/(2*math.Pi))
+	}
+
+	// v_te = 4.19e5 T_eV^½ m/s.
+	v, err := ThermalVelocity(Electron, TemperatureFromEnergy(units.ElectronVolt(1)))
+	if err != nil || !almostEqual(v.Val(), 4.19e5, 1e-3) {
+		t.Errorf("ThermalVelocity(1 eV) = %v, %v; want 4.19e5 m/s", v, err)
+	}
+
+	if _, err := CyclotronFrequency(Species{Mass: units.Kilogram(1)}, units.Tesla(1)); err == nil {
+		t.Error("CyclotronFrequency should reject a neutral species")
+	}
+	if _, err := ThermalVelocity(Electron, units.Kelvin(0)); err == nil {
+		t.Error("ThermalVelocity should reject zero temperature")
+	}
+}
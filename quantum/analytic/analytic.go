@@ -0,0 +1,256 @@
+package analytic
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// hbar is the reduced Planck constant in J·s.
+var hbar = constants.PlanckReduced.Val()
+
+// The electron, and the proton as the nucleus of hydrogen.
+var (
+	electron, _ = particles.Lookup("e-")
+	proton, _   = particles.Lookup("p")
+)
+
+// -----------------------------------------------------------------------------
+// Particle in a Box
+// -----------------------------------------------------------------------------
+
+// BoxEnergy returns the energy of level n ≥ 1 of a particle of mass m in an
+// infinite square well of width L.
+// Returns an error if n < 1 or m or L is not positive.
+//
+// Formula:
+//
+//	Eₙ = n²π²ℏ² / (2mL²)
+func BoxEnergy(n int, m units.Mass, width units.Length) (units.Energy, error) {
+	if err := checkBox(n, width); err != nil {
+		return units.Energy{}, err
+	}
+	if !(m.Val() > 0) {
+		return units.Energy{}, fmt.Errorf("mass must be positive, got %g kg", m.Val())
+	}
+	k := float64(n) * math.Pi / width.Val()
+	return units.Joule(hbar * hbar * k * k / (2 * m.Val())), nil
+}
+
+// BoxWavefunction returns ψₙ(x) = √(2/L) sin(nπx/L) in m^-1/2 for the well
+// occupying [0, L]. It is zero outside the well.
+// Returns an error if n < 1 or L is not positive.
+func BoxWavefunction(n int, width, x units.Length) (float64, error) {
+	if err := checkBox(n, width); err != nil {
+		return 0, err
+	}
+	l := width.Val()
+	if x.Val() < 0 || x.Val() > l {
+		return 0, nil
+	}
+	return math.Sqrt(2/l) * math.Sin(float64(n)*math.Pi*x.Val()/l), nil
+}
+
+func checkBox(n int, width units.Length) error {
+	if n < 1 {
+		return fmt.Errorf("quantum number must be at least 1, got %d", n)
+	}
+	if !(width.Val() > 0) {
+		return fmt.Errorf("box width must be positive, got %g m", width.Val())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Harmonic Oscillator
+// -----------------------------------------------------------------------------
+
+// OscillatorEnergy returns Eₙ = ℏω(n + ½) for level n ≥ 0 of a harmonic
+// oscillator with angular frequency ω. Returns an error if n < 0.
+func OscillatorEnergy(n int, omega units.AngularVelocity) (units.Energy, error) {
+	if n < 0 {
+		return units.Energy{}, fmt.Errorf("quantum number must be non-negative, got %d", n)
+	}
+	return units.Joule(hbar * omega.Val() * (float64(n) + 0.5)), nil
+}
+
+// OscillatorLength returns the characteristic length x₀ = √(ℏ/mω) of a
+// harmonic oscillator; the ground state has Δx = x₀/√2.
+func OscillatorLength(m units.Mass, omega units.AngularVelocity) units.Length {
+	return units.Meter(math.Sqrt(hbar / (m.Val() * omega.Val())))
+}
+
+// OscillatorWavefunction returns the normalized eigenfunction ψₙ(x) in
+// m^-1/2 of a particle of mass m in the potential ½mω²x².
+// Returns an error if n < 0 or m or ω is not positive.
+//
+// The functions are evaluated by the stable three-term recurrence
+// ψₙ₊₁ = √(2/(n+1)) ξ ψₙ - √(n/(n+1)) ψₙ₋₁ with ξ = x/x₀, avoiding the
+// overflow of Hₙ(ξ) for large n.
+//
+// Formula:
+//
+//	ψₙ(x) = (1/√(2ⁿ n!)) (mω/πℏ)^¼ Hₙ(ξ) e^{-ξ²/2}
+func OscillatorWavefunction(n int, m units.Mass, omega units.AngularVelocity, x units.Length) (float64, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("quantum number must be non-negative, got %d", n)
+	}
+	if !(m.Val() > 0) || !(omega.Val() > 0) {
+		return 0, fmt.Errorf("mass and angular frequency must be positive, got %g kg and %g rad/s", m.Val(), omega.Val())
+	}
+	x0 := OscillatorLength(m, omega).Val()
+	xi := x.Val() / x0
+	prev, cur := 0.0, math.Pow(math.Pi*x0*x0, -0.25)*math.Exp(-xi*xi/2)
+	for k := 0; k < n; k++ {
+		kf := float64(k)
+		prev, cur = cur, math.Sqrt(2/(kf+1))*xi*cur-math.Sqrt(kf/(kf+1))*prev
+	}
+	return cur, nil
+}
+
+// Hermite returns the physicists' Hermite polynomial Hₙ(x), or NaN if n < 0.
+//
+// Formula:
+//
+//	H₀ = 1, H₁ = 2x, Hₙ₊₁ = 2x Hₙ - 2n Hₙ₋₁
+func Hermite(n int, x float64) float64 {
+	if n < 0 {
+		return math.NaN()
+	}
+	prev, cur := 0.0, 1.0
+	for k := 0; k < n; k++ {
+		prev, cur = cur, 2*x*cur-2*float64(k)*prev
+	}
+	return cur
+}
+
+// Laguerre returns the generalized Laguerre polynomial L⁽ᵅ⁾ₙ(x), or NaN if
+// n < 0.
+//
+// Formula:
+//
+//	L₀ = 1, L₁ = 1 + α - x, (n+1)Lₙ₊₁ = (2n + 1 + α - x)Lₙ - (n + α)Lₙ₋₁
+func Laguerre(n int, alpha, x float64) float64 {
+	if n < 0 {
+		return math.NaN()
+	}
+	prev, cur := 0.0, 1.0
+	for k := 0; k < n; k++ {
+		kf := float64(k)
+		prev, cur = cur, ((2*kf+1+alpha-x)*cur-(kf+alpha)*prev)/(kf+1)
+	}
+	return cur
+}
+
+// -----------------------------------------------------------------------------
+// Hydrogen-like Atom
+// -----------------------------------------------------------------------------
+
+// HydrogenLike is a one-electron atom or ion with nuclear charge Ze,
+// including the reduced-mass correction for a nucleus of finite mass.
+type HydrogenLike struct {
+	Z           int        // nuclear charge number
+	ReducedMass units.Mass // μ = m_e M / (m_e + M)
+}
+
+// Hydrogen returns ¹H: Z = 1 with the electron-proton reduced mass.
+func Hydrogen() HydrogenLike {
+	h, _ := NewHydrogenLike(1, units.Mass{Value: proton.Mass.Value()})
+	return h
+}
+
+// NewHydrogenLike returns the hydrogen-like system with nuclear charge
+// number Z and nuclear mass M. Returns an error if Z < 1 or M is not
+// positive.
+//
+// Example:
+//
+//	// He⁺ with the alpha-particle mass
+//	he, _ := analytic.NewHydrogenLike(2, constants.AlphaParticleMass)
+func NewHydrogenLike(z int, nucleusMass units.Mass) (HydrogenLike, error) {
+	if z < 1 {
+		return HydrogenLike{}, fmt.Errorf("nuclear charge number must be at least 1, got %d", z)
+	}
+	if !(nucleusMass.Val() > 0) {
+		return HydrogenLike{}, fmt.Errorf("nuclear mass must be positive, got %g kg", nucleusMass.Val())
+	}
+	me, mn := electron.Mass.Value().Val(), nucleusMass.Val()
+	return HydrogenLike{Z: z, ReducedMass: units.Kilogram(me * mn / (me + mn))}, nil
+}
+
+// BohrRadius returns the reduced-mass Bohr radius a_μ = a₀ m_e/μ.
+func (h HydrogenLike) BohrRadius() units.Length {
+	return units.Meter(constants.BohrRadius.Val() * electron.Mass.Value().Val() / h.ReducedMass.Val())
+}
+
+// Energy returns the binding energy of shell n ≥ 1 (negative).
+// Returns an error if n < 1.
+//
+// Formula:
+//
+//	Eₙ = -Z² e² / (8πε₀ a_μ n²)
+func (h HydrogenLike) Energy(n int) (units.Energy, error) {
+	if n < 1 {
+		return units.Energy{}, fmt.Errorf("principal quantum number must be at least 1, got %d", n)
+	}
+	e := constants.ElementaryCharge.Val()
+	z, nf := float64(h.Z), float64(n)
+	return units.Joule(-z * z * e * e / (8 * math.Pi * constants.VacuumPermittivity.Val() * h.BohrRadius().Val() * nf * nf)), nil
+}
+
+// OrbitRadius returns the Bohr-model radius rₙ = n² a_μ / Z of shell n ≥ 1.
+// Returns an error if n < 1.
+func (h HydrogenLike) OrbitRadius(n int) (units.Length, error) {
+	if n < 1 {
+		return units.Length{}, fmt.Errorf("principal quantum number must be at least 1, got %d", n)
+	}
+	return units.Meter(float64(n*n) * h.BohrRadius().Val() / float64(h.Z)), nil
+}
+
+// ExpectationRadius returns the quantum-mechanical mean radius ⟨r⟩ of the
+// (n, l) orbital. Returns an error unless n ≥ 1 and 0 ≤ l < n.
+//
+// Formula:
+//
+//	⟨r⟩ = (a_μ / 2Z) [3n² - l(l + 1)]
+func (h HydrogenLike) ExpectationRadius(n, l int) (units.Length, error) {
+	if err := checkOrbital(n, l); err != nil {
+		return units.Length{}, err
+	}
+	return units.Meter(h.BohrRadius().Val() / (2 * float64(h.Z)) * float64(3*n*n-l*(l+1))), nil
+}
+
+// RadialFunction returns the normalized radial wavefunction Rₙₗ(r) in
+// m^-3/2, with ∫ Rₙₗ² r² dr = 1. Returns an error unless n ≥ 1 and
+// 0 ≤ l < n, or if r is negative.
+//
+// Formula:
+//
+//	Rₙₗ(r) = √((2Z/na_μ)³ (n-l-1)! / (2n (n+l)!)) ρˡ e^{-ρ/2} L⁽²ˡ⁺¹⁾ₙ₋ₗ₋₁(ρ),  ρ = 2Zr/(na_μ)
+func (h HydrogenLike) RadialFunction(n, l int, r units.Length) (float64, error) {
+	if err := checkOrbital(n, l); err != nil {
+		return 0, err
+	}
+	if r.Val() < 0 {
+		return 0, fmt.Errorf("radius must be non-negative, got %g m", r.Val())
+	}
+	k := 2 * float64(h.Z) / (float64(n) * h.BohrRadius().Val())
+	rho := k * r.Val()
+	lg1, _ := math.Lgamma(float64(n - l))     // ln (n-l-1)!
+	lg2, _ := math.Lgamma(float64(n + l + 1)) // ln (n+l)!
+	norm := math.Sqrt(k * k * k / (2 * float64(n)) * math.Exp(lg1-lg2))
+	return norm * math.Pow(rho, float64(l)) * math.Exp(-rho/2) * Laguerre(n-l-1, float64(2*l+1), rho), nil
+}
+
+func checkOrbital(n, l int) error {
+	if n < 1 {
+		return fmt.Errorf("principal quantum number must be at least 1, got %d", n)
+	}
+	if l < 0 || l >= n {
+		return fmt.Errorf("orbital quantum number must be in [0, %d), got %d", n, l)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package analytic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// integrate returns ∫ f dx over [a, b] by the composite Simpson rule.
+func integrate(f func(x float64) float64, a, b float64, n int) float64 {
+	h := (b - a) / float64(n)
+	sum := f(a) + f(b)
+	for i := 1; i < n; i++ {
+		w := 2.0
+		if i%2 == 1 {
+			w = 4
+		}
+		sum += w * f(a+float64(i)*h)
+	}
+	return sum * h / 3
+}
+
+func TestBox(t *testing.T) {
+	m, l := units.Mass{Value: electron.Mass.Value()}, units.Nanometer(1)
+	e1, err := BoxEnergy(1, m, l)
+	if err != nil {
+		t.Fatalf("BoxEnergy() error = %v", err)
+	}
+	if !almostEqual(e1.ToElectronVolts(), 0.376, 1e-3) {
+		t.Errorf("E₁ = %v eV, want ≈ 0.376", e1.ToElectronVolts())
+	}
+	e3, _ := BoxEnergy(3, m, l)
+	if !almostEqual(e3.Val(), 9*e1.Val(), 1e-12) {
+		t.Errorf("E₃ = %v, want 9E₁", e3)
+	}
+
+	// ψₙ are orthonormal on [0, L].
+	for _, p := range [][2]int{{1, 1}, {2, 2}, {1, 2}, {2, 5}} {
+		got := integrate(func(x float64) float64 {
+			a, _ := BoxWavefunction(p[0], l, units.Meter(x))
+			b, _ := BoxWavefunction(p[1], l, units.Meter(x))
+			return a * b
+		}, 0, l.Val(), 1000)
+		want := 0.0
+		if p[0] == p[1] {
+			want = 1
+		}
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("⟨ψ%d|ψ%d⟩ = %v, want %v", p[0], p[1], got, want)
+		}
+	}
+	if v, _ := BoxWavefunction(1, l, units.Nanometer(2)); v != 0 {
+		t.Errorf("ψ outside the box = %v, want 0", v)
+	}
+	if _, err := BoxEnergy(0, m, l); err == nil {
+		t.Error("BoxEnergy should reject n = 0")
+	}
+	if _, err := BoxEnergy(1, m, units.Meter(0)); err == nil {
+		t.Error("BoxEnergy should reject zero width")
+	}
+	if _, err := BoxEnergy(1, units.Kilogram(-1), l); err == nil {
+		t.Error("BoxEnergy should reject negative mass")
+	}
+}
+
+func TestSpecialFunctions(t *testing.T) {
+	x := 0.7
+	hermite := []float64{1, 2 * x, 4*x*x - 2, 8*x*x*x - 12*x, 16*math.Pow(x, 4) - 48*x*x + 12}
+	for n, want := range hermite {
+		if got := Hermite(n, x); !almostEqual(got, want, 1e-14) {
+			t.Errorf("H%d(%v) = %v, want %v", n, x, got, want)
+		}
+	}
+	// L⁽ᵅ⁾₂(x) = (x² - 2(α+2)x + (α+1)(α+2))/2
+	alpha := 3.0
+	if got, want := Laguerre(2, alpha, x), (x*x-2*(alpha+2)*x+(alpha+1)*(alpha+2))/2; !almostEqual(got, want, 1e-14) {
+		t.Errorf("L⁽³⁾₂(%v) = %v, want %v", x, got, want)
+	}
+	if !math.IsNaN(Hermite(-1, x)) || !math.IsNaN(Laguerre(-1, 0, x)) {
+		t.Error("negative degrees should return NaN")
+	}
+}
+
+func TestOscillator(t *testing.T) {
+	omega := units.RadianPerSecond(2e14)
+	e0, _ := OscillatorEnergy(0, omega)
+	e2, _ := OscillatorEnergy(2, omega)
+	hw := constants.PlanckReduced.Val() * omega.Val()
+	if !almostEqual(e0.Val(), hw/2, 1e-12) || !almostEqual(e2.Val(), 2.5*hw, 1e-12) {
+		t.Errorf("E₀, E₂ = %v, %v; want ½ℏω, 5/2 ℏω", e0, e2)
+	}
+	if _, err := OscillatorEnergy(-1, omega); err == nil {
+		t.Error("OscillatorEnergy should reject n < 0")
+	}
+
+	// Orthonormality, including a high level where Hₙ alone would be huge.
+	m := units.Mass{Value: electron.Mass.Value()}
+	x0 := OscillatorLength(m, omega).Val()
+	for _, p := range [][2]int{{0, 0}, {3, 3}, {60, 60}, {2, 4}, {5, 6}} {
+		got := integrate(func(x float64) float64 {
+			a, _ := OscillatorWavefunction(p[0], m, omega, units.Meter(x))
+			b, _ := OscillatorWavefunction(p[1], m, omega, units.Meter(x))
+			return a * b
+		}, -16*x0, 16*x0, 4000)
+		want := 0.0
+		if p[0] == p[1] {
+			want = 1
+		}
+		if math.Abs(got-want) > 1e-8 {
+			t.Errorf("⟨ψ%d|ψ%d⟩ = %v, want %v", p[0], p[1], got, want)
+		}
+	}
+
+	// Agreement with the closed form via Hermite polynomials.
+	x := units.Meter(0.8 * x0)
+	got, _ := OscillatorWavefunction(3, m, omega, x)
+	want := Hermite(3, 0.8) * math.Exp(-0.32) * math.Pow(math.Pi*x0*x0, -0.25) / math.Sqrt(8*6)
+	if !almostEqual(got, want, 1e-12) {
+		t.Errorf("ψ₃ = %v, want %v", got, want)
+	}
+	if _, err := OscillatorWavefunction(0, m, units.RadianPerSecond(0), x); err == nil {
+		t.Error("OscillatorWavefunction should reject ω = 0")
+	}
+}
+
+func TestHydrogen(t *testing.T) {
+	h := Hydrogen()
+	e1, err := h.Energy(1)
+	if err != nil {
+		t.Fatalf("Energy() error = %v", err)
+	}
+	// Ionization energy of ¹H: 13.598 eV (NIST ASD).
+	if !almostEqual(e1.ToElectronVolts(), -13.598, 1e-4) {
+		t.Errorf("E₁ = %v eV, want -13.598", e1.ToElectronVolts())
+	}
+	e2, _ := h.Energy(2)
+	if !almostEqual(e2.Val(), e1.Val()/4, 1e-12) {
+		t.Errorf("E₂ = %v, want E₁/4", e2)
+	}
+	if a := h.BohrRadius().Val(); a <= constants.BohrRadius.Val() || !almostEqual(a, constants.BohrRadius.Val(), 1e-3) {
+		t.Errorf("a_μ = %v, want slightly above a₀", a)
+	}
+	r2, _ := h.OrbitRadius(2)
+	if !almostEqual(r2.Val(), 4*h.BohrRadius().Val(), 1e-12) {
+		t.Errorf("r₂ = %v, want 4a_μ", r2)
+	}
+
+	// He⁺: Z² scaling.
+	he, _ := NewHydrogenLike(2, constants.AlphaParticleMass)
+	he1, _ := he.Energy(1)
+	if !almostEqual(he1.ToElectronVolts(), -54.418, 1e-4) {
+		t.Errorf("He⁺ E₁ = %v eV, want -54.418", he1.ToElectronVolts())
+	}
+
+	// Radial functions: normalization, orthogonality for equal l, and the
+	// closed form R₁₀ = 2a^{-3/2} e^{-r/a}.
+	a := h.BohrRadius().Val()
+	for _, nl := range [][2]int{{1, 0}, {2, 0}, {2, 1}, {3, 2}, {5, 1}} {
+		norm := integrate(func(r float64) float64 {
+			R, _ := h.RadialFunction(nl[0], nl[1], units.Meter(r))
+			return R * R * r * r
+		}, 0, 150*a, 6000)
+		if math.Abs(norm-1) > 1e-6 {
+			t.Errorf("∫R%d%d² r² dr = %v, want 1", nl[0], nl[1], norm)
+		}
+		mean := integrate(func(r float64) float64 {
+			R, _ := h.RadialFunction(nl[0], nl[1], units.Meter(r))
+			return R * R * r * r * r
+		}, 0, 150*a, 6000)
+		want, _ := h.ExpectationRadius(nl[0], nl[1])
+		if !almostEqual(mean, want.Val(), 1e-6) {
+			t.Errorf("⟨r⟩%d%d = %v, want %v", nl[0], nl[1], mean, want.Val())
+		}
+	}
+	overlap := integrate(func(r float64) float64 {
+		R1, _ := h.RadialFunction(1, 0, units.Meter(r))
+		R2, _ := h.RadialFunction(2, 0, units.Meter(r))
+		return R1 * R2 * r * r
+	}, 0, 100*a, 6000)
+	if math.Abs(overlap) > 1e-6 {
+		t.Errorf("⟨R10|R20⟩ = %v, want 0", overlap)
+	}
+	if got, _ := h.RadialFunction(1, 0, units.Meter(a)); !almostEqual(got, 2*math.Pow(a, -1.5)*math.Exp(-1), 1e-12) {
+		t.Errorf("R₁₀(a) = %v, want 2a^{-3/2}/e", got)
+	}
+
+	if _, err := h.RadialFunction(2, 2, units.Meter(a)); err == nil {
+		t.Error("RadialFunction should reject l ≥ n")
+	}
+	if _, err := h.RadialFunction(1, 0, units.Meter(-a)); err == nil {
+		t.Error("RadialFunction should reject negative radii")
+	}
+	if _, err := h.Energy(0); err == nil {
+		t.Error("Energy should reject n = 0")
+	}
+	if _, err := NewHydrogenLike(0, units.Mass{Value: proton.Mass.Value()}); err == nil {
+		t.Error("NewHydrogenLike should reject Z = 0")
+	}
+}
@@ -0,0 +1,36 @@
+// Package analytic provides closed-form solutions of the textbook quantum
+// systems: the particle in a one-dimensional box, the harmonic oscillator,
+// and the hydrogen-like atom.
+//
+// Energies and lengths are unit-typed and built from the constants package
+// (ℏ, e, ε₀, m_e, m_p, a₀), so results are in SI units. Wavefunction values
+// are returned as plain float64 in SI units: m^-1/2 for one-dimensional
+// wavefunctions and m^-3/2 for hydrogen radial functions.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants/particles"
+//	    "github.com/sakiphan/qsim-core/quantum/analytic"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Electron in a 1 nm box
+//	electron, _ := particles.Lookup("e-")
+//	me := units.Mass{Value: electron.Mass.Value()}
+//	e1, _ := analytic.BoxEnergy(1, me, units.Nanometer(1)) // ≈ 0.376 eV
+//
+//	// Harmonic oscillator levels
+//	e0, _ := analytic.OscillatorEnergy(0, units.RadianPerSecond(1e14)) // ½ℏω
+//
+//	// Hydrogen with the finite proton mass
+//	h := analytic.Hydrogen()
+//	ground, _ := h.Energy(1)       // ≈ -13.598 eV
+//	r2, _ := h.OrbitRadius(2)      // 4 a₀ (reduced-mass corrected)
+//	R10, _ := h.RadialFunction(1, 0, units.Meter(5.29e-11))
+//
+// References:
+//   - Griffiths, Schroeter. "Introduction to Quantum Mechanics", 3rd ed., Ch. 2 and 4
+//   - Bransden, Joachain. "Physics of Atoms and Molecules", 2nd ed., Ch. 3
+//   - Abramowitz, Stegun. "Handbook of Mathematical Functions", Ch. 22
+package analytic
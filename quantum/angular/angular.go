@@ -0,0 +1,276 @@
+package angular
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Coefficient is an exact coupling coefficient of the form sign·√square,
+// with square a non-negative rational.
+type Coefficient struct {
+	sign   int // -1, 0 or +1
+	square *big.Rat
+}
+
+// Sign returns -1, 0 or +1.
+func (c Coefficient) Sign() int {
+	return c.sign
+}
+
+// IsZero reports whether the coefficient vanishes.
+func (c Coefficient) IsZero() bool {
+	return c.sign == 0
+}
+
+// Square returns the exact rational c², which carries no sign.
+func (c Coefficient) Square() *big.Rat {
+	if c.sign == 0 {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(c.square)
+}
+
+// Float64 returns the nearest float64 to the coefficient.
+func (c Coefficient) Float64() float64 {
+	if c.sign == 0 {
+		return 0
+	}
+	f, _ := c.square.Float64()
+	return float64(c.sign) * math.Sqrt(f)
+}
+
+// String returns the exact value, e.g. "-√(2/15)", "1/6" when the square
+// is a perfect square, or "0".
+func (c Coefficient) String() string {
+	if c.sign == 0 {
+		return "0"
+	}
+	s := ""
+	if c.sign < 0 {
+		s = "-"
+	}
+	num, den := c.square.Num(), c.square.Denom()
+	rn, rd := new(big.Int).Sqrt(num), new(big.Int).Sqrt(den)
+	if new(big.Int).Mul(rn, rn).Cmp(num) == 0 && new(big.Int).Mul(rd, rd).Cmp(den) == 0 {
+		return s + new(big.Rat).SetFrac(rn, rd).RatString()
+	}
+	return s + "√(" + c.square.RatString() + ")"
+}
+
+// newCoefficient returns s·√r for a signed rational sum s and a
+// non-negative rational r.
+func newCoefficient(s, r *big.Rat) Coefficient {
+	if s.Sign() == 0 || r.Sign() == 0 {
+		return Coefficient{}
+	}
+	sq := new(big.Rat).Mul(s, s)
+	return Coefficient{sign: s.Sign(), square: sq.Mul(sq, r)}
+}
+
+// -----------------------------------------------------------------------------
+// Clebsch-Gordan Coefficients and 3j Symbols
+// -----------------------------------------------------------------------------
+
+// ClebschGordan returns the Clebsch-Gordan coefficient ⟨j₁ m₁; j₂ m₂ | J M⟩.
+// Returns an error if any argument is not an integer or half-integer or an
+// angular momentum is negative; selection-rule violations give zero.
+//
+// Formula (Racah):
+//
+//	⟨j₁m₁; j₂m₂|JM⟩ = δ_{M,m₁+m₂} √((2J+1) Δ(j₁j₂J)² (J+M)!(J-M)!(j₁-m₁)!(j₁+m₁)!(j₂-m₂)!(j₂+m₂)!)
+//	    × Σₖ (-1)ᵏ / [k!(j₁+j₂-J-k)!(j₁-m₁-k)!(j₂+m₂-k)!(J-j₂+m₁+k)!(J-j₁-m₂+k)!]
+func ClebschGordan(j1, m1, j2, m2, j, m float64) (Coefficient, error) {
+	d, err := doubled(j1, m1, j2, m2, j, m)
+	if err != nil {
+		return Coefficient{}, err
+	}
+	if err := checkMomenta(d[0], d[2], d[4]); err != nil {
+		return Coefficient{}, err
+	}
+	return clebschGordan(d[0], d[1], d[2], d[3], d[4], d[5]), nil
+}
+
+// clebschGordan evaluates the Racah formula with all arguments doubled.
+func clebschGordan(tj1, tm1, tj2, tm2, tj, tm int) Coefficient {
+	if tm1+tm2 != tm || !projectionOK(tj1, tm1) || !projectionOK(tj2, tm2) ||
+		!projectionOK(tj, tm) || !triangle(tj1, tj2, tj) {
+		return Coefficient{}
+	}
+	r := delta2(tj1, tj2, tj)
+	r.Mul(r, new(big.Rat).SetInt64(int64(tj+1)))
+	for _, n := range []int{tj + tm, tj - tm, tj1 - tm1, tj1 + tm1, tj2 - tm2, tj2 + tm2} {
+		r.Mul(r, new(big.Rat).SetInt(factorial(n/2)))
+	}
+
+	// Summation limits keep every factorial argument non-negative.
+	a := []int{(tj1 + tj2 - tj) / 2, (tj1 - tm1) / 2, (tj2 + tm2) / 2}
+	b := []int{(tj - tj2 + tm1) / 2, (tj - tj1 - tm2) / 2}
+	kmin := max(0, -b[0], -b[1])
+	kmax := min(a[0], a[1], a[2])
+	s := new(big.Rat)
+	for k := kmin; k <= kmax; k++ {
+		den := factorial(k)
+		for _, n := range []int{a[0] - k, a[1] - k, a[2] - k, b[0] + k, b[1] + k} {
+			den.Mul(den, factorial(n))
+		}
+		term := new(big.Rat).SetFrac(big.NewInt(1), den)
+		if k%2 != 0 {
+			term.Neg(term)
+		}
+		s.Add(s, term)
+	}
+	return newCoefficient(s, r)
+}
+
+// Wigner3j returns the Wigner 3j symbol (j₁ j₂ j₃; m₁ m₂ m₃).
+// Returns an error if any argument is not an integer or half-integer or an
+// angular momentum is negative; selection-rule violations give zero.
+//
+// Formula:
+//
+//	(j₁ j₂ j₃; m₁ m₂ m₃) = (-1)^{j₁-j₂-m₃} / √(2j₃+1) ⟨j₁ m₁; j₂ m₂ | j₃ -m₃⟩
+func Wigner3j(j1, j2, j3, m1, m2, m3 float64) (Coefficient, error) {
+	d, err := doubled(j1, j2, j3, m1, m2, m3)
+	if err != nil {
+		return Coefficient{}, err
+	}
+	if err := checkMomenta(d[0], d[1], d[2]); err != nil {
+		return Coefficient{}, err
+	}
+	cg := clebschGordan(d[0], d[3], d[1], d[4], d[2], -d[5])
+	if cg.IsZero() {
+		return cg, nil
+	}
+	sq := new(big.Rat).Quo(cg.square, new(big.Rat).SetInt64(int64(d[2]+1)))
+	sign := cg.sign
+	// j₁ - j₂ - m₃ is an integer whenever the CG coefficient is nonzero.
+	if ((d[0]-d[1]-d[5])/2)%2 != 0 {
+		sign = -sign
+	}
+	return Coefficient{sign: sign, square: sq}, nil
+}
+
+// -----------------------------------------------------------------------------
+// 6j Symbols
+// -----------------------------------------------------------------------------
+
+// Wigner6j returns the Wigner 6j symbol {j₁ j₂ j₃; j₄ j₅ j₆}.
+// Returns an error if any argument is not a non-negative integer or
+// half-integer; triangle-condition violations give zero.
+//
+// Formula (Racah):
+//
+//	{j₁ j₂ j₃; j₄ j₅ j₆} = Δ(j₁j₂j₃) Δ(j₁j₅j₆) Δ(j₄j₂j₆) Δ(j₄j₅j₃)
+//	    × Σₜ (-1)ᵗ (t+1)! / [(t-a₁)!(t-a₂)!(t-a₃)!(t-a₄)!(b₁-t)!(b₂-t)!(b₃-t)!]
+//
+// with a₁ = j₁+j₂+j₃, a₂ = j₁+j₅+j₆, a₃ = j₄+j₂+j₆, a₄ = j₄+j₅+j₃,
+// b₁ = j₁+j₂+j₄+j₅, b₂ = j₂+j₃+j₅+j₆, b₃ = j₃+j₁+j₆+j₄.
+func Wigner6j(j1, j2, j3, j4, j5, j6 float64) (Coefficient, error) {
+	d, err := doubled(j1, j2, j3, j4, j5, j6)
+	if err != nil {
+		return Coefficient{}, err
+	}
+	if err := checkMomenta(d...); err != nil {
+		return Coefficient{}, err
+	}
+	triads := [4][3]int{
+		{d[0], d[1], d[2]}, {d[0], d[4], d[5]}, {d[3], d[1], d[5]}, {d[3], d[4], d[2]},
+	}
+	r := big.NewRat(1, 1)
+	var a [4]int
+	for i, t := range triads {
+		if !triangle(t[0], t[1], t[2]) {
+			return Coefficient{}, nil
+		}
+		r.Mul(r, delta2(t[0], t[1], t[2]))
+		a[i] = (t[0] + t[1] + t[2]) / 2
+	}
+	b := [3]int{
+		(d[0] + d[1] + d[3] + d[4]) / 2,
+		(d[1] + d[2] + d[4] + d[5]) / 2,
+		(d[2] + d[0] + d[5] + d[3]) / 2,
+	}
+
+	tmin := max(a[0], a[1], a[2], a[3])
+	tmax := min(b[0], b[1], b[2])
+	s := new(big.Rat)
+	for t := tmin; t <= tmax; t++ {
+		den := big.NewInt(1)
+		for _, x := range a {
+			den.Mul(den, factorial(t-x))
+		}
+		for _, x := range b {
+			den.Mul(den, factorial(x-t))
+		}
+		term := new(big.Rat).SetFrac(factorial(t+1), den)
+		if t%2 != 0 {
+			term.Neg(term)
+		}
+		s.Add(s, term)
+	}
+	return newCoefficient(s, r), nil
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+// doubled returns 2x for each argument, or an error unless every 2x is an
+// integer.
+func doubled(xs ...float64) ([]int, error) {
+	d := make([]int, len(xs))
+	for i, x := range xs {
+		t := 2 * x
+		if math.IsNaN(t) || math.IsInf(t, 0) || t != math.Trunc(t) || math.Abs(t) > 1<<20 {
+			return nil, fmt.Errorf("angular momentum quantum numbers must be integers or half-integers, got %g", x)
+		}
+		d[i] = int(t)
+	}
+	return d, nil
+}
+
+// checkMomenta returns an error if any doubled angular momentum is negative.
+func checkMomenta(tj ...int) error {
+	for _, t := range tj {
+		if t < 0 {
+			return fmt.Errorf("angular momentum must be non-negative, got %g", float64(t)/2)
+		}
+	}
+	return nil
+}
+
+// projectionOK reports whether m is an allowed projection of j (doubled).
+func projectionOK(tj, tm int) bool {
+	return tm >= -tj && tm <= tj && (tj-tm)%2 == 0
+}
+
+// triangle reports whether doubled a, b, c satisfy |a-b| ≤ c ≤ a+b with
+// integer a+b+c.
+func triangle(ta, tb, tc int) bool {
+	return tc >= abs(ta-tb) && tc <= ta+tb && (ta+tb+tc)%2 == 0
+}
+
+// delta2 returns the squared triangle coefficient
+// Δ(abc)² = (a+b-c)!(a-b+c)!(-a+b+c)!/(a+b+c+1)! for doubled arguments.
+func delta2(ta, tb, tc int) *big.Rat {
+	num := factorial((ta + tb - tc) / 2)
+	num.Mul(num, factorial((ta-tb+tc)/2))
+	num.Mul(num, factorial((-ta+tb+tc)/2))
+	return new(big.Rat).SetFrac(num, factorial((ta+tb+tc)/2+1))
+}
+
+// factorial returns n! as a new big.Int.
+func factorial(n int) *big.Int {
+	if n < 2 {
+		return big.NewInt(1)
+	}
+	return new(big.Int).MulRange(1, int64(n))
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
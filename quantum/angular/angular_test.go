@@ -0,0 +1,193 @@
+package angular
+
+import (
+	"math"
+	"testing"
+)
+
+func TestClebschGordan_Table(t *testing.T) {
+	tests := []struct {
+		j1, m1, j2, m2, j, m float64
+		want                 string
+	}{
+		// Two spin-½: triplet and singlet.
+		{0.5, 0.5, 0.5, 0.5, 1, 1, "1"},
+		{0.5, 0.5, 0.5, -0.5, 1, 0, "√(1/2)"},
+		{0.5, -0.5, 0.5, 0.5, 1, 0, "√(1/2)"},
+		{0.5, 0.5, 0.5, -0.5, 0, 0, "√(1/2)"},
+		{0.5, -0.5, 0.5, 0.5, 0, 0, "-√(1/2)"},
+		// l = 1 with spin ½.
+		{1, 1, 0.5, -0.5, 1.5, 0.5, "√(1/3)"},
+		{1, 0, 0.5, 0.5, 1.5, 0.5, "√(2/3)"},
+		{1, 1, 0.5, -0.5, 0.5, 0.5, "√(2/3)"},
+		{1, 0, 0.5, 0.5, 0.5, 0.5, "-√(1/3)"},
+		// 1 ⊗ 1 → 0
+		{1, 1, 1, -1, 0, 0, "√(1/3)"},
+		{1, 0, 1, 0, 0, 0, "-√(1/3)"},
+		{1, 0, 1, 0, 1, 0, "0"},
+		// 2 ⊗ 1 → 2
+		{2, 1, 1, 0, 2, 1, "√(1/6)"},
+		// Selection rules.
+		{1, 1, 1, 1, 2, 1, "0"},
+		{1, 1, 1, 0, 3, 1, "0"},
+		{1, 2, 1, -1, 1, 1, "0"},
+	}
+	for _, tt := range tests {
+		got, err := ClebschGordan(tt.j1, tt.m1, tt.j2, tt.m2, tt.j, tt.m)
+		if err != nil {
+			t.Fatalf("ClebschGordan(%v) error = %v", tt, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("⟨%v %v; %v %v|%v %v⟩ = %v, want %v", tt.j1, tt.m1, tt.j2, tt.m2, tt.j, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestClebschGordan_Orthonormality(t *testing.T) {
+	// Σ_{m₁m₂} ⟨j₁m₁;j₂m₂|JM⟩⟨j₁m₁;j₂m₂|J'M⟩ = δ_{JJ'}
+	j1, j2 := 2.5, 1.5
+	for j := math.Abs(j1 - j2); j <= j1+j2; j++ {
+		for jp := math.Abs(j1 - j2); jp <= j1+j2; jp++ {
+			for m := -math.Min(j, jp); m <= math.Min(j, jp); m++ {
+				sum := 0.0
+				for m1 := -j1; m1 <= j1; m1++ {
+					a, _ := ClebschGordan(j1, m1, j2, m-m1, j, m)
+					b, _ := ClebschGordan(j1, m1, j2, m-m1, jp, m)
+					sum += a.Float64() * b.Float64()
+				}
+				want := 0.0
+				if j == jp {
+					want = 1
+				}
+				if math.Abs(sum-want) > 1e-12 {
+					t.Errorf("J=%v J'=%v M=%v: sum = %v, want %v", j, jp, m, sum, want)
+				}
+			}
+		}
+	}
+}
+
+func TestWigner3j(t *testing.T) {
+	tests := []struct {
+		j1, j2, j3, m1, m2, m3 float64
+		want                   string
+	}{
+		{1, 1, 0, 0, 0, 0, "-√(1/3)"},
+		{0.5, 0.5, 1, 0.5, -0.5, 0, "√(1/6)"},
+		{1, 1, 2, 0, 0, 0, "√(2/15)"},
+		{2, 2, 2, 0, 0, 0, "-√(2/35)"},
+		{1, 1, 1, 0, 0, 0, "0"}, // odd J with all m = 0
+		{1, 1, 1, 1, 0, 0, "0"}, // m₁ + m₂ + m₃ ≠ 0
+	}
+	for _, tt := range tests {
+		got, err := Wigner3j(tt.j1, tt.j2, tt.j3, tt.m1, tt.m2, tt.m3)
+		if err != nil {
+			t.Fatalf("Wigner3j(%v) error = %v", tt, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("(%v %v %v; %v %v %v) = %v, want %v", tt.j1, tt.j2, tt.j3, tt.m1, tt.m2, tt.m3, got, tt.want)
+		}
+	}
+
+	// Symmetries: even permutations leave the symbol unchanged; odd
+	// permutations and m → -m multiply it by (-1)^{j₁+j₂+j₃}.
+	j1, j2, j3, m1, m2, m3 := 2.0, 1.5, 2.5, 1.0, 0.5, -1.5
+	base, _ := Wigner3j(j1, j2, j3, m1, m2, m3)
+	cyc, _ := Wigner3j(j2, j3, j1, m2, m3, m1)
+	swp, _ := Wigner3j(j2, j1, j3, m2, m1, m3)
+	neg, _ := Wigner3j(j1, j2, j3, -m1, -m2, -m3)
+	phase := math.Pow(-1, j1+j2+j3)
+	if base.IsZero() {
+		t.Fatal("test symbol unexpectedly zero")
+	}
+	if cyc.Float64() != base.Float64() || swp.Float64() != phase*base.Float64() || neg.Float64() != phase*base.Float64() {
+		t.Errorf("3j symmetries violated: %v, %v, %v, %v", base, cyc, swp, neg)
+	}
+}
+
+func TestWigner6j(t *testing.T) {
+	tests := []struct {
+		j    [6]float64
+		want string
+	}{
+		{[6]float64{1, 1, 1, 1, 1, 1}, "1/6"},
+		{[6]float64{0.5, 0.5, 1, 0.5, 0.5, 0}, "1/2"},
+		{[6]float64{0.5, 0.5, 0, 0.5, 0.5, 0}, "-1/2"},
+		{[6]float64{2, 2, 2, 2, 2, 2}, "-3/70"},
+		{[6]float64{1, 1, 3, 1, 1, 1}, "0"}, // triangle violation
+	}
+	for _, tt := range tests {
+		j := tt.j
+		got, err := Wigner6j(j[0], j[1], j[2], j[3], j[4], j[5])
+		if err != nil {
+			t.Fatalf("Wigner6j(%v) error = %v", j, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("{%v} = %v, want %v", j, got, tt.want)
+		}
+	}
+
+	// {a b c; d e 0} = (-1)^{a+b+c} δ_{ae}δ_{bd} / √((2a+1)(2b+1))
+	a, b, c := 1.5, 2.0, 2.5
+	got, _ := Wigner6j(a, b, c, b, a, 0)
+	want := math.Pow(-1, a+b+c) / math.Sqrt((2*a+1)*(2*b+1))
+	if math.Abs(got.Float64()-want) > 1e-15 {
+		t.Errorf("{%v %v %v; %v %v 0} = %v, want %v", a, b, c, b, a, got.Float64(), want)
+	}
+
+	// Large quantum numbers stay exact; orthogonality
+	// Σ_x (2x+1)(2f+1) {a b x; c d f}{a b x; c d f'} = δ_{ff'}.
+	a, b, c2, d := 10.0, 9.5, 10.0, 9.5
+	for f := 0.5; f <= 19.5; f++ {
+		for fp := 0.5; fp <= 19.5; fp++ {
+			sum := 0.0
+			for x := 0.5; x <= 19.5; x++ {
+				p, _ := Wigner6j(a, b, x, c2, d, f)
+				q, _ := Wigner6j(a, b, x, c2, d, fp)
+				sum += (2*x + 1) * (2*f + 1) * p.Float64() * q.Float64()
+			}
+			want := 0.0
+			if f == fp {
+				want = 1
+			}
+			if math.Abs(sum-want) > 1e-12 {
+				t.Fatalf("f=%v f'=%v: orthogonality sum = %v, want %v", f, fp, sum, want)
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := ClebschGordan(0.3, 0, 1, 0, 1, 0); err == nil {
+		t.Error("ClebschGordan should reject non-half-integer arguments")
+	}
+	if _, err := ClebschGordan(-1, 0, 1, 0, 1, 0); err == nil {
+		t.Error("ClebschGordan should reject negative angular momenta")
+	}
+	if _, err := Wigner3j(1, 1, math.NaN(), 0, 0, 0); err == nil {
+		t.Error("Wigner3j should reject NaN")
+	}
+	if _, err := Wigner6j(1, 1, 1, 1, 1, -1); err == nil {
+		t.Error("Wigner6j should reject negative angular momenta")
+	}
+}
+
+func TestCoefficient(t *testing.T) {
+	c, _ := ClebschGordan(1, 0, 0.5, 0.5, 0.5, 0.5)
+	if c.Sign() != -1 || c.Square().RatString() != "1/3" {
+		t.Errorf("Sign, Square = %d, %v; want -1, 1/3", c.Sign(), c.Square())
+	}
+	if math.Abs(c.Float64()+1/math.Sqrt(3)) > 1e-15 {
+		t.Errorf("Float64() = %v, want -1/√3", c.Float64())
+	}
+	var z Coefficient
+	if !z.IsZero() || z.Float64() != 0 || z.Square().Sign() != 0 || z.String() != "0" {
+		t.Error("zero Coefficient should behave as 0")
+	}
+}
+
+func BenchmarkWigner6j(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Wigner6j(20, 19.5, 20.5, 19, 20, 20)
+	}
+}
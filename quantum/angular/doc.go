@@ -0,0 +1,38 @@
+// Package angular computes angular-momentum coupling coefficients:
+// Clebsch-Gordan coefficients and Wigner 3j and 6j symbols.
+//
+// Every such coefficient has the form ±√(p/q) with p/q rational, so results
+// are returned as a Coefficient holding the sign and the exact rational
+// square, computed with math/big from the Racah formulas. No rounding
+// occurs until Float64 is called, which keeps large quantum numbers and
+// cancellations in the alternating sums exact.
+//
+// Angular momenta and projections are passed as float64 and must be
+// integers or half-integers; an error is returned otherwise. Arguments
+// that violate the selection rules (triangle conditions, |m| ≤ j,
+// m₁ + m₂ = M) yield a zero Coefficient, not an error.
+//
+// Conventions follow Condon and Shortley, as used by Edmonds and the NIST
+// DLMF.
+//
+// Example usage:
+//
+//	import (
+//	    "fmt"
+//
+//	    "github.com/sakiphan/qsim-core/quantum/angular"
+//	)
+//
+//	// ⟨1 1; ½ -½ | 3/2 ½⟩ = √(1/3)
+//	cg, _ := angular.ClebschGordan(1, 1, 0.5, -0.5, 1.5, 0.5)
+//	fmt.Println(cg, cg.Float64()) // √(1/3) 0.5773502691896257
+//
+//	w3, _ := angular.Wigner3j(1, 1, 0, 0, 0, 0) // -√(1/3)
+//	w6, _ := angular.Wigner6j(1, 1, 1, 1, 1, 1) // 1/6
+//
+// References:
+//   - Edmonds. "Angular Momentum in Quantum Mechanics", 1957, Ch. 3 and 6
+//   - NIST DLMF, Ch. 34, https://dlmf.nist.gov/34
+//   - Racah, G. "Theory of Complex Spectra. II", Phys. Rev. 62, 438 (1942),
+//     doi:10.1103/PhysRev.62.438
+package angular
@@ -0,0 +1,145 @@
+package quantum
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// Channel is a single-qubit quantum channel in Kraus form,
+// ε(ρ) = Σₖ Kₖ ρ Kₖ†.
+type Channel struct {
+	Kraus []Gate
+}
+
+// IsTracePreserving reports whether Σₖ Kₖ†Kₖ = I within the given tolerance.
+func (ch Channel) IsTracePreserving(tolerance float64) bool {
+	var sum Gate
+	for _, k := range ch.Kraus {
+		p := k.Dagger().Mul(k)
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 2; j++ {
+				sum[i][j] += p[i][j]
+			}
+		}
+	}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if cmplx.Abs(sum[i][j]-I[i][j]) > tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// checkProbability returns an error unless p ∈ [0, 1].
+func checkProbability(name string, p float64) error {
+	if !(p >= 0 && p <= 1) {
+		return fmt.Errorf("%s must be in [0, 1], got %g", name, p)
+	}
+	return nil
+}
+
+// scaled returns √w · g.
+func scaled(g Gate, w float64) Gate {
+	s := complex(math.Sqrt(w), 0)
+	return Gate{{s * g[0][0], s * g[0][1]}, {s * g[1][0], s * g[1][1]}}
+}
+
+// Depolarizing returns the depolarizing channel with error probability p,
+// which replaces the qubit by the maximally mixed state with probability p.
+// Returns an error if p is outside [0, 1].
+//
+// Formula:
+//
+//	ε(ρ) = (1 - p)ρ + p I/2 = (1 - 3p/4)ρ + (p/4)(XρX + YρY + ZρZ)
+func Depolarizing(p float64) (Channel, error) {
+	if err := checkProbability("depolarizing probability", p); err != nil {
+		return Channel{}, err
+	}
+	return Channel{Kraus: []Gate{
+		scaled(I, 1-3*p/4), scaled(X, p/4), scaled(Y, p/4), scaled(Z, p/4),
+	}}, nil
+}
+
+// AmplitudeDamping returns the amplitude damping channel, which models
+// energy relaxation |1⟩ → |0⟩ with probability γ (e.g. γ = 1 - e^{-t/T₁}).
+// Returns an error if γ is outside [0, 1].
+//
+// Formula:
+//
+//	K₀ = [[1, 0], [0, √(1-γ)]],  K₁ = [[0, √γ], [0, 0]]
+func AmplitudeDamping(gamma float64) (Channel, error) {
+	if err := checkProbability("damping probability", gamma); err != nil {
+		return Channel{}, err
+	}
+	return Channel{Kraus: []Gate{
+		{{1, 0}, {0, complex(math.Sqrt(1-gamma), 0)}},
+		{{0, complex(math.Sqrt(gamma), 0)}, {0, 0}},
+	}}, nil
+}
+
+// PhaseDamping returns the phase damping channel, which shrinks the
+// off-diagonal elements of ρ by √(1-λ) without energy loss (pure dephasing,
+// e.g. λ = 1 - e^{-2t/T_φ}). Returns an error if λ is outside [0, 1].
+//
+// Formula:
+//
+//	K₀ = [[1, 0], [0, √(1-λ)]],  K₁ = [[0, 0], [0, √λ]]
+func PhaseDamping(lambda float64) (Channel, error) {
+	if err := checkProbability("damping probability", lambda); err != nil {
+		return Channel{}, err
+	}
+	return Channel{Kraus: []Gate{
+		{{1, 0}, {0, complex(math.Sqrt(1-lambda), 0)}},
+		{{0, 0}, {0, complex(math.Sqrt(lambda), 0)}},
+	}}, nil
+}
+
+// BitFlip returns the channel that applies X with probability p.
+// Returns an error if p is outside [0, 1].
+func BitFlip(p float64) (Channel, error) {
+	if err := checkProbability("flip probability", p); err != nil {
+		return Channel{}, err
+	}
+	return Channel{Kraus: []Gate{scaled(I, 1-p), scaled(X, p)}}, nil
+}
+
+// PhaseFlip returns the channel that applies Z with probability p.
+// Returns an error if p is outside [0, 1].
+func PhaseFlip(p float64) (Channel, error) {
+	if err := checkProbability("flip probability", p); err != nil {
+		return Channel{}, err
+	}
+	return Channel{Kraus: []Gate{scaled(I, 1-p), scaled(Z, p)}}, nil
+}
+
+// ApplyChannel evolves ρ → Σₖ Kₖ ρ Kₖ† with the channel acting on the
+// target qubit. Returns an error if the target is out of range or the
+// channel has no Kraus operators.
+//
+// Example:
+//
+//	ch, _ := quantum.AmplitudeDamping(0.1)
+//	for step := 0; step < 10; step++ {
+//	    _ = rho.ApplyChannel(ch, 0)
+//	}
+func (r *DensityMatrix) ApplyChannel(ch Channel, target int) error {
+	if err := r.checkQubit(target); err != nil {
+		return err
+	}
+	if len(ch.Kraus) == 0 {
+		return fmt.Errorf("channel has no Kraus operators")
+	}
+	sum := make([]complex128, len(r.rho))
+	for _, k := range ch.Kraus {
+		t := r.Clone()
+		t.conjugate(k, target, 0)
+		for i, x := range t.rho {
+			sum[i] += x
+		}
+	}
+	r.rho = sum
+	return nil
+}
@@ -0,0 +1,108 @@
+package quantum
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChannels_TracePreserving(t *testing.T) {
+	for name, f := range map[string]func(float64) (Channel, error){
+		"Depolarizing": Depolarizing, "AmplitudeDamping": AmplitudeDamping,
+		"PhaseDamping": PhaseDamping, "BitFlip": BitFlip, "PhaseFlip": PhaseFlip,
+	} {
+		for _, p := range []float64{0, 0.3, 1} {
+			ch, err := f(p)
+			if err != nil {
+				t.Fatalf("%s(%v) error = %v", name, p, err)
+			}
+			if !ch.IsTracePreserving(1e-12) {
+				t.Errorf("%s(%v) is not trace preserving", name, p)
+			}
+		}
+		if _, err := f(1.5); err == nil {
+			t.Errorf("%s should reject probabilities above 1", name)
+		}
+		if _, err := f(math.NaN()); err == nil {
+			t.Errorf("%s should reject NaN", name)
+		}
+	}
+}
+
+func TestDepolarizing(t *testing.T) {
+	// Full depolarization of any state yields I/2.
+	r, _ := NewDensityMatrix(1)
+	_ = r.Apply(RY(0.4), 0)
+	ch, _ := Depolarizing(1)
+	if err := r.ApplyChannel(ch, 0); err != nil {
+		t.Fatalf("ApplyChannel() error = %v", err)
+	}
+	if !almostEqual(r.Purity(), 0.5, 1e-12) {
+		t.Errorf("Purity() = %v, want 0.5", r.Purity())
+	}
+
+	// Partial depolarization shrinks the Bloch vector by 1 - p.
+	p := 0.2
+	s, _ := NewDensityMatrix(1)
+	_ = s.Apply(H, 0)
+	ch, _ = Depolarizing(p)
+	_ = s.ApplyChannel(ch, 0)
+	if x, _ := s.ExpectationPauli("X"); !almostEqual(x, 1-p, 1e-12) {
+		t.Errorf("⟨X⟩ = %v, want %v", x, 1-p)
+	}
+}
+
+func TestAmplitudeDamping(t *testing.T) {
+	// Repeated damping of |1⟩ decays the excited population as (1-γ)ᵏ.
+	gamma := 0.1
+	r, _ := NewDensityMatrix(2)
+	_ = r.Apply(X, 1)
+	ch, _ := AmplitudeDamping(gamma)
+	for k := 1; k <= 10; k++ {
+		if err := r.ApplyChannel(ch, 1); err != nil {
+			t.Fatalf("ApplyChannel() error = %v", err)
+		}
+		p1 := r.Probabilities()[2]
+		if want := math.Pow(1-gamma, float64(k)); !almostEqual(p1, want, 1e-12) {
+			t.Errorf("step %d: P(1) = %v, want %v", k, p1, want)
+		}
+	}
+	if !almostEqual(r.Trace(), 1, 1e-12) {
+		t.Errorf("Trace() = %v, want 1", r.Trace())
+	}
+	if err := r.ApplyChannel(ch, 2); err == nil {
+		t.Error("ApplyChannel should reject out-of-range qubits")
+	}
+	if err := r.ApplyChannel(Channel{}, 0); err == nil {
+		t.Error("ApplyChannel should reject an empty channel")
+	}
+}
+
+func TestPhaseDamping(t *testing.T) {
+	// Dephasing kills coherence but keeps populations.
+	lambda := 0.36
+	r, _ := NewDensityMatrix(1)
+	_ = r.Apply(H, 0)
+	ch, _ := PhaseDamping(lambda)
+	_ = r.ApplyChannel(ch, 0)
+	if x, _ := r.ExpectationPauli("X"); !almostEqual(x, math.Sqrt(1-lambda), 1e-12) {
+		t.Errorf("⟨X⟩ = %v, want %v", x, math.Sqrt(1-lambda))
+	}
+	if p := r.Probabilities(); !almostEqual(p[0], 0.5, 1e-12) {
+		t.Errorf("P(0) = %v, want 0.5", p[0])
+	}
+
+	// Phase flip with p = ½ fully dephases.
+	pf, _ := PhaseFlip(0.5)
+	_ = r.ApplyChannel(pf, 0)
+	if x, _ := r.ExpectationPauli("X"); !almostEqual(x, 0, 1e-12) {
+		t.Errorf("⟨X⟩ after PhaseFlip(½) = %v, want 0", x)
+	}
+
+	// Bit flip with p = 1 is X.
+	bf, _ := BitFlip(1)
+	z, _ := NewDensityMatrix(1)
+	_ = z.ApplyChannel(bf, 0)
+	if p := z.Probabilities(); !almostEqual(p[1], 1, 1e-12) {
+		t.Errorf("BitFlip(1)|0⟩ P(1) = %v, want 1", p[1])
+	}
+}
@@ -0,0 +1,428 @@
+package quantum
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+// MaxDensityQubits is the largest register a DensityMatrix may describe
+// (2¹³ × 2¹³ elements, 1 GiB).
+const MaxDensityQubits = 13
+
+// DensityMatrix is the (possibly mixed) state ρ of an n-qubit register,
+// stored as a 2ⁿ × 2ⁿ complex matrix in the computational basis, row-major.
+// Qubit ordering follows State.
+//
+// DensityMatrix is not safe for concurrent use.
+type DensityMatrix struct {
+	n   int
+	d   int
+	rho []complex128
+}
+
+// NewDensityMatrix returns the n-qubit register in the pure state |0…0⟩⟨0…0|.
+// Returns an error if n is outside [1, MaxDensityQubits].
+func NewDensityMatrix(n int) (*DensityMatrix, error) {
+	if n < 1 || n > MaxDensityQubits {
+		return nil, fmt.Errorf("number of qubits must be in [1, %d], got %d", MaxDensityQubits, n)
+	}
+	d := 1 << n
+	rho := make([]complex128, d*d)
+	rho[0] = 1
+	return &DensityMatrix{n: n, d: d, rho: rho}, nil
+}
+
+// PureDensityMatrix returns ρ = |ψ⟩⟨ψ| for a pure state.
+// Returns an error if the state has more than MaxDensityQubits qubits.
+func PureDensityMatrix(s *State) (*DensityMatrix, error) {
+	return MixedDensityMatrix([]*State{s}, []float64{1})
+}
+
+// MixedDensityMatrix returns the ensemble ρ = Σₖ pₖ |ψₖ⟩⟨ψₖ|.
+// Returns an error if the slices are empty or differ in length, the states
+// differ in size, or the weights are negative or do not sum to 1.
+//
+// Example:
+//
+//	zero, _ := quantum.NewState(1)
+//	one, _ := quantum.NewState(1)
+//	_ = one.Apply(quantum.X, 0)
+//	rho, _ := quantum.MixedDensityMatrix([]*quantum.State{zero, one}, []float64{0.5, 0.5}) // I/2
+func MixedDensityMatrix(states []*State, weights []float64) (*DensityMatrix, error) {
+	if len(states) == 0 || len(states) != len(weights) {
+		return nil, fmt.Errorf("need matching non-empty states and weights, got %d and %d", len(states), len(weights))
+	}
+	r, err := NewDensityMatrix(states[0].n)
+	if err != nil {
+		return nil, err
+	}
+	r.rho[0] = 0
+	total := 0.0
+	for k, s := range states {
+		if s.n != r.n {
+			return nil, fmt.Errorf("state %d has %d qubits, want %d", k, s.n, r.n)
+		}
+		p := weights[k]
+		if p < 0 {
+			return nil, fmt.Errorf("weight %d must be non-negative, got %g", k, p)
+		}
+		total += p
+		for i, a := range s.amp {
+			for j, b := range s.amp {
+				r.rho[i*r.d+j] += complex(p, 0) * a * cmplx.Conj(b)
+			}
+		}
+	}
+	if math.Abs(total-1) > normTolerance {
+		return nil, fmt.Errorf("weights must sum to 1, got %g", total)
+	}
+	return r, nil
+}
+
+// MaximallyMixed returns the n-qubit maximally mixed state I/2ⁿ.
+// Returns an error if n is outside [1, MaxDensityQubits].
+func MaximallyMixed(n int) (*DensityMatrix, error) {
+	r, err := NewDensityMatrix(n)
+	if err != nil {
+		return nil, err
+	}
+	r.rho[0] = 0
+	p := complex(1/float64(r.d), 0)
+	for i := 0; i < r.d; i++ {
+		r.rho[i*r.d+i] = p
+	}
+	return r, nil
+}
+
+// NumQubits returns the number of qubits n.
+func (r *DensityMatrix) NumQubits() int {
+	return r.n
+}
+
+// Dim returns the Hilbert space dimension 2ⁿ.
+func (r *DensityMatrix) Dim() int {
+	return r.d
+}
+
+// Element returns the matrix element ρᵢⱼ = ⟨i|ρ|j⟩.
+func (r *DensityMatrix) Element(i, j int) complex128 {
+	return r.rho[i*r.d+j]
+}
+
+// Clone returns an independent copy of the density matrix.
+func (r *DensityMatrix) Clone() *DensityMatrix {
+	return &DensityMatrix{n: r.n, d: r.d, rho: append([]complex128(nil), r.rho...)}
+}
+
+// Trace returns Tr ρ, which is 1 for a physical state.
+func (r *DensityMatrix) Trace() float64 {
+	sum := 0.0
+	for i := 0; i < r.d; i++ {
+		sum += real(r.rho[i*r.d+i])
+	}
+	return sum
+}
+
+// Probabilities returns the diagonal ρᵢᵢ, the Born probabilities of the
+// computational basis states.
+func (r *DensityMatrix) Probabilities() []float64 {
+	p := make([]float64, r.d)
+	for i := range p {
+		p[i] = real(r.rho[i*r.d+i])
+	}
+	return p
+}
+
+// Purity returns Tr ρ², which is 1 for pure states and 1/2ⁿ for the
+// maximally mixed state.
+func (r *DensityMatrix) Purity() float64 {
+	// Tr ρ² = Σᵢⱼ |ρᵢⱼ|² for Hermitian ρ.
+	sum := 0.0
+	for _, x := range r.rho {
+		sum += abs2(x)
+	}
+	return sum
+}
+
+// Eigenvalues returns the eigenvalues of ρ in ascending order.
+func (r *DensityMatrix) Eigenvalues() []float64 {
+	return hermitianEigenvalues(r.rho, r.d)
+}
+
+// VonNeumannEntropy returns S(ρ) = -Tr ρ log₂ ρ in bits. It is 0 for pure
+// states and n for the maximally mixed n-qubit state.
+func (r *DensityMatrix) VonNeumannEntropy() float64 {
+	s := 0.0
+	for _, l := range r.Eigenvalues() {
+		if l > 1e-15 {
+			s -= l * math.Log2(l)
+		}
+	}
+	return s
+}
+
+// Fidelity returns ⟨ψ|ρ|ψ⟩, the fidelity of ρ with a pure state.
+// Returns an error if the registers differ in size.
+func (r *DensityMatrix) Fidelity(s *State) (float64, error) {
+	if s.n != r.n {
+		return 0, fmt.Errorf("cannot compare %d-qubit density matrix with %d-qubit state", r.n, s.n)
+	}
+	var sum complex128
+	for i, a := range s.amp {
+		for j, b := range s.amp {
+			sum += cmplx.Conj(a) * r.rho[i*r.d+j] * b
+		}
+	}
+	return real(sum), nil
+}
+
+// ExpectationPauli returns Tr(ρP) for a Pauli string P over all qubits,
+// using the same convention as State.ExpectationPauli.
+func (r *DensityMatrix) ExpectationPauli(p string) (float64, error) {
+	if len(p) != r.n {
+		return 0, fmt.Errorf("length of Pauli string %d does not match %d qubits", len(p), r.n)
+	}
+	t := r.Clone()
+	for q, c := range p {
+		switch c {
+		case 'I':
+		case 'X':
+			t.leftApply(X, q, 0)
+		case 'Y':
+			t.leftApply(Y, q, 0)
+		case 'Z':
+			t.leftApply(Z, q, 0)
+		default:
+			return 0, fmt.Errorf("invalid Pauli operator %q at position %d", c, q)
+		}
+	}
+	return t.Trace(), nil
+}
+
+// String returns the matrix with one row per line.
+func (r *DensityMatrix) String() string {
+	s := ""
+	for i := 0; i < r.d; i++ {
+		s += fmt.Sprintf("%.3g\n", r.rho[i*r.d:(i+1)*r.d])
+	}
+	return s
+}
+
+// -----------------------------------------------------------------------------
+// Unitary Evolution
+// -----------------------------------------------------------------------------
+
+// Apply evolves ρ → GρG† under a single-qubit gate on the target qubit.
+// Returns an error if the target is out of range.
+func (r *DensityMatrix) Apply(g Gate, target int) error {
+	if err := r.checkQubit(target); err != nil {
+		return err
+	}
+	r.conjugate(g, target, 0)
+	return nil
+}
+
+// ApplyControlled evolves ρ under g on the target qubit, controlled on the
+// control qubit being |1⟩. Returns an error if either qubit is out of range
+// or they coincide.
+func (r *DensityMatrix) ApplyControlled(g Gate, control, target int) error {
+	if err := r.checkQubit(control); err != nil {
+		return err
+	}
+	if err := r.checkQubit(target); err != nil {
+		return err
+	}
+	if control == target {
+		return fmt.Errorf("control and target must differ, both are %d", control)
+	}
+	r.conjugate(g, target, 1<<control)
+	return nil
+}
+
+// CNOT applies a controlled-NOT (controlled-X) gate.
+func (r *DensityMatrix) CNOT(control, target int) error {
+	return r.ApplyControlled(X, control, target)
+}
+
+// conjugate applies ρ → GρG† with g acting on target where controlMask is set.
+func (r *DensityMatrix) conjugate(g Gate, target, controlMask int) {
+	r.leftApply(g, target, controlMask)
+	r.rightApply(g.Dagger(), target, controlMask)
+}
+
+// leftApply replaces ρ with Gρ.
+func (r *DensityMatrix) leftApply(g Gate, target, controlMask int) {
+	m := 1 << target
+	for i := 0; i < r.d; i++ {
+		if i&m != 0 || i&controlMask != controlMask {
+			continue
+		}
+		ri, rj := r.rho[i*r.d:(i+1)*r.d], r.rho[(i|m)*r.d:((i|m)+1)*r.d]
+		for c := 0; c < r.d; c++ {
+			a0, a1 := ri[c], rj[c]
+			ri[c] = g[0][0]*a0 + g[0][1]*a1
+			rj[c] = g[1][0]*a0 + g[1][1]*a1
+		}
+	}
+}
+
+// rightApply replaces ρ with ρG.
+func (r *DensityMatrix) rightApply(g Gate, target, controlMask int) {
+	m := 1 << target
+	for row := 0; row < r.d; row++ {
+		x := r.rho[row*r.d : (row+1)*r.d]
+		for j := 0; j < r.d; j++ {
+			if j&m != 0 || j&controlMask != controlMask {
+				continue
+			}
+			a0, a1 := x[j], x[j|m]
+			x[j] = a0*g[0][0] + a1*g[1][0]
+			x[j|m] = a0*g[0][1] + a1*g[1][1]
+		}
+	}
+}
+
+func (r *DensityMatrix) checkQubit(q int) error {
+	if q < 0 || q >= r.n {
+		return fmt.Errorf("qubit index %d out of range [0, %d)", q, r.n)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Partial Trace
+// -----------------------------------------------------------------------------
+
+// PartialTrace returns the reduced density matrix obtained by tracing out
+// the given qubits. The remaining qubits keep their relative order and are
+// renumbered from 0. Returns an error if a qubit is out of range or
+// repeated, or if every qubit would be traced out.
+//
+// Example:
+//
+//	// Either half of a Bell pair is maximally mixed.
+//	rhoA, _ := bell.PartialTrace(1)
+//	rhoA.Purity() // 0.5
+func (r *DensityMatrix) PartialTrace(qubits ...int) (*DensityMatrix, error) {
+	traced := 0
+	for _, q := range qubits {
+		if err := r.checkQubit(q); err != nil {
+			return nil, err
+		}
+		if traced&(1<<q) != 0 {
+			return nil, fmt.Errorf("qubit %d traced out more than once", q)
+		}
+		traced |= 1 << q
+	}
+	var keep []int
+	for q := 0; q < r.n; q++ {
+		if traced&(1<<q) == 0 {
+			keep = append(keep, q)
+		}
+	}
+	if len(keep) == 0 {
+		return nil, fmt.Errorf("cannot trace out all %d qubits", r.n)
+	}
+
+	out, _ := NewDensityMatrix(len(keep))
+	out.rho[0] = 0
+	// expand maps a reduced index onto the kept bits of a full index.
+	expand := func(k int) int {
+		full := 0
+		for b, q := range keep {
+			if k&(1<<b) != 0 {
+				full |= 1 << q
+			}
+		}
+		return full
+	}
+	for e := 0; e < r.d; e++ {
+		if e&^traced != 0 {
+			continue // e enumerates assignments of the traced-out qubits
+		}
+		for i := 0; i < out.d; i++ {
+			fi := expand(i) | e
+			for j := 0; j < out.d; j++ {
+				out.rho[i*out.d+j] += r.rho[fi*r.d+(expand(j)|e)]
+			}
+		}
+	}
+	return out, nil
+}
+
+// -----------------------------------------------------------------------------
+// Hermitian Eigenvalues
+// -----------------------------------------------------------------------------
+
+// hermitianEigenvalues returns the eigenvalues of the d×d Hermitian matrix h
+// (row-major) in ascending order.
+//
+// h = A + iB is embedded as the real symmetric matrix [[A, -B], [B, A]],
+// whose spectrum is that of h with every eigenvalue doubled, and
+// diagonalized with the cyclic Jacobi method.
+func hermitianEigenvalues(h []complex128, d int) []float64 {
+	n := 2 * d
+	a := make([]float64, n*n)
+	for i := 0; i < d; i++ {
+		for j := 0; j < d; j++ {
+			re, im := real(h[i*d+j]), imag(h[i*d+j])
+			a[i*n+j] = re
+			a[(i+d)*n+j+d] = re
+			a[i*n+j+d] = -im
+			a[(i+d)*n+j] = im
+		}
+	}
+
+	// Converge relative to the Frobenius norm so that matrices with
+	// physical-scale elements (e.g. joules) are handled like ρ.
+	norm2 := 0.0
+	for _, x := range a {
+		norm2 += x * x
+	}
+	for sweep := 0; sweep < 100; sweep++ {
+		off := 0.0
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				off += a[i*n+j] * a[i*n+j]
+			}
+		}
+		if off <= 1e-30*norm2 {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := a[p*n+q]
+				if apq == 0 {
+					continue
+				}
+				theta := (a[q*n+q] - a[p*n+p]) / (2 * apq)
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					akp, akq := a[k*n+p], a[k*n+q]
+					a[k*n+p] = c*akp - s*akq
+					a[k*n+q] = s*akp + c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p*n+k], a[q*n+k]
+					a[p*n+k] = c*apk - s*aqk
+					a[q*n+k] = s*apk + c*aqk
+				}
+			}
+		}
+	}
+
+	all := make([]float64, n)
+	for i := range all {
+		all[i] = a[i*n+i]
+	}
+	sort.Float64s(all)
+	ev := make([]float64, d)
+	for i := range ev {
+		ev[i] = all[2*i]
+	}
+	return ev
+}
@@ -0,0 +1,157 @@
+package quantum
+
+import (
+	"math"
+	"testing"
+)
+
+func bellDensity(t *testing.T) *DensityMatrix {
+	t.Helper()
+	r, err := PureDensityMatrix(bell(t))
+	if err != nil {
+		t.Fatalf("PureDensityMatrix() error = %v", err)
+	}
+	return r
+}
+
+func TestNewDensityMatrix(t *testing.T) {
+	r, err := NewDensityMatrix(2)
+	if err != nil {
+		t.Fatalf("NewDensityMatrix() error = %v", err)
+	}
+	if r.NumQubits() != 2 || r.Dim() != 4 || r.Element(0, 0) != 1 {
+		t.Errorf("NewDensityMatrix(2) = %v, want |00⟩⟨00|", r)
+	}
+	if !almostEqual(r.Trace(), 1, 1e-12) || !almostEqual(r.Purity(), 1, 1e-12) {
+		t.Errorf("Trace, Purity = %v, %v; want 1, 1", r.Trace(), r.Purity())
+	}
+	if _, err := NewDensityMatrix(MaxDensityQubits + 1); err == nil {
+		t.Error("NewDensityMatrix should reject too many qubits")
+	}
+}
+
+func TestDensityMatrix_MatchesState(t *testing.T) {
+	// Evolving ρ and |ψ⟩ with the same circuit must agree.
+	s, _ := NewState(3)
+	r, _ := NewDensityMatrix(3)
+	_ = s.Apply(RY(0.7), 0)
+	_ = r.Apply(RY(0.7), 0)
+	_ = s.Apply(H, 2)
+	_ = r.Apply(H, 2)
+	_ = s.CNOT(0, 1)
+	_ = r.CNOT(0, 1)
+	_ = s.ApplyControlled(RX(1.1), 2, 0)
+	_ = r.ApplyControlled(RX(1.1), 2, 0)
+
+	ps, pr := s.Probabilities(), r.Probabilities()
+	for i := range ps {
+		if !almostEqual(ps[i], pr[i], 1e-12) {
+			t.Errorf("P(%03b) = %v, want %v", i, pr[i], ps[i])
+		}
+	}
+	for _, p := range []string{"ZII", "XIZ", "YXI", "ZZZ"} {
+		want, _ := s.ExpectationPauli(p)
+		got, err := r.ExpectationPauli(p)
+		if err != nil || !almostEqual(got, want, 1e-12) {
+			t.Errorf("⟨%s⟩ = %v, %v; want %v", p, got, err, want)
+		}
+	}
+	if f, _ := r.Fidelity(s); !almostEqual(f, 1, 1e-12) {
+		t.Errorf("Fidelity() = %v, want 1", f)
+	}
+	if e := r.VonNeumannEntropy(); !almostEqual(e, 0, 1e-9) {
+		t.Errorf("entropy of pure state = %v, want 0", e)
+	}
+}
+
+func TestMixedDensityMatrix(t *testing.T) {
+	zero, _ := NewState(1)
+	one, _ := NewState(1)
+	_ = one.Apply(X, 0)
+	r, err := MixedDensityMatrix([]*State{zero, one}, []float64{0.5, 0.5})
+	if err != nil {
+		t.Fatalf("MixedDensityMatrix() error = %v", err)
+	}
+	mm, _ := MaximallyMixed(1)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if r.Element(i, j) != mm.Element(i, j) {
+				t.Errorf("ρ[%d][%d] = %v, want %v", i, j, r.Element(i, j), mm.Element(i, j))
+			}
+		}
+	}
+	if !almostEqual(r.Purity(), 0.5, 1e-12) || !almostEqual(r.VonNeumannEntropy(), 1, 1e-9) {
+		t.Errorf("Purity, S = %v, %v; want 0.5, 1", r.Purity(), r.VonNeumannEntropy())
+	}
+
+	if _, err := MixedDensityMatrix([]*State{zero, one}, []float64{0.5, 0.6}); err == nil {
+		t.Error("MixedDensityMatrix should reject weights not summing to 1")
+	}
+	if _, err := MixedDensityMatrix([]*State{zero}, []float64{0.5, 0.5}); err == nil {
+		t.Error("MixedDensityMatrix should reject mismatched lengths")
+	}
+	two, _ := NewState(2)
+	if _, err := MixedDensityMatrix([]*State{zero, two}, []float64{0.5, 0.5}); err == nil {
+		t.Error("MixedDensityMatrix should reject states of different sizes")
+	}
+}
+
+func TestPartialTrace(t *testing.T) {
+	r := bellDensity(t)
+	a, err := r.PartialTrace(1)
+	if err != nil {
+		t.Fatalf("PartialTrace() error = %v", err)
+	}
+	if a.NumQubits() != 1 || !almostEqual(a.Purity(), 0.5, 1e-12) {
+		t.Errorf("reduced Bell state = %v, want I/2", a)
+	}
+	if e := a.VonNeumannEntropy(); !almostEqual(e, 1, 1e-9) {
+		t.Errorf("entanglement entropy = %v, want 1 bit", e)
+	}
+
+	// Tracing out an unentangled qubit leaves the other untouched, and the
+	// remaining qubits are renumbered in order.
+	s, _ := NewState(3)
+	_ = s.Apply(X, 2)
+	_ = s.Apply(H, 1)
+	rho, _ := PureDensityMatrix(s)
+	red, _ := rho.PartialTrace(0)
+	if z, _ := red.ExpectationPauli("IZ"); !almostEqual(z, -1, 1e-12) {
+		t.Errorf("⟨Z⟩ of former qubit 2 = %v, want -1", z)
+	}
+	if x, _ := red.ExpectationPauli("XI"); !almostEqual(x, 1, 1e-12) {
+		t.Errorf("⟨X⟩ of former qubit 1 = %v, want 1", x)
+	}
+	if !almostEqual(red.Purity(), 1, 1e-12) {
+		t.Errorf("Purity() = %v, want 1", red.Purity())
+	}
+
+	if _, err := r.PartialTrace(0, 1); err == nil {
+		t.Error("PartialTrace should reject tracing out every qubit")
+	}
+	if _, err := r.PartialTrace(1, 1); err == nil {
+		t.Error("PartialTrace should reject repeated qubits")
+	}
+	if _, err := r.PartialTrace(2); err == nil {
+		t.Error("PartialTrace should reject out-of-range qubits")
+	}
+}
+
+func TestEigenvalues(t *testing.T) {
+	// Mix |0⟩ with SH|0⟩ = (|0⟩ + i|1⟩)/√2 so that ρ has imaginary
+	// off-diagonal elements.
+	plus, _ := NewState(1)
+	_ = plus.Apply(H, 0)
+	_ = plus.Apply(S, 0)
+	zero, _ := NewState(1)
+	r, _ := MixedDensityMatrix([]*State{plus, zero}, []float64{0.3, 0.7})
+	ev := r.Eigenvalues()
+	// For a qubit, λ± = (1 ± |r⃗|)/2 with r⃗ the Bloch vector.
+	x, _ := r.ExpectationPauli("X")
+	y, _ := r.ExpectationPauli("Y")
+	z, _ := r.ExpectationPauli("Z")
+	b := math.Sqrt(x*x + y*y + z*z)
+	if !almostEqual(ev[0], (1-b)/2, 1e-10) || !almostEqual(ev[1], (1+b)/2, 1e-10) {
+		t.Errorf("Eigenvalues() = %v, want [%v %v]", ev, (1-b)/2, (1+b)/2)
+	}
+}
@@ -0,0 +1,49 @@
+// Package quantum provides a state-vector simulator for systems of qubits.
+//
+// A State holds the 2ⁿ complex amplitudes of an n-qubit pure state in the
+// computational basis. Qubit 0 is the least significant bit of the basis
+// index, so basis state |q_{n-1} … q₁ q₀⟩ has index Σ qₖ 2ᵏ.
+//
+// Gates are applied in place. Measurement is sampled from the Born rule
+// using a caller-supplied *rand.Rand, so results are reproducible for a
+// given seed.
+//
+// A DensityMatrix describes mixed states of up to MaxDensityQubits qubits.
+// It supports unitary evolution, partial trace, purity and von Neumann
+// entropy, and single-qubit noise channels in Kraus form (depolarizing,
+// amplitude damping, phase damping, bit and phase flip).
+//
+// Operator is a general dense complex matrix with commutators and tensor
+// products. Spin operators S_x, S_y, S_z, S₊, S₋ for any spin j are given in
+// units of ℏ, and the Zeeman helpers couple them to a magnetic field via
+// μ = g μ_B S/ℏ using the constants package.
+//
+// Example usage:
+//
+//	import (
+//	    "math/rand"
+//
+//	    "github.com/sakiphan/qsim-core/quantum"
+//	)
+//
+//	// Prepare the Bell state (|00⟩ + |11⟩)/√2
+//	s, _ := quantum.NewState(2)
+//	_ = s.Apply(quantum.H, 0)
+//	_ = s.CNOT(0, 1)
+//
+//	zz, _ := s.ExpectationPauli("ZZ") // 1: perfectly correlated
+//
+//	rng := rand.New(rand.NewSource(1))
+//	counts, _ := s.Sample(1000, rng) // ≈ {0: 500, 3: 500}
+//
+//	// Decohere one half of the pair
+//	rho, _ := quantum.PureDensityMatrix(s)
+//	ch, _ := quantum.PhaseDamping(0.5)
+//	_ = rho.ApplyChannel(ch, 0)
+//	half, _ := rho.PartialTrace(1)
+//	entropy := half.VonNeumannEntropy() // 1 bit
+//
+// References:
+//   - Nielsen, Chuang. "Quantum Computation and Quantum Information", 10th anniv. ed., Ch. 4
+//   - Nielsen, Chuang. "Quantum Computation and Quantum Information", 10th anniv. ed., Ch. 8
+package quantum
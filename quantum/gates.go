@@ -0,0 +1,92 @@
+package quantum
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Gate is a single-qubit operator in the computational basis {|0⟩, |1⟩},
+// indexed as Gate[row][column].
+type Gate [2][2]complex128
+
+// Standard single-qubit gates.
+var (
+	I = Gate{{1, 0}, {0, 1}}                                                      // identity
+	X = Gate{{0, 1}, {1, 0}}                                                      // Pauli-X (NOT)
+	Y = Gate{{0, -1i}, {1i, 0}}                                                   // Pauli-Y
+	Z = Gate{{1, 0}, {0, -1}}                                                     // Pauli-Z
+	H = Gate{{math.Sqrt2 / 2, math.Sqrt2 / 2}, {math.Sqrt2 / 2, -math.Sqrt2 / 2}} // Hadamard
+	S = Gate{{1, 0}, {0, 1i}}                                                     // phase gate, √Z
+	T = Gate{{1, 0}, {0, complex(math.Sqrt2/2, math.Sqrt2/2)}}                    // π/8 gate, √S
+)
+
+// RX returns the rotation about the x axis of the Bloch sphere,
+// exp(-iθX/2).
+func RX(theta float64) Gate {
+	c, s := complex(math.Cos(theta/2), 0), complex(0, -math.Sin(theta/2))
+	return Gate{{c, s}, {s, c}}
+}
+
+// RY returns the rotation about the y axis of the Bloch sphere,
+// exp(-iθY/2).
+func RY(theta float64) Gate {
+	c, s := complex(math.Cos(theta/2), 0), complex(math.Sin(theta/2), 0)
+	return Gate{{c, -s}, {s, c}}
+}
+
+// RZ returns the rotation about the z axis of the Bloch sphere,
+// exp(-iθZ/2).
+func RZ(theta float64) Gate {
+	return Gate{{cmplx.Exp(complex(0, -theta/2)), 0}, {0, cmplx.Exp(complex(0, theta/2))}}
+}
+
+// Phase returns the phase shift diag(1, e^{iφ}).
+func Phase(phi float64) Gate {
+	return Gate{{1, 0}, {0, cmplx.Exp(complex(0, phi))}}
+}
+
+// U3 returns the general single-qubit unitary (up to global phase) with
+// Euler angles θ, φ, λ.
+//
+// Formula:
+//
+//	U3(θ, φ, λ) = [[cos(θ/2), -e^{iλ} sin(θ/2)], [e^{iφ} sin(θ/2), e^{i(φ+λ)} cos(θ/2)]]
+func U3(theta, phi, lambda float64) Gate {
+	c, s := math.Cos(theta/2), math.Sin(theta/2)
+	return Gate{
+		{complex(c, 0), -cmplx.Exp(complex(0, lambda)) * complex(s, 0)},
+		{cmplx.Exp(complex(0, phi)) * complex(s, 0), cmplx.Exp(complex(0, phi+lambda)) * complex(c, 0)},
+	}
+}
+
+// Mul returns the matrix product g·h (apply h first, then g).
+func (g Gate) Mul(h Gate) Gate {
+	var r Gate
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			r[i][j] = g[i][0]*h[0][j] + g[i][1]*h[1][j]
+		}
+	}
+	return r
+}
+
+// Dagger returns the conjugate transpose g†.
+func (g Gate) Dagger() Gate {
+	return Gate{
+		{cmplx.Conj(g[0][0]), cmplx.Conj(g[1][0])},
+		{cmplx.Conj(g[0][1]), cmplx.Conj(g[1][1])},
+	}
+}
+
+// IsUnitary reports whether g†g = I within the given tolerance.
+func (g Gate) IsUnitary(tolerance float64) bool {
+	p := g.Dagger().Mul(g)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if cmplx.Abs(p[i][j]-I[i][j]) > tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
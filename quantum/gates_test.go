@@ -0,0 +1,69 @@
+package quantum
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func gatesEqual(a, b Gate, tolerance float64) bool {
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if cmplx.Abs(a[i][j]-b[i][j]) > tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestGates_Unitary(t *testing.T) {
+	gates := map[string]Gate{
+		"I": I, "X": X, "Y": Y, "Z": Z, "H": H, "S": S, "T": T,
+		"RX": RX(0.3), "RY": RY(1.1), "RZ": RZ(-2), "Phase": Phase(0.4), "U3": U3(0.5, 1.2, -0.7),
+	}
+	for name, g := range gates {
+		if !g.IsUnitary(1e-12) {
+			t.Errorf("%s is not unitary", name)
+		}
+	}
+}
+
+func TestGates_Identities(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Gate
+		want Gate
+	}{
+		{"H² = I", H.Mul(H), I},
+		{"S² = Z", S.Mul(S), Z},
+		{"T² = S", T.Mul(T), S},
+		{"HXH = Z", H.Mul(X).Mul(H), Z},
+		{"XY = iZ", X.Mul(Y), Gate{{1i, 0}, {0, -1i}}},
+		{"U3(π/2, 0, π) = H", U3(math.Pi/2, 0, math.Pi), H},
+		{"Phase(π/2) = S", Phase(math.Pi / 2), S},
+		{"S† S = I", S.Dagger().Mul(S), I},
+	}
+	for _, tt := range tests {
+		if !gatesEqual(tt.got, tt.want, 1e-12) {
+			t.Errorf("%s: got %v", tt.name, tt.got)
+		}
+	}
+}
+
+func TestGates_RotationsCompose(t *testing.T) {
+	if !gatesEqual(RY(0.4).Mul(RY(0.9)), RY(1.3), 1e-12) {
+		t.Error("RY(a)RY(b) should equal RY(a+b)")
+	}
+	// RZ equals Phase up to a global phase e^{-iθ/2}.
+	theta := 0.8
+	g := Phase(theta)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			g[i][j] *= cmplx.Exp(complex(0, -theta/2))
+		}
+	}
+	if !gatesEqual(RZ(theta), g, 1e-12) {
+		t.Error("RZ(θ) should equal e^{-iθ/2} Phase(θ)")
+	}
+}
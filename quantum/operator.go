@@ -0,0 +1,229 @@
+package quantum
+
+import (
+	"fmt"
+	"math/cmplx"
+)
+
+// Operator is a dense square complex matrix acting on a d-dimensional
+// Hilbert space, stored row-major. Unlike Gate it is not restricted to a
+// single qubit or to unitary matrices.
+type Operator struct {
+	d int
+	m []complex128
+}
+
+// NewOperator creates an Operator from its rows. The rows are copied.
+// Returns an error if the matrix is empty or not square.
+func NewOperator(rows [][]complex128) (Operator, error) {
+	d := len(rows)
+	if d == 0 {
+		return Operator{}, fmt.Errorf("operator must have at least one row")
+	}
+	m := make([]complex128, 0, d*d)
+	for i, row := range rows {
+		if len(row) != d {
+			return Operator{}, fmt.Errorf("operator must be square: row %d has %d columns, want %d", i, len(row), d)
+		}
+		m = append(m, row...)
+	}
+	return Operator{d: d, m: m}, nil
+}
+
+// IdentityOperator returns the d×d identity.
+func IdentityOperator(d int) Operator {
+	a := Operator{d: d, m: make([]complex128, d*d)}
+	for i := 0; i < d; i++ {
+		a.m[i*d+i] = 1
+	}
+	return a
+}
+
+// Operator returns g as a 2×2 Operator.
+func (g Gate) Operator() Operator {
+	return Operator{d: 2, m: []complex128{g[0][0], g[0][1], g[1][0], g[1][1]}}
+}
+
+// Dim returns the dimension d of the space the operator acts on.
+func (a Operator) Dim() int {
+	return a.d
+}
+
+// At returns the matrix element ⟨i|A|j⟩.
+func (a Operator) At(i, j int) complex128 {
+	return a.m[i*a.d+j]
+}
+
+// Add returns A + B. Returns an error if the dimensions differ.
+func (a Operator) Add(b Operator) (Operator, error) {
+	if err := a.checkDim(b); err != nil {
+		return Operator{}, err
+	}
+	r := Operator{d: a.d, m: make([]complex128, len(a.m))}
+	for i := range a.m {
+		r.m[i] = a.m[i] + b.m[i]
+	}
+	return r, nil
+}
+
+// Subtract returns A - B. Returns an error if the dimensions differ.
+func (a Operator) Subtract(b Operator) (Operator, error) {
+	return a.Add(b.Scale(-1))
+}
+
+// Scale returns cA.
+func (a Operator) Scale(c complex128) Operator {
+	r := Operator{d: a.d, m: make([]complex128, len(a.m))}
+	for i, x := range a.m {
+		r.m[i] = c * x
+	}
+	return r
+}
+
+// Mul returns the matrix product AB. Returns an error if the dimensions differ.
+func (a Operator) Mul(b Operator) (Operator, error) {
+	if err := a.checkDim(b); err != nil {
+		return Operator{}, err
+	}
+	d := a.d
+	r := Operator{d: d, m: make([]complex128, d*d)}
+	for i := 0; i < d; i++ {
+		for k := 0; k < d; k++ {
+			aik := a.m[i*d+k]
+			if aik == 0 {
+				continue
+			}
+			for j := 0; j < d; j++ {
+				r.m[i*d+j] += aik * b.m[k*d+j]
+			}
+		}
+	}
+	return r, nil
+}
+
+// Dagger returns the conjugate transpose A†.
+func (a Operator) Dagger() Operator {
+	d := a.d
+	r := Operator{d: d, m: make([]complex128, d*d)}
+	for i := 0; i < d; i++ {
+		for j := 0; j < d; j++ {
+			r.m[j*d+i] = cmplx.Conj(a.m[i*d+j])
+		}
+	}
+	return r
+}
+
+// Trace returns Tr A.
+func (a Operator) Trace() complex128 {
+	var sum complex128
+	for i := 0; i < a.d; i++ {
+		sum += a.m[i*a.d+i]
+	}
+	return sum
+}
+
+// Kron returns the tensor product A ⊗ B. In the qubit convention of State,
+// B acts on the low-order qubits and A on the high-order ones.
+func (a Operator) Kron(b Operator) Operator {
+	d := a.d * b.d
+	r := Operator{d: d, m: make([]complex128, d*d)}
+	for i := 0; i < a.d; i++ {
+		for j := 0; j < a.d; j++ {
+			aij := a.m[i*a.d+j]
+			for k := 0; k < b.d; k++ {
+				for l := 0; l < b.d; l++ {
+					r.m[(i*b.d+k)*d+j*b.d+l] = aij * b.m[k*b.d+l]
+				}
+			}
+		}
+	}
+	return r
+}
+
+// Equal reports whether A and B have the same dimension and all elements
+// agree within the given tolerance.
+func (a Operator) Equal(b Operator, tolerance float64) bool {
+	if a.d != b.d {
+		return false
+	}
+	for i := range a.m {
+		if cmplx.Abs(a.m[i]-b.m[i]) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHermitian reports whether A = A† within the given tolerance.
+func (a Operator) IsHermitian(tolerance float64) bool {
+	return a.Equal(a.Dagger(), tolerance)
+}
+
+// Eigenvalues returns the eigenvalues of a Hermitian operator in ascending
+// order. Returns an error if A is not Hermitian.
+func (a Operator) Eigenvalues() ([]float64, error) {
+	if !a.IsHermitian(1e-12) {
+		return nil, fmt.Errorf("eigenvalues are only supported for Hermitian operators")
+	}
+	return hermitianEigenvalues(a.m, a.d), nil
+}
+
+// Expectation returns ⟨ψ|A|ψ⟩. Returns an error if the operator dimension
+// does not match the state.
+func (a Operator) Expectation(s *State) (complex128, error) {
+	if a.d != s.Dim() {
+		return 0, fmt.Errorf("operator dimension %d does not match state dimension %d", a.d, s.Dim())
+	}
+	var sum complex128
+	for i, x := range s.amp {
+		var row complex128
+		for j, y := range s.amp {
+			row += a.m[i*a.d+j] * y
+		}
+		sum += cmplx.Conj(x) * row
+	}
+	return sum, nil
+}
+
+// String returns the matrix with one row per line.
+func (a Operator) String() string {
+	s := ""
+	for i := 0; i < a.d; i++ {
+		s += fmt.Sprintf("%.3g\n", a.m[i*a.d:(i+1)*a.d])
+	}
+	return s
+}
+
+func (a Operator) checkDim(b Operator) error {
+	if a.d != b.d {
+		return fmt.Errorf("operator dimensions differ: %d and %d", a.d, b.d)
+	}
+	return nil
+}
+
+// Commutator returns [A, B] = AB - BA. Returns an error if the dimensions differ.
+//
+// Example:
+//
+//	sx, _ := quantum.SpinX(0.5)
+//	sy, _ := quantum.SpinY(0.5)
+//	c, _ := quantum.Commutator(sx, sy) // i S_z
+func Commutator(a, b Operator) (Operator, error) {
+	ab, err := a.Mul(b)
+	if err != nil {
+		return Operator{}, err
+	}
+	ba, _ := b.Mul(a)
+	return ab.Subtract(ba)
+}
+
+// Anticommutator returns {A, B} = AB + BA. Returns an error if the
+// dimensions differ.
+func Anticommutator(a, b Operator) (Operator, error) {
+	ab, err := a.Mul(b)
+	if err != nil {
+		return Operator{}, err
+	}
+	ba, _ := b.Mul(a)
+	return ab.Add(ba)
+}
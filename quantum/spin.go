@@ -0,0 +1,161 @@
+package quantum
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Spin operators are returned in units of ℏ in the |j, m⟩ basis ordered
+// m = j, j-1, …, -j. For j = ½ this matches the qubit basis: |↑⟩ = |0⟩ and
+// S_z = Z/2.
+
+// checkSpin returns the multiplicity 2j + 1, or an error unless j is a
+// non-negative integer or half-integer.
+func checkSpin(j float64) (int, error) {
+	if !(j >= 0) || math.IsInf(j, 0) || 2*j != math.Trunc(2*j) {
+		return 0, fmt.Errorf("spin must be a non-negative integer or half-integer, got %g", j)
+	}
+	return int(2*j) + 1, nil
+}
+
+// SpinZ returns S_z for spin j: diag(j, j-1, …, -j).
+// Returns an error if j is not a non-negative integer or half-integer.
+func SpinZ(j float64) (Operator, error) {
+	d, err := checkSpin(j)
+	if err != nil {
+		return Operator{}, err
+	}
+	a := Operator{d: d, m: make([]complex128, d*d)}
+	for k := 0; k < d; k++ {
+		a.m[k*d+k] = complex(j-float64(k), 0)
+	}
+	return a, nil
+}
+
+// SpinPlus returns the raising operator S₊ for spin j.
+// Returns an error if j is not a non-negative integer or half-integer.
+//
+// Formula:
+//
+//	S₊|j, m⟩ = √(j(j+1) - m(m+1)) |j, m+1⟩
+func SpinPlus(j float64) (Operator, error) {
+	d, err := checkSpin(j)
+	if err != nil {
+		return Operator{}, err
+	}
+	a := Operator{d: d, m: make([]complex128, d*d)}
+	for k := 1; k < d; k++ {
+		m := j - float64(k)
+		a.m[(k-1)*d+k] = complex(math.Sqrt(j*(j+1)-m*(m+1)), 0)
+	}
+	return a, nil
+}
+
+// SpinMinus returns the lowering operator S₋ = S₊† for spin j.
+// Returns an error if j is not a non-negative integer or half-integer.
+func SpinMinus(j float64) (Operator, error) {
+	sp, err := SpinPlus(j)
+	if err != nil {
+		return Operator{}, err
+	}
+	return sp.Dagger(), nil
+}
+
+// SpinX returns S_x = (S₊ + S₋)/2 for spin j.
+// Returns an error if j is not a non-negative integer or half-integer.
+func SpinX(j float64) (Operator, error) {
+	sp, err := SpinPlus(j)
+	if err != nil {
+		return Operator{}, err
+	}
+	sx, _ := sp.Add(sp.Dagger())
+	return sx.Scale(0.5), nil
+}
+
+// SpinY returns S_y = (S₊ - S₋)/2i for spin j.
+// Returns an error if j is not a non-negative integer or half-integer.
+func SpinY(j float64) (Operator, error) {
+	sp, err := SpinPlus(j)
+	if err != nil {
+		return Operator{}, err
+	}
+	sy, _ := sp.Subtract(sp.Dagger())
+	return sy.Scale(-0.5i), nil
+}
+
+// SpinSquared returns S² = j(j+1) I for spin j.
+// Returns an error if j is not a non-negative integer or half-integer.
+func SpinSquared(j float64) (Operator, error) {
+	d, err := checkSpin(j)
+	if err != nil {
+		return Operator{}, err
+	}
+	return IdentityOperator(d).Scale(complex(j*(j+1), 0)), nil
+}
+
+// -----------------------------------------------------------------------------
+// Magnetic Coupling
+// -----------------------------------------------------------------------------
+
+// teslaDim is the dimension of magnetic flux density [MT⁻²I⁻¹].
+var teslaDim = units.Tesla(1).Dim()
+
+// SpinMagneticMoment returns the z component μ_z = g μ_B m of the magnetic
+// moment of a state with magnetic quantum number m, in J/T. With the
+// CODATA sign convention g_e < 0 (constants.ElectronGFactor), the electron
+// moment is antiparallel to its spin.
+//
+// Example:
+//
+//	mu := quantum.SpinMagneticMoment(constants.ElectronGFactor, 0.5) // ≈ -9.285e-24 J/T
+func SpinMagneticMoment(g, m float64) units.MagneticMoment {
+	return units.JoulePerTesla(g * m * constants.BohrMagneton.Val())
+}
+
+// ZeemanEnergy returns the energy E = -μ_z B = -g μ_B m B of a state with
+// magnetic quantum number m in a field B along z.
+//
+// Example:
+//
+//	// Electron spin-up in 1 T: ≈ +9.285e-24 J (≈ 58 μeV)
+//	e := quantum.ZeemanEnergy(constants.ElectronGFactor, 0.5, units.Tesla(1))
+func ZeemanEnergy(g, m float64, b units.MagneticField) units.Energy {
+	return units.Joule(-g * constants.BohrMagneton.Val() * m * b.Val())
+}
+
+// ZeemanHamiltonian returns H = -μ·B = -g μ_B (B_x S_x + B_y S_y + B_z S_z)/ℏ
+// for spin j in the field b, with matrix elements in joules.
+// Returns an error if j is invalid or b is not a magnetic flux density.
+func ZeemanHamiltonian(j, g float64, b vector.Vector3) (Operator, error) {
+	if b.Dim() != teslaDim {
+		return Operator{}, fmt.Errorf("field must have dimension %s, got %s", teslaDim, b.Dim())
+	}
+	sx, err := SpinX(j)
+	if err != nil {
+		return Operator{}, err
+	}
+	sy, _ := SpinY(j)
+	sz, _ := SpinZ(j)
+	bf := b.ToArray()
+	h, _ := sx.Scale(complex(bf[0], 0)).Add(sy.Scale(complex(bf[1], 0)))
+	h, _ = h.Add(sz.Scale(complex(bf[2], 0)))
+	return h.Scale(complex(-g*constants.BohrMagneton.Val(), 0)), nil
+}
+
+// LarmorFrequency returns the spin precession (ESR/NMR resonance) frequency
+// f = |g| μ_B B / h, the Zeeman splitting between adjacent m levels divided
+// by h.
+//
+// Example:
+//
+//	f := quantum.LarmorFrequency(constants.ElectronGFactor, units.Tesla(1)) // ≈ 28.02 GHz
+//
+// References:
+//   - Griffiths, Schroeter. "Introduction to Quantum Mechanics", 3rd ed., Sec. 4.4.2
+func LarmorFrequency(g float64, b units.MagneticField) units.Frequency {
+	return units.Hertz(math.Abs(g) * constants.BohrMagneton.Val() * math.Abs(b.Val()) / constants.PlanckConstant.Val())
+}
@@ -0,0 +1,172 @@
+package quantum
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestSpinHalf_MatchesPauli(t *testing.T) {
+	sx, _ := SpinX(0.5)
+	sy, _ := SpinY(0.5)
+	sz, _ := SpinZ(0.5)
+	for _, tt := range []struct {
+		name string
+		s    Operator
+		g    Gate
+	}{
+		{"S_x", sx, X}, {"S_y", sy, Y}, {"S_z", sz, Z},
+	} {
+		if !tt.s.Equal(tt.g.Operator().Scale(0.5), 1e-15) {
+			t.Errorf("%s = %v, want σ/2", tt.name, tt.s)
+		}
+	}
+
+	// Pauli algebra: σ_x σ_y = iσ_z, {σ_i, σ_j} = 2δᵢⱼ.
+	xy, _ := X.Operator().Mul(Y.Operator())
+	if !xy.Equal(Z.Operator().Scale(1i), 1e-15) {
+		t.Errorf("σ_x σ_y = %v, want iσ_z", xy)
+	}
+	ac, _ := Anticommutator(X.Operator(), Z.Operator())
+	if !ac.Equal(IdentityOperator(2).Scale(0), 1e-15) {
+		t.Errorf("{σ_x, σ_z} = %v, want 0", ac)
+	}
+}
+
+func TestSpin_CommutationRelations(t *testing.T) {
+	for _, j := range []float64{0.5, 1, 1.5, 3} {
+		sx, err := SpinX(j)
+		if err != nil {
+			t.Fatalf("SpinX(%v) error = %v", j, err)
+		}
+		sy, _ := SpinY(j)
+		sz, _ := SpinZ(j)
+
+		// [S_x, S_y] = iS_z and cyclic permutations.
+		for _, tt := range []struct{ a, b, c Operator }{
+			{sx, sy, sz}, {sy, sz, sx}, {sz, sx, sy},
+		} {
+			got, _ := Commutator(tt.a, tt.b)
+			if !got.Equal(tt.c.Scale(1i), 1e-12) {
+				t.Errorf("j=%v: commutation relation violated", j)
+			}
+		}
+
+		// S² = S_x² + S_y² + S_z² = j(j+1).
+		xx, _ := sx.Mul(sx)
+		yy, _ := sy.Mul(sy)
+		zz, _ := sz.Mul(sz)
+		sum, _ := xx.Add(yy)
+		sum, _ = sum.Add(zz)
+		s2, _ := SpinSquared(j)
+		if !sum.Equal(s2, 1e-12) {
+			t.Errorf("j=%v: S² = %v, want %v", j, sum, s2)
+		}
+
+		// Ladder: [S_z, S₊] = S₊ and S₋ = S₊†.
+		sp, _ := SpinPlus(j)
+		sm, _ := SpinMinus(j)
+		c, _ := Commutator(sz, sp)
+		if !c.Equal(sp, 1e-12) || !sm.Equal(sp.Dagger(), 0) {
+			t.Errorf("j=%v: ladder operator relations violated", j)
+		}
+
+		if !sx.IsHermitian(1e-15) || !sy.IsHermitian(1e-15) {
+			t.Errorf("j=%v: S_x, S_y should be Hermitian", j)
+		}
+		ev, _ := sx.Eigenvalues()
+		for k, l := range ev {
+			if want := -j + float64(k); !almostEqual(l, want, 1e-10) {
+				t.Errorf("j=%v: eigenvalue %d of S_x = %v, want %v", j, k, l, want)
+			}
+		}
+	}
+
+	for _, j := range []float64{-0.5, 0.3, math.NaN(), math.Inf(1)} {
+		if _, err := SpinZ(j); err == nil {
+			t.Errorf("SpinZ(%v) should fail", j)
+		}
+	}
+}
+
+func TestOperator(t *testing.T) {
+	if _, err := NewOperator([][]complex128{{1, 2}, {3}}); err == nil {
+		t.Error("NewOperator should reject non-square matrices")
+	}
+	if _, err := NewOperator(nil); err == nil {
+		t.Error("NewOperator should reject empty matrices")
+	}
+	a, _ := NewOperator([][]complex128{{1, 2i}, {3, 4}})
+	if a.Trace() != 5 || a.At(0, 1) != 2i || a.Dim() != 2 {
+		t.Errorf("operator accessors wrong: %v", a)
+	}
+	if _, err := a.Mul(IdentityOperator(3)); err == nil {
+		t.Error("Mul should reject mismatched dimensions")
+	}
+	if _, err := a.Eigenvalues(); err == nil {
+		t.Error("Eigenvalues should reject non-Hermitian operators")
+	}
+
+	// Kron follows the State qubit ordering: Z ⊗ I acts on qubit 1.
+	zi := Z.Operator().Kron(IdentityOperator(2))
+	s, _ := NewState(2)
+	_ = s.Apply(X, 1)
+	e, err := zi.Expectation(s)
+	if err != nil || !almostEqual(real(e), -1, 1e-15) {
+		t.Errorf("⟨Z₁⟩ = %v, %v; want -1", e, err)
+	}
+	if _, err := a.Expectation(s); err == nil {
+		t.Error("Expectation should reject mismatched dimensions")
+	}
+}
+
+func TestZeeman(t *testing.T) {
+	g := constants.ElectronGFactor
+	mu := SpinMagneticMoment(g, 0.5)
+	if mu.Val() >= 0 || !almostEqual(mu.Val(), -9.2847647e-24, 1e-30) {
+		t.Errorf("electron μ_z = %v, want ≈ -9.285e-24 J/T", mu)
+	}
+	if mu.Dim() != constants.BohrMagneton.Dim() {
+		t.Errorf("μ_z dimension = %v, want J/T", mu.Dim())
+	}
+
+	// Spin-up electron lies above spin-down; splitting is hf_L.
+	b := units.Tesla(1)
+	up, down := ZeemanEnergy(g, 0.5, b), ZeemanEnergy(g, -0.5, b)
+	if up.Val() <= down.Val() {
+		t.Errorf("E(↑) = %v should exceed E(↓) = %v", up, down)
+	}
+	f := LarmorFrequency(g, b)
+	if !almostEqual(f.ToGigahertz(), 28.025, 0.001) {
+		t.Errorf("LarmorFrequency() = %v GHz, want ≈ 28.025", f.ToGigahertz())
+	}
+	if split := up.Val() - down.Val(); !almostEqual(split/(constants.PlanckConstant.Val()*f.Val()), 1, 1e-12) {
+		t.Errorf("Zeeman splitting %v J does not equal h f_L", split)
+	}
+
+	// The Hamiltonian in a field along z reproduces the level energies, and
+	// its spectrum is independent of the field direction.
+	bz, _ := vector.New(units.Tesla(0).Value, units.Tesla(0).Value, b.Value)
+	h, err := ZeemanHamiltonian(0.5, g, bz)
+	if err != nil {
+		t.Fatalf("ZeemanHamiltonian() error = %v", err)
+	}
+	if !almostEqual(real(h.At(0, 0)), up.Val(), 1e-36) || !almostEqual(real(h.At(1, 1)), down.Val(), 1e-36) {
+		t.Errorf("diag(H) = %v, %v; want %v, %v", h.At(0, 0), h.At(1, 1), up, down)
+	}
+	bt, _ := vector.New(units.Tesla(0.6).Value, units.Tesla(0).Value, units.Tesla(0.8).Value)
+	ht, _ := ZeemanHamiltonian(1, 2, bt)
+	ev, _ := ht.Eigenvalues()
+	step := 2 * constants.BohrMagneton.Val()
+	for k, l := range ev {
+		if want := float64(k-1) * step; !almostEqual(l, want, 1e-35) {
+			t.Errorf("level %d = %v, want %v", k, l, want)
+		}
+	}
+	if _, err := ZeemanHamiltonian(0.5, g, vector.Zero(units.Dimension{})); err == nil {
+		t.Error("ZeemanHamiltonian should reject non-field vectors")
+	}
+}
@@ -0,0 +1,379 @@
+package quantum
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"math/rand"
+	"strings"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// MaxQubits is the largest register NewState will allocate (2²⁶ amplitudes, 1 GiB).
+const MaxQubits = 26
+
+// normTolerance is the tolerance used when checking state normalization.
+const normTolerance = 1e-10
+
+// State is the pure state of an n-qubit register, stored as 2ⁿ complex
+// amplitudes in the computational basis.
+//
+// State is not safe for concurrent use.
+type State struct {
+	n   int
+	amp []complex128
+}
+
+// NewState returns the n-qubit register initialized to |0…0⟩.
+// Returns an error if n is outside [1, MaxQubits].
+func NewState(n int) (*State, error) {
+	if n < 1 || n > MaxQubits {
+		return nil, fmt.Errorf("number of qubits must be in [1, %d], got %d", MaxQubits, n)
+	}
+	amp := make([]complex128, 1<<n)
+	amp[0] = 1
+	return &State{n: n, amp: amp}, nil
+}
+
+// FromAmplitudes returns a state with the given computational-basis
+// amplitudes. The slice is copied. Returns an error if its length is not a
+// power of two ≥ 2 or the amplitudes are not normalized.
+func FromAmplitudes(amplitudes []complex128) (*State, error) {
+	d := len(amplitudes)
+	if d < 2 || d&(d-1) != 0 {
+		return nil, fmt.Errorf("number of amplitudes must be a power of two ≥ 2, got %d", d)
+	}
+	n := bits.TrailingZeros(uint(d))
+	if n > MaxQubits {
+		return nil, fmt.Errorf("number of qubits must be at most %d, got %d", MaxQubits, n)
+	}
+	s := &State{n: n, amp: append([]complex128(nil), amplitudes...)}
+	if norm := s.Norm(); math.Abs(norm-1) > normTolerance {
+		return nil, fmt.Errorf("state must be normalized, got norm %g", norm)
+	}
+	return s, nil
+}
+
+// FromVector returns a state with the amplitudes of v, which must be
+// dimensionless and satisfy the same conditions as for FromAmplitudes.
+func FromVector(v vector.ComplexN) (*State, error) {
+	if v.Dim() != (units.Dimension{}) {
+		return nil, fmt.Errorf("amplitudes must be dimensionless, got dimension %s", v.Dim())
+	}
+	return FromAmplitudes(v.ToSlice())
+}
+
+// NumQubits returns the number of qubits n.
+func (s *State) NumQubits() int {
+	return s.n
+}
+
+// Dim returns the Hilbert space dimension 2ⁿ.
+func (s *State) Dim() int {
+	return len(s.amp)
+}
+
+// Amplitude returns the amplitude ⟨i|ψ⟩ of basis state i.
+func (s *State) Amplitude(i int) complex128 {
+	return s.amp[i]
+}
+
+// Amplitudes returns a copy of all amplitudes.
+func (s *State) Amplitudes() []complex128 {
+	return append([]complex128(nil), s.amp...)
+}
+
+// Vector returns the amplitudes as a dimensionless ComplexN, for use with
+// the general complex-vector operations of the vector package.
+func (s *State) Vector() vector.ComplexN {
+	return vector.NewComplexN(s.amp, units.Dimension{})
+}
+
+// Probability returns the Born probability |⟨i|ψ⟩|² of basis state i.
+func (s *State) Probability(i int) float64 {
+	return abs2(s.amp[i])
+}
+
+// Probabilities returns the Born probabilities of all basis states.
+func (s *State) Probabilities() []float64 {
+	p := make([]float64, len(s.amp))
+	for i, a := range s.amp {
+		p[i] = abs2(a)
+	}
+	return p
+}
+
+// Norm returns ‖ψ‖ = √⟨ψ|ψ⟩.
+func (s *State) Norm() float64 {
+	sum := 0.0
+	for _, a := range s.amp {
+		sum += abs2(a)
+	}
+	return math.Sqrt(sum)
+}
+
+// Clone returns an independent copy of the state.
+func (s *State) Clone() *State {
+	return &State{n: s.n, amp: s.Amplitudes()}
+}
+
+// InnerProduct returns ⟨s|t⟩. Returns an error if the registers differ in size.
+func (s *State) InnerProduct(t *State) (complex128, error) {
+	if s.n != t.n {
+		return 0, fmt.Errorf("cannot take inner product of %d- and %d-qubit states", s.n, t.n)
+	}
+	var sum complex128
+	for i, a := range s.amp {
+		sum += cmplx.Conj(a) * t.amp[i]
+	}
+	return sum, nil
+}
+
+// String returns the nonzero amplitudes in ket notation, e.g.
+// "(0.707+0i)|00⟩ + (0.707+0i)|11⟩".
+func (s *State) String() string {
+	var b strings.Builder
+	for i, a := range s.amp {
+		if abs2(a) < 1e-24 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(" + ")
+		}
+		fmt.Fprintf(&b, "%.3g|%0*b⟩", a, s.n, i)
+	}
+	return b.String()
+}
+
+// -----------------------------------------------------------------------------
+// Gate Application
+// -----------------------------------------------------------------------------
+
+// Apply applies a single-qubit gate to the target qubit.
+// Returns an error if the target is out of range.
+func (s *State) Apply(g Gate, target int) error {
+	if err := s.checkQubit(target); err != nil {
+		return err
+	}
+	s.apply(g, target, 0)
+	return nil
+}
+
+// ApplyControlled applies g to the target qubit on the subspace where the
+// control qubit is |1⟩. Returns an error if either qubit is out of range or
+// they coincide.
+func (s *State) ApplyControlled(g Gate, control, target int) error {
+	if err := s.checkQubit(control); err != nil {
+		return err
+	}
+	if err := s.checkQubit(target); err != nil {
+		return err
+	}
+	if control == target {
+		return fmt.Errorf("control and target must differ, both are %d", control)
+	}
+	s.apply(g, target, 1<<control)
+	return nil
+}
+
+// CNOT applies a controlled-NOT (controlled-X) gate.
+func (s *State) CNOT(control, target int) error {
+	return s.ApplyControlled(X, control, target)
+}
+
+// CZ applies a controlled-Z gate.
+func (s *State) CZ(control, target int) error {
+	return s.ApplyControlled(Z, control, target)
+}
+
+// Swap exchanges the states of qubits a and b.
+func (s *State) Swap(a, b int) error {
+	if err := s.checkQubit(a); err != nil {
+		return err
+	}
+	if err := s.checkQubit(b); err != nil {
+		return err
+	}
+	if a == b {
+		return nil
+	}
+	ma, mb := 1<<a, 1<<b
+	for i := range s.amp {
+		// Visit each pair once, from the index with a=1, b=0.
+		if i&ma != 0 && i&mb == 0 {
+			j := i ^ ma ^ mb
+			s.amp[i], s.amp[j] = s.amp[j], s.amp[i]
+		}
+	}
+	return nil
+}
+
+// apply applies g to target on basis states whose bits in controlMask are all set.
+func (s *State) apply(g Gate, target, controlMask int) {
+	m := 1 << target
+	for i := range s.amp {
+		if i&m != 0 || i&controlMask != controlMask {
+			continue
+		}
+		j := i | m
+		a0, a1 := s.amp[i], s.amp[j]
+		s.amp[i] = g[0][0]*a0 + g[0][1]*a1
+		s.amp[j] = g[1][0]*a0 + g[1][1]*a1
+	}
+}
+
+func (s *State) checkQubit(q int) error {
+	if q < 0 || q >= s.n {
+		return fmt.Errorf("qubit index %d out of range [0, %d)", q, s.n)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Measurement
+// -----------------------------------------------------------------------------
+
+// MeasureQubit performs a projective Z-basis measurement of one qubit,
+// collapsing and renormalizing the state. Returns the outcome 0 or 1.
+func (s *State) MeasureQubit(q int, rng *rand.Rand) (int, error) {
+	if err := s.checkQubit(q); err != nil {
+		return 0, err
+	}
+	m := 1 << q
+	p1 := 0.0
+	for i, a := range s.amp {
+		if i&m != 0 {
+			p1 += abs2(a)
+		}
+	}
+
+	outcome, p := 0, 1-p1
+	if rng.Float64() < p1 {
+		outcome, p = 1, p1
+	}
+	scale := complex(1/math.Sqrt(p), 0)
+	for i := range s.amp {
+		if (i&m != 0) == (outcome == 1) {
+			s.amp[i] *= scale
+		} else {
+			s.amp[i] = 0
+		}
+	}
+	return outcome, nil
+}
+
+// Measure measures every qubit, collapsing the state onto the returned
+// basis index.
+func (s *State) Measure(rng *rand.Rand) int {
+	i := sample(s.Probabilities(), rng.Float64())
+	for j := range s.amp {
+		s.amp[j] = 0
+	}
+	s.amp[i] = 1
+	return i
+}
+
+// Sample draws shots independent full-register measurements without
+// disturbing the state and returns a histogram of basis indices.
+// Returns an error if shots is negative.
+func (s *State) Sample(shots int, rng *rand.Rand) (map[int]int, error) {
+	if shots < 0 {
+		return nil, fmt.Errorf("number of shots must be non-negative, got %d", shots)
+	}
+	cdf := s.Probabilities()
+	for i := 1; i < len(cdf); i++ {
+		cdf[i] += cdf[i-1]
+	}
+	counts := make(map[int]int)
+	for k := 0; k < shots; k++ {
+		u := rng.Float64() * cdf[len(cdf)-1]
+		counts[searchCDF(cdf, u)]++
+	}
+	return counts, nil
+}
+
+// sample returns the index selected by u ∈ [0, 1) from a probability vector.
+func sample(p []float64, u float64) int {
+	total := 0.0
+	for _, x := range p {
+		total += x
+	}
+	u *= total
+	last := 0
+	for i, x := range p {
+		if x == 0 {
+			continue
+		}
+		last = i
+		if u < x {
+			return i
+		}
+		u -= x
+	}
+	return last
+}
+
+// searchCDF returns the first index whose cumulative probability exceeds u.
+func searchCDF(cdf []float64, u float64) int {
+	lo, hi := 0, len(cdf)-1
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if cdf[mid] > u {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
+// -----------------------------------------------------------------------------
+// Expectation Values
+// -----------------------------------------------------------------------------
+
+// Expectation returns ⟨ψ|G_q|ψ⟩ for a single-qubit operator acting on
+// qubit q. The result is real for Hermitian operators.
+func (s *State) Expectation(g Gate, q int) (complex128, error) {
+	t := s.Clone()
+	if err := t.Apply(g, q); err != nil {
+		return 0, err
+	}
+	return s.InnerProduct(t)
+}
+
+// ExpectationPauli returns ⟨ψ|P|ψ⟩ for a Pauli string P over all qubits.
+// Character k of the string (from the left) acts on qubit k and must be one
+// of 'I', 'X', 'Y', 'Z'.
+//
+// Example:
+//
+//	zz, _ := s.ExpectationPauli("ZZ")  // ⟨Z₀Z₁⟩
+//	x1, _ := s.ExpectationPauli("IXI") // ⟨X₁⟩ on a 3-qubit register
+func (s *State) ExpectationPauli(p string) (float64, error) {
+	if len(p) != s.n {
+		return 0, fmt.Errorf("length of Pauli string %d does not match %d qubits", len(p), s.n)
+	}
+	t := s.Clone()
+	for q, r := range p {
+		switch r {
+		case 'I':
+		case 'X':
+			t.apply(X, q, 0)
+		case 'Y':
+			t.apply(Y, q, 0)
+		case 'Z':
+			t.apply(Z, q, 0)
+		default:
+			return 0, fmt.Errorf("invalid Pauli operator %q at position %d", r, q)
+		}
+	}
+	v, _ := s.InnerProduct(t)
+	return real(v), nil
+}
+
+func abs2(a complex128) float64 {
+	return real(a)*real(a) + imag(a)*imag(a)
+}
@@ -0,0 +1,264 @@
+package quantum
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func bell(t *testing.T) *State {
+	t.Helper()
+	s, err := NewState(2)
+	if err != nil {
+		t.Fatalf("NewState() error = %v", err)
+	}
+	if err := s.Apply(H, 0); err != nil {
+		t.Fatalf("Apply(H) error = %v", err)
+	}
+	if err := s.CNOT(0, 1); err != nil {
+		t.Fatalf("CNOT() error = %v", err)
+	}
+	return s
+}
+
+func TestNewState(t *testing.T) {
+	s, err := NewState(3)
+	if err != nil {
+		t.Fatalf("NewState() error = %v", err)
+	}
+	if s.NumQubits() != 3 || s.Dim() != 8 || s.Amplitude(0) != 1 {
+		t.Errorf("NewState(3) = %v, want |000⟩", s)
+	}
+	if _, err := NewState(0); err == nil {
+		t.Error("NewState(0) should fail")
+	}
+	if _, err := NewState(MaxQubits + 1); err == nil {
+		t.Error("NewState should reject too many qubits")
+	}
+}
+
+func TestFromAmplitudes(t *testing.T) {
+	r := complex(math.Sqrt2/2, 0)
+	s, err := FromAmplitudes([]complex128{r, 0, 0, r})
+	if err != nil {
+		t.Fatalf("FromAmplitudes() error = %v", err)
+	}
+	if s.NumQubits() != 2 {
+		t.Errorf("NumQubits() = %d, want 2", s.NumQubits())
+	}
+	if _, err := FromAmplitudes([]complex128{1, 0, 0}); err == nil {
+		t.Error("FromAmplitudes should reject non-power-of-two lengths")
+	}
+	if _, err := FromAmplitudes([]complex128{1, 1}); err == nil {
+		t.Error("FromAmplitudes should reject unnormalized states")
+	}
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	s := bell(t)
+	v := s.Vector()
+	// (X ⊗ I) on the vector matches Apply(X, 1) on the register.
+	x := [][]complex128{{0, 0, 1, 0}, {0, 0, 0, 1}, {1, 0, 0, 0}, {0, 1, 0, 0}}
+	w, err := v.ApplyUnitary(x, 1e-12)
+	if err != nil {
+		t.Fatalf("ApplyUnitary() error = %v", err)
+	}
+	got, err := FromVector(w)
+	if err != nil {
+		t.Fatalf("FromVector() error = %v", err)
+	}
+	if err := s.Apply(X, 1); err != nil {
+		t.Fatalf("Apply(X) error = %v", err)
+	}
+	if overlap, _ := s.InnerProduct(got); !almostEqual(cmplx.Abs(overlap), 1, 1e-12) {
+		t.Errorf("|⟨ψ|φ⟩| = %v, want 1", cmplx.Abs(overlap))
+	}
+	if _, err := FromVector(vector.NewComplexN([]complex128{1, 0}, units.Dimension{L: 1})); err == nil {
+		t.Error("FromVector should reject dimensioned vectors")
+	}
+}
+
+func TestQubitOrdering(t *testing.T) {
+	// X on qubit 0 flips the least significant bit.
+	s, _ := NewState(3)
+	_ = s.Apply(X, 0)
+	if s.Probability(1) != 1 {
+		t.Errorf("X₀|000⟩ should be basis index 1, got %v", s)
+	}
+	_ = s.Apply(X, 2)
+	if s.Probability(5) != 1 {
+		t.Errorf("X₂X₀|000⟩ should be basis index 5, got %v", s)
+	}
+	if got := s.String(); got != "(1+0i)|101⟩" {
+		t.Errorf("String() = %q, want (1+0i)|101⟩", got)
+	}
+}
+
+func TestBellState(t *testing.T) {
+	s := bell(t)
+	p := s.Probabilities()
+	want := []float64{0.5, 0, 0, 0.5}
+	for i := range p {
+		if !almostEqual(p[i], want[i], 1e-12) {
+			t.Errorf("P(%02b) = %v, want %v", i, p[i], want[i])
+		}
+	}
+	for _, tt := range []struct {
+		pauli string
+		want  float64
+	}{
+		{"ZZ", 1}, {"XX", 1}, {"YY", -1}, {"ZI", 0}, {"IX", 0},
+	} {
+		got, err := s.ExpectationPauli(tt.pauli)
+		if err != nil || !almostEqual(got, tt.want, 1e-12) {
+			t.Errorf("⟨%s⟩ = %v, %v; want %v", tt.pauli, got, err, tt.want)
+		}
+	}
+	if _, err := s.ExpectationPauli("ZA"); err == nil {
+		t.Error("ExpectationPauli should reject invalid operators")
+	}
+	if _, err := s.ExpectationPauli("Z"); err == nil {
+		t.Error("ExpectationPauli should reject wrong lengths")
+	}
+}
+
+func TestControlledAndSwap(t *testing.T) {
+	// CNOT with an unset control is the identity.
+	s, _ := NewState(2)
+	_ = s.CNOT(0, 1)
+	if s.Probability(0) != 1 {
+		t.Errorf("CNOT|00⟩ = %v, want |00⟩", s)
+	}
+
+	// CZ phase on |11⟩.
+	_ = s.Apply(X, 0)
+	_ = s.Apply(X, 1)
+	_ = s.CZ(0, 1)
+	if s.Amplitude(3) != -1 {
+		t.Errorf("CZ|11⟩ amplitude = %v, want -1", s.Amplitude(3))
+	}
+
+	// SWAP moves an excitation between qubits.
+	u, _ := NewState(3)
+	_ = u.Apply(X, 0)
+	_ = u.Swap(0, 2)
+	if u.Probability(4) != 1 {
+		t.Errorf("SWAP₀₂|001⟩ = %v, want |100⟩", u)
+	}
+
+	if err := s.CNOT(1, 1); err == nil {
+		t.Error("CNOT should reject control == target")
+	}
+	if err := s.Apply(H, 2); err == nil {
+		t.Error("Apply should reject out-of-range qubits")
+	}
+}
+
+func TestRotations(t *testing.T) {
+	// RY(θ)|0⟩ has ⟨Z⟩ = cos θ and ⟨X⟩ = sin θ.
+	theta := 0.7
+	s, _ := NewState(1)
+	_ = s.Apply(RY(theta), 0)
+	z, _ := s.ExpectationPauli("Z")
+	x, _ := s.ExpectationPauli("X")
+	if !almostEqual(z, math.Cos(theta), 1e-12) || !almostEqual(x, math.Sin(theta), 1e-12) {
+		t.Errorf("⟨Z⟩, ⟨X⟩ = %v, %v; want cos θ, sin θ", z, x)
+	}
+
+	// RX(π) = -iX, RZ(π) = -iZ
+	rx := RX(math.Pi)
+	if cmplx.Abs(rx[0][1]+1i) > 1e-12 || cmplx.Abs(rx[0][0]) > 1e-12 {
+		t.Errorf("RX(π) = %v, want -iX", rx)
+	}
+	rz := RZ(math.Pi)
+	if cmplx.Abs(rz[0][0]+1i) > 1e-12 || cmplx.Abs(rz[1][1]-1i) > 1e-12 {
+		t.Errorf("RZ(π) = %v, want -iZ", rz)
+	}
+
+	// Expectation of a non-Pauli operator.
+	e, _ := s.Expectation(Z, 0)
+	if !almostEqual(real(e), math.Cos(theta), 1e-12) || !almostEqual(imag(e), 0, 1e-12) {
+		t.Errorf("Expectation(Z) = %v, want cos θ", e)
+	}
+}
+
+func TestMeasureQubit(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < 20; i++ {
+		s := bell(t)
+		a, err := s.MeasureQubit(0, rng)
+		if err != nil {
+			t.Fatalf("MeasureQubit() error = %v", err)
+		}
+		// Measuring qubit 0 of a Bell state fixes qubit 1.
+		b, _ := s.MeasureQubit(1, rng)
+		if a != b {
+			t.Fatalf("Bell measurement outcomes differ: %d, %d", a, b)
+		}
+		if !almostEqual(s.Norm(), 1, 1e-12) {
+			t.Fatalf("post-measurement norm = %v, want 1", s.Norm())
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	s := bell(t)
+	before := s.Amplitudes()
+
+	shots := 20000
+	counts, err := s.Sample(shots, rng)
+	if err != nil {
+		t.Fatalf("Sample() error = %v", err)
+	}
+	if counts[1] != 0 || counts[2] != 0 {
+		t.Errorf("sampled impossible outcomes: %v", counts)
+	}
+	if f := float64(counts[0]) / float64(shots); !almostEqual(f, 0.5, 0.02) {
+		t.Errorf("P(00) sampled = %v, want ≈ 0.5", f)
+	}
+	for i, a := range s.Amplitudes() {
+		if a != before[i] {
+			t.Fatal("Sample should not disturb the state")
+		}
+	}
+
+	// Measure collapses onto a basis state.
+	m := s.Measure(rng)
+	if (m != 0 && m != 3) || s.Probability(m) != 1 {
+		t.Errorf("Measure() = %d, state %v", m, s)
+	}
+}
+
+func TestGHZ(t *testing.T) {
+	// n-qubit GHZ state via a CNOT ladder.
+	n := 5
+	s, _ := NewState(n)
+	_ = s.Apply(H, 0)
+	for q := 1; q < n; q++ {
+		_ = s.CNOT(q-1, q)
+	}
+	if !almostEqual(s.Probability(0), 0.5, 1e-12) || !almostEqual(s.Probability(1<<n-1), 0.5, 1e-12) {
+		t.Errorf("GHZ state = %v", s)
+	}
+	xs, _ := s.ExpectationPauli("XXXXX")
+	if !almostEqual(xs, 1, 1e-12) {
+		t.Errorf("⟨X⊗5⟩ = %v, want 1", xs)
+	}
+}
+
+func BenchmarkApplyH_20Qubits(b *testing.B) {
+	s, _ := NewState(20)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = s.Apply(H, i%20)
+	}
+}
@@ -0,0 +1,45 @@
+// Package wavefunction solves the one-dimensional time-dependent Schrödinger
+// equation
+//
+//	iℏ ∂ψ/∂t = -(ℏ²/2m) ∂²ψ/∂x² + V(x) ψ
+//
+// on a uniform grid with the Crank-Nicolson scheme.
+//
+// Mass, grid extent, time step and potential are unit-typed, so the same
+// code serves electrons on nanometre grids and atoms in optical traps.
+// Internally ψ is stored in SI units (m^-1/2) and the tridiagonal system is
+// solved with the Thomas algorithm in O(N) per step. Crank-Nicolson is
+// unitary and unconditionally stable, so the norm is conserved to rounding
+// error for any time step; accuracy still requires ω_max Δt ≲ 1 for the
+// dynamics of interest.
+//
+// ψ vanishes outside the grid (hard walls just beyond the end points).
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants/particles"
+//	    "github.com/sakiphan/qsim-core/quantum/wavefunction"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	grid, _ := wavefunction.NewGrid(units.Nanometer(-50), units.Nanometer(50), 2000)
+//	psi, _ := wavefunction.GaussianPacket(grid, units.Nanometer(-20), units.Nanometer(2),
+//	    units.KilogramMeterPerSecond(5e-25))
+//
+//	barrier := wavefunction.SquareBarrier(units.Nanometer(0), units.Nanometer(1), units.ElectronVolt(0.5))
+//	electron, _ := particles.Lookup("e-")
+//	me := units.Mass{Value: electron.Mass.Value()}
+//	s, _ := wavefunction.NewSolver(grid, me, barrier, units.Second(1e-16))
+//	_ = s.Evolve(psi, 500)
+//
+//	transmitted := psi.Probability(units.Nanometer(1), units.Nanometer(50))
+//	x := psi.ExpectationPosition()
+//
+// References:
+//   - Crank, Nicolson. "A practical method for numerical evaluation of solutions
+//     of partial differential equations of the heat-conduction type",
+//     Proc. Camb. Phil. Soc., 1947, doi:10.1017/S0305004100023197
+//   - Press et al. "Numerical Recipes", 3rd ed., Sec. 20.2
+//   - Griffiths, Schroeter. "Introduction to Quantum Mechanics", 3rd ed., Ch. 2
+package wavefunction
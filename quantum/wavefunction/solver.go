@@ -0,0 +1,170 @@
+package wavefunction
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Potential returns the potential energy V(x) at position x.
+type Potential func(x units.Length) units.Energy
+
+// FreeParticle is the zero potential.
+func FreeParticle(units.Length) units.Energy {
+	return units.Joule(0)
+}
+
+// HarmonicPotential returns V(x) = ½ m ω² (x - x₀)².
+func HarmonicPotential(m units.Mass, omega units.AngularVelocity, x0 units.Length) Potential {
+	k := m.Val() * omega.Val() * omega.Val()
+	return func(x units.Length) units.Energy {
+		d := x.Val() - x0.Val()
+		return units.Joule(0.5 * k * d * d)
+	}
+}
+
+// SquareBarrier returns the potential of height V₀ on [left, right] and
+// zero elsewhere. A negative height gives a square well.
+func SquareBarrier(left, right units.Length, height units.Energy) Potential {
+	return func(x units.Length) units.Energy {
+		if x.Val() >= left.Val() && x.Val() <= right.Val() {
+			return height
+		}
+		return units.Joule(0)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Crank-Nicolson Solver
+// -----------------------------------------------------------------------------
+
+// Solver advances wavefunctions on a fixed grid under a time-independent
+// potential with the Crank-Nicolson scheme
+//
+//	(1 + iHΔt/2ℏ) ψⁿ⁺¹ = (1 - iHΔt/2ℏ) ψⁿ
+//
+// where H is the three-point finite-difference Hamiltonian. The LU
+// factorization of the left-hand side is computed once in NewSolver.
+//
+// A Solver may be shared by wavefunctions on the same grid but is not safe
+// for concurrent use.
+type Solver struct {
+	grid Grid
+	mass float64
+	dt   float64
+
+	diag []float64 // Hᵢᵢ = ℏ²/mΔx² + Vᵢ (J)
+	off  float64   // Hᵢ,ᵢ±₁ = -ℏ²/2mΔx² (J)
+
+	cp  []complex128 // Thomas algorithm: modified super-diagonal
+	den []complex128 // Thomas algorithm: pivots
+	rhs []complex128
+}
+
+// NewSolver prepares a Crank-Nicolson solver for a particle of the given
+// mass in potential v, with time step dt.
+// Returns an error if the mass or time step is not positive or the
+// potential is not an energy or is not finite on the grid.
+func NewSolver(grid Grid, mass units.Mass, v Potential, dt units.Time) (*Solver, error) {
+	if !(mass.Val() > 0) {
+		return nil, fmt.Errorf("mass must be positive, got %g kg", mass.Val())
+	}
+	if !(dt.Val() > 0) {
+		return nil, fmt.Errorf("time step must be positive, got %g s", dt.Val())
+	}
+	energyDim := units.Joule(0).Dim()
+	n := grid.n
+	s := &Solver{
+		grid: grid,
+		mass: mass.Val(),
+		dt:   dt.Val(),
+		diag: make([]float64, n),
+		off:  -hbar * hbar / (2 * mass.Val() * grid.dx * grid.dx),
+		cp:   make([]complex128, n),
+		den:  make([]complex128, n),
+		rhs:  make([]complex128, n),
+	}
+	for i := 0; i < n; i++ {
+		vi := v(grid.X(i))
+		if vi.Dim() != energyDim {
+			return nil, fmt.Errorf("potential must have dimension %s, got %s", energyDim, vi.Dim())
+		}
+		if math.IsNaN(vi.Val()) || math.IsInf(vi.Val(), 0) {
+			return nil, fmt.Errorf("potential must be finite, got %g J at x = %g m", vi.Val(), grid.x(i))
+		}
+		s.diag[i] = -2*s.off + vi.Val()
+	}
+
+	// Factor the left-hand tridiagonal matrix (1 + iκH), κ = Δt/2ℏ.
+	kappa := complex(0, s.dt/(2*hbar))
+	a := kappa * complex(s.off, 0)
+	for i := 0; i < n; i++ {
+		b := 1 + kappa*complex(s.diag[i], 0)
+		if i > 0 {
+			b -= a * s.cp[i-1]
+		}
+		s.den[i] = b
+		s.cp[i] = a / b
+	}
+	return s, nil
+}
+
+// TimeStep returns the time step Δt.
+func (s *Solver) TimeStep() units.Time {
+	return units.Second(s.dt)
+}
+
+// Step advances w by one time step in place.
+// Returns an error if w is defined on a different grid.
+func (s *Solver) Step(w *Wavefunction) error {
+	if w.grid != s.grid {
+		return fmt.Errorf("wavefunction grid does not match solver grid")
+	}
+	kappa := complex(0, s.dt/(2*hbar))
+	a := kappa * complex(s.off, 0)
+	n := s.grid.n
+
+	// Right-hand side (1 - iκH)ψ.
+	for i := 0; i < n; i++ {
+		s.rhs[i] = (1-kappa*complex(s.diag[i], 0))*w.psi[i] - a*(w.at(i-1)+w.at(i+1))
+	}
+	// Forward substitution, then back substitution into ψ.
+	s.rhs[0] /= s.den[0]
+	for i := 1; i < n; i++ {
+		s.rhs[i] = (s.rhs[i] - a*s.rhs[i-1]) / s.den[i]
+	}
+	w.psi[n-1] = s.rhs[n-1]
+	for i := n - 2; i >= 0; i-- {
+		w.psi[i] = s.rhs[i] - s.cp[i]*w.psi[i+1]
+	}
+	return nil
+}
+
+// Evolve advances w by the given number of time steps in place.
+// Returns an error if steps is negative or w is on a different grid.
+func (s *Solver) Evolve(w *Wavefunction, steps int) error {
+	if steps < 0 {
+		return fmt.Errorf("number of steps must be non-negative, got %d", steps)
+	}
+	for k := 0; k < steps; k++ {
+		if err := s.Step(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Energy returns ⟨H⟩ for w using the solver's discretized Hamiltonian.
+// Returns an error if w is defined on a different grid.
+func (s *Solver) Energy(w *Wavefunction) (units.Energy, error) {
+	if w.grid != s.grid {
+		return units.Energy{}, fmt.Errorf("wavefunction grid does not match solver grid")
+	}
+	var sum complex128
+	for i, p := range w.psi {
+		hp := complex(s.diag[i], 0)*p + complex(s.off, 0)*(w.at(i-1)+w.at(i+1))
+		sum += complex(real(p), -imag(p)) * hp
+	}
+	return units.Joule(real(sum) * s.grid.dx / w.Norm()), nil
+}
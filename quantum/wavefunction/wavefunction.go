@@ -0,0 +1,201 @@
+package wavefunction
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// hbar is the reduced Planck constant in J·s.
+var hbar = constants.PlanckReduced.Val()
+
+// Grid is a uniform one-dimensional spatial grid of N points spanning
+// [XMin, XMax], end points included.
+type Grid struct {
+	xmin, dx float64
+	n        int
+}
+
+// NewGrid creates a grid of n points from xmin to xmax.
+// Returns an error if n < 3 or xmax ≤ xmin.
+func NewGrid(xmin, xmax units.Length, n int) (Grid, error) {
+	if n < 3 {
+		return Grid{}, fmt.Errorf("grid must have at least 3 points, got %d", n)
+	}
+	if !(xmax.Val() > xmin.Val()) {
+		return Grid{}, fmt.Errorf("grid upper bound must exceed lower bound, got [%g, %g] m", xmin.Val(), xmax.Val())
+	}
+	return Grid{xmin: xmin.Val(), dx: (xmax.Val() - xmin.Val()) / float64(n-1), n: n}, nil
+}
+
+// N returns the number of grid points.
+func (g Grid) N() int {
+	return g.n
+}
+
+// Spacing returns the grid spacing Δx.
+func (g Grid) Spacing() units.Length {
+	return units.Meter(g.dx)
+}
+
+// X returns the position of grid point i.
+func (g Grid) X(i int) units.Length {
+	return units.Meter(g.x(i))
+}
+
+func (g Grid) x(i int) float64 {
+	return g.xmin + float64(i)*g.dx
+}
+
+// -----------------------------------------------------------------------------
+// Wavefunction
+// -----------------------------------------------------------------------------
+
+// Wavefunction holds the samples ψ(xᵢ) of a one-dimensional wavefunction on
+// a Grid, in SI units of m^-1/2.
+type Wavefunction struct {
+	grid Grid
+	psi  []complex128
+}
+
+// New creates a wavefunction from samples in m^-1/2 and normalizes it so
+// that ∫|ψ|² dx = 1. The slice is copied. Returns an error if its length
+// does not match the grid or it is identically zero.
+func New(grid Grid, values []complex128) (*Wavefunction, error) {
+	if len(values) != grid.n {
+		return nil, fmt.Errorf("number of samples %d does not match %d grid points", len(values), grid.n)
+	}
+	w := &Wavefunction{grid: grid, psi: append([]complex128(nil), values...)}
+	if err := w.Normalize(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// GaussianPacket returns the normalized minimum-uncertainty wave packet
+// centred at x0 with position spread σ and mean momentum p0.
+// Returns an error if σ is not positive.
+//
+// Formula:
+//
+//	ψ(x) ∝ exp(-(x - x₀)²/4σ² + i p₀ x/ℏ)
+func GaussianPacket(grid Grid, x0, sigma units.Length, p0 units.Momentum) (*Wavefunction, error) {
+	if !(sigma.Val() > 0) {
+		return nil, fmt.Errorf("packet width must be positive, got %g m", sigma.Val())
+	}
+	k0 := p0.Val() / hbar
+	psi := make([]complex128, grid.n)
+	for i := range psi {
+		u := (grid.x(i) - x0.Val()) / sigma.Val()
+		psi[i] = cmplx.Rect(math.Exp(-u*u/4), k0*grid.x(i))
+	}
+	return New(grid, psi)
+}
+
+// Grid returns the spatial grid.
+func (w *Wavefunction) Grid() Grid {
+	return w.grid
+}
+
+// Values returns a copy of the samples ψ(xᵢ) in m^-1/2.
+func (w *Wavefunction) Values() []complex128 {
+	return append([]complex128(nil), w.psi...)
+}
+
+// Clone returns an independent copy of the wavefunction.
+func (w *Wavefunction) Clone() *Wavefunction {
+	return &Wavefunction{grid: w.grid, psi: w.Values()}
+}
+
+// Density returns the probability density |ψ(xᵢ)|² at each grid point, in m⁻¹.
+func (w *Wavefunction) Density() []float64 {
+	rho := make([]float64, len(w.psi))
+	for i, a := range w.psi {
+		rho[i] = abs2(a)
+	}
+	return rho
+}
+
+// Norm returns ∫|ψ|² dx, which is 1 for a normalized wavefunction.
+func (w *Wavefunction) Norm() float64 {
+	sum := 0.0
+	for _, a := range w.psi {
+		sum += abs2(a)
+	}
+	return sum * w.grid.dx
+}
+
+// Normalize rescales ψ so that ∫|ψ|² dx = 1.
+// Returns an error if ψ is identically zero.
+func (w *Wavefunction) Normalize() error {
+	norm := w.Norm()
+	if !(norm > 0) || math.IsInf(norm, 0) {
+		return fmt.Errorf("cannot normalize wavefunction with norm %g", norm)
+	}
+	scale := complex(1/math.Sqrt(norm), 0)
+	for i := range w.psi {
+		w.psi[i] *= scale
+	}
+	return nil
+}
+
+// Probability returns the probability ∫|ψ|² dx of finding the particle in
+// [a, b], summing the grid points that fall inside the interval.
+func (w *Wavefunction) Probability(a, b units.Length) float64 {
+	sum := 0.0
+	for i, p := range w.psi {
+		if x := w.grid.x(i); x >= a.Val() && x <= b.Val() {
+			sum += abs2(p)
+		}
+	}
+	return sum * w.grid.dx
+}
+
+// ExpectationPosition returns ⟨x⟩.
+func (w *Wavefunction) ExpectationPosition() units.Length {
+	return units.Meter(w.moment(1) / w.Norm())
+}
+
+// PositionSpread returns the standard deviation Δx = √(⟨x²⟩ - ⟨x⟩²).
+func (w *Wavefunction) PositionSpread() units.Length {
+	norm := w.Norm()
+	mean := w.moment(1) / norm
+	return units.Meter(math.Sqrt(math.Max(w.moment(2)/norm-mean*mean, 0)))
+}
+
+// ExpectationMomentum returns ⟨p⟩ = ∫ψ*(-iℏ ∂ψ/∂x) dx, with the derivative
+// taken by central differences.
+func (w *Wavefunction) ExpectationMomentum() units.Momentum {
+	var sum complex128
+	n := len(w.psi)
+	for i := 0; i < n; i++ {
+		sum += cmplx.Conj(w.psi[i]) * (w.at(i+1) - w.at(i-1))
+	}
+	// -iℏ Σ ψ*(ψ₊ - ψ₋)/2Δx · Δx
+	p := real(-1i*sum) * hbar / 2
+	return units.KilogramMeterPerSecond(p / w.Norm())
+}
+
+// moment returns ∫ xᵏ |ψ|² dx.
+func (w *Wavefunction) moment(k int) float64 {
+	sum := 0.0
+	for i, a := range w.psi {
+		sum += math.Pow(w.grid.x(i), float64(k)) * abs2(a)
+	}
+	return sum * w.grid.dx
+}
+
+// at returns ψᵢ, or 0 outside the grid.
+func (w *Wavefunction) at(i int) complex128 {
+	if i < 0 || i >= len(w.psi) {
+		return 0
+	}
+	return w.psi[i]
+}
+
+func abs2(a complex128) float64 {
+	return real(a)*real(a) + imag(a)*imag(a)
+}
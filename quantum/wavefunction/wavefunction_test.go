@@ -0,0 +1,204 @@
+package wavefunction
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+// massOf returns the rest mass of a particle from the catalog.
+func massOf(name string) units.Mass {
+	p, _ := particles.Lookup(name)
+	return units.Mass{Value: p.Mass.Value()}
+}
+
+func TestNewGrid(t *testing.T) {
+	g, err := NewGrid(units.Nanometer(-1), units.Nanometer(1), 201)
+	if err != nil {
+		t.Fatalf("NewGrid() error = %v", err)
+	}
+	if g.N() != 201 || !almostEqual(g.Spacing().ToNanometers(), 0.01, 1e-12) {
+		t.Errorf("grid N, Δx = %d, %v", g.N(), g.Spacing())
+	}
+	if !almostEqual(g.X(100).Val(), 0, 1e-12) || !almostEqual(g.X(200).ToNanometers(), 1, 1e-12) {
+		t.Errorf("grid end points wrong: X(100) = %v, X(200) = %v", g.X(100), g.X(200))
+	}
+	if _, err := NewGrid(units.Meter(1), units.Meter(0), 10); err == nil {
+		t.Error("NewGrid should reject inverted bounds")
+	}
+	if _, err := NewGrid(units.Meter(0), units.Meter(1), 2); err == nil {
+		t.Error("NewGrid should reject too few points")
+	}
+}
+
+func TestGaussianPacket(t *testing.T) {
+	g, _ := NewGrid(units.Nanometer(-20), units.Nanometer(20), 4001)
+	x0, sigma := units.Nanometer(3), units.Nanometer(1.5)
+	p0 := units.KilogramMeterPerSecond(2e-25)
+	w, err := GaussianPacket(g, x0, sigma, p0)
+	if err != nil {
+		t.Fatalf("GaussianPacket() error = %v", err)
+	}
+	if !almostEqual(w.Norm(), 1, 1e-12) {
+		t.Errorf("Norm() = %v, want 1", w.Norm())
+	}
+	if got := w.ExpectationPosition(); !almostEqual(got.ToNanometers(), 3, 1e-9) {
+		t.Errorf("⟨x⟩ = %v nm, want 3", got.ToNanometers())
+	}
+	if got := w.PositionSpread(); !almostEqual(got.ToNanometers(), 1.5, 1e-6) {
+		t.Errorf("Δx = %v nm, want 1.5", got.ToNanometers())
+	}
+	if got := w.ExpectationMomentum(); !almostEqual(got.Val(), p0.Val(), 1e-3) {
+		t.Errorf("⟨p⟩ = %v, want %v", got, p0)
+	}
+	if p := w.Probability(units.Nanometer(3), units.Nanometer(20)); !almostEqual(p, 0.5, 5e-3) {
+		t.Errorf("P(x > x₀) = %v, want 0.5", p)
+	}
+	if len(w.Density()) != g.N() {
+		t.Errorf("len(Density()) = %d, want %d", len(w.Density()), g.N())
+	}
+
+	if _, err := GaussianPacket(g, x0, units.Meter(0), p0); err == nil {
+		t.Error("GaussianPacket should reject zero width")
+	}
+	if _, err := New(g, make([]complex128, g.N())); err == nil {
+		t.Error("New should reject the zero wavefunction")
+	}
+	if _, err := New(g, make([]complex128, 3)); err == nil {
+		t.Error("New should reject mismatched lengths")
+	}
+}
+
+func TestFreeSpreading(t *testing.T) {
+	// A free Gaussian packet spreads as σ(t) = σ₀ √(1 + (ℏt/2mσ₀²)²) and
+	// drifts at p₀/m.
+	m := massOf("e-")
+	g, _ := NewGrid(units.Nanometer(-60), units.Nanometer(60), 3001)
+	sigma0 := units.Nanometer(2)
+	p0 := units.KilogramMeterPerSecond(1e-25)
+	w, _ := GaussianPacket(g, units.Nanometer(-10), sigma0, p0)
+
+	dt := units.Second(2e-16)
+	s, err := NewSolver(g, m, FreeParticle, dt)
+	if err != nil {
+		t.Fatalf("NewSolver() error = %v", err)
+	}
+	steps := 500
+	if err := s.Evolve(w, steps); err != nil {
+		t.Fatalf("Evolve() error = %v", err)
+	}
+	tt := float64(steps) * dt.Val()
+
+	if !almostEqual(w.Norm(), 1, 1e-10) {
+		t.Errorf("norm after evolution = %v, want 1", w.Norm())
+	}
+	tau := hbar * tt / (2 * m.Val() * sigma0.Val() * sigma0.Val())
+	want := sigma0.Val() * math.Sqrt(1+tau*tau)
+	if got := w.PositionSpread().Val(); !almostEqual(got, want, 5e-3) {
+		t.Errorf("σ(t) = %v nm, want %v nm", got*1e9, want*1e9)
+	}
+	wantX := -10e-9 + p0.Val()/m.Val()*tt
+	if got := w.ExpectationPosition().Val(); math.Abs(got-wantX) > 0.05e-9 {
+		t.Errorf("⟨x⟩(t) = %v nm, want %v nm", got*1e9, wantX*1e9)
+	}
+}
+
+func TestHarmonicOscillator(t *testing.T) {
+	m := massOf("e-")
+	omega := units.RadianPerSecond(1e15)
+	g, _ := NewGrid(units.Nanometer(-8), units.Nanometer(8), 3201)
+	s, err := NewSolver(g, m, HarmonicPotential(m, omega, units.Meter(0)), units.Second(2.5e-18))
+	if err != nil {
+		t.Fatalf("NewSolver() error = %v", err)
+	}
+
+	// The ground state σ = √(ℏ/2mω) is stationary with E₀ = ℏω/2.
+	sigma := units.Meter(math.Sqrt(hbar / (2 * m.Val() * omega.Val())))
+	ground, _ := GaussianPacket(g, units.Meter(0), sigma, units.KilogramMeterPerSecond(0))
+	e0, _ := s.Energy(ground)
+	if want := 0.5 * hbar * omega.Val(); !almostEqual(e0.Val(), want, 1e-3) {
+		t.Errorf("E₀ = %v J, want %v J", e0.Val(), want)
+	}
+	before := ground.Density()
+	_ = s.Evolve(ground, 1000)
+	for i, p := range ground.Density() {
+		if math.Abs(p-before[i]) > 1e-3*before[len(before)/2] {
+			t.Fatalf("ground-state density changed at x = %v", g.X(i))
+		}
+	}
+
+	// A displaced coherent state oscillates classically: ⟨x⟩ = x₀ cos ωt,
+	// with energy conserved.
+	x0 := units.Nanometer(2)
+	w, _ := GaussianPacket(g, x0, sigma, units.KilogramMeterPerSecond(0))
+	e1, _ := s.Energy(w)
+	steps := 628 // ωt ≈ π/2
+	_ = s.Evolve(w, steps)
+	tt := float64(steps) * s.TimeStep().Val()
+	if got, want := w.ExpectationPosition().Val(), x0.Val()*math.Cos(omega.Val()*tt); math.Abs(got-want) > 0.01e-9 {
+		t.Errorf("⟨x⟩(t) = %v nm, want %v nm", got*1e9, want*1e9)
+	}
+	if got, want := w.ExpectationMomentum().Val(), -m.Val()*omega.Val()*x0.Val()*math.Sin(omega.Val()*tt); !almostEqual(got, want, 1e-2) {
+		t.Errorf("⟨p⟩(t) = %v, want %v", got, want)
+	}
+	if e2, _ := s.Energy(w); !almostEqual(e2.Val(), e1.Val(), 1e-9) {
+		t.Errorf("energy drifted from %v to %v", e1, e2)
+	}
+}
+
+func TestSolver_Errors(t *testing.T) {
+	g, _ := NewGrid(units.Meter(0), units.Meter(1), 10)
+	m := massOf("e-")
+	if _, err := NewSolver(g, units.Kilogram(0), FreeParticle, units.Second(1)); err == nil {
+		t.Error("NewSolver should reject zero mass")
+	}
+	if _, err := NewSolver(g, m, FreeParticle, units.Second(-1)); err == nil {
+		t.Error("NewSolver should reject negative time steps")
+	}
+	inf := SquareBarrier(units.Meter(0.5), units.Meter(1), units.Joule(math.Inf(1)))
+	if _, err := NewSolver(g, m, inf, units.Second(1)); err == nil {
+		t.Error("NewSolver should reject infinite potentials")
+	}
+	bad := func(units.Length) units.Energy { return units.Energy{Value: units.Meter(1).Value} }
+	if _, err := NewSolver(g, m, bad, units.Second(1)); err == nil {
+		t.Error("NewSolver should reject potentials that are not energies")
+	}
+
+	s, _ := NewSolver(g, m, FreeParticle, units.Second(1))
+	other, _ := NewGrid(units.Meter(0), units.Meter(2), 10)
+	w, _ := GaussianPacket(other, units.Meter(1), units.Meter(0.2), units.KilogramMeterPerSecond(0))
+	if err := s.Step(w); err == nil {
+		t.Error("Step should reject wavefunctions on another grid")
+	}
+	if err := s.Evolve(w, -1); err == nil {
+		t.Error("Evolve should reject negative step counts")
+	}
+}
+
+func TestBarrierTunnelling(t *testing.T) {
+	// A packet below the barrier top is mostly reflected, above it mostly
+	// transmitted.
+	m := massOf("e-")
+	g, _ := NewGrid(units.Nanometer(-80), units.Nanometer(80), 4001)
+	barrier := SquareBarrier(units.Nanometer(0), units.Nanometer(1), units.ElectronVolt(0.5))
+	s, _ := NewSolver(g, m, barrier, units.Second(5e-17))
+
+	transmission := func(energyEV float64) float64 {
+		p0 := units.KilogramMeterPerSecond(math.Sqrt(2 * m.Val() * energyEV * constants.ElectronVoltToJoule))
+		w, _ := GaussianPacket(g, units.Nanometer(-30), units.Nanometer(5), p0)
+		steps := int(60e-9 / (p0.Val() / m.Val()) / s.TimeStep().Val())
+		_ = s.Evolve(w, steps)
+		return w.Probability(units.Nanometer(1), units.Nanometer(80))
+	}
+	low, high := transmission(0.2), transmission(1.5)
+	if low > 0.2 || high < 0.8 {
+		t.Errorf("transmission at 0.2 eV, 1.5 eV = %v, %v", low, high)
+	}
+}
@@ -0,0 +1,33 @@
+// Package gr provides general relativity helpers for the Schwarzschild
+// (static, spherically symmetric) spacetime and back-of-envelope
+// estimates for gravitational waves from compact binaries.
+//
+// All radii are Schwarzschild areal radii r. Functions that evaluate the
+// metric at a radius return an error when r lies at or inside the relevant
+// horizon or orbit.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/relativity/gr"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	rs := gr.SchwarzschildRadius(constants.SolarMass) // ≈ 2.95 km
+//	isco := gr.ISCO(units.SolarMass(10))               // ≈ 88.6 km
+//
+//	// Clock rate at the Earth's surface relative to infinity
+//	f, _ := gr.TimeDilationFactor(constants.EarthMass, constants.EarthRadius) // 1 - 6.96e-10
+//
+//	// Mercury's perihelion advance per orbit
+//	dphi, _ := gr.PerihelionPrecession(constants.SolarMass, units.Meter(5.7909e10), 0.2056)
+//
+//	// Binary neutron star inspiral seen from 40 Mpc at 100 Hz
+//	mc := gr.ChirpMass(units.SolarMass(1.4), units.SolarMass(1.4))
+//	h, _ := gr.StrainAmplitude(mc, units.Hertz(100), units.Megaparsec(40))
+//
+// References:
+//   - Carroll. "Spacetime and Geometry", 1st ed., Ch. 5
+//   - Hartle. "Gravity: An Introduction to Einstein's General Relativity", 1st ed., Ch. 9
+package gr
@@ -0,0 +1,103 @@
+package gr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b)/math.Max(math.Abs(a), math.Abs(b)) < tolerance
+}
+
+func TestSchwarzschildRadius(t *testing.T) {
+	// r_s(Sun) ≈ 2953 m
+	rs := SchwarzschildRadius(constants.SolarMass)
+	if !almostEqual(rs.Val(), 2954, 1e-3) {
+		t.Errorf("r_s(Sun) = %v m, want ≈ 2954", rs.Val())
+	}
+	if !almostEqual(PhotonSphere(constants.SolarMass).Val(), 1.5*rs.Val(), 1e-15) {
+		t.Error("photon sphere should be at 1.5 r_s")
+	}
+	if !almostEqual(ISCO(constants.SolarMass).Val(), 3*rs.Val(), 1e-15) {
+		t.Error("ISCO should be at 3 r_s")
+	}
+}
+
+func TestTimeDilationFactor(t *testing.T) {
+	// Earth surface: 1 - dτ/dt ≈ GM/(Rc²) ≈ 6.96e-10
+	f, err := TimeDilationFactor(constants.EarthMass, constants.EarthRadius)
+	if err != nil {
+		t.Fatalf("TimeDilationFactor() error = %v", err)
+	}
+	if !almostEqual(1-f, 6.96e-10, 1e-3) {
+		t.Errorf("1 - dτ/dt at Earth's surface = %v, want ≈ 6.96e-10", 1-f)
+	}
+
+	m := units.SolarMass(1)
+	if _, err := TimeDilationFactor(m, SchwarzschildRadius(m)); err == nil {
+		t.Error("TimeDilationFactor should fail at the horizon")
+	}
+}
+
+func TestCircularOrbitTimeDilation(t *testing.T) {
+	// At the ISCO, dτ/dt = √(1/2)
+	m := units.SolarMass(10)
+	f, err := CircularOrbitTimeDilation(m, ISCO(m))
+	if err != nil || !almostEqual(f, math.Sqrt(0.5), 1e-12) {
+		t.Errorf("dτ/dt at ISCO = %v, %v; want √½", f, err)
+	}
+	if _, err := CircularOrbitTimeDilation(m, PhotonSphere(m)); err == nil {
+		t.Error("CircularOrbitTimeDilation should fail at the photon sphere")
+	}
+}
+
+func TestGravitationalRedshift(t *testing.T) {
+	// Solar surface: z ≈ 2.12e-6 (636 m/s equivalent)
+	z, err := GravitationalRedshift(constants.SolarMass, constants.SolarRadius)
+	if err != nil {
+		t.Fatalf("GravitationalRedshift() error = %v", err)
+	}
+	if !almostEqual(z, 2.12e-6, 2e-3) {
+		t.Errorf("z(Sun) = %v, want ≈ 2.12e-6", z)
+	}
+
+	// Pound-Rebka: 22.5 m tower, Δν/ν ≈ -gh/c² = -2.46e-15 (blueshift going down)
+	r := constants.EarthRadius.Val()
+	zb, _ := RedshiftBetween(constants.EarthMass, units.Meter(r+22.5), units.Meter(r))
+	if !almostEqual(zb, -2.46e-15, 1e-2) {
+		t.Errorf("Pound-Rebka shift = %v, want ≈ -2.46e-15", zb)
+	}
+
+	// Emission from infinity-like radius to infinity agrees with GravitationalRedshift.
+	zr, _ := RedshiftBetween(constants.SolarMass, constants.SolarRadius, units.Meter(1e30))
+	if !almostEqual(zr, z, 1e-9) {
+		t.Errorf("RedshiftBetween(R☉, ∞) = %v, want %v", zr, z)
+	}
+}
+
+func TestPerihelionPrecession_Mercury(t *testing.T) {
+	// Mercury: ≈ 43 arcseconds per century
+	m, a, e := constants.SolarMass, units.Meter(5.7909e10), 0.2056
+	rate, err := PrecessionRate(m, a, e)
+	if err != nil {
+		t.Fatalf("PrecessionRate() error = %v", err)
+	}
+	century := units.Year(100).Val()
+	arcsec := rate.Val() * century * 180 / math.Pi * 3600
+	if !almostEqual(arcsec, 43.0, 5e-3) {
+		t.Errorf("Mercury precession = %v″/century, want ≈ 43.0", arcsec)
+	}
+
+	if _, err := PerihelionPrecession(m, a, 1.0); err == nil {
+		t.Error("PerihelionPrecession should reject unbound orbits")
+	}
+	if _, err := PerihelionPrecession(m, units.Meter(0), 0); err == nil {
+		t.Error("PerihelionPrecession should reject a ≤ 0")
+	}
+}
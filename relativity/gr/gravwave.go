@@ -0,0 +1,148 @@
+package gr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// This file provides leading-order (quadrupole, Newtonian inspiral)
+// estimates for gravitational waves from circular compact binaries. They
+// are accurate well before merger and should be read as order-of-magnitude
+// values near the ISCO.
+//
+// References:
+//   - Maggiore. "Gravitational Waves, Vol. 1", 1st ed., Ch. 4
+//   - Peters. "Gravitational Radiation and the Motion of Two Point Masses",
+//     Phys. Rev. 136, B1224 (1964)
+
+// ChirpMass returns the chirp mass, the mass combination that governs the
+// inspiral phase evolution.
+//
+// Formula:
+//
+//	M_c = (m₁ m₂)^(3/5) / (m₁ + m₂)^(1/5)
+//
+// Example:
+//
+//	mc := gr.ChirpMass(units.SolarMass(36), units.SolarMass(29)) // ≈ 28.1 M☉
+func ChirpMass(m1, m2 units.Mass) units.Mass {
+	a, b := m1.Val(), m2.Val()
+	return units.Kilogram(math.Pow(a*b, 0.6) / math.Pow(a+b, 0.2))
+}
+
+// GWFrequency returns the dominant gravitational-wave frequency of a
+// circular binary with orbital separation a, twice the orbital frequency.
+// Returns an error if a is not positive.
+//
+// Formula:
+//
+//	f_GW = (1/π) √(G(m₁ + m₂) / a³)
+func GWFrequency(m1, m2 units.Mass, separation units.Length) (units.Frequency, error) {
+	a := separation.Val()
+	if a <= 0 {
+		return units.Frequency{}, fmt.Errorf("separation must be positive, got %g m", a)
+	}
+	gm := constants.GravitationalConstant.Val() * (m1.Val() + m2.Val())
+	return units.Hertz(math.Sqrt(gm/(a*a*a)) / math.Pi), nil
+}
+
+// SeparationAtFrequency returns the orbital separation at which a circular
+// binary emits at gravitational-wave frequency f (inverse of GWFrequency).
+// Returns an error if f is not positive.
+func SeparationAtFrequency(m1, m2 units.Mass, f units.Frequency) (units.Length, error) {
+	if f.Val() <= 0 {
+		return units.Length{}, fmt.Errorf("frequency must be positive, got %g Hz", f.Val())
+	}
+	gm := constants.GravitationalConstant.Val() * (m1.Val() + m2.Val())
+	w := math.Pi * f.Val()
+	return units.Meter(math.Cbrt(gm / (w * w))), nil
+}
+
+// FrequencyDerivative returns the chirp rate df/dt of the inspiral signal
+// at gravitational-wave frequency f.
+//
+// Formula:
+//
+//	ḟ = (96/5) π^(8/3) (G M_c / c³)^(5/3) f^(11/3)
+func FrequencyDerivative(chirpMass units.Mass, f units.Frequency) units.Value {
+	tc := chirpTime(chirpMass)
+	v := 96.0 / 5.0 * math.Pow(math.Pi, 8.0/3.0) * math.Pow(tc, 5.0/3.0) * math.Pow(f.Val(), 11.0/3.0)
+	return units.NewValue(v, units.Dimension{T: -2})
+}
+
+// TimeToCoalescence returns the time remaining until merger for a
+// circular binary with separation a, from the quadrupole energy loss.
+// Returns an error if a is not positive.
+//
+// Formula:
+//
+//	τ = (5/256) c⁵ a⁴ / (G³ m₁ m₂ (m₁ + m₂))
+func TimeToCoalescence(m1, m2 units.Mass, separation units.Length) (units.Time, error) {
+	a := separation.Val()
+	if a <= 0 {
+		return units.Time{}, fmt.Errorf("separation must be positive, got %g m", a)
+	}
+	g := constants.GravitationalConstant.Val()
+	ma, mb := m1.Val(), m2.Val()
+	return units.Second(5.0 / 256.0 * math.Pow(c, 5) * math.Pow(a, 4) / (g * g * g * ma * mb * (ma + mb))), nil
+}
+
+// TimeToCoalescenceFromFrequency returns the time remaining until merger
+// for an inspiral currently emitting at gravitational-wave frequency f.
+//
+// Formula:
+//
+//	τ = (5/256) (G M_c / c³)^(-5/3) (π f)^(-8/3)
+func TimeToCoalescenceFromFrequency(chirpMass units.Mass, f units.Frequency) units.Time {
+	tc := chirpTime(chirpMass)
+	return units.Second(5.0 / 256.0 * math.Pow(tc, -5.0/3.0) * math.Pow(math.Pi*f.Val(), -8.0/3.0))
+}
+
+// StrainAmplitude returns the sky- and orientation-optimal strain amplitude
+// h₀ of a circular binary at luminosity distance D emitting at frequency f.
+// Returns an error if D is not positive.
+//
+// Formula:
+//
+//	h₀ = 4 (G M_c)^(5/3) (π f)^(2/3) / (c⁴ D)
+//
+// Example:
+//
+//	// GW150914-like source at 410 Mpc, 150 Hz: h₀ ≈ 1e-21
+//	mc := gr.ChirpMass(units.SolarMass(36), units.SolarMass(29))
+//	h, _ := gr.StrainAmplitude(mc, units.Hertz(150), units.Megaparsec(410))
+func StrainAmplitude(chirpMass units.Mass, f units.Frequency, distance units.Length) (float64, error) {
+	d := distance.Val()
+	if d <= 0 {
+		return 0, fmt.Errorf("distance must be positive, got %g m", d)
+	}
+	gmc := constants.GravitationalConstant.Val() * chirpMass.Val()
+	return 4 * math.Pow(gmc, 5.0/3.0) * math.Pow(math.Pi*f.Val(), 2.0/3.0) / (math.Pow(c, 4) * d), nil
+}
+
+// CharacteristicStrain returns the characteristic strain h_c(f) of an
+// inspiral, which accounts for the signal accumulating over many cycles
+// near frequency f and is the quantity compared against detector noise
+// curves √(f S_n(f)). Returns an error if D is not positive.
+//
+// Formula:
+//
+//	h_c(f) = (1/(πD)) √(2G/c³ · dE/df)
+//	dE/df = (π^(2/3)/3) G^(2/3) M_c^(5/3) f^(-1/3)
+func CharacteristicStrain(chirpMass units.Mass, f units.Frequency, distance units.Length) (float64, error) {
+	d := distance.Val()
+	if d <= 0 {
+		return 0, fmt.Errorf("distance must be positive, got %g m", d)
+	}
+	g := constants.GravitationalConstant.Val()
+	dEdf := math.Pow(math.Pi, 2.0/3.0) / 3 * math.Pow(g, 2.0/3.0) * math.Pow(chirpMass.Val(), 5.0/3.0) * math.Pow(f.Val(), -1.0/3.0)
+	return math.Sqrt(2*g/(c*c*c)*dEdf) / (math.Pi * d), nil
+}
+
+// chirpTime returns G M_c / c³ in seconds.
+func chirpTime(chirpMass units.Mass) float64 {
+	return constants.GravitationalConstant.Val() * chirpMass.Val() / (c * c * c)
+}
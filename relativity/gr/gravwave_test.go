@@ -0,0 +1,99 @@
+package gr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestChirpMass(t *testing.T) {
+	// GW150914: 36 + 29 M☉ → M_c ≈ 28.1 M☉
+	mc := ChirpMass(units.SolarMass(36), units.SolarMass(29))
+	if got := mc.Val() / units.SolarMass(1).Val(); !almostEqual(got, 28.1, 2e-3) {
+		t.Errorf("M_c = %v M☉, want ≈ 28.1", got)
+	}
+
+	// Equal masses: M_c = m / 2^(1/5)
+	mc = ChirpMass(units.SolarMass(1.4), units.SolarMass(1.4))
+	if want := 1.4 / math.Pow(2, 0.2); !almostEqual(mc.Val()/units.SolarMass(1).Val(), want, 1e-12) {
+		t.Errorf("M_c(1.4, 1.4) = %v M☉, want %v", mc.Val()/units.SolarMass(1).Val(), want)
+	}
+}
+
+func TestGWFrequency_ISCO(t *testing.T) {
+	// f_GW at the ISCO of the total mass ≈ 4.4 kHz (M☉/M)
+	m := units.SolarMass(0.5)
+	f, err := GWFrequency(m, m, ISCO(units.SolarMass(1)))
+	if err != nil {
+		t.Fatalf("GWFrequency() error = %v", err)
+	}
+	if !almostEqual(f.Val(), 4397, 1e-3) {
+		t.Errorf("f_ISCO = %v Hz, want ≈ 4397", f.Val())
+	}
+
+	a, _ := SeparationAtFrequency(m, m, f)
+	if !almostEqual(a.Val(), ISCO(units.SolarMass(1)).Val(), 1e-12) {
+		t.Errorf("SeparationAtFrequency round trip = %v, want ISCO", a.Val())
+	}
+
+	if _, err := GWFrequency(m, m, units.Meter(0)); err == nil {
+		t.Error("GWFrequency should reject zero separation")
+	}
+}
+
+func TestTimeToCoalescence(t *testing.T) {
+	m1, m2 := units.SolarMass(1.4), units.SolarMass(1.3)
+	a := units.Kilometer(1000)
+	tau, err := TimeToCoalescence(m1, m2, a)
+	if err != nil {
+		t.Fatalf("TimeToCoalescence() error = %v", err)
+	}
+
+	// The separation and frequency forms agree.
+	f, _ := GWFrequency(m1, m2, a)
+	tf := TimeToCoalescenceFromFrequency(ChirpMass(m1, m2), f)
+	if !almostEqual(tau.Val(), tf.Val(), 1e-12) {
+		t.Errorf("τ(a) = %v s, τ(f) = %v s", tau.Val(), tf.Val())
+	}
+
+	// τ = (3/8) f/ḟ
+	fdot := FrequencyDerivative(ChirpMass(m1, m2), f)
+	if !almostEqual(tau.Val(), 3.0/8.0*f.Val()/fdot.Val(), 1e-12) {
+		t.Errorf("τ = %v s, want 3f/(8ḟ) = %v s", tau.Val(), 3.0/8.0*f.Val()/fdot.Val())
+	}
+	if fdot.Dim() != (units.Dimension{T: -2}) {
+		t.Errorf("ḟ dimension = %v, want [T⁻²]", fdot.Dim())
+	}
+}
+
+func TestStrain(t *testing.T) {
+	// GW150914-like source at 410 Mpc near 150 Hz: h ~ 1e-21
+	mc := ChirpMass(units.SolarMass(36), units.SolarMass(29))
+	f, d := units.Hertz(150), units.Megaparsec(410)
+
+	h0, err := StrainAmplitude(mc, f, d)
+	if err != nil {
+		t.Fatalf("StrainAmplitude() error = %v", err)
+	}
+	if h0 < 5e-22 || h0 > 3e-21 {
+		t.Errorf("h₀ = %v, want ~1e-21", h0)
+	}
+
+	// h_c² = (4/5) h₀² f²/ḟ (sky-averaged h_c vs optimal h₀)
+	hc, _ := CharacteristicStrain(mc, f, d)
+	fdot := FrequencyDerivative(mc, f).Val()
+	if want := math.Sqrt(0.8 * h0 * h0 * f.Val() * f.Val() / fdot); !almostEqual(hc, want, 1e-12) {
+		t.Errorf("h_c = %v, want %v", hc, want)
+	}
+
+	// Strain falls off as 1/D.
+	h2, _ := StrainAmplitude(mc, f, units.Megaparsec(820))
+	if !almostEqual(h2, h0/2, 1e-12) {
+		t.Errorf("h₀(2D) = %v, want h₀/2 = %v", h2, h0/2)
+	}
+
+	if _, err := CharacteristicStrain(mc, f, units.Meter(0)); err == nil {
+		t.Error("CharacteristicStrain should reject zero distance")
+	}
+}
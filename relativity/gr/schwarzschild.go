@@ -0,0 +1,152 @@
+package gr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// c is the speed of light in m/s.
+var c = constants.SpeedOfLight.Val()
+
+// SchwarzschildRadius returns the event horizon radius of a non-rotating mass.
+//
+// Formula:
+//
+//	r_s = 2GM / c²
+//
+// Example:
+//
+//	rs := gr.SchwarzschildRadius(constants.SolarMass) // ≈ 2953 m
+func SchwarzschildRadius(m units.Mass) units.Length {
+	return units.Meter(schwarzschildRadius(m))
+}
+
+// PhotonSphere returns the radius of the unstable circular photon orbit,
+// r = 3GM/c² = 1.5 r_s.
+func PhotonSphere(m units.Mass) units.Length {
+	return units.Meter(1.5 * schwarzschildRadius(m))
+}
+
+// ISCO returns the radius of the innermost stable circular orbit of a test
+// particle, r = 6GM/c² = 3 r_s.
+func ISCO(m units.Mass) units.Length {
+	return units.Meter(3 * schwarzschildRadius(m))
+}
+
+// TimeDilationFactor returns the rate dτ/dt of a static clock at radius r
+// relative to a clock at infinity. Returns an error if r ≤ r_s.
+//
+// Formula:
+//
+//	dτ/dt = √(1 - r_s/r)
+func TimeDilationFactor(m units.Mass, r units.Length) (float64, error) {
+	x, err := horizonRatio(m, r)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(1 - x), nil
+}
+
+// CircularOrbitTimeDilation returns dτ/dt for a clock on a circular
+// geodesic orbit at radius r, combining gravitational and orbital-velocity
+// time dilation. Returns an error if r ≤ 1.5 r_s, where no timelike circular
+// orbit exists.
+//
+// Formula:
+//
+//	dτ/dt = √(1 - 3GM/(rc²))
+func CircularOrbitTimeDilation(m units.Mass, r units.Length) (float64, error) {
+	x, err := horizonRatio(m, r)
+	if err != nil {
+		return 0, err
+	}
+	if 1.5*x >= 1 {
+		return 0, fmt.Errorf("no circular orbit at r = %g m inside the photon sphere (%g m)", r.Val(), 1.5*schwarzschildRadius(m))
+	}
+	return math.Sqrt(1 - 1.5*x), nil
+}
+
+// GravitationalRedshift returns the redshift z of light emitted by a static
+// source at radius r and received at infinity.
+// Returns an error if r ≤ r_s.
+//
+// Formula:
+//
+//	1 + z = 1/√(1 - r_s/r)
+func GravitationalRedshift(m units.Mass, r units.Length) (float64, error) {
+	f, err := TimeDilationFactor(m, r)
+	if err != nil {
+		return 0, err
+	}
+	return 1/f - 1, nil
+}
+
+// RedshiftBetween returns the redshift z of light emitted by a static source
+// at radius rEmit and received by a static observer at radius rObs.
+// Negative values indicate a blueshift (rObs < rEmit).
+//
+// Formula:
+//
+//	1 + z = √(1 - r_s/r_obs) / √(1 - r_s/r_emit)
+func RedshiftBetween(m units.Mass, rEmit, rObs units.Length) (float64, error) {
+	fe, err := TimeDilationFactor(m, rEmit)
+	if err != nil {
+		return 0, fmt.Errorf("emitter: %w", err)
+	}
+	fo, err := TimeDilationFactor(m, rObs)
+	if err != nil {
+		return 0, fmt.Errorf("observer: %w", err)
+	}
+	return fo/fe - 1, nil
+}
+
+// PerihelionPrecession returns the periapsis advance per orbit (radians) of
+// a test particle on a bound orbit with semi-major axis a and eccentricity e,
+// to first post-Newtonian order.
+// Returns an error if a ≤ 0 or e is not in [0, 1).
+//
+// Formula:
+//
+//	Δφ = 6πGM / (c² a (1 - e²))
+//
+// Example:
+//
+//	// Mercury: ≈ 5.0e-7 rad per orbit, 43″ per century
+//	dphi, _ := gr.PerihelionPrecession(constants.SolarMass, units.Meter(5.7909e10), 0.2056)
+func PerihelionPrecession(m units.Mass, a units.Length, e float64) (float64, error) {
+	if a.Val() <= 0 {
+		return 0, fmt.Errorf("semi-major axis must be positive, got %g m", a.Val())
+	}
+	if e < 0 || e >= 1 {
+		return 0, fmt.Errorf("eccentricity must be in [0, 1) for a bound orbit, got %g", e)
+	}
+	return 3 * math.Pi * schwarzschildRadius(m) / (a.Val() * (1 - e*e)), nil
+}
+
+// PrecessionRate returns the mean periapsis advance rate, the precession per
+// orbit divided by the Keplerian period 2π√(a³/GM).
+func PrecessionRate(m units.Mass, a units.Length, e float64) (units.AngularVelocity, error) {
+	dphi, err := PerihelionPrecession(m, a, e)
+	if err != nil {
+		return units.AngularVelocity{}, err
+	}
+	gm := constants.GravitationalConstant.Val() * m.Val()
+	period := 2 * math.Pi * math.Sqrt(a.Val()*a.Val()*a.Val()/gm)
+	return units.RadianPerSecond(dphi / period), nil
+}
+
+func schwarzschildRadius(m units.Mass) float64 {
+	return 2 * constants.GravitationalConstant.Val() * m.Val() / (c * c)
+}
+
+// horizonRatio returns r_s/r, or an error if r ≤ r_s.
+func horizonRatio(m units.Mass, r units.Length) (float64, error) {
+	rs := schwarzschildRadius(m)
+	if r.Val() <= rs {
+		return 0, fmt.Errorf("radius %g m is at or inside the Schwarzschild radius %g m", r.Val(), rs)
+	}
+	return rs / r.Val(), nil
+}
@@ -0,0 +1,34 @@
+// Package sr provides special relativity kinematics with unit-safe
+// quantities.
+//
+// All functions that take a speed validate that |v| < c and return an
+// error otherwise. Velocities are treated as collinear (one-dimensional);
+// rapidity is additive under boosts along the same axis.
+//
+// FourVector represents Minkowski four-vectors with metric (+, -, -, -),
+// such as spacetime events (ct, r) and four-momenta (E/c, p), and supports
+// general Lorentz boosts and invariant products.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants/particles"
+//	    "github.com/sakiphan/qsim-core/relativity/sr"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// A muon moving at 0.998c
+//	v := units.SpeedOfLight(0.998)
+//	gamma, _ := sr.LorentzFactor(v)                             // ≈ 15.8
+//	lifetime, _ := sr.TimeDilation(units.Microsecond(2.197), v) // ≈ 34.7 μs
+//
+//	// Energy-momentum relation
+//	electron, _ := particles.Lookup("e-")
+//	me := units.Mass{Value: electron.Mass.Value()}
+//	p, _ := sr.Momentum(me, v)
+//	e := sr.EnergyFromMomentum(me, p) // E² = (pc)² + (mc²)²
+//
+// References:
+//   - Taylor, Wheeler. "Spacetime Physics", 2nd ed.
+//   - Jackson. "Classical Electrodynamics", 3rd ed., Ch. 11
+package sr
@@ -0,0 +1,228 @@
+package sr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// FourVector is a Minkowski four-vector (x⁰, x¹, x², x³) whose time and
+// space components share a dimension. The metric signature is (+, -, -, -).
+//
+// Position four-vectors use x⁰ = ct and four-momenta use p⁰ = E/c, so both
+// kinds carry a single dimension ([L] or [LMT⁻¹]).
+type FourVector struct {
+	T     units.Value    // time component x⁰
+	Space vector.Vector3 // spatial components (x¹, x², x³)
+}
+
+// NewFourVector creates a four-vector, checking that all components share
+// a dimension.
+func NewFourVector(t units.Value, space vector.Vector3) (FourVector, error) {
+	if _, err := vector.New(space.X, space.Y, space.Z); err != nil {
+		return FourVector{}, err
+	}
+	if t.Dim() != space.Dim() {
+		return FourVector{}, fmt.Errorf("four-vector components must have same dimension: t=%s, space=%s",
+			t.Dim(), space.Dim())
+	}
+	return FourVector{T: t, Space: space}, nil
+}
+
+// NewEvent creates the spacetime position four-vector (ct, r).
+// Returns an error if r is not a length vector.
+func NewEvent(t units.Time, r vector.Vector3) (FourVector, error) {
+	return NewFourVector(units.Meter(c*t.Val()).Value, r)
+}
+
+// NewFourMomentum creates the four-momentum (E/c, p).
+// Returns an error if p is not a momentum vector.
+//
+// Example:
+//
+//	p := vector.Vector3{
+//	    X: units.GigaelectronVoltPerC(0).Value,
+//	    Y: units.GigaelectronVoltPerC(0).Value,
+//	    Z: units.GigaelectronVoltPerC(3).Value,
+//	}
+//	proton, _ := sr.NewFourMomentum(units.GigaelectronVolt(3.14), p)
+func NewFourMomentum(e units.Energy, p vector.Vector3) (FourVector, error) {
+	return NewFourVector(units.KilogramMeterPerSecond(e.Val()/c).Value, p)
+}
+
+// FourMomentumAtRest returns the four-momentum (mc, 0) of a particle at rest.
+func FourMomentumAtRest(m units.Mass) FourVector {
+	return FourVector{
+		T:     units.KilogramMeterPerSecond(m.Val() * c).Value,
+		Space: vector.Zero(units.Dimension{L: 1, M: 1, T: -1}),
+	}
+}
+
+// FourMomentumOf returns the four-momentum (γmc, γmv) of a particle of
+// mass m moving with velocity v. Returns an error if v is not a velocity
+// vector or |v| ≥ c.
+func FourMomentumOf(m units.Mass, v vector.Vector3) (FourVector, error) {
+	beta, err := betaVector(v)
+	if err != nil {
+		return FourVector{}, err
+	}
+	gamma := 1 / math.Sqrt(1-dot3(beta, beta))
+	mc := m.Val() * c
+	return FourVector{
+		T:     units.KilogramMeterPerSecond(gamma * mc).Value,
+		Space: momentumVector([3]float64{gamma * mc * beta[0], gamma * mc * beta[1], gamma * mc * beta[2]}),
+	}, nil
+}
+
+// Dim returns the dimension shared by all components.
+func (a FourVector) Dim() units.Dimension {
+	return a.T.Dim()
+}
+
+// Add returns a + b. Returns an error if the dimensions differ.
+func (a FourVector) Add(b FourVector) (FourVector, error) {
+	if a.Dim() != b.Dim() {
+		return FourVector{}, fmt.Errorf("cannot add four-vectors with dimensions %s and %s", a.Dim(), b.Dim())
+	}
+	t, _ := a.T.Add(b.T)
+	s, _ := a.Space.Add(b.Space)
+	return FourVector{T: t, Space: s}, nil
+}
+
+// Subtract returns a - b. Returns an error if the dimensions differ.
+func (a FourVector) Subtract(b FourVector) (FourVector, error) {
+	if a.Dim() != b.Dim() {
+		return FourVector{}, fmt.Errorf("cannot subtract four-vectors with dimensions %s and %s", a.Dim(), b.Dim())
+	}
+	t, _ := a.T.Subtract(b.T)
+	s, _ := a.Space.Subtract(b.Space)
+	return FourVector{T: t, Space: s}, nil
+}
+
+// Dot returns the Minkowski inner product a·b = a⁰b⁰ - a·b (spatial).
+func (a FourVector) Dot(b FourVector) units.Value {
+	s, _ := a.T.Multiply(b.T).Subtract(a.Space.Dot(b.Space))
+	return s
+}
+
+// Norm2 returns the invariant interval a·a. It is positive for timelike,
+// zero for lightlike, and negative for spacelike four-vectors.
+func (a FourVector) Norm2() units.Value {
+	return a.Dot(a)
+}
+
+// Energy returns E = c p⁰ of a four-momentum.
+// Returns an error if a is not a four-momentum.
+func (a FourVector) Energy() (units.Energy, error) {
+	if err := a.checkMomentum(); err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(a.T.Val() * c), nil
+}
+
+// Mass returns the invariant mass m = √(p·p)/c of a four-momentum.
+// Returns an error if a is not a four-momentum or is spacelike.
+func (a FourVector) Mass() (units.Mass, error) {
+	if err := a.checkMomentum(); err != nil {
+		return units.Mass{}, err
+	}
+	n2 := a.Norm2().Val()
+	if n2 < 0 {
+		// Tolerate round-off for lightlike momenta.
+		if -n2 > 1e-12*a.T.Val()*a.T.Val() {
+			return units.Mass{}, fmt.Errorf("four-momentum is spacelike (p·p = %g)", n2)
+		}
+		n2 = 0
+	}
+	return units.Kilogram(math.Sqrt(n2) / c), nil
+}
+
+// Velocity returns the three-velocity v = c x/x⁰ associated with the
+// four-vector, e.g. the particle velocity for a four-momentum.
+// Returns an error if x⁰ is zero.
+func (a FourVector) Velocity() (vector.Vector3, error) {
+	t := a.T.Val()
+	if t == 0 {
+		return vector.Vector3{}, fmt.Errorf("four-vector has zero time component")
+	}
+	s := a.Space.ToArray()
+	return velocityVector([3]float64{c * s[0] / t, c * s[1] / t, c * s[2] / t}), nil
+}
+
+// Boost returns the components of a in a frame moving with velocity v
+// relative to the current frame (a pure Lorentz boost).
+// Returns an error if v is not a velocity vector or |v| ≥ c.
+//
+// Formula:
+//
+//	x'⁰ = γ (x⁰ - β·x)
+//	x'  = x + ((γ - 1)/β²)(β·x) β - γ x⁰ β
+func (a FourVector) Boost(v vector.Vector3) (FourVector, error) {
+	beta, err := betaVector(v)
+	if err != nil {
+		return FourVector{}, err
+	}
+	b2 := dot3(beta, beta)
+	if b2 == 0 {
+		return a, nil
+	}
+	gamma := 1 / math.Sqrt(1-b2)
+
+	t, x := a.T.Val(), a.Space.ToArray()
+	bx := dot3(beta, x)
+	k := (gamma-1)*bx/b2 - gamma*t
+
+	dim := a.Dim()
+	return FourVector{
+		T: units.NewValue(gamma*(t-bx), dim),
+		Space: vector.Vector3{
+			X: units.NewValue(x[0]+k*beta[0], dim),
+			Y: units.NewValue(x[1]+k*beta[1], dim),
+			Z: units.NewValue(x[2]+k*beta[2], dim),
+		},
+	}, nil
+}
+
+// String returns a human-readable representation of the four-vector.
+func (a FourVector) String() string {
+	s := a.Space.ToArray()
+	return fmt.Sprintf("(%g, %g, %g, %g) %s", a.T.Val(), s[0], s[1], s[2], a.Dim())
+}
+
+func (a FourVector) checkMomentum() error {
+	if want := (units.Dimension{L: 1, M: 1, T: -1}); a.Dim() != want {
+		return fmt.Errorf("four-momentum must have dimension %s, got %s", want, a.Dim())
+	}
+	return nil
+}
+
+// betaVector returns β = v/c, checking that v is a velocity with |v| < c.
+func betaVector(v vector.Vector3) ([3]float64, error) {
+	if want := (units.Dimension{L: 1, T: -1}); v.Dim() != want {
+		return [3]float64{}, fmt.Errorf("boost velocity must have dimension %s, got %s", want, v.Dim())
+	}
+	x := v.ToArray()
+	beta := [3]float64{x[0] / c, x[1] / c, x[2] / c}
+	if b2 := dot3(beta, beta); !(b2 < 1) {
+		return [3]float64{}, fmt.Errorf("speed must be less than c, got β = %g", math.Sqrt(b2))
+	}
+	return beta, nil
+}
+
+func momentumVector(x [3]float64) vector.Vector3 {
+	return vector.Vector3{
+		X: units.KilogramMeterPerSecond(x[0]).Value,
+		Y: units.KilogramMeterPerSecond(x[1]).Value,
+		Z: units.KilogramMeterPerSecond(x[2]).Value,
+	}
+}
+
+func velocityVector(x [3]float64) vector.Vector3 {
+	return vector.NewVelocity(units.MeterPerSecond(x[0]), units.MeterPerSecond(x[1]), units.MeterPerSecond(x[2]))
+}
+
+func dot3(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
@@ -0,0 +1,114 @@
+package sr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func velocity(bx, by, bz float64) vector.Vector3 {
+	return vector.NewVelocity(units.SpeedOfLight(bx), units.SpeedOfLight(by), units.SpeedOfLight(bz))
+}
+
+func TestNewFourVector_Validation(t *testing.T) {
+	r := vector.NewPosition(units.Meter(1), units.Meter(2), units.Meter(3))
+	if _, err := NewFourVector(units.Second(1).Value, r); err == nil {
+		t.Error("NewFourVector should reject mixed dimensions")
+	}
+	if _, err := NewFourMomentum(units.Joule(1), r); err == nil {
+		t.Error("NewFourMomentum should reject a position vector")
+	}
+	if _, err := NewEvent(units.Second(1), r); err != nil {
+		t.Errorf("NewEvent() error = %v", err)
+	}
+}
+
+func TestFourMomentum_Invariants(t *testing.T) {
+	m := massOf("p")
+	p, err := FourMomentumOf(m, velocity(0.3, -0.4, 0.5))
+	if err != nil {
+		t.Fatalf("FourMomentumOf() error = %v", err)
+	}
+
+	mass, err := p.Mass()
+	if err != nil || !almostEqual(mass.Val(), m.Val(), 1e-12) {
+		t.Errorf("Mass() = %v, %v; want %v", mass.Val(), err, m.Val())
+	}
+	e, _ := p.Energy()
+	gamma, _ := LorentzFactor(units.SpeedOfLight(math.Sqrt(0.5)))
+	if !almostEqual(e.Val(), gamma*RestEnergy(m).Val(), 1e-12) {
+		t.Errorf("Energy() = %v, want γmc²", e.Val())
+	}
+	v, _ := p.Velocity()
+	if !almostEqual(v.X.Val()/c, 0.3, 1e-12) || !almostEqual(v.Z.Val()/c, 0.5, 1e-12) {
+		t.Errorf("Velocity() = %v, want (0.3, -0.4, 0.5)c", v)
+	}
+}
+
+func TestBoost(t *testing.T) {
+	m := massOf("e-")
+	u := velocity(0.2, 0.6, -0.1)
+	p, _ := FourMomentumOf(m, u)
+
+	// Boosting into the particle's rest frame leaves (mc, 0).
+	rest, err := p.Boost(u)
+	if err != nil {
+		t.Fatalf("Boost() error = %v", err)
+	}
+	if !almostEqual(rest.T.Val(), m.Val()*c, 1e-12) {
+		t.Errorf("rest-frame p⁰ = %v, want mc = %v", rest.T.Val(), m.Val()*c)
+	}
+	if n := rest.Space.ToArray(); math.Abs(n[0])+math.Abs(n[1])+math.Abs(n[2]) > 1e-12*rest.T.Val() {
+		t.Errorf("rest-frame momentum = %v, want 0", n)
+	}
+
+	// The interval is invariant under boosts.
+	ev, _ := NewEvent(units.Second(2), vector.NewPosition(units.Meter(1e8), units.Meter(-3e8), units.Meter(5e7)))
+	boosted, _ := ev.Boost(velocity(-0.7, 0.1, 0.3))
+	if !almostEqual(ev.Norm2().Val(), boosted.Norm2().Val(), 1e-12) {
+		t.Errorf("interval %v → %v, want invariant", ev.Norm2().Val(), boosted.Norm2().Val())
+	}
+
+	// A collinear boost reproduces relativistic velocity addition.
+	q, _ := FourMomentumOf(m, velocity(0.5, 0, 0))
+	q, _ = q.Boost(velocity(-0.5, 0, 0))
+	v, _ := q.Velocity()
+	if !almostEqual(v.X.Val()/c, 0.8, 1e-12) {
+		t.Errorf("boosted velocity = %vc, want 0.8c", v.X.Val()/c)
+	}
+
+	if _, err := p.Boost(velocity(1, 0, 0)); err == nil {
+		t.Error("Boost should reject |v| ≥ c")
+	}
+}
+
+func TestFourVector_AddAndMass(t *testing.T) {
+	// Two back-to-back photons of energy E have invariant mass 2E/c².
+	e := units.MegaelectronVolt(0.51099895)
+	pz := units.KilogramMeterPerSecond(e.Val() / c).Value
+	zero := units.KilogramMeterPerSecond(0).Value
+	g1, _ := NewFourMomentum(e, vector.Vector3{X: zero, Y: zero, Z: pz})
+	g2, _ := NewFourMomentum(e, vector.Vector3{X: zero, Y: zero, Z: pz.Negate()})
+
+	if m, err := g1.Mass(); err != nil || m.Val() != 0 {
+		t.Errorf("photon mass = %v, %v; want 0", m.Val(), err)
+	}
+	sum, err := g1.Add(g2)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	m, _ := sum.Mass()
+	if !almostEqual(m.Val(), 2*massOf("e-").Val(), 1e-8) {
+		t.Errorf("pair mass = %v, want 2mₑ = %v", m.Val(), 2*massOf("e-").Val())
+	}
+
+	ev, _ := NewEvent(units.Second(0), vector.NewPosition(units.Meter(1), units.Meter(0), units.Meter(0)))
+	if _, err := g1.Add(ev); err == nil {
+		t.Error("Add should reject mismatched dimensions")
+	}
+	if _, err := ev.Mass(); err == nil {
+		t.Error("Mass should reject a position four-vector")
+	}
+}
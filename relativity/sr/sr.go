@@ -0,0 +1,171 @@
+package sr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// c is the speed of light in m/s.
+var c = constants.SpeedOfLight.Val()
+
+// checkSpeed returns an error unless |v| < c.
+func checkSpeed(v units.Velocity) error {
+	if math.IsNaN(v.Val()) || math.Abs(v.Val()) >= c {
+		return fmt.Errorf("speed must be less than c, got %g m/s (β = %g)", v.Val(), v.Val()/c)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Lorentz Factor and Boosts
+// -----------------------------------------------------------------------------
+
+// Beta returns the dimensionless speed β = v/c.
+// Returns an error if |v| ≥ c.
+func Beta(v units.Velocity) (float64, error) {
+	if err := checkSpeed(v); err != nil {
+		return 0, err
+	}
+	return v.Val() / c, nil
+}
+
+// LorentzFactor returns γ = 1/√(1 - v²/c²).
+// Returns an error if |v| ≥ c.
+//
+// Example:
+//
+//	gamma, _ := sr.LorentzFactor(units.SpeedOfLight(0.6)) // 1.25
+func LorentzFactor(v units.Velocity) (float64, error) {
+	b, err := Beta(v)
+	if err != nil {
+		return 0, err
+	}
+	return 1 / math.Sqrt(1-b*b), nil
+}
+
+// TimeDilation returns the coordinate time Δt = γ Δτ elapsed in the
+// observer frame while a clock moving at v records the proper time Δτ.
+func TimeDilation(properTime units.Time, v units.Velocity) (units.Time, error) {
+	gamma, err := LorentzFactor(v)
+	if err != nil {
+		return units.Time{}, err
+	}
+	return units.Second(gamma * properTime.Val()), nil
+}
+
+// LengthContraction returns the length L = L₀/γ measured for an object of
+// proper length L₀ moving at v along its length.
+func LengthContraction(properLength units.Length, v units.Velocity) (units.Length, error) {
+	gamma, err := LorentzFactor(v)
+	if err != nil {
+		return units.Length{}, err
+	}
+	return units.Meter(properLength.Val() / gamma), nil
+}
+
+// AddVelocities returns the relativistic composition of collinear
+// velocities: the velocity of an object moving at u in a frame that itself
+// moves at v.
+//
+// Formula:
+//
+//	w = (u + v) / (1 + uv/c²)
+func AddVelocities(u, v units.Velocity) (units.Velocity, error) {
+	if err := checkSpeed(u); err != nil {
+		return units.Velocity{}, err
+	}
+	if err := checkSpeed(v); err != nil {
+		return units.Velocity{}, err
+	}
+	// Compose via rapidities, which keeps |w| < c even for β → 1.
+	return VelocityFromRapidity(math.Atanh(u.Val()/c) + math.Atanh(v.Val()/c)), nil
+}
+
+// Rapidity returns φ = artanh(v/c). Rapidities add linearly under
+// collinear boosts. Returns an error if |v| ≥ c.
+func Rapidity(v units.Velocity) (float64, error) {
+	b, err := Beta(v)
+	if err != nil {
+		return 0, err
+	}
+	return math.Atanh(b), nil
+}
+
+// VelocityFromRapidity returns v = c tanh φ.
+func VelocityFromRapidity(phi float64) units.Velocity {
+	return units.MeterPerSecond(c * math.Tanh(phi))
+}
+
+// -----------------------------------------------------------------------------
+// Energy and Momentum
+// -----------------------------------------------------------------------------
+
+// RestEnergy returns E₀ = mc².
+func RestEnergy(m units.Mass) units.Energy {
+	return units.Joule(m.Val() * c * c)
+}
+
+// TotalEnergy returns E = γmc².
+// Returns an error if |v| ≥ c.
+func TotalEnergy(m units.Mass, v units.Velocity) (units.Energy, error) {
+	gamma, err := LorentzFactor(v)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(gamma * m.Val() * c * c), nil
+}
+
+// KineticEnergy returns T = (γ - 1)mc².
+// Returns an error if |v| ≥ c.
+func KineticEnergy(m units.Mass, v units.Velocity) (units.Energy, error) {
+	b, err := Beta(v)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	// γ - 1 = β²γ²/(γ + 1) avoids cancellation at low speed.
+	g := 1 / math.Sqrt(1-b*b)
+	return units.Joule(b * b * g * g / (g + 1) * m.Val() * c * c), nil
+}
+
+// Momentum returns the relativistic momentum p = γmv.
+// Returns an error if |v| ≥ c.
+func Momentum(m units.Mass, v units.Velocity) (units.Momentum, error) {
+	gamma, err := LorentzFactor(v)
+	if err != nil {
+		return units.Momentum{}, err
+	}
+	return units.KilogramMeterPerSecond(gamma * m.Val() * v.Val()), nil
+}
+
+// EnergyFromMomentum returns the total energy from the energy-momentum relation.
+//
+// Formula:
+//
+//	E² = (pc)² + (mc²)²
+func EnergyFromMomentum(m units.Mass, p units.Momentum) units.Energy {
+	return units.Joule(math.Hypot(p.Val()*c, m.Val()*c*c))
+}
+
+// InvariantMass returns the rest mass m = √(E² - (pc)²)/c² of a system
+// with total energy E and momentum magnitude p.
+// Returns an error if E < pc (a spacelike four-momentum).
+func InvariantMass(e units.Energy, p units.Momentum) (units.Mass, error) {
+	ev, pc := e.Val(), math.Abs(p.Val())*c
+	if ev < pc {
+		return units.Mass{}, fmt.Errorf("energy %g J is less than pc = %g J", ev, pc)
+	}
+	return units.Kilogram(math.Sqrt((ev-pc)*(ev+pc)) / (c * c)), nil
+}
+
+// VelocityFromMomentum returns the speed v = pc²/E of a particle of mass m
+// and momentum p. Massless particles move at c.
+func VelocityFromMomentum(m units.Mass, p units.Momentum) units.Velocity {
+	e := EnergyFromMomentum(m, p).Val()
+	if e == 0 {
+		return units.MeterPerSecond(0)
+	}
+	return units.MeterPerSecond(p.Val() * c * c / e)
+}
@@ -0,0 +1,156 @@
+package sr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b)/math.Max(math.Abs(a), math.Abs(b)) < tolerance
+}
+
+// massOf returns the rest mass of a particle from the catalog.
+func massOf(name string) units.Mass {
+	p, _ := particles.Lookup(name)
+	return units.Mass{Value: p.Mass.Value()}
+}
+
+func TestLorentzFactor(t *testing.T) {
+	tests := []struct {
+		beta, want float64
+	}{
+		{0, 1},
+		{0.6, 1.25},
+		{0.8, 5.0 / 3.0},
+		{0.99, 7.088812050083354},
+	}
+	for _, tt := range tests {
+		got, err := LorentzFactor(units.SpeedOfLight(tt.beta))
+		if err != nil {
+			t.Fatalf("LorentzFactor(%vc) error = %v", tt.beta, err)
+		}
+		if !almostEqual(got, tt.want, 1e-12) {
+			t.Errorf("LorentzFactor(%vc) = %v, want %v", tt.beta, got, tt.want)
+		}
+	}
+}
+
+func TestSpeedValidation(t *testing.T) {
+	for _, v := range []units.Velocity{units.SpeedOfLight(1), units.SpeedOfLight(-1.5), units.MeterPerSecond(math.NaN())} {
+		if _, err := LorentzFactor(v); err == nil {
+			t.Errorf("LorentzFactor(%v) should fail", v)
+		}
+		if _, err := Momentum(massOf("e-"), v); err == nil {
+			t.Errorf("Momentum(%v) should fail", v)
+		}
+		if _, err := AddVelocities(units.MeterPerSecond(0), v); err == nil {
+			t.Errorf("AddVelocities(0, %v) should fail", v)
+		}
+	}
+}
+
+func TestTimeDilationAndLengthContraction(t *testing.T) {
+	v := units.SpeedOfLight(0.6)
+	dt, _ := TimeDilation(units.Second(4), v)
+	if !almostEqual(dt.Val(), 5, 1e-12) {
+		t.Errorf("TimeDilation = %v, want 5", dt.Val())
+	}
+	l, _ := LengthContraction(units.Meter(10), v)
+	if !almostEqual(l.Val(), 8, 1e-12) {
+		t.Errorf("LengthContraction = %v, want 8", l.Val())
+	}
+}
+
+func TestAddVelocities(t *testing.T) {
+	// 0.5c ⊕ 0.5c = 0.8c
+	w, err := AddVelocities(units.SpeedOfLight(0.5), units.SpeedOfLight(0.5))
+	if err != nil {
+		t.Fatalf("AddVelocities() error = %v", err)
+	}
+	if !almostEqual(w.Val()/c, 0.8, 1e-12) {
+		t.Errorf("0.5c ⊕ 0.5c = %vc, want 0.8c", w.Val()/c)
+	}
+
+	// Extreme composition stays below c.
+	w, _ = AddVelocities(units.SpeedOfLight(0.9999999), units.SpeedOfLight(0.9999999))
+	if w.Val() >= c {
+		t.Errorf("composition reached c: %v", w.Val())
+	}
+
+	// Opposite velocities cancel.
+	w, _ = AddVelocities(units.SpeedOfLight(0.7), units.SpeedOfLight(-0.7))
+	if math.Abs(w.Val()) > 1e-6 {
+		t.Errorf("0.7c ⊕ -0.7c = %v, want 0", w.Val())
+	}
+}
+
+func TestRapidity(t *testing.T) {
+	phi1, _ := Rapidity(units.SpeedOfLight(0.3))
+	phi2, _ := Rapidity(units.SpeedOfLight(0.4))
+	sum, _ := AddVelocities(units.SpeedOfLight(0.3), units.SpeedOfLight(0.4))
+	if !almostEqual(VelocityFromRapidity(phi1+phi2).Val(), sum.Val(), 1e-12) {
+		t.Error("rapidities should add under collinear boosts")
+	}
+	gamma, _ := LorentzFactor(units.SpeedOfLight(0.3))
+	if !almostEqual(math.Cosh(phi1), gamma, 1e-12) {
+		t.Errorf("cosh φ = %v, want γ = %v", math.Cosh(phi1), gamma)
+	}
+}
+
+func TestEnergyMomentum(t *testing.T) {
+	m := massOf("e-")
+	v := units.SpeedOfLight(0.9)
+
+	e, _ := TotalEnergy(m, v)
+	p, _ := Momentum(m, v)
+	k, _ := KineticEnergy(m, v)
+
+	// E² = (pc)² + (mc²)²
+	if !almostEqual(EnergyFromMomentum(m, p).Val(), e.Val(), 1e-12) {
+		t.Errorf("EnergyFromMomentum = %v, want %v", EnergyFromMomentum(m, p).Val(), e.Val())
+	}
+	if !almostEqual(k.Val()+RestEnergy(m).Val(), e.Val(), 1e-12) {
+		t.Error("T + mc² should equal E")
+	}
+	if !almostEqual(RestEnergy(m).Val(), constants.ElectronRestEnergy.Val(), 1e-9) {
+		t.Errorf("RestEnergy(electron) = %v, want %v", RestEnergy(m).Val(), constants.ElectronRestEnergy.Val())
+	}
+
+	mass, err := InvariantMass(e, p)
+	if err != nil || !almostEqual(mass.Val(), m.Val(), 1e-9) {
+		t.Errorf("InvariantMass = %v, %v; want %v", mass.Val(), err, m.Val())
+	}
+	if got := VelocityFromMomentum(m, p); !almostEqual(got.Val(), v.Val(), 1e-12) {
+		t.Errorf("VelocityFromMomentum = %v, want %v", got.Val(), v.Val())
+	}
+
+	if _, err := InvariantMass(units.Joule(1), units.KilogramMeterPerSecond(1)); err == nil {
+		t.Error("InvariantMass should reject E < pc")
+	}
+}
+
+func TestKineticEnergy_LowSpeedLimit(t *testing.T) {
+	// T → ½mv² as v → 0, without catastrophic cancellation.
+	m, v := units.Kilogram(1), units.MeterPerSecond(1)
+	k, _ := KineticEnergy(m, v)
+	if !almostEqual(k.Val(), 0.5, 1e-12) {
+		t.Errorf("KineticEnergy(1 kg, 1 m/s) = %v, want 0.5", k.Val())
+	}
+}
+
+func TestMasslessParticle(t *testing.T) {
+	p := units.MegaelectronVoltPerC(1)
+	if got := VelocityFromMomentum(units.Kilogram(0), p).Val(); !almostEqual(got, c, 1e-15) {
+		t.Errorf("photon speed = %v, want c", got)
+	}
+	if got := EnergyFromMomentum(units.Kilogram(0), p); !almostEqual(got.Val(), units.MegaelectronVolt(1).Val(), 1e-12) {
+		t.Errorf("photon energy = %v, want 1 MeV", got.Val())
+	}
+}
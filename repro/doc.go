@@ -0,0 +1,39 @@
+// Package repro helps make simulation results reproducible bit for bit.
+//
+// A Run fixes the seed from which every random stream of a computation is
+// derived, and collects the computation's inputs and outputs in checkpoint
+// encoding. Its Fingerprint is a SHA-256 hash over the seed, the inputs and
+// the outputs: publishing it with a result lets anyone who reruns the
+// computation check that they regenerated exactly the same numbers.
+//
+// Random streams are numbered. Stream i is the seed's xoshiro256** source
+// advanced by i jumps of 2¹²⁸ draws, so streams never overlap and each one
+// depends only on the seed and its number. Parallel code that draws from
+// the stream of each work item, rather than of each worker, produces the
+// same samples however the items are scheduled. The parallel loops of this
+// module already give results independent of the number of workers.
+//
+// Bit-for-bit agreement further requires the same code, Go version and
+// GOARCH: some architectures fuse multiply-adds, which changes rounding.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/repro"
+//	    "github.com/sakiphan/qsim-core/sim"
+//	)
+//
+//	run := repro.NewRun(20240601)
+//	in := run.Input("scenario")
+//	in.Bytes(scenarioFile)
+//
+//	w, _ := sim.FromScenario(sc)
+//	w.Run(sc.Duration, sc.Step)
+//	w.Encode(run.Output("world"))
+//
+//	fmt.Println(run.Fingerprint()) // e.g. 9f2c…, published with the result
+//
+// References:
+//   - Blackman, Vigna. "Scrambled linear pseudorandom number generators",
+//     ACM Trans. Math. Softw. 47, 36 (2021)
+package repro
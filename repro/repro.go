@@ -0,0 +1,139 @@
+package repro
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+
+	"github.com/sakiphan/qsim-core/io/checkpoint"
+	"github.com/sakiphan/qsim-core/math/random"
+)
+
+// Fingerprint is a SHA-256 hash identifying a run.
+type Fingerprint [sha256.Size]byte
+
+// String returns the fingerprint in hexadecimal.
+func (f Fingerprint) String() string {
+	return hex.EncodeToString(f[:])
+}
+
+// ParseFingerprint parses a fingerprint in hexadecimal.
+func ParseFingerprint(s string) (Fingerprint, error) {
+	var f Fingerprint
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != len(f) {
+		return f, fmt.Errorf("fingerprint must be %d hexadecimal digits, got %q", 2*len(f), s)
+	}
+	copy(f[:], b)
+	return f, nil
+}
+
+// Run records the seed, inputs and outputs of a reproducible computation.
+// Run is not safe for concurrent use; draw on the sources it returns from
+// as many goroutines as there are sources.
+type Run struct {
+	seed            int64
+	inputs, outputs *checkpoint.Checkpoint
+}
+
+// NewRun starts a run whose random streams derive from seed.
+func NewRun(seed int64) *Run {
+	return &Run{seed: seed, inputs: checkpoint.New(), outputs: checkpoint.New()}
+}
+
+// Seed returns the run's seed.
+func (r *Run) Seed() int64 {
+	return r.seed
+}
+
+// Source returns a new source positioned at the start of random stream i,
+// which must be non-negative. Calling it twice with the same i yields two
+// sources producing the same draws.
+func (r *Run) Source(i int) *random.Source {
+	if i < 0 {
+		panic(fmt.Sprintf("repro: negative stream number %d", i))
+	}
+	src := random.NewSource(r.seed)
+	for k := 0; k < i; k++ {
+		src.Jump()
+	}
+	return src
+}
+
+// Rand returns a *rand.Rand drawing from stream i, for the samplers in
+// math/vector and quantum, or for montecarlo experiments.
+func (r *Run) Rand(i int) *rand.Rand {
+	return rand.New(r.Source(i))
+}
+
+// Sources returns n consecutive streams starting at stream 0, computed with
+// n jumps in total.
+func (r *Run) Sources(n int) []*random.Source {
+	out := make([]*random.Source, n)
+	src := random.NewSource(r.seed)
+	for i := range out {
+		out[i] = src.Clone()
+		src.Jump()
+	}
+	return out
+}
+
+// Input returns an encoder appending to the named input section, such as
+// a scenario file or parameter set.
+func (r *Run) Input(name string) *checkpoint.Encoder {
+	return r.inputs.Section(name)
+}
+
+// Output returns an encoder appending to the named output section, such as
+// a final state or a table of results.
+func (r *Run) Output(name string) *checkpoint.Encoder {
+	return r.outputs.Section(name)
+}
+
+// InputFingerprint hashes the seed and the inputs only, identifying the
+// computation before it has run.
+func (r *Run) InputFingerprint() Fingerprint {
+	h := sha256.New()
+	h.Write(binary.LittleEndian.AppendUint64(nil, uint64(r.seed)))
+	h.Write(encode(r.inputs))
+	var f Fingerprint
+	h.Sum(f[:0])
+	return f
+}
+
+// Fingerprint hashes the seed, the inputs and the outputs. Sections are
+// hashed in the order they were created, so a regenerating run must record
+// them in the same order.
+func (r *Run) Fingerprint() Fingerprint {
+	h := sha256.New()
+	in := r.InputFingerprint()
+	h.Write(in[:])
+	h.Write(encode(r.outputs))
+	var f Fingerprint
+	h.Sum(f[:0])
+	return f
+}
+
+// Verify returns an error unless the run's fingerprint equals want, given
+// in hexadecimal.
+func (r *Run) Verify(want string) error {
+	f, err := ParseFingerprint(want)
+	if err != nil {
+		return err
+	}
+	if got := r.Fingerprint(); got != f {
+		return fmt.Errorf("fingerprint mismatch: got %s, want %s", got, f)
+	}
+	return nil
+}
+
+// encode returns the checkpoint encoding of c. Saving to memory cannot
+// fail.
+func encode(c *checkpoint.Checkpoint) []byte {
+	var buf bytes.Buffer
+	_ = c.Save(&buf)
+	return buf.Bytes()
+}
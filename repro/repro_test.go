@@ -0,0 +1,103 @@
+package repro
+
+import (
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/parallel"
+	"github.com/sakiphan/qsim-core/sim"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestStreams(t *testing.T) {
+	run := NewRun(7)
+	all := run.Sources(4)
+	seen := map[uint64]bool{}
+	for i, src := range all {
+		// A stream depends only on the seed and its number.
+		again := NewRun(7).Source(i)
+		first := src.Uint64()
+		if again.Uint64() != first {
+			t.Errorf("stream %d differs between Sources and Source", i)
+		}
+		if seen[first] {
+			t.Errorf("stream %d repeats the first draw of another stream", i)
+		}
+		seen[first] = true
+	}
+	if NewRun(8).Source(0).Uint64() == NewRun(7).Source(0).Uint64() {
+		t.Error("different seeds gave the same stream")
+	}
+	if a, b := run.Rand(2).Float64(), run.Rand(2).Float64(); a != b {
+		t.Errorf("Rand(2) draws %v then %v", a, b)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	record := func(seed int64, out float64) *Run {
+		r := NewRun(seed)
+		r.Input("params").Value(units.Meter(1.5).Value)
+		r.Output("result").Float64(out)
+		return r
+	}
+	a, b := record(1, 0.1), record(1, 0.1)
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Fatal("identical runs have different fingerprints")
+	}
+	if err := b.Verify(a.Fingerprint().String()); err != nil {
+		t.Error(err)
+	}
+
+	// A one-ulp change in an output, or a different seed, changes the
+	// fingerprint; the input fingerprint ignores outputs.
+	c := record(1, 0.1+1.4e-17)
+	if c.Fingerprint() == a.Fingerprint() || c.InputFingerprint() != a.InputFingerprint() {
+		t.Error("fingerprint did not track a one-ulp output change")
+	}
+	if record(2, 0.1).InputFingerprint() == a.InputFingerprint() {
+		t.Error("fingerprint ignores the seed")
+	}
+	if err := c.Verify(a.Fingerprint().String()); err == nil {
+		t.Error("expected mismatch error")
+	}
+
+	f, err := ParseFingerprint(a.Fingerprint().String())
+	if err != nil || f != a.Fingerprint() {
+		t.Errorf("ParseFingerprint round trip = %v, %v", f, err)
+	}
+	if _, err := ParseFingerprint("abc"); err == nil {
+		t.Error("expected error for short fingerprint")
+	}
+}
+
+// TestParallelFingerprint runs the same N-body problem on pools of
+// different sizes and checks that the results agree bit for bit.
+func TestParallelFingerprint(t *testing.T) {
+	fingerprint := func(workers int) Fingerprint {
+		run := NewRun(42)
+		rng := run.Rand(0)
+		w := sim.NewWorld(nil)
+		for i := 0; i < 64; i++ {
+			w.Add(sim.Entity{
+				Mass:     units.Kilogram(1e20 * (1 + rng.Float64())),
+				Position: vector.RandomInSphere(units.Kilometer(1e4), rng),
+			})
+		}
+		pool := parallel.NewPool(workers)
+		defer pool.Close()
+		g, _ := sim.NewGravity(units.Kilometer(1))
+		g.SetPool(pool)
+		w.AddForce(g)
+
+		w.Encode(run.Input("initial"))
+		w.Run(units.Hour(1), units.Second(60))
+		w.Encode(run.Output("final"))
+		return run.Fingerprint()
+	}
+	want := fingerprint(1)
+	for _, workers := range []int{2, 3, 8} {
+		if got := fingerprint(workers); got != want {
+			t.Errorf("%d workers: fingerprint %s, 1 worker %s", workers, got, want)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package sim
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Constraint is a holonomic constraint C(r) = 0.
+//
+// Constraints are solved iteratively by sequential impulses. Within each
+// derivative evaluation the accelerations are corrected so that C̈ = 0,
+// which lets the integrator follow the constraint to its full order; after
+// every step the positions and velocities are projected back onto C = 0 and
+// Ċ = 0 to remove the remaining drift.
+//
+// Each method performs one Gauss-Seidel pass over the constraint, moving
+// the entities involved in proportion to their inverse masses w (zero for
+// pinned entities), and returns the violation found before the correction.
+type Constraint interface {
+	// Accelerate corrects accelerations so that C̈ = 0 and returns |C̈| in
+	// m/s².
+	Accelerate(pos, vel, acc, w []float64) float64
+	// Project corrects positions so that C(r) = 0 and returns |C| relative
+	// to the constraint's length scale.
+	Project(pos, w []float64) float64
+	// Correct removes the velocity components along ∇C so that Ċ = 0 and
+	// returns |Ċ| in m/s.
+	Correct(pos, vel, w []float64) float64
+}
+
+// pinner is implemented by constraints that fix an entity in place.
+type pinner interface {
+	pinned() int
+}
+
+// -----------------------------------------------------------------------------
+// Distance
+// -----------------------------------------------------------------------------
+
+// Distance holds two entities at a fixed separation, like a massless rigid
+// rod or a taut tether.
+//
+// With d = r_b - r_a, n = d/|d| and C = |d| - L, a position pass applies
+//
+//	Δr_a = +w_a C n / (w_a + w_b),   Δr_b = -w_b C n / (w_a + w_b)
+//
+// and velocity and acceleration passes remove the relative velocity
+// (v_b - v_a)·n and the relative acceleration
+//
+//	C̈ = (a_b - a_a)·n + (|v_b - v_a|² - ((v_b - v_a)·n)²) / |d|
+//
+// the same way, conserving linear and angular momentum.
+type Distance struct {
+	a, b   int
+	length float64
+}
+
+// NewDistance creates a distance constraint of the given length between
+// entities a and b.
+func NewDistance(a, b int, length units.Length) (*Distance, error) {
+	if a == b {
+		return nil, fmt.Errorf("distance constraint must join two different entities, got %d twice", a)
+	}
+	if !(length.Val() > 0) {
+		return nil, fmt.Errorf("constraint length must be positive, got %g m", length.Val())
+	}
+	return &Distance{a: a, b: b, length: length.Val()}, nil
+}
+
+// Project applies one position correction.
+func (c *Distance) Project(pos, w []float64) float64 {
+	wa, wb := w[c.a], w[c.b]
+	d, l := c.separation(pos)
+	viol := l - c.length
+	if wa+wb == 0 || l == 0 {
+		return math.Abs(viol) / c.length
+	}
+	k := viol / (l * (wa + wb))
+	for i := 0; i < 3; i++ {
+		pos[3*c.a+i] += wa * k * d[i]
+		pos[3*c.b+i] -= wb * k * d[i]
+	}
+	return math.Abs(viol) / c.length
+}
+
+// Correct applies one velocity correction.
+func (c *Distance) Correct(pos, vel, w []float64) float64 {
+	wa, wb := w[c.a], w[c.b]
+	d, l := c.separation(pos)
+	if wa+wb == 0 || l == 0 {
+		return 0
+	}
+	vrel := 0.0
+	for i := 0; i < 3; i++ {
+		vrel += (vel[3*c.b+i] - vel[3*c.a+i]) * d[i] / l
+	}
+	k := vrel / (l * (wa + wb))
+	for i := 0; i < 3; i++ {
+		vel[3*c.a+i] += wa * k * d[i]
+		vel[3*c.b+i] -= wb * k * d[i]
+	}
+	return math.Abs(vrel)
+}
+
+// Accelerate applies one acceleration correction.
+func (c *Distance) Accelerate(pos, vel, acc, w []float64) float64 {
+	wa, wb := w[c.a], w[c.b]
+	d, l := c.separation(pos)
+	if wa+wb == 0 || l == 0 {
+		return 0
+	}
+	var an, vn, v2 float64
+	for i := 0; i < 3; i++ {
+		dv := vel[3*c.b+i] - vel[3*c.a+i]
+		an += (acc[3*c.b+i] - acc[3*c.a+i]) * d[i] / l
+		vn += dv * d[i] / l
+		v2 += dv * dv
+	}
+	cdd := an + (v2-vn*vn)/l
+	k := cdd / (l * (wa + wb))
+	for i := 0; i < 3; i++ {
+		acc[3*c.a+i] += wa * k * d[i]
+		acc[3*c.b+i] -= wb * k * d[i]
+	}
+	return math.Abs(cdd)
+}
+
+func (c *Distance) entities() []int {
+	return []int{c.a, c.b}
+}
+
+func (c *Distance) separation(pos []float64) ([3]float64, float64) {
+	var d [3]float64
+	for i := 0; i < 3; i++ {
+		d[i] = pos[3*c.b+i] - pos[3*c.a+i]
+	}
+	return d, math.Sqrt(d[0]*d[0] + d[1]*d[1] + d[2]*d[2])
+}
+
+// -----------------------------------------------------------------------------
+// Pin
+// -----------------------------------------------------------------------------
+
+// Pin fixes an entity at a point. A pinned entity has zero inverse mass in
+// the constraint solver and is not moved by forces, so it acts as a fixed
+// anchor for pendulums and tethers.
+type Pin struct {
+	i  int
+	at [3]float64
+}
+
+// NewPin pins entity i at the given position.
+func NewPin(i int, at vector.Vector3) (*Pin, error) {
+	if err := checkVector("pin position", at, lengthDim); err != nil {
+		return nil, err
+	}
+	return &Pin{i: i, at: at.ToArray()}, nil
+}
+
+// Project moves the entity back onto its pin.
+func (p *Pin) Project(pos, w []float64) float64 {
+	d := 0.0
+	for k := 0; k < 3; k++ {
+		d = math.Hypot(d, pos[3*p.i+k]-p.at[k])
+		pos[3*p.i+k] = p.at[k]
+	}
+	if scale := math.Sqrt(p.at[0]*p.at[0] + p.at[1]*p.at[1] + p.at[2]*p.at[2]); scale > 0 {
+		return d / scale
+	}
+	return d
+}
+
+// Correct brings the entity to rest.
+func (p *Pin) Correct(pos, vel, w []float64) float64 {
+	v := 0.0
+	for k := 0; k < 3; k++ {
+		v = math.Hypot(v, vel[3*p.i+k])
+		vel[3*p.i+k] = 0
+	}
+	return v
+}
+
+// Accelerate holds the entity still.
+func (p *Pin) Accelerate(pos, vel, acc, w []float64) float64 {
+	a := 0.0
+	for k := 0; k < 3; k++ {
+		a = math.Hypot(a, acc[3*p.i+k])
+		acc[3*p.i+k] = 0
+	}
+	return a
+}
+
+func (p *Pin) pinned() int {
+	return p.i
+}
+
+func (p *Pin) entities() []int {
+	return []int{p.i}
+}
+
+// -----------------------------------------------------------------------------
+// Solver
+// -----------------------------------------------------------------------------
+
+// Default constraint solver settings.
+const (
+	DefaultConstraintIterations = 100
+	DefaultConstraintTolerance  = 1e-12
+)
+
+// AddConstraint adds a constraint and immediately projects the current
+// state onto it. Returns an error if the constraint refers to an entity
+// the world does not have.
+func (w *World) AddConstraint(c Constraint) error {
+	if err := w.checkEntities("constraint", c); err != nil {
+		return err
+	}
+	w.constraints = append(w.constraints, c)
+	if p, ok := c.(pinner); ok {
+		w.fixed[p.pinned()] = true
+	}
+	w.enforce()
+	return nil
+}
+
+// SetConstraintSolver sets the maximum number of Gauss-Seidel passes per
+// step and the relative tolerance at which they stop.
+func (w *World) SetConstraintSolver(iterations int, tolerance float64) error {
+	if iterations <= 0 {
+		return fmt.Errorf("constraint iterations must be positive, got %d", iterations)
+	}
+	if !(tolerance > 0) {
+		return fmt.Errorf("constraint tolerance must be positive, got %g", tolerance)
+	}
+	w.iterations, w.tolerance = iterations, tolerance
+	return nil
+}
+
+// ConstraintError returns the largest relative position violation among
+// the constraints in the current state, without correcting it.
+func (w *World) ConstraintError() float64 {
+	pos := append([]float64(nil), w.y[:3*len(w.mass)]...)
+	inv := w.inverseMasses()
+	worst := 0.0
+	for _, c := range w.constraints {
+		worst = math.Max(worst, c.Project(pos, inv))
+	}
+	return worst
+}
+
+// enforce projects positions, then velocities, onto the constraints.
+func (w *World) enforce() {
+	if len(w.constraints) == 0 {
+		return
+	}
+	n := len(w.mass)
+	pos, vel := w.y[:3*n], w.y[3*n:]
+	inv := w.inverseMasses()
+
+	for it := 0; it < w.iterations; it++ {
+		worst := 0.0
+		for _, c := range w.constraints {
+			worst = math.Max(worst, c.Project(pos, inv))
+		}
+		if worst <= w.tolerance {
+			break
+		}
+	}
+
+	vmax := 0.0
+	for i := 0; i < n; i++ {
+		vmax = math.Max(vmax, math.Sqrt(vel[3*i]*vel[3*i]+vel[3*i+1]*vel[3*i+1]+vel[3*i+2]*vel[3*i+2]))
+	}
+	for it := 0; it < w.iterations; it++ {
+		worst := 0.0
+		for _, c := range w.constraints {
+			worst = math.Max(worst, c.Correct(pos, vel, inv))
+		}
+		if worst <= w.tolerance*vmax {
+			break
+		}
+	}
+}
+
+// constrain corrects the accelerations acc in place so that C̈ = 0 for
+// every constraint.
+func (w *World) constrain(pos, vel, acc []float64) {
+	inv := w.inverseMasses()
+	amax := 0.0
+	for i := 0; i < len(w.mass); i++ {
+		amax = math.Max(amax, math.Sqrt(acc[3*i]*acc[3*i]+acc[3*i+1]*acc[3*i+1]+acc[3*i+2]*acc[3*i+2]))
+	}
+	for it := 0; it < w.iterations; it++ {
+		worst := 0.0
+		for _, c := range w.constraints {
+			worst = math.Max(worst, c.Accelerate(pos, vel, acc, inv))
+		}
+		if worst <= w.tolerance*amax {
+			break
+		}
+	}
+}
+
+// inverseMasses returns 1/m for each entity, zero for pinned entities.
+func (w *World) inverseMasses() []float64 {
+	w.invMass = resize(w.invMass, len(w.mass))
+	for i, m := range w.mass {
+		w.invMass[i] = 1 / m
+		if w.fixed[i] {
+			w.invMass[i] = 0
+		}
+	}
+	return w.invMass
+}
+
+func resize(buf []float64, n int) []float64 {
+	if cap(buf) < n {
+		return make([]float64, n)
+	}
+	return buf[:n]
+}
@@ -0,0 +1,268 @@
+package sim
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/timeseries"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Potential is implemented by conservative force models, F = -∇U.
+type Potential interface {
+	// PotentialEnergy returns U in joules for the given state.
+	PotentialEnergy(s *State) float64
+}
+
+// PotentialFunc evaluates a potential energy in joules. It can be
+// registered with Diagnostics for force models that do not implement
+// Potential themselves.
+type PotentialFunc func(s *State) float64
+
+// PotentialEnergy returns f(s).
+func (f PotentialFunc) PotentialEnergy(s *State) float64 {
+	return f(s)
+}
+
+// PotentialEnergy returns U = -Σ G mᵢ mⱼ / √(|rⱼ - rᵢ|² + ε²).
+func (g *Gravity) PotentialEnergy(s *State) float64 {
+	G := constants.GravitationalConstant.Val()
+	idx := g.bodies.indices(s.Len())
+	r := s.Position
+	u := 0.0
+	for a, i := range idx {
+		for _, j := range idx[a+1:] {
+			dx := r[3*j] - r[3*i]
+			dy := r[3*j+1] - r[3*i+1]
+			dz := r[3*j+2] - r[3*i+2]
+			if d := math.Sqrt(dx*dx + dy*dy + dz*dz + g.eps2); d > 0 {
+				u -= G * s.Mass[i] * s.Mass[j] / d
+			}
+		}
+	}
+	return u
+}
+
+// PotentialEnergy returns U = ½ k (|d| - L₀)². Damping is not included.
+func (sp *Spring) PotentialEnergy(s *State) float64 {
+	var d2 float64
+	for k := 0; k < 3; k++ {
+		d := s.Position[3*sp.b+k] - s.Position[3*sp.a+k]
+		d2 += d * d
+	}
+	x := math.Sqrt(d2) - sp.rest
+	return 0.5 * sp.k * x * x
+}
+
+// PotentialEnergy returns U = -Σ mᵢ g · rᵢ.
+func (u *Uniform) PotentialEnergy(s *State) float64 {
+	sum := 0.0
+	u.bodies.each(s.Len(), func(i int) {
+		for k := 0; k < 3; k++ {
+			sum -= s.Mass[i] * u.g[k] * s.Position[3*i+k]
+		}
+	})
+	return sum
+}
+
+// PotentialEnergy returns the electrostatic energy U = -Σ qᵢ E · rᵢ; the
+// magnetic force does no work.
+func (l *Lorentz) PotentialEnergy(s *State) float64 {
+	sum := 0.0
+	l.bodies.each(s.Len(), func(i int) {
+		for k := 0; k < 3; k++ {
+			sum -= s.Charge[i] * l.e[k] * s.Position[3*i+k]
+		}
+	})
+	return sum
+}
+
+// -----------------------------------------------------------------------------
+// Snapshots
+// -----------------------------------------------------------------------------
+
+// Snapshot holds the mechanical invariants of a world at one time.
+type Snapshot struct {
+	Time            units.Time
+	Kinetic         units.Energy
+	Potential       units.Energy   // sum over conservative force models
+	Momentum        vector.Vector3 // P = Σ m v
+	AngularMomentum vector.Vector3 // L = Σ m r × v, about the origin
+	MomentumScale   units.Momentum // Σ m |v|, the natural scale of P
+}
+
+// Total returns the total mechanical energy T + U.
+func (s Snapshot) Total() units.Energy {
+	return units.Joule(s.Kinetic.Val() + s.Potential.Val())
+}
+
+// Drift holds the changes of the invariants relative to a reference
+// snapshot: (E - E₀)/|E₀|, |P - P₀|/max(|P₀|, Σ m₀|v₀|) and
+// |L - L₀|/|L₀|. A zero reference yields an absolute difference instead.
+type Drift struct {
+	Energy, Momentum, AngularMomentum float64
+}
+
+// Drift returns the drift of s with respect to ref.
+func (s Snapshot) Drift(ref Snapshot) Drift {
+	dp, _ := s.Momentum.Subtract(ref.Momentum)
+	dl, _ := s.AngularMomentum.Subtract(ref.AngularMomentum)
+	p0, _ := ref.Momentum.Magnitude()
+	l0, _ := ref.AngularMomentum.Magnitude()
+	dpm, _ := dp.Magnitude()
+	dlm, _ := dl.Magnitude()
+	return Drift{
+		Energy:          relative(s.Total().Val()-ref.Total().Val(), ref.Total().Val()),
+		Momentum:        relative(dpm.Val(), math.Max(p0.Val(), ref.MomentumScale.Val())),
+		AngularMomentum: relative(dlm.Val(), l0.Val()),
+	}
+}
+
+// relative returns diff/|ref|, or diff itself if ref is zero.
+func relative(diff, ref float64) float64 {
+	if ref == 0 {
+		return diff
+	}
+	return diff / math.Abs(ref)
+}
+
+// Snapshot computes the invariants of the world's current state. Force
+// models implementing Potential contribute to the potential energy; others
+// are treated as non-conservative.
+func (w *World) Snapshot() Snapshot {
+	return w.snapshot(nil)
+}
+
+func (w *World) snapshot(extra map[ForceModel]Potential) Snapshot {
+	s := w.state(w.t, w.y)
+	var ke, pScale float64
+	var p, l [3]float64
+	for i, m := range w.mass {
+		r := s.Position[3*i : 3*i+3]
+		v := s.Velocity[3*i : 3*i+3]
+		v2 := v[0]*v[0] + v[1]*v[1] + v[2]*v[2]
+		ke += 0.5 * m * v2
+		pScale += m * math.Sqrt(v2)
+		for k := 0; k < 3; k++ {
+			p[k] += m * v[k]
+		}
+		l[0] += m * (r[1]*v[2] - r[2]*v[1])
+		l[1] += m * (r[2]*v[0] - r[0]*v[2])
+		l[2] += m * (r[0]*v[1] - r[1]*v[0])
+	}
+
+	pe := 0.0
+	for _, f := range w.forces {
+		if u, ok := extra[f]; ok {
+			pe += u.PotentialEnergy(&s)
+		} else if u, ok := f.(Potential); ok {
+			pe += u.PotentialEnergy(&s)
+		}
+	}
+
+	momentumDim := units.Dimension{L: 1, M: 1, T: -1}
+	return Snapshot{
+		Time:            w.Time(),
+		Kinetic:         units.Joule(ke),
+		Potential:       units.Joule(pe),
+		Momentum:        vec3(p[:], momentumDim),
+		AngularMomentum: vec3(l[:], units.Dimension{L: 2, M: 1, T: -1}),
+		MomentumScale:   units.Momentum{Value: units.NewValue(pScale, momentumDim)},
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Diagnostics Observer
+// -----------------------------------------------------------------------------
+
+// Diagnostics is an observer recording a Snapshot at every notification and
+// tracking the drift of energy, momentum and angular momentum from the
+// first one.
+//
+// Example:
+//
+//	diag := sim.NewDiagnostics()
+//	w.Observe(diag)
+//	w.Run(units.Year(1), units.Hour(1))
+//	if err := diag.CheckConservation(1e-6); err != nil {
+//	    log.Print(err)
+//	}
+type Diagnostics struct {
+	potentials map[ForceModel]Potential
+	history    []Snapshot
+}
+
+// NewDiagnostics creates an empty diagnostics recorder.
+func NewDiagnostics() *Diagnostics {
+	return &Diagnostics{potentials: map[ForceModel]Potential{}}
+}
+
+// SetPotential registers the potential energy of a force model, overriding
+// its own Potential implementation if any. Use it to account for custom
+// conservative force models.
+func (d *Diagnostics) SetPotential(f ForceModel, u Potential) {
+	d.potentials[f] = u
+}
+
+// Observe records a snapshot of the world.
+func (d *Diagnostics) Observe(w *World) {
+	d.history = append(d.history, w.snapshot(d.potentials))
+}
+
+// History returns the recorded snapshots.
+func (d *Diagnostics) History() []Snapshot {
+	return d.history
+}
+
+// Drift returns the drift of the latest snapshot from the first one.
+func (d *Diagnostics) Drift() Drift {
+	if len(d.history) == 0 {
+		return Drift{}
+	}
+	return d.history[len(d.history)-1].Drift(d.history[0])
+}
+
+// MaxDrift returns the largest absolute drift of each invariant over the
+// whole history.
+func (d *Diagnostics) MaxDrift() Drift {
+	var worst Drift
+	for _, s := range d.history {
+		dr := s.Drift(d.history[0])
+		worst.Energy = math.Max(worst.Energy, math.Abs(dr.Energy))
+		worst.Momentum = math.Max(worst.Momentum, math.Abs(dr.Momentum))
+		worst.AngularMomentum = math.Max(worst.AngularMomentum, math.Abs(dr.AngularMomentum))
+	}
+	return worst
+}
+
+// CheckConservation returns an error if any invariant has drifted from the
+// first snapshot by more than the given relative tolerance at any time.
+// Only meaningful when all forces are conservative and, for momentum,
+// internal.
+func (d *Diagnostics) CheckConservation(tolerance float64) error {
+	dr := d.MaxDrift()
+	if dr.Energy > tolerance {
+		return fmt.Errorf("energy not conserved: relative drift %.3e exceeds %.3e", dr.Energy, tolerance)
+	}
+	if dr.Momentum > tolerance {
+		return fmt.Errorf("momentum not conserved: relative drift %.3e exceeds %.3e", dr.Momentum, tolerance)
+	}
+	if dr.AngularMomentum > tolerance {
+		return fmt.Errorf("angular momentum not conserved: relative drift %.3e exceeds %.3e", dr.AngularMomentum, tolerance)
+	}
+	return nil
+}
+
+// Energy returns the kinetic, potential and total energy as time series.
+func (d *Diagnostics) Energy() (kinetic, potential, total *timeseries.Series) {
+	dim := units.Joule(1).Dim()
+	kinetic, potential, total = timeseries.New(dim), timeseries.New(dim), timeseries.New(dim)
+	for _, s := range d.history {
+		kinetic.Append(s.Time, s.Kinetic.Value)
+		potential.Append(s.Time, s.Potential.Value)
+		total.Append(s.Time, s.Total().Value)
+	}
+	return kinetic, potential, total
+}
@@ -0,0 +1,72 @@
+// Package sim is a point-body simulation engine tying the unit-safe
+// vectors, force laws and integrators of this module together.
+//
+// A World holds entities (mass, charge, radius, position, velocity) and a
+// list of force models. Each step it integrates
+//
+//	ṙᵢ = vᵢ,   v̇ᵢ = Fᵢ/mᵢ
+//
+// with the chosen solver.Stepper, where Fᵢ is the sum over all force
+// models. Force models implement ForceModel and work on a flat State view
+// in SI units, so new force laws can be plugged in without touching the
+// engine. Built in are:
+//   - Gravity: mutual Newtonian gravitation with Plummer softening;
+//   - Drag: linear and quadratic velocity drag;
+//   - Spring: damped Hookean springs between pairs of entities;
+//   - Lorentz: uniform electric and magnetic fields acting on charges;
+//   - Uniform: a constant acceleration field.
+//
+// Constraints (Distance rods and tethers, Pin anchors) are solved
+// iteratively by sequential impulses: accelerations are corrected inside
+// each derivative evaluation, and positions and velocities are projected
+// back onto the constraints after each step. Pendulums, linkages and
+// tethered satellites can thus be simulated without deriving equations of
+// motion in generalized coordinates.
+//
+// Observers are notified after every step; Every thins the notifications
+// and Recorder collects trajectories as timeseries.VectorSeries.
+// Diagnostics records energy, linear and angular momentum and reports
+// their drift; conservative force models implement Potential, and the
+// potential energy of custom models can be registered with SetPotential.
+// FromScenario builds a world from a config.Scenario file.
+//
+// Instruments receive Progress reports with the step number, simulated and
+// wall-clock time and the error estimates of adaptive integrators, for
+// progress bars and monitoring; LogProgress writes them to a slog.Logger
+// and Throttle limits their rate.
+//
+// RunContext stops a run when its context is cancelled or times out,
+// leaving the world at the last completed step so that the run can be
+// resumed. Gravity is O(n²) per evaluation; for large n, SetPool spreads
+// it over a parallel.Pool of worker goroutines.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/sim"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	w := sim.NewWorld(nil) // RK4
+//	w.Add(sim.Entity{Name: "sun", Mass: units.SolarMass(1)})
+//	earth, _ := w.Add(sim.Entity{
+//	    Name:     "earth",
+//	    Mass:     units.EarthMass(1),
+//	    Position: vector.NewPosition(units.AstronomicalUnit(1), units.Meter(0), units.Meter(0)),
+//	    Velocity: vector.NewVelocity(units.MeterPerSecond(0), units.MeterPerSecond(29780), units.MeterPerSecond(0)),
+//	})
+//	gravity, _ := sim.NewGravity(units.Meter(0))
+//	w.AddForce(gravity)
+//
+//	rec := sim.NewRecorder()
+//	w.Observe(sim.Every(24, rec))
+//	w.Run(units.Year(1), units.Hour(1))
+//	orbit := rec.Position(earth) // daily samples
+//
+// References:
+//   - Hairer, Nørsett, Wanner. "Solving Ordinary Differential Equations I", 2nd ed.
+//   - Aarseth. "Gravitational N-Body Simulations", Cambridge (2003)
+//   - Andersen. "RATTLE: A velocity version of the SHAKE algorithm",
+//     J. Comput. Phys. 52, 24 (1983)
+package sim
@@ -0,0 +1,317 @@
+package sim
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/parallel"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// ForceModel is a source of force on the entities of a world.
+type ForceModel interface {
+	// Accumulate adds the force on each entity, in newtons, to f, which is
+	// packed like s.Position.
+	Accumulate(s *State, f []float64)
+}
+
+// selection is a set of entity indices a force acts on; nil means all.
+type selection []int
+
+// indices returns the selected indices for a world of n entities.
+func (sel selection) indices(n int) []int {
+	if sel != nil {
+		return sel
+	}
+	all := make([]int, n)
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
+
+// each calls fn for every selected entity.
+func (sel selection) each(n int, fn func(i int)) {
+	if sel == nil {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+	for _, i := range sel {
+		fn(i)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Gravity
+// -----------------------------------------------------------------------------
+
+// Gravity is mutual Newtonian gravitation between the selected entities,
+// with optional Plummer softening ε:
+//
+//	Fᵢ = Σⱼ G mᵢ mⱼ (rⱼ - rᵢ) / (|rⱼ - rᵢ|² + ε²)^(3/2)
+//
+// Serially each pair is visited once. With a pool set, entities are split
+// into chunks and each worker sums the full row Fᵢ for its own entities;
+// that does twice the arithmetic but needs no locking, so it pays off from
+// a few hundred entities on multi-core machines. Each row is summed in
+// entity order whatever the number of workers, so results with a pool are
+// bit-identical on any machine, including one with a single worker.
+type Gravity struct {
+	eps2   float64
+	bodies selection
+	pool   *parallel.Pool
+}
+
+// NewGravity creates mutual gravity between the given entities, or all
+// entities if none are given. Returns an error for a negative softening.
+func NewGravity(softening units.Length, bodies ...int) (*Gravity, error) {
+	if softening.Val() < 0 {
+		return nil, fmt.Errorf("softening must be non-negative, got %g m", softening.Val())
+	}
+	return &Gravity{eps2: softening.Val() * softening.Val(), bodies: selectionOf(bodies)}, nil
+}
+
+func (g *Gravity) entities() []int {
+	return g.bodies
+}
+
+// SetPool enables parallel evaluation on the given pool; nil restores
+// serial evaluation.
+func (g *Gravity) SetPool(p *parallel.Pool) {
+	g.pool = p
+}
+
+// Accumulate adds the gravitational force on each entity.
+func (g *Gravity) Accumulate(s *State, f []float64) {
+	G := constants.GravitationalConstant.Val()
+	idx := g.bodies.indices(s.Len())
+	r := s.Position
+	if g.pool != nil {
+		g.pool.For(len(idx), 16, func(lo, hi int) {
+			for _, i := range idx[lo:hi] {
+				var fx, fy, fz float64
+				for _, j := range idx {
+					dx := r[3*j] - r[3*i]
+					dy := r[3*j+1] - r[3*i+1]
+					dz := r[3*j+2] - r[3*i+2]
+					d2 := dx*dx + dy*dy + dz*dz + g.eps2
+					if j == i || d2 == 0 {
+						continue
+					}
+					k := G * s.Mass[j] / (d2 * math.Sqrt(d2))
+					fx += k * dx
+					fy += k * dy
+					fz += k * dz
+				}
+				f[3*i] += s.Mass[i] * fx
+				f[3*i+1] += s.Mass[i] * fy
+				f[3*i+2] += s.Mass[i] * fz
+			}
+		})
+		return
+	}
+	for a, i := range idx {
+		for _, j := range idx[a+1:] {
+			dx := r[3*j] - r[3*i]
+			dy := r[3*j+1] - r[3*i+1]
+			dz := r[3*j+2] - r[3*i+2]
+			d2 := dx*dx + dy*dy + dz*dz + g.eps2
+			if d2 == 0 {
+				continue
+			}
+			k := G * s.Mass[i] * s.Mass[j] / (d2 * math.Sqrt(d2))
+			f[3*i] += k * dx
+			f[3*i+1] += k * dy
+			f[3*i+2] += k * dz
+			f[3*j] -= k * dx
+			f[3*j+1] -= k * dy
+			f[3*j+2] -= k * dz
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Drag
+// -----------------------------------------------------------------------------
+
+// quadraticDragDim is the dimension of a quadratic drag coefficient, [M L⁻¹].
+var quadraticDragDim = units.Dimension{L: -1, M: 1}
+
+// Drag is velocity-dependent drag with linear (Stokes) and quadratic
+// (Newton) terms:
+//
+//	F = -(b + c|v|) v
+type Drag struct {
+	b, c   float64
+	bodies selection
+}
+
+// NewDrag creates drag on the given entities, or all entities if none are
+// given. The quadratic coefficient c = ½ ρ C_D A has dimension [M L⁻¹].
+// Returns an error for negative coefficients or a wrong dimension.
+func NewDrag(linear units.DampingCoefficient, quadratic units.Value, bodies ...int) (*Drag, error) {
+	if quadratic.Val() != 0 && quadratic.Dim() != quadraticDragDim {
+		return nil, fmt.Errorf("quadratic drag coefficient must have dimension %s, got %s", quadraticDragDim, quadratic.Dim())
+	}
+	if linear.Val() < 0 || quadratic.Val() < 0 {
+		return nil, fmt.Errorf("drag coefficients must be non-negative, got %g kg/s and %g kg/m", linear.Val(), quadratic.Val())
+	}
+	return &Drag{b: linear.Val(), c: quadratic.Val(), bodies: selectionOf(bodies)}, nil
+}
+
+// Accumulate adds the drag force on each selected entity.
+func (d *Drag) Accumulate(s *State, f []float64) {
+	d.bodies.each(s.Len(), func(i int) {
+		v := s.Velocity[3*i : 3*i+3]
+		k := d.b + d.c*math.Sqrt(v[0]*v[0]+v[1]*v[1]+v[2]*v[2])
+		f[3*i] -= k * v[0]
+		f[3*i+1] -= k * v[1]
+		f[3*i+2] -= k * v[2]
+	})
+}
+
+func (d *Drag) entities() []int {
+	return d.bodies
+}
+
+// -----------------------------------------------------------------------------
+// Spring
+// -----------------------------------------------------------------------------
+
+// Spring is a damped Hookean spring between two entities. With d = r_b - r_a
+// and unit vector u = d/|d|, the force on a is
+//
+//	F_a = [k (|d| - L₀) + c (v_b - v_a)·u] u
+//
+// and the force on b is -F_a.
+type Spring struct {
+	a, b          int
+	k, rest, damp float64
+}
+
+// NewSpring creates a spring between entities a and b. Returns an error if
+// a == b or any parameter is negative.
+func NewSpring(a, b int, stiffness units.SpringConstant, rest units.Length, damping units.DampingCoefficient) (*Spring, error) {
+	if a == b {
+		return nil, fmt.Errorf("spring must join two different entities, got %d twice", a)
+	}
+	if stiffness.Val() < 0 || rest.Val() < 0 || damping.Val() < 0 {
+		return nil, fmt.Errorf("spring parameters must be non-negative, got k = %g N/m, L₀ = %g m, c = %g kg/s",
+			stiffness.Val(), rest.Val(), damping.Val())
+	}
+	return &Spring{a: a, b: b, k: stiffness.Val(), rest: rest.Val(), damp: damping.Val()}, nil
+}
+
+// Accumulate adds the spring force on both ends.
+func (sp *Spring) Accumulate(s *State, f []float64) {
+	a, b := sp.a, sp.b
+	var d, dv [3]float64
+	for k := 0; k < 3; k++ {
+		d[k] = s.Position[3*b+k] - s.Position[3*a+k]
+		dv[k] = s.Velocity[3*b+k] - s.Velocity[3*a+k]
+	}
+	l := math.Sqrt(d[0]*d[0] + d[1]*d[1] + d[2]*d[2])
+	if l == 0 {
+		return
+	}
+	mag := sp.k*(l-sp.rest) + sp.damp*(dv[0]*d[0]+dv[1]*d[1]+dv[2]*d[2])/l
+	for k := 0; k < 3; k++ {
+		fk := mag * d[k] / l
+		f[3*a+k] += fk
+		f[3*b+k] -= fk
+	}
+}
+
+func (sp *Spring) entities() []int {
+	return []int{sp.a, sp.b}
+}
+
+// -----------------------------------------------------------------------------
+// Lorentz
+// -----------------------------------------------------------------------------
+
+// Lorentz is the force of uniform electric and magnetic fields on charged
+// entities: F = q(E + v × B).
+type Lorentz struct {
+	e, b   [3]float64
+	bodies selection
+}
+
+// NewLorentz creates the force of uniform fields E [V/m] and B [T] on the
+// given entities, or all entities if none are given. A zero vector of any
+// dimension is accepted for an absent field.
+func NewLorentz(e, b vector.Vector3, bodies ...int) (*Lorentz, error) {
+	if !e.IsZero() {
+		if err := checkVector("electric field", e, units.VoltPerMeter(1).Dim()); err != nil {
+			return nil, err
+		}
+	}
+	if !b.IsZero() {
+		if err := checkVector("magnetic field", b, units.Tesla(1).Dim()); err != nil {
+			return nil, err
+		}
+	}
+	return &Lorentz{e: e.ToArray(), b: b.ToArray(), bodies: selectionOf(bodies)}, nil
+}
+
+// Accumulate adds the Lorentz force on each selected entity.
+func (l *Lorentz) Accumulate(s *State, f []float64) {
+	l.bodies.each(s.Len(), func(i int) {
+		q := s.Charge[i]
+		if q == 0 {
+			return
+		}
+		v := s.Velocity[3*i : 3*i+3]
+		f[3*i] += q * (l.e[0] + v[1]*l.b[2] - v[2]*l.b[1])
+		f[3*i+1] += q * (l.e[1] + v[2]*l.b[0] - v[0]*l.b[2])
+		f[3*i+2] += q * (l.e[2] + v[0]*l.b[1] - v[1]*l.b[0])
+	})
+}
+
+func (l *Lorentz) entities() []int {
+	return l.bodies
+}
+
+// -----------------------------------------------------------------------------
+// Uniform Field
+// -----------------------------------------------------------------------------
+
+// Uniform is a constant acceleration field, such as surface gravity:
+// F = m g.
+type Uniform struct {
+	g      [3]float64
+	bodies selection
+}
+
+// NewUniform creates a uniform acceleration field acting on the given
+// entities, or all entities if none are given.
+func NewUniform(g vector.Vector3, bodies ...int) (*Uniform, error) {
+	if err := checkVector("acceleration", g, units.MeterPerSecond2(1).Dim()); err != nil {
+		return nil, err
+	}
+	return &Uniform{g: g.ToArray(), bodies: selectionOf(bodies)}, nil
+}
+
+// Accumulate adds m g to each selected entity.
+func (u *Uniform) Accumulate(s *State, f []float64) {
+	u.bodies.each(s.Len(), func(i int) {
+		for k := 0; k < 3; k++ {
+			f[3*i+k] += s.Mass[i] * u.g[k]
+		}
+	})
+}
+
+func (u *Uniform) entities() []int {
+	return u.bodies
+}
+
+func selectionOf(bodies []int) selection {
+	if len(bodies) == 0 {
+		return nil
+	}
+	return append(selection(nil), bodies...)
+}
@@ -0,0 +1,191 @@
+package sim
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Phase tells which point of a run a Progress report describes.
+type Phase int
+
+const (
+	Started  Phase = iota // before the first step of a run
+	Stepped               // after a step
+	Finished              // after the run ended, successfully or not
+)
+
+func (p Phase) String() string {
+	switch p {
+	case Started:
+		return "started"
+	case Stepped:
+		return "stepped"
+	case Finished:
+		return "finished"
+	}
+	return "unknown"
+}
+
+// Progress is a report on a running simulation.
+type Progress struct {
+	Phase    Phase
+	Step     int           // steps taken by the world so far
+	Time     units.Time    // simulated time
+	Running  bool          // whether the report comes from Run
+	Start    units.Time    // simulated time at which the run began
+	End      units.Time    // simulated time at which the run ends
+	StepSize units.Time    // size of the last step
+	Wall     time.Duration // wall-clock time since the run or first step started
+
+	// ErrorNorm is the local error estimate of the last step against the
+	// tolerances of an adaptive stepper, between 0 and 1, and Rejected its
+	// count of rejected trial steps. ErrorNorm is NaN for fixed-step
+	// integrators.
+	ErrorNorm float64
+	Rejected  int
+
+	// ConstraintError is the largest relative constraint violation after
+	// the step, zero without constraints.
+	ConstraintError float64
+
+	Err error // the error ending the run, for Finished reports
+}
+
+// Fraction returns the completed fraction of the run, or NaN outside Run.
+func (p Progress) Fraction() float64 {
+	if !p.Running {
+		return math.NaN()
+	}
+	if p.End.Val() == p.Start.Val() {
+		return 1
+	}
+	return (p.Time.Val() - p.Start.Val()) / (p.End.Val() - p.Start.Val())
+}
+
+// Remaining estimates the wall-clock time left in the run by extrapolating
+// the rate so far. Returns zero if no estimate is possible.
+func (p Progress) Remaining() time.Duration {
+	f := p.Fraction()
+	if !(f > 0 && f < 1) {
+		return 0
+	}
+	return time.Duration(float64(p.Wall) * (1 - f) / f)
+}
+
+// Instrument receives progress reports from a world: one Started report
+// when a run begins, one Stepped report per step and one Finished report
+// when the run ends. Unlike observers, instruments see wall-clock time and
+// integrator error estimates, for progress bars, logging and monitoring.
+type Instrument interface {
+	Report(p Progress)
+}
+
+// InstrumentFunc adapts a function to the Instrument interface.
+type InstrumentFunc func(p Progress)
+
+// Report calls f(p).
+func (f InstrumentFunc) Report(p Progress) {
+	f(p)
+}
+
+// Throttle returns an instrument that forwards Started and Finished
+// reports, and Stepped reports at most once per interval of wall time.
+//
+// Example:
+//
+//	w.Instrument(sim.Throttle(time.Second, sim.InstrumentFunc(func(p sim.Progress) {
+//	    fmt.Printf("\r%5.1f%%  ETA %v", 100*p.Fraction(), p.Remaining().Round(time.Second))
+//	})))
+func Throttle(interval time.Duration, in Instrument) Instrument {
+	var last time.Duration
+	return InstrumentFunc(func(p Progress) {
+		if p.Phase == Stepped && p.Wall-last < interval {
+			return
+		}
+		last = p.Wall
+		in.Report(p)
+	})
+}
+
+// LogProgress returns an instrument writing reports to a structured logger:
+// the start and successful end of a run at Info level, steps at Debug level
+// and a failed run at Error level.
+//
+// Example:
+//
+//	w.Instrument(sim.Throttle(10*time.Second, sim.LogProgress(slog.Default())))
+func LogProgress(l *slog.Logger) Instrument {
+	return InstrumentFunc(func(p Progress) {
+		level, msg := slog.LevelDebug, "simulation step"
+		switch {
+		case p.Phase == Started:
+			level, msg = slog.LevelInfo, "simulation started"
+		case p.Phase == Finished && p.Err != nil:
+			level, msg = slog.LevelError, "simulation failed"
+		case p.Phase == Finished:
+			level, msg = slog.LevelInfo, "simulation finished"
+		}
+		attrs := []slog.Attr{
+			slog.Int("step", p.Step),
+			slog.Float64("t", p.Time.Val()),
+			slog.Duration("wall", p.Wall),
+		}
+		if p.Running {
+			attrs = append(attrs, slog.Float64("end", p.End.Val()))
+		}
+		if p.Phase == Stepped {
+			attrs = append(attrs, slog.Float64("h", p.StepSize.Val()))
+		}
+		if !math.IsNaN(p.ErrorNorm) {
+			attrs = append(attrs, slog.Float64("error", p.ErrorNorm), slog.Int("rejected", p.Rejected))
+		}
+		if p.ConstraintError != 0 {
+			attrs = append(attrs, slog.Float64("constraint_error", p.ConstraintError))
+		}
+		if p.Err != nil {
+			attrs = append(attrs, slog.String("err", p.Err.Error()))
+		}
+		l.LogAttrs(context.Background(), level, msg, attrs...)
+	})
+}
+
+// Instrument adds an instrument receiving progress reports.
+func (w *World) Instrument(in Instrument) {
+	w.instruments = append(w.instruments, in)
+}
+
+// report sends a progress report to the instruments.
+func (w *World) report(phase Phase, h float64, err error) {
+	if len(w.instruments) == 0 {
+		return
+	}
+	if w.wallStart.IsZero() {
+		w.wallStart = time.Now()
+	}
+	p := Progress{
+		Phase:     phase,
+		Step:      w.steps,
+		Time:      w.Time(),
+		Running:   w.running,
+		Start:     units.Second(w.span[0]),
+		End:       units.Second(w.span[1]),
+		StepSize:  units.Second(h),
+		Wall:      time.Since(w.wallStart),
+		ErrorNorm: math.NaN(),
+		Err:       err,
+	}
+	if a, ok := w.stepper.(*solver.Adaptive); ok {
+		p.ErrorNorm, p.Rejected = a.ErrorNorm(), a.Rejected
+	}
+	if len(w.constraints) > 0 {
+		p.ConstraintError = w.ConstraintError()
+	}
+	for _, in := range w.instruments {
+		in.Report(p)
+	}
+}
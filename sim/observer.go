@@ -0,0 +1,68 @@
+package sim
+
+import (
+	"github.com/sakiphan/qsim-core/timeseries"
+)
+
+// Observer is notified as a world advances.
+type Observer interface {
+	Observe(w *World)
+}
+
+// ObserverFunc adapts a function to the Observer interface.
+type ObserverFunc func(w *World)
+
+// Observe calls f(w).
+func (f ObserverFunc) Observe(w *World) {
+	f(w)
+}
+
+// Every returns an observer that forwards to o only on every n-th step,
+// including step 0.
+func Every(n int, o Observer) Observer {
+	if n <= 1 {
+		return o
+	}
+	return ObserverFunc(func(w *World) {
+		if w.Steps()%n == 0 {
+			o.Observe(w)
+		}
+	})
+}
+
+// Recorder is an observer that records the position and velocity of every
+// entity as time series.
+type Recorder struct {
+	pos, vel []*timeseries.VectorSeries
+}
+
+// NewRecorder creates an empty recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Observe appends the current state of every entity. Entities added after
+// recording started get series starting at the time they first appear.
+func (r *Recorder) Observe(w *World) {
+	for len(r.pos) < w.Len() {
+		r.pos = append(r.pos, timeseries.NewVector(lengthDim))
+		r.vel = append(r.vel, timeseries.NewVector(velocityDim))
+	}
+	t := w.Time()
+	for i := 0; i < w.Len(); i++ {
+		// Append only fails for non-increasing times, i.e. a repeated
+		// notification of the same state, which is safe to drop.
+		_ = r.pos[i].Append(t, w.Position(i))
+		_ = r.vel[i].Append(t, w.Velocity(i))
+	}
+}
+
+// Position returns the recorded trajectory of entity i.
+func (r *Recorder) Position(i int) *timeseries.VectorSeries {
+	return r.pos[i]
+}
+
+// Velocity returns the recorded velocity of entity i.
+func (r *Recorder) Velocity(i int) *timeseries.VectorSeries {
+	return r.vel[i]
+}
@@ -0,0 +1,90 @@
+package sim
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/config"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// FromScenario builds a world from a loaded scenario: its integrator,
+// bodies and force models. The caller runs it for sc.Duration in steps of
+// sc.Step.
+//
+// Example:
+//
+//	sc, _ := config.Load("earth-moon.yaml")
+//	w, _ := sim.FromScenario(sc)
+//	rec := sim.NewRecorder()
+//	w.Observe(sim.Every(sc.OutputStride(), rec))
+//	err := w.Run(sc.Duration, sc.Step)
+func FromScenario(sc *config.Scenario) (*World, error) {
+	stepper, err := sc.Stepper()
+	if err != nil {
+		return nil, err
+	}
+	w := NewWorld(stepper)
+	for _, b := range sc.Bodies {
+		if _, err := w.Add(Entity{
+			Name:     b.Name,
+			Mass:     b.Mass,
+			Charge:   b.Charge,
+			Radius:   b.Radius,
+			Position: b.Position,
+			Velocity: b.Velocity,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	for i, f := range sc.Forces {
+		model, err := forceFromConfig(w, f)
+		if err != nil {
+			return nil, fmt.Errorf("forces[%d] (%s): %w", i, f.Type, err)
+		}
+		if err := w.AddForce(model); err != nil {
+			return nil, fmt.Errorf("forces[%d] (%s): %w", i, f.Type, err)
+		}
+	}
+	return w, nil
+}
+
+// forceFromConfig converts a force description into a force model.
+func forceFromConfig(w *World, f config.Force) (ForceModel, error) {
+	bodies := make([]int, len(f.Bodies))
+	for i, name := range f.Bodies {
+		idx, ok := w.Index(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown body %q", name)
+		}
+		bodies[i] = idx
+	}
+	param := func(name string) units.Value {
+		v, _ := f.Param(name)
+		return v
+	}
+	vec := func(name string) vector.Vector3 {
+		v, _ := f.Vector(name)
+		return v
+	}
+
+	switch f.Type {
+	case "gravity":
+		return NewGravity(units.Length{Value: param("softening")}, bodies...)
+	case "drag":
+		return NewDrag(units.DampingCoefficient{Value: param("linear")}, param("quadratic"), bodies...)
+	case "spring":
+		if len(bodies) != 2 {
+			return nil, fmt.Errorf("spring needs exactly 2 bodies, got %d", len(bodies))
+		}
+		return NewSpring(bodies[0], bodies[1],
+			units.SpringConstant{Value: param("stiffness")},
+			units.Length{Value: param("rest_length")},
+			units.DampingCoefficient{Value: param("damping")})
+	case "lorentz":
+		return NewLorentz(vec("E"), vec("B"), bodies...)
+	case "uniform":
+		return NewUniform(vec("acceleration"), bodies...)
+	}
+	return nil, fmt.Errorf("unknown force type %q", f.Type)
+}
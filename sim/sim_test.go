@@ -0,0 +1,936 @@
+package sim
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sakiphan/qsim-core/config"
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/io/checkpoint"
+	"github.com/sakiphan/qsim-core/math/random"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/parallel"
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func pos(x, y, z float64) vector.Vector3 {
+	return vector.NewPosition(units.Meter(x), units.Meter(y), units.Meter(z))
+}
+
+func vel(x, y, z float64) vector.Vector3 {
+	return vector.NewVelocity(units.MeterPerSecond(x), units.MeterPerSecond(y), units.MeterPerSecond(z))
+}
+
+// -----------------------------------------------------------------------------
+// World Tests
+// -----------------------------------------------------------------------------
+
+func TestWorldAdd(t *testing.T) {
+	w := NewWorld(nil)
+	a, err := w.Add(Entity{Name: "a", Mass: units.Kilogram(1), Position: pos(1, 2, 3)})
+	if err != nil || a != 0 {
+		t.Fatalf("Add() = %d, %v", a, err)
+	}
+	b, _ := w.Add(Entity{Name: "b", Mass: units.Kilogram(2), Velocity: vel(4, 5, 6)})
+	if w.Len() != 2 || b != 1 {
+		t.Fatalf("Len() = %d, index = %d", w.Len(), b)
+	}
+	// Adding an entity must not disturb the others' state.
+	if p := w.Position(a).ToArray(); p != [3]float64{1, 2, 3} {
+		t.Errorf("Position(a) = %v", p)
+	}
+	if v := w.Velocity(b).ToArray(); v != [3]float64{4, 5, 6} || w.Velocity(b).Dim() != velocityDim {
+		t.Errorf("Velocity(b) = %v", w.Velocity(b))
+	}
+	if i, ok := w.Index("b"); !ok || i != 1 {
+		t.Errorf("Index(b) = %d, %v", i, ok)
+	}
+	if e := w.Entity(0); e.Name != "a" || e.Mass.Val() != 1 || !e.Velocity.IsZero() {
+		t.Errorf("Entity(0) = %+v", e)
+	}
+
+	for _, bad := range []Entity{
+		{Name: "c", Mass: units.Kilogram(0)},
+		{Name: "a", Mass: units.Kilogram(1)},
+		{Name: "d", Mass: units.Kilogram(1), Position: vel(1, 0, 0)},
+	} {
+		if _, err := w.Add(bad); err == nil {
+			t.Errorf("Add(%+v) should fail", bad)
+		}
+	}
+	if err := w.SetVelocity(a, pos(1, 0, 0)); err == nil {
+		t.Error("SetVelocity() should fail for a length")
+	}
+	if err := w.Step(units.Second(0)); err == nil {
+		t.Error("Step(0) should fail")
+	}
+}
+
+func TestRunObservers(t *testing.T) {
+	w := NewWorld(&solver.Euler{})
+	w.Add(Entity{Name: "p", Mass: units.Kilogram(1), Velocity: vel(1, 0, 0)})
+
+	calls := 0
+	w.Observe(ObserverFunc(func(*World) { calls++ }))
+	rec := NewRecorder()
+	w.Observe(Every(4, rec))
+
+	// 10 steps of 0.1 s plus a final 0.05 s step.
+	if err := w.Run(units.Second(1.05), units.Second(0.1)); err != nil {
+		t.Fatal(err)
+	}
+	if w.Steps() != 11 || calls != 12 {
+		t.Errorf("Steps(), observer calls = %d, %d, want 11, 12", w.Steps(), calls)
+	}
+	if w.Time().Val() != 1.05 || !almostEqual(w.Position(0).X.Val(), 1.05, 1e-12) {
+		t.Errorf("t, x = %v, %v", w.Time(), w.Position(0).X)
+	}
+	// Recorded at steps 0, 4 and 8.
+	if n := rec.Position(0).Len(); n != 3 {
+		t.Errorf("recorded samples = %d, want 3", n)
+	}
+	if tm, x := rec.Position(0).At(2); !almostEqual(tm.Val(), 0.8, 1e-12) || !almostEqual(x.X.Val(), 0.8, 1e-12) {
+		t.Errorf("sample 2 = %v, %v", tm, x)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Force Model Tests
+// -----------------------------------------------------------------------------
+
+func TestRunContext(t *testing.T) {
+	spring := func() *World {
+		w := NewWorld(nil)
+		w.Add(Entity{Name: "anchor", Mass: units.Kilogram(1e30)})
+		w.Add(Entity{Name: "bob", Mass: units.Kilogram(1), Position: pos(1.5, 0, 0)})
+		s, _ := NewSpring(0, 1, units.NewtonPerMeter(4), units.Meter(1), units.NewtonSecondPerMeter(0))
+		w.AddForce(s)
+		return w
+	}
+	ref := spring()
+	ref.Run(units.Second(10), units.Millisecond(10))
+
+	// Cancel from an observer after 300 steps.
+	w := spring()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.Observe(ObserverFunc(func(w *World) {
+		if w.Steps() == 300 {
+			cancel()
+		}
+	}))
+	err := w.RunContext(ctx, units.Second(10), units.Millisecond(10))
+	var stop *solver.Interrupted
+	if !errors.As(err, &stop) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want an Interrupted wrapping context.Canceled", err)
+	}
+	if stop.Steps != 300 || w.Steps() != 300 || !almostEqual(stop.T, 3, 1e-12) || stop.T != w.Time().Val() {
+		t.Errorf("interrupted after %d steps at t = %v, world at %d steps, %v", stop.Steps, stop.T, w.Steps(), w.Time())
+	}
+
+	// Resume with the remaining duration.
+	if err := w.RunContext(context.Background(), units.Second(10-stop.T), units.Millisecond(10)); err != nil {
+		t.Fatal(err)
+	}
+	if w.Steps() != ref.Steps() || !almostEqual(w.Position(1).X.Val(), ref.Position(1).X.Val(), 1e-12) {
+		t.Errorf("resumed: %d steps, x = %v; uninterrupted: %d steps, x = %v",
+			w.Steps(), w.Position(1).X, ref.Steps(), ref.Position(1).X)
+	}
+}
+
+// canceller cancels a context on the nth force evaluation.
+type canceller struct {
+	n      int
+	cancel context.CancelFunc
+}
+
+func (c *canceller) Accumulate(*State, []float64) {
+	if c.n--; c.n == 0 {
+		c.cancel()
+	}
+}
+
+func TestRunContextAdaptive(t *testing.T) {
+	// A single outer step spans the whole run, so only the integrator's
+	// internal checks can stop it.
+	build := func() (*World, *solver.Adaptive) {
+		a, _ := solver.NewAdaptive(StateLayout, solver.Tolerances{
+			units.Meter(1).Dim():          {Abs: units.Meter(1e-9).Value, Rel: 1e-12},
+			units.MeterPerSecond(1).Dim(): {Abs: units.MeterPerSecond(1e-9).Value, Rel: 1e-12},
+		})
+		w := NewWorld(a)
+		w.Add(Entity{Name: "anchor", Mass: units.Kilogram(1e30)})
+		w.Add(Entity{Name: "bob", Mass: units.Kilogram(1), Position: pos(1.5, 0, 0)})
+		s, _ := NewSpring(0, 1, units.NewtonPerMeter(4), units.Meter(1), units.NewtonSecondPerMeter(0))
+		w.AddForce(s)
+		return w, a
+	}
+	ref, _ := build()
+	if err := ref.Run(units.Second(10), units.Second(10)); err != nil {
+		t.Fatal(err)
+	}
+
+	w, a := build()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.AddForce(&canceller{n: 200, cancel: cancel})
+	err := w.RunContext(ctx, units.Second(10), units.Second(10))
+	var stop *solver.Interrupted
+	if !errors.As(err, &stop) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want an Interrupted wrapping context.Canceled", err)
+	}
+	if stop.Steps != 0 || w.Steps() != 0 || !(stop.T > 0 && stop.T < 10) || stop.T != w.Time().Val() || a.Accepted == 0 {
+		t.Errorf("interrupted after %d steps at t = %v, world at %d steps, %v, %d internal steps",
+			stop.Steps, stop.T, w.Steps(), w.Time(), a.Accepted)
+	}
+
+	// Resume with the remaining duration; the controller keeps its step
+	// size, so the run ends exactly where the uninterrupted one did.
+	if err := w.RunContext(context.Background(), units.Second(10-stop.T), units.Second(10)); err != nil {
+		t.Fatal(err)
+	}
+	if w.Steps() != ref.Steps() || w.Time() != ref.Time() || w.Position(1).ToArray() != ref.Position(1).ToArray() {
+		t.Errorf("resumed: %d steps, t = %v, r = %v; uninterrupted: %d steps, t = %v, r = %v",
+			w.Steps(), w.Time(), w.Position(1).ToArray(), ref.Steps(), ref.Time(), ref.Position(1).ToArray())
+	}
+}
+
+func TestInstrument(t *testing.T) {
+	w := NewWorld(nil)
+	w.Add(Entity{Name: "p", Mass: units.Kilogram(1), Velocity: vel(1, 0, 0)})
+	w.Step(units.Second(1))
+
+	var reports []Progress
+	w.Instrument(InstrumentFunc(func(p Progress) { reports = append(reports, p) }))
+	var throttled []Phase
+	w.Instrument(Throttle(time.Hour, InstrumentFunc(func(p Progress) { throttled = append(throttled, p.Phase) })))
+
+	if err := w.Run(units.Second(4), units.Second(1)); err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 6 || reports[0].Phase != Started || reports[5].Phase != Finished {
+		t.Fatalf("reports = %v", reports)
+	}
+	for i, p := range reports[1:5] {
+		if p.Phase != Stepped || p.Step != i+2 || p.StepSize.Val() != 1 || !math.IsNaN(p.ErrorNorm) {
+			t.Errorf("report %d = %+v", i+1, p)
+		}
+	}
+	if f := reports[2].Fraction(); !almostEqual(f, 0.5, 1e-12) {
+		t.Errorf("fraction after 2 of 4 steps = %v", f)
+	}
+	if p := reports[5]; p.Err != nil || p.Time.Val() != 5 || p.Start.Val() != 1 || p.End.Val() != 5 || p.Fraction() != 1 {
+		t.Errorf("final report = %+v", p)
+	}
+	if fmt.Sprint(throttled) != "[started finished]" {
+		t.Errorf("throttled phases = %v", throttled)
+	}
+
+	// Steps outside Run are reported without run bounds.
+	w.Step(units.Second(1))
+	if p := reports[len(reports)-1]; p.Phase != Stepped || p.Running || !math.IsNaN(p.Fraction()) {
+		t.Errorf("report outside Run = %+v", p)
+	}
+}
+
+func TestLogProgress(t *testing.T) {
+	adaptive, _ := solver.NewAdaptive(StateLayout, solver.Tolerances{
+		lengthDim:   {Abs: units.Meter(1e-9).Value},
+		velocityDim: {Abs: units.MeterPerSecond(1e-9).Value},
+	})
+	w := NewWorld(adaptive)
+	w.Add(Entity{Name: "anchor", Mass: units.Kilogram(1e30)})
+	w.Add(Entity{Name: "bob", Mass: units.Kilogram(1), Position: pos(1.5, 0, 0)})
+	s, _ := NewSpring(0, 1, units.NewtonPerMeter(4), units.Meter(1), units.NewtonSecondPerMeter(0))
+	w.AddForce(s)
+
+	var buf strings.Builder
+	w.Instrument(LogProgress(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+	ctx, cancel := context.WithCancel(context.Background())
+	w.Observe(ObserverFunc(func(w *World) {
+		if w.Steps() == 2 {
+			cancel()
+		}
+	}))
+	w.RunContext(ctx, units.Second(10), units.Second(1))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("log:\n%s", buf.String())
+	}
+	for i, want := range []string{
+		`level=INFO msg="simulation started" step=0 t=0`,
+		`level=DEBUG msg="simulation step" step=1 t=1`,
+		`level=DEBUG msg="simulation step" step=2 t=2`,
+		`level=ERROR msg="simulation failed" step=2 t=2`,
+	} {
+		if !strings.Contains(lines[i], want) {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want)
+		}
+	}
+	if !strings.Contains(lines[1], " error=") || !strings.Contains(lines[1], " h=1 ") ||
+		!strings.Contains(lines[3], "context canceled") {
+		t.Errorf("log:\n%s", buf.String())
+	}
+}
+
+func TestGravityCircularOrbit(t *testing.T) {
+	// A light satellite in a circular orbit returns to its start after one
+	// period T = 2π √(r³/GM).
+	G := constants.GravitationalConstant.Val()
+	M, r := 5.972e24, 7e6
+	v := math.Sqrt(G * M / r)
+	period := 2 * math.Pi * math.Sqrt(r*r*r/(G*M))
+
+	w := NewWorld(nil)
+	w.Add(Entity{Name: "earth", Mass: units.Kilogram(M)})
+	sat, _ := w.Add(Entity{Name: "sat", Mass: units.Kilogram(1), Position: pos(r, 0, 0), Velocity: vel(0, v, 0)})
+	g, _ := NewGravity(units.Meter(0))
+	w.AddForce(g)
+
+	if err := w.Run(units.Second(period), units.Second(period/2000)); err != nil {
+		t.Fatal(err)
+	}
+	p := w.Position(sat).ToArray()
+	if !almostEqual(p[0], r, 1e-6*r) || !almostEqual(p[1], 0, 1e-5*r) {
+		t.Errorf("position after one period = %v, want (%g, 0, 0)", p, r)
+	}
+
+	// Newton's third law: the forces on the two bodies cancel.
+	f0, f1 := w.Force(0).ToArray(), w.Force(1).ToArray()
+	for k := 0; k < 3; k++ {
+		if !almostEqual(f0[k], -f1[k], 1e-9*math.Abs(f0[0])) {
+			t.Errorf("F₀ + F₁ = %v + %v ≠ 0", f0, f1)
+			break
+		}
+	}
+	if w.Force(0).Dim() != units.Newton(1).Dim() {
+		t.Errorf("Force() dimension = %v", w.Force(0).Dim())
+	}
+}
+
+func TestTerminalVelocity(t *testing.T) {
+	// Under gravity and linear drag v → g m / b.
+	m, b := 2.0, 0.5
+	w := NewWorld(nil)
+	w.Add(Entity{Name: "drop", Mass: units.Kilogram(m)})
+	g, _ := NewUniform(vector.Vector3{
+		X: units.MeterPerSecond2(0).Value,
+		Y: units.MeterPerSecond2(0).Value,
+		Z: units.MeterPerSecond2(-9.81).Value,
+	})
+	drag, err := NewDrag(units.NewtonSecondPerMeter(b), units.Value{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.AddForce(g)
+	w.AddForce(drag)
+	w.Run(units.Second(100), units.Second(0.05))
+	if vz := w.Velocity(0).Z.Val(); !almostEqual(vz, -9.81*m/b, 1e-6) {
+		t.Errorf("terminal velocity = %v, want %v", vz, -9.81*m/b)
+	}
+
+	// Quadratic drag: v → √(m g / c).
+	c := units.Kilogram(0.1).Divide(units.Meter(1).Value)
+	quad, _ := NewDrag(units.NewtonSecondPerMeter(0), c)
+	w = NewWorld(nil)
+	w.Add(Entity{Name: "drop", Mass: units.Kilogram(m)})
+	w.AddForce(g)
+	w.AddForce(quad)
+	w.Run(units.Second(60), units.Second(0.01))
+	if vz, want := w.Velocity(0).Z.Val(), -math.Sqrt(m*9.81/0.1); !almostEqual(vz, want, 1e-6) {
+		t.Errorf("quadratic terminal velocity = %v, want %v", vz, want)
+	}
+
+	if _, err := NewDrag(units.NewtonSecondPerMeter(1), units.Kilogram(1).Value); err == nil {
+		t.Error("NewDrag() should reject a quadratic coefficient in kg")
+	}
+}
+
+func TestSpringOscillation(t *testing.T) {
+	// Two masses on a spring oscillate with ω = √(k/μ), μ = m₁m₂/(m₁+m₂).
+	m1, m2, k := 1.0, 3.0, 12.0
+	mu := m1 * m2 / (m1 + m2)
+	period := 2 * math.Pi * math.Sqrt(mu/k)
+
+	w := NewWorld(nil)
+	a, _ := w.Add(Entity{Name: "a", Mass: units.Kilogram(m1), Position: pos(-0.6, 0, 0)})
+	b, _ := w.Add(Entity{Name: "b", Mass: units.Kilogram(m2), Position: pos(0.6, 0, 0)})
+	s, err := NewSpring(a, b, units.NewtonPerMeter(k), units.Meter(1), units.NewtonSecondPerMeter(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.AddForce(s)
+
+	w.Run(units.Second(period/2), units.Second(period/1000))
+	// Half a period later the stretch of 0.2 m has become a compression.
+	if l := w.Position(b).X.Val() - w.Position(a).X.Val(); !almostEqual(l, 0.8, 1e-8) {
+		t.Errorf("separation after T/2 = %v, want 0.8", l)
+	}
+	// The centre of mass stays put.
+	if cm := (m1*w.Position(a).X.Val() + m2*w.Position(b).X.Val()) / (m1 + m2); !almostEqual(cm, 0.3, 1e-12) {
+		t.Errorf("centre of mass = %v, want 0.3", cm)
+	}
+
+	if _, err := NewSpring(a, a, units.NewtonPerMeter(k), units.Meter(1), units.NewtonSecondPerMeter(0)); err == nil {
+		t.Error("NewSpring() should reject a == b")
+	}
+}
+
+func TestForceIndices(t *testing.T) {
+	w := NewWorld(nil)
+	w.Add(Entity{Name: "p", Mass: units.Kilogram(1)})
+
+	spring, _ := NewSpring(0, 5, units.NewtonPerMeter(1), units.Meter(1), units.NewtonSecondPerMeter(0))
+	if err := w.AddForce(spring); err == nil {
+		t.Error("AddForce() should reject a spring to entity 5 in a world of 1")
+	}
+	g := vector.Vector3{X: units.MeterPerSecond2(0).Value, Y: units.MeterPerSecond2(-9.81).Value, Z: units.MeterPerSecond2(0).Value}
+	uniform, _ := NewUniform(g, -1)
+	if err := w.AddForce(uniform); err == nil {
+		t.Error("AddForce() should reject a negative entity index")
+	}
+	gravity, _ := NewGravity(units.Meter(0), 0, 1)
+	if err := w.AddForce(gravity); err == nil {
+		t.Error("AddForce() should reject gravity on entity 1 in a world of 1")
+	}
+
+	all, _ := NewUniform(g)
+	if err := w.AddForce(all); err != nil {
+		t.Fatalf("AddForce() error = %v for a force on all entities", err)
+	}
+	if err := w.Run(units.Second(0.1), units.Second(0.01)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLorentzCyclotron(t *testing.T) {
+	// A proton in B = 1 T ẑ gyrates with radius r = mv/(qB) and returns to
+	// its start after T = 2π m/(qB).
+	proton, _ := particles.Lookup("p")
+	m, q, B, v := proton.Mass.Value().Val(), constants.ElementaryCharge.Val(), 1.0, 1e5
+	period := 2 * math.Pi * m / (q * B)
+	radius := m * v / (q * B)
+
+	w := NewWorld(nil)
+	w.Add(Entity{Name: "p", Mass: units.Kilogram(m), Charge: units.Coulomb(q), Velocity: vel(v, 0, 0)})
+	field, err := NewLorentz(vector.Vector3{}, vector.Vector3{
+		X: units.Tesla(0).Value, Y: units.Tesla(0).Value, Z: units.Tesla(B).Value,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.AddForce(field)
+
+	w.Run(units.Second(period/2), units.Second(period/2000))
+	// Moving +x with qB ẑ, the force points -y: after half a turn y = -2r.
+	if p := w.Position(0).ToArray(); !almostEqual(p[0], 0, 1e-9*radius) || !almostEqual(p[1], -2*radius, 1e-8*radius) {
+		t.Errorf("position after T/2 = %v, want (0, %g, 0)", p, -2*radius)
+	}
+	if speed, _ := w.Velocity(0).Magnitude(); !almostEqual(speed.Val(), v, 1e-6*v) {
+		t.Errorf("speed = %v, want %v", speed.Val(), v)
+	}
+
+	if _, err := NewLorentz(vel(1, 0, 0), vector.Vector3{}); err == nil {
+		t.Error("NewLorentz() should reject E in m/s")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Scenario Tests
+// -----------------------------------------------------------------------------
+
+func TestFromScenario(t *testing.T) {
+	sc, err := config.Parse([]byte(`
+integrator: rk4
+step: 1 ms
+duration: 1 s
+output: {every: 100 ms}
+bodies:
+  - {name: anchor, mass: 1e30 kg}
+  - name: bob
+    mass: 1 kg
+    position: [0, -1.5 m, 0]
+forces:
+  - type: spring
+    bodies: [anchor, bob]
+    stiffness: 40 N/m
+    rest_length: 1 m
+  - type: uniform
+    bodies: [bob]
+    acceleration: [0, -9.81 m/s^2, 0]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := FromScenario(sc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := NewRecorder()
+	w.Observe(Every(sc.OutputStride(), rec))
+	if err := w.Run(sc.Duration, sc.Step); err != nil {
+		t.Fatal(err)
+	}
+	if n := rec.Position(1).Len(); n != 11 {
+		t.Errorf("recorded samples = %d, want 11", n)
+	}
+
+	// Hanging spring: y(t) = y_eq + A cos ωt, with y_eq = -(1 + mg/k).
+	omega, yeq := math.Sqrt(40.0), -(1 + 9.81/40)
+	want := yeq + (-1.5-yeq)*math.Cos(omega)
+	if y := w.Position(1).Y.Val(); !almostEqual(y, want, 1e-8) {
+		t.Errorf("y(1 s) = %v, want %v", y, want)
+	}
+	if y := w.Position(0).Y.Val(); !almostEqual(y, 0, 1e-20) {
+		t.Errorf("anchor moved to y = %v", y)
+	}
+
+	sc.Integrator = "verlet"
+	if _, err := FromScenario(sc); err == nil || !strings.Contains(err.Error(), "integrator") {
+		t.Errorf("FromScenario() error = %v, want unknown integrator", err)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Constraint Tests
+// -----------------------------------------------------------------------------
+
+func TestPendulum(t *testing.T) {
+	// A pinned anchor and a 1 m rod: for a small amplitude θ₀ the period is
+	// T ≈ 2π √(L/g) (1 + θ₀²/16).
+	L, g, theta0 := 1.0, 9.81, 0.05
+	period := 2 * math.Pi * math.Sqrt(L/g) * (1 + theta0*theta0/16)
+
+	w := NewWorld(nil)
+	pivot, _ := w.Add(Entity{Name: "pivot", Mass: units.Kilogram(1)})
+	bob, _ := w.Add(Entity{Name: "bob", Mass: units.Kilogram(0.2), Position: pos(L*math.Sin(theta0), -L*math.Cos(theta0), 0)})
+	pin, _ := NewPin(pivot, pos(0, 0, 0))
+	rod, _ := NewDistance(pivot, bob, units.Meter(L))
+	if err := w.AddConstraint(pin); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AddConstraint(rod); err != nil {
+		t.Fatal(err)
+	}
+	gravity, _ := NewUniform(vector.Vector3{
+		X: units.MeterPerSecond2(0).Value,
+		Y: units.MeterPerSecond2(-g).Value,
+		Z: units.MeterPerSecond2(0).Value,
+	})
+	w.AddForce(gravity)
+
+	// Time the first two zero crossings of x, moving right to left.
+	var crossings []float64
+	prev := w.Position(bob).X.Val()
+	w.Observe(ObserverFunc(func(w *World) {
+		x := w.Position(bob).X.Val()
+		if prev > 0 && x <= 0 {
+			// Linear interpolation within the step.
+			h := 1e-3
+			crossings = append(crossings, w.Time().Val()-h*x/(x-prev))
+		}
+		prev = x
+	}))
+	if err := w.Run(units.Second(2.5*period), units.Millisecond(1)); err != nil {
+		t.Fatal(err)
+	}
+	if len(crossings) < 2 {
+		t.Fatalf("found %d crossings, want at least 2", len(crossings))
+	}
+	if got := crossings[1] - crossings[0]; !almostEqual(got, period, 1e-4*period) {
+		t.Errorf("period = %v, want %v", got, period)
+	}
+	if err := w.ConstraintError(); err > 1e-10 {
+		t.Errorf("ConstraintError() = %v", err)
+	}
+	if p := w.Position(pivot); !p.IsZero() || !w.Velocity(pivot).IsZero() {
+		t.Errorf("pivot moved to %v", p)
+	}
+}
+
+func TestTether(t *testing.T) {
+	// Two tethered satellites spinning about their centre of mass keep
+	// their separation, momentum and angular momentum.
+	w := NewWorld(nil)
+	a, _ := w.Add(Entity{Name: "a", Mass: units.Kilogram(100), Position: pos(-10, 0, 0), Velocity: vel(1, -2, 0)})
+	b, _ := w.Add(Entity{Name: "b", Mass: units.Kilogram(300), Position: pos(30, 0, 0), Velocity: vel(1, 2.0/3, 0.5)})
+	tether, err := NewDistance(a, b, units.Meter(40))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AddConstraint(tether); err != nil {
+		t.Fatal(err)
+	}
+
+	lz := func() float64 {
+		var sum float64
+		for i, m := range []float64{100, 300} {
+			r, v := w.Position(i).ToArray(), w.Velocity(i).ToArray()
+			sum += m * (r[0]*v[1] - r[1]*v[0])
+		}
+		return sum
+	}
+	l0 := lz()
+	w.Run(units.Second(100), units.Millisecond(10))
+
+	d, _ := w.Position(b).Subtract(w.Position(a))
+	if l, _ := d.Magnitude(); !almostEqual(l.Val(), 40, 1e-9) {
+		t.Errorf("separation = %v, want 40", l.Val())
+	}
+	px := 100*w.Velocity(a).X.Val() + 300*w.Velocity(b).X.Val()
+	pz := 100*w.Velocity(a).Z.Val() + 300*w.Velocity(b).Z.Val()
+	if !almostEqual(px, 400, 1e-8) || !almostEqual(pz, 150, 1e-8) {
+		t.Errorf("momentum = (%v, _, %v), want (400, _, 150)", px, pz)
+	}
+	if l := lz(); !almostEqual(l/l0, 1, 1e-9) {
+		t.Errorf("L_z = %v, want %v", l, l0)
+	}
+
+	if _, err := NewDistance(a, b, units.Meter(0)); err == nil {
+		t.Error("NewDistance() should reject zero length")
+	}
+	if err := w.SetConstraintSolver(0, 1e-9); err == nil {
+		t.Error("SetConstraintSolver() should reject zero iterations")
+	}
+}
+
+func TestConstraintIndices(t *testing.T) {
+	w := NewWorld(nil)
+	a, _ := w.Add(Entity{Name: "a", Mass: units.Kilogram(1)})
+	w.Add(Entity{Name: "b", Mass: units.Kilogram(1), Position: pos(1, 0, 0)})
+
+	for _, i := range []int{-1, 2} {
+		pin, _ := NewPin(i, pos(0, 0, 0))
+		if err := w.AddConstraint(pin); err == nil {
+			t.Errorf("AddConstraint() should reject a pin on entity %d", i)
+		}
+		rod, _ := NewDistance(a, i, units.Meter(1))
+		if err := w.AddConstraint(rod); err == nil {
+			t.Errorf("AddConstraint() should reject a rod to entity %d", i)
+		}
+	}
+	if err := w.Run(units.Second(0.1), units.Second(0.01)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdaptiveWorld(t *testing.T) {
+	// An eccentric (e = 0.8) orbit integrated with unit-aware tolerances
+	// returns to periapsis after one period.
+	G := constants.GravitationalConstant.Val()
+	M, a, e := 5.972e24, 2e7, 0.8
+	rp := a * (1 - e)
+	vp := math.Sqrt(G * M * (1 + e) / rp)
+	period := 2 * math.Pi * math.Sqrt(a*a*a/(G*M))
+
+	adaptive, err := solver.NewAdaptive(StateLayout, solver.Tolerances{
+		units.Meter(1).Dim():          {Abs: units.Millimeter(1).Value, Rel: 1e-12},
+		units.MeterPerSecond(1).Dim(): {Abs: units.MeterPerSecond(1e-6).Value, Rel: 1e-12},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWorld(adaptive)
+	w.Add(Entity{Name: "earth", Mass: units.Kilogram(M)})
+	sat, _ := w.Add(Entity{Name: "sat", Mass: units.Kilogram(1), Position: pos(rp, 0, 0), Velocity: vel(0, vp, 0)})
+	g, _ := NewGravity(units.Meter(0))
+	w.AddForce(g)
+
+	if err := w.Run(units.Second(period), units.Second(period/10)); err != nil {
+		t.Fatal(err)
+	}
+	if p := w.Position(sat).ToArray(); !almostEqual(p[0], rp, 1) || !almostEqual(p[1], 0, 10) {
+		t.Errorf("position after one period = %v, want (%g, 0, 0)", p, rp)
+	}
+	if w.Steps() != 10 || adaptive.Accepted < 100 {
+		t.Errorf("outer steps = %d, internal steps = %d", w.Steps(), adaptive.Accepted)
+	}
+}
+
+func TestWorldCheckpoint(t *testing.T) {
+	for _, adaptive := range []bool{false, true} {
+		build := func(names ...string) *World {
+			var stepper solver.Stepper
+			if adaptive {
+				stepper, _ = solver.NewAdaptive(StateLayout, solver.Tolerances{
+					units.Meter(1).Dim():          {Abs: units.Meter(1e-6).Value, Rel: 1e-10},
+					units.MeterPerSecond(1).Dim(): {Abs: units.MeterPerSecond(1e-9).Value, Rel: 1e-10},
+				})
+			}
+			w := NewWorld(stepper)
+			w.Add(Entity{Name: names[0], Mass: units.Kilogram(1e12)})
+			w.Add(Entity{Name: names[1], Mass: units.Kilogram(1), Position: pos(100, 0, 0), Velocity: vel(0.3, 0.8, 0)})
+			g, _ := NewGravity(units.Meter(0))
+			w.AddForce(g)
+			return w
+		}
+
+		w := build("star", "probe")
+		if err := w.Run(units.Second(40), units.Second(1)); err != nil {
+			t.Fatal(err)
+		}
+		cp := checkpoint.New()
+		if err := w.Encode(cp.Section("world")); err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := cp.Save(&buf); err != nil {
+			t.Fatal(err)
+		}
+		loaded, err := checkpoint.Load(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		restored := build("star", "probe")
+		d, _ := loaded.Open("world")
+		if err := restored.Decode(d); err != nil {
+			t.Fatalf("adaptive=%v: Decode() error = %v", adaptive, err)
+		}
+		for _, x := range []*World{w, restored} {
+			if err := x.Run(units.Second(40), units.Second(1)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if restored.Time() != w.Time() || restored.Steps() != w.Steps() {
+			t.Errorf("adaptive=%v: restored at t = %v after %d steps, original at %v after %d",
+				adaptive, restored.Time().Val(), restored.Steps(), w.Time().Val(), w.Steps())
+		}
+		for i := 0; i < w.Len(); i++ {
+			if restored.Position(i).ToArray() != w.Position(i).ToArray() || restored.Velocity(i).ToArray() != w.Velocity(i).ToArray() {
+				t.Errorf("adaptive=%v: entity %d restored at %v, original at %v",
+					adaptive, i, restored.Position(i).ToArray(), w.Position(i).ToArray())
+			}
+		}
+
+		other := build("star", "moon")
+		d, _ = loaded.Open("world")
+		if err := other.Decode(d); err == nil {
+			t.Errorf("adaptive=%v: Decode() should reject a world with other entities", adaptive)
+		}
+		if other.Steps() != 0 {
+			t.Errorf("adaptive=%v: failed Decode() changed the world", adaptive)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Diagnostics Tests
+// -----------------------------------------------------------------------------
+
+// trap is a custom isotropic harmonic force F = -k r without a Potential.
+type trap struct{ k float64 }
+
+func (tr trap) Accumulate(s *State, f []float64) {
+	for i := range s.Position {
+		f[i] -= tr.k * s.Position[i]
+	}
+}
+
+func TestDiagnosticsOrbit(t *testing.T) {
+	G := constants.GravitationalConstant.Val()
+	M, m, r := 5.972e24, 1000.0, 7e6
+	v := math.Sqrt(G * M / r)
+
+	w := NewWorld(nil)
+	w.Add(Entity{Name: "earth", Mass: units.Kilogram(M)})
+	w.Add(Entity{Name: "sat", Mass: units.Kilogram(m), Position: pos(r, 0, 0), Velocity: vel(0, v, 0)})
+	g, _ := NewGravity(units.Meter(0))
+	w.AddForce(g)
+
+	// Circular orbit: U = -GMm/r, T = -U/2.
+	s := w.Snapshot()
+	if u := G * M * m / r; !almostEqual(s.Potential.Val(), -u, 1e-12*u) || !almostEqual(s.Total().Val(), -u/2, 1e-12*u) {
+		t.Errorf("U, E = %v, %v, want %v, %v", s.Potential, s.Total(), -G*M*m/r, -G*M*m/(2*r))
+	}
+	if lz := s.AngularMomentum.Z.Val(); !almostEqual(lz, m*r*v, 1e-6*m*r*v) || s.AngularMomentum.Dim() != units.KilogramMeter2PerSecond(1).Dim() {
+		t.Errorf("L = %v, want %v ẑ", s.AngularMomentum, m*r*v)
+	}
+
+	diag := NewDiagnostics()
+	w.Observe(diag)
+	w.Run(units.Hour(2), units.Second(10))
+	if len(diag.History()) != 721 {
+		t.Errorf("snapshots = %d, want 721", len(diag.History()))
+	}
+	if err := diag.CheckConservation(1e-9); err != nil {
+		t.Error(err)
+	}
+	if _, _, total := diag.Energy(); total.Len() != 721 || total.Dim() != units.Joule(1).Dim() {
+		t.Errorf("energy series = %d samples of %v", total.Len(), total.Dim())
+	}
+}
+
+func TestDiagnosticsDissipation(t *testing.T) {
+	// Drag removes energy; a hanging spring with gravity conserves it.
+	g := vector.Vector3{X: units.MeterPerSecond2(0).Value, Y: units.MeterPerSecond2(-9.81).Value, Z: units.MeterPerSecond2(0).Value}
+	build := func(drag bool) *Diagnostics {
+		w := NewWorld(nil)
+		anchor, _ := w.Add(Entity{Name: "anchor", Mass: units.Kilogram(1)})
+		bob, _ := w.Add(Entity{Name: "bob", Mass: units.Kilogram(0.5), Position: pos(0.3, -1.2, 0)})
+		pin, _ := NewPin(anchor, pos(0, 0, 0))
+		if err := w.AddConstraint(pin); err != nil {
+			t.Fatal(err)
+		}
+		spring, _ := NewSpring(anchor, bob, units.NewtonPerMeter(20), units.Meter(1), units.NewtonSecondPerMeter(0))
+		uniform, _ := NewUniform(g, bob)
+		w.AddForce(spring)
+		w.AddForce(uniform)
+		if drag {
+			d, _ := NewDrag(units.NewtonSecondPerMeter(0.2), units.Value{}, bob)
+			w.AddForce(d)
+		}
+		diag := NewDiagnostics()
+		w.Observe(diag)
+		w.Run(units.Second(5), units.Millisecond(1))
+		return diag
+	}
+
+	if dr := build(false).MaxDrift(); dr.Energy > 1e-10 {
+		t.Errorf("conservative energy drift = %v", dr.Energy)
+	}
+	diag := build(true)
+	if dr := diag.Drift(); dr.Energy > -0.01 {
+		t.Errorf("energy drift with drag = %v, want a clear loss", dr.Energy)
+	}
+	if err := diag.CheckConservation(1e-3); err == nil || !strings.Contains(err.Error(), "energy") {
+		t.Errorf("CheckConservation() = %v, want energy error", err)
+	}
+}
+
+func TestDiagnosticsCustomPotential(t *testing.T) {
+	w := NewWorld(nil)
+	w.Add(Entity{Name: "p", Mass: units.Kilogram(2), Position: pos(1, 0, 0), Velocity: vel(0, 1, 0.5)})
+	model := trap{k: 3}
+	w.AddForce(model)
+
+	diag := NewDiagnostics()
+	w.Observe(diag)
+	w.Run(units.Second(1), units.Millisecond(10))
+	// Without a potential only kinetic energy is counted, which oscillates.
+	if dr := diag.MaxDrift(); dr.Energy < 0.01 {
+		t.Errorf("kinetic-only drift = %v, want noticeable", dr.Energy)
+	}
+
+	diag = NewDiagnostics()
+	diag.SetPotential(model, PotentialFunc(func(s *State) float64 {
+		u := 0.0
+		for _, x := range s.Position {
+			u += 0.5 * model.k * x * x
+		}
+		return u
+	}))
+	w.Observe(diag)
+	w.Run(units.Second(3), units.Millisecond(10))
+	if dr := diag.MaxDrift(); dr.Energy > 1e-9 {
+		t.Errorf("energy drift with registered potential = %v", dr.Energy)
+	}
+	// The trap is an external force: momentum is not conserved, angular
+	// momentum about its centre is.
+	if dr := diag.MaxDrift(); dr.Momentum < 0.1 || dr.AngularMomentum > 1e-9 {
+		t.Errorf("momentum, angular momentum drift = %v, %v", dr.Momentum, dr.AngularMomentum)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Parallel Tests
+// -----------------------------------------------------------------------------
+
+// cluster returns the state of n bodies scattered over a 1 AU cube.
+func cluster(n int) *State {
+	rng := rand.New(random.NewSource(1))
+	s := &State{
+		Position: make([]float64, 3*n),
+		Velocity: make([]float64, 3*n),
+		Mass:     make([]float64, n),
+		Charge:   make([]float64, n),
+	}
+	for i := range s.Position {
+		s.Position[i] = 1.5e11 * rng.Float64()
+	}
+	for i := range s.Mass {
+		s.Mass[i] = 1e24 * (1 + rng.Float64())
+	}
+	return s
+}
+
+func TestGravityParallel(t *testing.T) {
+	pool := parallel.NewPool(4)
+	defer pool.Close()
+
+	s := cluster(300)
+	g, _ := NewGravity(units.Kilometer(1))
+	serial := make([]float64, 3*s.Len())
+	g.Accumulate(s, serial)
+
+	g.SetPool(pool)
+	par := make([]float64, 3*s.Len())
+	g.Accumulate(s, par)
+	for i := range serial {
+		if !almostEqual(par[i], serial[i], 1e-12*math.Abs(serial[i])) {
+			t.Fatalf("F[%d] = %v parallel, %v serial", i, par[i], serial[i])
+		}
+	}
+
+	// The parallel sum for each body runs in a fixed order, so the result
+	// does not depend on the number of workers.
+	for _, workers := range []int{1, 2, 7} {
+		p := parallel.NewPool(workers)
+		g.SetPool(p)
+		again := make([]float64, 3*s.Len())
+		g.Accumulate(s, again)
+		p.Close()
+		for i := range par {
+			if again[i] != par[i] {
+				t.Fatalf("F[%d] differs between %d and 4 workers", i, workers)
+			}
+		}
+	}
+
+	// A subset of bodies only feels the subset.
+	sub, _ := NewGravity(units.Kilometer(1), 3, 7)
+	sub.SetPool(pool)
+	f := make([]float64, 3*s.Len())
+	sub.Accumulate(s, f)
+	if f[0] != 0 || f[9] == 0 || !almostEqual(f[9], -f[21], 1e-12*math.Abs(f[9])) {
+		t.Errorf("subset forces = %v, %v, %v", f[0], f[9], f[21])
+	}
+}
+
+func BenchmarkGravity(b *testing.B) {
+	s := cluster(2000)
+	f := make([]float64, 3*s.Len())
+	b.Run("serial", func(b *testing.B) {
+		g, _ := NewGravity(units.Kilometer(1))
+		for i := 0; i < b.N; i++ {
+			g.Accumulate(s, f)
+		}
+	})
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			pool := parallel.NewPool(workers)
+			defer pool.Close()
+			g, _ := NewGravity(units.Kilometer(1))
+			g.SetPool(pool)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				g.Accumulate(s, f)
+			}
+		})
+	}
+}
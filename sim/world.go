@@ -0,0 +1,484 @@
+package sim
+
+import (
+	"context"
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/sakiphan/qsim-core/io/checkpoint"
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/solver"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	lengthDim   = units.Dimension{L: 1}
+	velocityDim = units.Dimension{L: 1, T: -1}
+)
+
+// StateLayout describes the integrator state of a World, all positions
+// followed by all velocities, for adaptive integrators with unit-aware
+// tolerances.
+//
+// Example:
+//
+//	adaptive, _ := solver.NewAdaptive(sim.StateLayout, solver.Tolerances{
+//	    units.Meter(1).Dim():          {Abs: units.Kilometer(1).Value, Rel: 1e-10},
+//	    units.MeterPerSecond(1).Dim(): {Abs: units.MeterPerSecond(1e-3).Value, Rel: 1e-10},
+//	})
+//	w := sim.NewWorld(adaptive)
+var StateLayout = solver.Blocks{lengthDim, velocityDim}
+
+// Entity is a point body: its intrinsic properties and its kinematic state.
+type Entity struct {
+	Name     string
+	Mass     units.Mass
+	Charge   units.Charge
+	Radius   units.Length
+	Position vector.Vector3 // [L]
+	Velocity vector.Vector3 // [L T⁻¹]
+}
+
+// State is the flat view of a world passed to force models. Vectors are
+// packed as x₀ y₀ z₀ x₁ y₁ z₁ …, and all values are in SI base units.
+// Force models must not modify or retain it.
+type State struct {
+	Time     float64   // s
+	Position []float64 // m
+	Velocity []float64 // m/s
+	Mass     []float64 // kg
+	Charge   []float64 // C
+}
+
+// Len returns the number of entities.
+func (s *State) Len() int {
+	return len(s.Mass)
+}
+
+// World is a set of entities moving under a set of force models.
+//
+// The state vector handed to the integrator holds all positions followed by
+// all velocities, so that position and velocity slices of the State view
+// alias it without copying.
+//
+// World is not safe for concurrent use.
+type World struct {
+	names     []string
+	radius    []float64
+	mass      []float64
+	charge    []float64
+	y         []float64 // positions (3n) then velocities (3n)
+	force     []float64 // scratch force accumulator (3n)
+	t         float64
+	steps     int
+	stepper   solver.Stepper
+	forces    []ForceModel
+	observers []Observer
+
+	instruments []Instrument
+	wallStart   time.Time  // wall-clock start of the current run
+	span        [2]float64 // simulated start and end of the current run
+	running     bool
+
+	constraints []Constraint
+	fixed       []bool    // pinned entities
+	invMass     []float64 // scratch inverse masses for the constraint solver
+	iterations  int
+	tolerance   float64
+}
+
+// NewWorld creates an empty world advanced by the given integrator; nil
+// selects RK4.
+func NewWorld(stepper solver.Stepper) *World {
+	if stepper == nil {
+		stepper = &solver.RK4{}
+	}
+	return &World{
+		stepper:    stepper,
+		iterations: DefaultConstraintIterations,
+		tolerance:  DefaultConstraintTolerance,
+	}
+}
+
+// Add adds an entity and returns its index. Returns an error if the mass is
+// not positive, the name is already taken, or the position or velocity has
+// the wrong dimension.
+func (w *World) Add(e Entity) (int, error) {
+	if !(e.Mass.Val() > 0) {
+		return 0, fmt.Errorf("entity %q: mass must be positive, got %g kg", e.Name, e.Mass.Val())
+	}
+	if e.Name != "" {
+		if _, ok := w.Index(e.Name); ok {
+			return 0, fmt.Errorf("entity %q already exists", e.Name)
+		}
+	}
+	if e.Position.X.Dim() == (units.Dimension{}) && e.Position.IsZero() {
+		e.Position = vector.Zero(lengthDim)
+	}
+	if e.Velocity.X.Dim() == (units.Dimension{}) && e.Velocity.IsZero() {
+		e.Velocity = vector.Zero(velocityDim)
+	}
+	if err := checkVector("position", e.Position, lengthDim); err != nil {
+		return 0, fmt.Errorf("entity %q: %w", e.Name, err)
+	}
+	if err := checkVector("velocity", e.Velocity, velocityDim); err != nil {
+		return 0, fmt.Errorf("entity %q: %w", e.Name, err)
+	}
+
+	n := len(w.mass)
+	y := make([]float64, 6*(n+1))
+	copy(y, w.y[:3*n])
+	copy(y[3*n+3:], w.y[3*n:])
+	p, v := e.Position.ToArray(), e.Velocity.ToArray()
+	copy(y[3*n:], p[:])
+	copy(y[6*n+3:], v[:])
+
+	w.y = y
+	w.force = make([]float64, 3*(n+1))
+	w.names = append(w.names, e.Name)
+	w.radius = append(w.radius, e.Radius.Val())
+	w.mass = append(w.mass, e.Mass.Val())
+	w.charge = append(w.charge, e.Charge.Val())
+	w.fixed = append(w.fixed, false)
+	return n, nil
+}
+
+// AddForce adds a force model acting on the world's entities. Returns an
+// error if the model refers to an entity the world does not have.
+func (w *World) AddForce(f ForceModel) error {
+	if err := w.checkEntities("force", f); err != nil {
+		return err
+	}
+	w.forces = append(w.forces, f)
+	return nil
+}
+
+// joiner is implemented by force models and constraints that report the
+// entities they act on; nil means all of them.
+type joiner interface {
+	entities() []int
+}
+
+// checkEntities returns an error if x is a joiner referring to an entity
+// index out of range.
+func (w *World) checkEntities(what string, x any) error {
+	j, ok := x.(joiner)
+	if !ok {
+		return nil
+	}
+	for _, i := range j.entities() {
+		if i < 0 || i >= w.Len() {
+			return fmt.Errorf("%s refers to entity %d, world has %d", what, i, w.Len())
+		}
+	}
+	return nil
+}
+
+// Observe registers an observer, called after every step.
+func (w *World) Observe(o Observer) {
+	w.observers = append(w.observers, o)
+}
+
+// Len returns the number of entities.
+func (w *World) Len() int {
+	return len(w.mass)
+}
+
+// Index returns the index of the named entity.
+func (w *World) Index(name string) (int, bool) {
+	for i, n := range w.names {
+		if n == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Entity returns a snapshot of entity i.
+func (w *World) Entity(i int) Entity {
+	return Entity{
+		Name:     w.names[i],
+		Mass:     units.Kilogram(w.mass[i]),
+		Charge:   units.Coulomb(w.charge[i]),
+		Radius:   units.Meter(w.radius[i]),
+		Position: w.Position(i),
+		Velocity: w.Velocity(i),
+	}
+}
+
+// Position returns the position of entity i.
+func (w *World) Position(i int) vector.Vector3 {
+	return vec3(w.y[3*i:], lengthDim)
+}
+
+// Velocity returns the velocity of entity i.
+func (w *World) Velocity(i int) vector.Vector3 {
+	return vec3(w.y[3*len(w.mass)+3*i:], velocityDim)
+}
+
+// SetPosition sets the position of entity i.
+func (w *World) SetPosition(i int, r vector.Vector3) error {
+	if err := checkVector("position", r, lengthDim); err != nil {
+		return err
+	}
+	p := r.ToArray()
+	copy(w.y[3*i:3*i+3], p[:])
+	return nil
+}
+
+// SetVelocity sets the velocity of entity i.
+func (w *World) SetVelocity(i int, v vector.Vector3) error {
+	if err := checkVector("velocity", v, velocityDim); err != nil {
+		return err
+	}
+	u := v.ToArray()
+	k := 3*len(w.mass) + 3*i
+	copy(w.y[k:k+3], u[:])
+	return nil
+}
+
+// Time returns the simulated time.
+func (w *World) Time() units.Time {
+	return units.Second(w.t)
+}
+
+// Steps returns the number of steps taken.
+func (w *World) Steps() int {
+	return w.steps
+}
+
+// Force returns the total force on entity i in the current state.
+func (w *World) Force(i int) vector.Vector3 {
+	s := w.state(w.t, w.y)
+	clear(w.force)
+	for _, f := range w.forces {
+		f.Accumulate(&s, w.force)
+	}
+	return vec3(w.force[3*i:], units.Newton(1).Dim())
+}
+
+// Encode appends the world's entities, time and state to a checkpoint
+// section: the step count, the time, and each entity's name, mass, charge
+// and radius, followed by the state vector bit for bit. An integrator that
+// implements encoding.BinaryMarshaler, such as *solver.Adaptive, has its
+// state appended last. Returns an error only if that marshalling fails.
+func (w *World) Encode(e *checkpoint.Encoder) error {
+	e.Int(w.steps)
+	e.Value(w.Time().Value)
+	e.Int(len(w.mass))
+	for i := range w.mass {
+		e.String(w.names[i])
+		e.Float64(w.mass[i])
+		e.Float64(w.charge[i])
+		e.Float64(w.radius[i])
+	}
+	e.Float64s(w.y)
+	if m, ok := w.stepper.(encoding.BinaryMarshaler); ok {
+		return e.Marshaler(m)
+	}
+	return nil
+}
+
+// Decode restores a world saved by Encode: the step count, the time, the
+// entities' mass, charge and radius, the state vector and any integrator
+// state. The world must already hold the same entities in the same order,
+// with its forces and constraints set up as before. Returns an error if
+// the section is malformed or the entities do not match, in which case the
+// world is left unchanged.
+func (w *World) Decode(d *checkpoint.Decoder) error {
+	steps := d.Int()
+	t := d.Quantity(units.Dimension{T: 1})
+	n := d.Int()
+	if d.Err() == nil && n != w.Len() {
+		return fmt.Errorf("checkpoint has %d entities, world has %d", n, w.Len())
+	}
+	mass, charge, radius := make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := 0; i < n && d.Err() == nil; i++ {
+		if name := d.String(); d.Err() == nil && name != w.names[i] {
+			return fmt.Errorf("checkpoint entity %d is %q, world has %q", i, name, w.names[i])
+		}
+		mass[i], charge[i], radius[i] = d.Float64(), d.Float64(), d.Float64()
+	}
+	y := d.Float64s()
+	if d.Err() == nil && len(y) != len(w.y) {
+		return fmt.Errorf("checkpoint state has %d components, world has %d", len(y), len(w.y))
+	}
+	if u, ok := w.stepper.(encoding.BinaryUnmarshaler); ok {
+		d.Unmarshaler(u)
+	}
+	if err := d.Err(); err != nil {
+		return err
+	}
+	w.steps, w.t = steps, t.Val()
+	copy(w.mass, mass)
+	copy(w.charge, charge)
+	copy(w.radius, radius)
+	copy(w.y, y)
+	return nil
+}
+
+// Step advances the world by one step of size h, projects the state onto
+// the constraints and notifies observers.
+func (w *World) Step(h units.Time) error {
+	if !(h.Val() > 0) {
+		return fmt.Errorf("step size must be positive, got %g s", h.Val())
+	}
+	w.stepper.Step(w.derivative, w.t, h.Val(), w.y)
+	if s, ok := w.stepper.(interface{ Err() error }); ok && s.Err() != nil {
+		return s.Err()
+	}
+	w.stepped(h.Val())
+	return nil
+}
+
+// stepped finishes a step of size h whose integration has updated y.
+func (w *World) stepped(h float64) {
+	w.enforce()
+	w.t += h
+	w.steps++
+	w.notify()
+	w.report(Stepped, h, nil)
+}
+
+// Run advances the world by duration in steps of h, shortening the last
+// step to end exactly on time. Observers are notified of the initial state
+// if no step has been taken yet, and after every step.
+//
+// Example:
+//
+//	w.Observe(sim.Every(10, recorder))
+//	err := w.Run(units.Day(365), units.Hour(1))
+func (w *World) Run(duration, h units.Time) error {
+	return w.RunContext(context.Background(), duration, h)
+}
+
+// RunContext is like Run but checks ctx before every step. If ctx is done,
+// it returns a *solver.Interrupted giving the time reached and the number
+// of steps taken by this call. The world keeps the state of the last
+// completed step, so the run is resumed by calling RunContext again with
+// the remaining duration.
+//
+// A *solver.Adaptive integrator also checks ctx before each of its
+// internal steps, so a long step h can be interrupted part way. The world
+// then holds the state at the last accepted internal step, between two of
+// its own steps, and observers are not notified of it.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//	defer cancel()
+//	end := w.Time().Val() + units.Year(100).Val()
+//	if err := w.RunContext(ctx, units.Year(100), units.Hour(1)); errors.Is(err, context.DeadlineExceeded) {
+//	    cp := checkpoint.New()
+//	    w.Encode(cp.Section("world"))
+//	    cp.Save(f)
+//	}
+//
+//	// ... after a restart, rebuild w with the same entities and forces
+//	cp, _ := checkpoint.Load(f)
+//	d, _ := cp.Open("world")
+//	if err := w.Decode(d); err != nil { ... }
+//	err := w.RunContext(ctx, units.Second(end-w.Time().Val()), units.Hour(1))
+func (w *World) RunContext(ctx context.Context, duration, h units.Time) error {
+	if !(h.Val() > 0) {
+		return fmt.Errorf("step size must be positive, got %g s", h.Val())
+	}
+	if duration.Val() < 0 {
+		return fmt.Errorf("duration must be non-negative, got %g s", duration.Val())
+	}
+	if w.steps == 0 {
+		w.notify()
+	}
+	end := w.t + duration.Val()
+	w.wallStart, w.span, w.running = time.Now(), [2]float64{w.t, end}, true
+	defer func() { w.wallStart, w.running = time.Time{}, false }()
+	w.report(Started, 0, nil)
+
+	err := w.run(ctx, end, h.Val())
+	w.report(Finished, 0, err)
+	return err
+}
+
+func (w *World) run(ctx context.Context, end, h float64) error {
+	for steps := 0; end-w.t > 1e-12*math.Max(math.Abs(end), h); steps++ {
+		if err := ctx.Err(); err != nil {
+			return &solver.Interrupted{T: w.t, Steps: steps, Err: err}
+		}
+		dt := math.Min(h, end-w.t)
+		a, ok := w.stepper.(*solver.Adaptive)
+		if !ok {
+			if err := w.Step(units.Second(dt)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := a.Err(); err != nil {
+			return err
+		}
+		if err := a.AdvanceContext(ctx, w.derivative, w.t, w.t+dt, w.y); err != nil {
+			var stop *solver.Interrupted
+			if !errors.As(err, &stop) {
+				return err
+			}
+			w.t = stop.T
+			w.enforce()
+			return &solver.Interrupted{T: w.t, Steps: steps, Err: stop.Err}
+		}
+		w.stepped(dt)
+	}
+	w.t = end
+	return nil
+}
+
+// derivative is the right-hand side of the equations of motion:
+// ṙ = v, v̇ = F/m, with the accelerations corrected for the constraints.
+// Pinned entities do not move.
+func (w *World) derivative(t float64, y, dydt []float64) {
+	n := len(w.mass)
+	s := w.state(t, y)
+	clear(w.force)
+	for _, f := range w.forces {
+		f.Accumulate(&s, w.force)
+	}
+	copy(dydt[:3*n], y[3*n:])
+	for i, m := range w.mass {
+		for k := 0; k < 3; k++ {
+			dydt[3*n+3*i+k] = w.force[3*i+k] / m
+			if w.fixed[i] {
+				dydt[3*i+k], dydt[3*n+3*i+k] = 0, 0
+			}
+		}
+	}
+	if len(w.constraints) > 0 {
+		w.constrain(y[:3*n], y[3*n:], dydt[3*n:])
+	}
+}
+
+func (w *World) state(t float64, y []float64) State {
+	n := len(w.mass)
+	return State{Time: t, Position: y[:3*n], Velocity: y[3*n:], Mass: w.mass, Charge: w.charge}
+}
+
+func (w *World) notify() {
+	for _, o := range w.observers {
+		o.Observe(w)
+	}
+}
+
+func vec3(a []float64, dim units.Dimension) vector.Vector3 {
+	return vector.Vector3{
+		X: units.NewValue(a[0], dim),
+		Y: units.NewValue(a[1], dim),
+		Z: units.NewValue(a[2], dim),
+	}
+}
+
+func checkVector(name string, v vector.Vector3, dim units.Dimension) error {
+	if v.X.Dim() != dim || v.Y.Dim() != dim || v.Z.Dim() != dim {
+		return fmt.Errorf("%s must have dimension %s, got %s", name, dim, v.Dim())
+	}
+	return nil
+}
@@ -0,0 +1,405 @@
+package solver
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// State Layout
+// -----------------------------------------------------------------------------
+
+// Layout assigns a physical dimension to each component of a state vector,
+// so that error estimates can be weighed against tolerances in units.
+type Layout interface {
+	// Dims returns the dimension of each of the n components, or an error
+	// if the layout does not fit a state of length n.
+	Dims(n int) ([]units.Dimension, error)
+}
+
+// Components is a Layout listing the dimension of every component.
+type Components []units.Dimension
+
+// Dims returns the components, which must number n.
+func (c Components) Dims(n int) ([]units.Dimension, error) {
+	if len(c) != n {
+		return nil, fmt.Errorf("layout has %d components, state has %d", len(c), n)
+	}
+	return c, nil
+}
+
+// Blocks is a Layout splitting the state into equal consecutive blocks of
+// one dimension each, such as all positions followed by all velocities.
+type Blocks []units.Dimension
+
+// Dims returns the block dimensions repeated over a state of length n,
+// which must be a multiple of the number of blocks.
+func (b Blocks) Dims(n int) ([]units.Dimension, error) {
+	if len(b) == 0 || n%len(b) != 0 {
+		return nil, fmt.Errorf("state of length %d does not split into %d blocks", n, len(b))
+	}
+	size := n / len(b)
+	dims := make([]units.Dimension, n)
+	for i := range dims {
+		dims[i] = b[i/size]
+	}
+	return dims, nil
+}
+
+// -----------------------------------------------------------------------------
+// Tolerances
+// -----------------------------------------------------------------------------
+
+// Tolerance is the local error accepted for a state component y:
+// |err| ≤ Abs + Rel |y|.
+type Tolerance struct {
+	Abs units.Value
+	Rel float64
+}
+
+// Tolerances maps each dimension in a state to its tolerance.
+//
+// Example:
+//
+//	tol := solver.Tolerances{
+//	    units.Meter(1).Dim():          {Abs: units.Millimeter(1).Value, Rel: 1e-9},
+//	    units.MeterPerSecond(1).Dim(): {Abs: units.MeterPerSecond(1e-6).Value, Rel: 1e-9},
+//	}
+type Tolerances map[units.Dimension]Tolerance
+
+// check returns an error if any dimension in dims lacks a valid tolerance.
+func (tol Tolerances) check(dims []units.Dimension) error {
+	for _, d := range dims {
+		t, ok := tol[d]
+		if !ok {
+			return fmt.Errorf("no tolerance for state components of dimension %s", d)
+		}
+		if t.Abs.Val() != 0 && t.Abs.Dim() != d {
+			return fmt.Errorf("absolute tolerance for dimension %s has dimension %s", d, t.Abs.Dim())
+		}
+		if t.Abs.Val() < 0 || t.Rel < 0 || t.Abs.Val() == 0 && t.Rel == 0 {
+			return fmt.Errorf("tolerance for dimension %s must be non-negative and not all zero, got abs %g, rel %g",
+				d, t.Abs.Val(), t.Rel)
+		}
+	}
+	return nil
+}
+
+// Norm returns the weighted RMS error norm of a step from y0 to y1 with
+// local error estimate e:
+//
+//	‖e‖ = √( (1/n) Σᵢ (eᵢ / (Absᵢ + Relᵢ max(|y0ᵢ|, |y1ᵢ|)))² )
+//
+// where Absᵢ and Relᵢ are the tolerances for the dimension of component i.
+// A step is acceptable when ‖e‖ ≤ 1, so position errors are measured in
+// meters against a tolerance in meters and velocity errors in m/s against
+// one in m/s, without nondimensionalizing the state.
+func (tol Tolerances) Norm(layout Layout, y0, y1, e []float64) (float64, error) {
+	dims, err := layout.Dims(len(e))
+	if err != nil {
+		return 0, err
+	}
+	if err := tol.check(dims); err != nil {
+		return 0, err
+	}
+	return tol.norm(dims, y0, y1, e), nil
+}
+
+func (tol Tolerances) norm(dims []units.Dimension, y0, y1, e []float64) float64 {
+	sum := 0.0
+	for i, d := range dims {
+		t := tol[d]
+		sc := t.Abs.Val() + t.Rel*math.Max(math.Abs(y0[i]), math.Abs(y1[i]))
+		r := e[i] / sc
+		sum += r * r
+	}
+	return math.Sqrt(sum / float64(len(dims)))
+}
+
+// -----------------------------------------------------------------------------
+// Adaptive Integrator
+// -----------------------------------------------------------------------------
+
+// Adaptive integrates with the Dormand-Prince 5(4) embedded Runge-Kutta
+// pair, choosing its own step size so that the local error stays within
+// unit-aware tolerances.
+//
+// After each trial step the embedded fourth-order solution provides an
+// error estimate e, whose norm against Tolerances decides acceptance. The
+// next step size is
+//
+//	h ← h · clamp(Safety · ‖e‖^(-1/5), MinFactor, MaxFactor)
+//
+// Adaptive implements Stepper: Step(f, t, h, y) advances from t to t + h in
+// as many internal steps as needed, so it can drive any code written for a
+// fixed-step integrator, with h acting as the output interval. Errors are
+// sticky and reported by Err. Adaptive is not safe for concurrent use.
+//
+// References:
+//   - Dormand, Prince. "A family of embedded Runge-Kutta formulae",
+//     J. Comput. Appl. Math. 6, 19 (1980)
+//   - Hairer, Nørsett, Wanner. "Solving Ordinary Differential Equations I",
+//     2nd ed., Sec. II.4
+type Adaptive struct {
+	Layout     Layout
+	Tolerances Tolerances
+
+	Safety    float64 // default 0.9
+	MinFactor float64 // smallest step shrink per trial, default 0.2
+	MaxFactor float64 // largest step growth per trial, default 5
+	MaxSteps  int     // internal step limit per Advance, default 1e6
+
+	Accepted, Rejected int // step counters
+
+	h       float64 // next trial step size; 0 before the first step
+	errNorm float64 // error norm of the last accepted step
+	err     error
+	dims    []units.Dimension
+	k       [7][]float64
+	tmp     []float64
+	y5      []float64
+	e       []float64
+}
+
+// NewAdaptive creates an adaptive integrator for states with the given
+// layout. Returns an error if a dimension of a Components or Blocks layout
+// lacks a valid tolerance.
+func NewAdaptive(layout Layout, tol Tolerances) (*Adaptive, error) {
+	var dims []units.Dimension
+	switch l := layout.(type) {
+	case Components:
+		dims = l
+	case Blocks:
+		dims = l
+	}
+	if err := tol.check(dims); err != nil {
+		return nil, err
+	}
+	return &Adaptive{Layout: layout, Tolerances: tol}, nil
+}
+
+// Step advances y from t to t + h, recording any error for Err.
+func (a *Adaptive) Step(f Derivative, t, h float64, y []float64) {
+	if a.err != nil {
+		return
+	}
+	a.err = a.Advance(f, t, t+h, y)
+}
+
+// Err returns the first error encountered by Step, if any.
+func (a *Adaptive) Err() error {
+	return a.err
+}
+
+// StepSize returns the step size the controller will try next.
+func (a *Adaptive) StepSize() float64 {
+	return a.h
+}
+
+// SetStepSize sets the step size the controller will try next. Zero lets
+// the next Advance guess one. Returns an error if h is negative or not
+// finite.
+func (a *Adaptive) SetStepSize(h float64) error {
+	if !(h >= 0) || math.IsInf(h, 1) {
+		return fmt.Errorf("step size must be non-negative and finite, got %g", h)
+	}
+	a.h = h
+	return nil
+}
+
+// ErrorNorm returns the norm of the local error estimate of the last
+// accepted step against the tolerances, between 0 and 1.
+func (a *Adaptive) ErrorNorm() float64 {
+	return a.errNorm
+}
+
+// adaptiveStateSize is the length of the controller state encoded by
+// MarshalBinary.
+const adaptiveStateSize = 32
+
+// MarshalBinary encodes the controller state in 32 bytes: the next step
+// size, the last error norm and the Accepted and Rejected counters. With
+// the time and state vector saved alongside it, a run restored by
+// UnmarshalBinary continues with the same steps. The configuration fields
+// are not included.
+func (a *Adaptive) MarshalBinary() ([]byte, error) {
+	b := make([]byte, adaptiveStateSize)
+	binary.LittleEndian.PutUint64(b[0:], math.Float64bits(a.h))
+	binary.LittleEndian.PutUint64(b[8:], math.Float64bits(a.errNorm))
+	binary.LittleEndian.PutUint64(b[16:], uint64(a.Accepted))
+	binary.LittleEndian.PutUint64(b[24:], uint64(a.Rejected))
+	return b, nil
+}
+
+// UnmarshalBinary restores a controller state encoded by MarshalBinary and
+// clears any error recorded by Step.
+func (a *Adaptive) UnmarshalBinary(b []byte) error {
+	if len(b) != adaptiveStateSize {
+		return fmt.Errorf("adaptive controller state must be %d bytes, got %d", adaptiveStateSize, len(b))
+	}
+	h := math.Float64frombits(binary.LittleEndian.Uint64(b[0:]))
+	if err := a.SetStepSize(h); err != nil {
+		return err
+	}
+	a.errNorm = math.Float64frombits(binary.LittleEndian.Uint64(b[8:]))
+	a.Accepted = int(binary.LittleEndian.Uint64(b[16:]))
+	a.Rejected = int(binary.LittleEndian.Uint64(b[24:]))
+	a.err = nil
+	return nil
+}
+
+// Advance integrates y in place from t0 to t1 with adaptive steps. Returns
+// an error if the layout does not fit y, a tolerance is missing, the step
+// size underflows or MaxSteps is exceeded.
+func (a *Adaptive) Advance(f Derivative, t0, t1 float64, y []float64) error {
+	return a.AdvanceContext(context.Background(), f, t0, t1, y)
+}
+
+// AdvanceContext is like Advance but checks ctx before every trial step.
+// If ctx is done, it returns an *Interrupted with y holding the state at
+// the last accepted step. The controller keeps its step size, so calling
+// AdvanceContext again from the interruption time resumes where it left
+// off.
+func (a *Adaptive) AdvanceContext(ctx context.Context, f Derivative, t0, t1 float64, y []float64) error {
+	n := len(y)
+	if len(a.dims) != n {
+		dims, err := a.Layout.Dims(n)
+		if err != nil {
+			return err
+		}
+		if err := a.Tolerances.check(dims); err != nil {
+			return err
+		}
+		a.dims = dims
+	}
+	for i := range a.k {
+		a.k[i] = resize(a.k[i], n)
+	}
+	a.tmp, a.y5, a.e = resize(a.tmp, n), resize(a.y5, n), resize(a.e, n)
+
+	safety, minF, maxF, maxSteps := a.Safety, a.MinFactor, a.MaxFactor, a.MaxSteps
+	if safety <= 0 {
+		safety = 0.9
+	}
+	if minF <= 0 {
+		minF = 0.2
+	}
+	if maxF <= 0 {
+		maxF = 5
+	}
+	if maxSteps <= 0 {
+		maxSteps = 1000000
+	}
+
+	span := t1 - t0
+	if span == 0 {
+		return nil
+	}
+	dir := math.Copysign(1, span)
+	if a.h == 0 {
+		a.h = a.initialStep(f, t0, y, math.Abs(span))
+	}
+
+	t := t0
+	accepted := a.Accepted
+	for steps := 0; dir*(t1-t) > 0; steps++ {
+		if err := ctx.Err(); err != nil {
+			return &Interrupted{T: t, Steps: a.Accepted - accepted, Err: err}
+		}
+		if steps == maxSteps {
+			return fmt.Errorf("adaptive integration exceeded %d steps at t = %g", maxSteps, t)
+		}
+		h := math.Min(a.h, math.Abs(t1-t))
+		last := h == math.Abs(t1-t)
+		if h <= 1e-14*math.Max(math.Abs(t), math.Abs(span)) {
+			return fmt.Errorf("adaptive step size underflow at t = %g", t)
+		}
+
+		a.trial(f, t, dir*h, y)
+		errNorm := a.Tolerances.norm(a.dims, y, a.y5, a.e)
+
+		factor := maxF
+		if errNorm > 0 {
+			factor = math.Min(maxF, math.Max(minF, safety*math.Pow(errNorm, -0.2)))
+		}
+		if errNorm <= 1 {
+			copy(y, a.y5)
+			a.errNorm = errNorm
+			if last {
+				t = t1
+			} else {
+				t += dir * h
+			}
+			a.Accepted++
+			next := h * factor
+			if last && h < a.h {
+				// The step was shortened to land on t1; don't let that
+				// shrink the next one.
+				next = math.Max(next, a.h)
+			}
+			a.h = next
+		} else {
+			a.Rejected++
+			a.h = h * math.Min(1, factor)
+		}
+	}
+	return nil
+}
+
+// initialStep guesses a first step size from the tolerance-weighted size of
+// y and f(t0, y).
+func (a *Adaptive) initialStep(f Derivative, t0 float64, y []float64, span float64) float64 {
+	f(t0, y, a.k[0])
+	d0 := a.Tolerances.norm(a.dims, y, y, y)
+	d1 := a.Tolerances.norm(a.dims, y, y, a.k[0])
+	h := 0.01 * span
+	if d0 > 1e-5 && d1 > 1e-5 {
+		h = math.Min(h, 0.01*d0/d1)
+	}
+	return h
+}
+
+// Dormand-Prince 5(4) coefficients.
+var (
+	dpC = [7]float64{0, 1.0 / 5, 3.0 / 10, 4.0 / 5, 8.0 / 9, 1, 1}
+	dpA = [7][6]float64{
+		{},
+		{1.0 / 5},
+		{3.0 / 40, 9.0 / 40},
+		{44.0 / 45, -56.0 / 15, 32.0 / 9},
+		{19372.0 / 6561, -25360.0 / 2187, 64448.0 / 6561, -212.0 / 729},
+		{9017.0 / 3168, -355.0 / 33, 46732.0 / 5247, 49.0 / 176, -5103.0 / 18656},
+		{35.0 / 384, 0, 500.0 / 1113, 125.0 / 192, -2187.0 / 6784, 11.0 / 84},
+	}
+	// dpE holds the differences between the fifth- and fourth-order weights.
+	dpE = [7]float64{71.0 / 57600, 0, -71.0 / 16695, 71.0 / 1920, -17253.0 / 339200, 22.0 / 525, -1.0 / 40}
+)
+
+// trial computes the fifth-order solution a.y5 and error estimate a.e for a
+// step of size h from (t, y).
+func (a *Adaptive) trial(f Derivative, t, h float64, y []float64) {
+	f(t, y, a.k[0])
+	for s := 1; s < 7; s++ {
+		for i := range y {
+			sum := 0.0
+			for j := 0; j < s; j++ {
+				sum += dpA[s][j] * a.k[j][i]
+			}
+			a.tmp[i] = y[i] + h*sum
+		}
+		f(t+dpC[s]*h, a.tmp, a.k[s])
+	}
+	// The last stage is evaluated at the fifth-order solution itself.
+	copy(a.y5, a.tmp)
+	for i := range y {
+		sum := 0.0
+		for s := 0; s < 7; s++ {
+			sum += dpE[s] * a.k[s][i]
+		}
+		a.e[i] = h * sum
+	}
+}
@@ -0,0 +1,137 @@
+package solver
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	lengthDim   = units.Meter(1).Dim()
+	velocityDim = units.MeterPerSecond(1).Dim()
+)
+
+func tolerances(abs float64) Tolerances {
+	return Tolerances{
+		lengthDim:   {Abs: units.Meter(abs).Value},
+		velocityDim: {Abs: units.MeterPerSecond(abs).Value},
+	}
+}
+
+// kepler is the planar two-body problem with GM = 1, state (x, y, vx, vy).
+func kepler(t float64, y, dydt []float64) {
+	r3 := math.Pow(y[0]*y[0]+y[1]*y[1], 1.5)
+	dydt[0], dydt[1] = y[2], y[3]
+	dydt[2], dydt[3] = -y[0]/r3, -y[1]/r3
+}
+
+func TestAdaptive_HarmonicOscillator(t *testing.T) {
+	a, err := NewAdaptive(Components{lengthDim, velocityDim}, tolerances(1e-10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := []float64{1, 0}
+	if err := a.Advance(harmonic, 0, 10, y); err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(y[0], math.Cos(10), 1e-8) || !almostEqual(y[1], -math.Sin(10), 1e-8) {
+		t.Errorf("y(10) = %v, want (%v, %v)", y, math.Cos(10), -math.Sin(10))
+	}
+	if a.Accepted == 0 || a.Accepted > 1000 {
+		t.Errorf("accepted steps = %d", a.Accepted)
+	}
+}
+
+func TestAdaptive_EccentricOrbit(t *testing.T) {
+	// An e = 0.9 orbit with a = 1 returns to periapsis after T = 2π. The
+	// step size must shrink by orders of magnitude at periapsis.
+	e := 0.9
+	y := []float64{1 - e, 0, 0, math.Sqrt((1 + e) / (1 - e))}
+	a, _ := NewAdaptive(Blocks{lengthDim, velocityDim}, Tolerances{
+		lengthDim:   {Abs: units.Meter(1e-12).Value, Rel: 1e-12},
+		velocityDim: {Abs: units.MeterPerSecond(1e-12).Value, Rel: 1e-12},
+	})
+
+	// Use it as a fixed-interval Stepper with 100 outputs.
+	hmin, hmax := math.Inf(1), 0.0
+	h := 2 * math.Pi / 100
+	for i := 0; i < 100; i++ {
+		a.Step(kepler, float64(i)*h, h, y)
+		hmin, hmax = math.Min(hmin, a.StepSize()), math.Max(hmax, a.StepSize())
+	}
+	if a.Err() != nil {
+		t.Fatal(a.Err())
+	}
+	if !almostEqual(y[0], 1-e, 1e-7) || !almostEqual(y[1], 0, 1e-7) {
+		t.Errorf("position after one period = (%v, %v), want (%v, 0)", y[0], y[1], 1-e)
+	}
+	if hmax/hmin < 10 {
+		t.Errorf("step size ranged over [%g, %g], want a wider range", hmin, hmax)
+	}
+}
+
+func TestAdaptive_ToleranceUnits(t *testing.T) {
+	// The same physical tolerance gives the same steps whether written in
+	// meters or kilometers, and a looser tolerance takes fewer steps.
+	run := func(tol Tolerances) int {
+		a, err := NewAdaptive(Components{lengthDim, velocityDim}, tol)
+		if err != nil {
+			t.Fatal(err)
+		}
+		y := []float64{1, 0}
+		a.Advance(harmonic, 0, 20, y)
+		return a.Accepted + a.Rejected
+	}
+	meters := run(Tolerances{
+		lengthDim:   {Abs: units.Meter(1e-6).Value},
+		velocityDim: {Abs: units.MeterPerSecond(1e-6).Value},
+	})
+	kilometers := run(Tolerances{
+		lengthDim:   {Abs: units.Kilometer(1e-9).Value},
+		velocityDim: {Abs: units.MeterPerSecond(1e-6).Value},
+	})
+	loose := run(tolerances(1e-3))
+	if meters != kilometers {
+		t.Errorf("steps with 1 µm = %d, with 1e-9 km = %d", meters, kilometers)
+	}
+	if loose >= meters {
+		t.Errorf("loose tolerance took %d steps, tight %d", loose, meters)
+	}
+}
+
+func TestTolerancesNorm(t *testing.T) {
+	tol := Tolerances{
+		lengthDim:   {Abs: units.Millimeter(1).Value},
+		velocityDim: {Rel: 0.5},
+	}
+	// Errors of 2 mm and 1 m/s on |v| = 4 m/s: ratios 2 and 0.5.
+	got, err := tol.Norm(Components{lengthDim, velocityDim}, []float64{0, 4}, []float64{0, 3}, []float64{0.002, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := math.Sqrt((4 + 0.25) / 2); !almostEqual(got, want, 1e-12) {
+		t.Errorf("Norm() = %v, want %v", got, want)
+	}
+
+	if _, err := tol.Norm(Blocks{lengthDim, velocityDim}, nil, nil, make([]float64, 3)); err == nil {
+		t.Error("Norm() should fail when blocks do not divide the state")
+	}
+}
+
+func TestAdaptive_Errors(t *testing.T) {
+	if _, err := NewAdaptive(Components{lengthDim, velocityDim}, Tolerances{lengthDim: {Rel: 1e-6}}); err == nil ||
+		!strings.Contains(err.Error(), "no tolerance") {
+		t.Errorf("NewAdaptive() error = %v, want missing tolerance", err)
+	}
+	if _, err := NewAdaptive(Components{lengthDim}, Tolerances{lengthDim: {Abs: units.Second(1).Value}}); err == nil {
+		t.Error("NewAdaptive() should reject an absolute tolerance in seconds for a length")
+	}
+
+	a, _ := NewAdaptive(Components{lengthDim, velocityDim}, tolerances(1e-6))
+	a.Step(harmonic, 0, 1, make([]float64, 3))
+	if a.Err() == nil {
+		t.Error("Step() should record a layout mismatch")
+	}
+}
@@ -0,0 +1,53 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+)
+
+// Interrupted is the error returned when a computation is stopped by its
+// context. The state it was working on holds the partial result reached at
+// time T, so the computation can be resumed from there.
+//
+// Example:
+//
+//	err := solver.IntegrateContext(ctx, &solver.RK4{}, f, 0, 10, 1000, y)
+//	var stop *solver.Interrupted
+//	if errors.As(err, &stop) {
+//	    // y holds the state at stop.T; finish later with
+//	    // solver.IntegrateContext(ctx, s, f, stop.T, 10, 1000-stop.Steps, y)
+//	}
+type Interrupted struct {
+	T     float64 // time reached
+	Steps int     // steps completed before the interruption
+	Err   error   // the context's error
+}
+
+func (e *Interrupted) Error() string {
+	return fmt.Sprintf("interrupted at t = %g after %d steps: %v", e.T, e.Steps, e.Err)
+}
+
+// Unwrap returns the context's error, so errors.Is(err, context.Canceled)
+// and errors.Is(err, context.DeadlineExceeded) work on an Interrupted.
+func (e *Interrupted) Unwrap() error {
+	return e.Err
+}
+
+// IntegrateContext is like Integrate but checks ctx before every step. If
+// ctx is done, it returns an *Interrupted with y holding the state at the
+// last completed step; passing that time as t0 and the remaining number of
+// steps continues the same step sequence.
+func IntegrateContext(ctx context.Context, s Stepper, f Derivative, t0, t1 float64, n int, y []float64) error {
+	if n <= 0 {
+		return fmt.Errorf("number of steps must be positive, got %d", n)
+	}
+	h := (t1 - t0) / float64(n)
+	for i := 0; i < n; i++ {
+		t := t0 + float64(i)*h
+		if err := ctx.Err(); err != nil {
+			return &Interrupted{T: t, Steps: i, Err: err}
+		}
+		s.Step(f, t, h, y)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+// cancelAfter returns a derivative that cancels the context after n calls
+// of f.
+func cancelAfter(n int, f Derivative) (context.Context, Derivative) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	return ctx, func(t float64, y, dydt []float64) {
+		if calls++; calls == n {
+			cancel()
+		}
+		f(t, y, dydt)
+	}
+}
+
+func TestIntegrateContext_Resume(t *testing.T) {
+	want := []float64{1, 0}
+	Integrate(&RK4{}, harmonic, 0, 10, 1000, want)
+
+	// RK4 makes four evaluations per step, so cancelling on the 1000th
+	// stops the run after 250 steps.
+	ctx, f := cancelAfter(1000, harmonic)
+	y := []float64{1, 0}
+	s := &RK4{}
+	err := IntegrateContext(ctx, s, f, 0, 10, 1000, y)
+	var stop *Interrupted
+	if !errors.As(err, &stop) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want an Interrupted wrapping context.Canceled", err)
+	}
+	if stop.Steps != 250 || !almostEqual(stop.T, 2.5, 1e-12) {
+		t.Errorf("interrupted after %d steps at t = %v, want 250 at 2.5", stop.Steps, stop.T)
+	}
+	if !almostEqual(y[0], math.Cos(2.5), 1e-9) {
+		t.Errorf("partial x = %v, want cos(2.5) = %v", y[0], math.Cos(2.5))
+	}
+
+	if err := IntegrateContext(context.Background(), s, harmonic, stop.T, 10, 1000-stop.Steps, y); err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(y[0], want[0], 1e-12) || !almostEqual(y[1], want[1], 1e-12) {
+		t.Errorf("resumed y = %v, uninterrupted %v", y, want)
+	}
+}
+
+func TestAdvanceContext_Resume(t *testing.T) {
+	newAdaptive := func() *Adaptive {
+		a, _ := NewAdaptive(Components{lengthDim, velocityDim}, tolerances(1e-10))
+		return a
+	}
+	want := []float64{1, 0}
+	ref := newAdaptive()
+	ref.Advance(harmonic, 0, 20, want)
+
+	ctx, f := cancelAfter(200, harmonic)
+	a := newAdaptive()
+	y := []float64{1, 0}
+	err := a.AdvanceContext(ctx, f, 0, 20, y)
+	var stop *Interrupted
+	if !errors.As(err, &stop) {
+		t.Fatalf("err = %v, want an Interrupted", err)
+	}
+	if stop.Steps == 0 || stop.Steps != a.Accepted || !(stop.T > 0 && stop.T < 20) {
+		t.Errorf("interrupted after %d steps (%d accepted) at t = %v", stop.Steps, a.Accepted, stop.T)
+	}
+
+	// The controller keeps its step size, so the resumed run takes the same
+	// steps as the uninterrupted one.
+	if err := a.AdvanceContext(context.Background(), harmonic, stop.T, 20, y); err != nil {
+		t.Fatal(err)
+	}
+	if y[0] != want[0] || y[1] != want[1] {
+		t.Errorf("resumed y = %v, uninterrupted %v", y, want)
+	}
+	if a.Accepted != ref.Accepted {
+		t.Errorf("accepted steps = %d, uninterrupted %d", a.Accepted, ref.Accepted)
+	}
+}
+
+func TestAdaptive_MarshalBinary(t *testing.T) {
+	newAdaptive := func() *Adaptive {
+		a, _ := NewAdaptive(Components{lengthDim, velocityDim}, tolerances(1e-10))
+		return a
+	}
+	want := []float64{1, 0}
+	ref := newAdaptive()
+	ref.Advance(harmonic, 0, 20, want)
+
+	ctx, f := cancelAfter(200, harmonic)
+	a := newAdaptive()
+	y := []float64{1, 0}
+	var stop *Interrupted
+	if err := a.AdvanceContext(ctx, f, 0, 20, y); !errors.As(err, &stop) {
+		t.Fatalf("err = %v, want an Interrupted", err)
+	}
+
+	// Save what a checkpoint holds, then finish in a fresh integrator.
+	state, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := append([]float64(nil), y...)
+	restored := newAdaptive()
+	if err := restored.UnmarshalBinary(state); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+	if restored.StepSize() != a.StepSize() || restored.ErrorNorm() != a.ErrorNorm() ||
+		restored.Accepted != a.Accepted || restored.Rejected != a.Rejected {
+		t.Errorf("restored controller = (%v, %v, %d, %d), saved (%v, %v, %d, %d)",
+			restored.StepSize(), restored.ErrorNorm(), restored.Accepted, restored.Rejected,
+			a.StepSize(), a.ErrorNorm(), a.Accepted, a.Rejected)
+	}
+	if err := restored.Advance(harmonic, stop.T, 20, saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved[0] != want[0] || saved[1] != want[1] {
+		t.Errorf("restored y = %v, uninterrupted %v", saved, want)
+	}
+	if restored.Accepted != ref.Accepted || restored.Rejected != ref.Rejected {
+		t.Errorf("steps = %d/%d, uninterrupted %d/%d", restored.Accepted, restored.Rejected, ref.Accepted, ref.Rejected)
+	}
+
+	if err := restored.UnmarshalBinary(state[:8]); err == nil {
+		t.Error("UnmarshalBinary() should reject a short state")
+	}
+	if err := restored.SetStepSize(-1); err == nil {
+		t.Error("SetStepSize() should reject a negative step")
+	}
+}
+
+func TestAdvanceContext_Deadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	a, _ := NewAdaptive(Components{lengthDim, velocityDim}, tolerances(1e-10))
+	y := []float64{1, 0}
+	err := a.AdvanceContext(ctx, harmonic, 0, 1, y)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if y[0] != 1 || y[1] != 0 {
+		t.Errorf("state changed before the first step: %v", y)
+	}
+}
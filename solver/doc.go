@@ -0,0 +1,40 @@
+// Package solver provides numerical methods for the physics packages:
+// explicit Runge-Kutta integrators for systems of ordinary differential
+// equations, and adaptive quadrature.
+//
+// Euler and RK4 take fixed steps. Adaptive is a Dormand-Prince 5(4)
+// integrator with step-size control whose error norm is computed against
+// per-dimension Tolerances: a Layout assigns a physical dimension to each
+// state component, so a position error is weighed against a tolerance in
+// meters and a velocity error against one in m/s.
+//
+// Long computations take a context.Context: IntegrateContext,
+// Adaptive.AdvanceContext and Quadrature.Run stop when it is cancelled or
+// its deadline passes, leaving the partial result in place. Integrators
+// return an *Interrupted recording where they stopped so the run can be
+// resumed; a Quadrature keeps its unfinished panels and resumes on the
+// next Run.
+//
+// For performance, solvers operate on plain float64 state slices holding
+// values in SI base units. The physics packages wrap them with unit-safe
+// APIs, packing and unpacking their typed state at the boundary.
+//
+// Example usage:
+//
+//	import "github.com/sakiphan/qsim-core/solver"
+//
+//	// Simple harmonic oscillator: x'' = -ω²x, state y = (x, v)
+//	omega := 2.0
+//	f := func(t float64, y, dydt []float64) {
+//	    dydt[0] = y[1]
+//	    dydt[1] = -omega * omega * y[0]
+//	}
+//
+//	y := []float64{1, 0}
+//	solver.Integrate(&solver.RK4{}, f, 0, 10, 1000, y) // y now holds the state at t = 10 s
+//
+// References:
+//   - Press et al. "Numerical Recipes", 3rd ed., Ch. 17
+//   - Hairer, Nørsett, Wanner. "Solving Ordinary Differential Equations I", 2nd ed.
+//   - Lyness. "Notes on the adaptive Simpson quadrature routine", J. ACM 16, 483 (1969)
+package solver
@@ -0,0 +1,92 @@
+package solver
+
+import "context"
+
+// Derivative evaluates the right-hand side of the system dy/dt = f(t, y),
+// writing the result into dydt. Implementations must not retain y or dydt.
+type Derivative func(t float64, y, dydt []float64)
+
+// Stepper advances a state vector y in place by one step of size h.
+type Stepper interface {
+	Step(f Derivative, t, h float64, y []float64)
+}
+
+// Euler is the explicit (forward) Euler method, first-order accurate.
+// It is mainly useful as a baseline; prefer RK4 for production runs.
+type Euler struct {
+	k []float64
+}
+
+// Step advances y by one forward Euler step: y ← y + h f(t, y).
+func (s *Euler) Step(f Derivative, t, h float64, y []float64) {
+	s.k = resize(s.k, len(y))
+	f(t, y, s.k)
+	for i := range y {
+		y[i] += h * s.k[i]
+	}
+}
+
+// RK4 is the classical fourth-order Runge-Kutta method.
+//
+// Scratch buffers are reused between steps, so a single RK4 value must not
+// be used concurrently.
+//
+// Formula:
+//
+//	k₁ = f(t, y)
+//	k₂ = f(t + h/2, y + h k₁/2)
+//	k₃ = f(t + h/2, y + h k₂/2)
+//	k₄ = f(t + h, y + h k₃)
+//	y ← y + h (k₁ + 2k₂ + 2k₃ + k₄)/6
+//
+// References:
+//   - Press et al. "Numerical Recipes", 3rd ed., Eq. 17.1.3
+type RK4 struct {
+	k1, k2, k3, k4, tmp []float64
+}
+
+// Step advances y by one classical Runge-Kutta step.
+func (s *RK4) Step(f Derivative, t, h float64, y []float64) {
+	n := len(y)
+	s.k1 = resize(s.k1, n)
+	s.k2 = resize(s.k2, n)
+	s.k3 = resize(s.k3, n)
+	s.k4 = resize(s.k4, n)
+	s.tmp = resize(s.tmp, n)
+
+	f(t, y, s.k1)
+	for i := range y {
+		s.tmp[i] = y[i] + 0.5*h*s.k1[i]
+	}
+	f(t+0.5*h, s.tmp, s.k2)
+	for i := range y {
+		s.tmp[i] = y[i] + 0.5*h*s.k2[i]
+	}
+	f(t+0.5*h, s.tmp, s.k3)
+	for i := range y {
+		s.tmp[i] = y[i] + h*s.k3[i]
+	}
+	f(t+h, s.tmp, s.k4)
+	for i := range y {
+		y[i] += h * (s.k1[i] + 2*s.k2[i] + 2*s.k3[i] + s.k4[i]) / 6
+	}
+}
+
+// Integrate advances y in place from t0 to t1 using n equal steps of the
+// given stepper. Returns an error if n is not positive.
+//
+// Example:
+//
+//	y := []float64{1, 0}
+//	err := solver.Integrate(&solver.RK4{}, f, 0, 10, 1000, y)
+func Integrate(s Stepper, f Derivative, t0, t1 float64, n int, y []float64) error {
+	return IntegrateContext(context.Background(), s, f, t0, t1, n, y)
+}
+
+// resize returns buf with length n, reallocating only when its capacity is insufficient.
+func resize(buf []float64, n int) []float64 {
+	if cap(buf) < n {
+		return make([]float64, n)
+	}
+	return buf[:n]
+}
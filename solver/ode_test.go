@@ -0,0 +1,67 @@
+package solver
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+// harmonic is ẍ = -x with state (x, v).
+func harmonic(t float64, y, dydt []float64) {
+	dydt[0] = y[1]
+	dydt[1] = -y[0]
+}
+
+func TestRK4_HarmonicOscillator(t *testing.T) {
+	y := []float64{1, 0}
+	if err := Integrate(&RK4{}, harmonic, 0, 2*math.Pi, 1000, y); err != nil {
+		t.Fatalf("Integrate() error = %v", err)
+	}
+	// After one period the state returns to (1, 0).
+	if !almostEqual(y[0], 1, 1e-10) || !almostEqual(y[1], 0, 1e-10) {
+		t.Errorf("state after one period = (%v, %v), want (1, 0)", y[0], y[1])
+	}
+}
+
+func TestRK4_ConvergenceOrder(t *testing.T) {
+	// y' = y, y(0) = 1 → y(1) = e. Halving h should reduce the error by ~2⁴.
+	f := func(t float64, y, dydt []float64) { dydt[0] = y[0] }
+
+	errAt := func(n int) float64 {
+		y := []float64{1}
+		_ = Integrate(&RK4{}, f, 0, 1, n, y)
+		return math.Abs(y[0] - math.E)
+	}
+
+	ratio := errAt(10) / errAt(20)
+	if ratio < 14 || ratio > 18 {
+		t.Errorf("RK4 error ratio = %v, want ≈ 16 (fourth order)", ratio)
+	}
+}
+
+func TestEuler(t *testing.T) {
+	f := func(t float64, y, dydt []float64) { dydt[0] = 2 }
+	y := []float64{0}
+	_ = Integrate(&Euler{}, f, 0, 3, 7, y)
+	if !almostEqual(y[0], 6, 1e-12) {
+		t.Errorf("Euler on constant derivative = %v, want 6", y[0])
+	}
+}
+
+func TestIntegrate_InvalidSteps(t *testing.T) {
+	if err := Integrate(&RK4{}, harmonic, 0, 1, 0, []float64{1, 0}); err == nil {
+		t.Error("Integrate() should fail for n = 0")
+	}
+}
+
+func BenchmarkRK4Step(b *testing.B) {
+	s := &RK4{}
+	y := []float64{1, 0}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Step(harmonic, 0, 1e-3, y)
+	}
+}
@@ -0,0 +1,238 @@
+package pde
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// BoundaryKind selects the condition applied at one end of the domain.
+type BoundaryKind int
+
+const (
+	// Dirichlet holds u at the boundary node fixed.
+	Dirichlet BoundaryKind = iota
+	// Neumann holds the gradient ∂u/∂x at the boundary fixed.
+	Neumann
+	// Periodic joins the two ends; both must be periodic.
+	Periodic
+)
+
+// Boundary is the condition at one end of the domain. For Dirichlet,
+// Value is u and must have the field's dimension; for Neumann, Value is
+// ∂u/∂x along +x and must have the field's dimension per length. A zero
+// Value means zero in whatever dimension is required.
+type Boundary struct {
+	Kind  BoundaryKind
+	Value units.Value
+}
+
+// Fixed returns a Dirichlet boundary holding u = v.
+func Fixed(v units.Value) Boundary {
+	return Boundary{Kind: Dirichlet, Value: v}
+}
+
+// Gradient returns a Neumann boundary holding ∂u/∂x = g. Gradient with a
+// zero Value models an insulated or free end.
+func Gradient(g units.Value) Boundary {
+	return Boundary{Kind: Neumann, Value: g}
+}
+
+// Wrap returns a periodic boundary.
+func Wrap() Boundary {
+	return Boundary{Kind: Periodic}
+}
+
+// Scheme selects the time discretization.
+type Scheme int
+
+const (
+	// Explicit uses forward differences in time (FTCS for heat, leapfrog
+	// for waves). It is conditionally stable.
+	Explicit Scheme = iota
+	// Implicit uses backward Euler for heat and average-acceleration
+	// Newmark for waves. It is unconditionally stable.
+	Implicit
+	// CrankNicolson averages the explicit and implicit heat operators for
+	// second-order accuracy in time. It applies to Heat1D only.
+	CrankNicolson
+)
+
+// String returns the scheme name.
+func (s Scheme) String() string {
+	switch s {
+	case Explicit:
+		return "explicit"
+	case Implicit:
+		return "implicit"
+	case CrankNicolson:
+		return "Crank-Nicolson"
+	}
+	return fmt.Sprintf("Scheme(%d)", int(s))
+}
+
+// grid is the spatial discretization shared by the solvers: the
+// second-difference operator L (scaled by Δx²) with boundary conditions
+// folded in, as L u + s.
+type grid struct {
+	n              int
+	dx             float64
+	dim            units.Dimension
+	left, right    Boundary
+	lo, di, up     []float64 // diagonals of L: lo[i] = L[i][i-1], up[i] = L[i][i+1]
+	periodic       bool
+	s              []float64 // constant term from Neumann gradients
+	fixed          []bool
+	fixedL, fixedR float64
+}
+
+// newGrid checks the boundaries against the field dimension and builds L.
+func newGrid(n int, dx units.Length, dim units.Dimension, left, right Boundary) (*grid, error) {
+	if n < 3 {
+		return nil, fmt.Errorf("grid must have at least 3 nodes, got %d", n)
+	}
+	if !(dx.Val() > 0) {
+		return nil, fmt.Errorf("grid spacing must be positive, got %g m", dx.Val())
+	}
+	if (left.Kind == Periodic) != (right.Kind == Periodic) {
+		return nil, fmt.Errorf("periodic boundaries must be applied at both ends")
+	}
+	g := &grid{
+		n: n, dx: dx.Val(), dim: dim, left: left, right: right,
+		lo: make([]float64, n), di: make([]float64, n), up: make([]float64, n),
+		s: make([]float64, n), fixed: make([]bool, n),
+		periodic: left.Kind == Periodic,
+	}
+	for i := 0; i < n; i++ {
+		g.lo[i], g.di[i], g.up[i] = 1, -2, 1
+	}
+	gradDim := units.NewValue(1, dim).Divide(units.Meter(1).Value).Dim()
+	for end, b := range [2]Boundary{left, right} {
+		i, inward := 0, 1
+		if end == 1 {
+			i, inward = n-1, -1
+		}
+		switch b.Kind {
+		case Dirichlet:
+			if err := checkValue(b.Value, dim, "Dirichlet"); err != nil {
+				return nil, err
+			}
+			g.fixed[i] = true
+			g.lo[i], g.di[i], g.up[i] = 0, 0, 0
+			if end == 0 {
+				g.fixedL = b.Value.Val()
+			} else {
+				g.fixedR = b.Value.Val()
+			}
+		case Neumann:
+			if err := checkValue(b.Value, gradDim, "Neumann"); err != nil {
+				return nil, err
+			}
+			// Mirrored ghost node: u[i-inward] = u[i+inward] - 2Δx·inward·g.
+			if inward == 1 {
+				g.lo[i], g.up[i] = 0, 2
+			} else {
+				g.lo[i], g.up[i] = 2, 0
+			}
+			g.s[i] = -2 * g.dx * float64(inward) * b.Value.Val()
+		case Periodic:
+		default:
+			return nil, fmt.Errorf("unknown boundary kind %d", b.Kind)
+		}
+	}
+	if !g.periodic {
+		g.lo[0], g.up[n-1] = 0, 0
+	}
+	return g, nil
+}
+
+// checkValue returns an error unless v is zero or has dimension dim.
+func checkValue(v units.Value, dim units.Dimension, kind string) error {
+	if v.Val() != 0 && v.Dim() != dim {
+		return fmt.Errorf("%s boundary value must have dimension %s, got %s", kind, dim, v.Dim())
+	}
+	return nil
+}
+
+// apply writes L u + s into out.
+func (g *grid) apply(u, out []float64) {
+	n := g.n
+	for i := 0; i < n; i++ {
+		v := g.di[i]*u[i] + g.s[i]
+		if i > 0 {
+			v += g.lo[i] * u[i-1]
+		} else if g.periodic {
+			v += g.lo[i] * u[n-1]
+		}
+		if i < n-1 {
+			v += g.up[i] * u[i+1]
+		} else if g.periodic {
+			v += g.up[i] * u[0]
+		}
+		out[i] = v
+	}
+}
+
+// enforce sets the Dirichlet nodes of u to their boundary values.
+func (g *grid) enforce(u []float64) {
+	if g.fixed[0] {
+		u[0] = g.fixedL
+	}
+	if g.fixed[g.n-1] {
+		u[g.n-1] = g.fixedR
+	}
+}
+
+// solve overwrites rhs with the solution x of (I - k L) x = rhs, with
+// Dirichlet rows replaced by their boundary values.
+func (g *grid) solve(k float64, rhs []float64) {
+	n := g.n
+	a := make([]float64, n)
+	b := make([]float64, n)
+	c := make([]float64, n)
+	for i := 0; i < n; i++ {
+		a[i], b[i], c[i] = -k*g.lo[i], 1-k*g.di[i], -k*g.up[i]
+	}
+	g.enforce(rhs)
+	if g.periodic {
+		solveCyclic(a, b, c, rhs)
+	} else {
+		solveTridiagonal(a, b, c, rhs)
+	}
+}
+
+// solveTridiagonal solves the system with sub-, main and super-diagonals
+// a, b, c in place of d (Thomas algorithm). a[0] and c[n-1] are ignored.
+// b is overwritten.
+func solveTridiagonal(a, b, c, d []float64) {
+	n := len(d)
+	for i := 1; i < n; i++ {
+		w := a[i] / b[i-1]
+		b[i] -= w * c[i-1]
+		d[i] -= w * d[i-1]
+	}
+	d[n-1] /= b[n-1]
+	for i := n - 2; i >= 0; i-- {
+		d[i] = (d[i] - c[i]*d[i+1]) / b[i]
+	}
+}
+
+// solveCyclic solves the tridiagonal system with corner elements
+// a[0] = M[0][n-1] and c[n-1] = M[n-1][0] by the Sherman-Morrison formula.
+func solveCyclic(a, b, c, d []float64) {
+	n := len(d)
+	alpha, beta := c[n-1], a[0]
+	gamma := -b[0]
+	bb := append([]float64(nil), b...)
+	bb[0] -= gamma
+	bb[n-1] -= alpha * beta / gamma
+	u := make([]float64, n)
+	u[0], u[n-1] = gamma, alpha
+	b2 := append([]float64(nil), bb...)
+	solveTridiagonal(a, bb, c, d)
+	solveTridiagonal(a, b2, c, u)
+	f := (d[0] + beta*d[n-1]/gamma) / (1 + u[0] + beta*u[n-1]/gamma)
+	for i := range d {
+		d[i] -= f * u[i]
+	}
+}
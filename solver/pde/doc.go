@@ -0,0 +1,48 @@
+// Package pde provides finite-difference solvers for the one-dimensional
+// heat (diffusion) equation and wave equation on a uniform grid:
+//
+//	∂u/∂t = α ∂²u/∂x²        (Heat1D)
+//	∂²u/∂t² = c² ∂²u/∂x²     (Wave1D)
+//
+// The field u may be any quantity, such as a temperature, a concentration
+// or a string displacement; its dimension is taken from the initial
+// condition and checked against the boundary values. Node i sits at
+// x = i·Δx.
+//
+// Each end of the domain has a Boundary. Dirichlet fixes u, Neumann fixes
+// ∂u/∂x (zero for an insulated or free end) through a mirrored ghost node,
+// and Periodic joins the two ends, in which case the nodes cover one full
+// period of N·Δx.
+//
+// Explicit schemes are cheap per step but only stable below a Courant
+// limit, which the constructors check:
+//
+//	heat: r = α Δt/Δx² ≤ 1/2        wave: C = c Δt/Δx ≤ 1
+//
+// Implicit schemes solve a tridiagonal system each step and are stable for
+// any Δt. For heat, Implicit is backward Euler (first order in time) and
+// CrankNicolson is second order; for waves, Implicit is the
+// average-acceleration Newmark scheme, which is second order and conserves
+// the discrete energy.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/math/vector"
+//	    "github.com/sakiphan/qsim-core/solver/pde"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Copper rod, 1 m at 1 cm resolution, ends held at 300 K and 400 K
+//	t0 := vector.FromSlice(make([]float64, 101), units.Kelvin(1).Dim())
+//	rod, _ := pde.NewHeat1D(t0, units.Centimeter(1), units.SquareMeterPerSecond(1.11e-4),
+//	    units.Second(0.4), pde.Fixed(units.Kelvin(300).Value), pde.Fixed(units.Kelvin(400).Value),
+//	    pde.Explicit)
+//	rod.Run(10000)
+//	profile := rod.State()
+//
+// References:
+//   - Press et al. "Numerical Recipes", 3rd ed., Sec. 20.1-20.2
+//   - LeVeque. "Finite Difference Methods for Ordinary and Partial
+//     Differential Equations", Ch. 9-10
+package pde
@@ -0,0 +1,104 @@
+package pde
+
+import (
+	"fmt"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Heat1D integrates the diffusion equation ∂u/∂t = α ∂²u/∂x² with a fixed
+// time step.
+//
+// Heat1D is not safe for concurrent use.
+type Heat1D struct {
+	g      *grid
+	u, tmp []float64
+	r      float64 // α Δt / Δx²
+	dt     float64
+	t      float64
+	scheme Scheme
+}
+
+// NewHeat1D returns a solver starting from the profile u0 with grid
+// spacing dx, diffusivity alpha [L²T⁻¹] (thermal diffusivity, or a
+// kinematic viscosity or mass diffusivity) and time step dt.
+//
+// Returns an error if the grid has fewer than three nodes, dx, alpha or dt
+// is not positive, a boundary value has the wrong dimension, or the
+// explicit scheme violates r = α Δt/Δx² ≤ 1/2.
+func NewHeat1D(u0 vector.VectorN, dx units.Length, alpha units.KinematicViscosity, dt units.Time,
+	left, right Boundary, scheme Scheme) (*Heat1D, error) {
+	g, err := newGrid(u0.Len(), dx, u0.Dim(), left, right)
+	if err != nil {
+		return nil, err
+	}
+	if !(alpha.Val() > 0) {
+		return nil, fmt.Errorf("diffusivity must be positive, got %g m²/s", alpha.Val())
+	}
+	if !(dt.Val() > 0) {
+		return nil, fmt.Errorf("time step must be positive, got %g s", dt.Val())
+	}
+	r := alpha.Val() * dt.Val() / (g.dx * g.dx)
+	switch scheme {
+	case Explicit:
+		if r > 0.5 {
+			return nil, fmt.Errorf("explicit heat scheme unstable: r = α Δt/Δx² = %g exceeds 1/2", r)
+		}
+	case Implicit, CrankNicolson:
+	default:
+		return nil, fmt.Errorf("unsupported scheme %v", scheme)
+	}
+	h := &Heat1D{g: g, u: u0.ToSlice(), tmp: make([]float64, g.n), r: r, dt: dt.Val(), scheme: scheme}
+	g.enforce(h.u)
+	return h, nil
+}
+
+// Step advances the solution by one time step.
+func (h *Heat1D) Step() {
+	g, u, lu := h.g, h.u, h.tmp
+	switch h.scheme {
+	case Explicit:
+		g.apply(u, lu)
+		for i := range u {
+			u[i] += h.r * lu[i]
+		}
+		g.enforce(u)
+	case Implicit:
+		// (I - r L) u' = u + r s
+		for i := range u {
+			u[i] += h.r * g.s[i]
+		}
+		g.solve(h.r, u)
+	case CrankNicolson:
+		// (I - r/2 L) u' = u + r/2 (L u + s) + r/2 s
+		g.apply(u, lu)
+		for i := range u {
+			u[i] += h.r / 2 * (lu[i] + g.s[i])
+		}
+		g.solve(h.r/2, u)
+	}
+	h.t += h.dt
+}
+
+// Run advances the solution by n time steps.
+func (h *Heat1D) Run(n int) {
+	for i := 0; i < n; i++ {
+		h.Step()
+	}
+}
+
+// State returns a copy of the current profile.
+func (h *Heat1D) State() vector.VectorN {
+	return vector.FromSlice(h.u, h.g.dim)
+}
+
+// Time returns the elapsed simulation time.
+func (h *Heat1D) Time() units.Time {
+	return units.Second(h.t)
+}
+
+// Courant returns the diffusion number r = α Δt/Δx².
+func (h *Heat1D) Courant() float64 {
+	return h.r
+}
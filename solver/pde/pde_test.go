@@ -0,0 +1,211 @@
+package pde
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+var kelvin = units.Kelvin(1).Dim()
+
+// profile samples f at n nodes spaced dx apart.
+func profile(n int, dx float64, dim units.Dimension, f func(x float64) float64) vector.VectorN {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = f(float64(i) * dx)
+	}
+	return vector.FromSlice(v, dim)
+}
+
+// -----------------------------------------------------------------------------
+// Heat Tests
+// -----------------------------------------------------------------------------
+
+func TestHeatSineModeDecay(t *testing.T) {
+	// u = sin(πx/L) decays as exp(-απ²t/L²) between ends held at zero.
+	const l, n, alpha = 1.0, 51, 1e-2
+	dx := l / (n - 1)
+	u0 := profile(n, dx, kelvin, func(x float64) float64 { return math.Sin(math.Pi * x / l) })
+	zero := Fixed(units.Kelvin(0).Value)
+
+	for _, tc := range []struct {
+		scheme Scheme
+		dt     float64
+		tol    float64
+	}{
+		{Explicit, 0.01, 1e-3},
+		{Implicit, 0.1, 1e-2},
+		{CrankNicolson, 0.5, 1e-3},
+	} {
+		h, err := NewHeat1D(u0, units.Meter(dx), units.SquareMeterPerSecond(alpha), units.Second(tc.dt), zero, zero, tc.scheme)
+		if err != nil {
+			t.Fatalf("%v: NewHeat1D() error = %v", tc.scheme, err)
+		}
+		h.Run(int(math.Round(10 / tc.dt)))
+		want := math.Exp(-alpha * math.Pi * math.Pi * 10 / (l * l))
+		if got := h.State().At(25); !almostEqual(got.Val()/want, 1, tc.tol) || got.Dim() != kelvin {
+			t.Errorf("%v: u(L/2, 10 s) = %v, want %v K", tc.scheme, got, want)
+		}
+		if !almostEqual(h.Time().Val(), 10, 1e-9) {
+			t.Errorf("%v: Time() = %v, want 10 s", tc.scheme, h.Time())
+		}
+	}
+}
+
+func TestHeatBoundaries(t *testing.T) {
+	const n = 21
+	dx := units.Centimeter(5)
+	alpha := units.SquareMeterPerSecond(1e-3)
+
+	// Insulated ends conserve the total heat (trapezoid rule).
+	u0 := profile(n, dx.Val(), kelvin, func(x float64) float64 { return 300 + 50*math.Exp(-100*(x-0.3)*(x-0.3)) })
+	total := func(u vector.VectorN) float64 {
+		s := u.ToSlice()
+		sum := (s[0] + s[n-1]) / 2
+		for _, v := range s[1 : n-1] {
+			sum += v
+		}
+		return sum
+	}
+	insulated := Gradient(units.Value{})
+	h, _ := NewHeat1D(u0, dx, alpha, units.Second(1), insulated, insulated, Implicit)
+	h.Run(500)
+	if got, want := total(h.State()), total(u0); !almostEqual(got/want, 1, 1e-12) {
+		t.Errorf("insulated total = %v, want %v", got, want)
+	}
+
+	// Steady state with an imposed gradient at the left and a fixed right
+	// end is linear with that slope.
+	slope := units.NewValue(-100, units.Dimension{L: -1, Θ: 1}) // K/m
+	h, err := NewHeat1D(u0, dx, alpha, units.Second(10), Gradient(slope), Fixed(units.Kelvin(300).Value), Implicit)
+	if err != nil {
+		t.Fatalf("NewHeat1D() error = %v", err)
+	}
+	h.Run(20000)
+	if got := h.State().At(0).Val(); !almostEqual(got, 400, 1e-6) {
+		t.Errorf("steady u(0) = %v, want 400 K", got)
+	}
+
+	// Periodic: sin(kx) decays as exp(-α k̃² t), with the discrete wavenumber
+	// k̃ = 2 sin(k Δx/2)/Δx; this also exercises the cyclic solver.
+	k := 2 * math.Pi / (dx.Val() * n)
+	kd := 2 * math.Sin(k*dx.Val()/2) / dx.Val()
+	u0 = profile(n, dx.Val(), kelvin, func(x float64) float64 { return math.Sin(k * x) })
+	h, _ = NewHeat1D(u0, dx, alpha, units.Second(0.5), Wrap(), Wrap(), CrankNicolson)
+	h.Run(200)
+	want := math.Exp(-alpha.Val() * kd * kd * 100)
+	if got := h.State().At(5).Val() / math.Sin(k*5*dx.Val()); !almostEqual(got/want, 1, 1e-3) {
+		t.Errorf("periodic amplitude = %v, want %v", got, want)
+	}
+}
+
+func TestHeatErrors(t *testing.T) {
+	u0 := profile(11, 0.1, kelvin, func(float64) float64 { return 0 })
+	zero := Fixed(units.Value{})
+	alpha := units.SquareMeterPerSecond(1)
+	// r = 1·0.01/0.01 = 1 > 1/2
+	if _, err := NewHeat1D(u0, units.Meter(0.1), alpha, units.Second(0.01), zero, zero, Explicit); err == nil {
+		t.Error("NewHeat1D() should reject an unstable explicit step")
+	}
+	if _, err := NewHeat1D(u0, units.Meter(0.1), alpha, units.Second(0.01), zero, zero, Implicit); err != nil {
+		t.Errorf("NewHeat1D() implicit error = %v", err)
+	}
+	if _, err := NewHeat1D(u0, units.Meter(0.1), alpha, units.Second(0.001), Fixed(units.Meter(1).Value), zero, Explicit); err == nil {
+		t.Error("NewHeat1D() should reject a boundary value of the wrong dimension")
+	}
+	if _, err := NewHeat1D(u0, units.Meter(0.1), alpha, units.Second(0.001), Wrap(), zero, Explicit); err == nil {
+		t.Error("NewHeat1D() should reject a one-sided periodic boundary")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Wave Tests
+// -----------------------------------------------------------------------------
+
+func TestWaveStandingMode(t *testing.T) {
+	// String fixed at both ends: u = sin(πx/L) cos(πct/L), period 2L/c.
+	const l, n, c = 2.0, 41, 10.0
+	dx := l / (n - 1)
+	m := units.Meter(1).Dim()
+	u0 := profile(n, dx, m, func(x float64) float64 { return 1e-3 * math.Sin(math.Pi*x/l) })
+	v0 := vector.ZeroN(n, units.Dimension{L: 1, T: -1})
+	fixed := Fixed(units.Value{})
+	period := 2 * l / c
+
+	for _, tc := range []struct {
+		scheme Scheme
+		steps  int
+		tol    float64
+	}{
+		{Explicit, 80, 1e-12}, // C = 1 is exact for the explicit scheme
+		{Implicit, 200, 1e-2},
+	} {
+		dt := period / float64(tc.steps)
+		w, err := NewWave1D(u0, v0, units.Meter(dx), units.MeterPerSecond(c), units.Second(dt), fixed, fixed, tc.scheme)
+		if err != nil {
+			t.Fatalf("%v: NewWave1D() error = %v", tc.scheme, err)
+		}
+		w.Run(tc.steps / 2)
+		if got := w.State().At(20).Val(); !almostEqual(got/-1e-3, 1, tc.tol) {
+			t.Errorf("%v: u(L/2, T/2) = %v, want -1e-3", tc.scheme, got)
+		}
+		w.Run(tc.steps / 2)
+		if got := w.State().At(20).Val(); !almostEqual(got/1e-3, 1, tc.tol) {
+			t.Errorf("%v: u(L/2, T) = %v, want 1e-3", tc.scheme, got)
+		}
+	}
+}
+
+func TestWaveFreeEndReflection(t *testing.T) {
+	// A pulse reflects from a free (Neumann) end without inversion and from
+	// a fixed end with inversion.
+	const n = 201
+	dx := 0.01
+	m := units.Meter(1).Dim()
+	pulse := func(x float64) float64 { return math.Exp(-math.Pow((x-1)/0.05, 2)) }
+	u0 := profile(n, dx, m, pulse)
+	// Right-moving pulse: v = -c u'
+	v0 := profile(n, dx, units.Dimension{L: 1, T: -1}, func(x float64) float64 {
+		return 2 * (x - 1) / (0.05 * 0.05) * pulse(x)
+	})
+	for _, tc := range []struct {
+		right Boundary
+		sign  float64
+	}{
+		{Gradient(units.Value{}), 1},
+		{Fixed(units.Value{}), -1},
+	} {
+		w, _ := NewWave1D(u0, v0, units.Meter(dx), units.MeterPerSecond(1), units.Second(dx), Fixed(units.Value{}), tc.right, Explicit)
+		// After 2 s the pulse has travelled to x = 2 and back to x = 1.
+		w.Run(200)
+		if got := w.State().At(100).Val(); !almostEqual(got, tc.sign, 0.02) {
+			t.Errorf("reflected pulse peak = %v, want %v", got, tc.sign)
+		}
+	}
+}
+
+func TestWaveErrors(t *testing.T) {
+	u0 := profile(11, 0.1, units.Dimension{L: 1}, func(float64) float64 { return 0 })
+	v0 := vector.ZeroN(11, units.Dimension{L: 1, T: -1})
+	b := Fixed(units.Value{})
+	if _, err := NewWave1D(u0, v0, units.Meter(0.1), units.MeterPerSecond(10), units.Second(0.02), b, b, Explicit); err == nil {
+		t.Error("NewWave1D() should reject C > 1 for the explicit scheme")
+	}
+	w, err := NewWave1D(u0, v0, units.Meter(0.1), units.MeterPerSecond(10), units.Second(0.02), b, b, Implicit)
+	if err != nil || !almostEqual(w.Courant(), 2, 1e-12) {
+		t.Errorf("NewWave1D() implicit = %v, %v, want C = 2", w, err)
+	}
+	bad := vector.FromSlice([]float64{0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0}, units.Dimension{L: 1})
+	if _, err := NewWave1D(u0, bad, units.Meter(0.1), units.MeterPerSecond(1), units.Second(0.01), b, b, Explicit); err == nil {
+		t.Error("NewWave1D() should reject a velocity of the wrong dimension")
+	}
+	if _, err := NewWave1D(u0, v0, units.Meter(0.1), units.MeterPerSecond(1), units.Second(0.01), b, b, CrankNicolson); err == nil {
+		t.Error("NewWave1D() should reject Crank-Nicolson")
+	}
+}
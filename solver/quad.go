@@ -0,0 +1,124 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// Quadrature computes ∫ f(x) dx over [a, b] by adaptive Simpson's rule.
+//
+// Each panel is split in two until Simpson's rule on the halves agrees
+// with the whole to within 15 times the panel's share of the tolerance;
+// the accepted value includes the Richardson correction (S₂ - S₁)/15.
+// Panels waiting to be refined are kept in the Quadrature, so a run
+// stopped by its context can be resumed by calling Run again.
+//
+// References:
+//   - Lyness. "Notes on the adaptive Simpson quadrature routine",
+//     J. ACM 16, 483 (1969)
+type Quadrature struct {
+	MaxEvals int // function evaluation limit over all runs, default 1e7
+	Evals    int // function evaluations so far
+
+	f       func(x float64) float64
+	done    float64 // sum over accepted panels
+	pending []panel
+}
+
+// panel is an interval [a, b] with f sampled at both ends and the middle,
+// and its Simpson estimate.
+type panel struct {
+	a, b, fa, fm, fb, whole, tol float64
+	depth                        int
+}
+
+// maxDepth limits the bisection of a panel; 2⁻⁵⁰ of the interval is below
+// the resolution of float64.
+const maxDepth = 50
+
+// NewQuadrature prepares the integration of f over [a, b] to an absolute
+// tolerance tol. Returns an error if the bounds are not finite or tol is
+// not positive.
+func NewQuadrature(f func(x float64) float64, a, b, tol float64) (*Quadrature, error) {
+	if math.IsInf(a, 0) || math.IsNaN(a) || math.IsInf(b, 0) || math.IsNaN(b) {
+		return nil, fmt.Errorf("integration bounds must be finite, got [%g, %g]", a, b)
+	}
+	if !(tol > 0) {
+		return nil, fmt.Errorf("tolerance must be positive, got %g", tol)
+	}
+	q := &Quadrature{f: f}
+	if a != b {
+		fa, fm, fb := f(a), f(0.5*(a+b)), f(b)
+		q.Evals = 3
+		q.pending = []panel{{a: a, b: b, fa: fa, fm: fm, fb: fb, whole: simpson(a, b, fa, fm, fb), tol: tol}}
+	}
+	return q, nil
+}
+
+// Run refines panels until all have converged, checking ctx before each
+// one, and returns the integral. If ctx is done, it returns Estimate and an
+// error wrapping the context's error.
+func (q *Quadrature) Run(ctx context.Context) (float64, error) {
+	maxEvals := q.MaxEvals
+	if maxEvals <= 0 {
+		maxEvals = 10000000
+	}
+	for len(q.pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			return q.Estimate(), fmt.Errorf("quadrature interrupted after %d evaluations: %w", q.Evals, err)
+		}
+		if q.Evals+2 > maxEvals {
+			return q.Estimate(), fmt.Errorf("quadrature exceeded %d function evaluations", maxEvals)
+		}
+		p := q.pending[len(q.pending)-1]
+		q.pending = q.pending[:len(q.pending)-1]
+
+		m := 0.5 * (p.a + p.b)
+		flm, frm := q.f(0.5*(p.a+m)), q.f(0.5*(m+p.b))
+		q.Evals += 2
+		left := simpson(p.a, m, p.fa, flm, p.fm)
+		right := simpson(m, p.b, p.fm, frm, p.fb)
+		diff := left + right - p.whole
+		if math.Abs(diff) <= 15*p.tol || p.depth == maxDepth {
+			q.done += left + right + diff/15
+			continue
+		}
+		q.pending = append(q.pending,
+			panel{a: m, b: p.b, fa: p.fm, fm: frm, fb: p.fb, whole: right, tol: p.tol / 2, depth: p.depth + 1},
+			panel{a: p.a, b: m, fa: p.fa, fm: flm, fb: p.fm, whole: left, tol: p.tol / 2, depth: p.depth + 1},
+		)
+	}
+	return q.done, nil
+}
+
+// Estimate returns the current estimate of the integral: the accepted
+// panels plus the Simpson estimates of those still pending.
+func (q *Quadrature) Estimate() float64 {
+	sum := q.done
+	for _, p := range q.pending {
+		sum += p.whole
+	}
+	return sum
+}
+
+// Integral returns ∫ f(x) dx over [a, b] to an absolute tolerance tol by
+// adaptive Simpson's rule. If ctx is done before convergence, the partial
+// estimate is returned with an error wrapping the context's error.
+//
+// Example:
+//
+//	// ∫₀^π sin x dx = 2
+//	v, err := solver.Integral(ctx, math.Sin, 0, math.Pi, 1e-10)
+func Integral(ctx context.Context, f func(x float64) float64, a, b, tol float64) (float64, error) {
+	q, err := NewQuadrature(f, a, b, tol)
+	if err != nil {
+		return 0, err
+	}
+	return q.Run(ctx)
+}
+
+// simpson returns Simpson's rule over [a, b].
+func simpson(a, b, fa, fm, fb float64) float64 {
+	return (b - a) / 6 * (fa + 4*fm + fb)
+}
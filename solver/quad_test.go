@@ -0,0 +1,69 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIntegral(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		want float64
+	}{
+		{"sin", math.Sin, 0, math.Pi, 2},
+		{"polynomial", func(x float64) float64 { return x * x * x }, -1, 2, 15.0 / 4},
+		{"reversed", math.Exp, 1, 0, 1 - math.E},
+		{"sharp peak", func(x float64) float64 { return 1 / (1e-4 + x*x) }, -1, 1, 2 * math.Atan(100) / 1e-2},
+		{"empty", math.Exp, 3, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Integral(context.Background(), tt.f, tt.a, tt.b, 1e-10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !almostEqual(got, tt.want, 1e-9) {
+				t.Errorf("integral = %.15g, want %.15g", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Integral(context.Background(), math.Sin, 0, math.Inf(1), 1e-6); err == nil {
+		t.Error("expected error for infinite bound")
+	}
+	if _, err := Integral(context.Background(), math.Sin, 0, 1, 0); err == nil {
+		t.Error("expected error for zero tolerance")
+	}
+}
+
+func TestQuadrature_Resume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	evals := 0
+	f := func(x float64) float64 {
+		if evals++; evals == 100 {
+			cancel()
+		}
+		return 1 / (1e-4 + x*x)
+	}
+	q, _ := NewQuadrature(f, -1, 1, 1e-10)
+	partial, err := q.Run(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if partial != q.Estimate() || q.Evals != 101 {
+		t.Errorf("partial estimate = %v after %d evaluations, Estimate() = %v", partial, q.Evals, q.Estimate())
+	}
+
+	want := 2 * math.Atan(100) / 1e-2
+	got, err := q.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(got, want, 1e-9) {
+		t.Errorf("resumed integral = %.15g, want %.15g", got, want)
+	}
+}
@@ -0,0 +1,44 @@
+// Package sweep runs a computation over a grid of parameter values and
+// collects the results in a tidy table.
+//
+// Each Axis varies one unit-typed parameter over evenly spaced (Linear),
+// logarithmically spaced (Log) or listed (List) values. A Sweep runs a
+// Func at every point of the cartesian product of its axes, optionally in
+// parallel on a parallel.Pool, and returns an export.Table with one row per
+// point: the parameter values followed by the outputs, each column with
+// its dimension, ready for WriteCSV or WriteParquet. The table does not
+// depend on the pool or scheduling.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/config"
+//	    "github.com/sakiphan/qsim-core/io/export"
+//	    "github.com/sakiphan/qsim-core/parallel"
+//	    "github.com/sakiphan/qsim-core/sim"
+//	    "github.com/sakiphan/qsim-core/sweep"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Scan a scenario over softening length and step size.
+//	eps, _ := sweep.Log("softening", units.Meter(1e3).Value, units.Meter(1e7).Value, 9)
+//	h, _ := sweep.List("step", units.Hour(1).Value, units.Hour(6).Value)
+//	s, _ := sweep.New(eps, h)
+//	s.SetPool(parallel.NewPool(0))
+//
+//	table, err := s.Run(ctx, func(ctx context.Context, p sweep.Values) (sweep.Values, error) {
+//	    sc, _ := config.Load("cluster.yaml")
+//	    sc.Forces[0].Params["softening"] = p["softening"]
+//	    w, err := sim.FromScenario(sc)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    diag := sim.NewDiagnostics()
+//	    w.Observe(diag)
+//	    if err := w.RunContext(ctx, sc.Duration, units.Time{Value: p["step"]}); err != nil {
+//	        return nil, err
+//	    }
+//	    return sweep.Values{"energy_drift": units.Dimensionless(diag.MaxDrift().Energy)}, nil
+//	})
+//	export.WriteCSV(os.Stdout, table) // softening [m],step [s],energy_drift [1]
+package sweep
@@ -0,0 +1,252 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sakiphan/qsim-core/io/export"
+	"github.com/sakiphan/qsim-core/parallel"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Axes
+// -----------------------------------------------------------------------------
+
+// Axis is a swept parameter: a name and the values it takes.
+type Axis struct {
+	Name   string
+	Values []units.Value
+}
+
+// Linear returns an axis of n evenly spaced values from lo to hi inclusive.
+// Returns an error if n < 2 or the bounds differ in dimension.
+func Linear(name string, lo, hi units.Value, n int) (Axis, error) {
+	if err := checkRange(lo, hi, n); err != nil {
+		return Axis{}, fmt.Errorf("axis %q: %w", name, err)
+	}
+	vals := make([]units.Value, n)
+	for i := range vals {
+		f := float64(i) / float64(n-1)
+		vals[i] = units.NewValue(lo.Val()+f*(hi.Val()-lo.Val()), lo.Dim())
+	}
+	vals[n-1] = hi
+	return Axis{Name: name, Values: vals}, nil
+}
+
+// Log returns an axis of n logarithmically spaced values from lo to hi
+// inclusive, for parameters spanning orders of magnitude. Returns an error
+// if n < 2, the bounds differ in dimension or are not both positive.
+func Log(name string, lo, hi units.Value, n int) (Axis, error) {
+	if err := checkRange(lo, hi, n); err != nil {
+		return Axis{}, fmt.Errorf("axis %q: %w", name, err)
+	}
+	if !(lo.Val() > 0 && hi.Val() > 0) {
+		return Axis{}, fmt.Errorf("axis %q: logarithmic bounds must be positive, got %g and %g", name, lo.Val(), hi.Val())
+	}
+	a, b := math.Log(lo.Val()), math.Log(hi.Val())
+	vals := make([]units.Value, n)
+	for i := range vals {
+		f := float64(i) / float64(n-1)
+		vals[i] = units.NewValue(math.Exp(a+f*(b-a)), lo.Dim())
+	}
+	vals[0], vals[n-1] = lo, hi
+	return Axis{Name: name, Values: vals}, nil
+}
+
+// List returns an axis taking the given values, which must share one
+// dimension.
+func List(name string, values ...units.Value) (Axis, error) {
+	if len(values) == 0 {
+		return Axis{}, fmt.Errorf("axis %q has no values", name)
+	}
+	for i, v := range values {
+		if v.Dim() != values[0].Dim() {
+			return Axis{}, fmt.Errorf("axis %q values must have same dimension: [0]=%s, [%d]=%s", name, values[0].Dim(), i, v.Dim())
+		}
+	}
+	return Axis{Name: name, Values: append([]units.Value(nil), values...)}, nil
+}
+
+func checkRange(lo, hi units.Value, n int) error {
+	if n < 2 {
+		return fmt.Errorf("need at least 2 points, got %d", n)
+	}
+	if lo.Dim() != hi.Dim() {
+		return fmt.Errorf("bounds must have the same dimension, got %s and %s", lo.Dim(), hi.Dim())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Sweep
+// -----------------------------------------------------------------------------
+
+// Values holds named quantities: the parameters of one grid point or the
+// outputs computed there.
+type Values map[string]units.Value
+
+// Func computes the outputs at one grid point. Every call must return the
+// same output names with the same dimensions. With a pool set, calls run
+// concurrently and must not share mutable state.
+type Func func(ctx context.Context, params Values) (Values, error)
+
+// Sweep is a grid scan over the cartesian product of its axes.
+type Sweep struct {
+	axes []Axis
+	pool *parallel.Pool
+}
+
+// New creates a sweep over the given axes. Returns an error if there are
+// no axes, an axis is empty or has mixed dimensions, or two axes share a
+// name.
+func New(axes ...Axis) (*Sweep, error) {
+	if len(axes) == 0 {
+		return nil, fmt.Errorf("sweep needs at least one axis")
+	}
+	seen := map[string]bool{}
+	for _, a := range axes {
+		if a.Name == "" {
+			return nil, fmt.Errorf("axis name must not be empty")
+		}
+		if seen[a.Name] {
+			return nil, fmt.Errorf("duplicate axis %q", a.Name)
+		}
+		seen[a.Name] = true
+		if _, err := List(a.Name, a.Values...); err != nil {
+			return nil, err
+		}
+	}
+	return &Sweep{axes: append([]Axis(nil), axes...)}, nil
+}
+
+// SetPool makes Run evaluate grid points on the given pool; nil restores
+// serial evaluation.
+func (s *Sweep) SetPool(p *parallel.Pool) {
+	s.pool = p
+}
+
+// Len returns the number of grid points.
+func (s *Sweep) Len() int {
+	n := 1
+	for _, a := range s.axes {
+		n *= len(a.Values)
+	}
+	return n
+}
+
+// Point returns the parameters of grid point i, 0 ≤ i < Len(). Points are
+// numbered with the last axis varying fastest.
+func (s *Sweep) Point(i int) Values {
+	p := make(Values, len(s.axes))
+	for k := len(s.axes) - 1; k >= 0; k-- {
+		a := s.axes[k]
+		p[a.Name] = a.Values[i%len(a.Values)]
+		i /= len(a.Values)
+	}
+	return p
+}
+
+// Run evaluates f at every grid point and returns a tidy table: one row per
+// point in Point order, with a column per axis followed by a column per
+// output in alphabetical order.
+//
+// An error from f stops the sweep and is returned for the lowest failing
+// point. If ctx is done, the rows of the points completed so far are
+// returned with an error wrapping the context's error.
+func (s *Sweep) Run(ctx context.Context, f Func) (*export.Table, error) {
+	n := s.Len()
+	points := make([]Values, n)
+	for i := range points {
+		points[i] = s.Point(i)
+	}
+	outputs := make([]Values, n)
+	errs := make([]error, n)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	s.pool.For(n, 1, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			if ctx.Err() != nil {
+				return
+			}
+			out, err := f(ctx, points[i])
+			if err != nil {
+				errs[i] = err
+				cancel()
+				return
+			}
+			outputs[i] = out
+		}
+	})
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("point %d %v: %w", i, points[i], err)
+		}
+	}
+
+	t, done, err := s.table(points, outputs)
+	if err != nil {
+		return nil, err
+	}
+	if done < n {
+		return t, fmt.Errorf("sweep stopped after %d of %d points: %w", done, n, context.Cause(ctx))
+	}
+	return t, nil
+}
+
+// table collects the completed points into a table and returns it with the
+// number of rows.
+func (s *Sweep) table(points, outputs []Values) (*export.Table, int, error) {
+	var names []string
+	var dims []units.Dimension
+	var rows []int
+	for i, out := range outputs {
+		if out == nil {
+			continue
+		}
+		if names == nil {
+			for name := range out {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				dims = append(dims, out[name].Dim())
+			}
+		}
+		if len(out) != len(names) {
+			return nil, 0, fmt.Errorf("point %d: function returned %d outputs, want %d", i, len(out), len(names))
+		}
+		for k, name := range names {
+			v, ok := out[name]
+			if !ok {
+				return nil, 0, fmt.Errorf("point %d: function did not return output %q", i, name)
+			}
+			if v.Dim() != dims[k] {
+				return nil, 0, fmt.Errorf("point %d: output %q has dimension %s, want %s", i, name, v.Dim(), dims[k])
+			}
+		}
+		rows = append(rows, i)
+	}
+
+	t := export.NewTable()
+	col := make([]float64, len(rows))
+	for _, a := range s.axes {
+		for r, i := range rows {
+			col[r] = points[i][a.Name].Val()
+		}
+		if err := t.AddColumn(a.Name, a.Values[0].Dim(), col); err != nil {
+			return nil, 0, err
+		}
+	}
+	for k, name := range names {
+		for r, i := range rows {
+			col[r] = outputs[i][name].Val()
+		}
+		if err := t.AddColumn(name, dims[k], col); err != nil {
+			return nil, 0, fmt.Errorf("output %q: %w", name, err)
+		}
+	}
+	return t, len(rows), nil
+}
@@ -0,0 +1,144 @@
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/io/export"
+	"github.com/sakiphan/qsim-core/parallel"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func TestAxes(t *testing.T) {
+	lin, err := Linear("x", units.Meter(0).Value, units.Meter(1).Value, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range lin.Values {
+		if !almostEqual(v.Val(), 0.25*float64(i), 1e-15) || v.Dim() != units.Meter(1).Dim() {
+			t.Errorf("linear[%d] = %v", i, v)
+		}
+	}
+
+	lg, _ := Log("f", units.Hertz(1).Value, units.Hertz(1e6).Value, 7)
+	for i, v := range lg.Values {
+		if !almostEqual(v.Val()/math.Pow(10, float64(i)), 1, 1e-12) {
+			t.Errorf("log[%d] = %v", i, v)
+		}
+	}
+	if lg.Values[6].Val() != 1e6 {
+		t.Errorf("log axis does not end on its upper bound: %v", lg.Values[6])
+	}
+
+	if _, err := Linear("x", units.Meter(0).Value, units.Second(1).Value, 3); err == nil {
+		t.Error("expected error for mixed dimensions")
+	}
+	if _, err := Log("x", units.Meter(0).Value, units.Meter(1).Value, 3); err == nil {
+		t.Error("expected error for zero log bound")
+	}
+	if _, err := Linear("x", units.Meter(0).Value, units.Meter(1).Value, 1); err == nil {
+		t.Error("expected error for a single point")
+	}
+	if _, err := List("x", units.Meter(1).Value, units.Kilogram(1).Value); err == nil {
+		t.Error("expected error for mixed list")
+	}
+}
+
+// kinetic returns E = ½ m v² and p = m v.
+func kinetic(ctx context.Context, p Values) (Values, error) {
+	m, v := p["m"].Val(), p["v"].Val()
+	return Values{
+		"p": units.NewValue(m*v, units.Dimension{M: 1, L: 1, T: -1}),
+		"E": units.Joule(0.5 * m * v * v).Value,
+	}, nil
+}
+
+func TestRun(t *testing.T) {
+	m, _ := List("m", units.Kilogram(1).Value, units.Kilogram(2).Value)
+	v, _ := Linear("v", units.MeterPerSecond(0).Value, units.MeterPerSecond(2).Value, 3)
+	s, err := New(m, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Len() != 6 {
+		t.Fatalf("Len() = %d", s.Len())
+	}
+	if p := s.Point(4); p["m"].Val() != 2 || p["v"].Val() != 1 {
+		t.Errorf("Point(4) = %v, want m = 2, v = 1", p)
+	}
+
+	table, err := s.Run(context.Background(), kinetic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	export.WriteCSV(&buf, table)
+	want := "m [kg],v [m s^-1],E [kg m^2 s^-2],p [kg m s^-1]\n" +
+		"1,0,0,0\n1,1,0.5,1\n1,2,2,2\n2,0,0,0\n2,1,1,2\n2,2,4,4\n"
+	if buf.String() != want {
+		t.Errorf("table:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	// A parallel sweep gives the same table.
+	pool := parallel.NewPool(3)
+	defer pool.Close()
+	s.SetPool(pool)
+	par, _ := s.Run(context.Background(), kinetic)
+	var pbuf bytes.Buffer
+	export.WriteCSV(&pbuf, par)
+	if pbuf.String() != want {
+		t.Errorf("parallel table:\n%s", pbuf.String())
+	}
+
+	if _, err := New(m, m); err == nil {
+		t.Error("expected error for duplicate axis")
+	}
+	if _, err := New(); err == nil {
+		t.Error("expected error for no axes")
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	x, _ := Linear("x", units.Meter(0).Value, units.Meter(9).Value, 10)
+	s, _ := New(x)
+
+	_, err := s.Run(context.Background(), func(ctx context.Context, p Values) (Values, error) {
+		if p["x"].Val() >= 4 {
+			return nil, errors.New("diverged")
+		}
+		return Values{"y": p["x"]}, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "point 4") || !strings.Contains(err.Error(), "diverged") {
+		t.Errorf("err = %v, want point 4 to fail", err)
+	}
+
+	_, err = s.Run(context.Background(), func(ctx context.Context, p Values) (Values, error) {
+		if p["x"].Val() == 3 {
+			return Values{"y": units.Second(1).Value}, nil
+		}
+		return Values{"y": p["x"]}, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "dimension") {
+		t.Errorf("err = %v, want a dimension error", err)
+	}
+
+	// Cancelling keeps the completed rows.
+	ctx, cancel := context.WithCancel(context.Background())
+	table, err := s.Run(ctx, func(ctx context.Context, p Values) (Values, error) {
+		if p["x"].Val() == 6 {
+			cancel()
+		}
+		return Values{"y": p["x"]}, nil
+	})
+	if !errors.Is(err, context.Canceled) || table == nil || table.Rows() != 7 {
+		t.Errorf("err = %v, table = %v", err, table)
+	}
+}
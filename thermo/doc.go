@@ -0,0 +1,69 @@
+// Package thermo provides equations of state for gases, the standard
+// reversible processes of an ideal gas, heat transfer by conduction,
+// convection and radiation, and the degeneracy scales of quantum gases.
+//
+// An EquationOfState relates pressure P, volume V, amount n and
+// temperature T. IdealGas implements PV = nRT and VanDerWaals the real-gas
+// correction
+//
+//	(P + a n²/V²)(V - n b) = nRT
+//
+// Either can be solved for any one unknown, directly through its methods
+// or generically with Solve, which fills in the single missing field of a
+// State.
+//
+// The heat transfer functions compose the unit types ThermalConductivity,
+// HeatTransferCoefficient, Area and TemperatureDelta into heat flows:
+// Fourier conduction through slabs and cylindrical walls, Newton cooling,
+// and radiative exchange between gray surfaces.
+//
+// Quantum gases are classical only well above their degeneracy
+// temperature. FermiEnergy, FermiTemperature and FermiVelocity give the
+// scales of a degenerate gas of spin-½ fermions, such as the electrons of
+// a metal or a white dwarf, and BECTemperature the condensation
+// temperature of an ideal Bose gas.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/constants"
+//	    "github.com/sakiphan/qsim-core/constants/particles"
+//	    "github.com/sakiphan/qsim-core/thermo"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	// Molar volume of an ideal gas at 0 °C and 1 atm
+//	stp := constants.STPAtm
+//	v, _ := thermo.IdealGas{}.Volume(stp.Pressure, units.Mole(1), stp.Temperature) // ≈ 22.4 L
+//
+//	// Pressure of 1 mol of CO₂ squeezed into 0.5 L at 300 K
+//	p, _ := thermo.CarbonDioxide.Pressure(units.Liter(0.5), units.Mole(1), units.Kelvin(300))
+//
+//	// Fill in the unknown temperature
+//	s, _ := thermo.Solve(thermo.Nitrogen, thermo.State{
+//	    Pressure: units.Bar(10),
+//	    Volume:   units.Liter(2.5),
+//	    Amount:   units.Mole(1),
+//	})
+//
+//	// Conduction electrons of copper
+//	electron, _ := particles.Lookup("e-")
+//	me := units.Mass{Value: electron.Mass.Value()}
+//	ef, _ := thermo.FermiEnergy(me, units.PerMeter3(8.47e28)) // ≈ 7.0 eV
+//
+//	// Adiabatic compression of air to a tenth of its volume
+//	p2, _ := thermo.AdiabaticPressure(constants.StandardAtmosphere, units.Liter(1), units.Liter(0.1), 1.4)
+//
+//	// Heat loss through a 20 cm brick wall with 20 K across it
+//	q, _ := thermo.SlabConduction(units.WattPerMeterKelvin(0.72), units.SquareMeter(10),
+//	    units.Centimeter(20), units.Celsius(20).Difference(units.Celsius(0)))
+//
+// References:
+//   - Atkins, de Paula. "Physical Chemistry", 11th ed., Ch. 1
+//   - Schroeder. "An Introduction to Thermal Physics", 1st ed., Ch. 1 and 5
+//   - Haynes (ed.). "CRC Handbook of Chemistry and Physics", 97th ed., Sec. 6
+//   - Incropera et al. "Fundamentals of Heat and Mass Transfer", 7th ed.
+//   - Ashcroft, Mermin. "Solid State Physics" (1976), Ch. 2
+//   - Pethick, Smith. "Bose-Einstein Condensation in Dilute Gases", 2nd ed.,
+//     Ch. 2
+package thermo
@@ -0,0 +1,274 @@
+package thermo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// r is the molar gas constant in J/(mol·K).
+var r = constants.UniversalGasConstant.Val()
+
+// State is a thermodynamic state of a fixed amount of gas.
+type State struct {
+	Pressure    units.Pressure
+	Volume      units.Volume
+	Amount      units.Amount
+	Temperature units.Temperature
+}
+
+// EquationOfState relates pressure, volume, amount and temperature and can
+// be solved for any one of them given the other three.
+type EquationOfState interface {
+	Pressure(v units.Volume, n units.Amount, t units.Temperature) (units.Pressure, error)
+	Volume(p units.Pressure, n units.Amount, t units.Temperature) (units.Volume, error)
+	Amount(p units.Pressure, v units.Volume, t units.Temperature) (units.Amount, error)
+	Temperature(p units.Pressure, v units.Volume, n units.Amount) (units.Temperature, error)
+}
+
+// Solve returns s with its single unset (zero-value) field computed from
+// the other three using eos. Returns an error unless exactly one field is
+// unset, or if eos cannot solve for it.
+//
+// Example:
+//
+//	s, _ := thermo.Solve(thermo.IdealGas{}, thermo.State{
+//	    Pressure:    constants.STPAtm.Pressure,
+//	    Amount:      units.Mole(1),
+//	    Temperature: constants.STPAtm.Temperature,
+//	})
+//	fmt.Println(s.Volume.ToLiters()) // ≈ 22.41
+func Solve(eos EquationOfState, s State) (State, error) {
+	unset := 0
+	for _, v := range []units.Value{s.Pressure.Value, s.Volume.Value, s.Amount.Value, s.Temperature.Value} {
+		if v == (units.Value{}) {
+			unset++
+		}
+	}
+	if unset != 1 {
+		return State{}, fmt.Errorf("exactly one state variable must be unset, got %d", unset)
+	}
+
+	var err error
+	switch {
+	case s.Pressure.Value == (units.Value{}):
+		s.Pressure, err = eos.Pressure(s.Volume, s.Amount, s.Temperature)
+	case s.Volume.Value == (units.Value{}):
+		s.Volume, err = eos.Volume(s.Pressure, s.Amount, s.Temperature)
+	case s.Amount.Value == (units.Value{}):
+		s.Amount, err = eos.Amount(s.Pressure, s.Volume, s.Temperature)
+	default:
+		s.Temperature, err = eos.Temperature(s.Pressure, s.Volume, s.Amount)
+	}
+	if err != nil {
+		return State{}, err
+	}
+	return s, nil
+}
+
+// checkPositive returns an error unless every value is positive.
+func checkPositive(names []string, values ...float64) error {
+	for i, v := range values {
+		if !(v > 0) || math.IsInf(v, 0) {
+			return fmt.Errorf("%s must be positive and finite, got %g", names[i], v)
+		}
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Ideal Gas
+// -----------------------------------------------------------------------------
+
+// IdealGas is the ideal-gas equation of state PV = nRT.
+type IdealGas struct{}
+
+// Pressure returns P = nRT/V. Returns an error unless V, n, T are positive.
+func (IdealGas) Pressure(v units.Volume, n units.Amount, t units.Temperature) (units.Pressure, error) {
+	if err := checkPositive([]string{"volume", "amount", "temperature"}, v.Val(), n.Val(), t.Val()); err != nil {
+		return units.Pressure{}, err
+	}
+	return units.Pascal(n.Val() * r * t.Val() / v.Val()), nil
+}
+
+// Volume returns V = nRT/P. Returns an error unless P, n, T are positive.
+func (IdealGas) Volume(p units.Pressure, n units.Amount, t units.Temperature) (units.Volume, error) {
+	if err := checkPositive([]string{"pressure", "amount", "temperature"}, p.Val(), n.Val(), t.Val()); err != nil {
+		return units.Volume{}, err
+	}
+	return units.CubicMeter(n.Val() * r * t.Val() / p.Val()), nil
+}
+
+// Amount returns n = PV/RT. Returns an error unless P, V, T are positive.
+func (IdealGas) Amount(p units.Pressure, v units.Volume, t units.Temperature) (units.Amount, error) {
+	if err := checkPositive([]string{"pressure", "volume", "temperature"}, p.Val(), v.Val(), t.Val()); err != nil {
+		return units.Amount{}, err
+	}
+	return units.Mole(p.Val() * v.Val() / (r * t.Val())), nil
+}
+
+// Temperature returns T = PV/nR. Returns an error unless P, V, n are positive.
+func (IdealGas) Temperature(p units.Pressure, v units.Volume, n units.Amount) (units.Temperature, error) {
+	if err := checkPositive([]string{"pressure", "volume", "amount"}, p.Val(), v.Val(), n.Val()); err != nil {
+		return units.Temperature{}, err
+	}
+	return units.Kelvin(p.Val() * v.Val() / (n.Val() * r)), nil
+}
+
+// -----------------------------------------------------------------------------
+// Van der Waals Gas
+// -----------------------------------------------------------------------------
+
+// VanDerWaals is the van der Waals equation of state
+// (P + a n²/V²)(V - n b) = nRT, where a measures the intermolecular
+// attraction and b the excluded molar volume.
+type VanDerWaals struct {
+	A units.Value // attraction parameter a (Pa·m⁶/mol²)
+	B units.Value // excluded volume b (m³/mol)
+}
+
+var (
+	vdwADim = units.Dimension{L: 5, M: 1, T: -2, N: -2}
+	vdwBDim = units.Dimension{L: 3, N: -1}
+)
+
+// Van der Waals parameters of common gases.
+//
+// References:
+//   - Haynes (ed.). "CRC Handbook of Chemistry and Physics", 97th ed., Sec. 6
+var (
+	Helium        = VanDerWaals{units.NewValue(0.00346, vdwADim), units.NewValue(2.38e-5, vdwBDim)}
+	Hydrogen      = VanDerWaals{units.NewValue(0.02452, vdwADim), units.NewValue(2.65e-5, vdwBDim)}
+	Nitrogen      = VanDerWaals{units.NewValue(0.1370, vdwADim), units.NewValue(3.87e-5, vdwBDim)}
+	Oxygen        = VanDerWaals{units.NewValue(0.1382, vdwADim), units.NewValue(3.19e-5, vdwBDim)}
+	Argon         = VanDerWaals{units.NewValue(0.1355, vdwADim), units.NewValue(3.20e-5, vdwBDim)}
+	CarbonDioxide = VanDerWaals{units.NewValue(0.3640, vdwADim), units.NewValue(4.267e-5, vdwBDim)}
+	Water         = VanDerWaals{units.NewValue(0.5537, vdwADim), units.NewValue(3.049e-5, vdwBDim)}
+)
+
+// NewVanDerWaals creates a van der Waals gas from its parameters.
+// Returns an error if a is not in Pa·m⁶/mol² or b not in m³/mol, or if
+// either is negative.
+func NewVanDerWaals(a, b units.Value) (VanDerWaals, error) {
+	if a.Dim() != vdwADim {
+		return VanDerWaals{}, fmt.Errorf("parameter a must have dimension %s, got %s", vdwADim, a.Dim())
+	}
+	if b.Dim() != vdwBDim {
+		return VanDerWaals{}, fmt.Errorf("parameter b must have dimension %s, got %s", vdwBDim, b.Dim())
+	}
+	if a.Val() < 0 || b.Val() < 0 {
+		return VanDerWaals{}, fmt.Errorf("parameters must be non-negative, got a = %g, b = %g", a.Val(), b.Val())
+	}
+	return VanDerWaals{A: a, B: b}, nil
+}
+
+// Pressure returns P = nRT/(V - nb) - an²/V².
+// Returns an error unless V, n, T are positive and V > nb.
+func (g VanDerWaals) Pressure(v units.Volume, n units.Amount, t units.Temperature) (units.Pressure, error) {
+	if err := checkPositive([]string{"volume", "amount", "temperature"}, v.Val(), n.Val(), t.Val()); err != nil {
+		return units.Pressure{}, err
+	}
+	if err := g.checkExcluded(v, n); err != nil {
+		return units.Pressure{}, err
+	}
+	vv, nn := v.Val(), n.Val()
+	return units.Pascal(nn*r*t.Val()/(vv-nn*g.B.Val()) - g.A.Val()*nn*nn/(vv*vv)), nil
+}
+
+// Temperature returns T = (P + an²/V²)(V - nb)/nR.
+// Returns an error unless P, V, n are positive and V > nb.
+func (g VanDerWaals) Temperature(p units.Pressure, v units.Volume, n units.Amount) (units.Temperature, error) {
+	if err := checkPositive([]string{"pressure", "volume", "amount"}, p.Val(), v.Val(), n.Val()); err != nil {
+		return units.Temperature{}, err
+	}
+	if err := g.checkExcluded(v, n); err != nil {
+		return units.Temperature{}, err
+	}
+	vv, nn := v.Val(), n.Val()
+	return units.Kelvin((p.Val() + g.A.Val()*nn*nn/(vv*vv)) * (vv - nn*g.B.Val()) / (nn * r)), nil
+}
+
+// Volume returns the volume of n moles at pressure P and temperature T.
+// Below the critical temperature the cubic can have three roots; the
+// largest (gas-like) one is returned. Returns an error unless P, n, T are
+// positive.
+func (g VanDerWaals) Volume(p units.Pressure, n units.Amount, t units.Temperature) (units.Volume, error) {
+	if err := checkPositive([]string{"pressure", "amount", "temperature"}, p.Val(), n.Val(), t.Val()); err != nil {
+		return units.Volume{}, err
+	}
+	return units.CubicMeter(n.Val() * g.molarVolume(p.Val(), t.Val())), nil
+}
+
+// Amount returns the amount of gas occupying V at pressure P and
+// temperature T, using the gas-like root as in Volume. Returns an error
+// unless P, V, T are positive.
+func (g VanDerWaals) Amount(p units.Pressure, v units.Volume, t units.Temperature) (units.Amount, error) {
+	if err := checkPositive([]string{"pressure", "volume", "temperature"}, p.Val(), v.Val(), t.Val()); err != nil {
+		return units.Amount{}, err
+	}
+	return units.Mole(v.Val() / g.molarVolume(p.Val(), t.Val())), nil
+}
+
+// CriticalPoint returns the critical temperature, pressure and molar volume
+// T_c = 8a/27Rb, P_c = a/27b², V_c = 3b. Returns an error if a or b is zero.
+func (g VanDerWaals) CriticalPoint() (units.Temperature, units.Pressure, units.Value, error) {
+	a, b := g.A.Val(), g.B.Val()
+	if !(a > 0) || !(b > 0) {
+		return units.Temperature{}, units.Pressure{}, units.Value{}, fmt.Errorf("critical point requires positive a and b, got a = %g, b = %g", a, b)
+	}
+	return units.Kelvin(8 * a / (27 * r * b)), units.Pascal(a / (27 * b * b)), units.NewValue(3*b, vdwBDim), nil
+}
+
+func (g VanDerWaals) checkExcluded(v units.Volume, n units.Amount) error {
+	if nb := n.Val() * g.B.Val(); v.Val() <= nb {
+		return fmt.Errorf("volume %g m³ must exceed excluded volume nb = %g m³", v.Val(), nb)
+	}
+	return nil
+}
+
+// molarVolume returns the largest real root of
+// P v³ - (Pb + RT) v² + a v - ab = 0, which always exceeds b.
+func (g VanDerWaals) molarVolume(p, t float64) float64 {
+	a, b := g.A.Val(), g.B.Val()
+	roots := cubicRoots(-(b + r*t/p), a/p, -a*b/p)
+	return roots[len(roots)-1]
+}
+
+// cubicRoots returns the real roots of x³ + c₂x² + c₁x + c₀ in ascending
+// order.
+//
+// References:
+//   - Press et al. "Numerical Recipes", 3rd ed., Sec. 5.6
+func cubicRoots(c2, c1, c0 float64) []float64 {
+	q := (c2*c2 - 3*c1) / 9
+	rr := (2*c2*c2*c2 - 9*c2*c1 + 27*c0) / 54
+	shift := c2 / 3
+	if rr*rr < q*q*q {
+		theta := math.Acos(rr / math.Sqrt(q*q*q))
+		s := -2 * math.Sqrt(q)
+		x := []float64{
+			s*math.Cos(theta/3) - shift,
+			s*math.Cos((theta+2*math.Pi)/3) - shift,
+			s*math.Cos((theta-2*math.Pi)/3) - shift,
+		}
+		// Sort three values.
+		if x[0] > x[1] {
+			x[0], x[1] = x[1], x[0]
+		}
+		if x[1] > x[2] {
+			x[1], x[2] = x[2], x[1]
+		}
+		if x[0] > x[1] {
+			x[0], x[1] = x[1], x[0]
+		}
+		return x
+	}
+	aa := -math.Copysign(math.Cbrt(math.Abs(rr)+math.Sqrt(rr*rr-q*q*q)), rr)
+	bb := 0.0
+	if aa != 0 {
+		bb = q / aa
+	}
+	return []float64{aa + bb - shift}
+}
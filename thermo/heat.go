@@ -0,0 +1,156 @@
+package thermo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Conduction
+// -----------------------------------------------------------------------------
+
+// SlabConduction returns the steady heat flow through a plane wall of
+// conductivity k, face area A and thickness L held at a temperature
+// difference ΔT (Fourier's law). Heat flows toward the colder face; a
+// negative ΔT gives a negative flow. Returns an error unless k, A and L are
+// positive.
+//
+// Formula:
+//
+//	Q̇ = k A ΔT / L
+func SlabConduction(k units.ThermalConductivity, area units.Area, thickness units.Length, dt units.TemperatureDelta) (units.Power, error) {
+	if err := checkPositive([]string{"thermal conductivity", "area", "thickness"}, k.Val(), area.Val(), thickness.Val()); err != nil {
+		return units.Power{}, err
+	}
+	return units.Watt(k.Val() * area.Val() * dt.Val() / thickness.Val()), nil
+}
+
+// CylinderConduction returns the steady radial heat flow through a pipe
+// wall of conductivity k between radii r₁ < r₂ over a length L, with the
+// inner surface ΔT hotter than the outer. Returns an error unless k, r₁
+// and L are positive and r₂ > r₁.
+//
+// Formula:
+//
+//	Q̇ = 2π k L ΔT / ln(r₂/r₁)
+func CylinderConduction(k units.ThermalConductivity, inner, outer, length units.Length, dt units.TemperatureDelta) (units.Power, error) {
+	if err := checkPositive([]string{"thermal conductivity", "inner radius", "length"}, k.Val(), inner.Val(), length.Val()); err != nil {
+		return units.Power{}, err
+	}
+	if !(outer.Val() > inner.Val()) {
+		return units.Power{}, fmt.Errorf("outer radius %g m must exceed inner radius %g m", outer.Val(), inner.Val())
+	}
+	return units.Watt(2 * math.Pi * k.Val() * length.Val() * dt.Val() / math.Log(outer.Val()/inner.Val())), nil
+}
+
+// -----------------------------------------------------------------------------
+// Convection
+// -----------------------------------------------------------------------------
+
+// Convection returns the heat flow from a surface of area A to a fluid
+// ΔT colder, for a heat transfer coefficient h (Newton's law of cooling).
+// Returns an error unless h and A are positive.
+//
+// Formula:
+//
+//	Q̇ = h A ΔT
+func Convection(h units.HeatTransferCoefficient, area units.Area, dt units.TemperatureDelta) (units.Power, error) {
+	if err := checkPositive([]string{"heat transfer coefficient", "area"}, h.Val(), area.Val()); err != nil {
+		return units.Power{}, err
+	}
+	return units.Watt(h.Val() * area.Val() * dt.Val()), nil
+}
+
+// NewtonCooling returns the temperature after time t of a lumped body of
+// heat capacity C and surface area A, starting at T₀ in surroundings at
+// T∞ with heat transfer coefficient h. Returns an error unless h, A and C
+// are positive and t is non-negative.
+//
+// Formula:
+//
+//	T(t) = T∞ + (T₀ - T∞) exp(-hA t / C)
+func NewtonCooling(initial, ambient units.Temperature, h units.HeatTransferCoefficient, area units.Area, c units.HeatCapacity, t units.Time) (units.Temperature, error) {
+	if err := checkPositive([]string{"heat transfer coefficient", "area", "heat capacity"}, h.Val(), area.Val(), c.Val()); err != nil {
+		return units.Temperature{}, err
+	}
+	if t.Val() < 0 {
+		return units.Temperature{}, fmt.Errorf("time must be non-negative, got %g s", t.Val())
+	}
+	decay := math.Exp(-h.Val() * area.Val() * t.Val() / c.Val())
+	return units.Kelvin(ambient.Val() + (initial.Val()-ambient.Val())*decay), nil
+}
+
+// -----------------------------------------------------------------------------
+// Radiation
+// -----------------------------------------------------------------------------
+
+// GraySurface is a diffuse gray surface: one whose emissivity ε does not
+// depend on wavelength or direction.
+type GraySurface struct {
+	Area       units.Area
+	Emissivity float64 // 0 < ε ≤ 1
+}
+
+func (s GraySurface) check() error {
+	if !(s.Area.Val() > 0) {
+		return fmt.Errorf("surface area must be positive, got %g m²", s.Area.Val())
+	}
+	if !(s.Emissivity > 0 && s.Emissivity <= 1) {
+		return fmt.Errorf("emissivity must be in (0, 1], got %g", s.Emissivity)
+	}
+	return nil
+}
+
+// RadiatedPower returns the power emitted by surface s at temperature T
+// (Stefan-Boltzmann law). Returns an error if the surface is invalid or T
+// is negative.
+//
+// Formula:
+//
+//	P = ε σ A T⁴
+func RadiatedPower(s GraySurface, t units.Temperature) (units.Power, error) {
+	if err := s.check(); err != nil {
+		return units.Power{}, err
+	}
+	if t.Val() < 0 {
+		return units.Power{}, fmt.Errorf("temperature must be non-negative, got %g K", t.Val())
+	}
+	return units.Watt(s.Emissivity * constants.StefanBoltzmannConstant.Val() * s.Area.Val() * math.Pow(t.Val(), 4)), nil
+}
+
+// RadiationExchange returns the net radiative heat flow from gray surface
+// 1 at T₁ to gray surface 2 at T₂, where F₁₂ is the fraction of radiation
+// leaving surface 1 that reaches surface 2 (the view factor). Returns an
+// error if either surface is invalid, F₁₂ is not in (0, 1] or a
+// temperature is negative.
+//
+// Formula (two-surface enclosure network):
+//
+//	Q̇₁₂ = σ(T₁⁴ - T₂⁴) / [(1-ε₁)/(ε₁A₁) + 1/(A₁F₁₂) + (1-ε₂)/(ε₂A₂)]
+//
+// For large parallel plates use A₁ = A₂ and F₁₂ = 1; for a body inside a
+// much larger enclosure, F₁₂ = 1 and the result approaches ε₁σA₁(T₁⁴ - T₂⁴).
+//
+// References:
+//   - Incropera et al. "Fundamentals of Heat and Mass Transfer", 7th ed., Sec. 13.3
+func RadiationExchange(s1, s2 GraySurface, viewFactor float64, t1, t2 units.Temperature) (units.Power, error) {
+	if err := s1.check(); err != nil {
+		return units.Power{}, err
+	}
+	if err := s2.check(); err != nil {
+		return units.Power{}, err
+	}
+	if !(viewFactor > 0 && viewFactor <= 1) {
+		return units.Power{}, fmt.Errorf("view factor must be in (0, 1], got %g", viewFactor)
+	}
+	if t1.Val() < 0 || t2.Val() < 0 {
+		return units.Power{}, fmt.Errorf("temperatures must be non-negative, got %g K and %g K", t1.Val(), t2.Val())
+	}
+	a1, e1, a2, e2 := s1.Area.Val(), s1.Emissivity, s2.Area.Val(), s2.Emissivity
+	resistance := (1-e1)/(e1*a1) + 1/(a1*viewFactor) + (1-e2)/(e2*a2)
+	sigma := constants.StefanBoltzmannConstant.Val()
+	return units.Watt(sigma * (math.Pow(t1.Val(), 4) - math.Pow(t2.Val(), 4)) / resistance), nil
+}
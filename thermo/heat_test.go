@@ -0,0 +1,97 @@
+package thermo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestConduction(t *testing.T) {
+	// 0.2 m brick wall (k = 0.72 W/m·K), 10 m², 20 K across: 720 W.
+	k := units.WattPerMeterKelvin(0.72)
+	q, err := SlabConduction(k, units.SquareMeter(10), units.Meter(0.2), units.KelvinDelta(20))
+	if err != nil || !almostEqual(q.Val(), 720, 1e-12) {
+		t.Errorf("SlabConduction() = %v, %v; want 720 W", q, err)
+	}
+	if q.Dim() != units.Watt(1).Dim() {
+		t.Errorf("SlabConduction() dimension = %v", q.Dim())
+	}
+	if _, err := SlabConduction(k, units.SquareMeter(10), units.Meter(0), units.KelvinDelta(20)); err == nil {
+		t.Error("SlabConduction should reject zero thickness")
+	}
+
+	// A thin cylindrical shell behaves like a slab of area 2πrL.
+	r, w, l := 0.5, 1e-4, 2.0
+	cyl, _ := CylinderConduction(k, units.Meter(r), units.Meter(r+w), units.Meter(l), units.KelvinDelta(5))
+	slab, _ := SlabConduction(k, units.SquareMeter(2*math.Pi*(r+w/2)*l), units.Meter(w), units.KelvinDelta(5))
+	if !almostEqual(cyl.Val(), slab.Val(), 1e-7) {
+		t.Errorf("thin cylinder = %v W, want slab limit %v W", cyl.Val(), slab.Val())
+	}
+	if _, err := CylinderConduction(k, units.Meter(2), units.Meter(1), units.Meter(l), units.KelvinDelta(5)); err == nil {
+		t.Error("CylinderConduction should reject r₂ ≤ r₁")
+	}
+}
+
+func TestConvection(t *testing.T) {
+	h := units.WattPerMeter2Kelvin(25)
+	q, err := Convection(h, units.SquareMeter(2), units.Celsius(80).Difference(units.Celsius(20)))
+	if err != nil || !almostEqual(q.Val(), 3000, 1e-12) {
+		t.Errorf("Convection() = %v, %v; want 3000 W", q, err)
+	}
+
+	// After one time constant C/hA the excess temperature falls by 1/e.
+	area, c := units.SquareMeter(0.01), units.JoulePerKelvin(500)
+	tau := units.Second(c.Val() / (h.Val() * area.Val()))
+	got, err := NewtonCooling(units.Celsius(90), units.Celsius(20), h, area, c, tau)
+	if err != nil || !almostEqual(got.ToCelsius()-20, 70/math.E, 1e-12) {
+		t.Errorf("NewtonCooling(τ) = %v °C, %v; want 20 + 70/e", got.ToCelsius(), err)
+	}
+	if got, _ := NewtonCooling(units.Celsius(90), units.Celsius(20), h, area, c, units.Second(0)); got != units.Celsius(90) {
+		t.Errorf("NewtonCooling(0) = %v, want initial temperature", got)
+	}
+	if _, err := NewtonCooling(units.Celsius(90), units.Celsius(20), h, area, c, units.Second(-1)); err == nil {
+		t.Error("NewtonCooling should reject negative time")
+	}
+}
+
+func TestRadiation(t *testing.T) {
+	// A 1 m² black body at 300 K emits σT⁴ ≈ 459.3 W.
+	black := GraySurface{Area: units.SquareMeter(1), Emissivity: 1}
+	p, err := RadiatedPower(black, units.Kelvin(300))
+	if err != nil || !almostEqual(p.Val(), 459.3, 1e-4) {
+		t.Errorf("RadiatedPower() = %v, %v; want ≈ 459.3 W", p, err)
+	}
+
+	// Parallel plates: Q̇/A = σ(T₁⁴ - T₂⁴)/(1/ε₁ + 1/ε₂ - 1).
+	s1 := GraySurface{Area: units.SquareMeter(1), Emissivity: 0.8}
+	s2 := GraySurface{Area: units.SquareMeter(1), Emissivity: 0.5}
+	q, _ := RadiationExchange(s1, s2, 1, units.Kelvin(500), units.Kelvin(300))
+	sigma := 5.670374419e-8
+	want := sigma * (math.Pow(500, 4) - math.Pow(300, 4)) / (1/0.8 + 1/0.5 - 1)
+	if !almostEqual(q.Val(), want, 1e-12) {
+		t.Errorf("plate exchange = %v W, want %v", q.Val(), want)
+	}
+	back, _ := RadiationExchange(s2, s1, 1, units.Kelvin(300), units.Kelvin(500))
+	if !almostEqual(back.Val(), -q.Val(), 1e-12) {
+		t.Errorf("reverse exchange = %v, want %v", back.Val(), -q.Val())
+	}
+
+	// A small body in a large room exchanges ε₁σA₁(T₁⁴ - T₂⁴).
+	small := GraySurface{Area: units.SquareMeter(0.1), Emissivity: 0.6}
+	room := GraySurface{Area: units.SquareMeter(1e9), Emissivity: 0.9}
+	q, _ = RadiationExchange(small, room, 1, units.Kelvin(400), units.Kelvin(300))
+	if want := 0.6 * sigma * 0.1 * (math.Pow(400, 4) - math.Pow(300, 4)); !almostEqual(q.Val(), want, 1e-9) {
+		t.Errorf("body in enclosure = %v W, want %v", q.Val(), want)
+	}
+
+	if _, err := RadiatedPower(GraySurface{Area: units.SquareMeter(1), Emissivity: 1.2}, units.Kelvin(300)); err == nil {
+		t.Error("RadiatedPower should reject ε > 1")
+	}
+	if _, err := RadiationExchange(s1, s2, 0, units.Kelvin(500), units.Kelvin(300)); err == nil {
+		t.Error("RadiationExchange should reject a zero view factor")
+	}
+	if _, err := RadiationExchange(s1, s2, 1, units.Kelvin(-1), units.Kelvin(300)); err == nil {
+		t.Error("RadiationExchange should reject negative temperatures")
+	}
+}
@@ -0,0 +1,91 @@
+package thermo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// -----------------------------------------------------------------------------
+// Isothermal Processes
+// -----------------------------------------------------------------------------
+
+// IsothermalPressure returns the pressure P₂ = P₁V₁/V₂ of an ideal gas
+// after an isothermal change of volume from V₁ to V₂ (Boyle's law).
+// Returns an error unless P₁, V₁, V₂ are positive.
+func IsothermalPressure(p1 units.Pressure, v1, v2 units.Volume) (units.Pressure, error) {
+	if err := checkPositive([]string{"initial pressure", "initial volume", "final volume"}, p1.Val(), v1.Val(), v2.Val()); err != nil {
+		return units.Pressure{}, err
+	}
+	return units.Pascal(p1.Val() * v1.Val() / v2.Val()), nil
+}
+
+// IsothermalWork returns the work done by n moles of ideal gas expanding
+// reversibly at temperature T from V₁ to V₂; it is negative for a
+// compression. Returns an error unless n, T, V₁, V₂ are positive.
+//
+// Formula:
+//
+//	W = nRT ln(V₂/V₁)
+func IsothermalWork(n units.Amount, t units.Temperature, v1, v2 units.Volume) (units.Energy, error) {
+	if err := checkPositive([]string{"amount", "temperature", "initial volume", "final volume"}, n.Val(), t.Val(), v1.Val(), v2.Val()); err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(n.Val() * r * t.Val() * math.Log(v2.Val()/v1.Val())), nil
+}
+
+// -----------------------------------------------------------------------------
+// Adiabatic Processes
+// -----------------------------------------------------------------------------
+
+// AdiabaticPressure returns the pressure of an ideal gas with heat
+// capacity ratio γ after a reversible adiabatic change of volume from V₁
+// to V₂. Returns an error unless P₁, V₁, V₂ are positive and γ > 1.
+//
+// Formula:
+//
+//	P₂ = P₁ (V₁/V₂)^γ
+func AdiabaticPressure(p1 units.Pressure, v1, v2 units.Volume, gamma float64) (units.Pressure, error) {
+	if err := checkAdiabatic(gamma, []string{"initial pressure", "initial volume", "final volume"}, p1.Val(), v1.Val(), v2.Val()); err != nil {
+		return units.Pressure{}, err
+	}
+	return units.Pascal(p1.Val() * math.Pow(v1.Val()/v2.Val(), gamma)), nil
+}
+
+// AdiabaticTemperature returns the temperature of an ideal gas with heat
+// capacity ratio γ after a reversible adiabatic change of volume from V₁
+// to V₂. Returns an error unless T₁, V₁, V₂ are positive and γ > 1.
+//
+// Formula:
+//
+//	T₂ = T₁ (V₁/V₂)^(γ-1)
+func AdiabaticTemperature(t1 units.Temperature, v1, v2 units.Volume, gamma float64) (units.Temperature, error) {
+	if err := checkAdiabatic(gamma, []string{"initial temperature", "initial volume", "final volume"}, t1.Val(), v1.Val(), v2.Val()); err != nil {
+		return units.Temperature{}, err
+	}
+	return units.Kelvin(t1.Val() * math.Pow(v1.Val()/v2.Val(), gamma-1)), nil
+}
+
+// AdiabaticWork returns the work done by an ideal gas with heat capacity
+// ratio γ in a reversible adiabatic change from (P₁, V₁) to V₂; it is
+// negative for a compression. Returns an error unless P₁, V₁, V₂ are
+// positive and γ > 1.
+//
+// Formula:
+//
+//	W = (P₁V₁ - P₂V₂) / (γ - 1)
+func AdiabaticWork(p1 units.Pressure, v1, v2 units.Volume, gamma float64) (units.Energy, error) {
+	p2, err := AdiabaticPressure(p1, v1, v2, gamma)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule((p1.Val()*v1.Val() - p2.Val()*v2.Val()) / (gamma - 1)), nil
+}
+
+func checkAdiabatic(gamma float64, names []string, values ...float64) error {
+	if !(gamma > 1) || math.IsInf(gamma, 0) {
+		return fmt.Errorf("heat capacity ratio must be greater than 1, got %g", gamma)
+	}
+	return checkPositive(names, values...)
+}
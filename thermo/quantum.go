@@ -0,0 +1,111 @@
+package thermo
+
+import (
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+var (
+	hbar = constants.PlanckReduced.Val()
+	kB   = constants.BoltzmannConstant.Val()
+)
+
+// zeta32 is the Riemann zeta function ζ(3/2).
+const zeta32 = 2.612375348685488
+
+// -----------------------------------------------------------------------------
+// Degenerate Fermi Gas
+// -----------------------------------------------------------------------------
+//
+// The Fermi functions describe an ideal gas of spin-½ fermions, such as
+// the conduction electrons of a metal or the electrons of a white dwarf,
+// at zero temperature: the n particles per volume fill the momentum
+// states, two to each, up to the Fermi momentum. A gas is degenerate when
+// its temperature is well below the Fermi temperature. The energy,
+// temperature and velocity are nonrelativistic, valid while p_F ≪ mc;
+// white-dwarf cores, at n ≳ 10³⁶ m⁻³, exceed it.
+
+// FermiMomentum returns the Fermi momentum p_F = ℏ(3π²n)^(1/3) of a gas of
+// spin-½ fermions at number density n. Returns an error unless n is
+// positive.
+func FermiMomentum(n units.NumberDensity) (units.Momentum, error) {
+	if err := checkPositive([]string{"density"}, n.Val()); err != nil {
+		return units.Momentum{}, err
+	}
+	return units.KilogramMeterPerSecond(hbar * math.Cbrt(3*math.Pi*math.Pi*n.Val())), nil
+}
+
+// FermiEnergy returns the Fermi energy of a gas of spin-½ fermions of mass
+// m at number density n, the kinetic energy of the most energetic particle
+// at zero temperature. Returns an error unless m and n are positive.
+//
+// Formula:
+//
+//	E_F = ℏ²(3π²n)^(2/3) / 2m
+//
+// Copper, with one conduction electron per atom at n = 8.47 × 10²⁸ m⁻³,
+// has E_F = 7.0 eV.
+func FermiEnergy(m units.Mass, n units.NumberDensity) (units.Energy, error) {
+	p, err := fermiMomentum(m, n)
+	if err != nil {
+		return units.Energy{}, err
+	}
+	return units.Joule(p * p / (2 * m.Val())), nil
+}
+
+// FermiTemperature returns the Fermi temperature T_F = E_F/k_B of a gas of
+// spin-½ fermions of mass m at number density n. Returns an error unless
+// m and n are positive.
+func FermiTemperature(m units.Mass, n units.NumberDensity) (units.Temperature, error) {
+	e, err := FermiEnergy(m, n)
+	if err != nil {
+		return units.Temperature{}, err
+	}
+	return units.Kelvin(e.Val() / kB), nil
+}
+
+// FermiVelocity returns the Fermi velocity v_F = p_F/m of a gas of spin-½
+// fermions of mass m at number density n. Returns an error unless m and n
+// are positive.
+func FermiVelocity(m units.Mass, n units.NumberDensity) (units.Velocity, error) {
+	p, err := fermiMomentum(m, n)
+	if err != nil {
+		return units.Velocity{}, err
+	}
+	return units.MeterPerSecond(p / m.Val()), nil
+}
+
+// fermiMomentum returns p_F in kg·m/s after checking m and n.
+func fermiMomentum(m units.Mass, n units.NumberDensity) (float64, error) {
+	if err := checkPositive([]string{"mass", "density"}, m.Val(), n.Val()); err != nil {
+		return 0, err
+	}
+	p, _ := FermiMomentum(n)
+	return p.Val(), nil
+}
+
+// -----------------------------------------------------------------------------
+// Bose-Einstein Condensation
+// -----------------------------------------------------------------------------
+
+// BECTemperature returns the critical temperature below which an ideal gas
+// of spinless bosons of mass m at number density n condenses into its
+// ground state, the temperature at which the thermal de Broglie wavelength
+// λ = h/√(2πmk_B T) satisfies nλ³ = ζ(3/2). Returns an error unless m and
+// n are positive.
+//
+// Formula:
+//
+//	T_c = (2πℏ² / m k_B) (n / ζ(3/2))^(2/3)
+//
+// Liquid helium-4 at 145 kg/m³ gives 3.1 K, near its 2.17 K lambda point;
+// dilute alkali gases condense at hundreds of nanokelvin.
+func BECTemperature(m units.Mass, n units.NumberDensity) (units.Temperature, error) {
+	if err := checkPositive([]string{"mass", "density"}, m.Val(), n.Val()); err != nil {
+		return units.Temperature{}, err
+	}
+	x := math.Cbrt(n.Val() / zeta32)
+	return units.Kelvin(2 * math.Pi * hbar * hbar * x * x / (m.Val() * kB)), nil
+}
@@ -0,0 +1,75 @@
+package thermo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/constants/particles"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func TestFermiGas(t *testing.T) {
+	// Conduction electrons of copper: E_F = 7.0 eV, T_F = 8.2e4 K,
+	// v_F = 1.57e6 m/s (Ashcroft and Mermin, Table 2.1).
+	electron, _ := particles.Lookup("e-")
+	me, n := units.Mass{Value: electron.Mass.Value()}, units.PerMeter3(8.47e28)
+	e, err := FermiEnergy(me, n)
+	if err != nil || !almostEqual(e.ToElectronVolts(), 7.0, 1e-2) {
+		t.Errorf("E_F(Cu) = %v eV, %v; want ≈ 7.0", e.ToElectronVolts(), err)
+	}
+	tf, _ := FermiTemperature(me, n)
+	if !almostEqual(tf.Val(), 8.16e4, 1e-2) {
+		t.Errorf("T_F(Cu) = %v, want ≈ 8.16e4 K", tf)
+	}
+	vf, _ := FermiVelocity(me, n)
+	if !almostEqual(vf.Val(), 1.57e6, 1e-2) {
+		t.Errorf("v_F(Cu) = %v, want ≈ 1.57e6 m/s", vf)
+	}
+
+	// E_F = p_F v_F / 2, and E_F ∝ n^(2/3)
+	p, _ := FermiMomentum(n)
+	if !almostEqual(e.Val(), p.Val()*vf.Val()/2, 1e-12) {
+		t.Errorf("E_F = %v, want p_F v_F/2 = %v", e.Val(), p.Val()*vf.Val()/2)
+	}
+	e8, _ := FermiEnergy(me, units.PerMeter3(8*8.47e28))
+	if !almostEqual(e8.Val(), 4*e.Val(), 1e-12) {
+		t.Errorf("E_F(8n) = %v, want 4 E_F(n)", e8)
+	}
+
+	// A white-dwarf core is relativistic: p_F > m_e c.
+	p, _ = FermiMomentum(units.PerMeter3(1e36))
+	if p.Val() < me.Val()*constants.SpeedOfLight.Val() {
+		t.Errorf("p_F(10³⁶ m⁻³) = %v, want > m_e c", p)
+	}
+
+	if _, err := FermiEnergy(me, units.PerMeter3(0)); err == nil {
+		t.Error("FermiEnergy should reject zero density")
+	}
+	if _, err := FermiVelocity(units.Kilogram(0), n); err == nil {
+		t.Error("FermiVelocity should reject zero mass")
+	}
+}
+
+func TestBECTemperature(t *testing.T) {
+	// Liquid helium-4 at 145 kg/m³ condenses at about 3.1 K as an ideal gas.
+	m := units.AtomicMassUnit(4.0026)
+	tc, err := BECTemperature(m, units.PerMeter3(145/m.Val()))
+	if err != nil || !almostEqual(tc.Val(), 3.13, 1e-2) {
+		t.Errorf("T_c(⁴He) = %v, %v; want ≈ 3.13 K", tc, err)
+	}
+
+	// At T_c the phase-space density nλ³ is ζ(3/2).
+	rb := units.AtomicMassUnit(86.909)
+	n := units.PerCentimeter3(1e14)
+	tc, _ = BECTemperature(rb, n)
+	h := constants.PlanckConstant.Val()
+	lambda := h / math.Sqrt(2*math.Pi*rb.Val()*kB*tc.Val())
+	if psd := n.Val() * lambda * lambda * lambda; !almostEqual(psd, zeta32, 1e-8) {
+		t.Errorf("nλ³ at T_c = %v, want ζ(3/2) = %v", psd, zeta32)
+	}
+
+	if _, err := BECTemperature(rb, units.PerMeter3(-1)); err == nil {
+		t.Error("BECTemperature should reject negative density")
+	}
+}
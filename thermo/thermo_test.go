@@ -0,0 +1,172 @@
+package thermo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestIdealGas(t *testing.T) {
+	var g IdealGas
+	v, err := g.Volume(units.Atmosphere(1), units.Mole(1), units.Celsius(0))
+	if err != nil {
+		t.Fatalf("Volume() error = %v", err)
+	}
+	if !almostEqual(v.ToLiters(), 22.414, 1e-4) {
+		t.Errorf("molar volume at STP = %v L, want 22.414", v.ToLiters())
+	}
+
+	p, _ := g.Pressure(v, units.Mole(1), units.Celsius(0))
+	n, _ := g.Amount(units.Atmosphere(1), v, units.Celsius(0))
+	tt, _ := g.Temperature(units.Atmosphere(1), v, units.Mole(1))
+	if !almostEqual(p.ToAtmospheres(), 1, 1e-12) || !almostEqual(n.Val(), 1, 1e-12) || !almostEqual(tt.Val(), 273.15, 1e-12) {
+		t.Errorf("round trip P, n, T = %v atm, %v mol, %v K", p.ToAtmospheres(), n.Val(), tt.Val())
+	}
+
+	if _, err := g.Pressure(units.Liter(0), units.Mole(1), units.Kelvin(300)); err == nil {
+		t.Error("Pressure should reject zero volume")
+	}
+	if _, err := g.Volume(units.Atmosphere(1), units.Mole(1), units.Kelvin(-1)); err == nil {
+		t.Error("Volume should reject negative temperature")
+	}
+}
+
+func TestVanDerWaals(t *testing.T) {
+	// 1 mol CO₂ in 0.5 L at 300 K: nRT/(V-nb) - an²/V² ≈ 39.46 atm.
+	p, err := CarbonDioxide.Pressure(units.Liter(0.5), units.Mole(1), units.Kelvin(300))
+	if err != nil {
+		t.Fatalf("Pressure() error = %v", err)
+	}
+	ideal, _ := IdealGas{}.Pressure(units.Liter(0.5), units.Mole(1), units.Kelvin(300))
+	if p.Val() >= ideal.Val() || !almostEqual(p.ToAtmospheres(), 39.46, 1e-3) {
+		t.Errorf("P = %v atm, want ≈ 39.46 and below ideal %v atm", p.ToAtmospheres(), ideal.ToAtmospheres())
+	}
+
+	// Solving for each variable inverts Pressure, including below T_c where
+	// the cubic has three roots.
+	for _, tc := range []struct {
+		gas  VanDerWaals
+		v, n float64
+		temp float64
+	}{
+		{CarbonDioxide, 0.5e-3, 1, 300},
+		{CarbonDioxide, 20e-3, 1, 280},
+		{Nitrogen, 2.5e-3, 1, 120},
+		{Helium, 1e-3, 3, 10},
+	} {
+		v, n, temp := units.CubicMeter(tc.v), units.Mole(tc.n), units.Kelvin(tc.temp)
+		p, _ := tc.gas.Pressure(v, n, temp)
+		gotV, _ := tc.gas.Volume(p, n, temp)
+		gotN, _ := tc.gas.Amount(p, v, temp)
+		gotT, _ := tc.gas.Temperature(p, v, n)
+		if !almostEqual(gotV.Val(), tc.v, 1e-9) || !almostEqual(gotN.Val(), tc.n, 1e-9) || !almostEqual(gotT.Val(), tc.temp, 1e-9) {
+			t.Errorf("%+v: V, n, T = %v, %v, %v", tc, gotV.Val(), gotN.Val(), gotT.Val())
+		}
+	}
+
+	// Critical point of CO₂ from its vdW constants: T_c ≈ 304 K, P_c ≈ 7.4 MPa.
+	tc, pc, vc, err := CarbonDioxide.CriticalPoint()
+	if err != nil {
+		t.Fatalf("CriticalPoint() error = %v", err)
+	}
+	if !almostEqual(tc.Val(), 304, 1e-2) || !almostEqual(pc.Val(), 7.40e6, 1e-2) || !almostEqual(vc.Val(), 3*4.267e-5, 1e-12) {
+		t.Errorf("critical point = %v K, %v Pa, %v", tc.Val(), pc.Val(), vc)
+	}
+
+	// With a = b = 0 the equation reduces to the ideal gas.
+	zero, err := NewVanDerWaals(units.NewValue(0, vdwADim), units.NewValue(0, vdwBDim))
+	if err != nil {
+		t.Fatalf("NewVanDerWaals() error = %v", err)
+	}
+	v, _ := zero.Volume(units.Atmosphere(1), units.Mole(1), units.Celsius(0))
+	if !almostEqual(v.ToLiters(), 22.414, 1e-4) {
+		t.Errorf("ideal-limit molar volume = %v L, want 22.414", v.ToLiters())
+	}
+
+	if _, err := CarbonDioxide.Pressure(units.CubicMeter(4e-5), units.Mole(1), units.Kelvin(300)); err == nil {
+		t.Error("Pressure should reject V ≤ nb")
+	}
+	if _, err := NewVanDerWaals(units.NewValue(0.1, vdwBDim), units.NewValue(1e-5, vdwBDim)); err == nil {
+		t.Error("NewVanDerWaals should reject a with wrong dimension")
+	}
+	if _, err := NewVanDerWaals(units.NewValue(0.1, vdwADim), units.NewValue(-1e-5, vdwBDim)); err == nil {
+		t.Error("NewVanDerWaals should reject negative b")
+	}
+	if _, _, _, err := zero.CriticalPoint(); err == nil {
+		t.Error("CriticalPoint should reject a = b = 0")
+	}
+}
+
+func TestSolve(t *testing.T) {
+	s, err := Solve(Nitrogen, State{
+		Pressure: units.Bar(10),
+		Volume:   units.Liter(2.5),
+		Amount:   units.Mole(1),
+	})
+	if err != nil {
+		t.Fatalf("Solve() error = %v", err)
+	}
+	want, _ := Nitrogen.Temperature(units.Bar(10), units.Liter(2.5), units.Mole(1))
+	if s.Temperature != want || s.Pressure != units.Bar(10) {
+		t.Errorf("Solve() = %+v, want T = %v", s, want)
+	}
+
+	s, _ = Solve(IdealGas{}, State{Volume: units.Liter(22.414), Amount: units.Mole(1), Temperature: units.Celsius(0)})
+	if !almostEqual(s.Pressure.ToAtmospheres(), 1, 1e-4) {
+		t.Errorf("Solve() P = %v atm, want 1", s.Pressure.ToAtmospheres())
+	}
+
+	if _, err := Solve(IdealGas{}, State{Pressure: units.Bar(1)}); err == nil {
+		t.Error("Solve should reject more than one unknown")
+	}
+	full := State{units.Bar(1), units.Liter(1), units.Mole(1), units.Kelvin(300)}
+	if _, err := Solve(IdealGas{}, full); err == nil {
+		t.Error("Solve should reject a fully specified state")
+	}
+	if _, err := Solve(IdealGas{}, State{Pressure: units.Bar(-1), Amount: units.Mole(1), Temperature: units.Kelvin(300)}); err == nil {
+		t.Error("Solve should propagate equation-of-state errors")
+	}
+}
+
+func TestProcesses(t *testing.T) {
+	p1, v1, v2 := units.Atmosphere(1), units.Liter(1), units.Liter(0.1)
+
+	p2, err := IsothermalPressure(p1, v1, v2)
+	if err != nil || !almostEqual(p2.ToAtmospheres(), 10, 1e-12) {
+		t.Errorf("IsothermalPressure() = %v atm, %v; want 10", p2.ToAtmospheres(), err)
+	}
+	w, _ := IsothermalWork(units.Mole(1), units.Kelvin(300), units.Liter(1), units.Liter(2))
+	if !almostEqual(w.Val(), 8.314462618*300*math.Ln2, 1e-12) {
+		t.Errorf("IsothermalWork() = %v J, want RT ln 2", w.Val())
+	}
+
+	p2, _ = AdiabaticPressure(p1, v1, v2, 1.4)
+	if !almostEqual(p2.ToAtmospheres(), math.Pow(10, 1.4), 1e-12) {
+		t.Errorf("AdiabaticPressure() = %v atm, want 10^1.4", p2.ToAtmospheres())
+	}
+	t2, _ := AdiabaticTemperature(units.Kelvin(300), v1, v2, 1.4)
+	if !almostEqual(t2.Val(), 300*math.Pow(10, 0.4), 1e-12) {
+		t.Errorf("AdiabaticTemperature() = %v K, want 300·10^0.4", t2.Val())
+	}
+
+	// Compression work equals -n c_v ΔT; with PV = nRT this is -(P₂V₂ - P₁V₁)/(γ-1).
+	wa, _ := AdiabaticWork(p1, v1, v2, 1.4)
+	if wa.Val() >= 0 || !almostEqual(wa.Val(), -(p2.Val()*v2.Val()-p1.Val()*v1.Val())/0.4, 1e-12) {
+		t.Errorf("AdiabaticWork() = %v J", wa.Val())
+	}
+
+	if _, err := AdiabaticPressure(p1, v1, v2, 1); err == nil {
+		t.Error("AdiabaticPressure should reject γ ≤ 1")
+	}
+	if _, err := AdiabaticWork(p1, v1, units.Liter(0), 1.4); err == nil {
+		t.Error("AdiabaticWork should reject zero volume")
+	}
+	if _, err := IsothermalWork(units.Mole(0), units.Kelvin(300), v1, v2); err == nil {
+		t.Error("IsothermalWork should reject zero amount")
+	}
+}
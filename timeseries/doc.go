@@ -0,0 +1,39 @@
+// Package timeseries stores sampled simulation output as unit-safe time
+// series and provides the common diagnostics on them.
+//
+// A Series holds (Time, Value) samples sharing one dimension; a
+// VectorSeries holds (Time, Vector3) samples. Sample times must be strictly
+// increasing but need not be uniform, as with adaptive integrators.
+// Between samples a series is interpolated linearly.
+//
+// Both types support:
+//   - resampling onto a uniform time grid (Resample) and cutting a time
+//     window (Window);
+//   - summary statistics (Stats) and a trailing moving average;
+//   - differentiation by second-order finite differences on the
+//     non-uniform grid, and trapezoidal integration (Integral, Cumulative),
+//     each with the dimension divided or multiplied by time;
+//   - CSV export with a header naming each column's dimension.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/timeseries"
+//	    "github.com/sakiphan/qsim-core/units"
+//	)
+//
+//	energy := timeseries.New(units.Joule(1).Dim())
+//	for t := 0.0; t < 10; t += dt {
+//	    // ... advance the simulation
+//	    energy.Append(units.Second(t), sys.TotalEnergy().Value)
+//	}
+//
+//	s := energy.Window(units.Second(5), units.Second(10)).Stats()
+//	drift := s.Max.Val() - s.Min.Val()
+//	power := energy.Derivative() // W
+//	energy.WriteCSV(os.Stdout)
+//
+// References:
+//   - Fornberg. "Generation of finite difference formulas on arbitrarily
+//     spaced grids", Math. Comp. 51, 699 (1988)
+package timeseries
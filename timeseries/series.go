@@ -0,0 +1,348 @@
+package timeseries
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// Series is a sequence of (Time, Value) samples sharing a single dimension,
+// stored in SI base units with strictly increasing times.
+//
+// Series is not safe for concurrent modification.
+type Series struct {
+	t   []float64
+	v   []float64
+	dim units.Dimension
+}
+
+// New returns an empty series holding values of dimension dim.
+func New(dim units.Dimension) *Series {
+	return &Series{dim: dim}
+}
+
+// FromSlices creates a series from sample times (in s) and values (in SI
+// base units of dim). The slices are copied. Times must be strictly
+// increasing.
+func FromSlices(times, values []float64, dim units.Dimension) (*Series, error) {
+	if len(times) != len(values) {
+		return nil, fmt.Errorf("times and values lengths differ: %d and %d", len(times), len(values))
+	}
+	for i := 1; i < len(times); i++ {
+		if !(times[i] > times[i-1]) {
+			return nil, fmt.Errorf("sample times must be strictly increasing, got %g s after %g s", times[i], times[i-1])
+		}
+	}
+	return &Series{
+		t:   append([]float64(nil), times...),
+		v:   append([]float64(nil), values...),
+		dim: dim,
+	}, nil
+}
+
+// Append adds a sample at time t, which must be later than the last
+// sample. v must have the series dimension.
+func (s *Series) Append(t units.Time, v units.Value) error {
+	if v.Dim() != s.dim {
+		return fmt.Errorf("value dimension %s does not match series dimension %s", v.Dim(), s.dim)
+	}
+	if n := len(s.t); n > 0 && !(t.Val() > s.t[n-1]) {
+		return fmt.Errorf("sample times must be strictly increasing, got %g s after %g s", t.Val(), s.t[n-1])
+	}
+	s.t = append(s.t, t.Val())
+	s.v = append(s.v, v.Val())
+	return nil
+}
+
+// Len returns the number of samples.
+func (s *Series) Len() int {
+	return len(s.t)
+}
+
+// Dim returns the dimension of the values.
+func (s *Series) Dim() units.Dimension {
+	return s.dim
+}
+
+// At returns sample i. Panics if i is out of range.
+func (s *Series) At(i int) (units.Time, units.Value) {
+	return units.Second(s.t[i]), units.NewValue(s.v[i], s.dim)
+}
+
+// Times returns a copy of the sample times (in s).
+func (s *Series) Times() []float64 {
+	return append([]float64(nil), s.t...)
+}
+
+// Values returns a copy of the sample values (in SI base units).
+func (s *Series) Values() []float64 {
+	return append([]float64(nil), s.v...)
+}
+
+// Start returns the time of the first sample, or zero if the series is empty.
+func (s *Series) Start() units.Time {
+	if len(s.t) == 0 {
+		return units.Second(0)
+	}
+	return units.Second(s.t[0])
+}
+
+// End returns the time of the last sample, or zero if the series is empty.
+func (s *Series) End() units.Time {
+	if len(s.t) == 0 {
+		return units.Second(0)
+	}
+	return units.Second(s.t[len(s.t)-1])
+}
+
+// Interpolate returns the value at time t by linear interpolation between
+// the neighbouring samples. Returns an error if t lies outside the sampled
+// span.
+func (s *Series) Interpolate(t units.Time) (units.Value, error) {
+	v, err := interpolate(s.t, s.v, t.Val())
+	if err != nil {
+		return units.Value{}, err
+	}
+	return units.NewValue(v, s.dim), nil
+}
+
+// Resample returns the series interpolated onto the uniform grid
+// Start, Start + dt, ... up to End.
+func (s *Series) Resample(dt units.Time) (*Series, error) {
+	times, err := grid(s.t, dt)
+	if err != nil {
+		return nil, err
+	}
+	out := &Series{t: times, v: make([]float64, len(times)), dim: s.dim}
+	for i, t := range times {
+		out.v[i], _ = interpolate(s.t, s.v, t)
+	}
+	return out, nil
+}
+
+// Window returns a copy of the samples with from ≤ t ≤ to.
+func (s *Series) Window(from, to units.Time) *Series {
+	lo, hi := window(s.t, from.Val(), to.Val())
+	return &Series{
+		t:   append([]float64(nil), s.t[lo:hi]...),
+		v:   append([]float64(nil), s.v[lo:hi]...),
+		dim: s.dim,
+	}
+}
+
+// Stats summarizes the values of a series. All quantities have the series
+// dimension; for an empty series they are zero.
+type Stats struct {
+	Count    int
+	Mean     units.Value
+	StdDev   units.Value // population standard deviation
+	Min, Max units.Value
+	RMS      units.Value
+}
+
+// Stats returns summary statistics of the sample values. Samples are
+// weighted equally regardless of their spacing in time.
+func (s *Series) Stats() Stats {
+	n := len(s.v)
+	if n == 0 {
+		zero := units.NewValue(0, s.dim)
+		return Stats{Mean: zero, StdDev: zero, Min: zero, Max: zero, RMS: zero}
+	}
+	// Welford's update keeps the variance accurate for large offsets.
+	mean, m2, sq := 0.0, 0.0, 0.0
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i, x := range s.v {
+		d := x - mean
+		mean += d / float64(i+1)
+		m2 += d * (x - mean)
+		sq += x * x
+		lo, hi = math.Min(lo, x), math.Max(hi, x)
+	}
+	return Stats{
+		Count:  n,
+		Mean:   units.NewValue(mean, s.dim),
+		StdDev: units.NewValue(math.Sqrt(m2/float64(n)), s.dim),
+		Min:    units.NewValue(lo, s.dim),
+		Max:    units.NewValue(hi, s.dim),
+		RMS:    units.NewValue(math.Sqrt(sq/float64(n)), s.dim),
+	}
+}
+
+// MovingAverage returns the trailing moving average over a window of the
+// given width: each output sample is the mean of the input samples in
+// (t - width, t].
+func (s *Series) MovingAverage(width units.Time) (*Series, error) {
+	if !(width.Val() > 0) {
+		return nil, fmt.Errorf("window width must be positive, got %g s", width.Val())
+	}
+	out := &Series{t: s.Times(), v: make([]float64, len(s.v)), dim: s.dim}
+	lo, sum := 0, 0.0
+	for i, t := range s.t {
+		sum += s.v[i]
+		for s.t[lo] <= t-width.Val() {
+			sum -= s.v[lo]
+			lo++
+		}
+		out.v[i] = sum / float64(i-lo+1)
+	}
+	return out, nil
+}
+
+// Derivative returns dv/dt by second-order finite differences on the
+// sample grid (first-order for a two-sample series). The result has the
+// series dimension divided by time. Requires at least two samples.
+func (s *Series) Derivative() (*Series, error) {
+	d, err := derivative(s.t, s.v)
+	if err != nil {
+		return nil, err
+	}
+	return &Series{t: s.Times(), v: d, dim: perTime(s.dim)}, nil
+}
+
+// Integral returns ∫ v dt over the sampled span by the trapezoidal rule,
+// with the series dimension times time.
+func (s *Series) Integral() units.Value {
+	c := cumulative(s.t, s.v)
+	total := 0.0
+	if len(c) > 0 {
+		total = c[len(c)-1]
+	}
+	return units.NewValue(total, timesTime(s.dim))
+}
+
+// Cumulative returns the running integral ∫_{t₀}^{t} v dt' at each sample
+// time by the trapezoidal rule.
+func (s *Series) Cumulative() *Series {
+	return &Series{t: s.Times(), v: cumulative(s.t, s.v), dim: timesTime(s.dim)}
+}
+
+// WriteCSV writes the series as two CSV columns, time and value in SI base
+// units, under a header naming each column's dimension.
+//
+// Example output:
+//
+//	t [T^1],value [L^2 M^1 T^-2]
+//	0,1.5
+//	0.01,1.4999
+func (s *Series) WriteCSV(w io.Writer) error {
+	return writeCSV(w, s.t, []string{"value " + s.dim.String()}, [][]float64{s.v})
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+// interpolate evaluates the piecewise-linear function through (t, v) at x.
+func interpolate(t, v []float64, x float64) (float64, error) {
+	n := len(t)
+	if n == 0 {
+		return 0, fmt.Errorf("cannot interpolate an empty series")
+	}
+	if x < t[0] || x > t[n-1] {
+		return 0, fmt.Errorf("time %g s outside sampled span [%g, %g] s", x, t[0], t[n-1])
+	}
+	i := sort.SearchFloat64s(t, x)
+	if t[i] == x {
+		return v[i], nil
+	}
+	f := (x - t[i-1]) / (t[i] - t[i-1])
+	return v[i-1] + f*(v[i]-v[i-1]), nil
+}
+
+// grid returns the uniform times t[0], t[0] + dt, ... not past the last
+// sample.
+func grid(t []float64, dt units.Time) ([]float64, error) {
+	if !(dt.Val() > 0) {
+		return nil, fmt.Errorf("resampling interval must be positive, got %g s", dt.Val())
+	}
+	if len(t) == 0 {
+		return nil, fmt.Errorf("cannot resample an empty series")
+	}
+	span := t[len(t)-1] - t[0]
+	// Tolerate round-off so that an exact multiple of dt keeps its endpoint.
+	n := int(math.Floor(span/dt.Val()*(1+1e-12))) + 1
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = t[0] + float64(i)*dt.Val()
+	}
+	return out, nil
+}
+
+// window returns the index range [lo, hi) of samples with from ≤ t ≤ to.
+func window(t []float64, from, to float64) (int, int) {
+	lo := sort.SearchFloat64s(t, from)
+	hi := sort.Search(len(t), func(i int) bool { return t[i] > to })
+	if hi < lo {
+		hi = lo
+	}
+	return lo, hi
+}
+
+// derivative differentiates v(t) with three-point formulas on a
+// non-uniform grid.
+func derivative(t, v []float64) ([]float64, error) {
+	n := len(t)
+	if n < 2 {
+		return nil, fmt.Errorf("differentiation requires at least 2 samples, got %d", n)
+	}
+	d := make([]float64, n)
+	if n == 2 {
+		d[0] = (v[1] - v[0]) / (t[1] - t[0])
+		d[1] = d[0]
+		return d, nil
+	}
+	for i := 1; i < n-1; i++ {
+		h1, h2 := t[i]-t[i-1], t[i+1]-t[i]
+		d[i] = -h2/(h1*(h1+h2))*v[i-1] + (h2-h1)/(h1*h2)*v[i] + h1/(h2*(h1+h2))*v[i+1]
+	}
+	h1, h2 := t[1]-t[0], t[2]-t[1]
+	d[0] = -(2*h1+h2)/(h1*(h1+h2))*v[0] + (h1+h2)/(h1*h2)*v[1] - h1/(h2*(h1+h2))*v[2]
+	h1, h2 = t[n-2]-t[n-3], t[n-1]-t[n-2]
+	d[n-1] = h2/(h1*(h1+h2))*v[n-3] - (h1+h2)/(h1*h2)*v[n-2] + (h1+2*h2)/(h2*(h1+h2))*v[n-1]
+	return d, nil
+}
+
+// cumulative returns the running trapezoidal integral of v(t).
+func cumulative(t, v []float64) []float64 {
+	c := make([]float64, len(t))
+	for i := 1; i < len(t); i++ {
+		c[i] = c[i-1] + 0.5*(v[i]+v[i-1])*(t[i]-t[i-1])
+	}
+	return c
+}
+
+// writeCSV writes a time column followed by the given value columns.
+func writeCSV(w io.Writer, t []float64, names []string, cols [][]float64) error {
+	cw := csv.NewWriter(w)
+	row := append([]string{"t " + units.Dimension{T: 1}.String()}, names...)
+	if err := cw.Write(row); err != nil {
+		return err
+	}
+	for i := range t {
+		row = row[:0]
+		row = append(row, strconv.FormatFloat(t[i], 'g', -1, 64))
+		for _, c := range cols {
+			row = append(row, strconv.FormatFloat(c[i], 'g', -1, 64))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// perTime returns dim divided by [T].
+func perTime(dim units.Dimension) units.Dimension {
+	return units.NewValue(1, dim).Divide(units.Second(1).Value).Dim()
+}
+
+// timesTime returns dim multiplied by [T].
+func timesTime(dim units.Dimension) units.Dimension {
+	return units.NewValue(1, dim).Multiply(units.Second(1).Value).Dim()
+}
@@ -0,0 +1,195 @@
+package timeseries
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+var joule = units.Joule(1).Dim()
+
+// sampled returns f on the given times as a series of dimension dim.
+func sampled(times []float64, dim units.Dimension, f func(t float64) float64) *Series {
+	s := New(dim)
+	for _, t := range times {
+		s.Append(units.Second(t), units.NewValue(f(t), dim))
+	}
+	return s
+}
+
+// -----------------------------------------------------------------------------
+// Series Tests
+// -----------------------------------------------------------------------------
+
+func TestAppend(t *testing.T) {
+	s := New(joule)
+	if err := s.Append(units.Second(0), units.Joule(1).Value); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if err := s.Append(units.Second(0), units.Joule(2).Value); err == nil {
+		t.Error("Append() should reject a non-increasing time")
+	}
+	if err := s.Append(units.Second(1), units.Meter(2).Value); err == nil {
+		t.Error("Append() should reject a value of the wrong dimension")
+	}
+	if s.Len() != 1 {
+		t.Errorf("Len() = %d after rejected appends, want 1", s.Len())
+	}
+	if _, err := FromSlices([]float64{0, 2, 1}, []float64{0, 0, 0}, joule); err == nil {
+		t.Error("FromSlices() should reject unordered times")
+	}
+}
+
+func TestInterpolateResampleWindow(t *testing.T) {
+	s, _ := FromSlices([]float64{0, 1, 3, 4}, []float64{0, 10, 30, 20}, joule)
+	if v, _ := s.Interpolate(units.Second(2)); v.Val() != 20 || v.Dim() != joule {
+		t.Errorf("Interpolate(2 s) = %v, want 20 J", v)
+	}
+	if _, err := s.Interpolate(units.Second(5)); err == nil {
+		t.Error("Interpolate() should reject a time outside the span")
+	}
+
+	r, err := s.Resample(units.Second(0.5))
+	if err != nil || r.Len() != 9 {
+		t.Fatalf("Resample() len = %d, %v, want 9", r.Len(), err)
+	}
+	if tm, v := r.At(7); tm.Val() != 3.5 || v.Val() != 25 {
+		t.Errorf("Resample()[7] = (%v, %v), want (3.5 s, 25 J)", tm, v)
+	}
+
+	w := s.Window(units.Second(1), units.Second(3))
+	if w.Len() != 2 || w.Start().Val() != 1 || w.End().Val() != 3 {
+		t.Errorf("Window(1 s, 3 s) = %v, want samples at 1 s and 3 s", w.Times())
+	}
+	if s.Window(units.Second(1.5), units.Second(2.5)).Len() != 0 {
+		t.Error("Window() between samples should be empty")
+	}
+}
+
+func TestStats(t *testing.T) {
+	s, _ := FromSlices([]float64{0, 1, 2, 3}, []float64{1e9 + 1, 1e9 + 3, 1e9 + 5, 1e9 + 7}, joule)
+	st := s.Stats()
+	if st.Count != 4 || st.Mean.Val() != 1e9+4 || st.Min.Val() != 1e9+1 || st.Max.Val() != 1e9+7 {
+		t.Errorf("Stats() = %+v", st)
+	}
+	if !almostEqual(st.StdDev.Val(), math.Sqrt(5), 1e-6) || st.StdDev.Dim() != joule {
+		t.Errorf("StdDev = %v, want √5 J", st.StdDev)
+	}
+	if New(joule).Stats().Mean.Dim() != joule {
+		t.Error("empty Stats() should keep the series dimension")
+	}
+
+	// Trailing average over (t - 2 s, t]
+	ma, _ := s.MovingAverage(units.Second(2))
+	for i, want := range []float64{1, 2, 4, 6} {
+		if _, v := ma.At(i); !almostEqual(v.Val()-1e9, want, 1e-6) {
+			t.Errorf("MovingAverage()[%d] = %v, want %v", i, v.Val()-1e9, want)
+		}
+	}
+}
+
+func TestDerivativeIntegral(t *testing.T) {
+	// Non-uniform samples of E = t² J: second-order differences are exact.
+	times := []float64{0, 0.1, 0.3, 0.6, 1.0, 1.1}
+	s := sampled(times, joule, func(t float64) float64 { return t * t })
+	d, err := s.Derivative()
+	if err != nil {
+		t.Fatalf("Derivative() error = %v", err)
+	}
+	if d.Dim() != units.Watt(1).Dim() {
+		t.Errorf("Derivative() dimension = %v, want W", d.Dim())
+	}
+	for i, tm := range times {
+		if _, v := d.At(i); !almostEqual(v.Val(), 2*tm, 1e-12) {
+			t.Errorf("Derivative() at %g s = %v, want %v", tm, v.Val(), 2*tm)
+		}
+	}
+	if _, err := sampled([]float64{0}, joule, math.Sin).Derivative(); err == nil {
+		t.Error("Derivative() should require two samples")
+	}
+
+	// ∫₀^π sin t dt = 2
+	fine := make([]float64, 1001)
+	for i := range fine {
+		fine[i] = math.Pi * float64(i) / 1000
+	}
+	p := sampled(fine, units.Watt(1).Dim(), math.Sin)
+	if e := p.Integral(); !almostEqual(e.Val(), 2, 1e-5) || e.Dim() != joule {
+		t.Errorf("Integral() = %v, want 2 J", e)
+	}
+	c := p.Cumulative()
+	if _, v := c.At(500); !almostEqual(v.Val(), 1, 1e-5) {
+		t.Errorf("Cumulative() at π/2 = %v, want 1 J", v)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	s, _ := FromSlices([]float64{0, 0.5}, []float64{1.5, -2}, joule)
+	var buf bytes.Buffer
+	if err := s.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	want := "t [T^1],value [L^2 M^1 T^-2]\n0,1.5\n0.5,-2\n"
+	if buf.String() != want {
+		t.Errorf("WriteCSV() = %q, want %q", buf.String(), want)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// VectorSeries Tests
+// -----------------------------------------------------------------------------
+
+func TestVectorSeries(t *testing.T) {
+	// Uniform circular motion, r = 2 m, ω = 1 rad/s
+	traj := NewVector(units.Dimension{L: 1})
+	for i := 0; i <= 200; i++ {
+		tm := 2 * math.Pi * float64(i) / 200
+		traj.Append(units.Second(tm), vector.NewPosition(
+			units.Meter(2*math.Cos(tm)), units.Meter(2*math.Sin(tm)), units.Meter(0)))
+	}
+	if err := traj.Append(units.Second(10), vector.Zero(units.Dimension{T: 1})); err == nil {
+		t.Error("Append() should reject a vector of the wrong dimension")
+	}
+
+	vel, _ := traj.Derivative()
+	if vel.Dim() != (units.Dimension{L: 1, T: -1}) {
+		t.Errorf("Derivative() dimension = %v, want m/s", vel.Dim())
+	}
+	st := vel.Magnitude().Stats()
+	if !almostEqual(st.Min.Val(), 2, 1e-2) || !almostEqual(st.Max.Val(), 2, 1e-2) {
+		t.Errorf("speed range = [%v, %v], want 2 m/s", st.Min, st.Max)
+	}
+
+	p, err := traj.Interpolate(units.Second(math.Pi))
+	if err != nil || !almostEqual(p.X.Val(), -2, 1e-12) || !almostEqual(p.Y.Val(), 0, 1e-12) {
+		t.Errorf("Interpolate(π s) = %v, %v, want (-2, 0, 0) m", p, err)
+	}
+	if y := traj.Y(); y.Len() != 201 || y.Dim() != traj.Dim() {
+		t.Errorf("Y() = %d samples of %v", y.Len(), y.Dim())
+	}
+
+	// Displacement over a full period is zero.
+	if d := vel.Integral(); !almostEqual(d.X.Val(), 0, 1e-3) || !almostEqual(d.Y.Val(), 0, 1e-3) {
+		t.Errorf("∫v dt = %v, want 0", d)
+	}
+
+	half := traj.Window(units.Second(0), units.Second(math.Pi))
+	r, _ := half.Resample(units.Second(math.Pi / 4))
+	if _, v := r.At(2); r.Len() != 5 || !almostEqual(v.Y.Val(), 2, 1e-3) {
+		t.Errorf("Resample()[2] = %v of %d, want (0, 2, 0) m", v, r.Len())
+	}
+
+	var buf bytes.Buffer
+	r.WriteCSV(&buf)
+	if header := strings.SplitN(buf.String(), "\n", 2)[0]; header != "t [T^1],x [L^1],y [L^1],z [L^1]" {
+		t.Errorf("WriteCSV() header = %q", header)
+	}
+}
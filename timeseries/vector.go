@@ -0,0 +1,168 @@
+package timeseries
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/sakiphan/qsim-core/math/vector"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// VectorSeries is a sequence of (Time, Vector3) samples sharing a single
+// dimension, such as a trajectory or the total momentum of a system. It is
+// stored as three component series on a shared time grid.
+//
+// VectorSeries is not safe for concurrent modification.
+type VectorSeries struct {
+	x, y, z Series
+}
+
+// NewVector returns an empty vector series holding vectors of dimension dim.
+func NewVector(dim units.Dimension) *VectorSeries {
+	return &VectorSeries{x: Series{dim: dim}, y: Series{dim: dim}, z: Series{dim: dim}}
+}
+
+// Append adds a sample at time t, which must be later than the last
+// sample. v must have the series dimension.
+func (s *VectorSeries) Append(t units.Time, v vector.Vector3) error {
+	if v.Dim() != s.x.dim {
+		return fmt.Errorf("vector dimension %s does not match series dimension %s", v.Dim(), s.x.dim)
+	}
+	if err := s.x.Append(t, v.X); err != nil {
+		return err
+	}
+	s.y.t, s.z.t = s.x.t, s.x.t
+	s.y.v = append(s.y.v, v.Y.Val())
+	s.z.v = append(s.z.v, v.Z.Val())
+	return nil
+}
+
+// Len returns the number of samples.
+func (s *VectorSeries) Len() int {
+	return s.x.Len()
+}
+
+// Dim returns the dimension of the vectors.
+func (s *VectorSeries) Dim() units.Dimension {
+	return s.x.dim
+}
+
+// At returns sample i. Panics if i is out of range.
+func (s *VectorSeries) At(i int) (units.Time, vector.Vector3) {
+	return units.Second(s.x.t[i]), s.vec(s.x.v[i], s.y.v[i], s.z.v[i])
+}
+
+// Start returns the time of the first sample, or zero if the series is empty.
+func (s *VectorSeries) Start() units.Time {
+	return s.x.Start()
+}
+
+// End returns the time of the last sample, or zero if the series is empty.
+func (s *VectorSeries) End() units.Time {
+	return s.x.End()
+}
+
+// X returns a copy of the x component as a scalar series.
+func (s *VectorSeries) X() *Series {
+	return s.x.clone()
+}
+
+// Y returns a copy of the y component as a scalar series.
+func (s *VectorSeries) Y() *Series {
+	return s.y.clone()
+}
+
+// Z returns a copy of the z component as a scalar series.
+func (s *VectorSeries) Z() *Series {
+	return s.z.clone()
+}
+
+// Magnitude returns |v| at each sample as a scalar series, such as the
+// speed along a velocity series.
+func (s *VectorSeries) Magnitude() *Series {
+	out := &Series{t: s.x.Times(), v: make([]float64, s.Len()), dim: s.x.dim}
+	for i := range out.v {
+		out.v[i] = math.Sqrt(s.x.v[i]*s.x.v[i] + s.y.v[i]*s.y.v[i] + s.z.v[i]*s.z.v[i])
+	}
+	return out
+}
+
+// Interpolate returns the vector at time t by componentwise linear
+// interpolation. Returns an error if t lies outside the sampled span.
+func (s *VectorSeries) Interpolate(t units.Time) (vector.Vector3, error) {
+	x, err := interpolate(s.x.t, s.x.v, t.Val())
+	if err != nil {
+		return vector.Vector3{}, err
+	}
+	y, _ := interpolate(s.y.t, s.y.v, t.Val())
+	z, _ := interpolate(s.z.t, s.z.v, t.Val())
+	return s.vec(x, y, z), nil
+}
+
+// Resample returns the series interpolated onto the uniform grid
+// Start, Start + dt, ... up to End.
+func (s *VectorSeries) Resample(dt units.Time) (*VectorSeries, error) {
+	x, err := s.x.Resample(dt)
+	if err != nil {
+		return nil, err
+	}
+	y, _ := s.y.Resample(dt)
+	z, _ := s.z.Resample(dt)
+	return join(x, y, z), nil
+}
+
+// Window returns a copy of the samples with from ≤ t ≤ to.
+func (s *VectorSeries) Window(from, to units.Time) *VectorSeries {
+	return join(s.x.Window(from, to), s.y.Window(from, to), s.z.Window(from, to))
+}
+
+// Derivative returns dv/dt componentwise, as for Series.Derivative; a
+// position series yields a velocity series. Requires at least two samples.
+func (s *VectorSeries) Derivative() (*VectorSeries, error) {
+	x, err := s.x.Derivative()
+	if err != nil {
+		return nil, err
+	}
+	y, _ := s.y.Derivative()
+	z, _ := s.z.Derivative()
+	return join(x, y, z), nil
+}
+
+// Integral returns ∫ v dt over the sampled span by the trapezoidal rule,
+// such as the impulse of a force series.
+func (s *VectorSeries) Integral() vector.Vector3 {
+	return vector.Vector3{X: s.x.Integral(), Y: s.y.Integral(), Z: s.z.Integral()}
+}
+
+// Cumulative returns the running integral at each sample time by the
+// trapezoidal rule.
+func (s *VectorSeries) Cumulative() *VectorSeries {
+	return join(s.x.Cumulative(), s.y.Cumulative(), s.z.Cumulative())
+}
+
+// WriteCSV writes the series as four CSV columns, time and the x, y, z
+// components in SI base units, under a header naming each column's
+// dimension.
+func (s *VectorSeries) WriteCSV(w io.Writer) error {
+	dim := s.x.dim.String()
+	return writeCSV(w, s.x.t, []string{"x " + dim, "y " + dim, "z " + dim},
+		[][]float64{s.x.v, s.y.v, s.z.v})
+}
+
+func (s *VectorSeries) vec(x, y, z float64) vector.Vector3 {
+	return vector.Vector3{
+		X: units.NewValue(x, s.x.dim),
+		Y: units.NewValue(y, s.x.dim),
+		Z: units.NewValue(z, s.x.dim),
+	}
+}
+
+// join assembles a vector series from component series on the same grid.
+func join(x, y, z *Series) *VectorSeries {
+	return &VectorSeries{x: *x, y: *y, z: *z}
+}
+
+func (s *Series) clone() *Series {
+	return &Series{t: s.Times(), v: s.Values(), dim: s.dim}
+}
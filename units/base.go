@@ -94,6 +94,12 @@ func Parsec(value float64) Length {
 	return Meter(value * 3.0856775814913673e16)
 }
 
+// Megaparsec creates a Length value in megaparsecs (10⁶ pc).
+// Commonly used for extragalactic distances.
+func Megaparsec(value float64) Length {
+	return Parsec(value * 1e6)
+}
+
 // -----------------------------------------------------------------------------
 // Mass [M]
 // -----------------------------------------------------------------------------
@@ -146,10 +152,21 @@ func AtomicMassUnit(value float64) Mass {
 	return Kilogram(value * 1.66053906660e-27)
 }
 
-// SolarMass creates a Mass value in solar masses (1 M☉ = 1.98892e30 kg).
+// MegaelectronVoltPerC2 creates a Mass value in MeV/c² (≈ 1.783e-30 kg).
+// Commonly used for particle masses.
+func MegaelectronVoltPerC2(value float64) Mass {
+	return Kilogram(value * 1.602176634e-13 / (299792458.0 * 299792458.0))
+}
+
+// GigaelectronVoltPerC2 creates a Mass value in GeV/c² (10³ MeV/c²).
+func GigaelectronVoltPerC2(value float64) Mass {
+	return MegaelectronVoltPerC2(value * 1e3)
+}
+
+// SolarMass creates a Mass value in solar masses (1 M☉ = 1.98841e30 kg).
 // One solar mass is the mass of the Sun.
 func SolarMass(value float64) Mass {
-	return Kilogram(value * 1.98892e30)
+	return Kilogram(value * 1.98841e30)
 }
 
 // EarthMass creates a Mass value in Earth masses (1 M⊕ = 5.9722e24 kg).
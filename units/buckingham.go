@@ -0,0 +1,199 @@
+package units
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Variable is a named quantity entering a dimensional analysis.
+type Variable struct {
+	Name  string
+	Value Value
+}
+
+// PiGroup is a dimensionless product of powers of variables,
+// Π = Πᵢ xᵢ^Exponents[i], with exponents indexed like the variables passed
+// to BuckinghamPi.
+type PiGroup struct {
+	Names     []string
+	Exponents []int
+	Value     float64 // Π evaluated for the given values
+}
+
+// String formats the group as a product of powers in variable order, such
+// as "L^-1 g T^2".
+func (g PiGroup) String() string {
+	var parts []string
+	for i, e := range g.Exponents {
+		switch e {
+		case 0:
+		case 1:
+			parts = append(parts, g.Names[i])
+		default:
+			parts = append(parts, g.Names[i]+"^"+strconv.Itoa(e))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// BuckinghamPi returns a complete set of independent dimensionless groups
+// formed from the variables. By the Buckingham Pi theorem, n variables
+// whose dimensions span r independent base dimensions form n - r groups,
+// and any dimensionally consistent relation among the variables can be
+// written as a relation among the groups.
+//
+// The groups are the integer null space of the dimension matrix, found by
+// exact rational elimination. Variables are taken as repeating variables
+// in the order given, so list the characteristic scales of the problem
+// first: every later variable not expressible by earlier ones appears in
+// exactly one group, with a positive exponent and the smallest integer
+// exponents possible.
+//
+// Returns an error if there are no variables or two share a name.
+//
+// Example:
+//
+//	// Pendulum: the period depends only on √(L/g).
+//	groups, _ := units.BuckinghamPi(
+//	    units.Variable{Name: "L", Value: units.Meter(1).Value},
+//	    units.Variable{Name: "m", Value: units.Kilogram(0.5).Value},
+//	    units.Variable{Name: "g", Value: units.MeterPerSecond2(9.81).Value},
+//	    units.Variable{Name: "T", Value: units.Second(2.006).Value},
+//	)
+//	// groups[0].String() = "L^-1 g T^2", groups[0].Value ≈ 39.5 ≈ 4π²
+//
+// References:
+//   - Buckingham. "On physically similar systems; illustrations of the
+//     use of dimensional equations", Phys. Rev. 4, 345 (1914)
+//   - Barenblatt. "Scaling, Self-Similarity, and Intermediate
+//     Asymptotics", Cambridge (1996), Ch. 1
+func BuckinghamPi(vars ...Variable) ([]PiGroup, error) {
+	if len(vars) == 0 {
+		return nil, fmt.Errorf("dimensional analysis needs at least one variable")
+	}
+	names := make([]string, len(vars))
+	seen := map[string]bool{}
+	for i, v := range vars {
+		if v.Name == "" {
+			return nil, fmt.Errorf("variable %d has no name", i)
+		}
+		if seen[v.Name] {
+			return nil, fmt.Errorf("duplicate variable %q", v.Name)
+		}
+		seen[v.Name] = true
+		names[i] = v.Name
+	}
+
+	// Dimension matrix: one row per base dimension, one column per
+	// variable, reduced to row echelon form.
+	n := len(vars)
+	var rows [][]*big.Rat
+	for b := 0; b < 7; b++ {
+		row := make([]*big.Rat, n)
+		nonzero := false
+		for j, v := range vars {
+			e := v.Value.Dim().exponents()[b]
+			row[j] = big.NewRat(int64(e), 1)
+			nonzero = nonzero || e != 0
+		}
+		if nonzero {
+			rows = append(rows, row)
+		}
+	}
+	pivots := reduce(rows)
+
+	// Each non-pivot column gives one group: its own exponent 1 and the
+	// pivot variables' exponents from the reduced rows.
+	isPivot := make([]bool, n)
+	for _, c := range pivots {
+		isPivot[c] = true
+	}
+	var groups []PiGroup
+	for j := 0; j < n; j++ {
+		if isPivot[j] {
+			continue
+		}
+		exp := make([]*big.Rat, n)
+		for k := range exp {
+			exp[k] = new(big.Rat)
+		}
+		exp[j].SetInt64(1)
+		for r, c := range pivots {
+			exp[c].Neg(rows[r][j])
+		}
+		g := PiGroup{Names: names, Exponents: integers(exp), Value: 1}
+		for k, e := range g.Exponents {
+			if e != 0 {
+				g.Value *= math.Pow(vars[k].Value.Val(), float64(e))
+			}
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+// exponents returns the base dimension exponents in a fixed order.
+func (d Dimension) exponents() [7]int8 {
+	return [7]int8{d.L, d.M, d.T, d.I, d.Θ, d.N, d.J}
+}
+
+// reduce brings rows to reduced row echelon form in place and returns the
+// pivot column of each leading row; any remaining rows end up zero.
+func reduce(rows [][]*big.Rat) []int {
+	var pivots []int
+	r := 0
+	for c := 0; r < len(rows) && c < len(rows[0]); c++ {
+		p := -1
+		for i := r; i < len(rows); i++ {
+			if rows[i][c].Sign() != 0 {
+				p = i
+				break
+			}
+		}
+		if p < 0 {
+			continue
+		}
+		rows[r], rows[p] = rows[p], rows[r]
+		inv := new(big.Rat).Inv(rows[r][c])
+		for k := range rows[r] {
+			rows[r][k].Mul(rows[r][k], inv)
+		}
+		for i := range rows {
+			if i == r || rows[i][c].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Set(rows[i][c])
+			for k := range rows[i] {
+				rows[i][k].Sub(rows[i][k], new(big.Rat).Mul(f, rows[r][k]))
+			}
+		}
+		pivots = append(pivots, c)
+		r++
+	}
+	return pivots
+}
+
+// integers scales rational exponents to the smallest integers with the
+// same ratios.
+func integers(exp []*big.Rat) []int {
+	lcm := big.NewInt(1)
+	for _, e := range exp {
+		d := e.Denom()
+		g := new(big.Int).GCD(nil, nil, lcm, d)
+		lcm.Mul(lcm, new(big.Int).Div(d, g))
+	}
+	nums := make([]*big.Int, len(exp))
+	gcd := new(big.Int)
+	for i, e := range exp {
+		nums[i] = new(big.Int).Mul(e.Num(), new(big.Int).Div(lcm, e.Denom()))
+		gcd.GCD(nil, nil, gcd, new(big.Int).Abs(nums[i]))
+	}
+	out := make([]int, len(exp))
+	for i, x := range nums {
+		out[i] = int(new(big.Int).Div(x, gcd).Int64())
+	}
+	return out
+}
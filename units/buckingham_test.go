@@ -0,0 +1,85 @@
+package units
+
+import (
+	"math"
+	"testing"
+)
+
+// dimOf returns the dimension of the product Πᵢ xᵢ^eᵢ.
+func dimOf(vars []Variable, g PiGroup) Dimension {
+	p := Dimensionless(1)
+	for i, e := range g.Exponents {
+		p = p.Multiply(vars[i].Value.Power(e))
+	}
+	return p.Dim()
+}
+
+func TestBuckinghamPi(t *testing.T) {
+	tests := []struct {
+		name string
+		vars []Variable
+		want []string
+	}{
+		{
+			name: "pendulum",
+			vars: []Variable{
+				{"L", Meter(1).Value}, {"m", Kilogram(0.5).Value},
+				{"g", MeterPerSecond2(9.81).Value}, {"T", Second(2.006).Value},
+			},
+			want: []string{"L^-1 g T^2"},
+		},
+		{
+			name: "drag on a sphere",
+			vars: []Variable{
+				{"D", Meter(0.1).Value}, {"v", MeterPerSecond(3).Value}, {"rho", KilogramPerMeter3(1.2).Value},
+				{"mu", PascalSecond(1.8e-5).Value}, {"F", Newton(0.05).Value},
+			},
+			want: []string{"D^-1 v^-1 rho^-1 mu", "D^-2 v^-2 rho^-1 F"},
+		},
+		{
+			name: "dimensionless variable",
+			vars: []Variable{{"theta", Dimensionless(0.3)}, {"L", Meter(2).Value}},
+			want: []string{"theta"},
+		},
+		{
+			name: "independent",
+			vars: []Variable{{"L", Meter(2).Value}, {"t", Second(1).Value}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := BuckinghamPi(tt.vars...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(groups) != len(tt.want) {
+				t.Fatalf("groups = %v, want %v", groups, tt.want)
+			}
+			for i, g := range groups {
+				if g.String() != tt.want[i] {
+					t.Errorf("group %d = %q, want %q", i, g.String(), tt.want[i])
+				}
+				if d := dimOf(tt.vars, g); d != (Dimension{}) {
+					t.Errorf("group %q has dimension %s", g, d)
+				}
+			}
+		})
+	}
+
+	groups, _ := BuckinghamPi(tests[0].vars...)
+	if v := groups[0].Value; math.Abs(v-4*math.Pi*math.Pi) > 0.01 {
+		t.Errorf("pendulum group = %v, want ≈ 4π²", v)
+	}
+	groups, _ = BuckinghamPi(tests[1].vars...)
+	if re := 1 / groups[0].Value; math.Abs(re-0.1*3*1.2/1.8e-5) > 1e-6 {
+		t.Errorf("1/Π₁ = %v, want the Reynolds number 20000", re)
+	}
+
+	if _, err := BuckinghamPi(); err == nil {
+		t.Error("expected error for no variables")
+	}
+	if _, err := BuckinghamPi(Variable{"x", Meter(1).Value}, Variable{"x", Second(1).Value}); err == nil {
+		t.Error("expected error for duplicate names")
+	}
+}
@@ -78,7 +78,7 @@ func (m Mass) ToPounds() float64 {
 
 // ToSolarMasses returns the mass value in solar masses.
 func (m Mass) ToSolarMasses() float64 {
-	return m.Val() / 1.98892e30
+	return m.Val() / 1.98841e30
 }
 
 // ToEarthMasses returns the mass value in Earth masses.
@@ -191,6 +191,11 @@ func (e Energy) ToGeV() float64 {
 	return e.ToElectronVolts() / 1e9
 }
 
+// ToHartrees returns the energy value in hartrees.
+func (e Energy) ToHartrees() float64 {
+	return e.Val() / 4.3597447222071e-18
+}
+
 // ToNewtons returns the force value in newtons.
 func (f Force) ToNewtons() float64 {
 	return f.Val()
@@ -248,7 +253,7 @@ func (p Pressure) ToAtmospheres() float64 {
 
 // ToPSI returns the pressure value in pounds per square inch.
 func (p Pressure) ToPSI() float64 {
-	return p.Val() / 6894.757293168
+	return p.Val() / (4.4482216152605 / (0.0254 * 0.0254))
 }
 
 // ToHertz returns the frequency value in hertz.
@@ -271,6 +276,47 @@ func (f Frequency) ToGigahertz() float64 {
 	return f.Val() / 1e9
 }
 
+// ToKilometerPerSecondPerMegaparsec returns the frequency value as a Hubble
+// parameter in km/s/Mpc.
+func (f Frequency) ToKilometerPerSecondPerMegaparsec() float64 {
+	return f.Val() * 3.0856775814913673e22 / 1e3
+}
+
+// ToRadians returns the angle value in radians.
+func (a Angle) ToRadians() float64 {
+	return a.Val()
+}
+
+// ToDegrees returns the angle value in degrees.
+func (a Angle) ToDegrees() float64 {
+	return a.Val() * 57.29577951308232 // 180/π
+}
+
+// ToArcminutes returns the angle value in minutes of arc.
+func (a Angle) ToArcminutes() float64 {
+	return a.ToDegrees() * 60
+}
+
+// ToArcseconds returns the angle value in seconds of arc.
+func (a Angle) ToArcseconds() float64 {
+	return a.ToDegrees() * 3600
+}
+
+// ToHourAngle returns the angle value in hours (15° per hour).
+func (a Angle) ToHourAngle() float64 {
+	return a.ToDegrees() / 15
+}
+
+// ToBecquerels returns the activity value in becquerels.
+func (a Activity) ToBecquerels() float64 {
+	return a.Val()
+}
+
+// ToCuries returns the activity value in curies.
+func (a Activity) ToCuries() float64 {
+	return a.Val() / 3.7e10
+}
+
 // ToMeterPerSecond returns the velocity value in meters per second.
 func (v Velocity) ToMeterPerSecond() float64 {
 	return v.Val()
@@ -291,6 +337,11 @@ func (v Velocity) ToSpeedOfLight() float64 {
 	return v.Val() / 299792458.0
 }
 
+// ToCubicKilometerPerSecond2 returns the gravitational parameter in km³/s².
+func (mu StandardGravitationalParameter) ToCubicKilometerPerSecond2() float64 {
+	return mu.Val() / 1e9
+}
+
 // ToVolts returns the voltage value in volts.
 func (v Voltage) ToVolts() float64 {
 	return v.Val()
@@ -341,11 +392,36 @@ func (b MagneticField) ToTeslas() float64 {
 	return b.Val()
 }
 
+// ToNanoteslas returns the magnetic field value in nanoteslas.
+func (b MagneticField) ToNanoteslas() float64 {
+	return b.Val() * 1e9
+}
+
 // ToGauss returns the magnetic field value in gauss.
 func (b MagneticField) ToGauss() float64 {
 	return b.Val() * 1e4
 }
 
+// ToJoulesPerTesla returns the magnetic moment value in J/T (A⋅m²).
+func (m MagneticMoment) ToJoulesPerTesla() float64 {
+	return m.Val()
+}
+
+// ToBohrMagnetons returns the magnetic moment value in Bohr magnetons.
+func (m MagneticMoment) ToBohrMagnetons() float64 {
+	return m.Val() / 9.2740100783e-24
+}
+
+// ToNuclearMagnetons returns the magnetic moment value in nuclear magnetons.
+func (m MagneticMoment) ToNuclearMagnetons() float64 {
+	return m.Val() / 5.0507837461e-27
+}
+
+// ToMegahertzPerTesla returns γ/2π in MHz/T.
+func (g GyromagneticRatio) ToMegahertzPerTesla() float64 {
+	return g.Val() / 6283185.307179586 // 2π × 10⁶
+}
+
 // ToSquareMeters returns the area value in square meters.
 func (a Area) ToSquareMeters() float64 {
 	return a.Val()
@@ -361,6 +437,11 @@ func (a Area) ToHectares() float64 {
 	return a.Val() / 1e4
 }
 
+// ToBarns returns the area value in barns.
+func (a Area) ToBarns() float64 {
+	return a.Val() / 1e-28
+}
+
 // ToCubicMeters returns the volume value in cubic meters.
 func (v Volume) ToCubicMeters() float64 {
 	return v.Val()
@@ -375,3 +456,8 @@ func (v Volume) ToLiters() float64 {
 func (v Volume) ToMilliliters() float64 {
 	return v.Val() * 1e6
 }
+
+// ToGramsPerMole returns the molar mass value in grams per mole.
+func (mm MolarMass) ToGramsPerMole() float64 {
+	return mm.Val() * 1e3
+}
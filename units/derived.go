@@ -4,9 +4,10 @@ package units
 // Each derived unit has a specific dimensional formula and physical meaning.
 //
 // Common derived units include:
-//   - Mechanical: Force, Energy, Power, Pressure
-//   - Electromagnetic: Charge, Voltage, Resistance, Capacitance, Magnetic Field
-//   - Frequency and other special units
+//   - Mechanical: Force, Energy, Power, Pressure, Viscosity, flow rates
+//   - Electromagnetic: Charge, Voltage, Resistance, Capacitance, Magnetic Field, Electric Field
+//   - Thermal: TemperatureDelta, ThermalConductivity, HeatTransferCoefficient, SpecificHeat
+//   - Frequency, Angle, Activity and other special units
 //
 // References:
 //   - BIPM, "The International System of Units (SI)", 9th edition, 2019
@@ -38,6 +39,17 @@ func Hectare(value float64) Area {
 	return SquareMeter(value * 1e4)
 }
 
+// Barn creates an Area value in barns (10⁻²⁸ m²), the customary unit of
+// nuclear and particle cross-sections.
+func Barn(value float64) Area {
+	return SquareMeter(value * 1e-28)
+}
+
+// Millibarn creates an Area value in millibarns (10⁻³¹ m²).
+func Millibarn(value float64) Area {
+	return SquareMeter(value * 1e-31)
+}
+
 // Volume represents a physical volume with dimension [L³].
 type Volume struct{ Value }
 
@@ -102,6 +114,22 @@ func StandardGravity(value float64) Acceleration {
 	return MeterPerSecond2(value * 9.80665)
 }
 
+// StandardGravitationalParameter represents a gravitational parameter
+// μ = GM with dimension [L³T⁻²]. For Solar System bodies μ is measured far
+// more precisely than G or M, so orbital mechanics should start from it.
+type StandardGravitationalParameter struct{ Value }
+
+// CubicMeterPerSecond2 creates a StandardGravitationalParameter value in m³/s².
+func CubicMeterPerSecond2(value float64) StandardGravitationalParameter {
+	return StandardGravitationalParameter{NewValue(value, Dimension{L: 3, T: -2})}
+}
+
+// CubicKilometerPerSecond2 creates a StandardGravitationalParameter value in
+// km³/s² (10⁹ m³/s²), the unit of planetary ephemerides.
+func CubicKilometerPerSecond2(value float64) StandardGravitationalParameter {
+	return CubicMeterPerSecond2(value * 1e9)
+}
+
 // -----------------------------------------------------------------------------
 // Mechanical Units
 // -----------------------------------------------------------------------------
@@ -130,6 +158,25 @@ func PoundForce(value float64) Force {
 	return Newton(value * 4.4482216152605)
 }
 
+// Momentum represents a linear momentum with dimension [LMT⁻¹].
+type Momentum struct{ Value }
+
+// KilogramMeterPerSecond creates a Momentum value in kg⋅m/s (N⋅s).
+func KilogramMeterPerSecond(value float64) Momentum {
+	return Momentum{NewValue(value, Dimension{L: 1, M: 1, T: -1})}
+}
+
+// MegaelectronVoltPerC creates a Momentum value in MeV/c (≈ 5.344e-22 kg⋅m/s).
+// Commonly used in particle physics.
+func MegaelectronVoltPerC(value float64) Momentum {
+	return KilogramMeterPerSecond(value * 1.602176634e-13 / 299792458.0)
+}
+
+// GigaelectronVoltPerC creates a Momentum value in GeV/c (10³ MeV/c).
+func GigaelectronVoltPerC(value float64) Momentum {
+	return MegaelectronVoltPerC(value * 1e3)
+}
+
 // Energy represents an energy with dimension [L²MT⁻²].
 type Energy struct{ Value }
 
@@ -179,6 +226,12 @@ func GigaelectronVolt(value float64) Energy {
 	return ElectronVolt(value * 1e9)
 }
 
+// Hartree creates an Energy value in hartrees, the atomic unit of energy
+// (E_h = 4.3597447222071e-18 J, CODATA 2018).
+func Hartree(value float64) Energy {
+	return Joule(value * 4.3597447222071e-18)
+}
+
 // Power represents a power (energy per time) with dimension [L²MT⁻³].
 type Power struct{ Value }
 
@@ -235,15 +288,149 @@ func Atmosphere(value float64) Pressure {
 	return Pascal(value * 101325.0)
 }
 
-// Torr creates a Pressure value in torr (133.322368421 Pa).
+// Torr creates a Pressure value in torr (133.3223684... Pa).
 // 1 torr = 1/760 atm
 func Torr(value float64) Pressure {
-	return Pascal(value * 133.322368421)
+	return Pascal(value * 101325.0 / 760)
 }
 
-// PSI creates a Pressure value in pounds per square inch (6894.757293168 Pa).
+// PSI creates a Pressure value in pounds per square inch (6894.757293168... Pa).
+// 1 psi = 1 lbf/in²
 func PSI(value float64) Pressure {
-	return Pascal(value * 6894.757293168)
+	return Pascal(value * 4.4482216152605 / (0.0254 * 0.0254))
+}
+
+// Density represents a mass density (mass per volume) with dimension [L⁻³M].
+type Density struct{ Value }
+
+// KilogramPerMeter3 creates a Density value in kilograms per cubic meter.
+func KilogramPerMeter3(value float64) Density {
+	return Density{NewValue(value, Dimension{L: -3, M: 1})}
+}
+
+// GramPerCentimeter3 creates a Density value in grams per cubic centimeter (10³ kg/m³).
+func GramPerCentimeter3(value float64) Density {
+	return KilogramPerMeter3(value * 1e3)
+}
+
+// NumberDensity represents a number of particles per volume with dimension [L⁻³].
+type NumberDensity struct{ Value }
+
+// PerMeter3 creates a NumberDensity value in particles per cubic meter.
+func PerMeter3(value float64) NumberDensity {
+	return NumberDensity{NewValue(value, Dimension{L: -3})}
+}
+
+// PerCentimeter3 creates a NumberDensity value in particles per cubic centimeter (10⁶ m⁻³).
+func PerCentimeter3(value float64) NumberDensity {
+	return PerMeter3(value * 1e6)
+}
+
+// MolarMass represents a mass per amount of substance with dimension [MN⁻¹].
+type MolarMass struct{ Value }
+
+// KilogramPerMole creates a MolarMass value in kilograms per mole.
+func KilogramPerMole(value float64) MolarMass {
+	return MolarMass{NewValue(value, Dimension{M: 1, N: -1})}
+}
+
+// GramPerMole creates a MolarMass value in grams per mole (10⁻³ kg/mol).
+func GramPerMole(value float64) MolarMass {
+	return KilogramPerMole(value * 1e-3)
+}
+
+// SpringConstant represents a spring stiffness (force per displacement) with dimension [MT⁻²].
+type SpringConstant struct{ Value }
+
+// NewtonPerMeter creates a SpringConstant value in newtons per meter (kg/s²).
+func NewtonPerMeter(value float64) SpringConstant {
+	return SpringConstant{NewValue(value, Dimension{M: 1, T: -2})}
+}
+
+// DampingCoefficient represents a viscous damping coefficient (force per velocity)
+// with dimension [MT⁻¹].
+type DampingCoefficient struct{ Value }
+
+// NewtonSecondPerMeter creates a DampingCoefficient value in N⋅s/m (kg/s).
+func NewtonSecondPerMeter(value float64) DampingCoefficient {
+	return DampingCoefficient{NewValue(value, Dimension{M: 1, T: -1})}
+}
+
+// Viscosity represents a dynamic viscosity with dimension [L⁻¹MT⁻¹].
+type Viscosity struct{ Value }
+
+// PascalSecond creates a Viscosity value in pascal seconds.
+func PascalSecond(value float64) Viscosity {
+	return Viscosity{NewValue(value, Dimension{L: -1, M: 1, T: -1})}
+}
+
+// Centipoise creates a Viscosity value in centipoise (10⁻³ Pa⋅s).
+func Centipoise(value float64) Viscosity {
+	return PascalSecond(value * 1e-3)
+}
+
+// KinematicViscosity represents a kinematic viscosity (momentum
+// diffusivity) with dimension [L²T⁻¹].
+type KinematicViscosity struct{ Value }
+
+// SquareMeterPerSecond creates a KinematicViscosity value in m²/s.
+func SquareMeterPerSecond(value float64) KinematicViscosity {
+	return KinematicViscosity{NewValue(value, Dimension{L: 2, T: -1})}
+}
+
+// Centistokes creates a KinematicViscosity value in centistokes (10⁻⁶ m²/s).
+func Centistokes(value float64) KinematicViscosity {
+	return SquareMeterPerSecond(value * 1e-6)
+}
+
+// VolumetricFlowRate represents a volume per time with dimension [L³T⁻¹].
+type VolumetricFlowRate struct{ Value }
+
+// CubicMeterPerSecond creates a VolumetricFlowRate value in m³/s.
+func CubicMeterPerSecond(value float64) VolumetricFlowRate {
+	return VolumetricFlowRate{NewValue(value, Dimension{L: 3, T: -1})}
+}
+
+// LiterPerMinute creates a VolumetricFlowRate value in L/min (1/60000 m³/s).
+func LiterPerMinute(value float64) VolumetricFlowRate {
+	return CubicMeterPerSecond(value * 1e-3 / 60)
+}
+
+// MassFlowRate represents a mass per time with dimension [MT⁻¹].
+type MassFlowRate struct{ Value }
+
+// KilogramPerSecond creates a MassFlowRate value in kg/s.
+func KilogramPerSecond(value float64) MassFlowRate {
+	return MassFlowRate{NewValue(value, Dimension{M: 1, T: -1})}
+}
+
+// -----------------------------------------------------------------------------
+// Rotational Units
+// -----------------------------------------------------------------------------
+
+// MomentOfInertia represents a moment of inertia with dimension [L²M].
+type MomentOfInertia struct{ Value }
+
+// KilogramMeter2 creates a MomentOfInertia value in kilogram square meters.
+func KilogramMeter2(value float64) MomentOfInertia {
+	return MomentOfInertia{NewValue(value, Dimension{L: 2, M: 1})}
+}
+
+// Torque represents a torque (moment of force) with dimension [L²MT⁻²].
+// Note: Torque shares its dimension with Energy but is a distinct physical quantity.
+type Torque struct{ Value }
+
+// NewtonMeter creates a Torque value in newton meters.
+func NewtonMeter(value float64) Torque {
+	return Torque{NewValue(value, Dimension{L: 2, M: 1, T: -2})}
+}
+
+// AngularMomentum represents an angular momentum with dimension [L²MT⁻¹].
+type AngularMomentum struct{ Value }
+
+// KilogramMeter2PerSecond creates an AngularMomentum value in kg⋅m²/s.
+func KilogramMeter2PerSecond(value float64) AngularMomentum {
+	return AngularMomentum{NewValue(value, Dimension{L: 2, M: 1, T: -1})}
 }
 
 // -----------------------------------------------------------------------------
@@ -273,6 +460,42 @@ func Gigahertz(value float64) Frequency {
 	return Hertz(value * 1e9)
 }
 
+// KilometerPerSecondPerMegaparsec creates a Frequency value from a Hubble
+// parameter in km/s/Mpc (≈ 3.2408e-20 s⁻¹).
+func KilometerPerSecondPerMegaparsec(value float64) Frequency {
+	return Hertz(value * 1e3 / 3.0856775814913673e22)
+}
+
+// Angle represents a plane angle. Radians are dimensionless, so an Angle
+// carries an empty dimension and is stored in radians.
+type Angle struct{ Value }
+
+// Radian creates an Angle value in radians.
+func Radian(value float64) Angle {
+	return Angle{NewValue(value, Dimension{})}
+}
+
+// Degree creates an Angle value in degrees (π/180 rad).
+func Degree(value float64) Angle {
+	return Radian(value * 0.017453292519943295) // π/180
+}
+
+// ArcMinute creates an Angle value in minutes of arc (1/60°).
+func ArcMinute(value float64) Angle {
+	return Degree(value / 60)
+}
+
+// ArcSecond creates an Angle value in seconds of arc (1/3600°).
+func ArcSecond(value float64) Angle {
+	return Degree(value / 3600)
+}
+
+// HourAngle creates an Angle value in hours of right ascension or hour
+// angle (1 h = 15°).
+func HourAngle(value float64) Angle {
+	return Degree(value * 15)
+}
+
 // AngularVelocity represents an angular velocity with dimension [T⁻¹].
 // Note: Radians are dimensionless, so angular velocity has the same dimension as frequency.
 type AngularVelocity struct{ Value }
@@ -288,6 +511,25 @@ func RPM(value float64) AngularVelocity {
 	return RadianPerSecond(value * 0.10471975511965977) // 2π/60
 }
 
+// Activity represents the decay rate of a radioactive sample with
+// dimension [T⁻¹].
+type Activity struct{ Value }
+
+// Becquerel creates an Activity value in becquerels (decays per second).
+func Becquerel(value float64) Activity {
+	return Activity{NewValue(value, Dimension{T: -1})}
+}
+
+// Curie creates an Activity value in curies (3.7×10¹⁰ Bq).
+func Curie(value float64) Activity {
+	return Becquerel(value * 3.7e10)
+}
+
+// Microcurie creates an Activity value in microcuries (3.7×10⁴ Bq).
+func Microcurie(value float64) Activity {
+	return Becquerel(value * 3.7e4)
+}
+
 // -----------------------------------------------------------------------------
 // Electromagnetic Units
 // -----------------------------------------------------------------------------
@@ -421,6 +663,12 @@ func Microtesla(value float64) MagneticField {
 	return Tesla(value * 1e-6)
 }
 
+// Nanotesla creates a MagneticField value in nanoteslas (10⁻⁹ T), the
+// customary unit of geomagnetism.
+func Nanotesla(value float64) MagneticField {
+	return Tesla(value * 1e-9)
+}
+
 // Gauss creates a MagneticField value in gauss (10⁻⁴ T).
 // The gauss is the CGS unit of magnetic flux density.
 func Gauss(value float64) MagneticField {
@@ -446,6 +694,130 @@ func Maxwell(value float64) MagneticFlux {
 	return Weber(value * 1e-8)
 }
 
+// MagneticMoment represents a magnetic dipole moment with dimension [L²I],
+// in A⋅m², equivalently J/T.
+type MagneticMoment struct{ Value }
+
+// AmpereMeter2 creates a MagneticMoment value in A⋅m².
+func AmpereMeter2(value float64) MagneticMoment {
+	return MagneticMoment{NewValue(value, Dimension{L: 2, I: 1})}
+}
+
+// JoulePerTesla creates a MagneticMoment value in joules per tesla (A⋅m²).
+func JoulePerTesla(value float64) MagneticMoment {
+	return AmpereMeter2(value)
+}
+
+// BohrMagneton creates a MagneticMoment value in Bohr magnetons
+// (μ_B = eℏ/2m_e = 9.2740100783e-24 J/T, CODATA 2018).
+// The natural unit of electron and atomic magnetic moments.
+func BohrMagneton(value float64) MagneticMoment {
+	return AmpereMeter2(value * 9.2740100783e-24)
+}
+
+// NuclearMagneton creates a MagneticMoment value in nuclear magnetons
+// (μ_N = eℏ/2m_p = 5.0507837461e-27 J/T, CODATA 2018).
+// The natural unit of nucleon and nuclear magnetic moments.
+func NuclearMagneton(value float64) MagneticMoment {
+	return AmpereMeter2(value * 5.0507837461e-27)
+}
+
+// GyromagneticRatio represents the ratio γ = ω/B of a moment's Larmor
+// precession frequency to the field, with dimension [M⁻¹TI].
+type GyromagneticRatio struct{ Value }
+
+// RadianPerSecondTesla creates a GyromagneticRatio value in rad/(s⋅T).
+func RadianPerSecondTesla(value float64) GyromagneticRatio {
+	return GyromagneticRatio{NewValue(value, Dimension{M: -1, T: 1, I: 1})}
+}
+
+// MegahertzPerTesla creates a GyromagneticRatio from γ/2π in MHz/T, the
+// form quoted in NMR (42.577 MHz/T for the proton).
+func MegahertzPerTesla(value float64) GyromagneticRatio {
+	return RadianPerSecondTesla(value * 6283185.307179586) // 2π × 10⁶
+}
+
+// ElectricField represents an electric field strength with dimension [LMT⁻³I⁻¹].
+type ElectricField struct{ Value }
+
+// VoltPerMeter creates an ElectricField value in volts per meter (N/C).
+func VoltPerMeter(value float64) ElectricField {
+	return ElectricField{NewValue(value, Dimension{L: 1, M: 1, T: -3, I: -1})}
+}
+
+// KilovoltPerMeter creates an ElectricField value in kilovolts per meter (10³ V/m).
+func KilovoltPerMeter(value float64) ElectricField {
+	return VoltPerMeter(value * 1e3)
+}
+
+// Irradiance represents a power per unit area (radiant flux density or wave
+// intensity) with dimension [MT⁻³].
+type Irradiance struct{ Value }
+
+// WattPerMeter2 creates an Irradiance value in watts per square meter.
+func WattPerMeter2(value float64) Irradiance {
+	return Irradiance{NewValue(value, Dimension{M: 1, T: -3})}
+}
+
+// MilliwattPerCentimeter2 creates an Irradiance value in mW/cm² (10 W/m²).
+func MilliwattPerCentimeter2(value float64) Irradiance {
+	return WattPerMeter2(value * 10)
+}
+
+// -----------------------------------------------------------------------------
+// Thermal Units
+// -----------------------------------------------------------------------------
+
+// TemperatureDelta represents a temperature difference with dimension [Θ].
+// Unlike Temperature it carries no zero-point offset, so one kelvin and one
+// degree Celsius of difference are the same.
+type TemperatureDelta struct{ Value }
+
+// KelvinDelta creates a TemperatureDelta value in kelvins (equal to °C).
+func KelvinDelta(value float64) TemperatureDelta {
+	return TemperatureDelta{NewValue(value, Dimension{Θ: 1})}
+}
+
+// FahrenheitDelta creates a TemperatureDelta value in degrees Fahrenheit (5/9 K).
+func FahrenheitDelta(value float64) TemperatureDelta {
+	return KelvinDelta(value * 5.0 / 9.0)
+}
+
+// ThermalConductivity represents a thermal conductivity with dimension [LMT⁻³Θ⁻¹].
+type ThermalConductivity struct{ Value }
+
+// WattPerMeterKelvin creates a ThermalConductivity value in W/(m⋅K).
+func WattPerMeterKelvin(value float64) ThermalConductivity {
+	return ThermalConductivity{NewValue(value, Dimension{L: 1, M: 1, T: -3, Θ: -1})}
+}
+
+// HeatTransferCoefficient represents a convective heat transfer coefficient
+// with dimension [MT⁻³Θ⁻¹].
+type HeatTransferCoefficient struct{ Value }
+
+// WattPerMeter2Kelvin creates a HeatTransferCoefficient value in W/(m²⋅K).
+func WattPerMeter2Kelvin(value float64) HeatTransferCoefficient {
+	return HeatTransferCoefficient{NewValue(value, Dimension{M: 1, T: -3, Θ: -1})}
+}
+
+// HeatCapacity represents a heat capacity (energy per temperature change)
+// with dimension [L²MT⁻²Θ⁻¹].
+type HeatCapacity struct{ Value }
+
+// JoulePerKelvin creates a HeatCapacity value in J/K.
+func JoulePerKelvin(value float64) HeatCapacity {
+	return HeatCapacity{NewValue(value, Dimension{L: 2, M: 1, T: -2, Θ: -1})}
+}
+
+// SpecificHeat represents a specific heat capacity (heat capacity per
+// mass) with dimension [L²T⁻²Θ⁻¹].
+type SpecificHeat struct{ Value }
+
+// JoulePerKilogramKelvin creates a SpecificHeat value in J/(kg⋅K).
+func JoulePerKilogramKelvin(value float64) SpecificHeat {
+	return SpecificHeat{NewValue(value, Dimension{L: 2, T: -2, Θ: -1})}
+}
+
 // -----------------------------------------------------------------------------
 // Type-Safe Operations for Derived Units
 // -----------------------------------------------------------------------------
@@ -472,6 +844,11 @@ func (m Mass) MultiplyAcceleration(a Acceleration) Force {
 	return Force{m.Value.Multiply(a.Value)}
 }
 
+// MassMultiplyVelocity returns Momentum (p = mv).
+func (m Mass) MultiplyVelocity(v Velocity) Momentum {
+	return Momentum{m.Value.Multiply(v.Value)}
+}
+
 // ForceMultiply returns Energy when multiplying Force by Length (work = F⋅d).
 func (f Force) Multiply(l Length) Energy {
 	return Energy{f.Value.Multiply(l.Value)}
@@ -502,7 +879,62 @@ func (i Current) Multiply(t Time) Charge {
 	return Charge{i.Value.Multiply(t.Value)}
 }
 
+// MomentOfInertiaMultiply returns AngularMomentum when multiplying by AngularVelocity (L = Iω).
+func (i MomentOfInertia) Multiply(w AngularVelocity) AngularMomentum {
+	return AngularMomentum{i.Value.Multiply(w.Value)}
+}
+
 // ChargeDivide returns Current when dividing Charge by Time (I = Q/t).
 func (q Charge) Divide(t Time) Current {
 	return Current{q.Value.Divide(t.Value)}
 }
+
+// SpringConstantMultiply returns Force when multiplying by a displacement (Hooke's law, F = kx).
+func (k SpringConstant) Multiply(x Length) Force {
+	return Force{k.Value.Multiply(x.Value)}
+}
+
+// DampingCoefficientMultiply returns Force when multiplying by Velocity (viscous damping, F = cv).
+func (c DampingCoefficient) Multiply(v Velocity) Force {
+	return Force{c.Value.Multiply(v.Value)}
+}
+
+// TemperatureDifference returns the TemperatureDelta t - other.
+func (t Temperature) Difference(other Temperature) TemperatureDelta {
+	return KelvinDelta(t.Val() - other.Val())
+}
+
+// HeatCapacityMultiply returns Energy when multiplying by a TemperatureDelta (Q = CΔT).
+func (c HeatCapacity) Multiply(dt TemperatureDelta) Energy {
+	return Energy{c.Value.Multiply(dt.Value)}
+}
+
+// PowerDivideArea returns Irradiance when dividing Power by Area (I = P/A).
+func (p Power) DivideArea(a Area) Irradiance {
+	return Irradiance{p.Value.Divide(a.Value)}
+}
+
+// ViscosityDivideDensity returns KinematicViscosity when dividing by a Density (ν = μ/ρ).
+func (mu Viscosity) DivideDensity(rho Density) KinematicViscosity {
+	return KinematicViscosity{mu.Value.Divide(rho.Value)}
+}
+
+// AreaMultiplyVelocity returns VolumetricFlowRate for flow at v through an Area (Q = vA).
+func (a Area) MultiplyVelocity(v Velocity) VolumetricFlowRate {
+	return VolumetricFlowRate{a.Value.Multiply(v.Value)}
+}
+
+// VolumetricFlowRateMultiplyDensity returns MassFlowRate for a fluid of the given Density (ṁ = ρQ).
+func (q VolumetricFlowRate) MultiplyDensity(rho Density) MassFlowRate {
+	return MassFlowRate{q.Value.Multiply(rho.Value)}
+}
+
+// AmountMultiplyMolarMass returns the Mass of an Amount of a substance with the given MolarMass (m = nM).
+func (n Amount) MultiplyMolarMass(mm MolarMass) Mass {
+	return Mass{n.Value.Multiply(mm.Value)}
+}
+
+// MassDivideMolarMass returns the Amount in a Mass of a substance with the given MolarMass (n = m/M).
+func (m Mass) DivideMolarMass(mm MolarMass) Amount {
+	return Amount{m.Value.Divide(mm.Value)}
+}
@@ -0,0 +1,271 @@
+package units
+
+import (
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Rotational Unit Tests
+// -----------------------------------------------------------------------------
+
+func TestRotationalUnits(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   Value
+		wantDim Dimension
+	}{
+		{"moment of inertia", KilogramMeter2(2.0).Value, Dimension{L: 2, M: 1}},
+		{"torque", NewtonMeter(2.0).Value, Dimension{L: 2, M: 1, T: -2}},
+		{"angular momentum", KilogramMeter2PerSecond(2.0).Value, Dimension{L: 2, M: 1, T: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.value.Dim() != tt.wantDim {
+				t.Errorf("dimension = %v, want %v", tt.value.Dim(), tt.wantDim)
+			}
+		})
+	}
+
+	// Torque and energy share a dimension
+	if NewtonMeter(1).Dim() != Joule(1).Dim() {
+		t.Error("torque and energy should share the dimension [L²MT⁻²]")
+	}
+}
+
+func TestMomentOfInertiaMultiply(t *testing.T) {
+	// L = Iω
+	l := KilogramMeter2(4.0).Multiply(RadianPerSecond(0.5))
+	if l.Dim() != KilogramMeter2PerSecond(1).Dim() {
+		t.Errorf("L = Iω dimension = %v, want [L²MT⁻¹]", l.Dim())
+	}
+	if !almostEqual(l.Val(), 2.0, 1e-14) {
+		t.Errorf("L = Iω = %v, want 2.0", l.Val())
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Mechanical Unit Tests
+// -----------------------------------------------------------------------------
+
+func TestDensity(t *testing.T) {
+	rho := GramPerCentimeter3(1.0)
+	if rho.Dim() != (Dimension{L: -3, M: 1}) {
+		t.Errorf("density dimension = %v, want [L⁻³M]", rho.Dim())
+	}
+	if !almostEqual(rho.Val(), 1000.0, 1e-12) {
+		t.Errorf("1 g/cm³ = %v kg/m³, want 1000", rho.Val())
+	}
+
+	// ρ = m / V
+	d := Kilogram(2.0).Divide(CubicMeter(4.0).Value)
+	if d.Dim() != rho.Dim() {
+		t.Errorf("m/V dimension = %v, want %v", d.Dim(), rho.Dim())
+	}
+}
+
+func TestMolarMass(t *testing.T) {
+	water := GramPerMole(18.015)
+	if water.Dim() != (Dimension{M: 1, N: -1}) || !almostEqual(water.ToGramsPerMole(), 18.015, 1e-14) {
+		t.Errorf("M(H₂O) = %v", water)
+	}
+
+	// n = m/M and back
+	n := Kilogram(1).DivideMolarMass(water)
+	if n.Dim() != Mole(1).Dim() || !almostEqual(n.Val(), 55.508, 1e-4) {
+		t.Errorf("1 kg of water = %v, want 55.51 mol", n)
+	}
+	if m := n.MultiplyMolarMass(water); m.Dim() != Kilogram(1).Dim() || !almostEqual(m.Val(), 1, 1e-14) {
+		t.Errorf("nM = %v, want 1 kg", m)
+	}
+}
+
+func TestSpringAndDamping(t *testing.T) {
+	// F = kx
+	f := NewtonPerMeter(50).Multiply(Meter(0.1))
+	if f.Dim() != Newton(1).Dim() || !almostEqual(f.Val(), 5, 1e-14) {
+		t.Errorf("kx = %v, want 5 N", f)
+	}
+
+	// F = cv
+	f = NewtonSecondPerMeter(2).Multiply(MeterPerSecond(3))
+	if f.Dim() != Newton(1).Dim() || !almostEqual(f.Val(), 6, 1e-14) {
+		t.Errorf("cv = %v, want 6 N", f)
+	}
+}
+
+func TestMomentum(t *testing.T) {
+	p := Kilogram(2).MultiplyVelocity(MeterPerSecond(3))
+	if p.Dim() != KilogramMeterPerSecond(1).Dim() || !almostEqual(p.Val(), 6, 1e-14) {
+		t.Errorf("mv = %v, want 6 kg⋅m/s", p)
+	}
+
+	// 1 GeV/c ≈ 5.344286e-19 kg⋅m/s
+	if got := GigaelectronVoltPerC(1).Val(); !almostEqual(got/5.344286e-19, 1, 1e-6) {
+		t.Errorf("1 GeV/c = %v kg⋅m/s, want ≈ 5.344286e-19", got)
+	}
+}
+
+func TestThermalUnits(t *testing.T) {
+	dt := Celsius(100).Difference(Fahrenheit(32))
+	if dt.Dim() != (Dimension{Θ: 1}) || !almostEqual(dt.Val(), 100, 1e-12) {
+		t.Errorf("100 °C - 32 °F = %v, want 100 K", dt)
+	}
+	if !almostEqual(FahrenheitDelta(180).Val(), 100, 1e-12) {
+		t.Errorf("180 °F difference = %v, want 100 K", FahrenheitDelta(180))
+	}
+
+	// W/(m⋅K) × K/m = W/m²
+	flux := WattPerMeterKelvin(2).Value.Multiply(KelvinDelta(10).Value).Divide(Meter(0.5).Value)
+	if flux.Dim() != Watt(1).Value.Divide(SquareMeter(1).Value).Dim() {
+		t.Errorf("kΔT/L dimension = %v, want [MT⁻³]", flux.Dim())
+	}
+	if WattPerMeter2Kelvin(1).Value.Multiply(SquareMeter(1).Value).Multiply(KelvinDelta(1).Value).Dim() != Watt(1).Dim() {
+		t.Error("hAΔT should be a power")
+	}
+
+	q := JoulePerKelvin(4184).Multiply(KelvinDelta(2))
+	if q.Dim() != Joule(1).Dim() || !almostEqual(q.Val(), 8368, 1e-14) {
+		t.Errorf("CΔT = %v, want 8368 J", q)
+	}
+}
+
+func TestFieldAndIrradiance(t *testing.T) {
+	if KilovoltPerMeter(1).Dim() != Volt(1).Value.Divide(Meter(1).Value).Dim() || KilovoltPerMeter(1).Val() != 1e3 {
+		t.Errorf("1 kV/m = %v, want 1000 V/m", KilovoltPerMeter(1))
+	}
+	i := Watt(100).DivideArea(SquareMeter(4))
+	if i.Dim() != WattPerMeter2(1).Dim() || !almostEqual(i.Val(), 25, 1e-14) {
+		t.Errorf("P/A = %v, want 25 W/m²", i)
+	}
+	if !almostEqual(MilliwattPerCentimeter2(1).Val(), 10, 1e-14) {
+		t.Errorf("1 mW/cm² = %v, want 10 W/m²", MilliwattPerCentimeter2(1))
+	}
+}
+
+func TestBarn(t *testing.T) {
+	if Barn(1).Dim() != SquareMeter(1).Dim() || !almostEqual(Barn(1).Val(), 1e-28, 1e-14) {
+		t.Errorf("1 b = %v, want 1e-28 m²", Barn(1))
+	}
+	if !almostEqual(Millibarn(665).ToBarns(), 0.665, 1e-14) {
+		t.Errorf("665 mb = %v b, want 0.665", Millibarn(665).ToBarns())
+	}
+}
+
+func TestActivity(t *testing.T) {
+	if Curie(1).Dim() != Hertz(1).Dim() || Curie(1).ToBecquerels() != 3.7e10 {
+		t.Errorf("1 Ci = %v, want 3.7e10 Bq", Curie(1))
+	}
+	if !almostEqual(Microcurie(2).ToCuries(), 2e-6, 1e-14) {
+		t.Errorf("2 μCi = %v Ci", Microcurie(2).ToCuries())
+	}
+}
+
+func TestNumberDensity(t *testing.T) {
+	n := PerCentimeter3(1)
+	if n.Dim() != (Dimension{L: -3}) || n.Val() != 1e6 {
+		t.Errorf("1 cm⁻³ = %v, want 1e6 m⁻³", n)
+	}
+}
+
+func TestViscosity(t *testing.T) {
+	nu := Centipoise(1).DivideDensity(KilogramPerMeter3(1000))
+	if nu.Dim() != Centistokes(1).Dim() || !almostEqual(nu.Val(), Centistokes(1).Val(), 1e-14) {
+		t.Errorf("1 cP / 1000 kg/m³ = %v, want 1 cSt", nu)
+	}
+	if PascalSecond(1).Dim() != Pascal(1).Value.Multiply(Second(1).Value).Dim() {
+		t.Errorf("Pa⋅s dimension = %v", PascalSecond(1).Dim())
+	}
+	if JoulePerKilogramKelvin(1).Dim() != JoulePerKelvin(1).Value.Divide(Kilogram(1).Value).Dim() {
+		t.Errorf("J/(kg⋅K) dimension = %v", JoulePerKilogramKelvin(1).Dim())
+	}
+}
+
+func TestFlowRate(t *testing.T) {
+	q := SquareCentimeter(10).MultiplyVelocity(MeterPerSecond(2))
+	if q.Dim() != CubicMeterPerSecond(1).Dim() || !almostEqual(q.Val(), 2e-3, 1e-14) {
+		t.Errorf("vA = %v, want 2e-3 m³/s", q)
+	}
+	if !almostEqual(LiterPerMinute(120).Val(), 2e-3, 1e-14) {
+		t.Errorf("120 L/min = %v, want 2e-3 m³/s", LiterPerMinute(120))
+	}
+	m := q.MultiplyDensity(KilogramPerMeter3(1000))
+	if m.Dim() != KilogramPerSecond(1).Dim() || !almostEqual(m.Val(), 2, 1e-14) {
+		t.Errorf("ρQ = %v, want 2 kg/s", m)
+	}
+}
+
+func TestHubbleUnit(t *testing.T) {
+	h := KilometerPerSecondPerMegaparsec(70)
+	if h.Dim() != Hertz(1).Dim() || !almostEqual(h.Val(), 70e3/Megaparsec(1).Val(), 1e-14) {
+		t.Errorf("70 km/s/Mpc = %v, want %v s⁻¹", h, 70e3/Megaparsec(1).Val())
+	}
+	if !almostEqual(h.ToKilometerPerSecondPerMegaparsec(), 70, 1e-14) {
+		t.Errorf("round trip = %v, want 70", h.ToKilometerPerSecondPerMegaparsec())
+	}
+}
+
+func TestStandardGravitationalParameter(t *testing.T) {
+	mu := CubicKilometerPerSecond2(398600.4418)
+	want := Meter(1).Value.Power(3).Divide(Second(1).Value.Power(2)).Dim()
+	if mu.Dim() != want || !almostEqual(mu.Val(), 3.986004418e14, 1e-14) {
+		t.Errorf("μ⊕ = %v, want 3.986004418e14 m³/s²", mu)
+	}
+	if !almostEqual(mu.ToCubicKilometerPerSecond2(), 398600.4418, 1e-14) {
+		t.Errorf("round trip = %v, want 398600.4418", mu.ToCubicKilometerPerSecond2())
+	}
+}
+
+func TestHartree(t *testing.T) {
+	// E_h = 27.211386245988 eV; hydrogen's ground state is -E_h/2.
+	if e := Hartree(1); e.Dim() != Joule(1).Dim() || !almostEqual(e.ToElectronVolts(), 27.211386245988, 1e-12) {
+		t.Errorf("1 E_h = %v eV", e.ToElectronVolts())
+	}
+	if got := ElectronVolt(-13.605693122994).ToHartrees(); !almostEqual(got, -0.5, 1e-12) {
+		t.Errorf("-13.6057 eV = %v E_h, want -0.5", got)
+	}
+}
+
+func TestMagneticMoment(t *testing.T) {
+	// The electron moment is 1.00115965 μ_B; the proton's 2.79284734 μ_N.
+	e := JoulePerTesla(-9.2847647043e-24)
+	if e.Dim() != (Dimension{L: 2, I: 1}) || !almostEqual(e.ToBohrMagnetons(), -1.00115965218, 1e-10) {
+		t.Errorf("μ_e = %v μ_B", e.ToBohrMagnetons())
+	}
+	if p := AmpereMeter2(1.41060679736e-26); !almostEqual(p.ToNuclearMagnetons(), 2.79284734463, 1e-10) {
+		t.Errorf("μ_p = %v μ_N", p.ToNuclearMagnetons())
+	}
+	// μ_B/μ_N = m_p/m_e
+	if r := BohrMagneton(1).Val() / NuclearMagneton(1).Val(); !almostEqual(r, 1836.15267343, 1e-10) {
+		t.Errorf("μ_B/μ_N = %v, want m_p/m_e", r)
+	}
+}
+
+func TestGyromagneticRatio(t *testing.T) {
+	// The proton's γ_p/2π = 42.577478518 MHz/T; γ_p = 2.6752218744e8 s⁻¹ T⁻¹.
+	g := MegahertzPerTesla(42.577478518)
+	if g.Dim() != (Dimension{M: -1, T: 1, I: 1}) || !almostEqual(g.Val(), 2.6752218744e8, 1e-10) {
+		t.Errorf("γ_p = %v, want 2.6752218744e8 s⁻¹ T⁻¹", g)
+	}
+	if f := RadianPerSecondTesla(2.6752218744e8).ToMegahertzPerTesla(); !almostEqual(f, 42.577478518, 1e-10) {
+		t.Errorf("γ_p/2π = %v MHz/T", f)
+	}
+}
+
+func TestAngle(t *testing.T) {
+	if a := Degree(180); !almostEqual(a.Val(), 3.141592653589793, 1e-15) || a.Dim() != (Dimension{}) {
+		t.Errorf("180° = %v, want π rad", a)
+	}
+	if !almostEqual(HourAngle(6).ToDegrees(), 90, 1e-14) {
+		t.Errorf("6h = %v°, want 90°", HourAngle(6).ToDegrees())
+	}
+	if !almostEqual(ArcSecond(3600).Val(), Degree(1).Val(), 1e-15) || !almostEqual(ArcMinute(1).ToArcseconds(), 60, 1e-14) {
+		t.Error("arcminute/arcsecond conversions are inconsistent")
+	}
+}
+
+func TestNanotesla(t *testing.T) {
+	if b := Nanotesla(5e4); !almostEqual(b.Val(), Gauss(0.5).Val(), 1e-14) || !almostEqual(b.ToNanoteslas(), 5e4, 1e-14) {
+		t.Errorf("50000 nT = %v, want 0.5 G", b)
+	}
+}
@@ -238,7 +238,7 @@ func ExampleMass_astronomical() {
 	// Output:
 	// Solar mass: 1.99e+30 kg
 	// Earth mass: 5.97e+24 kg
-	// Sun is 333030 times more massive than Earth
+	// Sun is 332944 times more massive than Earth
 }
 
 // Example demonstrating type safety - compilation errors.
@@ -0,0 +1,228 @@
+package units
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Measurement is a quantity with a standard uncertainty propagated to first
+// order from named, independent inputs. Alongside the total it tracks the
+// contribution of each input, so a derived quantity can report which input
+// uncertainties dominate its error budget.
+//
+// Inputs sharing a name are the same input: their contributions add
+// linearly rather than in quadrature, so correlations through a common input
+// are handled exactly. For example x.Subtract(x) is exact.
+//
+// Example:
+//
+//	// Schwarzschild radius r = 2GM/c² of the Sun
+//	gDim := units.Dimension{L: 3, M: -1, T: -2}
+//	G, _ := units.Measure("G", units.NewValue(6.67430e-11, gDim), units.NewValue(1.5e-15, gDim))
+//	M, _ := units.Measure("M", units.Kilogram(1.98841e30).Value, units.Kilogram(2e25).Value)
+//	c := units.Exact(units.MeterPerSecond(299792458).Value)
+//	r := G.Multiply(M).Scale(2).Divide(c.Power(2))
+//	fmt.Print(r.Report())
+//	// G contributes 83.3% of the variance
+//	// M contributes 16.7% of the variance
+//
+// References:
+//   - JCGM 100:2008. "Evaluation of measurement data — Guide to the
+//     expression of uncertainty in measurement" (GUM), Sec. 5.1
+type Measurement struct {
+	value Value
+	parts map[string]float64 // signed sensitivity × input uncertainty, SI units
+}
+
+// Exact returns a measurement of v with no uncertainty.
+func Exact(v Value) Measurement {
+	return Measurement{value: v}
+}
+
+// Measure returns the named input v with standard uncertainty u. Returns an
+// error if the name is empty, u differs from v in dimension or u is
+// negative.
+func Measure(name string, v, u Value) (Measurement, error) {
+	if name == "" {
+		return Measurement{}, fmt.Errorf("measurement input name must not be empty")
+	}
+	if v.dim != u.dim {
+		return Measurement{}, fmt.Errorf("value and uncertainty must have the same dimension, got %s and %s",
+			v.dim.String(), u.dim.String())
+	}
+	if !(u.value >= 0) {
+		return Measurement{}, fmt.Errorf("uncertainty must be non-negative, got %g", u.value)
+	}
+	if u.value == 0 {
+		return Exact(v), nil
+	}
+	return Measurement{value: v, parts: map[string]float64{name: u.value}}, nil
+}
+
+// Value returns the best estimate of the quantity.
+func (m Measurement) Value() Value {
+	return m.value
+}
+
+// Uncertainty returns the combined standard uncertainty, the root sum of
+// squares of the input contributions.
+func (m Measurement) Uncertainty() Value {
+	return Value{value: math.Sqrt(m.variance()), dim: m.value.dim}
+}
+
+// Relative returns the relative standard uncertainty u/|x|.
+func (m Measurement) Relative() float64 {
+	return math.Sqrt(m.variance()) / math.Abs(m.value.value)
+}
+
+// IsExact returns true if the measurement carries no uncertainty.
+func (m Measurement) IsExact() bool {
+	return m.variance() == 0
+}
+
+// String formats the measurement as "value ± uncertainty unit".
+func (m Measurement) String() string {
+	return fmt.Sprintf("%.6g ± %.2g %s", m.value.value, math.Sqrt(m.variance()), m.value.dim.String())
+}
+
+func (m Measurement) variance() float64 {
+	s := 0.0
+	for _, c := range m.parts {
+		s += c * c
+	}
+	return s
+}
+
+// -----------------------------------------------------------------------------
+// Propagation
+// -----------------------------------------------------------------------------
+
+// Add returns the sum of two measurements. Returns an error if the
+// dimensions don't match.
+func (m Measurement) Add(other Measurement) (Measurement, error) {
+	v, err := m.value.Add(other.value)
+	if err != nil {
+		return Measurement{}, err
+	}
+	return Measurement{value: v, parts: combine(m.parts, 1, other.parts, 1)}, nil
+}
+
+// Subtract returns the difference of two measurements. Returns an error if
+// the dimensions don't match.
+func (m Measurement) Subtract(other Measurement) (Measurement, error) {
+	v, err := m.value.Subtract(other.value)
+	if err != nil {
+		return Measurement{}, err
+	}
+	return Measurement{value: v, parts: combine(m.parts, 1, other.parts, -1)}, nil
+}
+
+// Multiply returns the product of two measurements.
+func (m Measurement) Multiply(other Measurement) Measurement {
+	return Measurement{
+		value: m.value.Multiply(other.value),
+		parts: combine(m.parts, other.value.value, other.parts, m.value.value),
+	}
+}
+
+// Divide returns the quotient of two measurements.
+func (m Measurement) Divide(other Measurement) Measurement {
+	y := other.value.value
+	return Measurement{
+		value: m.value.Divide(other.value),
+		parts: combine(m.parts, 1/y, other.parts, -m.value.value/(y*y)),
+	}
+}
+
+// Scale returns the measurement multiplied by an exact dimensionless scalar.
+func (m Measurement) Scale(scalar float64) Measurement {
+	return Measurement{value: m.value.Scale(scalar), parts: combine(m.parts, scalar, nil, 0)}
+}
+
+// Power returns the measurement raised to an integer power.
+func (m Measurement) Power(n int) Measurement {
+	x := m.value.value
+	return Measurement{
+		value: m.value.Power(n),
+		parts: combine(m.parts, float64(n)*math.Pow(x, float64(n-1)), nil, 0),
+	}
+}
+
+// Sqrt returns the square root of the measurement. Returns an error if any
+// dimension has an odd exponent.
+func (m Measurement) Sqrt() (Measurement, error) {
+	v, err := m.value.Sqrt()
+	if err != nil {
+		return Measurement{}, err
+	}
+	return Measurement{value: v, parts: combine(m.parts, 0.5/v.value, nil, 0)}, nil
+}
+
+// combine returns the contributions a·pa + b·pb, merging inputs by name.
+func combine(pa map[string]float64, a float64, pb map[string]float64, b float64) map[string]float64 {
+	if len(pa)+len(pb) == 0 {
+		return nil
+	}
+	out := make(map[string]float64, len(pa)+len(pb))
+	for name, c := range pa {
+		out[name] += a * c
+	}
+	for name, c := range pb {
+		out[name] += b * c
+	}
+	return out
+}
+
+// -----------------------------------------------------------------------------
+// Uncertainty budget
+// -----------------------------------------------------------------------------
+
+// Contribution is the share of one input in the uncertainty of a derived
+// measurement.
+type Contribution struct {
+	Name        string
+	Uncertainty Value   // |∂f/∂xᵢ|·u(xᵢ), in the units of the measurement
+	Fraction    float64 // share of the combined variance, in [0, 1]
+}
+
+// String formats the contribution as "name: percent", such as "G: 92.0%".
+func (c Contribution) String() string {
+	return fmt.Sprintf("%s: %.1f%%", c.Name, 100*c.Fraction)
+}
+
+// Budget returns the contribution of each input to the variance of the
+// measurement, largest first with ties in name order. Fractions sum to one
+// unless the measurement is exact, in which case the budget is empty.
+func (m Measurement) Budget() []Contribution {
+	total := m.variance()
+	if total == 0 {
+		return nil
+	}
+	out := make([]Contribution, 0, len(m.parts))
+	for name, c := range m.parts {
+		out = append(out, Contribution{
+			Name:        name,
+			Uncertainty: Value{value: math.Abs(c), dim: m.value.dim},
+			Fraction:    c * c / total,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Fraction != out[j].Fraction {
+			return out[i].Fraction > out[j].Fraction
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// Report formats the budget as one line per input, such as
+// "G contributes 92.0% of the variance".
+func (m Measurement) Report() string {
+	var b strings.Builder
+	for _, c := range m.Budget() {
+		fmt.Fprintf(&b, "%s contributes %.1f%% of the variance\n", c.Name, 100*c.Fraction)
+	}
+	return b.String()
+}
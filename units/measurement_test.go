@@ -0,0 +1,131 @@
+package units
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func mustMeasure(t *testing.T, name string, v, u Value) Measurement {
+	t.Helper()
+	m, err := Measure(name, v, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestMeasurementPropagation(t *testing.T) {
+	x := mustMeasure(t, "x", Meter(3).Value, Meter(0.3).Value)
+	y := mustMeasure(t, "y", Meter(4).Value, Meter(0.4).Value)
+
+	sum, err := x.Add(y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sum.Uncertainty().Val(); !almostEqual(got, 0.5, 1e-12) {
+		t.Errorf("u(x+y) = %g, want 0.5", got)
+	}
+
+	// Relative uncertainties add in quadrature for products and quotients.
+	for _, m := range []Measurement{x.Multiply(y), x.Divide(y)} {
+		if got := m.Relative(); !almostEqual(got, math.Sqrt2*0.1, 1e-12) {
+			t.Errorf("relative uncertainty = %g, want %g", got, math.Sqrt2*0.1)
+		}
+	}
+	if got := x.Power(3).Relative(); !almostEqual(got, 0.3, 1e-12) {
+		t.Errorf("relative u(x³) = %g, want 0.3", got)
+	}
+	area := x.Multiply(y)
+	side, err := area.Sqrt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := side.Relative(); !almostEqual(got, math.Sqrt2*0.05, 1e-12) {
+		t.Errorf("relative u(√(xy)) = %g, want %g", got, math.Sqrt2*0.05)
+	}
+	if got := x.Scale(-2).Uncertainty().Val(); !almostEqual(got, 0.6, 1e-12) {
+		t.Errorf("u(-2x) = %g, want 0.6", got)
+	}
+
+	if _, err := x.Add(Exact(Second(1).Value)); err == nil {
+		t.Error("expected error adding length and time")
+	}
+}
+
+func TestMeasurementCorrelation(t *testing.T) {
+	x := mustMeasure(t, "x", Meter(3).Value, Meter(0.3).Value)
+	diff, err := x.Subtract(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.IsExact() {
+		t.Errorf("x - x = %v, want exact", diff)
+	}
+	if got := x.Multiply(x).Relative(); !almostEqual(got, 0.2, 1e-12) {
+		t.Errorf("relative u(x·x) = %g, want 0.2", got)
+	}
+	if got := x.Divide(x).Uncertainty().Val(); got != 0 {
+		t.Errorf("u(x/x) = %g, want 0", got)
+	}
+}
+
+func TestMeasurementBudget(t *testing.T) {
+	// Schwarzschild radius of the Sun: G is known to 2.2e-5, GM☉/G to 1e-5.
+	gDim := Dimension{L: 3, M: -1, T: -2}
+	G := mustMeasure(t, "G", NewValue(6.67430e-11, gDim), NewValue(1.5e-15, gDim))
+	M := mustMeasure(t, "M", Kilogram(1.98841e30).Value, Kilogram(2e25).Value)
+	c := Exact(MeterPerSecond(299792458).Value)
+	r := G.Multiply(M).Scale(2).Divide(c.Power(2))
+
+	if r.Value().Dim() != (Dimension{L: 1}) {
+		t.Fatalf("radius dimension = %s, want [L^1]", r.Value().Dim())
+	}
+	if !almostEqual(r.Value().Val(), 2953.3, 1e-4) {
+		t.Errorf("radius = %g m, want ≈ 2953 m", r.Value().Val())
+	}
+
+	b := r.Budget()
+	if len(b) != 2 || b[0].Name != "G" || b[1].Name != "M" {
+		t.Fatalf("budget = %v, want G then M", b)
+	}
+	g, m := 1.5e-15/6.67430e-11, 2e25/1.98841e30
+	if want := g * g / (g*g + m*m); !almostEqual(b[0].Fraction, want, 1e-12) {
+		t.Errorf("G fraction = %g, want %g", b[0].Fraction, want)
+	}
+	if got := b[0].Fraction + b[1].Fraction; !almostEqual(got, 1, 1e-12) {
+		t.Errorf("fractions sum to %g, want 1", got)
+	}
+	var ss float64
+	for _, c := range b {
+		ss += c.Uncertainty.Val() * c.Uncertainty.Val()
+	}
+	if !almostEqual(math.Sqrt(ss), r.Uncertainty().Val(), 1e-12) {
+		t.Errorf("contributions combine to %g, want %g", math.Sqrt(ss), r.Uncertainty().Val())
+	}
+	if got := b[0].String(); got != "G: 83.3%" {
+		t.Errorf("String() = %q, want %q", got, "G: 83.3%")
+	}
+	if !strings.HasPrefix(r.Report(), "G contributes 83.3% of the variance\n") {
+		t.Errorf("Report() = %q", r.Report())
+	}
+
+	if Exact(Meter(1).Value).Budget() != nil {
+		t.Error("exact measurement should have an empty budget")
+	}
+}
+
+func TestMeasureValidation(t *testing.T) {
+	if _, err := Measure("", Meter(1).Value, Meter(0.1).Value); err == nil {
+		t.Error("expected error for empty name")
+	}
+	if _, err := Measure("x", Meter(1).Value, Second(0.1).Value); err == nil {
+		t.Error("expected error for mismatched dimensions")
+	}
+	if _, err := Measure("x", Meter(1).Value, Meter(-0.1).Value); err == nil {
+		t.Error("expected error for negative uncertainty")
+	}
+	if m, err := Measure("x", Meter(1).Value, Meter(0).Value); err != nil || !m.IsExact() {
+		t.Errorf("zero uncertainty: got %v, %v; want exact", m, err)
+	}
+}
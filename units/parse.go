@@ -0,0 +1,309 @@
+package units
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// This file parses quantities written as text, such as "9.81 m/s^2".
+
+// -----------------------------------------------------------------------------
+// Unit Table
+// -----------------------------------------------------------------------------
+
+// unitSymbol is a unit that may appear in a parsed expression.
+type unitSymbol struct {
+	value      Value // size of one unit
+	prefixable bool  // accepts SI prefixes
+}
+
+// symbols maps unit symbols to their size. Affine temperature scales
+// (°C, °F) are deliberately absent: a parsed quantity must scale linearly.
+var symbols = map[string]unitSymbol{
+	// SI base and coherent derived units
+	"m":   {Meter(1).Value, true},
+	"g":   {Gram(1).Value, true},
+	"s":   {Second(1).Value, true},
+	"A":   {Ampere(1).Value, true},
+	"K":   {Kelvin(1).Value, true},
+	"mol": {Mole(1).Value, true},
+	"cd":  {Candela(1).Value, true},
+	"N":   {Newton(1).Value, true},
+	"J":   {Joule(1).Value, true},
+	"W":   {Watt(1).Value, true},
+	"Pa":  {Pascal(1).Value, true},
+	"C":   {Coulomb(1).Value, true},
+	"V":   {Volt(1).Value, true},
+	"Ω":   {Ohm(1).Value, true},
+	"ohm": {Ohm(1).Value, true},
+	"S":   {Dimensionless(1).Divide(Ohm(1).Value), true},
+	"F":   {Farad(1).Value, true},
+	"T":   {Tesla(1).Value, true},
+	"Wb":  {Weber(1).Value, true},
+	"H":   {Henry(1).Value, true},
+	"Hz":  {Hertz(1).Value, true},
+	"Bq":  {Becquerel(1).Value, true},
+	"rad": {Radian(1).Value, true},
+
+	// Accepted and common non-SI units
+	"L":      {Liter(1).Value, true},
+	"eV":     {ElectronVolt(1).Value, true},
+	"bar":    {Bar(1).Value, true},
+	"pc":     {Parsec(1).Value, true},
+	"yr":     {Year(1).Value, true},
+	"ly":     {LightYear(1).Value, true},
+	"Da":     {AtomicMassUnit(1).Value, true},
+	"u":      {AtomicMassUnit(1).Value, false},
+	"min":    {Minute(1).Value, false},
+	"h":      {Hour(1).Value, false},
+	"d":      {Day(1).Value, false},
+	"au":     {AstronomicalUnit(1).Value, false},
+	"AU":     {AstronomicalUnit(1).Value, false},
+	"Å":      {Angstrom(1).Value, false},
+	"deg":    {Degree(1).Value, false},
+	"°":      {Degree(1).Value, false},
+	"arcmin": {ArcMinute(1).Value, false},
+	"arcsec": {ArcSecond(1).Value, false},
+	"atm":    {Atmosphere(1).Value, false},
+	"Torr":   {Torr(1).Value, false},
+	"G":      {Gauss(1).Value, false},
+	"dyn":    {Dyne(1).Value, false},
+	"cal":    {Calorie(1).Value, true},
+	"barn":   {Barn(1).Value, false},
+	"in":     {Inch(1).Value, false},
+	"ft":     {Foot(1).Value, false},
+	"mi":     {Mile(1).Value, false},
+	"lb":     {Pound(1).Value, false},
+	"Msun":   {SolarMass(1).Value, false},
+	"Mearth": {EarthMass(1).Value, false},
+}
+
+// prefixes lists the SI prefixes, longest first so that "da" is tried
+// before "d".
+var prefixes = []struct {
+	symbol string
+	factor float64
+}{
+	{"da", 1e1},
+	{"Y", 1e24}, {"Z", 1e21}, {"E", 1e18}, {"P", 1e15}, {"T", 1e12}, {"G", 1e9},
+	{"M", 1e6}, {"k", 1e3}, {"h", 1e2}, {"d", 1e-1}, {"c", 1e-2}, {"m", 1e-3},
+	{"µ", 1e-6}, {"μ", 1e-6}, {"u", 1e-6}, {"n", 1e-9}, {"p", 1e-12}, {"f", 1e-15},
+	{"a", 1e-18}, {"z", 1e-21}, {"y", 1e-24},
+}
+
+// lookupUnit resolves a unit symbol, trying an exact match before an SI
+// prefix, so "min" is a minute and "mm" a millimeter.
+func lookupUnit(sym string) (Value, bool) {
+	if u, ok := symbols[sym]; ok {
+		return u.value, true
+	}
+	for _, p := range prefixes {
+		rest, ok := strings.CutPrefix(sym, p.symbol)
+		if !ok || rest == "" {
+			continue
+		}
+		if u, ok := symbols[rest]; ok && u.prefixable {
+			return u.value.Scale(p.factor), true
+		}
+	}
+	return Value{}, false
+}
+
+// -----------------------------------------------------------------------------
+// Parser
+// -----------------------------------------------------------------------------
+
+// Parse converts a quantity written as a number followed by a unit
+// expression into a Value in SI base units.
+//
+// Units are combined by juxtaposition, '*' or '·' and divided by '/'; a
+// division applies to the single factor that follows, which may be a
+// parenthesized group. Exponents are written with '^' (m^2, s^-1) or
+// superscripts (m², s⁻¹). SI prefixes apply to SI and a few accepted
+// units (km, µs, MeV, kpc, Gyr). A number alone is dimensionless.
+//
+// Example:
+//
+//	g, _ := units.Parse("9.81 m/s^2")        // 9.81 [L^1 T^-2]
+//	c, _ := units.Parse("4.18 kJ/(kg K)")    // 4180 [L^2 T^-2 Θ^-1]
+//	d, _ := units.Parse("1.5 AU")            // 2.244e11 [L^1]
+//	b, _ := units.Parse("12 µT")             // 1.2e-05 [M^1 T^-2 I^-1]
+func Parse(s string) (Value, error) {
+	s = strings.TrimSpace(s)
+	end := numberEnd(s)
+	if end == 0 {
+		return Value{}, fmt.Errorf("quantity %q must start with a number", s)
+	}
+	x, err := strconv.ParseFloat(s[:end], 64)
+	if err != nil {
+		return Value{}, fmt.Errorf("quantity %q: invalid number %q", s, s[:end])
+	}
+	p := &unitParser{src: s, s: strings.TrimSpace(s[end:])}
+	unit := Dimensionless(1)
+	if p.s != "" {
+		if unit, err = p.expr(); err != nil {
+			return Value{}, err
+		}
+		if p.s != "" {
+			return Value{}, p.errorf("unexpected %q", p.s)
+		}
+	}
+	return unit.Scale(x), nil
+}
+
+// numberEnd returns the length of the longest leading floating-point
+// literal in s.
+func numberEnd(s string) int {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	digits := false
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+		digits = digits || s[i] != '.'
+		i++
+	}
+	if !digits {
+		return 0
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			i = j
+		}
+	}
+	return i
+}
+
+// unitParser is a recursive-descent parser over the unit part of a
+// quantity; s holds the unconsumed input.
+type unitParser struct {
+	src string
+	s   string
+}
+
+func (p *unitParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("quantity %q: %s", p.src, fmt.Sprintf(format, args...))
+}
+
+// expr parses factors joined by multiplication or division.
+func (p *unitParser) expr() (Value, error) {
+	result, err := p.factor()
+	if err != nil {
+		return Value{}, err
+	}
+	for {
+		p.s = strings.TrimLeft(p.s, " ")
+		if p.s == "" || p.s[0] == ')' {
+			return result, nil
+		}
+		divide := false
+		switch {
+		case p.s[0] == '/':
+			divide = true
+			p.s = p.s[1:]
+		case p.s[0] == '*':
+			p.s = p.s[1:]
+		case strings.HasPrefix(p.s, "·"):
+			p.s = p.s[len("·"):]
+		}
+		f, err := p.factor()
+		if err != nil {
+			return Value{}, err
+		}
+		if divide {
+			result = result.Divide(f)
+		} else {
+			result = result.Multiply(f)
+		}
+	}
+}
+
+// factor parses a unit symbol or parenthesized group with an optional
+// exponent.
+func (p *unitParser) factor() (Value, error) {
+	p.s = strings.TrimLeft(p.s, " ")
+	var base Value
+	if strings.HasPrefix(p.s, "(") {
+		p.s = p.s[1:]
+		inner, err := p.expr()
+		if err != nil {
+			return Value{}, err
+		}
+		if !strings.HasPrefix(p.s, ")") {
+			return Value{}, p.errorf("missing ')'")
+		}
+		p.s = p.s[1:]
+		base = inner
+	} else {
+		n := strings.IndexFunc(p.s, func(r rune) bool {
+			return !unicode.IsLetter(r) && r != 'Ω' && r != 'Å' && r != '°' && r != 'µ'
+		})
+		if n < 0 {
+			n = len(p.s)
+		}
+		sym := p.s[:n]
+		if sym == "" {
+			return Value{}, p.errorf("expected a unit at %q", p.s)
+		}
+		u, ok := lookupUnit(sym)
+		if !ok {
+			return Value{}, p.errorf("unknown unit %q", sym)
+		}
+		p.s = p.s[n:]
+		base = u
+	}
+	exp, err := p.exponent()
+	if err != nil {
+		return Value{}, err
+	}
+	return base.Power(exp), nil
+}
+
+// superscripts maps superscript runes to the characters they stand for.
+var superscripts = map[rune]byte{
+	'⁰': '0', '¹': '1', '²': '2', '³': '3', '⁴': '4',
+	'⁵': '5', '⁶': '6', '⁷': '7', '⁸': '8', '⁹': '9', '⁻': '-',
+}
+
+// exponent parses an optional "^n" or superscript exponent, returning 1
+// when absent.
+func (p *unitParser) exponent() (int, error) {
+	var digits []byte
+	if strings.HasPrefix(p.s, "^") {
+		p.s = p.s[1:]
+		n := 0
+		for n < len(p.s) && (p.s[n] == '-' && n == 0 || p.s[n] >= '0' && p.s[n] <= '9') {
+			n++
+		}
+		digits = []byte(p.s[:n])
+		p.s = p.s[n:]
+	} else {
+		for p.s != "" {
+			r, size := utf8.DecodeRuneInString(p.s)
+			d, ok := superscripts[r]
+			if !ok {
+				break
+			}
+			digits = append(digits, d)
+			p.s = p.s[size:]
+		}
+		if digits == nil {
+			return 1, nil
+		}
+	}
+	n, err := strconv.Atoi(string(digits))
+	if err != nil {
+		return 0, p.errorf("invalid exponent %q", digits)
+	}
+	return n, nil
+}
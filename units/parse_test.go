@@ -0,0 +1,73 @@
+package units
+
+import (
+	"math"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// Parser Tests
+// -----------------------------------------------------------------------------
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Value
+	}{
+		{"9.81 m/s^2", NewValue(9.81, Dimension{L: 1, T: -2})},
+		{"9.81 m s⁻²", NewValue(9.81, Dimension{L: 1, T: -2})},
+		{"1.5 km", Meter(1500).Value},
+		{"3 mm", Meter(0.003).Value},
+		{"2 min", Second(120).Value},
+		{"1 mi", Mile(1).Value},
+		{"1 h", Hour(1).Value},
+		{"1 hPa", Pascal(100).Value},
+		{"5 kg", Kilogram(5).Value},
+		{"12 µT", Tesla(12e-6).Value},
+		{"12 uT", Tesla(12e-6).Value},
+		{"1 G", Gauss(1).Value},
+		{"1 GeV", ElectronVolt(1e9).Value},
+		{"2.5e3 N*m", Joule(2500).Value},
+		{"1 N·m", Joule(1).Value},
+		{"4.18 kJ/(kg K)", NewValue(4180, Dimension{L: 2, T: -2, Θ: -1})},
+		{"1 kg m^2 s^-1", NewValue(1, Dimension{L: 2, M: 1, T: -1})},
+		{"1 AU", AstronomicalUnit(1).Value},
+		{"10 kpc", Parsec(1e4).Value},
+		{"1 Gyr", Year(1e9).Value},
+		{"90 deg", Degree(90).Value},
+		{"3 cm³", NewValue(3e-6, Dimension{L: 3})},
+		{"-0.5", Dimensionless(-0.5)},
+		{"  7 V/m  ", NewValue(7, Dimension{L: 1, M: 1, T: -3, I: -1})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.in, err)
+			}
+			if got.Dim() != tt.want.Dim() {
+				t.Errorf("Parse(%q) dimension = %v, want %v", tt.in, got.Dim(), tt.want.Dim())
+			}
+			if !almostEqual(got.Val(), tt.want.Val(), 1e-12*math.Abs(tt.want.Val())) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.in, got.Val(), tt.want.Val())
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"m/s",
+		"3 furlongs",
+		"20 °C",
+		"1 kmin",
+		"1 m/(s",
+		"1 m^x",
+		"1 m)",
+	} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) should fail", in)
+		}
+	}
+}
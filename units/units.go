@@ -19,6 +19,7 @@ package units
 import (
 	"fmt"
 	"math"
+	"strings"
 )
 
 // Dimension represents the dimensional formula of a physical quantity using
@@ -284,6 +285,36 @@ func (d Dimension) String() string {
 	return result
 }
 
+// Symbol returns the dimension as a product of SI base unit symbols with
+// integer exponents, in the order kg m s A K mol cd. Dimensionless
+// quantities give "1".
+//
+// Example:
+//
+//	units.Joule(1).Dim().Symbol()           // "kg m^2 s^-2"
+//	units.MeterPerSecond(1).Dim().Symbol()  // "m s^-1"
+func (d Dimension) Symbol() string {
+	if d == (Dimension{}) {
+		return "1"
+	}
+	var parts []string
+	for _, b := range []struct {
+		exp    int8
+		symbol string
+	}{
+		{d.M, "kg"}, {d.L, "m"}, {d.T, "s"}, {d.I, "A"}, {d.Θ, "K"}, {d.N, "mol"}, {d.J, "cd"},
+	} {
+		switch b.exp {
+		case 0:
+		case 1:
+			parts = append(parts, b.symbol)
+		default:
+			parts = append(parts, fmt.Sprintf("%s^%d", b.symbol, b.exp))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
 // almostEqual returns true if two float64 values are equal within a relative tolerance.
 func almostEqual(a, b, tolerance float64) bool {
 	if a == b {
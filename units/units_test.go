@@ -55,6 +55,24 @@ func TestDimensionString(t *testing.T) {
 // Value Basic Operations Tests
 // -----------------------------------------------------------------------------
 
+func TestDimensionSymbol(t *testing.T) {
+	tests := []struct {
+		dim  Dimension
+		want string
+	}{
+		{Dimension{}, "1"},
+		{Dimension{L: 1, T: -1}, "m s^-1"},
+		{Dimension{L: 2, M: 1, T: -2}, "kg m^2 s^-2"},
+		{Dimension{T: 3, I: 2, L: -2, M: -1}, "kg^-1 m^-2 s^3 A^2"},
+		{Dimension{Θ: 1, N: -1}, "K mol^-1"},
+	}
+	for _, tt := range tests {
+		if got := tt.dim.Symbol(); got != tt.want {
+			t.Errorf("%v.Symbol() = %q, want %q", tt.dim, got, tt.want)
+		}
+	}
+}
+
 func TestValueAdd(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -503,6 +521,7 @@ func TestMass(t *testing.T) {
 		{"gram", Gram(1000.0), 1.0},
 		{"milligram", Milligram(1e6), 1.0},
 		{"microgram", Microgram(1e9), 1.0},
+		{"GeV/c²", GigaelectronVoltPerC2(1 / 1.782661921627e-27), 1.0},
 		{"tonne", Tonne(0.001), 1.0},
 	}
 
@@ -629,12 +648,17 @@ func TestAstronomicalUnits(t *testing.T) {
 	if !almostEqual(pc.Val(), 3.0856775814913673e16, 1e-5) {
 		t.Errorf("Parsec(1.0) = %v m, want 3.0856775814913673e16 m", pc.Val())
 	}
+
+	mpc := Megaparsec(1.0)
+	if !almostEqual(mpc.Val(), 3.0856775814913673e22, 1e-5) {
+		t.Errorf("Megaparsec(1.0) = %v m, want 3.0856775814913673e22 m", mpc.Val())
+	}
 }
 
 func TestSolarMass(t *testing.T) {
 	sm := SolarMass(1.0)
-	if !almostEqual(sm.Val(), 1.98892e30, 1e-5) {
-		t.Errorf("SolarMass(1.0) = %v kg, want 1.98892e30 kg", sm.Val())
+	if !almostEqual(sm.Val(), 1.98841e30, 1e-5) {
+		t.Errorf("SolarMass(1.0) = %v kg, want 1.98841e30 kg", sm.Val())
 	}
 }
 
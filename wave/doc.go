@@ -0,0 +1,35 @@
+// Package wave provides kinematic relations for waves that do not depend
+// on the nature of the wave, starting with Doppler shifts.
+//
+// A Shift is the ratio D = f_observed/f_emitted. It is applied to
+// frequencies as f' = D f and to wavelengths as λ' = λ/D, and converts to
+// the astronomical redshift z = 1/D - 1.
+//
+// Sign conventions:
+//   - DopplerAcoustic takes both velocities as components along the
+//     direction from source to observer, measured in the rest frame of the
+//     medium: a positive source velocity approaches the observer, a positive
+//     observer velocity recedes from the source.
+//   - DopplerRelativistic and the redshift conversions take β > 0 for a
+//     receding source, so recession gives z > 0.
+//
+// Example usage:
+//
+//	import (
+//	    "github.com/sakiphan/qsim-core/units"
+//	    "github.com/sakiphan/qsim-core/wave"
+//	)
+//
+//	// Siren approaching a stationary listener at 30 m/s
+//	s, _ := wave.DopplerAcoustic(units.MeterPerSecond(30), units.MeterPerSecond(0), units.MeterPerSecond(343))
+//	heard := s.Frequency(units.Hertz(700)) // ≈ 767 Hz
+//
+//	// Galaxy receding at 0.1c: Hα at 656.3 nm is seen at ≈ 725.6 nm
+//	d, _ := wave.DopplerRelativistic(0.1)
+//	seen := d.Wavelength(units.Nanometer(656.3))
+//	z := d.Redshift() // ≈ 0.1055
+//
+// References:
+//   - Halliday, Resnick, Walker. "Fundamentals of Physics", 10th ed., Ch. 17
+//   - Rybicki, Lightman. "Radiative Processes in Astrophysics", Ch. 4
+package wave
@@ -0,0 +1,114 @@
+package wave
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sakiphan/qsim-core/constants"
+	"github.com/sakiphan/qsim-core/units"
+)
+
+// c is the speed of light in m/s.
+var c = constants.SpeedOfLight.Val()
+
+// Shift is a Doppler factor D = f_observed/f_emitted.
+type Shift float64
+
+// Frequency returns the observed frequency D f of a wave emitted at f.
+func (s Shift) Frequency(f units.Frequency) units.Frequency {
+	return units.Hertz(float64(s) * f.Val())
+}
+
+// Wavelength returns the observed wavelength λ/D of a wave emitted at λ.
+// For sound this is the wavelength the observer infers from the shifted
+// frequency at the propagation speed in the medium.
+func (s Shift) Wavelength(lambda units.Length) units.Length {
+	return units.Meter(lambda.Val() / float64(s))
+}
+
+// Redshift returns z = λ_observed/λ_emitted - 1 = 1/D - 1.
+func (s Shift) Redshift() float64 {
+	return 1/float64(s) - 1
+}
+
+// -----------------------------------------------------------------------------
+// Doppler Factors
+// -----------------------------------------------------------------------------
+
+// DopplerAcoustic returns the Doppler factor for a wave in a medium with
+// propagation speed c_s, for source and observer moving along the line
+// between them. Returns an error if c_s is not positive, the source moves
+// toward the observer at c_s or faster, or the observer outruns the wave.
+//
+// Formula:
+//
+//	D = (c_s - v_o) / (c_s - v_s)
+func DopplerAcoustic(sourceV, observerV, soundSpeed units.Velocity) (Shift, error) {
+	cs, vs, vo := soundSpeed.Val(), sourceV.Val(), observerV.Val()
+	if !(cs > 0) {
+		return 0, fmt.Errorf("wave speed must be positive, got %g m/s", cs)
+	}
+	if !(vs < cs) {
+		return 0, fmt.Errorf("source speed %g m/s toward observer must be less than wave speed %g m/s", vs, cs)
+	}
+	if !(vo < cs) {
+		return 0, fmt.Errorf("observer speed %g m/s away from source must be less than wave speed %g m/s", vo, cs)
+	}
+	return Shift((cs - vo) / (cs - vs)), nil
+}
+
+// DopplerRelativistic returns the longitudinal Doppler factor for light
+// from a source receding at β = v/c (negative when approaching).
+// Returns an error if |β| ≥ 1.
+//
+// Formula:
+//
+//	D = √((1 - β) / (1 + β))
+func DopplerRelativistic(beta float64) (Shift, error) {
+	if !(math.Abs(beta) < 1) {
+		return 0, fmt.Errorf("|β| must be less than 1, got %g", beta)
+	}
+	return Shift(math.Sqrt((1 - beta) / (1 + beta))), nil
+}
+
+// -----------------------------------------------------------------------------
+// Redshift
+// -----------------------------------------------------------------------------
+
+// RedshiftFromVelocity returns the relativistic Doppler redshift of a
+// source receding at radial velocity v. For |v| ≪ c this reduces to
+// z ≈ v/c. Returns an error if |v| ≥ c.
+//
+// Formula:
+//
+//	z = √((1 + β) / (1 - β)) - 1
+func RedshiftFromVelocity(v units.Velocity) (float64, error) {
+	s, err := DopplerRelativistic(v.Val() / c)
+	if err != nil {
+		return 0, err
+	}
+	return s.Redshift(), nil
+}
+
+// VelocityFromRedshift returns the radial velocity of a source whose
+// spectrum is Doppler shifted by z. Returns an error if z ≤ -1.
+//
+// Formula:
+//
+//	β = ((1 + z)² - 1) / ((1 + z)² + 1)
+func VelocityFromRedshift(z float64) (units.Velocity, error) {
+	if !(z > -1) {
+		return units.Velocity{}, fmt.Errorf("redshift must be greater than -1, got %g", z)
+	}
+	q := (1 + z) * (1 + z)
+	return units.MeterPerSecond(c * (q - 1) / (q + 1)), nil
+}
+
+// ShiftFromRedshift returns the Doppler factor D = 1/(1 + z).
+// Returns an error if z ≤ -1.
+func ShiftFromRedshift(z float64) (Shift, error) {
+	if !(z > -1) {
+		return 0, fmt.Errorf("redshift must be greater than -1, got %g", z)
+	}
+	return Shift(1 / (1 + z)), nil
+}
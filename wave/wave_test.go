@@ -0,0 +1,98 @@
+package wave
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sakiphan/qsim-core/units"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestDopplerAcoustic(t *testing.T) {
+	cs := units.MeterPerSecond(343)
+	zero := units.MeterPerSecond(0)
+
+	// Approaching source: f' = f c/(c - v).
+	s, err := DopplerAcoustic(units.MeterPerSecond(30), zero, cs)
+	if err != nil || !almostEqual(s.Frequency(units.Hertz(700)).Val(), 700*343/313.0, 1e-14) {
+		t.Errorf("approaching source: %v, %v", s, err)
+	}
+	// Receding source is lowered.
+	if r, _ := DopplerAcoustic(units.MeterPerSecond(-30), zero, cs); !almostEqual(float64(r), 343/373.0, 1e-14) {
+		t.Errorf("receding source D = %v", r)
+	}
+	// Observer approaching a stationary source: f' = f (c + v)/c.
+	if o, _ := DopplerAcoustic(zero, units.MeterPerSecond(-30), cs); !almostEqual(float64(o), 373/343.0, 1e-14) {
+		t.Errorf("approaching observer D = %v", o)
+	}
+	// Source and observer moving together hear no shift.
+	if m, _ := DopplerAcoustic(units.MeterPerSecond(20), units.MeterPerSecond(20), cs); float64(m) != 1 {
+		t.Errorf("co-moving D = %v, want 1", m)
+	}
+	// Wavelength follows the frequency.
+	if l := s.Wavelength(units.Meter(0.49)); !almostEqual(l.Val(), 0.49*313/343.0, 1e-14) {
+		t.Errorf("shifted wavelength = %v", l)
+	}
+
+	if _, err := DopplerAcoustic(cs, zero, cs); err == nil {
+		t.Error("DopplerAcoustic should reject a sonic source")
+	}
+	if _, err := DopplerAcoustic(zero, units.MeterPerSecond(400), cs); err == nil {
+		t.Error("DopplerAcoustic should reject an observer outrunning the wave")
+	}
+	if _, err := DopplerAcoustic(zero, zero, zero); err == nil {
+		t.Error("DopplerAcoustic should reject zero wave speed")
+	}
+}
+
+func TestDopplerRelativistic(t *testing.T) {
+	d, err := DopplerRelativistic(0.6)
+	if err != nil || !almostEqual(float64(d), 0.5, 1e-14) {
+		t.Errorf("DopplerRelativistic(0.6) = %v, %v; want 0.5", d, err)
+	}
+	if d.Redshift() != 1 {
+		t.Errorf("z = %v, want 1", d.Redshift())
+	}
+	if l := d.Wavelength(units.Nanometer(500)); !almostEqual(l.Val(), 1000e-9, 1e-14) {
+		t.Errorf("shifted wavelength = %v, want 1000 nm", l)
+	}
+	// Approach and recession are reciprocal.
+	a, _ := DopplerRelativistic(-0.6)
+	if !almostEqual(float64(a)*float64(d), 1, 1e-14) {
+		t.Errorf("D(β)D(-β) = %v, want 1", float64(a)*float64(d))
+	}
+	if _, err := DopplerRelativistic(1); err == nil {
+		t.Error("DopplerRelativistic should reject β = 1")
+	}
+}
+
+func TestRedshift(t *testing.T) {
+	// Low velocity: z ≈ v/c.
+	z, err := RedshiftFromVelocity(units.MeterPerSecond(3000))
+	if err != nil || !almostEqual(z, 3000/c, 1e-5) {
+		t.Errorf("RedshiftFromVelocity(3 km/s) = %v, %v", z, err)
+	}
+	for _, beta := range []float64{-0.5, 0.001, 0.3, 0.99} {
+		z, _ := RedshiftFromVelocity(units.SpeedOfLight(beta))
+		v, err := VelocityFromRedshift(z)
+		if err != nil || !almostEqual(v.Val()/c, beta, 1e-12) {
+			t.Errorf("round trip β = %v gave %v, %v", beta, v.Val()/c, err)
+		}
+		s, _ := ShiftFromRedshift(z)
+		if d, _ := DopplerRelativistic(beta); !almostEqual(float64(s), float64(d), 1e-12) {
+			t.Errorf("ShiftFromRedshift(%v) = %v, want %v", z, s, d)
+		}
+	}
+	if _, err := VelocityFromRedshift(-1); err == nil {
+		t.Error("VelocityFromRedshift should reject z = -1")
+	}
+	if _, err := ShiftFromRedshift(-2); err == nil {
+		t.Error("ShiftFromRedshift should reject z < -1")
+	}
+	if _, err := RedshiftFromVelocity(units.SpeedOfLight(1)); err == nil {
+		t.Error("RedshiftFromVelocity should reject v = c")
+	}
+}